@@ -0,0 +1,173 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// AtRuleSpec describes an at-rule and the descriptors allowed in its block.
+type AtRuleSpec struct {
+	Name        string         `json:"name"` // "font-face", without the @
+	Syntax      string         `json:"syntax"`
+	Status      string         `json:"status"`
+	Descriptors []PropertySpec `json:"descriptors,omitempty"`
+
+	// DescriptorsOnly marks the experimental descriptors of a stable rule,
+	// whose name constant is generated with the stable part.
+	DescriptorsOnly bool `json:"-"`
+}
+
+// MDNAtRule represents an at-rule from MDN data.
+type MDNAtRule struct {
+	Syntax      string                 `json:"syntax"`
+	Status      string                 `json:"status"`
+	Descriptors map[string]MDNProperty `json:"descriptors"`
+}
+
+// loadAtRules reads at-rules.json. Descriptors are analyzed like properties.
+func loadAtRules(mdnPath string, analyzer *syntaxAnalyzer) ([]AtRuleSpec, error) {
+	data, err := os.ReadFile(filepath.Join(mdnPath, "at-rules.json"))
+	if err != nil {
+		return nil, nil // optional
+	}
+	var mdnAtRules map[string]MDNAtRule
+	if err := json.Unmarshal(data, &mdnAtRules); err != nil {
+		return nil, fmt.Errorf("cannot parse at-rules.json: %w", err)
+	}
+
+	var rules []AtRuleSpec
+	for name, mdnRule := range mdnAtRules {
+		rule := AtRuleSpec{
+			Name:   strings.TrimPrefix(name, "@"),
+			Syntax: mdnRule.Syntax,
+			Status: mdnRule.Status,
+		}
+		for descName, desc := range mdnRule.Descriptors {
+			types, problems := analyzer.ValueTypes(desc.Syntax)
+			rule.Descriptors = append(rule.Descriptors, PropertySpec{
+				Name:     descName,
+				Keywords: analyzer.Keywords(desc.Syntax),
+				Syntax:   desc.Syntax,
+				Status:   desc.Status,
+				Types:    types,
+				Meta:     propertyMeta(desc, mdnRule.Descriptors),
+				Problems: problems,
+			})
+		}
+		sort.Slice(rule.Descriptors, func(i, j int) bool {
+			return rule.Descriptors[i].Name < rule.Descriptors[j].Name
+		})
+		rules = append(rules, rule)
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].Name < rules[j].Name
+	})
+	return rules, nil
+}
+
+// generateAtRules creates the atrules_gen.go file with at-rule names and
+// descriptor constants, keyword types and setters.
+func generateAtRules(spec Spec, outPath, pkg string) error {
+	if skip, err := skipOutput(spec, outPath, "atrules", len(spec.AtRules) == 0); skip {
+		return err
+	}
+
+	var buf strings.Builder
+
+	// Header
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	var named []AtRuleSpec
+	hasDescriptors, hasKeywords := false, false
+	for _, rule := range spec.AtRules {
+		if !rule.DescriptorsOnly {
+			named = append(named, rule)
+		}
+		hasDescriptors = hasDescriptors || len(rule.Descriptors) > 0
+		for _, desc := range rule.Descriptors {
+			hasKeywords = hasKeywords || len(desc.Keywords) > 0
+		}
+	}
+
+	qual := ""
+	if pkg != "css" {
+		qual = "css."
+	}
+	switch {
+	case hasKeywords && qual != "":
+		buf.WriteString("import (\n\t\"strings\"\n\n\t\"github.com/ahmed-com/typesafe-css/css\"\n)\n\n")
+	case hasKeywords:
+		buf.WriteString("import \"strings\"\n\n")
+	case hasDescriptors && qual != "":
+		buf.WriteString("import \"github.com/ahmed-com/typesafe-css/css\"\n\n")
+	}
+
+	if len(named) > 0 {
+		buf.WriteString("// At-rule names, as used for AtRule.Name.\n")
+		buf.WriteString("const (\n")
+		for _, rule := range named {
+			buf.WriteString(deprecationNotice(rule.Status, "@"+rule.Name+" at-rule"))
+			buf.WriteString(fmt.Sprintf("\t%s = %q\n", atRuleToConstName(rule.Name), rule.Name))
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	for _, rule := range spec.AtRules {
+		if len(rule.Descriptors) == 0 {
+			continue
+		}
+
+		buf.WriteString(fmt.Sprintf("// Descriptors of @%s.\n", rule.Name))
+		buf.WriteString("const (\n")
+		for _, desc := range rule.Descriptors {
+			constName := propToConstName(descriptorName(rule, desc))
+			subject := fmt.Sprintf("%s descriptor of @%s", desc.Name, rule.Name)
+			buf.WriteString(fmt.Sprintf("// %s is the %s.\n", constName, subject))
+			buf.WriteString(propertyDoc(desc, subject, false))
+			buf.WriteString(fmt.Sprintf("\t%s %sProperty = %q\n\n", constName, qual, desc.Name))
+		}
+		buf.WriteString(")\n\n")
+
+		for _, desc := range rule.Descriptors {
+			name := descriptorName(rule, desc)
+			subject := fmt.Sprintf("%s descriptor of @%s", desc.Name, rule.Name)
+			doc := propertyDoc(desc, subject, false)
+			if len(desc.Keywords) > 0 {
+				writeKeywordType(&buf, propToTypeName(name), subject, doc, desc.Keywords)
+			}
+			prop := desc
+			prop.Name = name
+			if members := setterMembers(prop, qual); len(members) > 0 {
+				writeSetter(&buf, name, subject, doc, members, qual)
+			}
+		}
+	}
+
+	// Format and write
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated at-rules: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "atrules")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// descriptorName qualifies a descriptor with its at-rule, so that the names
+// derived from it do not clash with properties.
+// e.g., @font-face font-display -> "font-face-font-display"
+func descriptorName(rule AtRuleSpec, desc PropertySpec) string {
+	return rule.Name + "-" + desc.Name
+}
+
+// atRuleToConstName converts an at-rule name to a Go constant name.
+// e.g., "font-face" -> "AtRuleFontFace"
+func atRuleToConstName(name string) string {
+	return "AtRule" + propToConstName(name)
+}
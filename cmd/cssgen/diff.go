@@ -0,0 +1,325 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+)
+
+// Change is a difference between two specs that affects the generated API.
+type Change struct {
+	Kind     string `json:"kind"`             // e.g. "property-removed", "keyword-added"
+	Name     string `json:"name"`             // "display", "@font-face font-display", "rgb()"
+	Detail   string `json:"detail,omitempty"` // the keyword, value type, or old and new value
+	Breaking bool   `json:"breaking"`         // code using the old generated API may not compile
+}
+
+// DiffReport lists the changes between two specs.
+type DiffReport struct {
+	OldVersion string   `json:"oldVersion"`
+	NewVersion string   `json:"newVersion"`
+	Changes    []Change `json:"changes"`
+	Breaking   int      `json:"breaking"`
+}
+
+// runDiff implements "cssgen diff", which compares two spec directories or
+// files and prints the changes to the generated API.
+func runDiff(args []string, w io.Writer) error {
+	flags := flag.NewFlagSet("cssgen diff", flag.ExitOnError)
+	format := flags.String("format", "text", "Output format: text or json")
+	failOnBreaking := flags.Bool("fail-on-breaking", false, "Fail if a change breaks the generated API")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: cssgen diff [flags] <old spec> <new spec>")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return errors.New("diff needs an old and a new spec")
+	}
+	if *format != "text" && *format != "json" {
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	oldSpec, err := loadSpec(flags.Arg(0))
+	if err != nil {
+		return fmt.Errorf("loading old spec: %w", err)
+	}
+	newSpec, err := loadSpec(flags.Arg(1))
+	if err != nil {
+		return fmt.Errorf("loading new spec: %w", err)
+	}
+
+	report := diffSpecs(normalize(oldSpec), normalize(newSpec))
+	if *format == "json" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		writeDiffText(w, report)
+	}
+
+	if *failOnBreaking && report.Breaking > 0 {
+		return fmt.Errorf("%d breaking changes", report.Breaking)
+	}
+	return nil
+}
+
+// diffSpecs compares two normalized specs.
+func diffSpecs(oldSpec, newSpec Spec) DiffReport {
+	d := &differ{report: DiffReport{OldVersion: oldSpec.Version, NewVersion: newSpec.Version}}
+
+	d.properties("property", "", oldSpec.Properties, newSpec.Properties)
+
+	oldRules, newRules := make(map[string]AtRuleSpec), make(map[string]AtRuleSpec)
+	for _, rule := range oldSpec.AtRules {
+		oldRules[rule.Name] = rule
+	}
+	for _, rule := range newSpec.AtRules {
+		newRules[rule.Name] = rule
+	}
+	d.features("at-rule", atRuleStatuses(oldSpec.AtRules), atRuleStatuses(newSpec.AtRules))
+	for _, name := range unionKeys(oldRules, newRules) {
+		d.properties("descriptor", "@"+name+" ", oldRules[name].Descriptors, newRules[name].Descriptors)
+	}
+
+	d.features("function", functionStatuses(oldSpec.Functions), functionStatuses(newSpec.Functions))
+	oldFns, newFns := make(map[string]string), make(map[string]string)
+	for _, fn := range oldSpec.Functions {
+		oldFns[fn.Name+"()"] = functionSignature(fn)
+	}
+	for _, fn := range newSpec.Functions {
+		newFns[fn.Name+"()"] = functionSignature(fn)
+	}
+	for _, name := range unionKeys(oldFns, newFns) {
+		oldSig, inOld := oldFns[name]
+		newSig, inNew := newFns[name]
+		if inOld && inNew && oldSig != newSig {
+			d.add(Change{Kind: "function-signature-changed", Name: name, Detail: oldSig + " -> " + newSig, Breaking: true})
+		}
+	}
+
+	d.features("selector", selectorStatuses(oldSpec.Selectors), selectorStatuses(newSpec.Selectors))
+	d.features("unit", unitStatuses(oldSpec.Units), unitStatuses(newSpec.Units))
+
+	return d.report
+}
+
+// differ accumulates the changes of a DiffReport.
+type differ struct {
+	report DiffReport
+}
+
+func (d *differ) add(c Change) {
+	d.report.Changes = append(d.report.Changes, c)
+	if c.Breaking {
+		d.report.Breaking++
+	}
+}
+
+// properties compares properties or the descriptors of an at-rule. The
+// prefix qualifies descriptor names with their rule.
+func (d *differ) properties(kind, prefix string, oldProps, newProps []PropertySpec) {
+	olds, news := make(map[string]PropertySpec), make(map[string]PropertySpec)
+	for _, prop := range oldProps {
+		olds[prop.Name] = prop
+	}
+	for _, prop := range newProps {
+		news[prop.Name] = prop
+	}
+
+	for _, name := range unionKeys(olds, news) {
+		oldProp, inOld := olds[name]
+		newProp, inNew := news[name]
+		name = prefix + name
+		switch {
+		case !inOld:
+			d.add(Change{Kind: kind + "-added", Name: name, Detail: newProp.Status})
+			continue
+		case !inNew:
+			d.add(Change{Kind: kind + "-removed", Name: name, Breaking: true})
+			continue
+		}
+
+		d.status(name, oldProp.Status, newProp.Status)
+		if oldProp.Syntax != newProp.Syntax {
+			d.add(Change{Kind: "syntax-changed", Name: name, Detail: oldProp.Syntax + " -> " + newProp.Syntax})
+		}
+		removed, added := setDiff(oldProp.Keywords, newProp.Keywords)
+		for _, kw := range added {
+			d.add(Change{Kind: "keyword-added", Name: name, Detail: kw})
+		}
+		for _, kw := range removed {
+			d.add(Change{Kind: "keyword-removed", Name: name, Detail: kw, Breaking: true})
+		}
+		// The setter's constraint is the union of the keyword type and the
+		// value types, so losing a value type rejects values it accepted.
+		removed, added = setDiff(oldProp.Types, newProp.Types)
+		for _, t := range added {
+			d.add(Change{Kind: "value-type-added", Name: name, Detail: t})
+		}
+		for _, t := range removed {
+			d.add(Change{Kind: "value-type-removed", Name: name, Detail: t, Breaking: true})
+		}
+	}
+}
+
+// features compares named features by their status.
+func (d *differ) features(kind string, olds, news map[string]string) {
+	for _, name := range unionKeys(olds, news) {
+		oldStatus, inOld := olds[name]
+		newStatus, inNew := news[name]
+		switch {
+		case !inOld:
+			d.add(Change{Kind: kind + "-added", Name: name, Detail: newStatus})
+		case !inNew:
+			d.add(Change{Kind: kind + "-removed", Name: name, Breaking: true})
+		default:
+			d.status(name, oldStatus, newStatus)
+		}
+	}
+}
+
+// status reports a status change. Becoming experimental is breaking, as the
+// generated API moves behind the experimental build tag; becoming obsolete
+// only deprecates it.
+func (d *differ) status(name, oldStatus, newStatus string) {
+	if oldStatus == newStatus {
+		return
+	}
+	d.add(Change{
+		Kind:     "status-changed",
+		Name:     name,
+		Detail:   oldStatus + " -> " + newStatus,
+		Breaking: isExperimental(newStatus) && !isExperimental(oldStatus),
+	})
+}
+
+// writeDiffText prints a report as an aligned table followed by a summary.
+func writeDiffText(w io.Writer, report DiffReport) {
+	fmt.Fprintf(w, "Spec diff: %s -> %s\n\n", report.OldVersion, report.NewVersion)
+	if len(report.Changes) == 0 {
+		fmt.Fprintln(w, "No changes to the generated API.")
+		return
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, c := range report.Changes {
+		mark := ""
+		if c.Breaking {
+			mark = "BREAKING"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", mark, c.Kind, c.Name, c.Detail)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\n%d changes, %d breaking\n", len(report.Changes), report.Breaking)
+}
+
+// functionSignature summarizes the generated constructor of a function:
+// its parameter types, arity and result.
+func functionSignature(fn FunctionSpec) string {
+	params := make([]string, len(fn.Params))
+	for i, p := range fn.Params {
+		params[i] = p.Type
+		if params[i] == "" {
+			params[i] = "Value"
+		}
+	}
+	result := "Function"
+	switch {
+	case fn.Math:
+		result = "T"
+	case fn.Returns != "":
+		result = fn.Returns
+	}
+	max := "unbounded"
+	if fn.MaxArgs >= 0 {
+		max = fmt.Sprint(fn.MaxArgs)
+	}
+	return fmt.Sprintf("(%s) %s, %d..%s args", strings.Join(params, ", "), result, fn.MinArgs(), max)
+}
+
+func atRuleStatuses(rules []AtRuleSpec) map[string]string {
+	statuses := make(map[string]string)
+	for _, rule := range rules {
+		statuses["@"+rule.Name] = rule.Status
+	}
+	return statuses
+}
+
+func functionStatuses(fns []FunctionSpec) map[string]string {
+	statuses := make(map[string]string)
+	for _, fn := range fns {
+		statuses[fn.Name+"()"] = fn.Status
+	}
+	return statuses
+}
+
+func selectorStatuses(sels []SelectorSpec) map[string]string {
+	statuses := make(map[string]string)
+	for _, sel := range sels {
+		name := sel.Name
+		if sel.Functional() {
+			name += "()"
+		}
+		statuses[name] = sel.Status
+	}
+	return statuses
+}
+
+func unitStatuses(units []UnitSpec) map[string]string {
+	statuses := make(map[string]string)
+	for _, unit := range units {
+		statuses[unit.Name] = unit.Status
+	}
+	return statuses
+}
+
+// unionKeys returns the keys of both maps, sorted.
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// setDiff returns the values only in a and those only in b, sorted.
+func setDiff(a, b []string) (onlyA, onlyB []string) {
+	inA, inB := make(map[string]bool, len(a)), make(map[string]bool, len(b))
+	for _, v := range a {
+		inA[v] = true
+	}
+	for _, v := range b {
+		inB[v] = true
+	}
+	for _, v := range a {
+		if !inB[v] {
+			onlyA = append(onlyA, v)
+		}
+	}
+	for _, v := range b {
+		if !inA[v] {
+			onlyB = append(onlyB, v)
+		}
+	}
+	sort.Strings(onlyA)
+	sort.Strings(onlyB)
+	return onlyA, onlyB
+}
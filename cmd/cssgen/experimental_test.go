@@ -0,0 +1,9 @@
+//go:build cssexperimental
+
+package main
+
+import "github.com/ahmed-com/typesafe-css/cssgen"
+
+// positionTryStatus is the status reported for @position-try, which is
+// experimental and therefore only generated with the cssexperimental tag.
+const positionTryStatus = cssgen.StatusExperimental
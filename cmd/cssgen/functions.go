@@ -0,0 +1,674 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/internal/syntax"
+)
+
+// FunctionSpec describes a CSS function such as translate().
+type FunctionSpec struct {
+	Name    string          `json:"name"` // "translate", without parentheses
+	Syntax  string          `json:"syntax"`
+	Status  string          `json:"status"`
+	Returns string          `json:"returns,omitempty"` // css value type of the result; "Function" when empty
+	Math    bool            `json:"math,omitempty"`    // the result type is chosen by the caller, as for calc()
+	Params  []FunctionParam `json:"params,omitempty"`
+	MaxArgs int             `json:"maxArgs"`          // syntax.Unbounded when the last parameter repeats
+	Spaced  bool            `json:"spaced,omitempty"` // arguments are separated by spaces, as in circle()
+}
+
+// FunctionParam is one comma-separated argument of a function. When MaxArgs
+// is unbounded the last parameter stands for every remaining argument.
+type FunctionParam struct {
+	Name     string `json:"name"`
+	Type     string `json:"type,omitempty"` // css value type; css.Value when empty
+	Optional bool   `json:"optional,omitempty"`
+	Keyword  string `json:"keyword,omitempty"` // written before the argument, as "at" in circle()
+}
+
+// MinArgs returns the number of arguments up to the last required one.
+func (f FunctionSpec) MinArgs() int {
+	n := 0
+	for i, p := range f.Params {
+		if !p.Optional {
+			n = i + 1
+		}
+	}
+	return n
+}
+
+// loadFunctions reads functions.json and derives each function's parameters
+// from its syntax.
+func loadFunctions(mdnPath string, analyzer *syntaxAnalyzer) ([]FunctionSpec, error) {
+	data, err := os.ReadFile(filepath.Join(mdnPath, "functions.json"))
+	if err != nil {
+		return nil, nil // optional
+	}
+	var mdnFunctions map[string]MDNProperty
+	if err := json.Unmarshal(data, &mdnFunctions); err != nil {
+		return nil, fmt.Errorf("cannot parse functions.json: %w", err)
+	}
+
+	returns := analyzer.functionReturns()
+	var functions []FunctionSpec
+	for name, mdnFunc := range mdnFunctions {
+		fn := analyzer.Function(mdnFunc.Syntax)
+		fn.Name = strings.TrimSuffix(name, "()")
+		fn.Status = mdnFunc.Status
+		fn.Returns = returns[fn.Name]
+		functions = append(functions, fn)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		return functions[i].Name < functions[j].Name
+	})
+	return functions, nil
+}
+
+// functionReturns maps function names to the css value type of their result,
+// for functions that are alternatives of a data type with its own css value
+// type, such as rgb() in <color>.
+func (a *syntaxAnalyzer) functionReturns() map[string]string {
+	names := make([]string, 0, len(valueTypes))
+	for name := range valueTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	returns := make(map[string]string)
+	for _, name := range names {
+		types := valueTypes[name]
+		def, ok := a.syntaxes[name]
+		if !ok || len(types) != 1 {
+			continue
+		}
+		for _, fn := range a.reachableFunctions(a.parse(def.Syntax), make(map[string]bool)) {
+			if _, seen := returns[fn]; !seen {
+				returns[fn] = types[0]
+			}
+		}
+	}
+	return returns
+}
+
+// reachableFunctions returns the functions that can form a value of n,
+// following type references but not function arguments.
+func (a *syntaxAnalyzer) reachableFunctions(n *syntax.Node, seen map[string]bool) []string {
+	var functions []string
+	if n == nil {
+		return nil
+	}
+	syntax.Walk(n, func(c *syntax.Node) bool {
+		switch c.Kind {
+		case syntax.Function:
+			if c.Name != "" {
+				functions = append(functions, c.Name)
+			}
+			return false
+		case syntax.Type:
+			if strings.HasSuffix(c.Name, "()") {
+				functions = append(functions, strings.TrimSuffix(c.Name, "()"))
+				return false
+			}
+			if _, basic := valueTypes[c.Name]; basic || seen[c.Name] {
+				return false
+			}
+			seen[c.Name] = true
+			if def, ok := a.syntaxes[c.Name]; ok {
+				functions = append(functions, a.reachableFunctions(a.parse(def.Syntax), seen)...)
+			}
+		}
+		return true
+	})
+	return functions
+}
+
+// Function derives the parameters of a function from its syntax. Arguments
+// are the comma-separated parts of the function's argument list; a part
+// repeated with # counts once per repetition. An argument list without
+// commas, as in circle( <radial-size>? [ at <position> ]? ), takes one
+// argument per space-separated component instead. When the syntax offers
+// several forms, as rgb() does, the parameters cover all of them and are
+// untyped.
+func (a *syntaxAnalyzer) Function(fnSyntax string) FunctionSpec {
+	a.problems = make(map[string]bool)
+	fn := FunctionSpec{Syntax: fnSyntax}
+
+	n := a.parse(fnSyntax)
+	if n == nil {
+		return fn
+	}
+	var forms []*syntax.Node
+	if n.Kind == syntax.Function {
+		forms = []*syntax.Node{n}
+	} else if n.Kind == syntax.Group && n.Combinator == syntax.OneOf {
+		forms = n.Children
+	}
+
+	var params [][]FunctionParam
+	maxArgs := 0
+	for _, form := range forms {
+		if form.Kind != syntax.Function || len(form.Children) != 1 {
+			continue
+		}
+		args := form.Children[0]
+		var (
+			p    []FunctionParam
+			max  int
+			math bool
+		)
+		if len(forms) == 1 && spaced(args) {
+			p, max, math = a.spacedParams(args)
+			fn.Spaced = true
+		} else {
+			p, max, math = a.functionParams(args)
+		}
+		params = append(params, p)
+		fn.Math = fn.Math || math
+		if max == syntax.Unbounded || maxArgs == syntax.Unbounded {
+			maxArgs = syntax.Unbounded
+		} else if max > maxArgs {
+			maxArgs = max
+		}
+	}
+
+	switch len(params) {
+	case 0:
+	case 1:
+		fn.Params = params[0]
+	default:
+		fn.Params = mergeParams(params)
+	}
+	fn.MaxArgs = maxArgs
+	nameParams(&fn)
+	return fn
+}
+
+// functionParams lists the parameters of one form of a function, with the
+// maximum number of arguments it accepts and whether it takes a <calc-sum>.
+func (a *syntaxAnalyzer) functionParams(args *syntax.Node) ([]FunctionParam, int, bool) {
+	var params []FunctionParam
+	maxArgs := 0
+	math := false
+	for _, part := range splitArgs(args.String()) {
+		n := a.parse(part)
+		if n == nil {
+			continue
+		}
+		syntax.Walk(n, func(c *syntax.Node) bool {
+			math = math || c.Kind == syntax.Type && c.Name == "calc-sum"
+			return c.Kind != syntax.Function
+		})
+
+		optional := a.isNullable(n)
+		elem, min, max := repetition(n)
+		if elem.Min == 0 && elem.Max == 1 && !elem.Comma && !elem.Required {
+			// The ? of an optional argument is carried by Optional.
+			single := *elem
+			single.Min = 1
+			elem = &single
+		}
+		params = append(params, repeatParam(a.param(elem), optional, min, max)...)
+		maxArgs = addArgs(maxArgs, max)
+	}
+	return params, maxArgs, math
+}
+
+// spaced reports whether an argument list is a sequence of space-separated
+// components without commas, as in circle( <radial-size>? [ at <position> ]? ).
+func spaced(args *syntax.Node) bool {
+	if args.Kind != syntax.Group || args.Combinator != syntax.Juxtapose || args.Multiplied() || len(args.Children) < 2 {
+		return false
+	}
+	commas := false
+	syntax.Walk(args, func(n *syntax.Node) bool {
+		commas = commas || n.Comma || n.Kind == syntax.Literal && n.Name == ","
+		return n.Kind != syntax.Function
+	})
+	return !commas
+}
+
+// spacedParams lists the parameters of a space-separated argument list, one
+// per component, or per repetition of a component such as
+// <length-percentage>{1,4}. The keywords that introduce a component, such as
+// the at of [ at <position> ]?, are kept with its parameter.
+func (a *syntaxAnalyzer) spacedParams(args *syntax.Node) ([]FunctionParam, int, bool) {
+	var params []FunctionParam
+	maxArgs := 0
+	math := false
+	for _, c := range args.Children {
+		syntax.Walk(c, func(n *syntax.Node) bool {
+			math = math || n.Kind == syntax.Type && n.Name == "calc-sum"
+			return n.Kind != syntax.Function
+		})
+
+		elem, keyword := c, ""
+		if c.Kind == syntax.Group && c.Combinator == syntax.Juxtapose && c.Max == 1 {
+			var keywords []string
+			rest := c.Children
+			for len(rest) > 1 && (rest[0].Kind == syntax.Keyword || rest[0].Kind == syntax.Literal) && !rest[0].Multiplied() {
+				keywords = append(keywords, rest[0].Name)
+				rest = rest[1:]
+			}
+			if len(keywords) > 0 && len(rest) == 1 {
+				elem, keyword = rest[0], strings.Join(keywords, " ")
+			}
+		}
+
+		min, max := 1, 1
+		if elem == c && c.Multiplied() {
+			// Each repetition of <x>{1,4}, and the single <x> of <x>?,
+			// is a parameter of its own.
+			min, max = c.Min, c.Max
+			single := *c
+			single.Min, single.Max, single.Required = 1, 1, false
+			elem = &single
+		}
+		param := a.param(elem)
+		param.Keyword = keyword
+		params = append(params, repeatParam(param, a.isNullable(c), min, max)...)
+		maxArgs = addArgs(maxArgs, max)
+	}
+	return params, maxArgs, math
+}
+
+// repeatParam returns the parameters of an argument repeated min to max
+// times. When max is unbounded the last parameter stands for the rest.
+func repeatParam(param FunctionParam, optional bool, min, max int) []FunctionParam {
+	count := max
+	if max == syntax.Unbounded {
+		count = min + 1
+	}
+	params := make([]FunctionParam, count)
+	for i := range params {
+		params[i] = param
+		params[i].Optional = optional || i >= min
+	}
+	return params
+}
+
+// addArgs adds the maximum number of arguments of a parameter to a
+// function's, either of which may be unbounded.
+func addArgs(maxArgs, max int) int {
+	if max == syntax.Unbounded || maxArgs == syntax.Unbounded {
+		return syntax.Unbounded
+	}
+	return maxArgs + max
+}
+
+// param returns the parameter for one argument. Arguments that are a single
+// reference, or a choice of references, to exactly one css value type are
+// typed; anything else, including arguments that accept keywords, is a
+// css.Value.
+func (a *syntaxAnalyzer) param(n *syntax.Node) FunctionParam {
+	var p FunctionParam
+	if n.Kind == syntax.Type || n.Kind == syntax.Property {
+		p.Name = n.Name
+	}
+
+	simple := n.Kind == syntax.Type || n.Kind == syntax.Property
+	if n.Kind == syntax.Group && n.Combinator == syntax.OneOf && !n.Multiplied() {
+		simple = true
+		for _, c := range n.Children {
+			if c.Kind != syntax.Type && c.Kind != syntax.Property || c.Multiplied() {
+				simple = false
+			} else if types, _ := a.ValueTypes(c.String()); len(types) == 0 {
+				// An alternative without a css value type, such as the
+				// <zero> of [ <angle> | <zero> ], rules out a typed parameter.
+				simple = false
+			}
+		}
+	}
+	if simple && !n.Multiplied() && len(a.Keywords(n.String())) == 0 {
+		if types, _ := a.ValueTypes(n.String()); len(types) == 1 {
+			p.Type = types[0]
+		}
+	}
+	return p
+}
+
+// repetition unwraps a comma-separated repetition such as <calc-sum>#{3},
+// returning the repeated element and the number of arguments it spans.
+func repetition(n *syntax.Node) (*syntax.Node, int, int) {
+	if !n.Comma {
+		// A multiplier stacked on a repetition, as in <x>#?
+		if n.Kind == syntax.Group && len(n.Children) == 1 && n.Children[0].Comma {
+			elem, min, max := repetition(n.Children[0])
+			return elem, n.Min * min, multiply(n.Max, max)
+		}
+		return n, 1, 1
+	}
+
+	elem := *n
+	elem.Min, elem.Max, elem.Comma = 1, 1, false
+	width := len(splitArgs(elem.String()))
+	if width > 1 {
+		// [ <number [0,1]>, <number> ]#{2} spans four arguments; each is
+		// typed on its own, so the element stays untyped.
+		return &syntax.Node{Kind: syntax.Group, Min: 1, Max: 1}, n.Min * width, multiply(n.Max, width)
+	}
+	return &elem, n.Min, n.Max
+}
+
+// multiply multiplies two repetition bounds, either of which may be
+// unbounded.
+func multiply(a, b int) int {
+	if a == syntax.Unbounded || b == syntax.Unbounded {
+		return syntax.Unbounded
+	}
+	return a * b
+}
+
+// mergeParams combines the parameters of several forms of a function into
+// untyped parameters accepting any of them.
+func mergeParams(forms [][]FunctionParam) []FunctionParam {
+	longest, shortest := 0, -1
+	for _, form := range forms {
+		if len(form) > longest {
+			longest = len(form)
+		}
+		if required := (FunctionSpec{Params: form}).MinArgs(); shortest < 0 || required < shortest {
+			shortest = required
+		}
+	}
+	merged := make([]FunctionParam, longest)
+	for i := range merged {
+		merged[i].Optional = i >= shortest
+	}
+	return merged
+}
+
+// nameParams gives every parameter a Go identifier derived from the type it
+// references, numbering names repeated among the positional parameters:
+// every parameter of a function with a bounded arity, and the required ones
+// otherwise.
+func nameParams(fn *FunctionSpec) {
+	positional := fn.MinArgs()
+	if fn.MaxArgs != syntax.Unbounded {
+		positional = len(fn.Params)
+	}
+	count := make(map[string]int)
+	for i := range fn.Params {
+		fn.Params[i].Name = paramName(fn.Params[i].Name)
+		if i < positional {
+			count[fn.Params[i].Name]++
+		}
+	}
+	seen := make(map[string]int)
+	for i := range fn.Params[:positional] {
+		name := fn.Params[i].Name
+		if count[name] > 1 {
+			seen[name]++
+			fn.Params[i].Name = fmt.Sprintf("%s%d", name, seen[name])
+		}
+	}
+}
+
+// paramName converts a data type or property name to a Go parameter name.
+// e.g., "length-percentage" -> "lengthPercentage"
+func paramName(name string) string {
+	name = strings.Trim(name, "'")
+	if name == "" {
+		return "arg"
+	}
+	ident := propToConstName(name)
+	ident = strings.Map(func(r rune) rune {
+		if r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' {
+			return r
+		}
+		return -1
+	}, ident)
+	if ident == "" {
+		return "arg"
+	}
+	ident = strings.ToLower(ident[:1]) + ident[1:]
+	if token.IsKeyword(ident) || types.Universe.Lookup(ident) != nil || ident == "css" {
+		ident += "Value"
+	}
+	return ident
+}
+
+// splitArgs splits an argument list at its top-level commas.
+func splitArgs(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '(', '<', '{':
+			depth++
+		case ']', ')', '>', '}':
+			depth--
+		case '\'':
+			if end := strings.IndexByte(s[i+1:], '\''); end >= 0 {
+				i += end + 1
+			}
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if rest := strings.TrimSpace(s[start:]); rest != "" {
+		parts = append(parts, rest)
+	}
+	return parts
+}
+
+// generateFunctions creates the functions_gen.go file with constructors for
+// CSS functions.
+func generateFunctions(spec Spec, outPath, pkg string) error {
+	if skip, err := skipOutput(spec, outPath, "functions", len(spec.Functions) == 0); skip {
+		return err
+	}
+
+	var buf strings.Builder
+
+	// Header
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	// The helpers are generated with the stable part only.
+	stable := spec.BuildTag == ""
+	qual := ""
+	if pkg != "css" {
+		qual = "css."
+		buf.WriteString("import \"github.com/ahmed-com/typesafe-css/css\"\n\n")
+	}
+
+	if !stable {
+		buf.WriteString("// Constructors for experimental CSS functions.\n\n")
+	} else {
+		buf.WriteString("// Constructors for CSS functions. Arguments are checked against the\n")
+		buf.WriteString("// function's syntax at compile time: typed parameters take a single css\n")
+		buf.WriteString("// value type, and every argument the syntax allows has its own parameter\n")
+		buf.WriteString("// unless the last one repeats without limit.\n\n")
+
+		buf.WriteString(fmt.Sprintf(`// fnArgs collects the arguments of a function value, dropping omitted (nil
+// or empty) optional arguments.
+func fnArgs[T %[1]sValue](args []%[1]sValue, more []T) []%[1]sValue {
+	for _, v := range more {
+		args = append(args, v)
+	}
+	kept := args[:0]
+	for _, v := range args {
+		if v != nil && v.String() != "" {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// fnKeywords collects the arguments of a function whose syntax separates
+// them with spaces, writing each after the keywords that introduce it, as
+// the at of circle( <radial-size>? [ at <position> ]? ). Omitted (nil or
+// empty) optional arguments are dropped along with their keywords.
+func fnKeywords[T %[1]sValue](keywords []string, args []%[1]sValue, more []T) []%[1]sValue {
+	for _, v := range more {
+		args = append(args, v)
+	}
+	kept := args[:0]
+	for i, v := range args {
+		switch {
+		case v == nil || v.String() == "":
+		case i < len(keywords) && keywords[i] != "":
+			kept = append(kept, %[1]sRaw(keywords[i]+" "+v.String()))
+		default:
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+`, qual))
+	}
+
+	for _, fn := range spec.Functions {
+		if err := writeFunction(&buf, fn, qual); err != nil {
+			return err
+		}
+	}
+
+	// Format and write
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated functions: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "functions")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// writeFunction writes the constructor of a function. Every argument of a
+// function with a bounded arity is a parameter of its own, so passing too
+// many does not compile; only an unbounded last parameter is variadic.
+func writeFunction(buf *strings.Builder, fn FunctionSpec, qual string) error {
+	funcName := functionToFuncName(fn.Name)
+	required := fn.MinArgs()
+	fixed := fn.Params[:required]
+	if fn.MaxArgs != syntax.Unbounded {
+		if len(fn.Params) != fn.MaxArgs {
+			return fmt.Errorf("function %s(): %d parameters cannot express at most %d arguments", fn.Name, len(fn.Params), fn.MaxArgs)
+		}
+		fixed = fn.Params
+	}
+
+	var params, positional []string
+	nilable, blank := false, false
+	for i, p := range fixed {
+		typ := qual + "Value"
+		// Optional leading arguments are untyped so that nil can omit them;
+		// optional trailing ones keep their type and are omitted with "".
+		if p.Type != "" && (!p.Optional || i >= required) {
+			typ = qual + p.Type
+		}
+		if p.Optional {
+			nilable = nilable || typ == qual+"Value"
+			blank = blank || typ != qual+"Value"
+		}
+		params = append(params, fmt.Sprintf("%s %s", p.Name, typ))
+		positional = append(positional, p.Name)
+	}
+
+	variadic := ""
+	if rest := fn.Params[len(fixed):]; len(rest) > 0 {
+		variadic = "more"
+		typ := rest[0].Type
+		for _, p := range rest {
+			if p.Type != typ {
+				typ = ""
+			}
+		}
+		if typ == "" {
+			typ = "Value"
+		}
+		params = append(params, fmt.Sprintf("%s ...%s%s", variadic, qual, typ))
+	}
+
+	result, typeParams, conv := qual+"Function", "", ""
+	switch {
+	case fn.Math:
+		result, typeParams, conv = "T", fmt.Sprintf("[T %sVarValue]", qual), "T"
+	case fn.Returns != "":
+		result, conv = qual+fn.Returns, qual+fn.Returns
+	}
+
+	if fn.Math {
+		buf.WriteString(fmt.Sprintf("// %s builds %s as a T, e.g. css.Length.\n", funcName, fn.Syntax))
+	} else {
+		buf.WriteString(fmt.Sprintf("// %s builds %s.\n", funcName, fn.Syntax))
+	}
+	switch {
+	case nilable && blank:
+		buf.WriteString("// Pass nil, or \"\" for a typed parameter, to omit an optional argument.\n")
+	case blank:
+		buf.WriteString("// Pass \"\" to omit an optional argument.\n")
+	case nilable:
+		buf.WriteString("// Pass nil to omit an optional argument.\n")
+	}
+	if notice := deprecationNotice(fn.Status, fn.Name+"() function"); notice != "" {
+		buf.WriteString("//\n" + notice)
+	}
+	buf.WriteString(fmt.Sprintf("func %s%s(%s) %s {\n", funcName, typeParams, strings.Join(params, ", "), result))
+
+	var keywords []string
+	for _, p := range fn.Params {
+		if p.Keyword != "" {
+			keywords = make([]string, len(fn.Params))
+			for i, p := range fn.Params {
+				keywords[i] = p.Keyword
+			}
+			break
+		}
+	}
+
+	var args string
+	switch {
+	case keywords != nil && variadic != "":
+		args = fmt.Sprintf("fnKeywords(%#v, %s, %s)...", keywords, valueSlice(positional, qual), variadic)
+	case keywords != nil:
+		args = fmt.Sprintf("fnKeywords[%sValue](%#v, %s, nil)...", qual, keywords, valueSlice(positional, qual))
+	case variadic != "":
+		args = fmt.Sprintf("fnArgs(%s, %s)...", valueSlice(positional, qual), variadic)
+	case nilable || blank:
+		args = fmt.Sprintf("fnArgs[%sValue](%s, nil)...", qual, valueSlice(positional, qual))
+	default:
+		args = strings.Join(positional, ", ")
+	}
+	constructor := "Func"
+	if fn.Spaced {
+		constructor = "SpaceFunc"
+	}
+	call := fmt.Sprintf("%s%s(%q", qual, constructor, fn.Name)
+	if args != "" {
+		call += ", " + args
+	}
+	call += ")"
+	if conv != "" {
+		call = conv + "(" + call + ")"
+	}
+	buf.WriteString(fmt.Sprintf("\treturn %s\n}\n\n", call))
+	return nil
+}
+
+func valueSlice(names []string, qual string) string {
+	if len(names) == 0 {
+		return "nil"
+	}
+	return fmt.Sprintf("[]%sValue{%s}", qual, strings.Join(names, ", "))
+}
+
+// functionToFuncName converts a CSS function name to the name of its Go
+// constructor.
+// e.g., "linear-gradient" -> "FnLinearGradient"
+func functionToFuncName(name string) string {
+	return "Fn" + propToConstName(name)
+}
@@ -9,24 +9,54 @@ import (
 	"log"
 	"os"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
 // Spec represents the CSS specification data.
 type Spec struct {
-	Properties []PropertySpec `json:"properties"`
-	Version    string         `json:"version"`
+	Properties []PropertySpec    `json:"properties"`
+	Version    string            `json:"version"`
+	Syntaxes   map[string]string `json:"syntaxes,omitempty"` // data type name -> value definition syntax
+	Functions  []FunctionSpec    `json:"functions,omitempty"`
+	Selectors  []SelectorSpec    `json:"selectors,omitempty"`
+	AtRules    []AtRuleSpec      `json:"atRules,omitempty"`
+	Units      []UnitSpec        `json:"units,omitempty"`
+
+	// BuildTag, when set, puts the generated files behind a build constraint
+	// and names them *_experimental_gen.go; see splitExperimental.
+	BuildTag string `json:"-"`
 }
 
 // PropertySpec represents a single CSS property specification.
 type PropertySpec struct {
-	Name     string   `json:"name"`     // "background-repeat"
-	Keywords []string `json:"keywords"` // ["repeat", "no-repeat", ...] (may be empty)
-	Syntax   string   `json:"syntax"`   // e.g., "<color> | <image> | ...", used later for richer types
-	Status   string   `json:"status"`   // "standard" | "experimental" | "deprecated"
+	Name     string       `json:"name"`            // "background-repeat"
+	Keywords []string     `json:"keywords"`        // ["repeat", "no-repeat", ...] (may be empty)
+	Syntax   string       `json:"syntax"`          // e.g., "<color> | <image> | ...", used later for richer types
+	Status   string       `json:"status"`          // "standard" | "nonstandard" | "experimental" | "obsolete"
+	Types    []string     `json:"types,omitempty"` // css value types accepted on their own, e.g. ["Length", "Color"]
+	Meta     PropertyMeta `json:"meta"`            // MDN metadata for the PropertyInfo table
+
+	// Problems lists unknown references and unparsable syntax found while
+	// computing Types; -strict turns them into errors.
+	Problems []string `json:"-"`
+}
+
+// PropertyMeta holds the MDN metadata carried into the generated
+// PropertyInfo table.
+type PropertyMeta struct {
+	Initial       string   `json:"initial,omitempty"`   // empty for shorthands
+	Longhands     []string `json:"longhands,omitempty"` // properties set by a shorthand, sorted by name
+	Inherited     bool     `json:"inherited,omitempty"`
+	Animatable    bool     `json:"animatable,omitempty"`
+	AnimationType string   `json:"animationType,omitempty"` // e.g. "length", "discrete"; empty for shorthands
+	AppliesTo     string   `json:"appliesTo,omitempty"`
+	Computed      string   `json:"computed,omitempty"` // empty for shorthands
+	Groups        []string `json:"groups,omitempty"`
+	MDNURL        string   `json:"mdnUrl,omitempty"`
 }
 
 // MDNProperty represents a CSS property from MDN data.
@@ -51,12 +81,20 @@ type MDNSyntax struct {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "diff" {
+		if err := runDiff(os.Args[2:], os.Stdout); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+		return
+	}
+
 	var (
 		inPath            = flag.String("in", "", "Path to spec.json or directory containing spec files")
 		outPath           = flag.String("out", "./cssgen", "Output directory for generated files")
 		pkg               = flag.String("pkg", "css", "Package name for generated files")
 		strict            = flag.Bool("strict", false, "Fail if unknown/unsupported syntax segments")
-		allowExperimental = flag.Bool("allow-experimental", false, "Include non-standard properties")
+		allowExperimental = flag.Bool("allow-experimental", false, "Generate experimental APIs without the "+experimentalTag+" build tag")
+		grammarPath       = flag.String("grammar", "", "Output file for the property grammar table used by css.Validate (package syntax)")
 	)
 	flag.Parse()
 
@@ -71,7 +109,12 @@ func main() {
 	}
 
 	// Normalize spec
-	normalized := normalize(spec, *allowExperimental, *strict)
+	normalized := normalize(spec)
+	if *strict {
+		if err := checkSyntax(normalized); err != nil {
+			log.Fatalf("Error: %v", err)
+		}
+	}
 
 	// Ensure output directory exists
 	if err := os.MkdirAll(*outPath, 0755); err != nil {
@@ -79,16 +122,16 @@ func main() {
 	}
 
 	// Generate files
-	if err := generateProperties(normalized, *outPath, *pkg); err != nil {
-		log.Fatalf("Error generating properties: %v", err)
-	}
-
-	if err := generateKeywords(normalized, *outPath, *pkg); err != nil {
-		log.Fatalf("Error generating keywords: %v", err)
+	if err := generateAll(normalized, *outPath, *pkg, *allowExperimental); err != nil {
+		log.Fatalf("Error %v", err)
 	}
 
-	if err := generateSetters(normalized, *outPath, *pkg); err != nil {
-		log.Fatalf("Error generating setters: %v", err)
+	// The grammar covers every property regardless of status, so that
+	// validation accepts experimental and deprecated properties too.
+	if *grammarPath != "" {
+		if err := generateGrammar(spec, *grammarPath); err != nil {
+			log.Fatalf("Error generating grammar: %v", err)
+		}
 	}
 
 	fmt.Printf("Generated CSS property definitions in %s\n", *outPath)
@@ -133,6 +176,21 @@ func loadSpecFromFile(filePath string) (Spec, error) {
 		return spec, fmt.Errorf("cannot parse JSON from %s: %w", filePath, err)
 	}
 
+	// A standalone spec file carries no data type definitions, so only the
+	// basic types referenced directly by each syntax are recognized.
+	analyzer := newSyntaxAnalyzer(nil, nil, nil)
+	for i, prop := range spec.Properties {
+		if len(prop.Types) == 0 && prop.Syntax != "" {
+			spec.Properties[i].Types, spec.Properties[i].Problems = analyzer.ValueTypes(prop.Syntax)
+		}
+	}
+	for i, fn := range spec.Functions {
+		if fn.Params == nil && fn.Syntax != "" {
+			derived := analyzer.Function(fn.Syntax)
+			spec.Functions[i].Params, spec.Functions[i].MaxArgs, spec.Functions[i].Math = derived.Params, derived.MaxArgs, derived.Math
+		}
+	}
+
 	return spec, nil
 }
 
@@ -155,14 +213,31 @@ func loadSpecFromMDN(mdnPath string) (Spec, error) {
 	syntaxesFile := filepath.Join(mdnPath, "syntaxes.json")
 	if syntaxesData, err := os.ReadFile(syntaxesFile); err == nil {
 		if err := json.Unmarshal(syntaxesData, &mdnSyntaxes); err != nil {
-			fmt.Printf("Warning: cannot parse syntaxes.json: %v\n", err)
+			fmt.Fprintf(os.Stderr, "Warning: cannot parse syntaxes.json: %v\n", err)
 		}
 	}
 
+	// Load basic data types (optional)
+	basicTypes := make(map[string]bool)
+	if typesData, err := os.ReadFile(filepath.Join(mdnPath, "types.json")); err == nil {
+		var mdnTypes map[string]json.RawMessage
+		if err := json.Unmarshal(typesData, &mdnTypes); err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: cannot parse types.json: %v\n", err)
+		}
+		for name := range mdnTypes {
+			basicTypes[name] = true
+		}
+	}
+	analyzer := newSyntaxAnalyzer(mdnSyntaxes, mdnProperties, basicTypes)
+
 	// Convert MDN format to our Spec format
 	spec := Spec{
 		Version:    "mdn-latest",
 		Properties: make([]PropertySpec, 0, len(mdnProperties)),
+		Syntaxes:   make(map[string]string, len(mdnSyntaxes)),
+	}
+	for name, def := range mdnSyntaxes {
+		spec.Syntaxes[name] = def.Syntax
 	}
 
 	for propName, mdnProp := range mdnProperties {
@@ -171,13 +246,17 @@ func loadSpecFromMDN(mdnPath string) (Spec, error) {
 			continue
 		}
 
-		keywords := extractKeywordsFromSyntax(mdnProp.Syntax, mdnSyntaxes)
-		
+		keywords := analyzer.Keywords(mdnProp.Syntax)
+		types, problems := analyzer.ValueTypes(mdnProp.Syntax)
+
 		propSpec := PropertySpec{
 			Name:     propName,
 			Keywords: keywords,
 			Syntax:   mdnProp.Syntax,
 			Status:   mdnProp.Status,
+			Types:    types,
+			Meta:     propertyMeta(mdnProp, mdnProperties),
+			Problems: problems,
 		}
 
 		spec.Properties = append(spec.Properties, propSpec)
@@ -188,106 +267,84 @@ func loadSpecFromMDN(mdnPath string) (Spec, error) {
 		return spec.Properties[i].Name < spec.Properties[j].Name
 	})
 
-	return spec, nil
-}
-
-// extractKeywordsFromSyntax extracts concrete keyword values from MDN syntax.
-func extractKeywordsFromSyntax(syntax string, syntaxes map[string]MDNSyntax) []string {
-	var keywords []string
-	seen := make(map[string]bool)
-
-	// First, expand any type references (e.g., <display-box> -> "contents | none")
-	expanded := expandSyntaxTypes(syntax, syntaxes)
-
-	// Extract keywords using regex for simple alternatives (word | word | word)
-	// Include numbers for font-weight and similar properties
-	re := regexp.MustCompile(`\b([a-z-]+|\d+)\b`)
-	matches := re.FindAllString(expanded, -1)
-
-	for _, match := range matches {
-		// Filter out obvious non-keywords
-		if isKeyword(match) && !seen[match] {
-			keywords = append(keywords, match)
-			seen[match] = true
-		}
+	// Load functions, selectors, at-rules and units (optional)
+	if spec.Functions, err = loadFunctions(mdnPath, analyzer); err != nil {
+		return Spec{}, err
 	}
-
-	sort.Strings(keywords)
-	return keywords
-}
-
-// expandSyntaxTypes replaces type references with their syntax definitions.
-func expandSyntaxTypes(syntax string, syntaxes map[string]MDNSyntax) string {
-	if syntaxes == nil {
-		return syntax
+	if spec.Selectors, err = loadSelectors(mdnPath); err != nil {
+		return Spec{}, err
+	}
+	if spec.AtRules, err = loadAtRules(mdnPath, analyzer); err != nil {
+		return Spec{}, err
+	}
+	if spec.Units, err = loadUnits(mdnPath); err != nil {
+		return Spec{}, err
 	}
 
-	// Replace <type-name> with the actual syntax from syntaxes.json
-	re := regexp.MustCompile(`<([^>]+)>`)
-	
-	expanded := re.ReplaceAllStringFunc(syntax, func(match string) string {
-		typeName := strings.Trim(match, "<>")
-		if syntaxDef, exists := syntaxes[typeName]; exists {
-			return syntaxDef.Syntax
-		}
-		return match
-	})
-
-	return expanded
+	return spec, nil
 }
 
-// isKeyword determines if a string looks like a CSS keyword value.
-func isKeyword(s string) bool {
-	// Skip common syntax elements that aren't keywords
-	skip := []string{
-		"and", "or", "not", "where", "is", "has", "any", "all",
-		"inherit", "initial", "unset", "revert",
-		"length", "percentage", "number", "integer", "string",
-		"color", "image", "url", "calc", "var", "env", "attr",
-		"global", "values", "keyword", "value", "property",
+// propertyMeta converts MDN property metadata. Shorthands list their
+// longhands in place of an initial value, computed value and animation type;
+// MDN's order varies between properties, so they are sorted by name.
+func propertyMeta(p MDNProperty, all map[string]MDNProperty) PropertyMeta {
+	meta := PropertyMeta{
+		Groups: p.Groups,
+		MDNURL: p.MDNURL,
 	}
-
-	s = strings.ToLower(s)
-	
-	// Skip if too short (but allow single digits for font-weight)
-	if len(s) < 2 && !regexp.MustCompile(`^\d+$`).MatchString(s) {
-		return false
-	}
-
-	// Skip if contains characters that suggest it's not a simple keyword
-	if strings.ContainsAny(s, "()[]{}#%<>") {
-		return false
+	meta.Inherited, _ = p.Inherited.(bool)
+	meta.AppliesTo, _ = p.AppliesToo.(string)
+	meta.Computed, _ = p.Computed.(string)
+
+	switch initial := p.Initial.(type) {
+	case string:
+		meta.Initial = initial
+	case []interface{}:
+		for _, longhand := range initial {
+			if name, ok := longhand.(string); ok {
+				meta.Longhands = append(meta.Longhands, name)
+			}
+		}
+		sort.Strings(meta.Longhands)
 	}
 
-	// Skip common non-keyword terms
-	for _, term := range skip {
-		if s == term {
-			return false
+	switch animation := p.AnimationType.(type) {
+	case string:
+		meta.AnimationType = animation
+		meta.Animatable = animation != "notAnimatable"
+	case []interface{}:
+		// A shorthand is animatable if any of its longhands is.
+		for _, longhand := range animation {
+			name, _ := longhand.(string)
+			if t, ok := all[name].AnimationType.(string); ok && t != "notAnimatable" {
+				meta.Animatable = true
+			}
 		}
 	}
+	return meta
+}
 
-	// Accept numeric values (for font-weight, z-index, etc.)
-	if regexp.MustCompile(`^\d+$`).MatchString(s) {
-		return true
+// normalize processes the spec to ensure consistency. Entries of every
+// status are kept; generateAll decides how each status is generated.
+func normalize(spec Spec) Spec {
+	normalized := spec
+	normalized.Syntaxes = nil
+	normalized.Properties = normalizeProperties(spec.Properties)
+
+	normalized.AtRules = nil
+	for _, rule := range spec.AtRules {
+		rule.Descriptors = normalizeProperties(rule.Descriptors)
+		normalized.AtRules = append(normalized.AtRules, rule)
 	}
 
-	return true
+	return normalized
 }
 
-// normalize processes the spec to ensure consistency.
-func normalize(spec Spec, allowExperimental, strict bool) Spec {
-	var normalized Spec
-	normalized.Version = spec.Version
-
-	for _, prop := range spec.Properties {
-		// Filter by status
-		if prop.Status == "deprecated" {
-			continue
-		}
-		if prop.Status == "experimental" && !allowExperimental {
-			continue
-		}
-
+// normalizeProperties normalizes the names and keywords of properties or
+// descriptors.
+func normalizeProperties(props []PropertySpec) []PropertySpec {
+	var normalized []PropertySpec
+	for _, prop := range props {
 		// Normalize property name to lowercase kebab-case
 		name := strings.ToLower(prop.Name)
 		name = strings.ReplaceAll(name, "_", "-")
@@ -307,28 +364,87 @@ func normalize(spec Spec, allowExperimental, strict bool) Spec {
 		}
 		sort.Strings(keywords)
 
-		normalized.Properties = append(normalized.Properties, PropertySpec{
-			Name:     name,
-			Keywords: keywords,
-			Syntax:   prop.Syntax,
-			Status:   prop.Status,
-		})
+		prop.Name = name
+		prop.Keywords = keywords
+		normalized = append(normalized, prop)
 	}
 
 	// Sort properties by name for consistent output
-	sort.Slice(normalized.Properties, func(i, j int) bool {
-		return normalized.Properties[i].Name < normalized.Properties[j].Name
+	sort.Slice(normalized, func(i, j int) bool {
+		return normalized[i].Name < normalized[j].Name
 	})
 
 	return normalized
 }
 
+// checkSyntax reports the syntax problems found in the spec's properties and
+// at-rule descriptors.
+func checkSyntax(spec Spec) error {
+	var lines []string
+	for _, prop := range spec.Properties {
+		for _, problem := range prop.Problems {
+			lines = append(lines, fmt.Sprintf("%s: %s", prop.Name, problem))
+		}
+	}
+	for _, rule := range spec.AtRules {
+		for _, desc := range rule.Descriptors {
+			for _, problem := range desc.Problems {
+				lines = append(lines, fmt.Sprintf("@%s %s: %s", rule.Name, desc.Name, problem))
+			}
+		}
+	}
+	if len(lines) > 0 {
+		return fmt.Errorf("unsupported syntax in %d places:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	return nil
+}
+
+// generators lists the file generators run by generateAll, by what they
+// generate.
+var generators = []struct {
+	name string
+	gen  func(spec Spec, outPath, pkg string) error
+}{
+	{"properties", generateProperties},
+	{"keywords", generateKeywords},
+	{"setters", generateSetters},
+	{"property info", generateInfo},
+	{"functions", generateFunctions},
+	{"selectors", generateSelectors},
+	{"at-rules", generateAtRules},
+	{"units", generateUnits},
+}
+
+// generateAll writes every generated file. Experimental entries go to
+// *_experimental_gen.go files behind the cssexperimental build tag, unless
+// allowExperimental puts them with the rest.
+func generateAll(spec Spec, outPath, pkg string, allowExperimental bool) error {
+	stable, experimental := splitExperimental(spec)
+	if allowExperimental {
+		// Still generate the empty experimental part, which removes the
+		// files of an earlier run.
+		stable, experimental = spec, Spec{Version: spec.Version, BuildTag: experimentalTag}
+	}
+	for _, g := range generators {
+		for _, part := range []Spec{stable, experimental} {
+			if err := g.gen(part, outPath, pkg); err != nil {
+				return fmt.Errorf("generating %s: %w", g.name, err)
+			}
+		}
+	}
+	return nil
+}
+
 // generateProperties creates the properties_gen.go file with property constants.
 func generateProperties(spec Spec, outPath, pkg string) error {
+	if skip, err := skipOutput(spec, outPath, "properties", len(spec.Properties) == 0); skip {
+		return err
+	}
+
 	var buf strings.Builder
 
 	// Header
-	buf.WriteString(generateHeader(spec.Version))
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
 	
 	// Import css package if we're generating a separate package
@@ -345,7 +461,9 @@ func generateProperties(spec Spec, outPath, pkg string) error {
 		if pkg != "css" {
 			propType = "css.Property"
 		}
-		buf.WriteString(fmt.Sprintf("\t%s %s = %q\n", constName, propType, prop.Name))
+		buf.WriteString(fmt.Sprintf("// %s is the %s property.\n", constName, prop.Name))
+		buf.WriteString(propertyDoc(prop, prop.Name+" property", true))
+		buf.WriteString(fmt.Sprintf("\t%s %s = %q\n\n", constName, propType, prop.Name))
 	}
 
 	buf.WriteString(")\n")
@@ -356,37 +474,57 @@ func generateProperties(spec Spec, outPath, pkg string) error {
 		return fmt.Errorf("failed to format generated properties: %w", err)
 	}
 
-	outFile := filepath.Join(outPath, "properties_gen.go")
+	outFile := outputFile(spec, outPath, "properties")
 	return os.WriteFile(outFile, formatted, 0644)
 }
 
 // generateKeywords creates the keywords_gen.go file with keyword types and constants.
 func generateKeywords(spec Spec, outPath, pkg string) error {
+	empty := true
+	for _, prop := range spec.Properties {
+		empty = empty && len(prop.Keywords) == 0
+	}
+	if skip, err := skipOutput(spec, outPath, "keywords", empty); skip {
+		return err
+	}
+
 	var buf strings.Builder
 
 	// Header
-	buf.WriteString(generateHeader(spec.Version))
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	// The error type is generated with the stable part only.
+	if spec.BuildTag == "" {
+		buf.WriteString("import (\n\t\"fmt\"\n\t\"strings\"\n)\n\n")
+	} else {
+		buf.WriteString("import \"strings\"\n\n")
+	}
+
 	buf.WriteString("// Keyword types and constants for CSS property values.\n\n")
 
-	for _, prop := range spec.Properties {
-		if len(prop.Keywords) == 0 {
-			continue // Skip properties without finite keyword sets
-		}
+	if spec.BuildTag == "" {
+		buf.WriteString(fmt.Sprintf(`// InvalidKeywordError is returned when parsing or marshaling a string that
+// is not one of the constants of a keyword type.
+type InvalidKeywordError struct {
+	Type  string // the keyword type, e.g. "DisplayVal"
+	Value string
+}
 
-		typeName := propToTypeName(prop.Name)
-		buf.WriteString(fmt.Sprintf("// %s represents values for the %s property.\n", typeName, prop.Name))
-		buf.WriteString(fmt.Sprintf("type %s string\n\n", typeName))
+func (e *InvalidKeywordError) Error() string {
+	return fmt.Sprintf("%s: %%q is not a valid %%s", e.Value, e.Type)
+}
 
-		buf.WriteString(fmt.Sprintf("// %s constants.\n", typeName))
-		buf.WriteString("const (\n")
+`, pkg))
+	}
 
-		for _, keyword := range prop.Keywords {
-			constName := typeName + keywordToConstSuffix(keyword)
-			buf.WriteString(fmt.Sprintf("\t%s %s = %q\n", constName, typeName, keyword))
+	for _, prop := range spec.Properties {
+		if len(prop.Keywords) == 0 {
+			continue // Skip properties without finite keyword sets
 		}
 
-		buf.WriteString(")\n\n")
+		subject := prop.Name + " property"
+		writeKeywordType(&buf, propToTypeName(prop.Name), subject, propertyDoc(prop, subject, true), prop.Keywords)
 	}
 
 	// Format and write
@@ -395,16 +533,95 @@ func generateKeywords(spec Spec, outPath, pkg string) error {
 		return fmt.Errorf("failed to format generated keywords: %w", err)
 	}
 
-	outFile := filepath.Join(outPath, "keywords_gen.go")
+	outFile := outputFile(spec, outPath, "keywords")
 	return os.WriteFile(outFile, formatted, 0644)
 }
 
+// writeKeywordType writes a keyword type with its constants. The subject
+// names what the values are for, e.g. "display property", and doc is the
+// rest of the type's doc comment, as returned by propertyDoc.
+func writeKeywordType(buf *strings.Builder, typeName, subject, doc string, keywords []string) {
+	buf.WriteString(fmt.Sprintf("// %s represents values for the %s.\n", typeName, subject))
+	buf.WriteString(doc)
+	buf.WriteString(fmt.Sprintf("type %s string\n\n", typeName))
+
+	buf.WriteString(fmt.Sprintf("// %s constants.\n", typeName))
+	buf.WriteString("const (\n")
+
+	for _, keyword := range keywords {
+		constName := typeName + keywordToConstSuffix(keyword)
+		buf.WriteString(fmt.Sprintf("\t%s %s = %q\n", constName, typeName, keyword))
+	}
+
+	buf.WriteString(")\n\n")
+
+	buf.WriteString(fmt.Sprintf("func (v %s) String() string { return string(v) }\n\n", typeName))
+
+	constNames := make([]string, len(keywords))
+	for i, keyword := range keywords {
+		constNames[i] = typeName + keywordToConstSuffix(keyword)
+	}
+	list := "\n\t\t" + strings.Join(constNames, ",\n\t\t") + ",\n\t"
+
+	buf.WriteString(fmt.Sprintf(`// Values returns every %[1]s constant.
+func (%[1]s) Values() []%[1]s {
+	return []%[1]s{%[2]s}
+}
+
+// Valid reports whether v is one of the %[1]s constants.
+func (v %[1]s) Valid() bool {
+	switch v {
+	case %[3]s:
+		return true
+	}
+	return false
+}
+
+// Parse%[1]s returns the %[1]s constant for a keyword, ignoring ASCII case.
+func Parse%[1]s(s string) (%[1]s, error) {
+	v := %[1]s(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: %[1]q, Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not %[1]s constants.
+func (v %[1]s) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: %[1]q, Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using Parse%[1]s.
+func (v *%[1]s) UnmarshalText(text []byte) error {
+	parsed, err := Parse%[1]s(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+`, typeName, list, strings.Join(constNames, ",\n\t\t")))
+}
+
 // generateSetters creates the setters_gen.go file with type-safe setter functions.
 func generateSetters(spec Spec, outPath, pkg string) error {
+	empty := true
+	for _, prop := range spec.Properties {
+		empty = empty && len(setterMembers(prop, "")) == 0
+	}
+	if skip, err := skipOutput(spec, outPath, "setters", empty); skip {
+		return err
+	}
+
 	var buf strings.Builder
 
 	// Header
-	buf.WriteString(generateHeader(spec.Version))
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
 	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
 	
 	// Import css package if we're generating a separate package
@@ -414,38 +631,361 @@ func generateSetters(spec Spec, outPath, pkg string) error {
 	
 	buf.WriteString("// Type-safe setter functions for CSS properties.\n\n")
 
+	// Qualify css types when generating a separate package
+	qual := ""
+	if pkg != "css" {
+		qual = "css."
+	}
+
 	for _, prop := range spec.Properties {
-		if len(prop.Keywords) == 0 {
-			continue // Skip properties without finite keyword sets for now
+		members := setterMembers(prop, qual)
+		if len(members) == 0 {
+			continue // Skip properties with no typed values
 		}
 
-		funcName := propToSetterName(prop.Name)
-		typeName := propToTypeName(prop.Name)
-		constName := propToConstName(prop.Name)
-		
-		// Determine types based on package
-		declType := "Decl"
-		keywordType := "Keyword"
-		setFunc := "Set"
-		if pkg != "css" {
-			declType = "css.Decl"
-			keywordType = "css.Keyword"
-			setFunc = "css.Set"
+		subject := prop.Name + " property"
+		writeSetter(&buf, prop.Name, subject, propertyDoc(prop, subject, true), members, qual)
+	}
+
+	// Format and write
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated setters: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "setters")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// writeSetter writes the constraint interface and generic setter for a
+// property or descriptor. Its name, keyword type and constant are derived
+// from name; subject names it in doc comments, e.g. "display property", and
+// doc is the rest of the setter's doc comment, as returned by propertyDoc.
+// Besides members, every setter accepts Raw values (including var()
+// references), function values and the CSS-wide keywords.
+func writeSetter(buf *strings.Builder, name, subject, doc string, members []string, qual string) {
+	funcName := propToSetterName(name)
+	ifaceName := propToValueInterfaceName(name)
+	constName := propToConstName(name)
+	members = slices.DeleteFunc(slices.Clone(members), func(m string) bool { return m == qual+"Function" })
+	members = append(members, qual+"Raw", qual+"Function", qual+"Global")
+
+	buf.WriteString(fmt.Sprintf("// %s is implemented by the value types accepted by the %s.\n", ifaceName, subject))
+	buf.WriteString(fmt.Sprintf("type %s interface {\n", ifaceName))
+	buf.WriteString(fmt.Sprintf("\t%sValue\n", qual))
+	buf.WriteString(fmt.Sprintf("\t%s\n", strings.Join(members, " | ")))
+	buf.WriteString("}\n\n")
+
+	buf.WriteString(fmt.Sprintf("// %s creates a declaration for the %s.\n", funcName, subject))
+	buf.WriteString(doc)
+	buf.WriteString(fmt.Sprintf("func %s[T %s](v T) %sDecl {\n", funcName, ifaceName, qual))
+	buf.WriteString(fmt.Sprintf("\treturn %sSet(%s, v)\n", qual, constName))
+	buf.WriteString("}\n\n")
+}
+
+// propertyDoc returns the reference paragraphs that follow the first line of
+// the doc comments generated for a property or descriptor: its initial
+// value, status, formal syntax and MDN page, and its Deprecated notice.
+// Only properties state whether they are inherited; descriptors never are.
+func propertyDoc(prop PropertySpec, subject string, isProperty bool) string {
+	var doc strings.Builder
+	var facts []string
+	switch {
+	case len(prop.Meta.Longhands) > 0:
+		facts = append(facts, "Shorthand for: "+strings.Join(prop.Meta.Longhands, ", "))
+	case prop.Meta.Initial != "":
+		facts = append(facts, "Initial: "+prop.Meta.Initial)
+	}
+	if isProperty {
+		inherited := "no"
+		if prop.Meta.Inherited {
+			inherited = "yes"
+		}
+		facts = append(facts, "Inherited: "+inherited)
+	}
+	if prop.Status != "" {
+		facts = append(facts, "Status: "+prop.Status)
+	}
+	if len(facts) > 0 {
+		doc.WriteString("//\n")
+		for _, fact := range facts {
+			doc.WriteString("//   - " + fact + "\n")
+		}
+	}
+
+	// The syntax follows the list, as an indented list item after a code
+	// block would continue the block.
+	if prop.Syntax != "" {
+		doc.WriteString("//\n// Syntax:\n//\n//\t" + prop.Syntax + "\n")
+	}
+	if prop.Meta.MDNURL != "" {
+		doc.WriteString("//\n// MDN: " + prop.Meta.MDNURL + "\n")
+	}
+	if notice := deprecationNotice(prop.Status, subject); notice != "" {
+		doc.WriteString("//\n" + notice)
+	}
+	return doc.String()
+}
+
+// generateInfo creates the info_gen.go file with the PropertyInfo table and
+// the status of every other generated feature. The experimental part adds its
+// entries to the tables of the stable part from an init function.
+func generateInfo(spec Spec, outPath, pkg string) error {
+	statuses := featureStatuses(spec)
+	if skip, err := skipOutput(spec, outPath, "info", len(spec.Properties) == 0 && len(statuses) == 0); skip {
+		return err
+	}
+
+	var buf strings.Builder
+
+	// Header
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	qual := ""
+	if pkg != "css" {
+		qual = "css."
+		if spec.BuildTag == "" || len(spec.Properties) > 0 {
+			buf.WriteString("import \"github.com/ahmed-com/typesafe-css/css\"\n\n")
+		}
+	}
+
+	if spec.BuildTag != "" {
+		buf.WriteString("// init adds the experimental features to the metadata tables.\n")
+		buf.WriteString("func init() {\n")
+		if len(spec.Properties) > 0 {
+			buf.WriteString(fmt.Sprintf("\tfor p, info := range map[%sProperty]PropertyInfo{\n", qual))
+			writeInfoEntries(&buf, spec.Properties)
+			buf.WriteString("\t} {\n\t\tpropertyInfo[p] = info\n\t}\n")
 		}
+		if len(statuses) > 0 {
+			buf.WriteString("\tfor name, status := range map[string]Status{\n")
+			writeStatusEntries(&buf, statuses)
+			buf.WriteString("\t} {\n\t\tstatuses[name] = status\n\t}\n")
+		}
+		buf.WriteString("}\n")
+	} else {
+		buf.WriteString(fmt.Sprintf(`// PropertyInfo describes a CSS property using the metadata from MDN.
+type PropertyInfo struct {
+	Name          %[1]sProperty
+	Syntax        string   // value definition syntax
+	Initial       string   // initial value; empty for shorthands
+	Longhands     []string // properties set by a shorthand, sorted by name
+	Inherited     bool
+	Animatable    bool
+	AnimationType string // e.g. "length", "discrete"; empty for shorthands
+	AppliesTo     string // MDN key such as "allElements"
+	Computed      string // MDN key such as "asSpecified"; empty for shorthands
+	Groups        []string
+	Status        Status
+	MDNURL        string
+}
+
+// IsShorthand reports whether the property sets other properties.
+func (i PropertyInfo) IsShorthand() bool { return len(i.Longhands) > 0 }
+
+// Status is the standardization status of a CSS feature on MDN.
+// Experimental features are only generated with the %[2]s build tag,
+// and deprecated ones are marked as such.
+type Status string
+
+// Statuses used by MDN.
+const (
+	StatusStandard     Status = "standard"
+	StatusNonstandard  Status = "nonstandard"
+	StatusExperimental Status = "experimental"
+	StatusObsolete     Status = "obsolete"
+	StatusDeprecated   Status = "deprecated"
+)
+
+// Experimental reports whether the feature is experimental.
+func (s Status) Experimental() bool { return s == StatusExperimental }
+
+// Deprecated reports whether the feature is obsolete or deprecated.
+func (s Status) Deprecated() bool { return s == StatusObsolete || s == StatusDeprecated }
+
+// Info returns the metadata for a property, or the zero PropertyInfo if the
+// property is unknown.
+// e.g., Info(Color).Inherited == true
+func Info(p %[1]sProperty) PropertyInfo {
+	info, _ := LookupInfo(p)
+	return info
+}
+
+// LookupInfo returns the metadata for a property and whether it is known.
+func LookupInfo(p %[1]sProperty) (PropertyInfo, bool) {
+	info, ok := propertyInfo[p]
+	if !ok {
+		return PropertyInfo{}, false
+	}
+	info.Longhands = append([]string(nil), info.Longhands...)
+	info.Groups = append([]string(nil), info.Groups...)
+	return info, true
+}
+
+// StatusOf returns the status of a generated feature, named as in CSS: "color"
+// for a property, "@font-face" for an at-rule, "@font-face font-display" for
+// one of its descriptors, ":hover" or ":not()" for a selector and "rgb()" for
+// a function. It returns "" for unknown names.
+// e.g., StatusOf("clip").Deprecated() == true
+func StatusOf(name string) Status {
+	if info, ok := propertyInfo[%[1]sProperty(name)]; ok {
+		return info.Status
+	}
+	return statuses[name]
+}
+
+`, qual, experimentalTag))
 
-		buf.WriteString(fmt.Sprintf("// %s creates a declaration for the %s property.\n", funcName, prop.Name))
-		buf.WriteString(fmt.Sprintf("func %s(v %s) %s {\n", funcName, typeName, declType))
-		buf.WriteString(fmt.Sprintf("\treturn %s(%s, %s(string(v)))\n", setFunc, constName, keywordType))
+		buf.WriteString(fmt.Sprintf("var propertyInfo = map[%sProperty]PropertyInfo{\n", qual))
+		writeInfoEntries(&buf, spec.Properties)
 		buf.WriteString("}\n\n")
+
+		buf.WriteString("// statuses holds the status of the features other than properties.\n")
+		buf.WriteString("var statuses = map[string]Status{\n")
+		writeStatusEntries(&buf, statuses)
+		buf.WriteString("}\n")
 	}
 
 	// Format and write
 	formatted, err := format.Source([]byte(buf.String()))
 	if err != nil {
-		return fmt.Errorf("failed to format generated setters: %w", err)
+		return fmt.Errorf("failed to format generated property info: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "info")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// writeInfoEntries writes the PropertyInfo map entries of properties.
+func writeInfoEntries(buf *strings.Builder, props []PropertySpec) {
+	for _, prop := range props {
+		constName := propToConstName(prop.Name)
+		meta := prop.Meta
+		buf.WriteString(fmt.Sprintf("\t%s: {\n", constName))
+		buf.WriteString(fmt.Sprintf("\t\tName: %s,\n", constName))
+		buf.WriteString(fmt.Sprintf("\t\tSyntax: %q,\n", prop.Syntax))
+		if meta.Initial != "" {
+			buf.WriteString(fmt.Sprintf("\t\tInitial: %q,\n", meta.Initial))
+		}
+		if len(meta.Longhands) > 0 {
+			buf.WriteString(fmt.Sprintf("\t\tLonghands: %s,\n", goStringSlice(meta.Longhands)))
+		}
+		if meta.Inherited {
+			buf.WriteString("\t\tInherited: true,\n")
+		}
+		if meta.Animatable {
+			buf.WriteString("\t\tAnimatable: true,\n")
+		}
+		if meta.AnimationType != "" {
+			buf.WriteString(fmt.Sprintf("\t\tAnimationType: %q,\n", meta.AnimationType))
+		}
+		if meta.AppliesTo != "" {
+			buf.WriteString(fmt.Sprintf("\t\tAppliesTo: %q,\n", meta.AppliesTo))
+		}
+		if meta.Computed != "" {
+			buf.WriteString(fmt.Sprintf("\t\tComputed: %q,\n", meta.Computed))
+		}
+		if len(meta.Groups) > 0 {
+			buf.WriteString(fmt.Sprintf("\t\tGroups: %s,\n", goStringSlice(meta.Groups)))
+		}
+		if prop.Status != "" {
+			buf.WriteString(fmt.Sprintf("\t\tStatus: %q,\n", prop.Status))
+		}
+		if meta.MDNURL != "" {
+			buf.WriteString(fmt.Sprintf("\t\tMDNURL: %q,\n", meta.MDNURL))
+		}
+		buf.WriteString("\t},\n")
+	}
+}
+
+// writeStatusEntries writes status map entries, sorted by name.
+func writeStatusEntries(buf *strings.Builder, statuses map[string]string) {
+	names := make([]string, 0, len(statuses))
+	for name := range statuses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		buf.WriteString(fmt.Sprintf("\t%q: %q,\n", name, statuses[name]))
+	}
+}
+
+// featureStatuses returns the status of the spec's features other than
+// properties, keyed by the names StatusOf takes. Units are left out, as the
+// x unit would collide with the x property.
+func featureStatuses(spec Spec) map[string]string {
+	statuses := make(map[string]string)
+	for _, fn := range spec.Functions {
+		statuses[fn.Name+"()"] = fn.Status
+	}
+	for _, sel := range spec.Selectors {
+		name := sel.Name
+		if sel.Functional() {
+			name += "()"
+		}
+		statuses[name] = sel.Status
+	}
+	for _, rule := range spec.AtRules {
+		if !rule.DescriptorsOnly {
+			statuses["@"+rule.Name] = rule.Status
+		}
+		for _, desc := range rule.Descriptors {
+			statuses["@"+rule.Name+" "+desc.Name] = desc.Status
+		}
+	}
+	for name, status := range statuses {
+		if status == "" {
+			delete(statuses, name)
+		}
+	}
+	return statuses
+}
+
+// goStringSlice formats a string slice as a Go composite literal.
+func goStringSlice(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = strconv.Quote(v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// generateGrammar writes the property and data type syntaxes as Go maps for
+// the runtime grammar matcher in internal/syntax.
+func generateGrammar(spec Spec, outFile string) error {
+	var buf strings.Builder
+
+	buf.WriteString(generateHeader(spec.Version))
+	buf.WriteString("package syntax\n\n")
+
+	buf.WriteString("// properties maps property names to their value definition syntax.\n")
+	buf.WriteString("var properties = map[string]string{\n")
+	for _, prop := range spec.Properties {
+		if prop.Syntax != "" {
+			buf.WriteString(fmt.Sprintf("\t%q: %q,\n", prop.Name, prop.Syntax))
+		}
+	}
+	buf.WriteString("}\n\n")
+
+	names := make([]string, 0, len(spec.Syntaxes))
+	for name := range spec.Syntaxes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf.WriteString("// types maps data type names to their value definition syntax.\n")
+	buf.WriteString("var types = map[string]string{\n")
+	for _, name := range names {
+		buf.WriteString(fmt.Sprintf("\t%q: %q,\n", name, spec.Syntaxes[name]))
+	}
+	buf.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated grammar: %w", err)
 	}
 
-	outFile := filepath.Join(outPath, "setters_gen.go")
 	return os.WriteFile(outFile, formatted, 0644)
 }
 
@@ -476,6 +1016,26 @@ func propToTypeName(propName string) string {
 	return propToConstName(propName) + "Val"
 }
 
+// propToValueInterfaceName converts a property name to the name of its
+// setter's constraint interface.
+// e.g., "background-color" -> "BackgroundColorValue"
+func propToValueInterfaceName(propName string) string {
+	return propToConstName(propName) + "Value"
+}
+
+// setterMembers returns the types accepted by a property's setter: its
+// keyword type, if any, followed by the css value types from its syntax.
+func setterMembers(prop PropertySpec, qual string) []string {
+	var members []string
+	if len(prop.Keywords) > 0 {
+		members = append(members, propToTypeName(prop.Name))
+	}
+	for _, t := range prop.Types {
+		members = append(members, qual+t)
+	}
+	return members
+}
+
 // propToSetterName converts a property name to a Go setter function name.
 // e.g., "background-color" -> "SetBackgroundColor"
 func propToSetterName(propName string) string {
@@ -1,10 +1,23 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/cssgen"
+	"github.com/ahmed-com/typesafe-css/internal/syntax"
 )
 
 // TestMDNValidation runs the MDN validator to ensure our spec matches MDN data
@@ -51,6 +64,11 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 	if err := cmd.Run(); err != nil {
 		t.Fatalf("Generated cssgen package does not compile: %v", err)
 	}
+
+	cmd = exec.Command("go", "build", "-tags", "cssexperimental", "../../cssgen")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("Generated cssgen package does not compile with experimental APIs: %v\n%s", err, out)
+	}
 }
 
 // BenchmarkCodeGeneration benchmarks the CSS generation process
@@ -65,4 +83,531 @@ func BenchmarkCodeGeneration(b *testing.B) {
 			b.Fatalf("Code generation failed: %v", err)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// TestValueTypes checks the value types derived from property syntaxes in the
+// bundled spec data.
+func TestValueTypes(t *testing.T) {
+	spec, err := loadSpecFromMDN("../../spec")
+	if err != nil {
+		t.Fatalf("loadSpecFromMDN() error = %v", err)
+	}
+	types := make(map[string]string)
+	for _, prop := range spec.Properties {
+		types[prop.Name] = fmt.Sprint(prop.Types)
+	}
+
+	tests := map[string]string{
+		"animation-delay":     "[Time]",
+		"width":               "[Length Function]",
+		"z-index":             "[Integer]",
+		"color":               "[Color]",
+		"line-height":         "[Length Number Integer]",
+		"font-family":         "[Keyword QuotedString]",
+		"rotate":              "[Angle]",
+		"margin":              "[Length Function]",
+		"box-shadow":          "[Shadow]",
+		"text-shadow":         "[Shadow]",
+		"grid-template-areas": "[QuotedString]",
+	}
+	for prop, want := range tests {
+		if got := types[prop]; got != want {
+			t.Errorf("%s types = %s, want %s", prop, got, want)
+		}
+	}
+}
+
+// TestGeneratedSetters checks that every setter accepts raw values, var()
+// references and function values besides the types from its syntax.
+func TestGeneratedSetters(t *testing.T) {
+	tests := []struct {
+		decl css.Decl
+		want string
+	}{
+		{cssgen.SetDisplay(css.Raw("flex")), "display:flex"},
+		{cssgen.SetDisplay(css.Var("--display")), "display:var(--display)"},
+		{cssgen.SetColor(css.Func("color-mix", css.Raw("in srgb"), css.Hex("#000"), css.Hex("#fff"))), "color:color-mix(in srgb, #000, #fff)"},
+		{cssgen.SetBoxShadow(css.ShadowOf(css.Px(0), css.Px(1), css.Px(3), css.Hex("#0000001a"))), "box-shadow:0px 1px 3px #0000001a"},
+		{cssgen.SetBoxShadow(cssgen.BoxShadowValNone), "box-shadow:none"},
+		{cssgen.SetTextShadow(css.Shadows(css.ShadowOf(css.Px(1), css.Px(1), css.Px(2), css.Hex("#000")), css.Shadow("0 0 1em red"))), "text-shadow:1px 1px 2px #000, 0 0 1em red"},
+	}
+	for _, tt := range tests {
+		if got := tt.decl.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+		if err := css.Validate(tt.decl); err != nil {
+			t.Errorf("Validate(%s) = %v", tt.decl, err)
+		}
+	}
+}
+
+// TestGeneratedOutputUpToDate regenerates the cssgen package from the bundled
+// spec data and compares it with the committed files, ignoring the header.
+func TestGeneratedOutputUpToDate(t *testing.T) {
+	spec, err := loadSpecFromMDN("../../spec")
+	if err != nil {
+		t.Fatalf("loadSpecFromMDN() error = %v", err)
+	}
+	normalized := normalize(spec)
+
+	dir := t.TempDir()
+	if err := generateAll(normalized, dir, "cssgen", false); err != nil {
+		t.Fatal(err)
+	}
+
+	names, _ := filepath.Glob(filepath.Join(dir, "*_gen.go"))
+	committed, _ := filepath.Glob("../../cssgen/*_gen.go")
+	if len(names) != len(committed) {
+		t.Errorf("generated %d files, cssgen has %d; run go generate ./...", len(names), len(committed))
+	}
+	for _, name := range names {
+		name = filepath.Base(name)
+		want, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(filepath.Join("../../cssgen", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stripHeader(string(got)) != stripHeader(string(want)) {
+			t.Errorf("cssgen/%s is out of date; run go generate ./...", name)
+		}
+	}
+}
+
+func stripHeader(src string) string {
+	_, body, _ := strings.Cut(src, "\npackage ")
+	return body
+}
+
+// TestGeneratedKeywordsAreLiterals checks every keyword constant in the
+// committed cssgen output against the grammar: each value must be a literal
+// keyword of some syntax, never the name of a data type.
+func TestGeneratedKeywordsAreLiterals(t *testing.T) {
+	literals := make(map[string]bool)
+	for _, file := range []string{"properties.json", "syntaxes.json"} {
+		data, err := os.ReadFile(filepath.Join("../../spec", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entries map[string]MDNSyntax
+		if err := json.Unmarshal(data, &entries); err != nil {
+			t.Fatal(err)
+		}
+		for _, entry := range entries {
+			syntax.Walk(syntax.MustParse(entry.Syntax), func(n *syntax.Node) bool {
+				if n.Kind == syntax.Keyword {
+					literals[strings.ToLower(n.Name)] = true
+				}
+				return true
+			})
+		}
+	}
+
+	file, err := parser.ParseFile(token.NewFileSet(), "../../cssgen/keywords_gen.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byType := make(map[string][]string)
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			value, _ := strconv.Unquote(vs.Values[0].(*ast.BasicLit).Value)
+			typeName := vs.Type.(*ast.Ident).Name
+			byType[typeName] = append(byType[typeName], value)
+			if !literals[value] {
+				t.Errorf("%s = %q is not a keyword in any syntax", vs.Names[0].Name, value)
+			}
+		}
+	}
+
+	if got := fmt.Sprint(byType["AccentColorVal"]); got != "[auto]" {
+		t.Errorf("AccentColorVal values = %s, want [auto]", got)
+	}
+	if got := fmt.Sprint(byType["FontWeightVal"]); got != "[bold bolder lighter normal]" {
+		t.Errorf("FontWeightVal values = %s, want [bold bolder lighter normal]", got)
+	}
+}
+
+// TestPropertyInfo checks the generated metadata table against known MDN
+// entries.
+func TestPropertyInfo(t *testing.T) {
+	color := cssgen.Info(cssgen.Color)
+	if !color.Inherited || color.Initial != "canvastext" || !color.Animatable || color.IsShorthand() {
+		t.Errorf("Info(Color) = %+v", color)
+	}
+	if color.MDNURL != "https://developer.mozilla.org/docs/Web/CSS/color" || color.Status != "standard" {
+		t.Errorf("Info(Color) URL and status = %q, %q", color.MDNURL, color.Status)
+	}
+
+	margin := cssgen.Info(cssgen.Margin)
+	if !margin.IsShorthand() || margin.Initial != "" || margin.Inherited {
+		t.Errorf("Info(Margin) = %+v", margin)
+	}
+	if got := strings.Join(margin.Longhands, " "); got != "margin-bottom margin-left margin-right margin-top" {
+		t.Errorf("Info(Margin).Longhands = %q", got)
+	}
+	if got := strings.Join(cssgen.Info(cssgen.MarginBlock).Longhands, " "); got != "margin-block-end margin-block-start" {
+		t.Errorf("Info(MarginBlock).Longhands = %q, want them sorted", got)
+	}
+
+	if display := cssgen.Info(cssgen.Display); !display.Animatable || display.AnimationType != "discreteButVisibleForDurationWhenAnimatedNone" {
+		t.Errorf("Info(Display) animation = %v, %q", display.Animatable, display.AnimationType)
+	}
+
+	if _, ok := cssgen.LookupInfo("not-a-property"); ok {
+		t.Error("LookupInfo reported an unknown property")
+	}
+
+	// Returned slices must not alias the table.
+	margin.Longhands[0] = "changed"
+	if cssgen.Info(cssgen.Margin).Longhands[0] != "margin-bottom" {
+		t.Error("Info returned a shared Longhands slice")
+	}
+}
+
+// TestGeneratedDocComments checks that the reference information from the
+// spec reaches the doc comments of generated declarations.
+func TestGeneratedDocComments(t *testing.T) {
+	docs := make(map[string]string)
+	for _, name := range []string{"properties_gen.go", "keywords_gen.go", "setters_gen.go", "atrules_gen.go"} {
+		file, err := parser.ParseFile(token.NewFileSet(), filepath.Join("../../cssgen", name), nil, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				docs[decl.Name.Name] = decl.Doc.Text()
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						docs[spec.Name.Name] = decl.Doc.Text()
+					case *ast.ValueSpec:
+						docs[spec.Names[0].Name] = spec.Doc.Text()
+					}
+				}
+			}
+		}
+	}
+
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"SetAlignItems", []string{"Initial: normal", "Inherited: no", "Status: standard", "\tnormal | stretch | <baseline-position>", "MDN: https://developer.mozilla.org/docs/Web/CSS/align-items"}},
+		{"DisplayVal", []string{"Initial: inline", "Syntax:", "MDN: https://developer.mozilla.org/docs/Web/CSS/display"}},
+		{"Color", []string{"Inherited: yes", "MDN: https://developer.mozilla.org/docs/Web/CSS/color"}},
+		{"Margin", []string{"Shorthand for: margin-bottom, margin-left, margin-right, margin-top"}},
+		{"Clip", []string{"Status: obsolete", "Deprecated: the clip property is obsolete."}},
+		{"FontFaceFontDisplay", []string{"Initial: auto", "MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-display"}},
+	}
+	for _, tt := range tests {
+		doc, ok := docs[tt.name]
+		if !ok {
+			t.Errorf("%s is not generated", tt.name)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(doc, want) {
+				t.Errorf("doc comment of %s does not contain %q:\n%s", tt.name, want, doc)
+			}
+		}
+	}
+	if strings.Contains(docs["FontFaceFontDisplay"], "Inherited") {
+		t.Error("descriptor doc comments state inheritance")
+	}
+}
+
+// TestKeywordEnums checks the generated enumeration, parsing and text
+// marshaling of keyword types.
+func TestKeywordEnums(t *testing.T) {
+	values := cssgen.FontWeightVal("").Values()
+	if got := fmt.Sprint(values); got != "[bold bolder lighter normal]" {
+		t.Errorf("FontWeightVal.Values() = %s", got)
+	}
+	for _, v := range values {
+		if !v.Valid() {
+			t.Errorf("%q.Valid() = false", v)
+		}
+	}
+	if cssgen.DisplayVal("flexbox").Valid() {
+		t.Error(`DisplayVal("flexbox").Valid() = true`)
+	}
+
+	if v, err := cssgen.ParseDisplayVal("FLEX"); err != nil || v != cssgen.DisplayValFlex {
+		t.Errorf("ParseDisplayVal(FLEX) = %q, %v", v, err)
+	}
+	_, err := cssgen.ParseDisplayVal("flexbox")
+	var invalid *cssgen.InvalidKeywordError
+	if !errors.As(err, &invalid) || invalid.Type != "DisplayVal" || invalid.Value != "flexbox" {
+		t.Errorf("ParseDisplayVal(flexbox) error = %v", err)
+	}
+
+	type options struct {
+		Display cssgen.DisplayVal `json:"display"`
+	}
+	var opts options
+	if err := json.Unmarshal([]byte(`{"display":"Grid"}`), &opts); err != nil || opts.Display != cssgen.DisplayValGrid {
+		t.Errorf("Unmarshal = %q, %v", opts.Display, err)
+	}
+	if data, err := json.Marshal(opts); err != nil || string(data) != `{"display":"grid"}` {
+		t.Errorf("Marshal = %s, %v", data, err)
+	}
+	if err := json.Unmarshal([]byte(`{"display":"flexbox"}`), &opts); !errors.As(err, &invalid) {
+		t.Errorf("Unmarshal of an invalid keyword error = %v", err)
+	}
+	if _, err := json.Marshal(options{Display: "flexbox"}); !errors.As(err, &invalid) {
+		t.Errorf("Marshal of an invalid keyword error = %v", err)
+	}
+}
+
+// TestFunctionArity checks the parameters derived from function syntaxes.
+func TestFunctionArity(t *testing.T) {
+	analyzer := newSyntaxAnalyzer(nil, nil, nil)
+	tests := []struct {
+		syntax   string
+		min, max int
+		types    string
+	}{
+		{"translate( <length-percentage> , <length-percentage>? )", 1, 2, "[Length Length]"},
+		{"clamp( <calc-sum>#{3} )", 3, 3, "[  ]"},
+		{"max( <calc-sum># )", 1, syntax.Unbounded, "[ ]"},
+		{"blur( <length>? )", 0, 1, "[Length]"},
+		{"cubic-bezier( [ <number [0,1]>, <number> ]#{2} )", 4, 4, "[   ]"},
+		{"inset( <length-percentage>{1,4} [ round <'border-radius'> ]? )", 1, 5, "[Length Length Length Length ]"},
+		{"circle( <radial-size>? [ at <position> ]? )", 0, 2, "[ ]"},
+		{"rotate3d( <number> , <number> , <number> , [ <angle> | <zero> ] )", 4, 4, "[   ]"},
+		{"round( <rounding-strategy>?, <calc-sum>, <calc-sum> )", 3, 3, "[  ]"},
+		{"rgb( <percentage>#{3} , <alpha-value>? ) | rgb( [ <number> | <percentage> | none ]{3} [ / [ <alpha-value> | none ] ]? )", 1, 4, "[   ]"},
+	}
+	for _, tt := range tests {
+		fn := analyzer.Function(tt.syntax)
+		var types []string
+		for _, p := range fn.Params {
+			types = append(types, p.Type)
+		}
+		if fn.MinArgs() != tt.min || fn.MaxArgs != tt.max || fmt.Sprint(types) != tt.types {
+			t.Errorf("Function(%q) = %d..%d %v, want %d..%d %s", tt.syntax, fn.MinArgs(), fn.MaxArgs, types, tt.min, tt.max, tt.types)
+		}
+	}
+}
+
+// TestGeneratedFunctions checks the generated constructors against the
+// grammar used by css.Validate.
+func TestGeneratedFunctions(t *testing.T) {
+	decls := []css.Decl{
+		cssgen.SetTransform(cssgen.FnTranslate(css.Px(10), css.Percent(50))),
+		cssgen.SetTransform(cssgen.FnRotate(cssgen.Deg(45))),
+		cssgen.SetWidth(cssgen.FnCalc[css.Length](css.Raw("100% - 2rem"))),
+		cssgen.SetColor(cssgen.FnLightDark(css.Hex("#000"), css.Hex("#fff"))),
+		cssgen.SetFilter(cssgen.FnBlur("")),
+		cssgen.SetGridTemplateColumns(cssgen.Fr(1)),
+		css.Set("clip-path", cssgen.FnPath(nil, css.Quote("M0 0"))),
+		css.Set("clip-path", cssgen.FnCircle(css.Px(10), css.Keyword("center"))),
+		css.Set("clip-path", cssgen.FnInset(css.Px(10), "", "", "", css.Px(4))),
+		cssgen.SetTransform(cssgen.FnRotate3d(css.Num(1), css.Num(0), css.Num(0), css.Raw("0"))),
+	}
+	for _, decl := range decls {
+		if err := css.Validate(decl); err != nil {
+			t.Errorf("Validate(%s) = %v", decl, err)
+		}
+	}
+
+	if got := cssgen.FnRound[css.Length](nil, css.Px(7), css.Px(5)).String(); got != "round(7px, 5px)" {
+		t.Errorf("FnRound with omitted strategy = %q", got)
+	}
+	for fn, want := range map[css.Function]string{
+		cssgen.FnCircle(css.Px(10), css.Keyword("center")): "circle(10px at center)",
+		cssgen.FnEllipse(nil, css.Keyword("top")):          "ellipse(at top)",
+		cssgen.FnInset(css.Px(10), "", "", "", css.Px(4)):  "inset(10px round 4px)",
+	} {
+		if got := fn.String(); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	}
+	if got := cssgen.FnTranslate(css.Px(1), "").String(); got != "translate(1px)" {
+		t.Errorf("FnTranslate with omitted argument = %q", got)
+	}
+
+	// Bounded arities are fixed parameters, so extra arguments do not compile.
+	for name, fn := range map[string]any{"FnTranslate": cssgen.FnTranslate, "FnCircle": cssgen.FnCircle, "FnRgb": cssgen.FnRgb} {
+		if reflect.TypeOf(fn).IsVariadic() {
+			t.Errorf("%s is variadic", name)
+		}
+	}
+	if !reflect.TypeOf(cssgen.FnPolygon).IsVariadic() {
+		t.Error("FnPolygon, whose last argument repeats, is not variadic")
+	}
+}
+
+// TestGeneratedSelectorsAndAtRules checks pseudo-class builders, descriptor
+// setters and unit constructors.
+func TestGeneratedSelectorsAndAtRules(t *testing.T) {
+	if got := ".btn" + cssgen.PseudoClassHover; got != ".btn:hover" {
+		t.Errorf("PseudoClassHover = %q", got)
+	}
+	if got := cssgen.PseudoClassNot(".a", ".b"); got != ":not(.a, .b)" {
+		t.Errorf("PseudoClassNot = %q", got)
+	}
+	if got := cssgen.PseudoElementPart("label", "active"); got != "::part(label active)" {
+		t.Errorf("PseudoElementPart = %q", got)
+	}
+	if got := cssgen.PseudoClassHostFunc(".dark"); got != ":host(.dark)" {
+		t.Errorf("PseudoClassHostFunc = %q", got)
+	}
+
+	fontFace := css.AtRule{Name: cssgen.AtRuleFontFace, Body: []css.Item{
+		cssgen.SetFontFaceFontDisplay(cssgen.FontFaceFontDisplayValSwap),
+		cssgen.SetFontFaceSizeAdjust(css.Percent(90)),
+	}}
+	if got := fontFace.String(); got != "@font-face{font-display:swap;size-adjust:90%}" {
+		t.Errorf("font-face = %q", got)
+	}
+
+	if got := cssgen.Rem(1.5).String() + " " + cssgen.KHz(2).String() + " " + cssgen.ResolutionX(2).String(); got != "1.5rem 2kHz 2x" {
+		t.Errorf("units = %q", got)
+	}
+}
+
+// TestStatusGating checks that experimental entries are split off behind the
+// build tag and that obsolete ones are marked deprecated.
+func TestStatusGating(t *testing.T) {
+	spec, err := loadSpecFromMDN("../../spec")
+	if err != nil {
+		t.Fatalf("loadSpecFromMDN() error = %v", err)
+	}
+	stable, experimental := splitExperimental(normalize(spec))
+	for _, prop := range stable.Properties {
+		if prop.Status == "experimental" {
+			t.Errorf("experimental property %s in the stable part", prop.Name)
+		}
+	}
+	found := false
+	for _, rule := range experimental.AtRules {
+		found = found || rule.Name == "position-try" && !rule.DescriptorsOnly
+	}
+	if !found {
+		t.Error("@position-try is not in the experimental part")
+	}
+
+	src, err := os.ReadFile("../../cssgen/properties_experimental_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "//go:build cssexperimental\n") || !strings.Contains(string(src), `"anchor-name"`) {
+		t.Error("properties_experimental_gen.go lacks the build constraint or anchor-name")
+	}
+
+	src, err = os.ReadFile("../../cssgen/setters_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(src), "//\n// Deprecated: the clip property is obsolete.\nfunc SetClip") {
+		t.Error("SetClip is not marked deprecated")
+	}
+
+	if status := cssgen.Info(cssgen.Clip).Status; status != cssgen.StatusObsolete || !status.Deprecated() {
+		t.Errorf("Info(Clip).Status = %q", status)
+	}
+	for name, want := range map[string]cssgen.Status{
+		"color":                   cssgen.StatusStandard,
+		":not()":                  cssgen.StatusStandard,
+		"::before":                cssgen.StatusStandard,
+		"rgba()":                  cssgen.StatusNonstandard,
+		"@font-face font-stretch": cssgen.StatusObsolete,
+		"@position-try":           positionTryStatus,
+		"not-a-feature":           "",
+	} {
+		if got := cssgen.StatusOf(name); got != want {
+			t.Errorf("StatusOf(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+// TestDiffSpecs checks the changes reported between two specs and which of
+// them break the generated API.
+func TestDiffSpecs(t *testing.T) {
+	oldSpec := Spec{
+		Version: "old",
+		Properties: []PropertySpec{
+			{Name: "display", Keywords: []string{"block", "run-in"}, Syntax: "block | run-in", Status: "standard"},
+			{Name: "clip", Syntax: "<shape> | auto", Status: "standard"},
+			{Name: "zoom", Syntax: "<number>", Status: "standard", Types: []string{"Number", "Integer"}},
+			{Name: "gone", Status: "standard"},
+		},
+		Functions: []FunctionSpec{{Name: "blur", Status: "standard", Params: []FunctionParam{{Name: "length", Type: "Length"}}, MaxArgs: 1}},
+	}
+	newSpec := Spec{
+		Version: "new",
+		Properties: []PropertySpec{
+			{Name: "display", Keywords: []string{"block", "flex"}, Syntax: "block | flex", Status: "standard"},
+			{Name: "clip", Syntax: "<shape> | auto", Status: "obsolete"},
+			{Name: "zoom", Syntax: "<integer>", Status: "experimental", Types: []string{"Integer"}},
+			{Name: "anchor-name", Status: "experimental"},
+		},
+		Functions: []FunctionSpec{{Name: "blur", Status: "standard", Params: []FunctionParam{{Name: "length", Type: "Length", Optional: true}}, MaxArgs: 1}},
+	}
+
+	report := diffSpecs(oldSpec, newSpec)
+	var got []string
+	for _, c := range report.Changes {
+		line := c.Kind + " " + c.Name + " " + c.Detail
+		if c.Breaking {
+			line += " !"
+		}
+		got = append(got, strings.TrimSpace(line))
+	}
+	want := []string{
+		"property-added anchor-name experimental",
+		"status-changed clip standard -> obsolete",
+		"syntax-changed display block | run-in -> block | flex",
+		"keyword-added display flex",
+		"keyword-removed display run-in !",
+		"property-removed gone  !",
+		"status-changed zoom standard -> experimental !",
+		"syntax-changed zoom <number> -> <integer>",
+		"value-type-removed zoom Number !",
+		"function-signature-changed blur() (Length) Function, 1..1 args -> (Length) Function, 0..1 args !",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("diffSpecs() changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if report.Breaking != 5 {
+		t.Errorf("diffSpecs() breaking = %d, want 5", report.Breaking)
+	}
+
+	var buf strings.Builder
+	writeDiffText(&buf, report)
+	if !strings.Contains(buf.String(), "BREAKING  property-removed") || !strings.HasSuffix(buf.String(), "10 changes, 5 breaking\n") {
+		t.Errorf("writeDiffText() =\n%s", buf.String())
+	}
+
+	// The JSON report must keep syntax text readable rather than HTML-escaped.
+	dir := t.TempDir()
+	var paths []string
+	for i, spec := range []Spec{oldSpec, newSpec} {
+		data, err := json.Marshal(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, strconv.Itoa(i)+".json")
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		paths = append(paths, path)
+	}
+	buf.Reset()
+	if err := runDiff([]string{"-format", "json", paths[0], paths[1]}, &buf); err != nil {
+		t.Fatalf("runDiff() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), `"detail": "<number> -> <integer>"`) {
+		t.Errorf("runDiff() json =\n%s", buf.String())
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/internal/syntax"
+)
+
+// SelectorSpec describes a pseudo-class or pseudo-element.
+type SelectorSpec struct {
+	Name    string `json:"name"` // ":hover", "::before", ":nth-child", without parentheses
+	Syntax  string `json:"syntax"`
+	Status  string `json:"status"`
+	Element bool   `json:"element,omitempty"` // a pseudo-element
+	Args    string `json:"args,omitempty"`    // "one", "list" or "space" for functional selectors
+}
+
+// Functional reports whether the selector takes arguments, as :not() does.
+func (s SelectorSpec) Functional() bool { return s.Args != "" }
+
+// loadSelectors reads the pseudo-classes and pseudo-elements from
+// selectors.json. Combinators, basic selectors and vendor-prefixed
+// pseudo-elements are skipped.
+func loadSelectors(mdnPath string) ([]SelectorSpec, error) {
+	data, err := os.ReadFile(filepath.Join(mdnPath, "selectors.json"))
+	if err != nil {
+		return nil, nil // optional
+	}
+	var mdnSelectors map[string]MDNProperty
+	if err := json.Unmarshal(data, &mdnSelectors); err != nil {
+		return nil, fmt.Errorf("cannot parse selectors.json: %w", err)
+	}
+
+	var selectors []SelectorSpec
+	for name, mdnSel := range mdnSelectors {
+		bare := strings.TrimLeft(name, ":")
+		if !strings.HasPrefix(name, ":") || bare == "" || strings.HasPrefix(bare, "-") {
+			continue
+		}
+		sel := SelectorSpec{
+			Name:    strings.TrimSuffix(name, "()"),
+			Syntax:  mdnSel.Syntax,
+			Status:  mdnSel.Status,
+			Element: strings.HasPrefix(name, "::"),
+		}
+		if strings.HasSuffix(name, "()") {
+			sel.Args = selectorArgs(mdnSel.Syntax)
+		}
+		selectors = append(selectors, sel)
+	}
+	sort.Slice(selectors, func(i, j int) bool {
+		return selectors[i].Name < selectors[j].Name
+	})
+	return selectors, nil
+}
+
+// selectorArgs classifies the argument of a functional selector: "list" for
+// comma-separated lists such as a <complex-selector-list>, "space" for
+// space-separated repetitions and "one" otherwise.
+func selectorArgs(selSyntax string) string {
+	open, close := strings.Index(selSyntax, "("), strings.LastIndex(selSyntax, ")")
+	if open < 0 || close < open {
+		return "one"
+	}
+	n, err := syntax.Parse(selSyntax[open+1 : close])
+	if err != nil {
+		return "one"
+	}
+	switch {
+	case n.Comma || n.Kind == syntax.Type && strings.HasSuffix(n.Name, "-list"):
+		return "list"
+	case n.Max == syntax.Unbounded || n.Max > 1:
+		return "space"
+	}
+	return "one"
+}
+
+// generateSelectors creates the selectors_gen.go file with pseudo-class and
+// pseudo-element constants and builders.
+func generateSelectors(spec Spec, outPath, pkg string) error {
+	if skip, err := skipOutput(spec, outPath, "selectors", len(spec.Selectors) == 0); skip {
+		return err
+	}
+
+	var buf strings.Builder
+
+	// Header
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	needsStrings := false
+	for _, sel := range spec.Selectors {
+		needsStrings = needsStrings || sel.Args == "list" || sel.Args == "space"
+	}
+	if needsStrings {
+		buf.WriteString("import \"strings\"\n\n")
+	}
+
+	simple := make(map[string]bool)
+	for _, sel := range spec.Selectors {
+		if !sel.Functional() {
+			simple[sel.Name] = true
+		}
+	}
+
+	for _, element := range []bool{false, true} {
+		var consts []SelectorSpec
+		for _, sel := range spec.Selectors {
+			if sel.Element == element && !sel.Functional() {
+				consts = append(consts, sel)
+			}
+		}
+		if len(consts) == 0 {
+			continue
+		}
+
+		if element {
+			buf.WriteString("// Pseudo-element selectors.\n")
+		} else {
+			buf.WriteString("// Pseudo-class selectors, appended to a compound selector.\n")
+			buf.WriteString("// e.g., \".btn\" + PseudoClassHover -> \".btn:hover\"\n")
+		}
+		buf.WriteString("const (\n")
+		for _, sel := range consts {
+			buf.WriteString(deprecationNotice(sel.Status, sel.Name+" "+selectorKind(sel)))
+			buf.WriteString(fmt.Sprintf("\t%s = %q\n", selectorToConstName(sel), sel.Name))
+		}
+		buf.WriteString(")\n\n")
+	}
+
+	for _, sel := range spec.Selectors {
+		if !sel.Functional() {
+			continue
+		}
+		funcName := selectorToConstName(sel)
+		if simple[sel.Name] {
+			funcName += "Func" // :host and :host() both exist
+		}
+
+		param := "arg"
+		if _, args, _ := strings.Cut(sel.Syntax, "("); strings.HasPrefix(strings.TrimSpace(args), "<") {
+			if ref, _, _ := strings.Cut(args, ">"); strings.Contains(ref, "selector") {
+				param = "selector"
+			}
+		}
+		buf.WriteString(fmt.Sprintf("// %s creates a %s() selector: %s\n", funcName, sel.Name, sel.Syntax))
+		if notice := deprecationNotice(sel.Status, sel.Name+"() "+selectorKind(sel)); notice != "" {
+			buf.WriteString("//\n" + notice)
+		}
+		switch sel.Args {
+		case "list", "space":
+			sep := ", "
+			if sel.Args == "space" {
+				sep = " "
+			}
+			buf.WriteString(fmt.Sprintf("func %s(%ss ...string) string {\n", funcName, param))
+			buf.WriteString(fmt.Sprintf("\treturn %q + strings.Join(%ss, %q) + \")\"\n}\n\n", sel.Name+"(", param, sep))
+		default:
+			buf.WriteString(fmt.Sprintf("func %s(%s string) string {\n", funcName, param))
+			buf.WriteString(fmt.Sprintf("\treturn %q + %s + \")\"\n}\n\n", sel.Name+"(", param))
+		}
+	}
+
+	// Format and write
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated selectors: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "selectors")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// selectorKind names the kind of a selector in doc comments.
+func selectorKind(sel SelectorSpec) string {
+	if sel.Element {
+		return "pseudo-element"
+	}
+	return "pseudo-class"
+}
+
+// selectorToConstName converts a pseudo-class or pseudo-element to a Go name.
+// e.g., ":first-child" -> "PseudoClassFirstChild", "::before" -> "PseudoElementBefore"
+func selectorToConstName(sel SelectorSpec) string {
+	prefix := "PseudoClass"
+	if sel.Element {
+		prefix = "PseudoElement"
+	}
+	return prefix + propToConstName(strings.TrimLeft(sel.Name, ":"))
+}
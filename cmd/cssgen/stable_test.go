@@ -0,0 +1,7 @@
+//go:build !cssexperimental
+
+package main
+
+// positionTryStatus is the status reported for @position-try, which is
+// experimental and therefore only generated with the cssexperimental tag.
+const positionTryStatus = ""
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// experimentalTag is the build tag that enables the generated experimental
+// APIs.
+const experimentalTag = "cssexperimental"
+
+// isExperimental reports whether entries with the given MDN status are
+// generated behind the experimental build tag.
+func isExperimental(status string) bool {
+	return status == "experimental"
+}
+
+// isDeprecated reports whether entries with the given MDN status are
+// generated with a Deprecated comment. MDN marks features that were removed
+// from the specifications as obsolete.
+func isDeprecated(status string) bool {
+	return status == "obsolete" || status == "deprecated"
+}
+
+// deprecationNotice returns the Deprecated comment for an entry with the
+// given status, or "" if it is not deprecated. The subject names the entry,
+// e.g. "clip property".
+func deprecationNotice(status, subject string) string {
+	if !isDeprecated(status) {
+		return ""
+	}
+	return fmt.Sprintf("// Deprecated: the %s is %s.\n", subject, status)
+}
+
+// splitExperimental splits a spec into its stable entries and the
+// experimental ones, which are generated behind the experimental build tag.
+// Experimental descriptors of a stable at-rule are moved to a copy of the
+// rule marked DescriptorsOnly.
+func splitExperimental(spec Spec) (stable, experimental Spec) {
+	stable = Spec{Version: spec.Version}
+	experimental = Spec{Version: spec.Version, BuildTag: experimentalTag}
+
+	for _, prop := range spec.Properties {
+		part := &stable
+		if isExperimental(prop.Status) {
+			part = &experimental
+		}
+		part.Properties = append(part.Properties, prop)
+	}
+	for _, fn := range spec.Functions {
+		part := &stable
+		if isExperimental(fn.Status) {
+			part = &experimental
+		}
+		part.Functions = append(part.Functions, fn)
+	}
+	for _, sel := range spec.Selectors {
+		part := &stable
+		if isExperimental(sel.Status) {
+			part = &experimental
+		}
+		part.Selectors = append(part.Selectors, sel)
+	}
+	for _, unit := range spec.Units {
+		part := &stable
+		if isExperimental(unit.Status) {
+			part = &experimental
+		}
+		part.Units = append(part.Units, unit)
+	}
+
+	for _, rule := range spec.AtRules {
+		if isExperimental(rule.Status) {
+			experimental.AtRules = append(experimental.AtRules, rule)
+			continue
+		}
+		kept, gated := rule, rule
+		kept.Descriptors, gated.Descriptors = nil, nil
+		gated.DescriptorsOnly = true
+		for _, desc := range rule.Descriptors {
+			if isExperimental(desc.Status) {
+				gated.Descriptors = append(gated.Descriptors, desc)
+			} else {
+				kept.Descriptors = append(kept.Descriptors, desc)
+			}
+		}
+		stable.AtRules = append(stable.AtRules, kept)
+		if len(gated.Descriptors) > 0 {
+			experimental.AtRules = append(experimental.AtRules, gated)
+		}
+	}
+	return stable, experimental
+}
+
+// buildConstraint returns the //go:build line for the files of a spec, if
+// it has a build tag.
+func buildConstraint(spec Spec) string {
+	if spec.BuildTag == "" {
+		return ""
+	}
+	return fmt.Sprintf("//go:build %s\n\n", spec.BuildTag)
+}
+
+// outputFile returns the path of a generated file.
+// e.g., "units" -> "units_gen.go", or "units_experimental_gen.go" for the
+// experimental part
+func outputFile(spec Spec, outPath, base string) string {
+	if spec.BuildTag != "" {
+		base += "_experimental"
+	}
+	return filepath.Join(outPath, base+"_gen.go")
+}
+
+// skipOutput reports whether the generated file of a tagged spec is skipped
+// because it would be empty, and removes it if an earlier run wrote it.
+func skipOutput(spec Spec, outPath, base string, empty bool) (bool, error) {
+	if spec.BuildTag == "" || !empty {
+		return false, nil
+	}
+	err := os.Remove(outputFile(spec, outPath, base))
+	if errors.Is(err, fs.ErrNotExist) {
+		err = nil
+	}
+	return true, err
+}
@@ -0,0 +1,317 @@
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/internal/syntax"
+)
+
+// valueTypes maps basic data types to the css value types that can express a
+// complete value of that type.
+var valueTypes = map[string][]string{
+	"length":            {"Length"},
+	"percentage":        {"Length"},
+	"length-percentage": {"Length"},
+	"color":             {"Color"},
+	"time":              {"Time"},
+	"time-percentage":   {"Time", "Length"},
+	"angle":             {"Angle"},
+	"angle-percentage":  {"Angle", "Length"},
+	"frequency":         {"Frequency"},
+	"resolution":        {"Resolution"},
+	"flex":              {"Flex"},
+	"number":            {"Number", "Integer"},
+	"integer":           {"Integer"},
+	"string":            {"QuotedString"},
+	"url":               {"URL"},
+	"shadow":            {"Shadow"},
+	"shadow-t":          {"Shadow"},
+	"custom-ident":      {"Keyword"},
+	"dashed-ident":      {"Keyword"},
+	"ident":             {"Keyword"},
+}
+
+// valueTypeOrder is the order in which value types appear in generated
+// sum types, after the property's keyword type.
+var valueTypeOrder = []string{
+	"Length", "Color", "Time", "Angle", "Frequency", "Resolution", "Flex", "Number", "Integer",
+	"Keyword", "QuotedString", "URL", "Shadow", "Function",
+}
+
+// syntaxAnalyzer derives a property's keywords and the value types that can
+// form a complete value on their own, by walking the property's value
+// definition syntax and the data types it references.
+type syntaxAnalyzer struct {
+	syntaxes   map[string]MDNSyntax
+	properties map[string]MDNProperty
+	basicTypes map[string]bool // data types from types.json
+
+	parsed   map[string]*syntax.Node
+	keywords map[string][]string
+	singles  map[string]map[string]bool
+	nullable map[string]bool
+	visiting map[string]bool
+	problems map[string]bool // unknown references and parse errors
+}
+
+func newSyntaxAnalyzer(syntaxes map[string]MDNSyntax, properties map[string]MDNProperty, basicTypes map[string]bool) *syntaxAnalyzer {
+	return &syntaxAnalyzer{
+		syntaxes:   syntaxes,
+		properties: properties,
+		basicTypes: basicTypes,
+		parsed:     make(map[string]*syntax.Node),
+		keywords:   make(map[string][]string),
+		singles:    make(map[string]map[string]bool),
+		nullable:   make(map[string]bool),
+		visiting:   make(map[string]bool),
+	}
+}
+
+// ValueTypes returns the css value types accepted by a property with the
+// given syntax, in valueTypeOrder, and the problems found along the way.
+func (a *syntaxAnalyzer) ValueTypes(propSyntax string) ([]string, []string) {
+	a.problems = make(map[string]bool)
+
+	var types []string
+	if n := a.parse(propSyntax); n != nil {
+		set := a.singleTypes(n)
+		for _, t := range valueTypeOrder {
+			if set[t] {
+				types = append(types, t)
+			}
+		}
+	}
+
+	var problems []string
+	for p := range a.problems {
+		problems = append(problems, p)
+	}
+	sort.Strings(problems)
+	return types, problems
+}
+
+// Keywords returns the literal keywords reachable from a property's syntax,
+// sorted. Referenced types and properties are expanded recursively, except
+// data types that have their own css value type (such as <color>) and
+// functions, whose arguments are not values of the property itself.
+func (a *syntaxAnalyzer) Keywords(propSyntax string) []string {
+	a.problems = make(map[string]bool)
+
+	n := a.parse(propSyntax)
+	if n == nil {
+		return nil
+	}
+	set := make(map[string]bool)
+	for _, kw := range a.collectKeywords(n) {
+		set[kw] = true
+	}
+	keywords := make([]string, 0, len(set))
+	for kw := range set {
+		keywords = append(keywords, kw)
+	}
+	sort.Strings(keywords)
+	return keywords
+}
+
+func (a *syntaxAnalyzer) collectKeywords(n *syntax.Node) []string {
+	var keywords []string
+	syntax.Walk(n, func(c *syntax.Node) bool {
+		switch c.Kind {
+		case syntax.Keyword:
+			if !cssWideKeywords[strings.ToLower(c.Name)] {
+				keywords = append(keywords, c.Name)
+			}
+		case syntax.Function:
+			return false
+		case syntax.Type, syntax.Property:
+			keywords = append(keywords, a.refKeywords(c)...)
+		}
+		return true
+	})
+	return keywords
+}
+
+func (a *syntaxAnalyzer) refKeywords(n *syntax.Node) []string {
+	if n.Kind == syntax.Type {
+		if _, ok := valueTypes[n.Name]; ok || strings.HasSuffix(n.Name, "()") {
+			return nil
+		}
+	}
+
+	key := refKey(n)
+	if cached, ok := a.keywords[key]; ok {
+		return cached
+	}
+	if a.visiting[key] {
+		return nil
+	}
+	a.visiting[key] = true
+	defer delete(a.visiting, key)
+
+	var keywords []string
+	if def := a.resolve(n); def != nil {
+		keywords = a.collectKeywords(def)
+	}
+	a.keywords[key] = keywords
+	return keywords
+}
+
+// cssWideKeywords are accepted by every property and are not part of any
+// property's keyword type.
+var cssWideKeywords = map[string]bool{
+	"inherit": true, "initial": true, "unset": true, "revert": true, "revert-layer": true,
+}
+
+func (a *syntaxAnalyzer) parse(s string) *syntax.Node {
+	if n, ok := a.parsed[s]; ok {
+		return n
+	}
+	n, err := syntax.Parse(s)
+	if err != nil {
+		a.problems[err.Error()] = true
+	}
+	a.parsed[s] = n
+	return n
+}
+
+// resolve returns the syntax a type or property reference stands for, or
+// nil when it has none.
+func (a *syntaxAnalyzer) resolve(n *syntax.Node) *syntax.Node {
+	if n.Kind == syntax.Property {
+		if p, ok := a.properties[n.Name]; ok {
+			return a.parse(p.Syntax)
+		}
+		a.problems["unknown property <'"+n.Name+"'>"] = true
+		return nil
+	}
+	if s, ok := a.syntaxes[n.Name]; ok {
+		return a.parse(s.Syntax)
+	}
+	if !a.basicTypes[n.Name] {
+		a.problems["unknown type <"+n.Name+">"] = true
+	}
+	return nil
+}
+
+// singleTypes returns the value types that can match n as one token.
+func (a *syntaxAnalyzer) singleTypes(n *syntax.Node) map[string]bool {
+	set := make(map[string]bool)
+	if n.Min > 1 || n.Max == 0 {
+		return set
+	}
+
+	switch n.Kind {
+	case syntax.Function:
+		set["Function"] = true
+	case syntax.Type, syntax.Property:
+		for t := range a.refSingleTypes(n) {
+			set[t] = true
+		}
+	case syntax.Group:
+		for i, c := range n.Children {
+			// In a sequence or && group a single token can only satisfy one
+			// member, so every other member must be optional.
+			if n.Combinator == syntax.Juxtapose || n.Combinator == syntax.AllOf {
+				if !a.othersNullable(n.Children, i) {
+					continue
+				}
+			}
+			for t := range a.singleTypes(c) {
+				set[t] = true
+			}
+		}
+	}
+	return set
+}
+
+func (a *syntaxAnalyzer) refSingleTypes(n *syntax.Node) map[string]bool {
+	set := make(map[string]bool)
+	if n.Kind == syntax.Type {
+		if types, ok := valueTypes[n.Name]; ok {
+			for _, t := range types {
+				set[t] = true
+			}
+			return set
+		}
+		if strings.HasSuffix(n.Name, "()") {
+			set["Function"] = true
+			return set
+		}
+	}
+
+	key := refKey(n)
+	if cached, ok := a.singles[key]; ok {
+		return cached
+	}
+	if a.visiting[key] {
+		return set
+	}
+	a.visiting[key] = true
+	defer delete(a.visiting, key)
+
+	if def := a.resolve(n); def != nil {
+		set = a.singleTypes(def)
+	}
+	a.singles[key] = set
+	return set
+}
+
+func (a *syntaxAnalyzer) othersNullable(children []*syntax.Node, skip int) bool {
+	for i, c := range children {
+		if i != skip && !a.isNullable(c) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNullable reports whether n can match no tokens at all.
+func (a *syntaxAnalyzer) isNullable(n *syntax.Node) bool {
+	if n.Min == 0 {
+		return true
+	}
+	switch n.Kind {
+	case syntax.Group:
+		if n.Required {
+			return false
+		}
+		switch n.Combinator {
+		case syntax.OneOf, syntax.AnyOf:
+			for _, c := range n.Children {
+				if a.isNullable(c) {
+					return true
+				}
+			}
+			return false
+		default:
+			return a.othersNullable(n.Children, -1)
+		}
+	case syntax.Type, syntax.Property:
+		if _, ok := valueTypes[n.Name]; ok && n.Kind == syntax.Type {
+			return false
+		}
+		key := refKey(n)
+		if v, ok := a.nullable[key]; ok {
+			return v
+		}
+		if a.visiting[key] {
+			return false
+		}
+		a.visiting[key] = true
+		defer delete(a.visiting, key)
+
+		def := a.resolve(n)
+		v := def != nil && a.isNullable(def)
+		a.nullable[key] = v
+		return v
+	}
+	return false
+}
+
+func refKey(n *syntax.Node) string {
+	if n.Kind == syntax.Property {
+		return "'" + n.Name + "'"
+	}
+	return n.Name
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// UnitSpec describes a CSS unit and the css value type of its dimensions.
+type UnitSpec struct {
+	Name   string `json:"name"` // "px", "kHz"
+	Type   string `json:"type"` // css value type, e.g. "Length"
+	Status string `json:"status"`
+}
+
+// unitTypes maps units to the css value type of their dimensions. units.json
+// does not say which data type a unit belongs to.
+var unitTypes = map[string]string{
+	"cap": "Length", "ch": "Length", "cm": "Length", "em": "Length", "ex": "Length",
+	"ic": "Length", "in": "Length", "mm": "Length", "pc": "Length", "pt": "Length",
+	"px": "Length", "Q": "Length", "rem": "Length", "vh": "Length", "vmax": "Length",
+	"vmin": "Length", "vw": "Length",
+	"deg": "Angle", "grad": "Angle", "rad": "Angle", "turn": "Angle",
+	"ms": "Time", "s": "Time",
+	"Hz": "Frequency", "kHz": "Frequency",
+	"dpcm": "Resolution", "dpi": "Resolution", "dppx": "Resolution", "x": "Resolution",
+	"fr": "Flex",
+}
+
+// loadUnits reads units.json. Units missing from unitTypes are skipped with a
+// warning.
+func loadUnits(mdnPath string) ([]UnitSpec, error) {
+	data, err := os.ReadFile(filepath.Join(mdnPath, "units.json"))
+	if err != nil {
+		return nil, nil // optional
+	}
+	var mdnUnits map[string]MDNProperty
+	if err := json.Unmarshal(data, &mdnUnits); err != nil {
+		return nil, fmt.Errorf("cannot parse units.json: %w", err)
+	}
+
+	var units []UnitSpec
+	for name, mdnUnit := range mdnUnits {
+		typ, ok := unitTypes[name]
+		if !ok {
+			fmt.Fprintf(os.Stderr, "Warning: unit %q has no known value type\n", name)
+			continue
+		}
+		units = append(units, UnitSpec{Name: name, Type: typ, Status: mdnUnit.Status})
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return strings.ToLower(units[i].Name) < strings.ToLower(units[j].Name)
+	})
+	return units, nil
+}
+
+// generateUnits creates the units_gen.go file with a constructor per unit.
+func generateUnits(spec Spec, outPath, pkg string) error {
+	if skip, err := skipOutput(spec, outPath, "units", len(spec.Units) == 0); skip {
+		return err
+	}
+
+	var buf strings.Builder
+
+	// Header
+	buf.WriteString(generateHeader(spec.Version) + buildConstraint(spec))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	// dimension is generated with the stable part only.
+	stable := spec.BuildTag == ""
+	qual := ""
+	switch {
+	case pkg != "css" && stable:
+		qual = "css."
+		buf.WriteString("import (\n\t\"strconv\"\n\n\t\"github.com/ahmed-com/typesafe-css/css\"\n)\n\n")
+	case pkg != "css":
+		qual = "css."
+		buf.WriteString("import \"github.com/ahmed-com/typesafe-css/css\"\n\n")
+	case stable:
+		buf.WriteString("import \"strconv\"\n\n")
+	}
+
+	buf.WriteString("// Constructors for CSS dimensions, one per unit.\n\n")
+	if stable {
+		buf.WriteString(`// dimension formats a number with the shortest representation followed by
+// a unit, e.g. 1.5rem.
+func dimension(x float64, unit string) string {
+	return strconv.FormatFloat(x, 'f', -1, 64) + unit
+}
+
+`)
+	}
+
+	props := make(map[string]bool)
+	for _, prop := range spec.Properties {
+		props[propToConstName(prop.Name)] = true
+	}
+
+	for _, unit := range spec.Units {
+		funcName := unitToFuncName(unit.Name)
+		if props[funcName] {
+			funcName = unit.Type + funcName // the x unit and the x property
+		}
+		buf.WriteString(fmt.Sprintf("// %s creates a %s%s dimension in %s.\n", funcName, qual, unit.Type, unit.Name))
+		if notice := deprecationNotice(unit.Status, unit.Name+" unit"); notice != "" {
+			buf.WriteString("//\n" + notice)
+		}
+		buf.WriteString(fmt.Sprintf("func %s(x float64) %s%s {\n", funcName, qual, unit.Type))
+		buf.WriteString(fmt.Sprintf("\treturn %s%s(dimension(x, %q))\n}\n\n", qual, unit.Type, unit.Name))
+	}
+
+	// Format and write
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format generated units: %w", err)
+	}
+
+	outFile := outputFile(spec, outPath, "units")
+	return os.WriteFile(outFile, formatted, 0644)
+}
+
+// unitToFuncName converts a unit to the name of its constructor.
+// e.g., "px" -> "Px", "kHz" -> "KHz"
+func unitToFuncName(unit string) string {
+	return strings.ToUpper(unit[:1]) + unit[1:]
+}
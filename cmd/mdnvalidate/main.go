@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -34,6 +35,13 @@ type MDNSyntax struct {
 	Syntax string `json:"syntax"`
 }
 
+// MDNAtRule represents an at-rule from MDN data.
+type MDNAtRule struct {
+	Syntax      string                 `json:"syntax"`
+	Status      string                 `json:"status"`
+	Descriptors map[string]MDNProperty `json:"descriptors"`
+}
+
 // PropertySpec represents our property specification format.
 type PropertySpec struct {
 	Name     string   `json:"name"`
@@ -42,10 +50,44 @@ type PropertySpec struct {
 	Status   string   `json:"status"`
 }
 
+// FunctionSpec represents a function in our spec format.
+type FunctionSpec struct {
+	Name   string `json:"name"` // without parentheses
+	Syntax string `json:"syntax"`
+	Status string `json:"status"`
+}
+
+// SelectorSpec represents a pseudo-class or pseudo-element in our spec format.
+type SelectorSpec struct {
+	Name   string `json:"name"` // without parentheses
+	Syntax string `json:"syntax"`
+	Status string `json:"status"`
+	Args   string `json:"args,omitempty"` // set for functional selectors
+}
+
+// AtRuleSpec represents an at-rule in our spec format.
+type AtRuleSpec struct {
+	Name        string         `json:"name"` // without the @
+	Syntax      string         `json:"syntax"`
+	Status      string         `json:"status"`
+	Descriptors []PropertySpec `json:"descriptors,omitempty"`
+}
+
+// UnitSpec represents a unit in our spec format.
+type UnitSpec struct {
+	Name   string `json:"name"`
+	Type   string `json:"type,omitempty"`
+	Status string `json:"status"`
+}
+
 // Spec represents our CSS specification data.
 type Spec struct {
 	Properties []PropertySpec `json:"properties"`
 	Version    string         `json:"version"`
+	Functions  []FunctionSpec `json:"functions,omitempty"`
+	Selectors  []SelectorSpec `json:"selectors,omitempty"`
+	AtRules    []AtRuleSpec   `json:"atRules,omitempty"`
+	Units      []UnitSpec     `json:"units,omitempty"`
 }
 
 func main() {
@@ -55,12 +97,19 @@ func main() {
 		outPath     = flag.String("out", "", "Output path for updated spec (optional)")
 		verbose     = flag.Bool("v", false, "Verbose output")
 		updateSpec  = flag.Bool("update", false, "Update spec with MDN data")
+		format      = flag.String("format", "text", "Output format: text, json, sarif or junit")
+		maxMissing  = flag.Int("max-missing-keywords", -1, "Exit with status 1 if more keywords are missing (-1: no limit)")
+		maxUnknown  = flag.Int("max-unknown", -1, "Exit with status 1 if more entries are not found in MDN (-1: no limit)")
 	)
 	flag.Parse()
 
 	if *mdnPath == "" || *specPath == "" {
 		log.Fatal("Error: Both -mdn and -spec flags are required")
 	}
+	report, ok := reporters[*format]
+	if !ok {
+		log.Fatalf("Error: unknown format %q", *format)
+	}
 
 	validator := &Validator{
 		verbose: *verbose,
@@ -80,7 +129,9 @@ func main() {
 	results := validator.validate()
 
 	// Print results
-	validator.printResults(results)
+	if err := report(validator, results, os.Stdout); err != nil {
+		log.Fatalf("Error writing results: %v", err)
+	}
 
 	// Update spec if requested
 	if *updateSpec {
@@ -92,34 +143,53 @@ func main() {
 		if err := validator.writeSpec(updated, outFile); err != nil {
 			log.Fatalf("Error writing updated spec: %v", err)
 		}
-		fmt.Printf("Updated spec written to %s\n", outFile)
+		fmt.Fprintf(os.Stderr, "Updated spec written to %s\n", outFile)
+	}
+
+	summary := summarize(results)
+	if exceeds(summary.MissingKeywords, *maxMissing) || exceeds(summary.Unknown, *maxUnknown) {
+		fmt.Fprintf(os.Stderr, "Validation failed: %d unknown entries (limit %d), %d missing keywords (limit %d)\n",
+			summary.Unknown, *maxUnknown, summary.MissingKeywords, *maxMissing)
+		os.Exit(1)
 	}
 }
 
+// exceeds reports whether n is over a limit; a negative limit disables it.
+func exceeds(n, limit int) bool {
+	return limit >= 0 && n > limit
+}
+
 // Validator handles the validation logic.
 type Validator struct {
 	mdnProperties map[string]MDNProperty
 	mdnSyntaxes   map[string]MDNSyntax
+	mdnFunctions  map[string]MDNProperty // keyed like "rgb()"
+	mdnSelectors  map[string]MDNProperty // keyed like ":hover" or ":not()"
+	mdnAtRules    map[string]MDNAtRule   // keyed like "@font-face"
+	mdnUnits      map[string]MDNProperty
 	spec          Spec
+	specPath      string
 	verbose       bool
 }
 
-// ValidationResult represents the result of validating a property.
+// ValidationResult represents the result of validating a spec entry.
 type ValidationResult struct {
-	Property        string
-	Status          string
-	MDNKeywords     []string
-	SpecKeywords    []string
-	MissingKeywords []string
-	ExtraKeywords   []string
-	SyntaxMatch     bool
-	Exists          bool
+	Kind            string   `json:"kind"` // "property", "descriptor", "function", "selector", "at-rule" or "unit"
+	Property        string   `json:"name"` // the MDN name, e.g. "display", "@font-face font-display", "rgb()"
+	Status          string   `json:"status"`
+	SpecStatus      string   `json:"specStatus,omitempty"`
+	MDNKeywords     []string `json:"mdnKeywords,omitempty"`
+	SpecKeywords    []string `json:"specKeywords,omitempty"`
+	MissingKeywords []string `json:"missingKeywords,omitempty"`
+	ExtraKeywords   []string `json:"extraKeywords,omitempty"`
+	SyntaxMatch     bool     `json:"syntaxMatch"`
+	Exists          bool     `json:"exists"`
 }
 
 // loadMDNData loads properties and syntax data from MDN.
 func (v *Validator) loadMDNData(mdnPath string) error {
 	// Load properties
-	propertiesFile := filepath.Join(mdnPath, "css", "properties.json")
+	propertiesFile := mdnFile(mdnPath, "properties.json")
 	propertiesData, err := os.ReadFile(propertiesFile)
 	if err != nil {
 		return fmt.Errorf("cannot read properties.json: %w", err)
@@ -130,7 +200,7 @@ func (v *Validator) loadMDNData(mdnPath string) error {
 	}
 
 	// Load syntaxes
-	syntaxesFile := filepath.Join(mdnPath, "css", "syntaxes.json")
+	syntaxesFile := mdnFile(mdnPath, "syntaxes.json")
 	syntaxesData, err := os.ReadFile(syntaxesFile)
 	if err != nil {
 		return fmt.Errorf("cannot read syntaxes.json: %w", err)
@@ -140,16 +210,44 @@ func (v *Validator) loadMDNData(mdnPath string) error {
 		return fmt.Errorf("cannot parse syntaxes.json: %w", err)
 	}
 
+	// The other categories are optional; entries of a category whose file
+	// is missing are not validated.
+	for name, target := range map[string]interface{}{
+		"functions.json": &v.mdnFunctions,
+		"selectors.json": &v.mdnSelectors,
+		"at-rules.json":  &v.mdnAtRules,
+		"units.json":     &v.mdnUnits,
+	} {
+		data, err := os.ReadFile(mdnFile(mdnPath, name))
+		if err != nil {
+			continue
+		}
+		if err := json.Unmarshal(data, target); err != nil {
+			return fmt.Errorf("cannot parse %s: %w", name, err)
+		}
+	}
+
 	if v.verbose {
-		fmt.Printf("Loaded %d properties and %d syntax definitions from MDN\n", 
+		fmt.Fprintf(os.Stderr, "Loaded %d properties and %d syntax definitions from MDN\n", 
 			len(v.mdnProperties), len(v.mdnSyntaxes))
 	}
 
 	return nil
 }
 
+// mdnFile returns the path of an MDN data file, which is under css/ in a
+// checkout of the MDN data repository and at the top of a copy such as spec/.
+func mdnFile(mdnPath, name string) string {
+	nested := filepath.Join(mdnPath, "css", name)
+	if _, err := os.Stat(nested); err == nil {
+		return nested
+	}
+	return filepath.Join(mdnPath, name)
+}
+
 // loadSpec loads our CSS specification.
 func (v *Validator) loadSpec(specPath string) error {
+	v.specPath = specPath
 	data, err := os.ReadFile(specPath)
 	if err != nil {
 		return fmt.Errorf("cannot read spec file: %w", err)
@@ -160,7 +258,7 @@ func (v *Validator) loadSpec(specPath string) error {
 	}
 
 	if v.verbose {
-		fmt.Printf("Loaded spec with %d properties\n", len(v.spec.Properties))
+		fmt.Fprintf(os.Stderr, "Loaded spec with %d properties\n", len(v.spec.Properties))
 	}
 
 	return nil
@@ -171,36 +269,89 @@ func (v *Validator) validate() []ValidationResult {
 	var results []ValidationResult
 
 	for _, prop := range v.spec.Properties {
-		result := ValidationResult{
-			Property:     prop.Name,
-			SpecKeywords: prop.Keywords,
-		}
-
 		mdnProp, exists := v.mdnProperties[prop.Name]
-		result.Exists = exists
-		if !exists {
-			result.Status = "NOT_FOUND_IN_MDN"
-			results = append(results, result)
-			continue
+		results = append(results, v.validateProperty("property", prop.Name, prop, mdnProp, exists))
+	}
+
+	if v.mdnAtRules != nil {
+		for _, rule := range v.spec.AtRules {
+			name := "@" + rule.Name
+			mdnRule, exists := v.mdnAtRules[name]
+			results = append(results, validateEntry("at-rule", name, rule.Status, rule.Syntax, mdnRule.Status, mdnRule.Syntax, exists))
+			for _, desc := range rule.Descriptors {
+				mdnDesc, exists := mdnRule.Descriptors[desc.Name]
+				results = append(results, v.validateProperty("descriptor", name+" "+desc.Name, desc, mdnDesc, exists))
+			}
 		}
+	}
+	if v.mdnFunctions != nil {
+		for _, fn := range v.spec.Functions {
+			name := fn.Name + "()"
+			mdnFn, exists := v.mdnFunctions[name]
+			results = append(results, validateEntry("function", name, fn.Status, fn.Syntax, mdnFn.Status, mdnFn.Syntax, exists))
+		}
+	}
+	if v.mdnSelectors != nil {
+		for _, sel := range v.spec.Selectors {
+			name := sel.Name
+			if sel.Args != "" {
+				name += "()"
+			}
+			mdnSel, exists := v.mdnSelectors[name]
+			results = append(results, validateEntry("selector", name, sel.Status, sel.Syntax, mdnSel.Status, mdnSel.Syntax, exists))
+		}
+	}
+	if v.mdnUnits != nil {
+		for _, unit := range v.spec.Units {
+			mdnUnit, exists := v.mdnUnits[unit.Name]
+			results = append(results, validateEntry("unit", unit.Name, unit.Status, "", mdnUnit.Status, "", exists))
+		}
+	}
 
-		result.Status = mdnProp.Status
+	return results
+}
 
-		// Extract keywords from MDN syntax
-		mdnKeywords := v.extractKeywords(mdnProp.Syntax)
-		result.MDNKeywords = mdnKeywords
+// validateEntry checks that an entry without keywords exists in MDN with
+// the same status and syntax.
+func validateEntry(kind, name, status, syntax, mdnStatus, mdnSyntax string, exists bool) ValidationResult {
+	result := ValidationResult{Kind: kind, Property: name, SpecStatus: status, Exists: exists}
+	if !exists {
+		result.Status = "NOT_FOUND_IN_MDN"
+		return result
+	}
+	result.Status = mdnStatus
+	result.SyntaxMatch = syntax == "" || syntax == mdnSyntax
+	return result
+}
 
-		// Compare keywords
-		result.MissingKeywords = difference(mdnKeywords, prop.Keywords)
-		result.ExtraKeywords = difference(prop.Keywords, mdnKeywords)
+// validateProperty compares the keywords of a property or descriptor with
+// those of its MDN syntax.
+func (v *Validator) validateProperty(kind, name string, prop PropertySpec, mdnProp MDNProperty, exists bool) ValidationResult {
+	result := ValidationResult{
+		Kind:         kind,
+		Property:     name,
+		SpecStatus:   prop.Status,
+		SpecKeywords: prop.Keywords,
+		Exists:       exists,
+	}
+	if !exists {
+		result.Status = "NOT_FOUND_IN_MDN"
+		return result
+	}
 
-		// Check syntax similarity
-		result.SyntaxMatch = strings.Contains(mdnProp.Syntax, strings.Join(prop.Keywords, " | "))
+	result.Status = mdnProp.Status
 
-		results = append(results, result)
-	}
+	// Extract keywords from MDN syntax
+	mdnKeywords := v.extractKeywords(mdnProp.Syntax)
+	result.MDNKeywords = mdnKeywords
 
-	return results
+	// Compare keywords
+	result.MissingKeywords = difference(mdnKeywords, prop.Keywords)
+	result.ExtraKeywords = difference(prop.Keywords, mdnKeywords)
+
+	// Check syntax similarity
+	result.SyntaxMatch = strings.Contains(mdnProp.Syntax, strings.Join(prop.Keywords, " | "))
+	return result
 }
 
 // extractKeywords extracts concrete keyword values from MDN syntax.
@@ -298,45 +449,67 @@ func difference(a, b []string) []string {
 	return diff
 }
 
-// printResults prints validation results to stdout.
-func (v *Validator) printResults(results []ValidationResult) {
-	fmt.Println("=== MDN Validation Results ===")
-	fmt.Println()
+// printResults prints validation results as text.
+func (v *Validator) printResults(results []ValidationResult, w io.Writer) error {
+	fmt.Fprintln(w, "=== MDN Validation Results ===")
+	fmt.Fprintln(w)
 
 	for _, result := range results {
-		fmt.Printf("Property: %s\n", result.Property)
-		fmt.Printf("  Exists in MDN: %t\n", result.Exists)
-		
-		if result.Exists {
-			fmt.Printf("  Status: %s\n", result.Status)
-			fmt.Printf("  Spec keywords (%d): %v\n", len(result.SpecKeywords), result.SpecKeywords)
-			fmt.Printf("  MDN keywords (%d): %v\n", len(result.MDNKeywords), result.MDNKeywords)
-			
+		fmt.Fprintf(w, "%s: %s\n", kindTitle(result.Kind), result.Property)
+		fmt.Fprintf(w, "  Exists in MDN: %t\n", result.Exists)
+
+		switch {
+		case !result.Exists:
+			fmt.Fprintf(w, "  ❌ %s not found in MDN data\n", kindTitle(result.Kind))
+		case result.Kind != "property" && result.Kind != "descriptor":
+			fmt.Fprintf(w, "  Status: %s\n", result.Status)
+			if result.SpecStatus != "" && result.SpecStatus != result.Status {
+				fmt.Fprintf(w, "  ⚠️  Spec status: %s\n", result.SpecStatus)
+			}
+			if !result.SyntaxMatch {
+				fmt.Fprintf(w, "  ⚠️  Syntax differs from MDN\n")
+			}
+		default:
+			fmt.Fprintf(w, "  Status: %s\n", result.Status)
+			fmt.Fprintf(w, "  Spec keywords (%d): %v\n", len(result.SpecKeywords), result.SpecKeywords)
+			fmt.Fprintf(w, "  MDN keywords (%d): %v\n", len(result.MDNKeywords), result.MDNKeywords)
+
 			if len(result.MissingKeywords) > 0 {
-				fmt.Printf("  ⚠️  Missing keywords: %v\n", result.MissingKeywords)
+				fmt.Fprintf(w, "  ⚠️  Missing keywords: %v\n", result.MissingKeywords)
 			}
-			
+
 			if len(result.ExtraKeywords) > 0 {
-				fmt.Printf("  ⚠️  Extra keywords: %v\n", result.ExtraKeywords)
+				fmt.Fprintf(w, "  ⚠️  Extra keywords: %v\n", result.ExtraKeywords)
 			}
-			
+
 			if len(result.MissingKeywords) == 0 && len(result.ExtraKeywords) == 0 {
-				fmt.Printf("  ✅ Keywords match MDN\n")
+				fmt.Fprintf(w, "  ✅ Keywords match MDN\n")
 			}
-		} else {
-			fmt.Printf("  ❌ Property not found in MDN data\n")
 		}
-		
-		fmt.Println()
+
+		fmt.Fprintln(w)
 	}
+
+	summary := summarize(results)
+	_, err := fmt.Fprintf(w, "Checked %d entries: %d not found in MDN, %d missing keywords, %d extra keywords\n",
+		summary.Checked, summary.Unknown, summary.MissingKeywords, summary.ExtraKeywords)
+	return err
+}
+
+// kindTitle capitalizes a result kind for text output.
+// e.g., "at-rule" -> "At-rule"
+func kindTitle(kind string) string {
+	if kind == "" {
+		return ""
+	}
+	return strings.ToUpper(kind[:1]) + kind[1:]
 }
 
 // updateSpecWithMDN creates an updated spec incorporating MDN data.
 func (v *Validator) updateSpecWithMDN() Spec {
-	updated := Spec{
-		Version:    v.spec.Version,
-		Properties: make([]PropertySpec, 0, len(v.spec.Properties)),
-	}
+	// Keep the other categories as they are.
+	updated := v.spec
+	updated.Properties = make([]PropertySpec, 0, len(v.spec.Properties))
 
 	for _, prop := range v.spec.Properties {
 		updatedProp := prop
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// testValidator validates a spec covering every category against the MDN
+// data bundled in spec/.
+func testValidator(t *testing.T) (*Validator, []ValidationResult) {
+	t.Helper()
+	spec := `{
+  "version": "test",
+  "properties": [
+    {"name": "position", "keywords": ["static", "relative", "absolute", "fixed"], "status": "standard"},
+    {"name": "not-a-property", "status": "standard"}
+  ],
+  "functions": [{"name": "rgb", "status": "standard"}, {"name": "not-a-function", "status": "standard"}],
+  "selectors": [{"name": ":hover", "status": "standard"}, {"name": ":not", "status": "standard", "args": "list"}],
+  "atRules": [{"name": "font-face", "status": "standard", "descriptors": [{"name": "font-display", "keywords": ["auto", "block", "swap", "fallback", "optional"], "status": "standard"}]}],
+  "units": [{"name": "px", "type": "Length", "status": "experimental"}]
+}`
+	specPath := filepath.Join(t.TempDir(), "spec.json")
+	if err := os.WriteFile(specPath, []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	v := &Validator{}
+	if err := v.loadMDNData("../../spec"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.loadSpec(specPath); err != nil {
+		t.Fatal(err)
+	}
+	return v, v.validate()
+}
+
+func TestValidateAllCategories(t *testing.T) {
+	_, results := testValidator(t)
+
+	var names []string
+	for _, r := range results {
+		names = append(names, r.Kind+" "+r.Property)
+	}
+	want := "property position, property not-a-property, at-rule @font-face, descriptor @font-face font-display, " +
+		"function rgb(), function not-a-function(), selector :hover, selector :not(), unit px"
+	if got := strings.Join(names, ", "); got != want {
+		t.Errorf("validated %s\nwant %s", got, want)
+	}
+
+	s := summarize(results)
+	if s.Checked != 9 || s.Unknown != 2 || s.MissingKeywords != 1 || s.ExtraKeywords != 0 || s.StatusMismatch != 1 {
+		t.Errorf("summary = %+v", s)
+	}
+	if got := results[0].MissingKeywords; len(got) != 1 || got[0] != "sticky" {
+		t.Errorf("position missing keywords = %v", got)
+	}
+	if !exceeds(s.Unknown, 1) || exceeds(s.Unknown, 2) || exceeds(s.Unknown, -1) {
+		t.Error("exceeds does not apply the limits")
+	}
+}
+
+func TestReportFormats(t *testing.T) {
+	v, results := testValidator(t)
+
+	var buf strings.Builder
+	if err := writeJSON(v, results, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var report struct {
+		Summary Summary
+		Results []ValidationResult
+	}
+	if err := json.Unmarshal([]byte(buf.String()), &report); err != nil || report.Summary.Unknown != 2 || len(report.Results) != 9 {
+		t.Errorf("json report = %+v, %v", report.Summary, err)
+	}
+
+	buf.Reset()
+	if err := writeSARIF(v, results, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var sarif struct {
+		Version string
+		Runs    []struct {
+			Results []struct {
+				RuleID string
+				Level  string
+			}
+		}
+	}
+	if err := json.Unmarshal([]byte(buf.String()), &sarif); err != nil || sarif.Version != "2.1.0" || len(sarif.Runs) != 1 {
+		t.Fatalf("sarif report = %+v, %v", sarif, err)
+	}
+	var levels []string
+	for _, r := range sarif.Runs[0].Results {
+		levels = append(levels, r.RuleID+":"+r.Level)
+	}
+	if got := strings.Join(levels, " "); got != "missing-keywords:warning unknown:error unknown:error status-mismatch:note" {
+		t.Errorf("sarif results = %s", got)
+	}
+
+	buf.Reset()
+	if err := writeJUnit(v, results, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var junit struct {
+		Suites []struct {
+			Name     string `xml:"name,attr"`
+			Tests    int    `xml:"tests,attr"`
+			Failures int    `xml:"failures,attr"`
+		} `xml:"testsuite"`
+	}
+	if err := xml.Unmarshal([]byte(buf.String()), &junit); err != nil {
+		t.Fatal(err)
+	}
+	if len(junit.Suites) != 6 || junit.Suites[0].Name != "mdnvalidate.property" || junit.Suites[0].Tests != 2 || junit.Suites[0].Failures != 2 {
+		t.Errorf("junit suites = %+v", junit.Suites)
+	}
+
+	buf.Reset()
+	if err := v.printResults(results, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), "❌ Function not found in MDN data") {
+		t.Errorf("text report lacks the unknown function:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,229 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// reporters write validation results in the formats selected by -format.
+var reporters = map[string]func(v *Validator, results []ValidationResult, w io.Writer) error{
+	"text":  (*Validator).printResults,
+	"json":  writeJSON,
+	"sarif": writeSARIF,
+	"junit": writeJUnit,
+}
+
+// Summary counts the problems found by a validation run.
+type Summary struct {
+	Checked         int `json:"checked"`
+	Unknown         int `json:"unknown"`         // entries not found in MDN
+	MissingKeywords int `json:"missingKeywords"` // keywords in MDN but not in the spec
+	ExtraKeywords   int `json:"extraKeywords"`   // keywords in the spec but not in MDN
+	StatusMismatch  int `json:"statusMismatch"`  // entries whose spec status differs from MDN
+}
+
+func summarize(results []ValidationResult) Summary {
+	s := Summary{Checked: len(results)}
+	for _, r := range results {
+		if !r.Exists {
+			s.Unknown++
+			continue
+		}
+		s.MissingKeywords += len(r.MissingKeywords)
+		s.ExtraKeywords += len(r.ExtraKeywords)
+		if r.statusMismatch() {
+			s.StatusMismatch++
+		}
+	}
+	return s
+}
+
+func (r ValidationResult) statusMismatch() bool {
+	return r.Exists && r.SpecStatus != "" && r.SpecStatus != r.Status
+}
+
+// Issue is a problem found in one validation result.
+type Issue struct {
+	Rule    string // one of the issueRules IDs
+	Message string
+}
+
+// issueRules describes the kinds of issues, in the order they are reported.
+var issueRules = []struct {
+	ID, Level, Description string
+}{
+	{"unknown", "error", "The spec entry is not found in MDN data."},
+	{"missing-keywords", "warning", "MDN lists keywords that are missing from the spec."},
+	{"extra-keywords", "warning", "The spec lists keywords that MDN does not."},
+	{"status-mismatch", "note", "The spec status differs from the MDN status."},
+}
+
+// issues lists the problems of a result, if any.
+func (r ValidationResult) issues() []Issue {
+	if !r.Exists {
+		return []Issue{{"unknown", fmt.Sprintf("%s %s not found in MDN data", r.Kind, r.Property)}}
+	}
+	var issues []Issue
+	if len(r.MissingKeywords) > 0 {
+		issues = append(issues, Issue{"missing-keywords", fmt.Sprintf("%s %s is missing keywords: %s", r.Kind, r.Property, strings.Join(r.MissingKeywords, ", "))})
+	}
+	if len(r.ExtraKeywords) > 0 {
+		issues = append(issues, Issue{"extra-keywords", fmt.Sprintf("%s %s has keywords not in MDN: %s", r.Kind, r.Property, strings.Join(r.ExtraKeywords, ", "))})
+	}
+	if r.statusMismatch() {
+		issues = append(issues, Issue{"status-mismatch", fmt.Sprintf("%s %s is %s in the spec but %s in MDN", r.Kind, r.Property, r.SpecStatus, r.Status)})
+	}
+	return issues
+}
+
+func writeJSON(v *Validator, results []ValidationResult, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Summary Summary            `json:"summary"`
+		Results []ValidationResult `json:"results"`
+	}{summarize(results), results})
+}
+
+// writeSARIF writes the issues as a SARIF 2.1.0 log, as read by code
+// scanning services. Every issue is located in the spec file.
+func writeSARIF(v *Validator, results []ValidationResult, w io.Writer) error {
+	type message struct {
+		Text string `json:"text"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+		DefaultLevel     struct {
+			Level string `json:"level"`
+		} `json:"defaultConfiguration"`
+	}
+	type location struct {
+		PhysicalLocation struct {
+			ArtifactLocation struct {
+				URI string `json:"uri"`
+			} `json:"artifactLocation"`
+		} `json:"physicalLocation"`
+	}
+	type result struct {
+		RuleID    string     `json:"ruleId"`
+		Level     string     `json:"level"`
+		Message   message    `json:"message"`
+		Locations []location `json:"locations"`
+	}
+
+	var loc location
+	loc.PhysicalLocation.ArtifactLocation.URI = v.specPath
+
+	levels := make(map[string]string)
+	rules := make([]rule, 0, len(issueRules))
+	for _, ir := range issueRules {
+		r := rule{ID: ir.ID, ShortDescription: message{ir.Description}}
+		r.DefaultLevel.Level = ir.Level
+		rules = append(rules, r)
+		levels[ir.ID] = ir.Level
+	}
+
+	sarifResults := []result{}
+	for _, r := range results {
+		for _, issue := range r.issues() {
+			sarifResults = append(sarifResults, result{
+				RuleID:    issue.Rule,
+				Level:     levels[issue.Rule],
+				Message:   message{issue.Message},
+				Locations: []location{loc},
+			})
+		}
+	}
+
+	type driver struct {
+		Name  string `json:"name"`
+		Rules []rule `json:"rules"`
+	}
+	type run struct {
+		Tool struct {
+			Driver driver `json:"driver"`
+		} `json:"tool"`
+		Results []result `json:"results"`
+	}
+	var r run
+	r.Tool.Driver = driver{Name: "mdnvalidate", Rules: rules}
+	r.Results = sarifResults
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Schema  string `json:"$schema"`
+		Version string `json:"version"`
+		Runs    []run  `json:"runs"`
+	}{"https://json.schemastore.org/sarif-2.1.0.json", "2.1.0", []run{r}})
+}
+
+// writeJUnit writes one test case per result, grouped into a test suite per
+// kind, so that CI systems can show problems as failed tests. Only unknown
+// entries and missing keywords fail; other issues are written to the
+// test case's system-out.
+func writeJUnit(v *Validator, results []ValidationResult, w io.Writer) error {
+	type failure struct {
+		Message string `xml:"message,attr"`
+		Type    string `xml:"type,attr"`
+		Text    string `xml:",chardata"`
+	}
+	type testCase struct {
+		Name      string   `xml:"name,attr"`
+		ClassName string   `xml:"classname,attr"`
+		Failure   *failure `xml:"failure,omitempty"`
+		SystemOut string   `xml:"system-out,omitempty"`
+	}
+	type testSuite struct {
+		Name     string     `xml:"name,attr"`
+		Tests    int        `xml:"tests,attr"`
+		Failures int        `xml:"failures,attr"`
+		Cases    []testCase `xml:"testcase"`
+	}
+
+	var suites []*testSuite
+	byKind := make(map[string]*testSuite)
+	for _, r := range results {
+		suite := byKind[r.Kind]
+		if suite == nil {
+			suite = &testSuite{Name: "mdnvalidate." + r.Kind}
+			byKind[r.Kind] = suite
+			suites = append(suites, suite)
+		}
+
+		tc := testCase{Name: r.Property, ClassName: "mdnvalidate." + r.Kind}
+		var failed, notes []string
+		for _, issue := range r.issues() {
+			if issue.Rule == "unknown" || issue.Rule == "missing-keywords" {
+				failed = append(failed, issue.Message)
+			} else {
+				notes = append(notes, issue.Message)
+			}
+		}
+		if len(failed) > 0 {
+			tc.Failure = &failure{Message: failed[0], Type: "mdnvalidate", Text: strings.Join(failed, "\n")}
+			suite.Failures++
+		}
+		tc.SystemOut = strings.Join(notes, "\n")
+		suite.Tests++
+		suite.Cases = append(suite.Cases, tc)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(struct {
+		XMLName xml.Name     `xml:"testsuites"`
+		Suites  []*testSuite `xml:"testsuite"`
+	}{Suites: suites}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
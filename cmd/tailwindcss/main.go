@@ -0,0 +1,58 @@
+// Package main builds a CSS file with the Tailwind utilities used by a set
+// of content files, using the default configuration.
+//
+//	go run ./cmd/tailwindcss -content './web/**/*.{html,templ}' -content './internal/**/*.go' -o static/app.css
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/tailwind"
+)
+
+// patterns collects repeated -content flags.
+type patterns []string
+
+func (p *patterns) String() string     { return strings.Join(*p, ",") }
+func (p *patterns) Set(v string) error { *p = append(*p, v); return nil }
+
+func main() {
+	var content patterns
+	flag.Var(&content, "content", "Glob of files to scan for classes; repeatable, ** and {a,b} are supported")
+	var (
+		outPath = flag.String("o", "", "Output CSS file (default: standard output)")
+		pretty  = flag.Bool("pretty", false, "Indent the output instead of minifying it")
+	)
+	flag.Parse()
+
+	if len(content) == 0 {
+		log.Fatal("Error: at least one -content pattern is required")
+	}
+
+	config := tailwind.DefaultConfig()
+	config.Content = content
+	stylesheet, err := tailwind.Build(config)
+	if err != nil {
+		log.Fatalf("Error scanning content: %v", err)
+	}
+
+	out := stylesheet.String()
+	if *pretty {
+		out = css.PrettyCSS(stylesheet.Items...)
+	}
+	out += "\n"
+
+	if *outPath == "" {
+		fmt.Print(out)
+		return
+	}
+	if err := os.WriteFile(*outPath, []byte(out), 0644); err != nil {
+		log.Fatalf("Error writing CSS: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "Wrote %d rules to %s\n", len(stylesheet.Items), *outPath)
+}
@@ -1,6 +1,7 @@
 package css
 
 import (
+	"errors"
 	"strings"
 	"testing"
 )
@@ -21,6 +22,18 @@ func TestValues(t *testing.T) {
 		{"RGB color", RGB(255, 0, 0), "rgb(255 0 0)"},
 		{"RGBA color", RGBA(255, 0, 0, 128), "rgba(255 0 0 / 0.502)"},
 		{"CSS var", Var("--primary"), "var(--primary)"},
+		{"Ms", Ms(150), "150ms"},
+		{"Sec", Sec(1.5), "1.5s"},
+		{"Deg", Deg(45), "45deg"},
+		{"Turn", Turn(0.25), "0.25turn"},
+		{"Num", Num(1.5), "1.5"},
+		{"Int", Int(10), "10"},
+		{"Url", Url("/img/a.png"), `url("/img/a.png")`},
+		{"Quote", Quote(`say "hi"`), `"say \"hi\""`},
+		{"Func", Func("fit-content", Px(200)), "fit-content(200px)"},
+		{"Global", GlobalRevertLayer, "revert-layer"},
+		{"ShadowOf", ShadowOf(Px(0), Px(1), Px(3), Hex("#000")), "0px 1px 3px #000"},
+		{"Shadows", Shadows(Shadow("inset 0 0 1px red"), Shadow("0 1px 2px blue")), "inset 0 0 1px red, 0 1px 2px blue"},
 	}
 
 	for _, tt := range tests {
@@ -179,4 +192,162 @@ func TestStylesheet(t *testing.T) {
 	if !strings.Contains(result, ".test2") {
 		t.Errorf("Stylesheet should contain '.test2', got: %v", result)
 	}
-}
\ No newline at end of file
+}
+
+func TestLayeredStylesheet(t *testing.T) {
+	layers := NewLayeredStylesheet("base", "components", "utilities")
+
+	// Items are added out of layer order.
+	layers.Add("utilities", RuleSet(".p-4", Set(Padding, Rem(1))))
+	layers.AddUnlayered(RuleSet(".override", Set(Display, DisplayNone)))
+	layers.Add("base", RuleSet("body", Set(Margin, Px(0))))
+	layers.Add("components", RuleSet(".btn", Set(Display, DisplayFlex)))
+	layers.Add("vendor", RuleSet(".x", Set(Display, DisplayBlock)))
+
+	expected := "@layer base, components, utilities, vendor;" +
+		"@layer base{body{margin:0px}}" +
+		"@layer components{.btn{display:flex}}" +
+		"@layer utilities{.p-4{padding:1rem}}" +
+		"@layer vendor{.x{display:block}}" +
+		".override{display:none}"
+	if got := layers.String(); got != expected {
+		t.Errorf("LayeredStylesheet.String() = %v, want %v", got, expected)
+	}
+
+	expected = "body{margin:0px}.btn{display:flex}.p-4{padding:1rem}.x{display:block}.override{display:none}"
+	if got := layers.Flatten().String(); got != expected {
+		t.Errorf("LayeredStylesheet.Flatten() = %v, want %v", got, expected)
+	}
+}
+
+
+func TestLayeredStylesheetEmptyLayers(t *testing.T) {
+	layers := NewLayeredStylesheet("base", "utilities")
+	layers.Add("utilities", RuleSet(".block", Set(Display, DisplayBlock)))
+
+	expected := "@layer base, utilities;@layer utilities{.block{display:block}}"
+	if got := layers.String(); got != expected {
+		t.Errorf("LayeredStylesheet.String() = %v, want %v", got, expected)
+	}
+}
+
+func TestTypedVar(t *testing.T) {
+	brand := NewVar[Color]("brand")
+
+	if got := brand.Name(); got != "--brand" {
+		t.Errorf("Name() = %v, want --brand", got)
+	}
+	if got := brand.Set(Hex("#3b82f6")).String(); got != "--brand:#3b82f6" {
+		t.Errorf("Set() = %v", got)
+	}
+
+	// References are Colors, so they can be passed to color-typed APIs.
+	var ref Color = brand.Ref()
+	if got := Set(ColorP, ref).String(); got != "color:var(--brand)" {
+		t.Errorf("Ref() = %v", got)
+	}
+	if got := brand.Or(Hex("#000")).String(); got != "var(--brand, #000)" {
+		t.Errorf("Or() = %v", got)
+	}
+	if got := BorderShorthand(Px(1), BorderSolid, brand.Ref()).String(); got != "1px solid var(--brand)" {
+		t.Errorf("BorderShorthand() = %v", got)
+	}
+}
+
+func TestTypedVarRegister(t *testing.T) {
+	tests := []struct {
+		name     string
+		rule     AtRule
+		expected string
+	}{
+		{
+			"Color",
+			NewVar[Color]("--brand").Register(false, Hex("#000")),
+			"@property --brand{syntax:'<color>';inherits:false;initial-value:#000}",
+		},
+		{
+			"Length",
+			NewVar[Length]("--gap").Register(true, Rem(1)),
+			"@property --gap{syntax:'<length-percentage>';inherits:true;initial-value:1rem}",
+		},
+		{
+			"Raw without initial value",
+			NewVar[Raw]("--shadow").Register(false, Raw("")),
+			"@property --shadow{syntax:'*';inherits:false}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.String(); got != tt.expected {
+				t.Errorf("Register() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Register() without an initial value for <color> did not panic")
+			}
+		}()
+		NewVar[Color]("--brand").Register(false, "")
+	}()
+
+	pretty := PrettyCSS(NewVar[Color]("--brand").Register(false, Hex("#000")))
+	expected := "@property --brand {\n  syntax:'<color>';\n  inherits:false;\n  initial-value:#000;\n}"
+	if pretty != expected {
+		t.Errorf("PrettyCSS(@property) = %q, want %q", pretty, expected)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []Decl{
+		Set(Display, DisplayFlex),
+		Set(Width, Percent(50)),
+		Set("animation-delay", Ms(150)),
+		Set(ColorP, NewVar[Color]("--brand").Ref()),
+		Set("--anything", Raw("goes here")),
+		Set("-webkit-line-clamp", Raw("3")),
+	}
+	for _, d := range valid {
+		if err := Validate(d); err != nil {
+			t.Errorf("Validate(%s) = %v", d, err)
+		}
+	}
+
+	err := Validate(Set("animation-delay", Px(10)))
+	want := `css: invalid value "10px" for animation-delay: unexpected "10px", expected <time>`
+	if err == nil || err.Error() != want {
+		t.Errorf("Validate(animation-delay:10px) = %v, want %s", err, want)
+	}
+
+	err = Validate(Set("colr", Keyword("red")))
+	if !errors.Is(err, ErrUnknownProperty) {
+		t.Errorf("Validate(colr:red) = %v, want ErrUnknownProperty", err)
+	}
+}
+
+func TestStylesheetValidate(t *testing.T) {
+	var s Stylesheet
+	s.Add(
+		RuleSet(".ok", Set(Display, DisplayBlock)),
+		RuleSet(".btn", Set(Display, Keyword("flexx")), Set(Padding, Px(4))),
+		AtRule{Name: "media", Params: "(min-width: 640px)", Body: []Item{
+			RuleSet(".card", Set("z-index", Raw("1.5"))),
+		}},
+		AtRule{Name: "font-face", Body: []Item{Set("src", Url("/f.woff2"))}},
+	)
+
+	errs := s.Validate()
+	if len(errs) != 2 {
+		t.Fatalf("Validate() returned %d problems, want 2: %v", len(errs), errs)
+	}
+	if errs[0].Selector != ".btn" || errs[0].Property != Display {
+		t.Errorf("first problem = %v", errs[0])
+	}
+	want := `css: .card: invalid value "1.5" for z-index: unexpected "1.5", expected auto | <integer>`
+	if got := errs[1].Error(); got != want {
+		t.Errorf("second problem = %s, want %s", got, want)
+	}
+}
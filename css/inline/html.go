@@ -0,0 +1,299 @@
+package inline
+
+import (
+	"html"
+	"strings"
+)
+
+// node is an element in the lightweight document tree built by parseHTML.
+// Only elements are tracked; text and comments are left untouched in the
+// source and copied through verbatim when the document is rewritten.
+type node struct {
+	tag      string // lower-case tag name; empty for the document root
+	attrs    []attr
+	parent   *node
+	children []*node
+
+	// start and end delimit the start tag in the source document.
+	start, end  int
+	selfClosing bool
+}
+
+// attr is a single attribute of a start tag.
+type attr struct {
+	key    string // lower-case attribute name
+	val    string // decoded attribute value
+	raw    string // attribute exactly as written in the source
+	hasVal bool
+}
+
+// document is the result of parsing an HTML string.
+type document struct {
+	src      string
+	root     *node
+	elements []*node // every element in source order
+
+	headClose int // offset of </head>, or -1
+	bodyOpen  int // offset just after <body ...>, or -1
+}
+
+// voidElements never have content or an end tag.
+var voidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true,
+	"hr": true, "img": true, "input": true, "link": true, "meta": true,
+	"param": true, "source": true, "track": true, "wbr": true,
+}
+
+// rawTextElements contain text that must not be scanned for tags.
+var rawTextElements = map[string]bool{
+	"script": true, "style": true, "textarea": true, "title": true,
+}
+
+// parseHTML builds an element tree from src. It is deliberately forgiving:
+// unknown constructs are skipped and unmatched end tags are ignored, which
+// is enough for the well-formed markup produced by email templates.
+func parseHTML(src string) *document {
+	doc := &document{src: src, root: &node{}, headClose: -1, bodyOpen: -1}
+	cur := doc.root
+	i := 0
+
+	for i < len(src) {
+		lt := strings.IndexByte(src[i:], '<')
+		if lt < 0 {
+			break
+		}
+		i += lt
+		rest := src[i:]
+
+		switch {
+		case strings.HasPrefix(rest, "<!--"):
+			end := strings.Index(rest[4:], "-->")
+			if end < 0 {
+				return doc
+			}
+			i += 4 + end + 3
+
+		case strings.HasPrefix(rest, "<!") || strings.HasPrefix(rest, "<?"):
+			end := strings.IndexByte(rest, '>')
+			if end < 0 {
+				return doc
+			}
+			i += end + 1
+
+		case strings.HasPrefix(rest, "</"):
+			name, j := readTagName(src, i+2)
+			end := strings.IndexByte(src[j:], '>')
+			if end < 0 {
+				return doc
+			}
+			if name == "head" && doc.headClose < 0 {
+				doc.headClose = i
+			}
+			i = j + end + 1
+			for n := cur; n != doc.root; n = n.parent {
+				if n.tag == name {
+					cur = n.parent
+					break
+				}
+			}
+
+		case len(rest) > 1 && isASCIILetter(rest[1]):
+			n, j, ok := parseStartTag(src, i)
+			if !ok {
+				return doc
+			}
+			n.parent = cur
+			cur.children = append(cur.children, n)
+			doc.elements = append(doc.elements, n)
+			i = j
+
+			if n.tag == "body" && doc.bodyOpen < 0 {
+				doc.bodyOpen = j
+			}
+			if n.selfClosing || voidElements[n.tag] {
+				continue
+			}
+			if rawTextElements[n.tag] {
+				i = skipRawText(src, i, n.tag)
+				continue
+			}
+			cur = n
+
+		default:
+			i++
+		}
+	}
+
+	return doc
+}
+
+// parseStartTag parses the start tag beginning at src[i] == '<'.
+func parseStartTag(src string, i int) (*node, int, bool) {
+	n := &node{start: i}
+	n.tag, i = readTagName(src, i+1)
+
+	for i < len(src) {
+		for i < len(src) && isSpace(src[i]) {
+			i++
+		}
+		if i >= len(src) {
+			return nil, 0, false
+		}
+		switch {
+		case src[i] == '>':
+			n.end = i + 1
+			return n, n.end, true
+		case strings.HasPrefix(src[i:], "/>"):
+			n.selfClosing = true
+			n.end = i + 2
+			return n, n.end, true
+		case src[i] == '/':
+			i++
+			continue
+		}
+
+		begin := i
+		for i < len(src) && !isSpace(src[i]) && src[i] != '=' && src[i] != '>' && !strings.HasPrefix(src[i:], "/>") {
+			i++
+		}
+		a := attr{key: strings.ToLower(src[begin:i])}
+
+		j := i
+		for j < len(src) && isSpace(src[j]) {
+			j++
+		}
+		if j < len(src) && src[j] == '=' {
+			j++
+			for j < len(src) && isSpace(src[j]) {
+				j++
+			}
+			var val string
+			if j < len(src) && (src[j] == '"' || src[j] == '\'') {
+				q := src[j]
+				end := strings.IndexByte(src[j+1:], q)
+				if end < 0 {
+					return nil, 0, false
+				}
+				val = src[j+1 : j+1+end]
+				j += end + 2
+			} else {
+				vb := j
+				for j < len(src) && !isSpace(src[j]) && src[j] != '>' {
+					j++
+				}
+				val = src[vb:j]
+			}
+			a.val = html.UnescapeString(val)
+			a.hasVal = true
+			i = j
+		}
+		a.raw = src[begin:i]
+		n.attrs = append(n.attrs, a)
+	}
+
+	return nil, 0, false
+}
+
+// readTagName reads a tag name starting at src[i] and returns it lower-cased.
+func readTagName(src string, i int) (string, int) {
+	begin := i
+	for i < len(src) && !isSpace(src[i]) && src[i] != '>' && src[i] != '/' {
+		i++
+	}
+	return strings.ToLower(src[begin:i]), i
+}
+
+// skipRawText returns the offset of the end tag closing a raw text element.
+func skipRawText(src string, i int, tag string) int {
+	lower := strings.ToLower(src[i:])
+	end := strings.Index(lower, "</"+tag)
+	if end < 0 {
+		return len(src)
+	}
+	return i + end
+}
+
+// attr returns the value of the named attribute.
+func (n *node) attr(key string) (string, bool) {
+	for _, a := range n.attrs {
+		if a.key == key {
+			return a.val, true
+		}
+	}
+	return "", false
+}
+
+// isElement reports whether n is an element rather than the document root.
+func (n *node) isElement() bool {
+	return n != nil && n.tag != ""
+}
+
+// siblings returns the element children of n's parent.
+func (n *node) siblings() []*node {
+	if n.parent == nil {
+		return []*node{n}
+	}
+	return n.parent.children
+}
+
+// prevSibling returns the element immediately preceding n.
+func (n *node) prevSibling() *node {
+	sibs := n.siblings()
+	for i, s := range sibs {
+		if s == n {
+			if i == 0 {
+				return nil
+			}
+			return sibs[i-1]
+		}
+	}
+	return nil
+}
+
+// startTag serializes n's start tag with the style attribute replaced by style.
+// Every other attribute is copied exactly as written.
+func (n *node) startTag(src, style string) string {
+	var b strings.Builder
+	b.WriteString("<")
+	b.WriteString(src[n.start+1 : n.start+1+len(n.tag)])
+
+	styled := false
+	for _, a := range n.attrs {
+		if a.key == "style" {
+			if styled {
+				continue
+			}
+			styled = true
+			b.WriteString(" ")
+			writeStyleAttr(&b, style)
+			continue
+		}
+		b.WriteString(" ")
+		b.WriteString(a.raw)
+	}
+	if !styled {
+		b.WriteString(" ")
+		writeStyleAttr(&b, style)
+	}
+
+	if n.selfClosing {
+		b.WriteString(" />")
+	} else {
+		b.WriteString(">")
+	}
+	return b.String()
+}
+
+func writeStyleAttr(b *strings.Builder, style string) {
+	b.WriteString(`style="`)
+	b.WriteString(html.EscapeString(style))
+	b.WriteString(`"`)
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isASCIILetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
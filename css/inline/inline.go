@@ -0,0 +1,223 @@
+// Package inline applies a css.Stylesheet to HTML as inline style attributes.
+//
+// Many email clients ignore <style> blocks, so transactional email templates
+// need every declaration copied onto the elements it applies to. Inline walks
+// the stylesheet in cascade order (importance, then specificity, then source
+// order) and writes the winning declarations into each element's style
+// attribute. Anything that cannot be resolved against a static document, such
+// as @media rules, :hover selectors and pseudo-elements, is kept in a residual
+// <style> block for the clients that do support it.
+//
+// Example usage:
+//
+//	out, err := inline.Inline(html, sheet, inline.Options{})
+//	if err != nil {
+//		return err
+//	}
+package inline
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Options control how declarations are inlined.
+type Options struct {
+	// KeepImportant keeps the !important flag on inlined declarations.
+	// By default the flag is dropped once the cascade has been resolved.
+	KeepImportant bool
+
+	// SkipPseudoClasses leaves every selector that uses a pseudo-class in
+	// the residual <style> block instead of inlining it. By default
+	// structural pseudo-classes such as :first-child are evaluated against
+	// the document and inlined.
+	SkipPseudoClasses bool
+}
+
+// declaration is a single candidate in an element's cascade.
+type declaration struct {
+	property  string
+	value     string
+	important bool
+	spec      specificity
+	inline    bool // from the element's existing style attribute
+	order     int
+}
+
+// Inline applies the rules in sheet to the matching elements of html and
+// returns the rewritten document. Rules that cannot be inlined are emitted in
+// a <style> block placed before </head>, after <body>, or at the start of the
+// document, in that order of preference. An error is returned if a selector
+// in sheet cannot be parsed.
+func Inline(html string, sheet css.Stylesheet, opts Options) (string, error) {
+	doc := parseHTML(html)
+	cascade := make(map[*node][]declaration)
+	var residual []css.Item
+	order := 0
+
+	for _, item := range sheet.Items {
+		rule, ok := item.(css.Rule)
+		if !ok {
+			residual = append(residual, item)
+			continue
+		}
+		if len(rule.Decls) == 0 {
+			continue
+		}
+
+		selectors, err := parseSelectorList(rule.Selector)
+		if err != nil {
+			return "", fmt.Errorf("inline: %w", err)
+		}
+
+		var kept []string
+		for _, sel := range selectors {
+			if !sel.inlinable(opts.SkipPseudoClasses) {
+				kept = append(kept, strings.TrimSpace(sel.text))
+				continue
+			}
+			spec := sel.specificity()
+			for _, el := range doc.elements {
+				if !sel.matches(el) {
+					continue
+				}
+				for _, d := range rule.Decls {
+					value, important := splitImportant(d.Value.String())
+					cascade[el] = append(cascade[el], declaration{
+						property:  string(d.Property),
+						value:     value,
+						important: important,
+						spec:      spec,
+						order:     order,
+					})
+					order++
+				}
+			}
+		}
+		if len(kept) > 0 {
+			residual = append(residual, css.RuleSet(strings.Join(kept, ", "), rule.Decls...))
+		}
+	}
+
+	return rewrite(doc, cascade, residual, opts), nil
+}
+
+// rewrite produces the output document from the resolved cascade.
+func rewrite(doc *document, cascade map[*node][]declaration, residual []css.Item, opts Options) string {
+	insertAt := 0
+	switch {
+	case doc.headClose >= 0:
+		insertAt = doc.headClose
+	case doc.bodyOpen >= 0:
+		insertAt = doc.bodyOpen
+	}
+	styleBlock := ""
+	if len(residual) > 0 {
+		styleBlock = "<style>" + css.CSS(residual...) + "</style>"
+	}
+
+	var b strings.Builder
+	prev := 0
+	inserted := styleBlock == ""
+	for _, el := range doc.elements {
+		decls, ok := cascade[el]
+		if !ok {
+			continue
+		}
+		if !inserted && insertAt <= el.start {
+			b.WriteString(doc.src[prev:insertAt])
+			b.WriteString(styleBlock)
+			prev, inserted = insertAt, true
+		}
+		b.WriteString(doc.src[prev:el.start])
+		b.WriteString(el.startTag(doc.src, resolve(el, decls, opts)))
+		prev = el.end
+	}
+	if !inserted {
+		b.WriteString(doc.src[prev:insertAt])
+		b.WriteString(styleBlock)
+		prev = insertAt
+	}
+	b.WriteString(doc.src[prev:])
+	return b.String()
+}
+
+// resolve runs the cascade for one element and serializes the winning
+// declarations as a style attribute value.
+func resolve(el *node, decls []declaration, opts Options) string {
+	if style, ok := el.attr("style"); ok {
+		for _, d := range parseStyleAttr(style) {
+			d.inline = true
+			d.order = len(decls)
+			decls = append(decls, d)
+		}
+	}
+
+	sort.SliceStable(decls, func(i, j int) bool {
+		a, b := decls[i], decls[j]
+		if a.important != b.important {
+			return !a.important
+		}
+		if a.inline != b.inline {
+			return !a.inline
+		}
+		if a.spec != b.spec {
+			return a.spec.less(b.spec)
+		}
+		return a.order < b.order
+	})
+
+	// Later declarations win. A property that is set again moves to the end
+	// so that shorthands and longhands keep their relative order.
+	var winners []declaration
+	for _, d := range decls {
+		for i, w := range winners {
+			if w.property == d.property {
+				winners = append(winners[:i], winners[i+1:]...)
+				break
+			}
+		}
+		winners = append(winners, d)
+	}
+
+	parts := make([]string, len(winners))
+	for i, d := range winners {
+		value := d.value
+		if d.important && opts.KeepImportant {
+			value += " !important"
+		}
+		parts[i] = d.property + ":" + value
+	}
+	return strings.Join(parts, ";")
+}
+
+// parseStyleAttr parses the declarations of an existing style attribute.
+func parseStyleAttr(style string) []declaration {
+	var decls []declaration
+	for _, part := range splitTopLevel(style, ';') {
+		colon := strings.IndexByte(part, ':')
+		if colon < 0 {
+			continue
+		}
+		prop := strings.ToLower(strings.TrimSpace(part[:colon]))
+		value, important := splitImportant(part[colon+1:])
+		if prop == "" || value == "" {
+			continue
+		}
+		decls = append(decls, declaration{property: prop, value: value, important: important})
+	}
+	return decls
+}
+
+// splitImportant trims v and strips a trailing !important flag.
+func splitImportant(v string) (string, bool) {
+	v = strings.TrimSpace(v)
+	const flag = "!important"
+	if len(v) >= len(flag) && strings.EqualFold(v[len(v)-len(flag):], flag) {
+		return strings.TrimSpace(v[:len(v)-len(flag)]), true
+	}
+	return v, false
+}
@@ -0,0 +1,151 @@
+package inline
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+func sheetOf(items ...css.Item) css.Stylesheet {
+	var sheet css.Stylesheet
+	sheet.Add(items...)
+	return sheet
+}
+
+func TestInlineSpecificityOrder(t *testing.T) {
+	sheet := sheetOf(
+		css.RuleSet("#cta", css.Set(css.ColorP, css.Hex("#f00"))),
+		css.RuleSet(".btn", css.Set(css.ColorP, css.Hex("#0f0")), css.Set(css.Padding, css.Px(8))),
+		css.RuleSet("a", css.Set(css.ColorP, css.Hex("#00f"))),
+	)
+	html := `<p><a id="cta" class="btn" href="#">Go</a></p>`
+
+	got, err := Inline(html, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<p><a id="cta" class="btn" href="#" style="padding:8px;color:#f00">Go</a></p>`
+	if got != want {
+		t.Errorf("Inline() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestInlineExistingStyleAndImportant(t *testing.T) {
+	sheet := sheetOf(
+		css.RuleSet("td", css.Set(css.ColorP, css.Raw("red !important")), css.Set(css.Padding, css.Px(4))),
+	)
+	html := `<table><tr><td style="color: blue; padding: 2px">x</td></tr></table>`
+
+	got, err := Inline(html, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, `style="padding:2px;color:red"`) {
+		t.Errorf("expected important stylesheet color to beat inline style, got %s", got)
+	}
+
+	got, err = Inline(html, sheet, Options{KeepImportant: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, `color:red !important`) {
+		t.Errorf("expected !important to be kept, got %s", got)
+	}
+}
+
+func TestInlineResidualStyleBlock(t *testing.T) {
+	sheet := sheetOf(
+		css.RuleSet("a, a:hover", css.Set(css.ColorP, css.Hex("#123"))),
+		css.RuleSet("p::before", css.Set(css.Property("content"), css.Raw(`"*"`))),
+		css.AtRule{Name: "media", Params: "(max-width: 600px)", Body: []css.Item{
+			css.RuleSet("a", css.Set(css.Display, css.DisplayBlock)),
+		}},
+	)
+	html := `<html><head><title>t</title></head><body><p><a href="#">x</a></p></body></html>`
+
+	got, err := Inline(html, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantStyle := `<style>a:hover{color:#123}p::before{content:"*"}@media (max-width: 600px){a{display:block}}</style></head>`
+	if !strings.Contains(got, wantStyle) {
+		t.Errorf("expected residual style block before </head>, got %s", got)
+	}
+	if !strings.Contains(got, `<a href="#" style="color:#123">`) {
+		t.Errorf("expected plain selector to be inlined, got %s", got)
+	}
+}
+
+func TestInlineStructuralPseudoClasses(t *testing.T) {
+	sheet := sheetOf(
+		css.RuleSet("li:first-child", css.Set(css.Margin, css.Px(0))),
+		css.RuleSet("li:nth-child(even)", css.Set(css.ColorP, css.Hex("#999"))),
+	)
+	html := `<ul><li>a</li><li>b</li><li>c</li></ul>`
+
+	got, err := Inline(html, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<ul><li style="margin:0px">a</li><li style="color:#999">b</li><li>c</li></ul>`
+	if got != want {
+		t.Errorf("Inline() =\n%s\nwant\n%s", got, want)
+	}
+
+	got, err = Inline(html, sheet, Options{SkipPseudoClasses: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want = `<style>li:first-child{margin:0px}li:nth-child(even){color:#999}</style><ul><li>a</li><li>b</li><li>c</li></ul>`
+	if got != want {
+		t.Errorf("Inline(SkipPseudoClasses) =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestInlineCombinators(t *testing.T) {
+	sheet := sheetOf(
+		css.RuleSet("table > tr > td", css.Set(css.TextAlign, css.TextLeft)),
+		css.RuleSet("h1 + p", css.Set(css.FontSize, css.Px(18))),
+		css.RuleSet("h1 ~ p.note", css.Set(css.ColorP, css.Hex("#666"))),
+		css.RuleSet("div [data-role=\"footer\"]", css.Set(css.FontSize, css.Px(12))),
+	)
+	html := `<div><h1>T</h1><p>a</p><p class="note">b</p><span data-role="footer">c</span></div>` +
+		`<table><tr><td>x</td></tr></table><br/>`
+
+	got, err := Inline(html, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`<p style="font-size:18px">a</p>`,
+		`<p class="note" style="color:#666">b</p>`,
+		`<span data-role="footer" style="font-size:12px">c</span>`,
+		`<td style="text-align:left">x</td>`,
+		`<br/>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected %s in output, got %s", want, got)
+		}
+	}
+}
+
+func TestInlineEscapesAttributeValues(t *testing.T) {
+	sheet := sheetOf(css.RuleSet("p", css.Set(css.FontFamily, css.Raw(`"Helvetica Neue", sans-serif`))))
+
+	got, err := Inline(`<P>x</P>`, sheet, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<P style="font-family:&#34;Helvetica Neue&#34;, sans-serif">x</P>`
+	if got != want {
+		t.Errorf("Inline() = %s, want %s", got, want)
+	}
+}
+
+func TestInlineInvalidSelector(t *testing.T) {
+	sheet := sheetOf(css.RuleSet("a[href", css.Set(css.ColorP, css.Hex("#000"))))
+	if _, err := Inline(`<a href="#">x</a>`, sheet, Options{}); err == nil {
+		t.Error("expected an error for an unterminated attribute selector")
+	}
+}
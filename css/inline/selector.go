@@ -0,0 +1,647 @@
+package inline
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// selector is a complex selector: compound selectors joined by combinators.
+type selector struct {
+	text  string
+	parts []compound
+	combs []byte // combs[i] joins parts[i] and parts[i+1]: ' ', '>', '+' or '~'
+}
+
+// compound is a sequence of simple selectors that all apply to one element.
+type compound struct {
+	tag           string // lower-case; empty or "*" matches any element
+	id            string
+	classes       []string
+	attrs         []attrSelector
+	pseudos       []pseudoClass
+	pseudoElement bool
+}
+
+type attrSelector struct {
+	name string
+	op   string // "", "=", "~=", "|=", "^=", "$=", "*="
+	val  string
+}
+
+type pseudoClass struct {
+	name string
+	not  []*selector // argument of :not()
+	a, b int         // an+b argument of the :nth-* family
+}
+
+// specificity is the (id, class, type) triple used to order the cascade.
+type specificity [3]int
+
+func (s specificity) less(o specificity) bool {
+	for i := range s {
+		if s[i] != o[i] {
+			return s[i] < o[i]
+		}
+	}
+	return false
+}
+
+// structuralPseudoClasses can be evaluated against the static document and
+// therefore inlined. Every other pseudo-class depends on user interaction or
+// browser state and has to stay in a <style> block.
+var structuralPseudoClasses = map[string]bool{
+	"root": true, "first-child": true, "last-child": true, "only-child": true,
+	"first-of-type": true, "last-of-type": true, "only-of-type": true,
+	"nth-child": true, "nth-last-child": true, "nth-of-type": true, "nth-last-of-type": true,
+	"not": true,
+}
+
+// legacyPseudoElements may be written with a single colon.
+var legacyPseudoElements = map[string]bool{
+	"before": true, "after": true, "first-line": true, "first-letter": true,
+}
+
+// parseSelectorList parses a comma-separated selector list.
+func parseSelectorList(s string) ([]*selector, error) {
+	var list []*selector
+	for _, part := range splitTopLevel(s, ',') {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("empty selector in %q", s)
+		}
+		sel, err := parseSelector(part)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sel)
+	}
+	return list, nil
+}
+
+// parseSelector parses a single complex selector.
+func parseSelector(s string) (*selector, error) {
+	p := &selectorParser{src: s}
+	sel := &selector{text: s}
+
+	p.skipSpace()
+	for {
+		c, err := p.compound()
+		if err != nil {
+			return nil, err
+		}
+		sel.parts = append(sel.parts, c)
+
+		hadSpace := p.skipSpace()
+		if p.eof() {
+			return sel, nil
+		}
+		comb := byte(' ')
+		if ch := p.peek(); ch == '>' || ch == '+' || ch == '~' {
+			comb = ch
+			p.pos++
+			p.skipSpace()
+		} else if !hadSpace {
+			return nil, fmt.Errorf("unexpected %q in selector %q", ch, s)
+		}
+		sel.combs = append(sel.combs, comb)
+	}
+}
+
+type selectorParser struct {
+	src string
+	pos int
+}
+
+func (p *selectorParser) eof() bool  { return p.pos >= len(p.src) }
+func (p *selectorParser) peek() byte { return p.src[p.pos] }
+
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for !p.eof() && isSpace(p.peek()) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func (p *selectorParser) compound() (compound, error) {
+	var c compound
+	start := p.pos
+
+	if !p.eof() && p.peek() == '*' {
+		c.tag = "*"
+		p.pos++
+	} else if !p.eof() && isIdentStart(p.peek()) {
+		c.tag = strings.ToLower(p.ident())
+	}
+
+	for !p.eof() {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			c.id = p.ident()
+			if c.id == "" {
+				return c, fmt.Errorf("missing id after # in selector %q", p.src)
+			}
+		case '.':
+			p.pos++
+			class := p.ident()
+			if class == "" {
+				return c, fmt.Errorf("missing class name after . in selector %q", p.src)
+			}
+			c.classes = append(c.classes, class)
+		case '[':
+			a, err := p.attribute()
+			if err != nil {
+				return c, err
+			}
+			c.attrs = append(c.attrs, a)
+		case ':':
+			p.pos++
+			if !p.eof() && p.peek() == ':' {
+				p.pos++
+				p.ident()
+				p.skipArgs()
+				c.pseudoElement = true
+				continue
+			}
+			pc, err := p.pseudoClass()
+			if err != nil {
+				return c, err
+			}
+			if legacyPseudoElements[pc.name] {
+				c.pseudoElement = true
+				continue
+			}
+			c.pseudos = append(c.pseudos, pc)
+		default:
+			if p.pos == start {
+				return c, fmt.Errorf("unexpected %q in selector %q", p.peek(), p.src)
+			}
+			return c, nil
+		}
+	}
+
+	if p.pos == start {
+		return c, fmt.Errorf("empty compound selector in %q", p.src)
+	}
+	return c, nil
+}
+
+func (p *selectorParser) attribute() (attrSelector, error) {
+	end := indexClosing(p.src, p.pos, '[', ']')
+	if end < 0 {
+		return attrSelector{}, fmt.Errorf("unterminated attribute selector in %q", p.src)
+	}
+	body := strings.TrimSpace(p.src[p.pos+1 : end])
+	p.pos = end + 1
+
+	// A trailing " i" or " s" flag is accepted and ignored.
+	if n := len(body); n > 2 && isSpace(body[n-2]) && (body[n-1] == 'i' || body[n-1] == 's') {
+		body = strings.TrimSpace(body[:n-2])
+	}
+
+	for _, op := range []string{"~=", "|=", "^=", "$=", "*=", "="} {
+		if i := strings.Index(body, op); i > 0 {
+			val := strings.TrimSpace(body[i+len(op):])
+			if len(val) >= 2 && (val[0] == '"' || val[0] == '\'') && val[len(val)-1] == val[0] {
+				val = val[1 : len(val)-1]
+			}
+			return attrSelector{
+				name: strings.ToLower(strings.TrimSpace(body[:i])),
+				op:   op,
+				val:  unescapeIdent(val),
+			}, nil
+		}
+	}
+	if body == "" {
+		return attrSelector{}, fmt.Errorf("empty attribute selector in %q", p.src)
+	}
+	return attrSelector{name: strings.ToLower(body)}, nil
+}
+
+func (p *selectorParser) pseudoClass() (pseudoClass, error) {
+	pc := pseudoClass{name: strings.ToLower(p.ident())}
+	if pc.name == "" {
+		return pc, fmt.Errorf("missing pseudo-class name in selector %q", p.src)
+	}
+	if p.eof() || p.peek() != '(' {
+		return pc, nil
+	}
+
+	end := indexClosing(p.src, p.pos, '(', ')')
+	if end < 0 {
+		return pc, fmt.Errorf("unterminated :%s() in selector %q", pc.name, p.src)
+	}
+	arg := strings.TrimSpace(p.src[p.pos+1 : end])
+	p.pos = end + 1
+
+	switch pc.name {
+	case "not":
+		list, err := parseSelectorList(arg)
+		if err != nil {
+			return pc, err
+		}
+		pc.not = list
+	case "nth-child", "nth-last-child", "nth-of-type", "nth-last-of-type":
+		a, b, ok := parseNth(arg)
+		if !ok {
+			return pc, fmt.Errorf("invalid argument %q to :%s() in selector %q", arg, pc.name, p.src)
+		}
+		pc.a, pc.b = a, b
+	}
+	return pc, nil
+}
+
+// skipArgs skips a parenthesized argument list, if present.
+func (p *selectorParser) skipArgs() {
+	if p.eof() || p.peek() != '(' {
+		return
+	}
+	if end := indexClosing(p.src, p.pos, '(', ')'); end >= 0 {
+		p.pos = end + 1
+	} else {
+		p.pos = len(p.src)
+	}
+}
+
+// ident reads a CSS identifier, resolving backslash escapes such as the
+// "\:" used by variant class names.
+func (p *selectorParser) ident() string {
+	var b strings.Builder
+	for !p.eof() {
+		ch := p.peek()
+		switch {
+		case ch == '\\' && p.pos+1 < len(p.src):
+			p.pos++
+			if isHex(p.peek()) {
+				start := p.pos
+				for !p.eof() && p.pos-start < 6 && isHex(p.peek()) {
+					p.pos++
+				}
+				r, _ := strconv.ParseUint(p.src[start:p.pos], 16, 32)
+				b.WriteRune(rune(r))
+				if !p.eof() && isSpace(p.peek()) {
+					p.pos++
+				}
+				continue
+			}
+			b.WriteByte(p.peek())
+			p.pos++
+		case isIdentChar(ch):
+			b.WriteByte(ch)
+			p.pos++
+		default:
+			return b.String()
+		}
+	}
+	return b.String()
+}
+
+// specificity computes the selector's specificity.
+func (s *selector) specificity() specificity {
+	var spec specificity
+	for _, c := range s.parts {
+		if c.id != "" {
+			spec[0]++
+		}
+		spec[1] += len(c.classes) + len(c.attrs)
+		for _, pc := range c.pseudos {
+			if pc.name == "not" {
+				var max specificity
+				for _, inner := range pc.not {
+					if is := inner.specificity(); max.less(is) {
+						max = is
+					}
+				}
+				for i := range spec {
+					spec[i] += max[i]
+				}
+				continue
+			}
+			spec[1]++
+		}
+		if c.tag != "" && c.tag != "*" {
+			spec[2]++
+		}
+		if c.pseudoElement {
+			spec[2]++
+		}
+	}
+	return spec
+}
+
+// inlinable reports whether the selector can be resolved against a static
+// document. Pseudo-elements and state-dependent pseudo-classes cannot; when
+// skipPseudo is set no pseudo-class is considered inlinable.
+func (s *selector) inlinable(skipPseudo bool) bool {
+	for _, c := range s.parts {
+		if c.pseudoElement {
+			return false
+		}
+		for _, pc := range c.pseudos {
+			if skipPseudo || !structuralPseudoClasses[pc.name] {
+				return false
+			}
+			for _, inner := range pc.not {
+				if !inner.inlinable(skipPseudo) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// matches reports whether the selector matches element n.
+func (s *selector) matches(n *node) bool {
+	return s.matchFrom(len(s.parts)-1, n)
+}
+
+func (s *selector) matchFrom(i int, n *node) bool {
+	if !s.parts[i].matches(n) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+
+	switch s.combs[i-1] {
+	case '>':
+		return n.parent.isElement() && s.matchFrom(i-1, n.parent)
+	case '+':
+		prev := n.prevSibling()
+		return prev != nil && s.matchFrom(i-1, prev)
+	case '~':
+		for prev := n.prevSibling(); prev != nil; prev = prev.prevSibling() {
+			if s.matchFrom(i-1, prev) {
+				return true
+			}
+		}
+		return false
+	default:
+		for anc := n.parent; anc.isElement(); anc = anc.parent {
+			if s.matchFrom(i-1, anc) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (c compound) matches(n *node) bool {
+	if c.tag != "" && c.tag != "*" && c.tag != n.tag {
+		return false
+	}
+	if c.id != "" {
+		if id, _ := n.attr("id"); id != c.id {
+			return false
+		}
+	}
+	if len(c.classes) > 0 {
+		class, _ := n.attr("class")
+		have := strings.Fields(class)
+		for _, want := range c.classes {
+			if !containsString(have, want) {
+				return false
+			}
+		}
+	}
+	for _, a := range c.attrs {
+		if !a.matches(n) {
+			return false
+		}
+	}
+	for _, pc := range c.pseudos {
+		if !pc.matches(n) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a attrSelector) matches(n *node) bool {
+	v, ok := n.attr(a.name)
+	if !ok {
+		return false
+	}
+	switch a.op {
+	case "":
+		return true
+	case "=":
+		return v == a.val
+	case "~=":
+		return containsString(strings.Fields(v), a.val)
+	case "|=":
+		return v == a.val || strings.HasPrefix(v, a.val+"-")
+	case "^=":
+		return a.val != "" && strings.HasPrefix(v, a.val)
+	case "$=":
+		return a.val != "" && strings.HasSuffix(v, a.val)
+	case "*=":
+		return a.val != "" && strings.Contains(v, a.val)
+	}
+	return false
+}
+
+func (pc pseudoClass) matches(n *node) bool {
+	switch pc.name {
+	case "root":
+		return !n.parent.isElement()
+	case "first-child":
+		return position(n, false, false) == 1
+	case "last-child":
+		return position(n, true, false) == 1
+	case "only-child":
+		return position(n, false, false) == 1 && position(n, true, false) == 1
+	case "first-of-type":
+		return position(n, false, true) == 1
+	case "last-of-type":
+		return position(n, true, true) == 1
+	case "only-of-type":
+		return position(n, false, true) == 1 && position(n, true, true) == 1
+	case "nth-child":
+		return nthMatches(pc.a, pc.b, position(n, false, false))
+	case "nth-last-child":
+		return nthMatches(pc.a, pc.b, position(n, true, false))
+	case "nth-of-type":
+		return nthMatches(pc.a, pc.b, position(n, false, true))
+	case "nth-last-of-type":
+		return nthMatches(pc.a, pc.b, position(n, true, true))
+	case "not":
+		for _, inner := range pc.not {
+			if inner.matches(n) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// position returns n's 1-based index among its siblings, counted from the
+// end when fromEnd is set and only among same-tag siblings when ofType is set.
+func position(n *node, fromEnd, ofType bool) int {
+	sibs := n.siblings()
+	pos := 0
+	for i := range sibs {
+		s := sibs[i]
+		if fromEnd {
+			s = sibs[len(sibs)-1-i]
+		}
+		if ofType && s.tag != n.tag {
+			continue
+		}
+		pos++
+		if s == n {
+			return pos
+		}
+	}
+	return 0
+}
+
+// parseNth parses the an+b argument of the :nth-* pseudo-classes.
+func parseNth(arg string) (a, b int, ok bool) {
+	arg = strings.ToLower(strings.ReplaceAll(arg, " ", ""))
+	switch arg {
+	case "odd":
+		return 2, 1, true
+	case "even":
+		return 2, 0, true
+	}
+
+	i := strings.IndexByte(arg, 'n')
+	if i < 0 {
+		n, err := strconv.Atoi(arg)
+		return 0, n, err == nil
+	}
+
+	switch coef := arg[:i]; coef {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		n, err := strconv.Atoi(coef)
+		if err != nil {
+			return 0, 0, false
+		}
+		a = n
+	}
+	if rest := arg[i+1:]; rest != "" {
+		n, err := strconv.Atoi(rest)
+		if err != nil {
+			return 0, 0, false
+		}
+		b = n
+	}
+	return a, b, true
+}
+
+// nthMatches reports whether pos = a*n + b for some n >= 0.
+func nthMatches(a, b, pos int) bool {
+	if a == 0 {
+		return pos == b
+	}
+	diff := pos - b
+	return diff%a == 0 && diff/a >= 0
+}
+
+// splitTopLevel splits s on sep, ignoring separators nested in brackets,
+// parentheses or quotes.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	depth, start := 0, 0
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '\\':
+			i++
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == '(' || ch == '[':
+			depth++
+		case ch == ')' || ch == ']':
+			depth--
+		case ch == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// indexClosing returns the index of the close bracket matching the open
+// bracket at s[i], or -1.
+func indexClosing(s string, i int, open, close byte) int {
+	depth := 0
+	var quote byte
+	for ; i < len(s); i++ {
+		ch := s[i]
+		switch {
+		case quote != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == quote {
+				quote = 0
+			}
+		case ch == '\\':
+			i++
+		case ch == '"' || ch == '\'':
+			quote = ch
+		case ch == open:
+			depth++
+		case ch == close:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func unescapeIdent(s string) string {
+	if !strings.Contains(s, "\\") {
+		return s
+	}
+	p := &selectorParser{src: s}
+	var b strings.Builder
+	for !p.eof() {
+		if id := p.ident(); id != "" {
+			b.WriteString(id)
+			continue
+		}
+		b.WriteByte(p.peek())
+		p.pos++
+	}
+	return b.String()
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdentStart(c byte) bool {
+	return isASCIILetter(c) || c == '_' || c == '-' || c == '\\' || c >= 0x80
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || (c >= '0' && c <= '9')
+}
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
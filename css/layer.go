@@ -0,0 +1,111 @@
+package css
+
+import (
+	"strings"
+	"sync"
+)
+
+// LayeredStylesheet groups items into cascade layers (@layer) declared in a
+// fixed order. Items can be added to any layer at any time; serialization
+// always emits a single @layer order statement followed by one block per
+// layer, regardless of the order in which items were added.
+type LayeredStylesheet struct {
+	mu        sync.Mutex
+	order     []string
+	layers    map[string][]Item
+	unlayered []Item
+}
+
+// NewLayeredStylesheet creates a layered stylesheet with the given layer
+// order, lowest priority first.
+// e.g., NewLayeredStylesheet("base", "components", "utilities")
+func NewLayeredStylesheet(order ...string) *LayeredStylesheet {
+	l := &LayeredStylesheet{layers: make(map[string][]Item)}
+	for _, name := range order {
+		l.declare(name)
+	}
+	return l
+}
+
+// declare appends a layer to the order if it is not already known.
+func (l *LayeredStylesheet) declare(name string) {
+	if _, ok := l.layers[name]; ok {
+		return
+	}
+	l.order = append(l.order, name)
+	l.layers[name] = nil
+}
+
+// Add appends items to the named layer. As in CSS, a layer that was not
+// declared up front is appended to the end of the order.
+func (l *LayeredStylesheet) Add(layer string, items ...Item) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.declare(layer)
+	l.layers[layer] = append(l.layers[layer], items...)
+}
+
+// AddUnlayered appends items outside of any layer. Unlayered styles take
+// precedence over every layer.
+func (l *LayeredStylesheet) AddUnlayered(items ...Item) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.unlayered = append(l.unlayered, items...)
+}
+
+// Order returns the declared layer order, lowest priority first.
+func (l *LayeredStylesheet) Order() []string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]string(nil), l.order...)
+}
+
+// Layer returns the items added to the named layer.
+func (l *LayeredStylesheet) Layer(name string) []Item {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Item(nil), l.layers[name]...)
+}
+
+// Stylesheet returns the layered form: an @layer order statement, one
+// @layer block per non-empty layer, then the unlayered items.
+func (l *LayeredStylesheet) Stylesheet() Stylesheet {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var s Stylesheet
+	if len(l.order) > 0 {
+		s.Add(AtRule{Name: "layer", Params: strings.Join(l.order, ", ")})
+	}
+	for _, name := range l.order {
+		if items := l.layers[name]; len(items) > 0 {
+			s.Add(AtRule{Name: "layer", Params: name, Body: append([]Item(nil), items...)})
+		}
+	}
+	s.Add(l.unlayered...)
+	return s
+}
+
+// Flatten returns the items without @layer wrappers, ordered so that source
+// order reproduces the layer priority for browsers without @layer support.
+// Note that flattening cannot reproduce the reversed layer priority of
+// !important declarations.
+func (l *LayeredStylesheet) Flatten() Stylesheet {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var s Stylesheet
+	for _, name := range l.order {
+		s.Add(l.layers[name]...)
+	}
+	s.Add(l.unlayered...)
+	return s
+}
+
+func (l *LayeredStylesheet) String() string {
+	return l.Stylesheet().String()
+}
@@ -0,0 +1,369 @@
+// Package modules scopes class names and keyframes to a namespace, in the
+// spirit of CSS Modules.
+//
+// Every class selector and @keyframes name in a stylesheet is rewritten to a
+// deterministic hashed name derived from the namespace, so components can use
+// short local names without colliding:
+//
+//	mod := modules.Scope(sheet, "button")
+//	mod.Class("btn") // "btn_e1d401"
+//
+// Selectors wrapped in :global(...) are left untouched. GoSource renders the
+// name mapping as Go constants so templates can refer to styles.Btn instead of
+// a string.
+package modules
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Module is a stylesheet whose local names have been scoped to a namespace.
+type Module struct {
+	// Namespace is the namespace the names were hashed with.
+	Namespace string
+
+	// Sheet is the rewritten stylesheet.
+	Sheet css.Stylesheet
+
+	// Classes maps each local class name to its scoped name.
+	Classes map[string]string
+
+	// Keyframes maps each local @keyframes name to its scoped name.
+	Keyframes map[string]string
+}
+
+// Scope rewrites the class selectors and @keyframes names in sheet to hashed
+// names unique to namespace. The same namespace and name always produce the
+// same scoped name. The input stylesheet is not modified.
+func Scope(sheet css.Stylesheet, namespace string) Module {
+	m := Module{
+		Namespace: namespace,
+		Classes:   make(map[string]string),
+		Keyframes: make(map[string]string),
+	}
+
+	// Keyframes are collected first so animation declarations that appear
+	// before their @keyframes rule are rewritten too.
+	collectKeyframes(sheet.Items, &m)
+	m.Sheet.Items = m.scopeItems(sheet.Items)
+	return m
+}
+
+// Class returns the scoped name for a local class name, or the name
+// unchanged if the stylesheet does not define it.
+func (m Module) Class(name string) string {
+	if scoped, ok := m.Classes[name]; ok {
+		return scoped
+	}
+	return name
+}
+
+// Keyframe returns the scoped name for a local @keyframes name, or the name
+// unchanged if the stylesheet does not define it.
+func (m Module) Keyframe(name string) string {
+	if scoped, ok := m.Keyframes[name]; ok {
+		return scoped
+	}
+	return name
+}
+
+// ScopedName returns the hashed name for name within namespace.
+// e.g., ScopedName("button", "btn") -> "btn_e1d401"
+func ScopedName(namespace, name string) string {
+	sum := sha256.Sum256([]byte(namespace + "\x00" + name))
+	return name + "_" + hex.EncodeToString(sum[:3])
+}
+
+func collectKeyframes(items []css.Item, m *Module) {
+	for _, item := range items {
+		at, ok := item.(css.AtRule)
+		if !ok {
+			continue
+		}
+		if isKeyframes(at.Name) {
+			name := strings.TrimSpace(at.Params)
+			if name != "" && !isGlobal(name) {
+				m.Keyframes[name] = ScopedName(m.Namespace, name)
+			}
+			continue
+		}
+		collectKeyframes(at.Body, m)
+	}
+}
+
+func (m *Module) scopeItems(items []css.Item) []css.Item {
+	out := make([]css.Item, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case css.Rule:
+			out = append(out, css.Rule{
+				Selector: m.scopeSelector(v.Selector),
+				Decls:    m.scopeDecls(v.Decls),
+			})
+		case css.AtRule:
+			at := css.AtRule{Name: v.Name, Params: v.Params}
+			if isKeyframes(v.Name) {
+				name := strings.TrimSpace(v.Params)
+				if isGlobal(name) {
+					at.Params = unwrapGlobal(name)
+				} else {
+					at.Params = m.Keyframe(name)
+				}
+				// Keyframe selectors (from, to, 50%) are not class names.
+				at.Body = v.Body
+			} else {
+				at.Body = m.scopeItems(v.Body)
+			}
+			out = append(out, at)
+		default:
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func (m *Module) scopeDecls(decls []css.Decl) []css.Decl {
+	out := make([]css.Decl, len(decls))
+	for i, d := range decls {
+		out[i] = d
+		if len(m.Keyframes) == 0 {
+			continue
+		}
+		switch d.Property {
+		case "animation", "animation-name":
+			out[i].Value = css.Raw(m.scopeAnimation(d.Value.String()))
+		}
+	}
+	return out
+}
+
+// scopeAnimation replaces keyframe names appearing as whole tokens in an
+// animation or animation-name value.
+func (m *Module) scopeAnimation(value string) string {
+	var b strings.Builder
+	i := 0
+	for i < len(value) {
+		if !isNameChar(value[i]) {
+			b.WriteByte(value[i])
+			i++
+			continue
+		}
+		start := i
+		for i < len(value) && isNameChar(value[i]) {
+			i++
+		}
+		b.WriteString(m.Keyframe(value[start:i]))
+	}
+	return b.String()
+}
+
+// scopeSelector rewrites every class in selector to its scoped name,
+// registering new classes as they are found.
+func (m *Module) scopeSelector(selector string) string {
+	var b strings.Builder
+	i := 0
+	for i < len(selector) {
+		ch := selector[i]
+		switch {
+		case ch == '"' || ch == '\'':
+			end := skipString(selector, i)
+			b.WriteString(selector[i:end])
+			i = end
+		case ch == '[':
+			end := strings.IndexByte(selector[i:], ']')
+			if end < 0 {
+				b.WriteString(selector[i:])
+				return b.String()
+			}
+			b.WriteString(selector[i : i+end+1])
+			i += end + 1
+		case strings.HasPrefix(selector[i:], ":global("):
+			end := closingParen(selector, i+len(":global"))
+			if end < 0 {
+				b.WriteString(selector[i:])
+				return b.String()
+			}
+			b.WriteString(selector[i+len(":global(") : end])
+			i = end + 1
+		case ch == '\\' && i+1 < len(selector):
+			b.WriteString(selector[i : i+2])
+			i += 2
+		case ch == '.' && i+1 < len(selector) && isIdentStart(selector[i+1]):
+			name, end := readIdent(selector, i+1)
+			scoped, ok := m.Classes[name]
+			if !ok {
+				scoped = ScopedName(m.Namespace, name)
+				m.Classes[name] = scoped
+			}
+			b.WriteByte('.')
+			b.WriteString(escapeIdent(scoped))
+			i = end
+		default:
+			b.WriteByte(ch)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// GoSource renders the module's scoped names as a Go source file in package
+// pkg. Each class becomes a string constant named after the local class
+// (btn-primary -> BtnPrimary) and each keyframe a constant with a Keyframes
+// suffix (spin -> SpinKeyframes). An error is returned if two names map to
+// the same identifier.
+func (m Module) GoSource(pkg string) ([]byte, error) {
+	var buf strings.Builder
+	buf.WriteString("// Code generated by css/modules; DO NOT EDIT.\n")
+	buf.WriteString(fmt.Sprintf("// Source: namespace %q\n\n", m.Namespace))
+	buf.WriteString(fmt.Sprintf("package %s\n\n", pkg))
+
+	seen := make(map[string]string)
+	writeConsts := func(comment string, names map[string]string, suffix string) error {
+		if len(names) == 0 {
+			return nil
+		}
+		keys := make([]string, 0, len(names))
+		for k := range names {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		buf.WriteString(comment)
+		buf.WriteString("const (\n")
+		for _, k := range keys {
+			ident := goIdent(k) + suffix
+			if prev, dup := seen[ident]; dup {
+				return fmt.Errorf("modules: %q and %q both map to Go identifier %s", prev, k, ident)
+			}
+			seen[ident] = k
+			buf.WriteString(fmt.Sprintf("\t%s = %q\n", ident, names[k]))
+		}
+		buf.WriteString(")\n\n")
+		return nil
+	}
+
+	if err := writeConsts("// Scoped class names.\n", m.Classes, ""); err != nil {
+		return nil, err
+	}
+	if err := writeConsts("// Scoped keyframes names.\n", m.Keyframes, "Keyframes"); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source([]byte(buf.String()))
+	if err != nil {
+		return nil, fmt.Errorf("modules: failed to format generated source: %w", err)
+	}
+	return formatted, nil
+}
+
+// goIdent converts a CSS name to an exported Go identifier.
+// e.g., "btn-primary" -> "BtnPrimary", "md:p-4" -> "MdP4"
+func goIdent(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	ident := b.String()
+	if ident == "" || unicode.IsDigit(rune(ident[0])) {
+		ident = "C" + ident
+	}
+	return ident
+}
+
+func isKeyframes(name string) bool {
+	return name == "keyframes" || strings.HasSuffix(name, "-keyframes")
+}
+
+func isGlobal(name string) bool {
+	return strings.HasPrefix(name, ":global(") && strings.HasSuffix(name, ")")
+}
+
+func unwrapGlobal(name string) string {
+	return strings.TrimSpace(name[len(":global(") : len(name)-1])
+}
+
+// readIdent reads a CSS identifier starting at s[i], resolving escapes.
+func readIdent(s string, i int) (string, int) {
+	var b strings.Builder
+	for i < len(s) {
+		ch := s[i]
+		if ch == '\\' && i+1 < len(s) {
+			b.WriteByte(s[i+1])
+			i += 2
+			continue
+		}
+		if !isNameChar(ch) {
+			break
+		}
+		b.WriteByte(ch)
+		i++
+	}
+	return b.String(), i
+}
+
+// escapeIdent escapes the characters of name that are not valid in a CSS
+// identifier, such as the ':' in variant class names.
+func escapeIdent(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		if !isNameChar(name[i]) {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(name[i])
+	}
+	return b.String()
+}
+
+func skipString(s string, i int) int {
+	quote := s[i]
+	for j := i + 1; j < len(s); j++ {
+		switch s[j] {
+		case '\\':
+			j++
+		case quote:
+			return j + 1
+		}
+	}
+	return len(s)
+}
+
+func closingParen(s string, i int) int {
+	depth := 0
+	for ; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isIdentStart(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c == '_' || c == '-' || c == '\\' || c >= 0x80
+}
+
+func isNameChar(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '_' || c == '-' || c >= 0x80
+}
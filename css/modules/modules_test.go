@@ -0,0 +1,97 @@
+package modules
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+func testSheet() css.Stylesheet {
+	var sheet css.Stylesheet
+	sheet.Add(
+		css.RuleSet(".btn", css.Set(css.Display, css.DisplayBlock), css.Set("animation", css.Raw("spin 1s linear infinite"))),
+		css.RuleSet(".btn-primary:hover > .icon, :global(.theme-dark) .btn", css.Set(css.ColorP, css.Hex("#fff"))),
+		css.RuleSet(`a[class=".btn"]`, css.Set(css.ColorP, css.Hex("#000"))),
+		css.AtRule{Name: "media", Params: "(min-width: 640px)", Body: []css.Item{
+			css.RuleSet(`.md\:btn`, css.Set(css.Padding, css.Px(4))),
+		}},
+		css.AtRule{Name: "keyframes", Params: "spin", Body: []css.Item{
+			css.RuleSet("from", css.Set("transform", css.Raw("rotate(0deg)"))),
+			css.RuleSet("to", css.Set("transform", css.Raw("rotate(360deg)"))),
+		}},
+	)
+	return sheet
+}
+
+func TestScopedNameIsDeterministic(t *testing.T) {
+	a := ScopedName("button", "btn")
+	if a != ScopedName("button", "btn") {
+		t.Fatal("ScopedName should be deterministic")
+	}
+	if a == ScopedName("card", "btn") {
+		t.Error("different namespaces should produce different names")
+	}
+	if !strings.HasPrefix(a, "btn_") || len(a) != len("btn_")+6 {
+		t.Errorf("unexpected scoped name %q", a)
+	}
+}
+
+func TestScopeRewritesClassesAndKeyframes(t *testing.T) {
+	mod := Scope(testSheet(), "button")
+
+	btn := ScopedName("button", "btn")
+	primary := ScopedName("button", "btn-primary")
+	icon := ScopedName("button", "icon")
+	spin := ScopedName("button", "spin")
+	md := ScopedName("button", "md:btn")
+
+	want := "." + btn + "{display:block;animation:" + spin + " 1s linear infinite}" +
+		"." + primary + ":hover > ." + icon + ", .theme-dark ." + btn + "{color:#fff}" +
+		`a[class=".btn"]{color:#000}` +
+		"@media (min-width: 640px){." + strings.Replace(md, ":", `\:`, 1) + "{padding:4px}}" +
+		"@keyframes " + spin + "{from{transform:rotate(0deg)}to{transform:rotate(360deg)}}"
+	if got := mod.Sheet.String(); got != want {
+		t.Errorf("Scope() =\n%s\nwant\n%s", got, want)
+	}
+
+	if mod.Class("btn") != btn || mod.Keyframe("spin") != spin {
+		t.Error("Class/Keyframe lookups should return scoped names")
+	}
+	if _, ok := mod.Classes["theme-dark"]; ok {
+		t.Error(":global classes should not be scoped")
+	}
+	if mod.Class("unknown") != "unknown" {
+		t.Error("unknown classes should be returned unchanged")
+	}
+}
+
+func TestGoSource(t *testing.T) {
+	src, err := Scope(testSheet(), "button").GoSource("styles")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+	for _, want := range []string{
+		"package styles",
+		"Btn        = \"" + ScopedName("button", "btn") + "\"",
+		"BtnPrimary = ",
+		"MdBtn      = ",
+		"SpinKeyframes = \"" + ScopedName("button", "spin") + "\"",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("generated source missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestGoSourceCollision(t *testing.T) {
+	var sheet css.Stylesheet
+	sheet.Add(
+		css.RuleSet(".btn-primary", css.Set(css.Display, css.DisplayBlock)),
+		css.RuleSet(".btn_primary", css.Set(css.Display, css.DisplayBlock)),
+	)
+	if _, err := Scope(sheet, "x").GoSource("styles"); err == nil {
+		t.Error("expected an identifier collision error")
+	}
+}
@@ -0,0 +1,200 @@
+// Package registry collects the styles of the components rendered on a page.
+//
+// Components register their css.Items under a key once, typically at package
+// initialization. Each request attaches a Collector to its context.Context;
+// as components render they record their key with Use, and at the end the
+// collector emits a stylesheet containing only the styles that page needs.
+//
+// Example usage:
+//
+//	func init() {
+//		registry.Register("button", css.RuleSet(".btn", ...))
+//	}
+//
+//	func handler(w http.ResponseWriter, r *http.Request) {
+//		ctx, styles := registry.DefaultRegistry().NewContext(r.Context())
+//		body := renderPage(ctx) // components call registry.Use(ctx, "button")
+//		fmt.Fprintf(w, "<style>%s</style>%s", styles.Stylesheet().String(), body)
+//	}
+package registry
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Registry maps component keys to their styles. It is safe for concurrent use.
+type Registry struct {
+	mu         sync.RWMutex
+	components map[string]component
+}
+
+type component struct {
+	items []css.Item
+	seq   int // registration order, used to keep output stable
+}
+
+// NewRegistry creates an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{components: make(map[string]component)}
+}
+
+// Register records the styles for a component key. Only the first
+// registration of a key takes effect; it reports whether this call did.
+func (r *Registry) Register(key string, items ...css.Item) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.components[key]; exists {
+		return false
+	}
+	r.components[key] = component{
+		items: append([]css.Item(nil), items...), // defensive copy
+		seq:   len(r.components),
+	}
+	return true
+}
+
+// Lookup returns the items registered under key.
+func (r *Registry) Lookup(key string) ([]css.Item, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.components[key]
+	return c.items, ok
+}
+
+// Keys returns the registered keys in registration order.
+func (r *Registry) Keys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]string, 0, len(r.components))
+	for key := range r.components {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return r.components[keys[i]].seq < r.components[keys[j]].seq
+	})
+	return keys
+}
+
+// NewCollector creates a collector that resolves keys against r.
+func (r *Registry) NewCollector() *Collector {
+	return &Collector{registry: r, used: make(map[string]bool)}
+}
+
+// NewContext returns a copy of ctx carrying a new collector for r, along with
+// the collector itself.
+func (r *Registry) NewContext(ctx context.Context) (context.Context, *Collector) {
+	c := r.NewCollector()
+	return WithCollector(ctx, c), c
+}
+
+// Collector records which components rendered during a single request.
+// It is safe for concurrent use.
+type Collector struct {
+	registry *Registry
+	mu       sync.Mutex
+	used     map[string]bool
+}
+
+// Use records that the component registered under key was rendered.
+func (c *Collector) Use(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		c.used[key] = true
+	}
+}
+
+// Used returns the recorded keys, including keys that were never registered,
+// in registration order followed by unknown keys sorted by name.
+func (c *Collector) Used() []string {
+	c.mu.Lock()
+	used := make([]string, 0, len(c.used))
+	for key := range c.used {
+		used = append(used, key)
+	}
+	c.mu.Unlock()
+
+	c.registry.mu.RLock()
+	defer c.registry.mu.RUnlock()
+
+	sort.Slice(used, func(i, j int) bool {
+		a, aok := c.registry.components[used[i]]
+		b, bok := c.registry.components[used[j]]
+		switch {
+		case aok && bok:
+			return a.seq < b.seq
+		case aok != bok:
+			return aok
+		default:
+			return used[i] < used[j]
+		}
+	})
+	return used
+}
+
+// Stylesheet returns the styles of every recorded component. Components are
+// emitted in registration order, independent of render order, and items that
+// serialize identically are emitted once. Unregistered keys are ignored.
+func (c *Collector) Stylesheet() css.Stylesheet {
+	var sheet css.Stylesheet
+	seen := make(map[string]bool)
+
+	for _, key := range c.Used() {
+		items, ok := c.registry.Lookup(key)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			s := item.String()
+			if s == "" || seen[s] {
+				continue
+			}
+			seen[s] = true
+			sheet.Add(item)
+		}
+	}
+	return sheet
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a copy of ctx carrying c.
+func WithCollector(ctx context.Context, c *Collector) context.Context {
+	return context.WithValue(ctx, collectorKey{}, c)
+}
+
+// FromContext returns the collector carried by ctx, if any.
+func FromContext(ctx context.Context) (*Collector, bool) {
+	c, ok := ctx.Value(collectorKey{}).(*Collector)
+	return c, ok
+}
+
+// Use records keys on the collector carried by ctx. It does nothing if ctx
+// has no collector, so components can call it unconditionally.
+func Use(ctx context.Context, keys ...string) {
+	if c, ok := FromContext(ctx); ok {
+		c.Use(keys...)
+	}
+}
+
+// defaultRegistry is the registry used by the package-level Register and
+// DefaultRegistry functions.
+var defaultRegistry = NewRegistry()
+
+// DefaultRegistry returns the default registry.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Register records styles for a component key on the default registry.
+func Register(key string, items ...css.Item) bool {
+	return defaultRegistry.Register(key, items...)
+}
@@ -0,0 +1,81 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+func testRegistry() *Registry {
+	r := NewRegistry()
+	r.Register("button", css.RuleSet(".btn", css.Set(css.Display, css.DisplayFlex)))
+	r.Register("card",
+		css.RuleSet(".card", css.Set(css.Padding, css.Px(16))),
+		css.RuleSet(".btn", css.Set(css.Display, css.DisplayFlex)), // shared with button
+	)
+	r.Register("modal", css.RuleSet(".modal", css.Set(css.Position, css.PositionFixed)))
+	return r
+}
+
+func TestRegisterFirstWins(t *testing.T) {
+	r := testRegistry()
+	if r.Register("button", css.RuleSet(".other", css.Set(css.Display, css.DisplayNone))) {
+		t.Error("second registration of a key should be ignored")
+	}
+	items, _ := r.Lookup("button")
+	if got := css.CSS(items...); got != ".btn{display:flex}" {
+		t.Errorf("Lookup(button) = %s", got)
+	}
+	if got := fmt.Sprint(r.Keys()); got != "[button card modal]" {
+		t.Errorf("Keys() = %s", got)
+	}
+}
+
+func TestCollectorStylesheet(t *testing.T) {
+	r := testRegistry()
+	ctx, styles := r.NewContext(context.Background())
+
+	// Render order differs from registration order and repeats keys.
+	Use(ctx, "card")
+	Use(ctx, "button", "unknown")
+	Use(ctx, "card")
+
+	want := ".btn{display:flex}.card{padding:16px}"
+	if got := styles.Stylesheet().String(); got != want {
+		t.Errorf("Stylesheet() = %s, want %s", got, want)
+	}
+	if got := fmt.Sprint(styles.Used()); got != "[button card unknown]" {
+		t.Errorf("Used() = %s", got)
+	}
+}
+
+func TestUseWithoutCollector(t *testing.T) {
+	Use(context.Background(), "button") // must not panic
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("expected no collector on a bare context")
+	}
+}
+
+func TestConcurrentCollection(t *testing.T) {
+	r := testRegistry()
+	ctx, styles := r.NewContext(context.Background())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			r.Register(fmt.Sprintf("dynamic-%d", i), css.RuleSet(fmt.Sprintf(".d%d", i), css.Set(css.Display, css.DisplayBlock)))
+			Use(ctx, "modal", "button")
+		}(i)
+	}
+	wg.Wait()
+
+	want := ".btn{display:flex}.modal{position:fixed}"
+	if got := styles.Stylesheet().String(); got != want {
+		t.Errorf("Stylesheet() = %s, want %s", got, want)
+	}
+}
@@ -42,7 +42,14 @@ func (a AtRule) String() string {
 	
 	if len(a.Body) > 0 {
 		b.WriteString("{")
-		for _, item := range a.Body {
+		for i, item := range a.Body {
+			// Declarations directly inside an at-rule (@font-face, @property)
+			// are separated like the declarations of a rule.
+			if _, ok := item.(Decl); ok && i > 0 {
+				if _, prevDecl := a.Body[i-1].(Decl); prevDecl {
+					b.WriteString(";")
+				}
+			}
 			b.WriteString(item.String())
 		}
 		b.WriteString("}")
@@ -91,6 +98,8 @@ func prettyItem(item Item, indent int) string {
 		return prettyRule(v, indent)
 	case AtRule:
 		return prettyAtRule(v, indent)
+	case Decl:
+		return strings.Repeat("  ", indent) + v.String() + ";"
 	default:
 		return item.String()
 	}
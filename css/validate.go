@@ -0,0 +1,98 @@
+package css
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/internal/syntax"
+)
+
+// ErrUnknownProperty is reported for declarations of properties that are not
+// in the specification data.
+var ErrUnknownProperty = syntax.ErrUnknownProperty
+
+// ValidationError describes a declaration whose value does not match its
+// property's grammar.
+type ValidationError struct {
+	Selector string // selector of the enclosing rule; set by Stylesheet.Validate
+	Property Property
+	Value    string
+	Err      error // ErrUnknownProperty, or a description of the failing component
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("css: ")
+	if e.Selector != "" {
+		fmt.Fprintf(&b, "%s: ", e.Selector)
+	}
+	if e.Err == ErrUnknownProperty {
+		fmt.Fprintf(&b, "unknown property %q", e.Property)
+		return b.String()
+	}
+	fmt.Fprintf(&b, "invalid value %q for %s: %v", e.Value, e.Property, e.Err)
+	return b.String()
+}
+
+func (e *ValidationError) Unwrap() error { return e.Err }
+
+// Validate checks a declaration's serialized value against the grammar of its
+// property from the CSS specification data, and returns a *ValidationError
+// naming the first component that failed to match.
+//
+// Custom properties (--*) and vendor-prefixed properties are not checked, nor
+// are values containing var(), env() or attr(), which are only resolved by
+// the browser.
+// e.g., Validate(Set("animation-delay", Px(10))) reports that <time> was expected
+func Validate(decl Decl) error {
+	name := string(decl.Property)
+	if strings.HasPrefix(name, "-") {
+		return nil
+	}
+
+	value := ""
+	if decl.Value != nil {
+		value = decl.Value.String()
+	}
+	if err := syntax.Default.MatchProperty(name, value); err != nil {
+		return &ValidationError{Property: decl.Property, Value: value, Err: err}
+	}
+	return nil
+}
+
+// Validate checks every declaration in the stylesheet, including rules nested
+// in at-rules, and returns all problems found with their rule's selector.
+// Declarations placed directly in at-rules, such as @font-face descriptors,
+// are not checked.
+func (s Stylesheet) Validate() []*ValidationError {
+	var errs []*ValidationError
+	validateItems(s.Items, &errs)
+	return errs
+}
+
+func validateItems(items []Item, errs *[]*ValidationError) {
+	for _, item := range items {
+		switch it := item.(type) {
+		case Rule:
+			validateRule(it, errs)
+		case *Rule:
+			validateRule(*it, errs)
+		case AtRule:
+			validateItems(it.Body, errs)
+		case *AtRule:
+			validateItems(it.Body, errs)
+		case Stylesheet:
+			validateItems(it.Items, errs)
+		}
+	}
+}
+
+func validateRule(r Rule, errs *[]*ValidationError) {
+	for _, decl := range r.Decls {
+		if err := Validate(decl); err != nil {
+			verr := err.(*ValidationError)
+			verr.Selector = r.Selector
+			*errs = append(*errs, verr)
+		}
+	}
+}
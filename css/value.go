@@ -31,6 +31,67 @@ type Color string
 
 func (c Color) String() string { return string(c) }
 
+// Time represents CSS time values such as "150ms" or "1.5s".
+type Time string
+
+func (t Time) String() string { return string(t) }
+
+// Angle represents CSS angle values such as "45deg" or "0.25turn".
+type Angle string
+
+func (a Angle) String() string { return string(a) }
+
+// Frequency represents CSS frequency values such as "440Hz".
+type Frequency string
+
+func (f Frequency) String() string { return string(f) }
+
+// Resolution represents CSS resolution values such as "2dppx" or "96dpi".
+type Resolution string
+
+func (r Resolution) String() string { return string(r) }
+
+// Flex represents flexible grid track sizes such as "1fr".
+type Flex string
+
+func (f Flex) String() string { return string(f) }
+
+// Number represents unitless CSS numbers such as line-height or opacity values.
+type Number string
+
+func (n Number) String() string { return string(n) }
+
+// Integer represents CSS integer values such as z-index or order values.
+type Integer string
+
+func (i Integer) String() string { return string(i) }
+
+// URL represents a CSS url() value.
+type URL string
+
+func (u URL) String() string { return string(u) }
+
+// QuotedString represents a CSS <string> value, including its quotes.
+type QuotedString string
+
+func (s QuotedString) String() string { return string(s) }
+
+// Function represents a CSS function call such as "fit-content(20em)".
+type Function string
+
+func (f Function) String() string { return string(f) }
+
+// Shadow represents a CSS <shadow> value, or a comma-separated list of
+// them, such as "0 1px 3px #0000001a".
+type Shadow string
+
+func (s Shadow) String() string { return string(s) }
+
+// Global represents the CSS-wide keywords accepted by every property.
+type Global string
+
+func (g Global) String() string { return string(g) }
+
 // Length constructors
 func Px(n int) Length {
 	return Length(fmt.Sprintf("%dpx", n))
@@ -61,6 +122,80 @@ func RGBA(r, g, b, a uint8) Color {
 	return Color(fmt.Sprintf("rgba(%d %d %d / %.3f)", r, g, b, float64(a)/255))
 }
 
+// Time constructors
+func Ms(n int) Time {
+	return Time(fmt.Sprintf("%dms", n))
+}
+
+func Sec(x float64) Time {
+	return Time(trim0(fmt.Sprintf("%.3fs", x)))
+}
+
+// Angle constructors
+func Deg(x float64) Angle {
+	return Angle(trim0(fmt.Sprintf("%.4fdeg", x)))
+}
+
+func Turn(x float64) Angle {
+	return Angle(trim0(fmt.Sprintf("%.4fturn", x)))
+}
+
+// Number constructors
+func Num(x float64) Number {
+	return Number(trim0(fmt.Sprintf("%.4f", x)))
+}
+
+func Int(n int) Integer {
+	return Integer(fmt.Sprintf("%d", n))
+}
+
+// Url creates a url() value; the path is quoted.
+func Url(path string) URL {
+	return URL("url(" + string(Quote(path)) + ")")
+}
+
+// Quote creates a double-quoted CSS string, escaping quotes and backslashes.
+func Quote(s string) QuotedString {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return QuotedString(`"` + s + `"`)
+}
+
+// Func creates a function value with comma-separated arguments.
+// e.g., Func("fit-content", Px(200)) -> fit-content(200px)
+func Func(name string, args ...Value) Function {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = a.String()
+	}
+	return Function(name + "(" + strings.Join(parts, ", ") + ")")
+}
+
+// SpaceFunc creates a function value with space-separated arguments.
+// e.g., SpaceFunc("circle", Percent(50), Raw("at center")) -> circle(50% at center)
+func SpaceFunc(name string, args ...Value) Function {
+	parts := make([]string, len(args))
+	for i, a := range args {
+		parts[i] = a.String()
+	}
+	return Function(name + "(" + strings.Join(parts, " ") + ")")
+}
+
+// Shadow constructors
+// e.g., ShadowOf(Px(0), Px(1), Px(3), Hex("#0000001a")) -> 0px 1px 3px #0000001a
+func ShadowOf(x, y, blur Length, color Color) Shadow {
+	return Shadow(x.String() + " " + y.String() + " " + blur.String() + " " + color.String())
+}
+
+// Shadows joins shadows into a comma-separated list, drawn front to back.
+func Shadows(shadows ...Shadow) Shadow {
+	parts := make([]string, len(shadows))
+	for i, s := range shadows {
+		parts[i] = s.String()
+	}
+	return Shadow(strings.Join(parts, ", "))
+}
+
 // CSS custom property reference
 func Var(name string) Raw {
 	return Raw("var(" + name + ")")
@@ -120,4 +255,11 @@ var (
 	None   = Keyword("none")
 	Inherit = Keyword("inherit")
 	Initial = Keyword("initial")
+
+	// CSS-wide keywords
+	GlobalInherit     = Global("inherit")
+	GlobalInitial     = Global("initial")
+	GlobalUnset       = Global("unset")
+	GlobalRevert      = Global("revert")
+	GlobalRevertLayer = Global("revert-layer")
 )
\ No newline at end of file
@@ -0,0 +1,108 @@
+package css
+
+import "strings"
+
+// VarValue is the set of value types a typed custom property can hold.
+// Every member is a string type, so a var() reference can be expressed as a
+// value of the same type and passed anywhere that type is expected.
+type VarValue interface {
+	Value
+	~string
+}
+
+// TypedVar is a CSS custom property whose values are restricted to T.
+//
+//	brand := css.NewVar[css.Color]("--brand")
+//	brand.Set(css.Hex("#3b82f6"))          // --brand:#3b82f6
+//	css.Set(css.ColorP, brand.Ref())       // color:var(--brand)
+//	css.Set(css.ColorP, brand.Or(css.Hex("#000"))) // color:var(--brand, #000)
+type TypedVar[T VarValue] struct {
+	name string
+}
+
+// NewVar creates a typed custom property. The leading "--" is added if the
+// name does not already have it.
+func NewVar[T VarValue](name string) TypedVar[T] {
+	if !strings.HasPrefix(name, "--") {
+		name = "--" + name
+	}
+	return TypedVar[T]{name: name}
+}
+
+// Name returns the custom property name, including the leading "--".
+func (v TypedVar[T]) Name() string { return v.name }
+
+// Property returns the custom property name as a Property.
+func (v TypedVar[T]) Property() Property { return Property(v.name) }
+
+// Set creates a declaration assigning value to the custom property.
+func (v TypedVar[T]) Set(value T) Decl {
+	return Set(v.Property(), value)
+}
+
+// Ref returns a var() reference to the custom property as a T.
+func (v TypedVar[T]) Ref() T {
+	return T("var(" + v.name + ")")
+}
+
+// Or returns a var() reference with a fallback used when the custom property
+// is not set.
+func (v TypedVar[T]) Or(fallback T) T {
+	return T("var(" + v.name + ", " + fallback.String() + ")")
+}
+
+// Syntax returns the @property syntax descriptor matching T.
+func (v TypedVar[T]) Syntax() string {
+	var zero T
+	switch any(zero).(type) {
+	case Color:
+		return "<color>"
+	case Length:
+		return "<length-percentage>"
+	case Time:
+		return "<time>"
+	case Angle:
+		return "<angle>"
+	case Resolution:
+		return "<resolution>"
+	case Number:
+		return "<number>"
+	case Integer:
+		return "<integer>"
+	case URL:
+		return "<url>"
+	case QuotedString:
+		return "<string>"
+	case Keyword:
+		return "<custom-ident>"
+	default:
+		return "*"
+	}
+}
+
+// Register creates the @property rule that registers the custom property
+// with its syntax, inheritance and initial value.
+// e.g., @property --brand{syntax:'<color>';inherits:false;initial-value:#000}
+//
+// The initial value may only be empty when the syntax is "*": browsers
+// reject any other registration without one, so Register panics instead.
+func (v TypedVar[T]) Register(inherits bool, initial T) AtRule {
+	syntax := v.Syntax()
+	if initial.String() == "" && syntax != "*" {
+		panic("css: @property " + v.name + " with syntax " + syntax + " needs an initial value")
+	}
+
+	inheritsValue := Keyword("false")
+	if inherits {
+		inheritsValue = Keyword("true")
+	}
+
+	body := []Item{
+		Set("syntax", Raw("'"+syntax+"'")),
+		Set("inherits", inheritsValue),
+	}
+	if initial.String() != "" {
+		body = append(body, Set("initial-value", initial))
+	}
+	return AtRule{Name: "property", Params: v.name, Body: body}
+}
@@ -0,0 +1,11 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T18:02:37Z
+
+//go:build cssexperimental
+
+package cssgen
+
+// At-rule names, as used for AtRule.Name.
+const (
+	AtRulePositionTry = "position-try"
+)
@@ -0,0 +1,2453 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:50:30Z
+
+package cssgen
+
+import (
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// At-rule names, as used for AtRule.Name.
+const (
+	AtRuleCharset           = "charset"
+	AtRuleContainer         = "container"
+	AtRuleCounterStyle      = "counter-style"
+	AtRuleDocument          = "document"
+	AtRuleFontFace          = "font-face"
+	AtRuleFontFeatureValues = "font-feature-values"
+	AtRuleFontPaletteValues = "font-palette-values"
+	AtRuleImport            = "import"
+	AtRuleKeyframes         = "keyframes"
+	AtRuleLayer             = "layer"
+	AtRuleMedia             = "media"
+	AtRuleNamespace         = "namespace"
+	AtRulePage              = "page"
+	AtRuleProperty          = "property"
+	AtRuleScope             = "scope"
+	AtRuleStartingStyle     = "starting-style"
+	AtRuleSupports          = "supports"
+	AtRuleViewTransition    = "view-transition"
+)
+
+// Descriptors of @counter-style.
+const (
+	// CounterStyleAdditiveSymbols is the additive-symbols descriptor of @counter-style.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <integer [0,∞]> && <symbol> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/additive-symbols
+	CounterStyleAdditiveSymbols css.Property = "additive-symbols"
+
+	// CounterStyleFallback is the fallback descriptor of @counter-style.
+	//
+	//   - Initial: decimal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<counter-style-name>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/fallback
+	CounterStyleFallback css.Property = "fallback"
+
+	// CounterStyleNegative is the negative descriptor of @counter-style.
+	//
+	//   - Initial: "-" hyphen-minus
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<symbol> <symbol>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/negative
+	CounterStyleNegative css.Property = "negative"
+
+	// CounterStylePad is the pad descriptor of @counter-style.
+	//
+	//   - Initial: 0 ""
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer [0,∞]> && <symbol>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/pad
+	CounterStylePad css.Property = "pad"
+
+	// CounterStylePrefix is the prefix descriptor of @counter-style.
+	//
+	//   - Initial: ""
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<symbol>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/prefix
+	CounterStylePrefix css.Property = "prefix"
+
+	// CounterStyleRange is the range descriptor of @counter-style.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ [ <integer> | infinite ]{2} ]# | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/range
+	CounterStyleRange css.Property = "range"
+
+	// CounterStyleSpeakAs is the speak-as descriptor of @counter-style.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | bullets | numbers | words | spell-out | <counter-style-name>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/speak-as
+	CounterStyleSpeakAs css.Property = "speak-as"
+
+	// CounterStyleSuffix is the suffix descriptor of @counter-style.
+	//
+	//   - Initial: ". "
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<symbol>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/suffix
+	CounterStyleSuffix css.Property = "suffix"
+
+	// CounterStyleSymbols is the symbols descriptor of @counter-style.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<symbol>+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/symbols
+	CounterStyleSymbols css.Property = "symbols"
+
+	// CounterStyleSystem is the system descriptor of @counter-style.
+	//
+	//   - Initial: symbolic
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	cyclic | numeric | alphabetic | symbolic | additive | [ fixed <integer>? ] | [ extends <counter-style-name> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/system
+	CounterStyleSystem css.Property = "system"
+)
+
+// CounterStyleFallbackValue is implemented by the value types accepted by the fallback descriptor of @counter-style.
+type CounterStyleFallbackValue interface {
+	css.Value
+	css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleFallback creates a declaration for the fallback descriptor of @counter-style.
+//
+//   - Initial: decimal
+//   - Status: standard
+//
+// Syntax:
+//
+//	<counter-style-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/fallback
+func SetCounterStyleFallback[T CounterStyleFallbackValue](v T) css.Decl {
+	return css.Set(CounterStyleFallback, v)
+}
+
+// CounterStyleNegativeValue is implemented by the value types accepted by the negative descriptor of @counter-style.
+type CounterStyleNegativeValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleNegative creates a declaration for the negative descriptor of @counter-style.
+//
+//   - Initial: "-" hyphen-minus
+//   - Status: standard
+//
+// Syntax:
+//
+//	<symbol> <symbol>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/negative
+func SetCounterStyleNegative[T CounterStyleNegativeValue](v T) css.Decl {
+	return css.Set(CounterStyleNegative, v)
+}
+
+// CounterStylePrefixValue is implemented by the value types accepted by the prefix descriptor of @counter-style.
+type CounterStylePrefixValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStylePrefix creates a declaration for the prefix descriptor of @counter-style.
+//
+//   - Initial: ""
+//   - Status: standard
+//
+// Syntax:
+//
+//	<symbol>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/prefix
+func SetCounterStylePrefix[T CounterStylePrefixValue](v T) css.Decl {
+	return css.Set(CounterStylePrefix, v)
+}
+
+// CounterStyleRangeVal represents values for the range descriptor of @counter-style.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <integer> | infinite ]{2} ]# | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/range
+type CounterStyleRangeVal string
+
+// CounterStyleRangeVal constants.
+const (
+	CounterStyleRangeValAuto     CounterStyleRangeVal = "auto"
+	CounterStyleRangeValInfinite CounterStyleRangeVal = "infinite"
+)
+
+func (v CounterStyleRangeVal) String() string { return string(v) }
+
+// Values returns every CounterStyleRangeVal constant.
+func (CounterStyleRangeVal) Values() []CounterStyleRangeVal {
+	return []CounterStyleRangeVal{
+		CounterStyleRangeValAuto,
+		CounterStyleRangeValInfinite,
+	}
+}
+
+// Valid reports whether v is one of the CounterStyleRangeVal constants.
+func (v CounterStyleRangeVal) Valid() bool {
+	switch v {
+	case CounterStyleRangeValAuto,
+		CounterStyleRangeValInfinite:
+		return true
+	}
+	return false
+}
+
+// ParseCounterStyleRangeVal returns the CounterStyleRangeVal constant for a keyword, ignoring ASCII case.
+func ParseCounterStyleRangeVal(s string) (CounterStyleRangeVal, error) {
+	v := CounterStyleRangeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterStyleRangeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterStyleRangeVal constants.
+func (v CounterStyleRangeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterStyleRangeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterStyleRangeVal.
+func (v *CounterStyleRangeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterStyleRangeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// CounterStyleRangeValue is implemented by the value types accepted by the range descriptor of @counter-style.
+type CounterStyleRangeValue interface {
+	css.Value
+	CounterStyleRangeVal | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleRange creates a declaration for the range descriptor of @counter-style.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <integer> | infinite ]{2} ]# | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/range
+func SetCounterStyleRange[T CounterStyleRangeValue](v T) css.Decl {
+	return css.Set(CounterStyleRange, v)
+}
+
+// CounterStyleSpeakAsVal represents values for the speak-as descriptor of @counter-style.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | bullets | numbers | words | spell-out | <counter-style-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/speak-as
+type CounterStyleSpeakAsVal string
+
+// CounterStyleSpeakAsVal constants.
+const (
+	CounterStyleSpeakAsValAuto     CounterStyleSpeakAsVal = "auto"
+	CounterStyleSpeakAsValBullets  CounterStyleSpeakAsVal = "bullets"
+	CounterStyleSpeakAsValNumbers  CounterStyleSpeakAsVal = "numbers"
+	CounterStyleSpeakAsValSpellOut CounterStyleSpeakAsVal = "spell-out"
+	CounterStyleSpeakAsValWords    CounterStyleSpeakAsVal = "words"
+)
+
+func (v CounterStyleSpeakAsVal) String() string { return string(v) }
+
+// Values returns every CounterStyleSpeakAsVal constant.
+func (CounterStyleSpeakAsVal) Values() []CounterStyleSpeakAsVal {
+	return []CounterStyleSpeakAsVal{
+		CounterStyleSpeakAsValAuto,
+		CounterStyleSpeakAsValBullets,
+		CounterStyleSpeakAsValNumbers,
+		CounterStyleSpeakAsValSpellOut,
+		CounterStyleSpeakAsValWords,
+	}
+}
+
+// Valid reports whether v is one of the CounterStyleSpeakAsVal constants.
+func (v CounterStyleSpeakAsVal) Valid() bool {
+	switch v {
+	case CounterStyleSpeakAsValAuto,
+		CounterStyleSpeakAsValBullets,
+		CounterStyleSpeakAsValNumbers,
+		CounterStyleSpeakAsValSpellOut,
+		CounterStyleSpeakAsValWords:
+		return true
+	}
+	return false
+}
+
+// ParseCounterStyleSpeakAsVal returns the CounterStyleSpeakAsVal constant for a keyword, ignoring ASCII case.
+func ParseCounterStyleSpeakAsVal(s string) (CounterStyleSpeakAsVal, error) {
+	v := CounterStyleSpeakAsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterStyleSpeakAsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterStyleSpeakAsVal constants.
+func (v CounterStyleSpeakAsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterStyleSpeakAsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterStyleSpeakAsVal.
+func (v *CounterStyleSpeakAsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterStyleSpeakAsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// CounterStyleSpeakAsValue is implemented by the value types accepted by the speak-as descriptor of @counter-style.
+type CounterStyleSpeakAsValue interface {
+	css.Value
+	CounterStyleSpeakAsVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleSpeakAs creates a declaration for the speak-as descriptor of @counter-style.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | bullets | numbers | words | spell-out | <counter-style-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/speak-as
+func SetCounterStyleSpeakAs[T CounterStyleSpeakAsValue](v T) css.Decl {
+	return css.Set(CounterStyleSpeakAs, v)
+}
+
+// CounterStyleSuffixValue is implemented by the value types accepted by the suffix descriptor of @counter-style.
+type CounterStyleSuffixValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleSuffix creates a declaration for the suffix descriptor of @counter-style.
+//
+//   - Initial: ". "
+//   - Status: standard
+//
+// Syntax:
+//
+//	<symbol>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/suffix
+func SetCounterStyleSuffix[T CounterStyleSuffixValue](v T) css.Decl {
+	return css.Set(CounterStyleSuffix, v)
+}
+
+// CounterStyleSymbolsValue is implemented by the value types accepted by the symbols descriptor of @counter-style.
+type CounterStyleSymbolsValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleSymbols creates a declaration for the symbols descriptor of @counter-style.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	<symbol>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/symbols
+func SetCounterStyleSymbols[T CounterStyleSymbolsValue](v T) css.Decl {
+	return css.Set(CounterStyleSymbols, v)
+}
+
+// CounterStyleSystemVal represents values for the system descriptor of @counter-style.
+//
+//   - Initial: symbolic
+//   - Status: standard
+//
+// Syntax:
+//
+//	cyclic | numeric | alphabetic | symbolic | additive | [ fixed <integer>? ] | [ extends <counter-style-name> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/system
+type CounterStyleSystemVal string
+
+// CounterStyleSystemVal constants.
+const (
+	CounterStyleSystemValAdditive   CounterStyleSystemVal = "additive"
+	CounterStyleSystemValAlphabetic CounterStyleSystemVal = "alphabetic"
+	CounterStyleSystemValCyclic     CounterStyleSystemVal = "cyclic"
+	CounterStyleSystemValExtends    CounterStyleSystemVal = "extends"
+	CounterStyleSystemValFixed      CounterStyleSystemVal = "fixed"
+	CounterStyleSystemValNumeric    CounterStyleSystemVal = "numeric"
+	CounterStyleSystemValSymbolic   CounterStyleSystemVal = "symbolic"
+)
+
+func (v CounterStyleSystemVal) String() string { return string(v) }
+
+// Values returns every CounterStyleSystemVal constant.
+func (CounterStyleSystemVal) Values() []CounterStyleSystemVal {
+	return []CounterStyleSystemVal{
+		CounterStyleSystemValAdditive,
+		CounterStyleSystemValAlphabetic,
+		CounterStyleSystemValCyclic,
+		CounterStyleSystemValExtends,
+		CounterStyleSystemValFixed,
+		CounterStyleSystemValNumeric,
+		CounterStyleSystemValSymbolic,
+	}
+}
+
+// Valid reports whether v is one of the CounterStyleSystemVal constants.
+func (v CounterStyleSystemVal) Valid() bool {
+	switch v {
+	case CounterStyleSystemValAdditive,
+		CounterStyleSystemValAlphabetic,
+		CounterStyleSystemValCyclic,
+		CounterStyleSystemValExtends,
+		CounterStyleSystemValFixed,
+		CounterStyleSystemValNumeric,
+		CounterStyleSystemValSymbolic:
+		return true
+	}
+	return false
+}
+
+// ParseCounterStyleSystemVal returns the CounterStyleSystemVal constant for a keyword, ignoring ASCII case.
+func ParseCounterStyleSystemVal(s string) (CounterStyleSystemVal, error) {
+	v := CounterStyleSystemVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterStyleSystemVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterStyleSystemVal constants.
+func (v CounterStyleSystemVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterStyleSystemVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterStyleSystemVal.
+func (v *CounterStyleSystemVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterStyleSystemVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// CounterStyleSystemValue is implemented by the value types accepted by the system descriptor of @counter-style.
+type CounterStyleSystemValue interface {
+	css.Value
+	CounterStyleSystemVal | css.Raw | css.Function | css.Global
+}
+
+// SetCounterStyleSystem creates a declaration for the system descriptor of @counter-style.
+//
+//   - Initial: symbolic
+//   - Status: standard
+//
+// Syntax:
+//
+//	cyclic | numeric | alphabetic | symbolic | additive | [ fixed <integer>? ] | [ extends <counter-style-name> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@counter-style/system
+func SetCounterStyleSystem[T CounterStyleSystemValue](v T) css.Decl {
+	return css.Set(CounterStyleSystem, v)
+}
+
+// Descriptors of @font-face.
+const (
+	// FontFaceAscentOverride is the ascent-override descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/ascent-override
+	FontFaceAscentOverride css.Property = "ascent-override"
+
+	// FontFaceDescentOverride is the descent-override descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/descent-override
+	FontFaceDescentOverride css.Property = "descent-override"
+
+	// FontFaceFontDisplay is the font-display descriptor of @font-face.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | block | swap | fallback | optional
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-display
+	FontFaceFontDisplay css.Property = "font-display"
+
+	// FontFaceFontFamily is the font-family descriptor of @font-face.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<family-name>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-family
+	FontFaceFontFamily css.Property = "font-family"
+
+	// FontFaceFontFeatureSettings is the font-feature-settings descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <feature-tag-value>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-feature-settings
+	FontFaceFontFeatureSettings css.Property = "font-feature-settings"
+
+	// FontFaceFontStretch is the font-stretch descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<font-stretch-absolute>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-stretch
+	//
+	// Deprecated: the font-stretch descriptor of @font-face is obsolete.
+	FontFaceFontStretch css.Property = "font-stretch"
+
+	// FontFaceFontStyle is the font-style descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | italic | oblique <angle>{0,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-style
+	FontFaceFontStyle css.Property = "font-style"
+
+	// FontFaceFontVariationSettings is the font-variation-settings descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ <string> <number> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-variation-settings
+	FontFaceFontVariationSettings css.Property = "font-variation-settings"
+
+	// FontFaceFontWeight is the font-weight descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<font-weight-absolute>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-weight
+	FontFaceFontWeight css.Property = "font-weight"
+
+	// FontFaceLineGapOverride is the line-gap-override descriptor of @font-face.
+	//
+	//   - Initial: normal
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/line-gap-override
+	FontFaceLineGapOverride css.Property = "line-gap-override"
+
+	// FontFaceSizeAdjust is the size-adjust descriptor of @font-face.
+	//
+	//   - Initial: 100%
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/size-adjust
+	FontFaceSizeAdjust css.Property = "size-adjust"
+
+	// FontFaceSrc is the src descriptor of @font-face.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <url> [ format( <string># ) ]? | local( <family-name> ) ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/src
+	FontFaceSrc css.Property = "src"
+
+	// FontFaceUnicodeRange is the unicode-range descriptor of @font-face.
+	//
+	//   - Initial: U+0-10FFFF
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<unicode-range-token>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/unicode-range
+	FontFaceUnicodeRange css.Property = "unicode-range"
+)
+
+// FontFaceAscentOverrideVal represents values for the ascent-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/ascent-override
+type FontFaceAscentOverrideVal string
+
+// FontFaceAscentOverrideVal constants.
+const (
+	FontFaceAscentOverrideValNormal FontFaceAscentOverrideVal = "normal"
+)
+
+func (v FontFaceAscentOverrideVal) String() string { return string(v) }
+
+// Values returns every FontFaceAscentOverrideVal constant.
+func (FontFaceAscentOverrideVal) Values() []FontFaceAscentOverrideVal {
+	return []FontFaceAscentOverrideVal{
+		FontFaceAscentOverrideValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceAscentOverrideVal constants.
+func (v FontFaceAscentOverrideVal) Valid() bool {
+	switch v {
+	case FontFaceAscentOverrideValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceAscentOverrideVal returns the FontFaceAscentOverrideVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceAscentOverrideVal(s string) (FontFaceAscentOverrideVal, error) {
+	v := FontFaceAscentOverrideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceAscentOverrideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceAscentOverrideVal constants.
+func (v FontFaceAscentOverrideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceAscentOverrideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceAscentOverrideVal.
+func (v *FontFaceAscentOverrideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceAscentOverrideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceAscentOverrideValue is implemented by the value types accepted by the ascent-override descriptor of @font-face.
+type FontFaceAscentOverrideValue interface {
+	css.Value
+	FontFaceAscentOverrideVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceAscentOverride creates a declaration for the ascent-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/ascent-override
+func SetFontFaceAscentOverride[T FontFaceAscentOverrideValue](v T) css.Decl {
+	return css.Set(FontFaceAscentOverride, v)
+}
+
+// FontFaceDescentOverrideVal represents values for the descent-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/descent-override
+type FontFaceDescentOverrideVal string
+
+// FontFaceDescentOverrideVal constants.
+const (
+	FontFaceDescentOverrideValNormal FontFaceDescentOverrideVal = "normal"
+)
+
+func (v FontFaceDescentOverrideVal) String() string { return string(v) }
+
+// Values returns every FontFaceDescentOverrideVal constant.
+func (FontFaceDescentOverrideVal) Values() []FontFaceDescentOverrideVal {
+	return []FontFaceDescentOverrideVal{
+		FontFaceDescentOverrideValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceDescentOverrideVal constants.
+func (v FontFaceDescentOverrideVal) Valid() bool {
+	switch v {
+	case FontFaceDescentOverrideValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceDescentOverrideVal returns the FontFaceDescentOverrideVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceDescentOverrideVal(s string) (FontFaceDescentOverrideVal, error) {
+	v := FontFaceDescentOverrideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceDescentOverrideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceDescentOverrideVal constants.
+func (v FontFaceDescentOverrideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceDescentOverrideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceDescentOverrideVal.
+func (v *FontFaceDescentOverrideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceDescentOverrideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceDescentOverrideValue is implemented by the value types accepted by the descent-override descriptor of @font-face.
+type FontFaceDescentOverrideValue interface {
+	css.Value
+	FontFaceDescentOverrideVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceDescentOverride creates a declaration for the descent-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/descent-override
+func SetFontFaceDescentOverride[T FontFaceDescentOverrideValue](v T) css.Decl {
+	return css.Set(FontFaceDescentOverride, v)
+}
+
+// FontFaceFontDisplayVal represents values for the font-display descriptor of @font-face.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | block | swap | fallback | optional
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-display
+type FontFaceFontDisplayVal string
+
+// FontFaceFontDisplayVal constants.
+const (
+	FontFaceFontDisplayValAuto     FontFaceFontDisplayVal = "auto"
+	FontFaceFontDisplayValBlock    FontFaceFontDisplayVal = "block"
+	FontFaceFontDisplayValFallback FontFaceFontDisplayVal = "fallback"
+	FontFaceFontDisplayValOptional FontFaceFontDisplayVal = "optional"
+	FontFaceFontDisplayValSwap     FontFaceFontDisplayVal = "swap"
+)
+
+func (v FontFaceFontDisplayVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontDisplayVal constant.
+func (FontFaceFontDisplayVal) Values() []FontFaceFontDisplayVal {
+	return []FontFaceFontDisplayVal{
+		FontFaceFontDisplayValAuto,
+		FontFaceFontDisplayValBlock,
+		FontFaceFontDisplayValFallback,
+		FontFaceFontDisplayValOptional,
+		FontFaceFontDisplayValSwap,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontDisplayVal constants.
+func (v FontFaceFontDisplayVal) Valid() bool {
+	switch v {
+	case FontFaceFontDisplayValAuto,
+		FontFaceFontDisplayValBlock,
+		FontFaceFontDisplayValFallback,
+		FontFaceFontDisplayValOptional,
+		FontFaceFontDisplayValSwap:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontDisplayVal returns the FontFaceFontDisplayVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontDisplayVal(s string) (FontFaceFontDisplayVal, error) {
+	v := FontFaceFontDisplayVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontDisplayVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontDisplayVal constants.
+func (v FontFaceFontDisplayVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontDisplayVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontDisplayVal.
+func (v *FontFaceFontDisplayVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontDisplayVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontDisplayValue is implemented by the value types accepted by the font-display descriptor of @font-face.
+type FontFaceFontDisplayValue interface {
+	css.Value
+	FontFaceFontDisplayVal | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontDisplay creates a declaration for the font-display descriptor of @font-face.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | block | swap | fallback | optional
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-display
+func SetFontFaceFontDisplay[T FontFaceFontDisplayValue](v T) css.Decl {
+	return css.Set(FontFaceFontDisplay, v)
+}
+
+// FontFaceFontFamilyValue is implemented by the value types accepted by the font-family descriptor of @font-face.
+type FontFaceFontFamilyValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontFamily creates a declaration for the font-family descriptor of @font-face.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	<family-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-family
+func SetFontFaceFontFamily[T FontFaceFontFamilyValue](v T) css.Decl {
+	return css.Set(FontFaceFontFamily, v)
+}
+
+// FontFaceFontFeatureSettingsVal represents values for the font-feature-settings descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <feature-tag-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-feature-settings
+type FontFaceFontFeatureSettingsVal string
+
+// FontFaceFontFeatureSettingsVal constants.
+const (
+	FontFaceFontFeatureSettingsValNormal FontFaceFontFeatureSettingsVal = "normal"
+	FontFaceFontFeatureSettingsValOff    FontFaceFontFeatureSettingsVal = "off"
+	FontFaceFontFeatureSettingsValOn     FontFaceFontFeatureSettingsVal = "on"
+)
+
+func (v FontFaceFontFeatureSettingsVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontFeatureSettingsVal constant.
+func (FontFaceFontFeatureSettingsVal) Values() []FontFaceFontFeatureSettingsVal {
+	return []FontFaceFontFeatureSettingsVal{
+		FontFaceFontFeatureSettingsValNormal,
+		FontFaceFontFeatureSettingsValOff,
+		FontFaceFontFeatureSettingsValOn,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontFeatureSettingsVal constants.
+func (v FontFaceFontFeatureSettingsVal) Valid() bool {
+	switch v {
+	case FontFaceFontFeatureSettingsValNormal,
+		FontFaceFontFeatureSettingsValOff,
+		FontFaceFontFeatureSettingsValOn:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontFeatureSettingsVal returns the FontFaceFontFeatureSettingsVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontFeatureSettingsVal(s string) (FontFaceFontFeatureSettingsVal, error) {
+	v := FontFaceFontFeatureSettingsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontFeatureSettingsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontFeatureSettingsVal constants.
+func (v FontFaceFontFeatureSettingsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontFeatureSettingsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontFeatureSettingsVal.
+func (v *FontFaceFontFeatureSettingsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontFeatureSettingsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontFeatureSettingsValue is implemented by the value types accepted by the font-feature-settings descriptor of @font-face.
+type FontFaceFontFeatureSettingsValue interface {
+	css.Value
+	FontFaceFontFeatureSettingsVal | css.QuotedString | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontFeatureSettings creates a declaration for the font-feature-settings descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <feature-tag-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-feature-settings
+func SetFontFaceFontFeatureSettings[T FontFaceFontFeatureSettingsValue](v T) css.Decl {
+	return css.Set(FontFaceFontFeatureSettings, v)
+}
+
+// FontFaceFontStretchVal represents values for the font-stretch descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<font-stretch-absolute>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-stretch
+//
+// Deprecated: the font-stretch descriptor of @font-face is obsolete.
+type FontFaceFontStretchVal string
+
+// FontFaceFontStretchVal constants.
+const (
+	FontFaceFontStretchValCondensed      FontFaceFontStretchVal = "condensed"
+	FontFaceFontStretchValExpanded       FontFaceFontStretchVal = "expanded"
+	FontFaceFontStretchValExtraCondensed FontFaceFontStretchVal = "extra-condensed"
+	FontFaceFontStretchValExtraExpanded  FontFaceFontStretchVal = "extra-expanded"
+	FontFaceFontStretchValNormal         FontFaceFontStretchVal = "normal"
+	FontFaceFontStretchValSemiCondensed  FontFaceFontStretchVal = "semi-condensed"
+	FontFaceFontStretchValSemiExpanded   FontFaceFontStretchVal = "semi-expanded"
+	FontFaceFontStretchValUltraCondensed FontFaceFontStretchVal = "ultra-condensed"
+	FontFaceFontStretchValUltraExpanded  FontFaceFontStretchVal = "ultra-expanded"
+)
+
+func (v FontFaceFontStretchVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontStretchVal constant.
+func (FontFaceFontStretchVal) Values() []FontFaceFontStretchVal {
+	return []FontFaceFontStretchVal{
+		FontFaceFontStretchValCondensed,
+		FontFaceFontStretchValExpanded,
+		FontFaceFontStretchValExtraCondensed,
+		FontFaceFontStretchValExtraExpanded,
+		FontFaceFontStretchValNormal,
+		FontFaceFontStretchValSemiCondensed,
+		FontFaceFontStretchValSemiExpanded,
+		FontFaceFontStretchValUltraCondensed,
+		FontFaceFontStretchValUltraExpanded,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontStretchVal constants.
+func (v FontFaceFontStretchVal) Valid() bool {
+	switch v {
+	case FontFaceFontStretchValCondensed,
+		FontFaceFontStretchValExpanded,
+		FontFaceFontStretchValExtraCondensed,
+		FontFaceFontStretchValExtraExpanded,
+		FontFaceFontStretchValNormal,
+		FontFaceFontStretchValSemiCondensed,
+		FontFaceFontStretchValSemiExpanded,
+		FontFaceFontStretchValUltraCondensed,
+		FontFaceFontStretchValUltraExpanded:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontStretchVal returns the FontFaceFontStretchVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontStretchVal(s string) (FontFaceFontStretchVal, error) {
+	v := FontFaceFontStretchVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontStretchVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontStretchVal constants.
+func (v FontFaceFontStretchVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontStretchVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontStretchVal.
+func (v *FontFaceFontStretchVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontStretchVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontStretchValue is implemented by the value types accepted by the font-stretch descriptor of @font-face.
+type FontFaceFontStretchValue interface {
+	css.Value
+	FontFaceFontStretchVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontStretch creates a declaration for the font-stretch descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<font-stretch-absolute>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-stretch
+//
+// Deprecated: the font-stretch descriptor of @font-face is obsolete.
+func SetFontFaceFontStretch[T FontFaceFontStretchValue](v T) css.Decl {
+	return css.Set(FontFaceFontStretch, v)
+}
+
+// FontFaceFontStyleVal represents values for the font-style descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | italic | oblique <angle>{0,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-style
+type FontFaceFontStyleVal string
+
+// FontFaceFontStyleVal constants.
+const (
+	FontFaceFontStyleValItalic  FontFaceFontStyleVal = "italic"
+	FontFaceFontStyleValNormal  FontFaceFontStyleVal = "normal"
+	FontFaceFontStyleValOblique FontFaceFontStyleVal = "oblique"
+)
+
+func (v FontFaceFontStyleVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontStyleVal constant.
+func (FontFaceFontStyleVal) Values() []FontFaceFontStyleVal {
+	return []FontFaceFontStyleVal{
+		FontFaceFontStyleValItalic,
+		FontFaceFontStyleValNormal,
+		FontFaceFontStyleValOblique,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontStyleVal constants.
+func (v FontFaceFontStyleVal) Valid() bool {
+	switch v {
+	case FontFaceFontStyleValItalic,
+		FontFaceFontStyleValNormal,
+		FontFaceFontStyleValOblique:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontStyleVal returns the FontFaceFontStyleVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontStyleVal(s string) (FontFaceFontStyleVal, error) {
+	v := FontFaceFontStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontStyleVal constants.
+func (v FontFaceFontStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontStyleVal.
+func (v *FontFaceFontStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontStyleValue is implemented by the value types accepted by the font-style descriptor of @font-face.
+type FontFaceFontStyleValue interface {
+	css.Value
+	FontFaceFontStyleVal | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontStyle creates a declaration for the font-style descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | italic | oblique <angle>{0,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-style
+func SetFontFaceFontStyle[T FontFaceFontStyleValue](v T) css.Decl {
+	return css.Set(FontFaceFontStyle, v)
+}
+
+// FontFaceFontVariationSettingsVal represents values for the font-variation-settings descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <string> <number> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-variation-settings
+type FontFaceFontVariationSettingsVal string
+
+// FontFaceFontVariationSettingsVal constants.
+const (
+	FontFaceFontVariationSettingsValNormal FontFaceFontVariationSettingsVal = "normal"
+)
+
+func (v FontFaceFontVariationSettingsVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontVariationSettingsVal constant.
+func (FontFaceFontVariationSettingsVal) Values() []FontFaceFontVariationSettingsVal {
+	return []FontFaceFontVariationSettingsVal{
+		FontFaceFontVariationSettingsValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontVariationSettingsVal constants.
+func (v FontFaceFontVariationSettingsVal) Valid() bool {
+	switch v {
+	case FontFaceFontVariationSettingsValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontVariationSettingsVal returns the FontFaceFontVariationSettingsVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontVariationSettingsVal(s string) (FontFaceFontVariationSettingsVal, error) {
+	v := FontFaceFontVariationSettingsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontVariationSettingsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontVariationSettingsVal constants.
+func (v FontFaceFontVariationSettingsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontVariationSettingsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontVariationSettingsVal.
+func (v *FontFaceFontVariationSettingsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontVariationSettingsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontVariationSettingsValue is implemented by the value types accepted by the font-variation-settings descriptor of @font-face.
+type FontFaceFontVariationSettingsValue interface {
+	css.Value
+	FontFaceFontVariationSettingsVal | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontVariationSettings creates a declaration for the font-variation-settings descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <string> <number> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-variation-settings
+func SetFontFaceFontVariationSettings[T FontFaceFontVariationSettingsValue](v T) css.Decl {
+	return css.Set(FontFaceFontVariationSettings, v)
+}
+
+// FontFaceFontWeightVal represents values for the font-weight descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	<font-weight-absolute>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-weight
+type FontFaceFontWeightVal string
+
+// FontFaceFontWeightVal constants.
+const (
+	FontFaceFontWeightValBold   FontFaceFontWeightVal = "bold"
+	FontFaceFontWeightValNormal FontFaceFontWeightVal = "normal"
+)
+
+func (v FontFaceFontWeightVal) String() string { return string(v) }
+
+// Values returns every FontFaceFontWeightVal constant.
+func (FontFaceFontWeightVal) Values() []FontFaceFontWeightVal {
+	return []FontFaceFontWeightVal{
+		FontFaceFontWeightValBold,
+		FontFaceFontWeightValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceFontWeightVal constants.
+func (v FontFaceFontWeightVal) Valid() bool {
+	switch v {
+	case FontFaceFontWeightValBold,
+		FontFaceFontWeightValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceFontWeightVal returns the FontFaceFontWeightVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceFontWeightVal(s string) (FontFaceFontWeightVal, error) {
+	v := FontFaceFontWeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceFontWeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceFontWeightVal constants.
+func (v FontFaceFontWeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceFontWeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceFontWeightVal.
+func (v *FontFaceFontWeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceFontWeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceFontWeightValue is implemented by the value types accepted by the font-weight descriptor of @font-face.
+type FontFaceFontWeightValue interface {
+	css.Value
+	FontFaceFontWeightVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceFontWeight creates a declaration for the font-weight descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	<font-weight-absolute>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/font-weight
+func SetFontFaceFontWeight[T FontFaceFontWeightValue](v T) css.Decl {
+	return css.Set(FontFaceFontWeight, v)
+}
+
+// FontFaceLineGapOverrideVal represents values for the line-gap-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/line-gap-override
+type FontFaceLineGapOverrideVal string
+
+// FontFaceLineGapOverrideVal constants.
+const (
+	FontFaceLineGapOverrideValNormal FontFaceLineGapOverrideVal = "normal"
+)
+
+func (v FontFaceLineGapOverrideVal) String() string { return string(v) }
+
+// Values returns every FontFaceLineGapOverrideVal constant.
+func (FontFaceLineGapOverrideVal) Values() []FontFaceLineGapOverrideVal {
+	return []FontFaceLineGapOverrideVal{
+		FontFaceLineGapOverrideValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontFaceLineGapOverrideVal constants.
+func (v FontFaceLineGapOverrideVal) Valid() bool {
+	switch v {
+	case FontFaceLineGapOverrideValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontFaceLineGapOverrideVal returns the FontFaceLineGapOverrideVal constant for a keyword, ignoring ASCII case.
+func ParseFontFaceLineGapOverrideVal(s string) (FontFaceLineGapOverrideVal, error) {
+	v := FontFaceLineGapOverrideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFaceLineGapOverrideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFaceLineGapOverrideVal constants.
+func (v FontFaceLineGapOverrideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFaceLineGapOverrideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFaceLineGapOverrideVal.
+func (v *FontFaceLineGapOverrideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFaceLineGapOverrideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontFaceLineGapOverrideValue is implemented by the value types accepted by the line-gap-override descriptor of @font-face.
+type FontFaceLineGapOverrideValue interface {
+	css.Value
+	FontFaceLineGapOverrideVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceLineGapOverride creates a declaration for the line-gap-override descriptor of @font-face.
+//
+//   - Initial: normal
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/line-gap-override
+func SetFontFaceLineGapOverride[T FontFaceLineGapOverrideValue](v T) css.Decl {
+	return css.Set(FontFaceLineGapOverride, v)
+}
+
+// FontFaceSizeAdjustValue is implemented by the value types accepted by the size-adjust descriptor of @font-face.
+type FontFaceSizeAdjustValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceSizeAdjust creates a declaration for the size-adjust descriptor of @font-face.
+//
+//   - Initial: 100%
+//   - Status: standard
+//
+// Syntax:
+//
+//	<percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/size-adjust
+func SetFontFaceSizeAdjust[T FontFaceSizeAdjustValue](v T) css.Decl {
+	return css.Set(FontFaceSizeAdjust, v)
+}
+
+// FontFaceSrcValue is implemented by the value types accepted by the src descriptor of @font-face.
+type FontFaceSrcValue interface {
+	css.Value
+	css.URL | css.Raw | css.Function | css.Global
+}
+
+// SetFontFaceSrc creates a declaration for the src descriptor of @font-face.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <url> [ format( <string># ) ]? | local( <family-name> ) ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-face/src
+func SetFontFaceSrc[T FontFaceSrcValue](v T) css.Decl {
+	return css.Set(FontFaceSrc, v)
+}
+
+// Descriptors of @font-palette-values.
+const (
+	// FontPaletteValuesBasePalette is the base-palette descriptor of @font-palette-values.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	light | dark | <integer [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/base-palette
+	FontPaletteValuesBasePalette css.Property = "base-palette"
+
+	// FontPaletteValuesFontFamily is the font-family descriptor of @font-palette-values.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<family-name>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/font-family
+	FontPaletteValuesFontFamily css.Property = "font-family"
+
+	// FontPaletteValuesOverrideColors is the override-colors descriptor of @font-palette-values.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <integer [0,∞]> <color> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/override-colors
+	FontPaletteValuesOverrideColors css.Property = "override-colors"
+)
+
+// FontPaletteValuesBasePaletteVal represents values for the base-palette descriptor of @font-palette-values.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	light | dark | <integer [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/base-palette
+type FontPaletteValuesBasePaletteVal string
+
+// FontPaletteValuesBasePaletteVal constants.
+const (
+	FontPaletteValuesBasePaletteValDark  FontPaletteValuesBasePaletteVal = "dark"
+	FontPaletteValuesBasePaletteValLight FontPaletteValuesBasePaletteVal = "light"
+)
+
+func (v FontPaletteValuesBasePaletteVal) String() string { return string(v) }
+
+// Values returns every FontPaletteValuesBasePaletteVal constant.
+func (FontPaletteValuesBasePaletteVal) Values() []FontPaletteValuesBasePaletteVal {
+	return []FontPaletteValuesBasePaletteVal{
+		FontPaletteValuesBasePaletteValDark,
+		FontPaletteValuesBasePaletteValLight,
+	}
+}
+
+// Valid reports whether v is one of the FontPaletteValuesBasePaletteVal constants.
+func (v FontPaletteValuesBasePaletteVal) Valid() bool {
+	switch v {
+	case FontPaletteValuesBasePaletteValDark,
+		FontPaletteValuesBasePaletteValLight:
+		return true
+	}
+	return false
+}
+
+// ParseFontPaletteValuesBasePaletteVal returns the FontPaletteValuesBasePaletteVal constant for a keyword, ignoring ASCII case.
+func ParseFontPaletteValuesBasePaletteVal(s string) (FontPaletteValuesBasePaletteVal, error) {
+	v := FontPaletteValuesBasePaletteVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontPaletteValuesBasePaletteVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontPaletteValuesBasePaletteVal constants.
+func (v FontPaletteValuesBasePaletteVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontPaletteValuesBasePaletteVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontPaletteValuesBasePaletteVal.
+func (v *FontPaletteValuesBasePaletteVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontPaletteValuesBasePaletteVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontPaletteValuesBasePaletteValue is implemented by the value types accepted by the base-palette descriptor of @font-palette-values.
+type FontPaletteValuesBasePaletteValue interface {
+	css.Value
+	FontPaletteValuesBasePaletteVal | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetFontPaletteValuesBasePalette creates a declaration for the base-palette descriptor of @font-palette-values.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	light | dark | <integer [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/base-palette
+func SetFontPaletteValuesBasePalette[T FontPaletteValuesBasePaletteValue](v T) css.Decl {
+	return css.Set(FontPaletteValuesBasePalette, v)
+}
+
+// FontPaletteValuesFontFamilyValue is implemented by the value types accepted by the font-family descriptor of @font-palette-values.
+type FontPaletteValuesFontFamilyValue interface {
+	css.Value
+	css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
+}
+
+// SetFontPaletteValuesFontFamily creates a declaration for the font-family descriptor of @font-palette-values.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	<family-name>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@font-palette-values/font-family
+func SetFontPaletteValuesFontFamily[T FontPaletteValuesFontFamilyValue](v T) css.Decl {
+	return css.Set(FontPaletteValuesFontFamily, v)
+}
+
+// Descriptors of @page.
+const (
+	// PageBleed is the bleed descriptor of @page.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length>
+	PageBleed css.Property = "bleed"
+
+	// PageMarks is the marks descriptor of @page.
+	//
+	//   - Initial: none
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ crop || cross ]
+	PageMarks css.Property = "marks"
+
+	// PagePageOrientation is the page-orientation descriptor of @page.
+	//
+	//   - Initial: upright
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	upright | rotate-left | rotate-right
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/page-orientation
+	PagePageOrientation css.Property = "page-orientation"
+
+	// PageSize is the size descriptor of @page.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length [0,∞]>{1,2} | auto | [ <page-size> || [ portrait | landscape ] ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/size
+	PageSize css.Property = "size"
+)
+
+// PageBleedVal represents values for the bleed descriptor of @page.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length>
+type PageBleedVal string
+
+// PageBleedVal constants.
+const (
+	PageBleedValAuto PageBleedVal = "auto"
+)
+
+func (v PageBleedVal) String() string { return string(v) }
+
+// Values returns every PageBleedVal constant.
+func (PageBleedVal) Values() []PageBleedVal {
+	return []PageBleedVal{
+		PageBleedValAuto,
+	}
+}
+
+// Valid reports whether v is one of the PageBleedVal constants.
+func (v PageBleedVal) Valid() bool {
+	switch v {
+	case PageBleedValAuto:
+		return true
+	}
+	return false
+}
+
+// ParsePageBleedVal returns the PageBleedVal constant for a keyword, ignoring ASCII case.
+func ParsePageBleedVal(s string) (PageBleedVal, error) {
+	v := PageBleedVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageBleedVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageBleedVal constants.
+func (v PageBleedVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageBleedVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageBleedVal.
+func (v *PageBleedVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageBleedVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PageBleedValue is implemented by the value types accepted by the bleed descriptor of @page.
+type PageBleedValue interface {
+	css.Value
+	PageBleedVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetPageBleed creates a declaration for the bleed descriptor of @page.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length>
+func SetPageBleed[T PageBleedValue](v T) css.Decl {
+	return css.Set(PageBleed, v)
+}
+
+// PageMarksVal represents values for the marks descriptor of @page.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ crop || cross ]
+type PageMarksVal string
+
+// PageMarksVal constants.
+const (
+	PageMarksValCrop  PageMarksVal = "crop"
+	PageMarksValCross PageMarksVal = "cross"
+	PageMarksValNone  PageMarksVal = "none"
+)
+
+func (v PageMarksVal) String() string { return string(v) }
+
+// Values returns every PageMarksVal constant.
+func (PageMarksVal) Values() []PageMarksVal {
+	return []PageMarksVal{
+		PageMarksValCrop,
+		PageMarksValCross,
+		PageMarksValNone,
+	}
+}
+
+// Valid reports whether v is one of the PageMarksVal constants.
+func (v PageMarksVal) Valid() bool {
+	switch v {
+	case PageMarksValCrop,
+		PageMarksValCross,
+		PageMarksValNone:
+		return true
+	}
+	return false
+}
+
+// ParsePageMarksVal returns the PageMarksVal constant for a keyword, ignoring ASCII case.
+func ParsePageMarksVal(s string) (PageMarksVal, error) {
+	v := PageMarksVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageMarksVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageMarksVal constants.
+func (v PageMarksVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageMarksVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageMarksVal.
+func (v *PageMarksVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageMarksVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PageMarksValue is implemented by the value types accepted by the marks descriptor of @page.
+type PageMarksValue interface {
+	css.Value
+	PageMarksVal | css.Raw | css.Function | css.Global
+}
+
+// SetPageMarks creates a declaration for the marks descriptor of @page.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ crop || cross ]
+func SetPageMarks[T PageMarksValue](v T) css.Decl {
+	return css.Set(PageMarks, v)
+}
+
+// PagePageOrientationVal represents values for the page-orientation descriptor of @page.
+//
+//   - Initial: upright
+//   - Status: standard
+//
+// Syntax:
+//
+//	upright | rotate-left | rotate-right
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/page-orientation
+type PagePageOrientationVal string
+
+// PagePageOrientationVal constants.
+const (
+	PagePageOrientationValRotateLeft  PagePageOrientationVal = "rotate-left"
+	PagePageOrientationValRotateRight PagePageOrientationVal = "rotate-right"
+	PagePageOrientationValUpright     PagePageOrientationVal = "upright"
+)
+
+func (v PagePageOrientationVal) String() string { return string(v) }
+
+// Values returns every PagePageOrientationVal constant.
+func (PagePageOrientationVal) Values() []PagePageOrientationVal {
+	return []PagePageOrientationVal{
+		PagePageOrientationValRotateLeft,
+		PagePageOrientationValRotateRight,
+		PagePageOrientationValUpright,
+	}
+}
+
+// Valid reports whether v is one of the PagePageOrientationVal constants.
+func (v PagePageOrientationVal) Valid() bool {
+	switch v {
+	case PagePageOrientationValRotateLeft,
+		PagePageOrientationValRotateRight,
+		PagePageOrientationValUpright:
+		return true
+	}
+	return false
+}
+
+// ParsePagePageOrientationVal returns the PagePageOrientationVal constant for a keyword, ignoring ASCII case.
+func ParsePagePageOrientationVal(s string) (PagePageOrientationVal, error) {
+	v := PagePageOrientationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PagePageOrientationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PagePageOrientationVal constants.
+func (v PagePageOrientationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PagePageOrientationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePagePageOrientationVal.
+func (v *PagePageOrientationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePagePageOrientationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PagePageOrientationValue is implemented by the value types accepted by the page-orientation descriptor of @page.
+type PagePageOrientationValue interface {
+	css.Value
+	PagePageOrientationVal | css.Raw | css.Function | css.Global
+}
+
+// SetPagePageOrientation creates a declaration for the page-orientation descriptor of @page.
+//
+//   - Initial: upright
+//   - Status: standard
+//
+// Syntax:
+//
+//	upright | rotate-left | rotate-right
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/page-orientation
+func SetPagePageOrientation[T PagePageOrientationValue](v T) css.Decl {
+	return css.Set(PagePageOrientation, v)
+}
+
+// PageSizeVal represents values for the size descriptor of @page.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length [0,∞]>{1,2} | auto | [ <page-size> || [ portrait | landscape ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/size
+type PageSizeVal string
+
+// PageSizeVal constants.
+const (
+	PageSizeValA3        PageSizeVal = "a3"
+	PageSizeValA4        PageSizeVal = "a4"
+	PageSizeValA5        PageSizeVal = "a5"
+	PageSizeValAuto      PageSizeVal = "auto"
+	PageSizeValB4        PageSizeVal = "b4"
+	PageSizeValB5        PageSizeVal = "b5"
+	PageSizeValJisB4     PageSizeVal = "jis-b4"
+	PageSizeValJisB5     PageSizeVal = "jis-b5"
+	PageSizeValLandscape PageSizeVal = "landscape"
+	PageSizeValLedger    PageSizeVal = "ledger"
+	PageSizeValLegal     PageSizeVal = "legal"
+	PageSizeValLetter    PageSizeVal = "letter"
+	PageSizeValPortrait  PageSizeVal = "portrait"
+)
+
+func (v PageSizeVal) String() string { return string(v) }
+
+// Values returns every PageSizeVal constant.
+func (PageSizeVal) Values() []PageSizeVal {
+	return []PageSizeVal{
+		PageSizeValA3,
+		PageSizeValA4,
+		PageSizeValA5,
+		PageSizeValAuto,
+		PageSizeValB4,
+		PageSizeValB5,
+		PageSizeValJisB4,
+		PageSizeValJisB5,
+		PageSizeValLandscape,
+		PageSizeValLedger,
+		PageSizeValLegal,
+		PageSizeValLetter,
+		PageSizeValPortrait,
+	}
+}
+
+// Valid reports whether v is one of the PageSizeVal constants.
+func (v PageSizeVal) Valid() bool {
+	switch v {
+	case PageSizeValA3,
+		PageSizeValA4,
+		PageSizeValA5,
+		PageSizeValAuto,
+		PageSizeValB4,
+		PageSizeValB5,
+		PageSizeValJisB4,
+		PageSizeValJisB5,
+		PageSizeValLandscape,
+		PageSizeValLedger,
+		PageSizeValLegal,
+		PageSizeValLetter,
+		PageSizeValPortrait:
+		return true
+	}
+	return false
+}
+
+// ParsePageSizeVal returns the PageSizeVal constant for a keyword, ignoring ASCII case.
+func ParsePageSizeVal(s string) (PageSizeVal, error) {
+	v := PageSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageSizeVal constants.
+func (v PageSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageSizeVal.
+func (v *PageSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PageSizeValue is implemented by the value types accepted by the size descriptor of @page.
+type PageSizeValue interface {
+	css.Value
+	PageSizeVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetPageSize creates a declaration for the size descriptor of @page.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length [0,∞]>{1,2} | auto | [ <page-size> || [ portrait | landscape ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@page/size
+func SetPageSize[T PageSizeValue](v T) css.Decl {
+	return css.Set(PageSize, v)
+}
+
+// Descriptors of @property.
+const (
+	// PropertyInherits is the inherits descriptor of @property.
+	//
+	//   - Initial: auto
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	true | false
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/inherits
+	PropertyInherits css.Property = "inherits"
+
+	// PropertyInitialValue is the initial-value descriptor of @property.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<declaration-value>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/initial-value
+	PropertyInitialValue css.Property = "initial-value"
+
+	// PropertySyntax is the syntax descriptor of @property.
+	//
+	//   - Initial: n/a (required)
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<string>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/syntax
+	PropertySyntax css.Property = "syntax"
+)
+
+// PropertyInheritsVal represents values for the inherits descriptor of @property.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	true | false
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/inherits
+type PropertyInheritsVal string
+
+// PropertyInheritsVal constants.
+const (
+	PropertyInheritsValFalse PropertyInheritsVal = "false"
+	PropertyInheritsValTrue  PropertyInheritsVal = "true"
+)
+
+func (v PropertyInheritsVal) String() string { return string(v) }
+
+// Values returns every PropertyInheritsVal constant.
+func (PropertyInheritsVal) Values() []PropertyInheritsVal {
+	return []PropertyInheritsVal{
+		PropertyInheritsValFalse,
+		PropertyInheritsValTrue,
+	}
+}
+
+// Valid reports whether v is one of the PropertyInheritsVal constants.
+func (v PropertyInheritsVal) Valid() bool {
+	switch v {
+	case PropertyInheritsValFalse,
+		PropertyInheritsValTrue:
+		return true
+	}
+	return false
+}
+
+// ParsePropertyInheritsVal returns the PropertyInheritsVal constant for a keyword, ignoring ASCII case.
+func ParsePropertyInheritsVal(s string) (PropertyInheritsVal, error) {
+	v := PropertyInheritsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PropertyInheritsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PropertyInheritsVal constants.
+func (v PropertyInheritsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PropertyInheritsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePropertyInheritsVal.
+func (v *PropertyInheritsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePropertyInheritsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PropertyInheritsValue is implemented by the value types accepted by the inherits descriptor of @property.
+type PropertyInheritsValue interface {
+	css.Value
+	PropertyInheritsVal | css.Raw | css.Function | css.Global
+}
+
+// SetPropertyInherits creates a declaration for the inherits descriptor of @property.
+//
+//   - Initial: auto
+//   - Status: standard
+//
+// Syntax:
+//
+//	true | false
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/inherits
+func SetPropertyInherits[T PropertyInheritsValue](v T) css.Decl {
+	return css.Set(PropertyInherits, v)
+}
+
+// PropertySyntaxValue is implemented by the value types accepted by the syntax descriptor of @property.
+type PropertySyntaxValue interface {
+	css.Value
+	css.QuotedString | css.Raw | css.Function | css.Global
+}
+
+// SetPropertySyntax creates a declaration for the syntax descriptor of @property.
+//
+//   - Initial: n/a (required)
+//   - Status: standard
+//
+// Syntax:
+//
+//	<string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/@property/syntax
+func SetPropertySyntax[T PropertySyntaxValue](v T) css.Decl {
+	return css.Set(PropertySyntax, v)
+}
+
+// Descriptors of @view-transition.
+const (
+	// ViewTransitionNavigation is the navigation descriptor of @view-transition.
+	//
+	//   - Initial: none
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	ViewTransitionNavigation css.Property = "navigation"
+
+	// ViewTransitionTypes is the types descriptor of @view-transition.
+	//
+	//   - Initial: none
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <custom-ident>+
+	ViewTransitionTypes css.Property = "types"
+)
+
+// ViewTransitionNavigationVal represents values for the navigation descriptor of @view-transition.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+type ViewTransitionNavigationVal string
+
+// ViewTransitionNavigationVal constants.
+const (
+	ViewTransitionNavigationValAuto ViewTransitionNavigationVal = "auto"
+	ViewTransitionNavigationValNone ViewTransitionNavigationVal = "none"
+)
+
+func (v ViewTransitionNavigationVal) String() string { return string(v) }
+
+// Values returns every ViewTransitionNavigationVal constant.
+func (ViewTransitionNavigationVal) Values() []ViewTransitionNavigationVal {
+	return []ViewTransitionNavigationVal{
+		ViewTransitionNavigationValAuto,
+		ViewTransitionNavigationValNone,
+	}
+}
+
+// Valid reports whether v is one of the ViewTransitionNavigationVal constants.
+func (v ViewTransitionNavigationVal) Valid() bool {
+	switch v {
+	case ViewTransitionNavigationValAuto,
+		ViewTransitionNavigationValNone:
+		return true
+	}
+	return false
+}
+
+// ParseViewTransitionNavigationVal returns the ViewTransitionNavigationVal constant for a keyword, ignoring ASCII case.
+func ParseViewTransitionNavigationVal(s string) (ViewTransitionNavigationVal, error) {
+	v := ViewTransitionNavigationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTransitionNavigationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTransitionNavigationVal constants.
+func (v ViewTransitionNavigationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTransitionNavigationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTransitionNavigationVal.
+func (v *ViewTransitionNavigationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTransitionNavigationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTransitionNavigationValue is implemented by the value types accepted by the navigation descriptor of @view-transition.
+type ViewTransitionNavigationValue interface {
+	css.Value
+	ViewTransitionNavigationVal | css.Raw | css.Function | css.Global
+}
+
+// SetViewTransitionNavigation creates a declaration for the navigation descriptor of @view-transition.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+func SetViewTransitionNavigation[T ViewTransitionNavigationValue](v T) css.Decl {
+	return css.Set(ViewTransitionNavigation, v)
+}
+
+// ViewTransitionTypesVal represents values for the types descriptor of @view-transition.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
+type ViewTransitionTypesVal string
+
+// ViewTransitionTypesVal constants.
+const (
+	ViewTransitionTypesValNone ViewTransitionTypesVal = "none"
+)
+
+func (v ViewTransitionTypesVal) String() string { return string(v) }
+
+// Values returns every ViewTransitionTypesVal constant.
+func (ViewTransitionTypesVal) Values() []ViewTransitionTypesVal {
+	return []ViewTransitionTypesVal{
+		ViewTransitionTypesValNone,
+	}
+}
+
+// Valid reports whether v is one of the ViewTransitionTypesVal constants.
+func (v ViewTransitionTypesVal) Valid() bool {
+	switch v {
+	case ViewTransitionTypesValNone:
+		return true
+	}
+	return false
+}
+
+// ParseViewTransitionTypesVal returns the ViewTransitionTypesVal constant for a keyword, ignoring ASCII case.
+func ParseViewTransitionTypesVal(s string) (ViewTransitionTypesVal, error) {
+	v := ViewTransitionTypesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTransitionTypesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTransitionTypesVal constants.
+func (v ViewTransitionTypesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTransitionTypesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTransitionTypesVal.
+func (v *ViewTransitionTypesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTransitionTypesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTransitionTypesValue is implemented by the value types accepted by the types descriptor of @view-transition.
+type ViewTransitionTypesValue interface {
+	css.Value
+	ViewTransitionTypesVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetViewTransitionTypes creates a declaration for the types descriptor of @view-transition.
+//
+//   - Initial: none
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
+func SetViewTransitionTypes[T ViewTransitionTypesValue](v T) css.Decl {
+	return css.Set(ViewTransitionTypes, v)
+}
@@ -0,0 +1,66 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:55:24Z
+
+//go:build cssexperimental
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// Constructors for experimental CSS functions.
+
+// FnAnchor builds anchor( <anchor-name>? && <anchor-side>, <length-percentage>? ).
+func FnAnchor(arg css.Value) css.Function {
+	return css.Func("anchor", arg)
+}
+
+// FnAnchorSize builds anchor-size( [ <anchor-name> || <anchor-size> ]? , <length-percentage>? ).
+// Pass nil, or "" for a typed parameter, to omit an optional argument.
+func FnAnchorSize(arg css.Value, lengthPercentage css.Length) css.Function {
+	return css.Func("anchor-size", fnArgs[css.Value]([]css.Value{arg, lengthPercentage}, nil)...)
+}
+
+// FnCalcSize builds calc-size( <calc-size-basis>, <calc-sum> ) as a T, e.g. css.Length.
+func FnCalcSize[T css.VarValue](calcSizeBasis css.Value, calcSum css.Value) T {
+	return T(css.Func("calc-size", calcSizeBasis, calcSum))
+}
+
+// FnElement builds element( <id-selector> ).
+func FnElement(idSelector css.Value) css.Function {
+	return css.Func("element", idSelector)
+}
+
+// FnLeader builds leader( <leader-type> ).
+func FnLeader(leaderType css.Value) css.Function {
+	return css.Func("leader", leaderType)
+}
+
+// FnScroll builds scroll( [ <scroller> || <axis> ]? ).
+// Pass nil to omit an optional argument.
+func FnScroll(arg css.Value) css.Function {
+	return css.Func("scroll", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnTargetCounter builds target-counter( [ <string> | <url> ] , <custom-ident> , <counter-style>? ).
+// Pass nil to omit an optional argument.
+func FnTargetCounter(arg css.Value, customIdent css.Keyword, counterStyle css.Value) css.Function {
+	return css.Func("target-counter", fnArgs[css.Value]([]css.Value{arg, customIdent, counterStyle}, nil)...)
+}
+
+// FnTargetCounters builds target-counters( [ <string> | <url> ] , <custom-ident> , <string> , <counter-style>? ).
+// Pass nil to omit an optional argument.
+func FnTargetCounters(arg css.Value, customIdent css.Keyword, stringValue css.QuotedString, counterStyle css.Value) css.Function {
+	return css.Func("target-counters", fnArgs[css.Value]([]css.Value{arg, customIdent, stringValue, counterStyle}, nil)...)
+}
+
+// FnTargetText builds target-text( [ <string> | <url> ] , [ content | before | after | first-letter ]? ).
+// Pass nil to omit an optional argument.
+func FnTargetText(arg1 css.Value, arg2 css.Value) css.Function {
+	return css.Func("target-text", fnArgs[css.Value]([]css.Value{arg1, arg2}, nil)...)
+}
+
+// FnView builds view([<axis> || <'view-timeline-inset'>]?).
+// Pass nil to omit an optional argument.
+func FnView(arg css.Value) css.Function {
+	return css.Func("view", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
@@ -0,0 +1,561 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:55:24Z
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// Constructors for CSS functions. Arguments are checked against the
+// function's syntax at compile time: typed parameters take a single css
+// value type, and every argument the syntax allows has its own parameter
+// unless the last one repeats without limit.
+
+// fnArgs collects the arguments of a function value, dropping omitted (nil
+// or empty) optional arguments.
+func fnArgs[T css.Value](args []css.Value, more []T) []css.Value {
+	for _, v := range more {
+		args = append(args, v)
+	}
+	kept := args[:0]
+	for _, v := range args {
+		if v != nil && v.String() != "" {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// fnKeywords collects the arguments of a function whose syntax separates
+// them with spaces, writing each after the keywords that introduce it, as
+// the at of circle( <radial-size>? [ at <position> ]? ). Omitted (nil or
+// empty) optional arguments are dropped along with their keywords.
+func fnKeywords[T css.Value](keywords []string, args []css.Value, more []T) []css.Value {
+	for _, v := range more {
+		args = append(args, v)
+	}
+	kept := args[:0]
+	for i, v := range args {
+		switch {
+		case v == nil || v.String() == "":
+		case i < len(keywords) && keywords[i] != "":
+			kept = append(kept, css.Raw(keywords[i]+" "+v.String()))
+		default:
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// FnAbs builds abs( <calc-sum> ) as a T, e.g. css.Length.
+func FnAbs[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("abs", calcSum))
+}
+
+// FnAcos builds acos( <calc-sum> ) as a T, e.g. css.Length.
+func FnAcos[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("acos", calcSum))
+}
+
+// FnAsin builds asin( <calc-sum> ) as a T, e.g. css.Length.
+func FnAsin[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("asin", calcSum))
+}
+
+// FnAtan builds atan( <calc-sum> ) as a T, e.g. css.Length.
+func FnAtan[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("atan", calcSum))
+}
+
+// FnAtan2 builds atan2( <calc-sum>, <calc-sum> ) as a T, e.g. css.Length.
+func FnAtan2[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("atan2", calcSum1, calcSum2))
+}
+
+// FnAttr builds attr( <attr-name> <type-or-unit>? [, <attr-fallback> ]? ).
+func FnAttr(arg css.Value) css.Function {
+	return css.Func("attr", arg)
+}
+
+// FnBlur builds blur( <length>? ).
+// Pass "" to omit an optional argument.
+func FnBlur(length css.Length) css.Function {
+	return css.Func("blur", fnArgs[css.Value]([]css.Value{length}, nil)...)
+}
+
+// FnBrightness builds brightness( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnBrightness(arg css.Value) css.Function {
+	return css.Func("brightness", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnCalc builds calc( <calc-sum> ) as a T, e.g. css.Length.
+func FnCalc[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("calc", calcSum))
+}
+
+// FnCircle builds circle( <radial-size>? [ at <position> ]? ).
+// Pass nil to omit an optional argument.
+func FnCircle(radialSize css.Value, position css.Value) css.Function {
+	return css.SpaceFunc("circle", fnKeywords[css.Value]([]string{"", "at"}, []css.Value{radialSize, position}, nil)...)
+}
+
+// FnClamp builds clamp( <calc-sum>#{3} ) as a T, e.g. css.Length.
+func FnClamp[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value, calcSum3 css.Value) T {
+	return T(css.Func("clamp", calcSum1, calcSum2, calcSum3))
+}
+
+// FnColor builds color( [ from <color> ]? <colorspace-params> [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnColor(color css.Value, colorspaceParams css.Value, arg css.Value) css.Color {
+	return css.Color(css.SpaceFunc("color", fnKeywords[css.Value]([]string{"from", "", "/"}, []css.Value{color, colorspaceParams, arg}, nil)...))
+}
+
+// FnColorMix builds color-mix( <color-interpolation-method> , [ <color> && <percentage [0,100]>? ]#{2}).
+func FnColorMix(colorInterpolationMethod css.Value, arg1 css.Value, arg2 css.Value) css.Color {
+	return css.Color(css.Func("color-mix", colorInterpolationMethod, arg1, arg2))
+}
+
+// FnConicGradient builds conic-gradient( [ <conic-gradient-syntax> ] ).
+func FnConicGradient(conicGradientSyntax css.Value) css.Function {
+	return css.Func("conic-gradient", conicGradientSyntax)
+}
+
+// FnContrast builds contrast( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnContrast(arg css.Value) css.Function {
+	return css.Func("contrast", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnCos builds cos( <calc-sum> ) as a T, e.g. css.Length.
+func FnCos[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("cos", calcSum))
+}
+
+// FnCounter builds counter( <counter-name>, <counter-style>? ).
+// Pass nil to omit an optional argument.
+func FnCounter(counterName css.Keyword, counterStyle css.Value) css.Function {
+	return css.Func("counter", fnArgs[css.Value]([]css.Value{counterName, counterStyle}, nil)...)
+}
+
+// FnCounters builds counters( <counter-name>, <string>, <counter-style>? ).
+// Pass nil to omit an optional argument.
+func FnCounters(counterName css.Keyword, stringValue css.QuotedString, counterStyle css.Value) css.Function {
+	return css.Func("counters", fnArgs[css.Value]([]css.Value{counterName, stringValue, counterStyle}, nil)...)
+}
+
+// FnCrossFade builds cross-fade( <cf-mixing-image> , <cf-final-image>? ).
+// Pass nil to omit an optional argument.
+func FnCrossFade(cfMixingImage css.Value, cfFinalImage css.Value) css.Function {
+	return css.Func("cross-fade", fnArgs[css.Value]([]css.Value{cfMixingImage, cfFinalImage}, nil)...)
+}
+
+// FnCubicBezier builds cubic-bezier( [ <number [0,1]>, <number> ]#{2} ).
+func FnCubicBezier(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Function {
+	return css.Func("cubic-bezier", arg1, arg2, arg3, arg4)
+}
+
+// FnDropShadow builds drop-shadow( [ <color>? && <length>{2,3} ] ).
+func FnDropShadow(arg css.Value) css.Function {
+	return css.Func("drop-shadow", arg)
+}
+
+// FnEllipse builds ellipse( <radial-size>? [ at <position> ]? ).
+// Pass nil to omit an optional argument.
+func FnEllipse(radialSize css.Value, position css.Value) css.Function {
+	return css.SpaceFunc("ellipse", fnKeywords[css.Value]([]string{"", "at"}, []css.Value{radialSize, position}, nil)...)
+}
+
+// FnEnv builds env( <custom-ident> , <declaration-value>? ).
+// Pass nil to omit an optional argument.
+func FnEnv(customIdent css.Keyword, declarationValue css.Value) css.Function {
+	return css.Func("env", fnArgs[css.Value]([]css.Value{customIdent, declarationValue}, nil)...)
+}
+
+// FnExp builds exp( <calc-sum> ) as a T, e.g. css.Length.
+func FnExp[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("exp", calcSum))
+}
+
+// FnFitContent builds fit-content( <length-percentage [0,∞]> ).
+func FnFitContent(lengthPercentage css.Length) css.Function {
+	return css.Func("fit-content", lengthPercentage)
+}
+
+// FnGrayscale builds grayscale( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnGrayscale(arg css.Value) css.Function {
+	return css.Func("grayscale", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnHsl builds hsl( <hue>, <percentage>, <percentage>, <alpha-value>? ) | hsl( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnHsl(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.Func("hsl", fnArgs[css.Value]([]css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnHsla builds hsla( <hue>, <percentage>, <percentage>, <alpha-value>? ) | hsla( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnHsla(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.Func("hsla", fnArgs[css.Value]([]css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnHueRotate builds hue-rotate( [ <angle> | <zero> ]? ).
+// Pass nil to omit an optional argument.
+func FnHueRotate(arg css.Value) css.Function {
+	return css.Func("hue-rotate", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnHwb builds hwb( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnHwb(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.SpaceFunc("hwb", fnKeywords[css.Value]([]string{"", "", "", "/"}, []css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnHypot builds hypot( <calc-sum># ) as a T, e.g. css.Length.
+func FnHypot[T css.VarValue](calcSum css.Value, more ...css.Value) T {
+	return T(css.Func("hypot", fnArgs([]css.Value{calcSum}, more)...))
+}
+
+// FnImage builds image( <image-tags>? [ <image-src>? , <color>? ]! ).
+func FnImage(arg css.Value) css.Function {
+	return css.Func("image", arg)
+}
+
+// FnImageSet builds image-set( <image-set-option># ).
+func FnImageSet(imageSetOption css.Value, more ...css.Value) css.Function {
+	return css.Func("image-set", fnArgs([]css.Value{imageSetOption}, more)...)
+}
+
+// FnInset builds inset( <length-percentage>{1,4} [ round <'border-radius'> ]? ).
+// Pass "" to omit an optional argument.
+func FnInset(lengthPercentage1 css.Length, lengthPercentage2 css.Length, lengthPercentage3 css.Length, lengthPercentage4 css.Length, borderRadius css.Length) css.Function {
+	return css.SpaceFunc("inset", fnKeywords[css.Value]([]string{"", "", "", "", "round"}, []css.Value{lengthPercentage1, lengthPercentage2, lengthPercentage3, lengthPercentage4, borderRadius}, nil)...)
+}
+
+// FnInvert builds invert( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnInvert(arg css.Value) css.Function {
+	return css.Func("invert", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnLab builds lab( [<percentage> | <number> | none] [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ / [<alpha-value> | none] ]? ).
+// Pass nil to omit an optional argument.
+func FnLab(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.SpaceFunc("lab", fnKeywords[css.Value]([]string{"", "", "", "/"}, []css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnLayer builds layer( <layer-name> ).
+func FnLayer(layerName css.Keyword) css.Function {
+	return css.Func("layer", layerName)
+}
+
+// FnLch builds lch( [<percentage> | <number> | none] [ <percentage> | <number> | none] [ <hue> | none] [ / [<alpha-value> | none] ]? ).
+// Pass nil to omit an optional argument.
+func FnLch(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.SpaceFunc("lch", fnKeywords[css.Value]([]string{"", "", "", "/"}, []css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnLightDark builds light-dark( <color>, <color> ).
+func FnLightDark(color1 css.Color, color2 css.Color) css.Color {
+	return css.Color(css.Func("light-dark", color1, color2))
+}
+
+// FnLinear builds linear( [ <number> && <percentage>{0,2} ]# ).
+func FnLinear(arg css.Value, more ...css.Value) css.Function {
+	return css.Func("linear", fnArgs([]css.Value{arg}, more)...)
+}
+
+// FnLinearGradient builds linear-gradient( [ <linear-gradient-syntax> ] ).
+func FnLinearGradient(linearGradientSyntax css.Value) css.Function {
+	return css.Func("linear-gradient", linearGradientSyntax)
+}
+
+// FnLog builds log( <calc-sum>, <calc-sum>? ) as a T, e.g. css.Length.
+// Pass nil to omit an optional argument.
+func FnLog[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("log", fnArgs[css.Value]([]css.Value{calcSum1, calcSum2}, nil)...))
+}
+
+// FnMatrix builds matrix( <number>#{6} ).
+func FnMatrix(number1 css.Value, number2 css.Value, number3 css.Value, number4 css.Value, number5 css.Value, number6 css.Value) css.Function {
+	return css.Func("matrix", number1, number2, number3, number4, number5, number6)
+}
+
+// FnMatrix3d builds matrix3d( <number>#{16} ).
+func FnMatrix3d(number1 css.Value, number2 css.Value, number3 css.Value, number4 css.Value, number5 css.Value, number6 css.Value, number7 css.Value, number8 css.Value, number9 css.Value, number10 css.Value, number11 css.Value, number12 css.Value, number13 css.Value, number14 css.Value, number15 css.Value, number16 css.Value) css.Function {
+	return css.Func("matrix3d", number1, number2, number3, number4, number5, number6, number7, number8, number9, number10, number11, number12, number13, number14, number15, number16)
+}
+
+// FnMax builds max( <calc-sum># ) as a T, e.g. css.Length.
+func FnMax[T css.VarValue](calcSum css.Value, more ...css.Value) T {
+	return T(css.Func("max", fnArgs([]css.Value{calcSum}, more)...))
+}
+
+// FnMin builds min( <calc-sum># ) as a T, e.g. css.Length.
+func FnMin[T css.VarValue](calcSum css.Value, more ...css.Value) T {
+	return T(css.Func("min", fnArgs([]css.Value{calcSum}, more)...))
+}
+
+// FnMinmax builds minmax( [ <length-percentage> | min-content | max-content | auto ] , [ <length-percentage> | <flex> | min-content | max-content | auto ] ).
+func FnMinmax(arg1 css.Value, arg2 css.Value) css.Function {
+	return css.Func("minmax", arg1, arg2)
+}
+
+// FnMod builds mod( <calc-sum>, <calc-sum> ) as a T, e.g. css.Length.
+func FnMod[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("mod", calcSum1, calcSum2))
+}
+
+// FnOklab builds oklab( [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ / [<alpha-value> | none] ]? ).
+// Pass nil to omit an optional argument.
+func FnOklab(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.SpaceFunc("oklab", fnKeywords[css.Value]([]string{"", "", "", "/"}, []css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnOklch builds oklch( [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ <hue> | none] [ / [<alpha-value> | none] ]? ).
+// Pass nil to omit an optional argument.
+func FnOklch(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.SpaceFunc("oklch", fnKeywords[css.Value]([]string{"", "", "", "/"}, []css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnOpacity builds opacity( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnOpacity(arg css.Value) css.Function {
+	return css.Func("opacity", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnPaint builds paint( <ident>, <declaration-value>? ).
+// Pass nil to omit an optional argument.
+func FnPaint(ident css.Keyword, declarationValue css.Value) css.Function {
+	return css.Func("paint", fnArgs[css.Value]([]css.Value{ident, declarationValue}, nil)...)
+}
+
+// FnPaletteMix builds palette-mix(<color-interpolation-method> , [ [normal | light | dark | <palette-identifier> | <palette-mix()> ] && <percentage [0,100]>? ]#{2}).
+func FnPaletteMix(colorInterpolationMethod css.Value, arg1 css.Value, arg2 css.Value) css.Function {
+	return css.Func("palette-mix", colorInterpolationMethod, arg1, arg2)
+}
+
+// FnPath builds path( <'fill-rule'>? , <string> ).
+// Pass nil to omit an optional argument.
+func FnPath(fillRule css.Value, stringValue css.QuotedString) css.Function {
+	return css.Func("path", fnArgs[css.Value]([]css.Value{fillRule, stringValue}, nil)...)
+}
+
+// FnPerspective builds perspective( [ <length [0,∞]> | none ] ).
+func FnPerspective(arg css.Value) css.Function {
+	return css.Func("perspective", arg)
+}
+
+// FnPolygon builds polygon( <'fill-rule'>? , [ <length-percentage> <length-percentage> ]# ).
+// Pass nil to omit an optional argument.
+func FnPolygon(fillRule css.Value, arg css.Value, more ...css.Value) css.Function {
+	return css.Func("polygon", fnArgs([]css.Value{fillRule, arg}, more)...)
+}
+
+// FnPow builds pow( <calc-sum>, <calc-sum> ) as a T, e.g. css.Length.
+func FnPow[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("pow", calcSum1, calcSum2))
+}
+
+// FnRadialGradient builds radial-gradient( [ <radial-gradient-syntax> ] ).
+func FnRadialGradient(radialGradientSyntax css.Value) css.Function {
+	return css.Func("radial-gradient", radialGradientSyntax)
+}
+
+// FnRay builds ray( <angle> && <ray-size>? && contain? && [at <position>]? ).
+func FnRay(arg css.Value) css.Function {
+	return css.Func("ray", arg)
+}
+
+// FnRect builds rect( [ <length-percentage> | auto ]{4} [ round <'border-radius'> ]? ).
+// Pass "" to omit an optional argument.
+func FnRect(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value, borderRadius css.Length) css.Function {
+	return css.SpaceFunc("rect", fnKeywords[css.Value]([]string{"", "", "", "", "round"}, []css.Value{arg1, arg2, arg3, arg4, borderRadius}, nil)...)
+}
+
+// FnRem builds rem( <calc-sum>, <calc-sum> ) as a T, e.g. css.Length.
+func FnRem[T css.VarValue](calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("rem", calcSum1, calcSum2))
+}
+
+// FnRepeatingConicGradient builds repeating-conic-gradient( [ <conic-gradient-syntax> ] ).
+func FnRepeatingConicGradient(conicGradientSyntax css.Value) css.Function {
+	return css.Func("repeating-conic-gradient", conicGradientSyntax)
+}
+
+// FnRepeatingLinearGradient builds repeating-linear-gradient( [ <linear-gradient-syntax> ] ).
+func FnRepeatingLinearGradient(linearGradientSyntax css.Value) css.Function {
+	return css.Func("repeating-linear-gradient", linearGradientSyntax)
+}
+
+// FnRepeatingRadialGradient builds repeating-radial-gradient( [ <radial-gradient-syntax> ] ).
+func FnRepeatingRadialGradient(radialGradientSyntax css.Value) css.Function {
+	return css.Func("repeating-radial-gradient", radialGradientSyntax)
+}
+
+// FnRgb builds rgb( <percentage>#{3} , <alpha-value>? ) | rgb( <number>#{3} , <alpha-value>? ) | rgb( [ <number> | <percentage> | none ]{3} [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnRgb(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.Func("rgb", fnArgs[css.Value]([]css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnRgba builds rgba( <percentage>#{3} , <alpha-value>? ) | rgba( <number>#{3} , <alpha-value>? ) | rgba( [ <number> | <percentage> | none ]{3} [ / [ <alpha-value> | none ] ]? ).
+// Pass nil to omit an optional argument.
+func FnRgba(arg1 css.Value, arg2 css.Value, arg3 css.Value, arg4 css.Value) css.Color {
+	return css.Color(css.Func("rgba", fnArgs[css.Value]([]css.Value{arg1, arg2, arg3, arg4}, nil)...))
+}
+
+// FnRotate builds rotate( [ <angle> | <zero> ] ).
+func FnRotate(arg css.Value) css.Function {
+	return css.Func("rotate", arg)
+}
+
+// FnRotate3d builds rotate3d( <number> , <number> , <number> , [ <angle> | <zero> ] ).
+func FnRotate3d(number1 css.Value, number2 css.Value, number3 css.Value, arg css.Value) css.Function {
+	return css.Func("rotate3d", number1, number2, number3, arg)
+}
+
+// FnRotateX builds rotateX( [ <angle> | <zero> ] ).
+func FnRotateX(arg css.Value) css.Function {
+	return css.Func("rotateX", arg)
+}
+
+// FnRotateY builds rotateY( [ <angle> | <zero> ] ).
+func FnRotateY(arg css.Value) css.Function {
+	return css.Func("rotateY", arg)
+}
+
+// FnRotateZ builds rotateZ( [ <angle> | <zero> ] ).
+func FnRotateZ(arg css.Value) css.Function {
+	return css.Func("rotateZ", arg)
+}
+
+// FnRound builds round( <rounding-strategy>?, <calc-sum>, <calc-sum> ) as a T, e.g. css.Length.
+// Pass nil to omit an optional argument.
+func FnRound[T css.VarValue](roundingStrategy css.Value, calcSum1 css.Value, calcSum2 css.Value) T {
+	return T(css.Func("round", fnArgs[css.Value]([]css.Value{roundingStrategy, calcSum1, calcSum2}, nil)...))
+}
+
+// FnSaturate builds saturate( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnSaturate(arg css.Value) css.Function {
+	return css.Func("saturate", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnScale builds scale( [ <number> | <percentage> ]#{1,2} ).
+// Pass nil to omit an optional argument.
+func FnScale(arg1 css.Value, arg2 css.Value) css.Function {
+	return css.Func("scale", fnArgs[css.Value]([]css.Value{arg1, arg2}, nil)...)
+}
+
+// FnScale3d builds scale3d( [ <number> | <percentage> ]#{3} ).
+func FnScale3d(arg1 css.Value, arg2 css.Value, arg3 css.Value) css.Function {
+	return css.Func("scale3d", arg1, arg2, arg3)
+}
+
+// FnScaleX builds scaleX( [ <number> | <percentage> ] ).
+func FnScaleX(arg css.Value) css.Function {
+	return css.Func("scaleX", arg)
+}
+
+// FnScaleY builds scaleY( [ <number> | <percentage> ] ).
+func FnScaleY(arg css.Value) css.Function {
+	return css.Func("scaleY", arg)
+}
+
+// FnScaleZ builds scaleZ( [ <number> | <percentage> ] ).
+func FnScaleZ(arg css.Value) css.Function {
+	return css.Func("scaleZ", arg)
+}
+
+// FnSepia builds sepia( [ <number> | <percentage> ]? ).
+// Pass nil to omit an optional argument.
+func FnSepia(arg css.Value) css.Function {
+	return css.Func("sepia", fnArgs[css.Value]([]css.Value{arg}, nil)...)
+}
+
+// FnSign builds sign( <calc-sum> ) as a T, e.g. css.Length.
+func FnSign[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("sign", calcSum))
+}
+
+// FnSin builds sin( <calc-sum> ) as a T, e.g. css.Length.
+func FnSin[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("sin", calcSum))
+}
+
+// FnSkew builds skew( [ <angle> | <zero> ] , [ <angle> | <zero> ]? ).
+// Pass nil to omit an optional argument.
+func FnSkew(arg1 css.Value, arg2 css.Value) css.Function {
+	return css.Func("skew", fnArgs[css.Value]([]css.Value{arg1, arg2}, nil)...)
+}
+
+// FnSkewX builds skewX( [ <angle> | <zero> ] ).
+func FnSkewX(arg css.Value) css.Function {
+	return css.Func("skewX", arg)
+}
+
+// FnSkewY builds skewY( [ <angle> | <zero> ] ).
+func FnSkewY(arg css.Value) css.Function {
+	return css.Func("skewY", arg)
+}
+
+// FnSqrt builds sqrt( <calc-sum> ) as a T, e.g. css.Length.
+func FnSqrt[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("sqrt", calcSum))
+}
+
+// FnSteps builds steps( <integer>, <step-position>? ).
+// Pass nil to omit an optional argument.
+func FnSteps(integer css.Integer, stepPosition css.Value) css.Function {
+	return css.Func("steps", fnArgs[css.Value]([]css.Value{integer, stepPosition}, nil)...)
+}
+
+// FnSymbols builds symbols( <symbols-type>? [ <string> | <image> ]+ ).
+// Pass nil to omit an optional argument.
+func FnSymbols(symbolsType css.Value, arg css.Value, more ...css.Value) css.Function {
+	return css.SpaceFunc("symbols", fnArgs([]css.Value{symbolsType, arg}, more)...)
+}
+
+// FnTan builds tan( <calc-sum> ) as a T, e.g. css.Length.
+func FnTan[T css.VarValue](calcSum css.Value) T {
+	return T(css.Func("tan", calcSum))
+}
+
+// FnTranslate builds translate( <length-percentage> , <length-percentage>? ).
+// Pass "" to omit an optional argument.
+func FnTranslate(lengthPercentage1 css.Length, lengthPercentage2 css.Length) css.Function {
+	return css.Func("translate", fnArgs[css.Value]([]css.Value{lengthPercentage1, lengthPercentage2}, nil)...)
+}
+
+// FnTranslate3d builds translate3d( <length-percentage> , <length-percentage> , <length> ).
+func FnTranslate3d(lengthPercentage1 css.Length, lengthPercentage2 css.Length, length css.Length) css.Function {
+	return css.Func("translate3d", lengthPercentage1, lengthPercentage2, length)
+}
+
+// FnTranslateX builds translateX( <length-percentage> ).
+func FnTranslateX(lengthPercentage css.Length) css.Function {
+	return css.Func("translateX", lengthPercentage)
+}
+
+// FnTranslateY builds translateY( <length-percentage> ).
+func FnTranslateY(lengthPercentage css.Length) css.Function {
+	return css.Func("translateY", lengthPercentage)
+}
+
+// FnTranslateZ builds translateZ( <length> ).
+func FnTranslateZ(length css.Length) css.Function {
+	return css.Func("translateZ", length)
+}
+
+// FnVar builds var( <custom-property-name> , <declaration-value>? ).
+// Pass nil to omit an optional argument.
+func FnVar(customPropertyName css.Value, declarationValue css.Value) css.Function {
+	return css.Func("var", fnArgs[css.Value]([]css.Value{customPropertyName, declarationValue}, nil)...)
+}
+
+// FnXywh builds xywh( <length-percentage>{2} <length-percentage [0,∞]>{2} [ round <'border-radius'> ]? ).
+// Pass "" to omit an optional argument.
+func FnXywh(lengthPercentage1 css.Length, lengthPercentage2 css.Length, lengthPercentage3 css.Length, lengthPercentage4 css.Length, borderRadius css.Length) css.Function {
+	return css.SpaceFunc("xywh", fnKeywords[css.Value]([]string{"", "", "", "", "round"}, []css.Value{lengthPercentage1, lengthPercentage2, lengthPercentage3, lengthPercentage4, borderRadius}, nil)...)
+}
@@ -0,0 +1,490 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
+
+//go:build cssexperimental
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// init adds the experimental features to the metadata tables.
+func init() {
+	for p, info := range map[css.Property]PropertyInfo{
+		AnchorName: {
+			Name:          AnchorName,
+			Syntax:        "none | <dashed-ident>#",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElementsThatGenerateAPrincipalBox",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/anchor-name",
+		},
+		AnchorScope: {
+			Name:          AnchorScope,
+			Syntax:        "none | all | <dashed-ident>#",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+		},
+		AnimationRange: {
+			Name:      AnimationRange,
+			Syntax:    "[ <'animation-range-start'> <'animation-range-end'>? ]#",
+			Longhands: []string{"animation-range-end", "animation-range-start"},
+			AppliesTo: "allElements",
+			Groups:    []string{"Scroll-driven Animations"},
+			Status:    "experimental",
+			MDNURL:    "https://developer.mozilla.org/docs/Web/CSS/animation-range",
+		},
+		AnimationRangeEnd: {
+			Name:          AnimationRangeEnd,
+			Syntax:        "[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#",
+			Initial:       "normal",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "listEachItemConsistingOfNormalLengthPercentageOrNameLengthPercentage",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-range-end",
+		},
+		AnimationRangeStart: {
+			Name:          AnimationRangeStart,
+			Syntax:        "[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#",
+			Initial:       "normal",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "listEachItemConsistingOfNormalLengthPercentageOrNameLengthPercentage",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-range-start",
+		},
+		AnimationTimeline: {
+			Name:          AnimationTimeline,
+			Syntax:        "<single-animation-timeline>#",
+			Initial:       "auto",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "listEachItemIdentifierOrNoneAuto",
+			Groups:        []string{"CSS Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-timeline",
+		},
+		FieldSizing: {
+			Name:          FieldSizing,
+			Syntax:        "content | fixed",
+			Initial:       "fixed",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "elementsWithDefaultPreferredSize",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Basic User Interface"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/field-sizing",
+		},
+		FontSynthesisPosition: {
+			Name:          FontSynthesisPosition,
+			Syntax:        "auto | none",
+			Initial:       "none",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElementsAndText",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Fonts"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-synthesis-position",
+		},
+		FontWidth: {
+			Name:          FontWidth,
+			Syntax:        "normal | <percentage [0,∞]> | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded",
+			Initial:       "normal",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "byComputedValueType",
+			AppliesTo:     "allElementsAndText",
+			Computed:      "percentage",
+			Groups:        []string{"CSS Fonts"},
+			Status:        "experimental",
+		},
+		ImageResolution: {
+			Name:          ImageResolution,
+			Syntax:        "[ from-image || <resolution> ] && snap?",
+			Initial:       "1dppx",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecifiedWithExceptionOfResolution",
+			Groups:        []string{"CSS Images"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/image-resolution",
+		},
+		InitialLetterAlign: {
+			Name:          InitialLetterAlign,
+			Syntax:        "[ auto | alphabetic | hanging | ideographic ]",
+			Initial:       "auto",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "firstLetterPseudoElementsAndInlineLevelFirstChildren",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Inline"},
+			Status:        "experimental",
+		},
+		InterpolateSize: {
+			Name:          InterpolateSize,
+			Syntax:        "numeric-only | allow-keywords",
+			Initial:       "numeric-only",
+			Inherited:     true,
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Values and Units"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/interpolate-size",
+		},
+		LineHeightStep: {
+			Name:          LineHeightStep,
+			Syntax:        "<length>",
+			Initial:       "0",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "byComputedValueType",
+			AppliesTo:     "blockContainers",
+			Computed:      "absoluteLength",
+			Groups:        []string{"CSS Rhythmic Sizing"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/line-height-step",
+		},
+		MarginTrim: {
+			Name:          MarginTrim,
+			Syntax:        "none | in-flow | all",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "blockContainersAndMultiColumnContainers",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Box Model"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-trim",
+		},
+		MathShift: {
+			Name:          MathShift,
+			Syntax:        "normal | compact",
+			Initial:       "normal",
+			Inherited:     true,
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"MathML"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/math-shift",
+		},
+		MaxLines: {
+			Name:          MaxLines,
+			Syntax:        "none | <integer>",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "integer",
+			AppliesTo:     "blockContainersExceptMultiColumnContainers",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Overflow"},
+			Status:        "experimental",
+		},
+		ObjectViewBox: {
+			Name:          ObjectViewBox,
+			Syntax:        "none | <basic-shape-rect>",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "asIfPossibleOtherwiseDiscrete",
+			AppliesTo:     "replacedElements",
+			Computed:      "specifiedKeywordOrComputedFunction",
+			Groups:        []string{"CSS Images"},
+			Status:        "experimental",
+		},
+		Overlay: {
+			Name:          Overlay,
+			Syntax:        "none | auto",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discreteButVisibleForDurationWhenAnimatedNone",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Positioned Layout"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overlay",
+		},
+		PositionAnchor: {
+			Name:          PositionAnchor,
+			Syntax:        "auto | <anchor-name>",
+			Initial:       "auto",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "absolutelyPositionedElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position-anchor",
+		},
+		PositionArea: {
+			Name:          PositionArea,
+			Syntax:        "none | <position-area>",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "positionedElementsWithADefaultAnchorElement",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position-area",
+		},
+		PositionTry: {
+			Name:       PositionTry,
+			Syntax:     "<'position-try-order'>? <'position-try-fallbacks'>",
+			Longhands:  []string{"position-try-fallbacks", "position-try-order"},
+			Animatable: true,
+			AppliesTo:  "absolutelyPositionedElements",
+			Groups:     []string{"CSS Anchor Positioning"},
+			Status:     "experimental",
+			MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/position-try",
+		},
+		PositionTryFallbacks: {
+			Name:          PositionTryFallbacks,
+			Syntax:        "none | [ [<dashed-ident> || <try-tactic>] | <'position-area'> ]#",
+			Initial:       "none",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "absolutelyPositionedElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position-try-fallbacks",
+		},
+		PositionTryOrder: {
+			Name:          PositionTryOrder,
+			Syntax:        "normal | <try-size>",
+			Initial:       "normal",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "absolutelyPositionedElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position-try-order",
+		},
+		PositionVisibility: {
+			Name:          PositionVisibility,
+			Syntax:        "always | [ anchors-valid || anchors-visible || no-overflow ]",
+			Initial:       "anchors-visible",
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "absolutelyPositionedElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Anchor Positioning"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position-visibility",
+		},
+		RubyMerge: {
+			Name:          RubyMerge,
+			Syntax:        "separate | collapse | auto",
+			Initial:       "separate",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "byComputedValueType",
+			AppliesTo:     "rubyAnnotationContainers",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Ruby"},
+			Status:        "experimental",
+		},
+		ScrollInitialTarget: {
+			Name:          ScrollInitialTarget,
+			Syntax:        "none | nearest",
+			Initial:       "none",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "theSpecifiedKeyword",
+			Groups:        []string{"CSS Scroll Snap"},
+			Status:        "experimental",
+		},
+		ScrollTimeline: {
+			Name:      ScrollTimeline,
+			Syntax:    "[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#",
+			Longhands: []string{"scroll-timeline-axis", "scroll-timeline-name"},
+			AppliesTo: "scrollContainers",
+			Groups:    []string{"Scroll-driven Animations"},
+			Status:    "experimental",
+			MDNURL:    "https://developer.mozilla.org/docs/Web/CSS/scroll-timeline",
+		},
+		ScrollTimelineAxis: {
+			Name:          ScrollTimelineAxis,
+			Syntax:        "[ block | inline | x | y ]#",
+			Initial:       "block",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "scrollContainers",
+			Computed:      "asSpecified",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-axis",
+		},
+		ScrollTimelineName: {
+			Name:          ScrollTimelineName,
+			Syntax:        "[ none | <dashed-ident> ]#",
+			Initial:       "none",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "scrollContainers",
+			Computed:      "noneOrOrderedListOfIdentifiers",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-name",
+		},
+		SpeakAs: {
+			Name:          SpeakAs,
+			Syntax:        "normal | spell-out || digits || [ literal-punctuation | no-punctuation ]",
+			Initial:       "auto",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElements",
+			Computed:      "specifiedValue",
+			Groups:        []string{"CSS Speech"},
+			Status:        "experimental",
+		},
+		StrokeColor: {
+			Name:          StrokeColor,
+			Syntax:        "<color>",
+			Initial:       "transparent",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "byComputedValue",
+			AppliesTo:     "textAndSVGShapes",
+			Computed:      "computedColor",
+			Groups:        []string{"Scalable Vector Graphics"},
+			Status:        "experimental",
+		},
+		TextDecorationSkip: {
+			Name:          TextDecorationSkip,
+			Syntax:        "none | [ objects || [ spaces | [ leading-spaces || trailing-spaces ] ] || edges || box-decoration ]",
+			Initial:       "objects",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Text Decoration"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip",
+		},
+		TextSizeAdjust: {
+			Name:          TextSizeAdjust,
+			Syntax:        "none | auto | <percentage>",
+			Initial:       "autoForSmartphoneBrowsersSupportingInflation",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "byComputedValueType",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Mobile Text Size Adjustment"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-size-adjust",
+		},
+		TextSpacingTrim: {
+			Name:          TextSpacingTrim,
+			Syntax:        "space-all | normal | space-first | trim-start",
+			Initial:       "normal",
+			Inherited:     true,
+			Animatable:    true,
+			AnimationType: "discrete",
+			AppliesTo:     "textElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"CSS Text"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-spacing-trim",
+		},
+		TimelineScope: {
+			Name:          TimelineScope,
+			Syntax:        "none | <dashed-ident>#",
+			Initial:       "none",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "noneOrOrderedListOfIdentifiers",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/timeline-scope",
+		},
+		ViewTimeline: {
+			Name:      ViewTimeline,
+			Syntax:    "[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#",
+			Longhands: []string{"view-timeline-axis", "view-timeline-name"},
+			AppliesTo: "allElements",
+			Groups:    []string{"Scroll-driven Animations"},
+			Status:    "experimental",
+			MDNURL:    "https://developer.mozilla.org/docs/Web/CSS/view-timeline",
+		},
+		ViewTimelineAxis: {
+			Name:          ViewTimelineAxis,
+			Syntax:        "[ block | inline | x | y ]#",
+			Initial:       "block",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "asSpecified",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/view-timeline-axis",
+		},
+		ViewTimelineInset: {
+			Name:          ViewTimelineInset,
+			Syntax:        "[ [ auto | <length-percentage> ]{1,2} ]#",
+			Initial:       "auto",
+			Animatable:    true,
+			AnimationType: "byComputedValueType",
+			AppliesTo:     "allElements",
+			Computed:      "listEachItemConsistingOfPairsOfAutoOrLengthPercentage",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/view-timeline-inset",
+		},
+		ViewTimelineName: {
+			Name:          ViewTimelineName,
+			Syntax:        "[ none | <dashed-ident> ]#",
+			Initial:       "none",
+			AnimationType: "notAnimatable",
+			AppliesTo:     "allElements",
+			Computed:      "noneOrOrderedListOfIdentifiers",
+			Groups:        []string{"Scroll-driven Animations"},
+			Status:        "experimental",
+			MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/view-timeline-name",
+		},
+	} {
+		propertyInfo[p] = info
+	}
+	for name, status := range map[string]Status{
+		"::cue-region":          "experimental",
+		"::cue-region()":        "experimental",
+		"::scroll-marker":       "experimental",
+		"::scroll-marker-group": "experimental",
+		":blank":                "experimental",
+		":current":              "experimental",
+		":local-link":           "experimental",
+		":target-current":       "experimental",
+		":target-within":        "experimental",
+		":xr-overlay":           "experimental",
+		"@position-try":         "experimental",
+		"anchor()":              "experimental",
+		"anchor-size()":         "experimental",
+		"calc-size()":           "experimental",
+		"element()":             "experimental",
+		"leader()":              "experimental",
+		"scroll()":              "experimental",
+		"target-counter()":      "experimental",
+		"target-counters()":     "experimental",
+		"target-text()":         "experimental",
+		"view()":                "experimental",
+	} {
+		statuses[name] = status
+	}
+}
@@ -0,0 +1,5930 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// PropertyInfo describes a CSS property using the metadata from MDN.
+type PropertyInfo struct {
+	Name          css.Property
+	Syntax        string   // value definition syntax
+	Initial       string   // initial value; empty for shorthands
+	Longhands     []string // properties set by a shorthand, sorted by name
+	Inherited     bool
+	Animatable    bool
+	AnimationType string // e.g. "length", "discrete"; empty for shorthands
+	AppliesTo     string // MDN key such as "allElements"
+	Computed      string // MDN key such as "asSpecified"; empty for shorthands
+	Groups        []string
+	Status        Status
+	MDNURL        string
+}
+
+// IsShorthand reports whether the property sets other properties.
+func (i PropertyInfo) IsShorthand() bool { return len(i.Longhands) > 0 }
+
+// Status is the standardization status of a CSS feature on MDN.
+// Experimental features are only generated with the cssexperimental build tag,
+// and deprecated ones are marked as such.
+type Status string
+
+// Statuses used by MDN.
+const (
+	StatusStandard     Status = "standard"
+	StatusNonstandard  Status = "nonstandard"
+	StatusExperimental Status = "experimental"
+	StatusObsolete     Status = "obsolete"
+	StatusDeprecated   Status = "deprecated"
+)
+
+// Experimental reports whether the feature is experimental.
+func (s Status) Experimental() bool { return s == StatusExperimental }
+
+// Deprecated reports whether the feature is obsolete or deprecated.
+func (s Status) Deprecated() bool { return s == StatusObsolete || s == StatusDeprecated }
+
+// Info returns the metadata for a property, or the zero PropertyInfo if the
+// property is unknown.
+// e.g., Info(Color).Inherited == true
+func Info(p css.Property) PropertyInfo {
+	info, _ := LookupInfo(p)
+	return info
+}
+
+// LookupInfo returns the metadata for a property and whether it is known.
+func LookupInfo(p css.Property) (PropertyInfo, bool) {
+	info, ok := propertyInfo[p]
+	if !ok {
+		return PropertyInfo{}, false
+	}
+	info.Longhands = append([]string(nil), info.Longhands...)
+	info.Groups = append([]string(nil), info.Groups...)
+	return info, true
+}
+
+// StatusOf returns the status of a generated feature, named as in CSS: "color"
+// for a property, "@font-face" for an at-rule, "@font-face font-display" for
+// one of its descriptors, ":hover" or ":not()" for a selector and "rgb()" for
+// a function. It returns "" for unknown names.
+// e.g., StatusOf("clip").Deprecated() == true
+func StatusOf(name string) Status {
+	if info, ok := propertyInfo[css.Property(name)]; ok {
+		return info.Status
+	}
+	return statuses[name]
+}
+
+var propertyInfo = map[css.Property]PropertyInfo{
+	AccentColor: {
+		Name:          AccentColor,
+		Syntax:        "auto | <color>",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asAutoOrColor",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/accent-color",
+	},
+	AlignContent: {
+		Name:          AlignContent,
+		Syntax:        "normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersMultiColumnContainersFlexContainersGridContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Alignment", "CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/align-content",
+	},
+	AlignItems: {
+		Name:          AlignItems,
+		Syntax:        "normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ] | anchor-center",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Alignment", "CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/align-items",
+	},
+	AlignSelf: {
+		Name:          AlignSelf,
+		Syntax:        "auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position> | anchor-center",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "flexItemsGridItemsAndAbsolutelyPositionedBoxes",
+		Computed:      "autoOnAbsolutelyPositionedElementsValueOfAlignItemsOnParent",
+		Groups:        []string{"CSS Box Alignment", "CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/align-self",
+	},
+	AlignTracks: {
+		Name:          AlignTracks,
+		Syntax:        "[ normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position> ]#",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridContainersWithMasonryLayoutInTheirBlockAxis",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "nonstandard",
+	},
+	AlignmentBaseline: {
+		Name:          AlignmentBaseline,
+		Syntax:        "baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge",
+		Initial:       "baseline",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "inlineLevelBoxesFlexItemsGridItemsTableCellsAndSVGTextContentElements",
+		Computed:      "theSpecifiedKeyword",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/alignment-baseline",
+	},
+	All: {
+		Name:          All,
+		Syntax:        "initial | inherit | unset | revert | revert-layer",
+		Initial:       "noPracticalInitialValue",
+		Animatable:    true,
+		AnimationType: "eachOfShorthandPropertiesExceptUnicodeBiDiAndDirection",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedAppliesToEachProperty",
+		Groups:        []string{"CSS Cascading and Inheritance"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/all",
+	},
+	Animation: {
+		Name:          Animation,
+		Syntax:        "<single-animation>#",
+		Longhands:     []string{"animation-delay", "animation-direction", "animation-duration", "animation-fill-mode", "animation-iteration-count", "animation-name", "animation-play-state", "animation-timeline", "animation-timing-function"},
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation",
+	},
+	AnimationComposition: {
+		Name:          AnimationComposition,
+		Syntax:        "<single-animation-composition>#",
+		Initial:       "replace",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-composition",
+	},
+	AnimationDelay: {
+		Name:          AnimationDelay,
+		Syntax:        "<time>#",
+		Initial:       "0s",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-delay",
+	},
+	AnimationDirection: {
+		Name:          AnimationDirection,
+		Syntax:        "<single-animation-direction>#",
+		Initial:       "normal",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-direction",
+	},
+	AnimationDuration: {
+		Name:          AnimationDuration,
+		Syntax:        "[ auto | <time [0s,∞]> ]#",
+		Initial:       "0s",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-duration",
+	},
+	AnimationFillMode: {
+		Name:          AnimationFillMode,
+		Syntax:        "<single-animation-fill-mode>#",
+		Initial:       "none",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-fill-mode",
+	},
+	AnimationIterationCount: {
+		Name:          AnimationIterationCount,
+		Syntax:        "<single-animation-iteration-count>#",
+		Initial:       "1",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-iteration-count",
+	},
+	AnimationName: {
+		Name:          AnimationName,
+		Syntax:        "[ none | <keyframes-name> ]#",
+		Initial:       "none",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-name",
+	},
+	AnimationPlayState: {
+		Name:          AnimationPlayState,
+		Syntax:        "<single-animation-play-state>#",
+		Initial:       "running",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-play-state",
+	},
+	AnimationTimingFunction: {
+		Name:          AnimationTimingFunction,
+		Syntax:        "<easing-function>#",
+		Initial:       "ease",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Animations"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/animation-timing-function",
+	},
+	Appearance: {
+		Name:          Appearance,
+		Syntax:        "none | auto | <compat-auto> | <compat-special>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/appearance",
+	},
+	AspectRatio: {
+		Name:          AspectRatio,
+		Syntax:        "auto || <ratio>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsExceptInlineBoxesAndInternalRubyOrTableBoxes",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/aspect-ratio",
+	},
+	BackdropFilter: {
+		Name:          BackdropFilter,
+		Syntax:        "none | <filter-value-list>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "filterList",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/backdrop-filter",
+	},
+	BackfaceVisibility: {
+		Name:          BackfaceVisibility,
+		Syntax:        "visible | hidden",
+		Initial:       "visible",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/backface-visibility",
+	},
+	Background: {
+		Name:       Background,
+		Syntax:     "<bg-layer>#? , <final-bg-layer>",
+		Longhands:  []string{"background-attachment", "background-clip", "background-color", "background-image", "background-origin", "background-position", "background-repeat", "background-size"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/background",
+	},
+	BackgroundAttachment: {
+		Name:          BackgroundAttachment,
+		Syntax:        "<attachment>#",
+		Initial:       "scroll",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-attachment",
+	},
+	BackgroundBlendMode: {
+		Name:          BackgroundBlendMode,
+		Syntax:        "<blend-mode>#",
+		Initial:       "normal",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsSVGContainerGraphicsAndGraphicsReferencingElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Compositing and Blending"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-blend-mode",
+	},
+	BackgroundClip: {
+		Name:          BackgroundClip,
+		Syntax:        "<bg-clip>#",
+		Initial:       "border-box",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-clip",
+	},
+	BackgroundColor: {
+		Name:          BackgroundColor,
+		Syntax:        "<color>",
+		Initial:       "transparent",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-color",
+	},
+	BackgroundImage: {
+		Name:          BackgroundImage,
+		Syntax:        "<bg-image>#",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-image",
+	},
+	BackgroundOrigin: {
+		Name:          BackgroundOrigin,
+		Syntax:        "<visual-box>#",
+		Initial:       "padding-box",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-origin",
+	},
+	BackgroundPosition: {
+		Name:          BackgroundPosition,
+		Syntax:        "<bg-position>#",
+		Initial:       "0% 0%",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-position",
+	},
+	BackgroundPositionX: {
+		Name:          BackgroundPositionX,
+		Syntax:        "[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#",
+		Initial:       "0%",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Computed:      "listEachItemConsistingOfAbsoluteLengthPercentageAndOrigin",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-position-x",
+	},
+	BackgroundPositionY: {
+		Name:          BackgroundPositionY,
+		Syntax:        "[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#",
+		Initial:       "0%",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Computed:      "listEachItemConsistingOfAbsoluteLengthPercentageAndOrigin",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-position-y",
+	},
+	BackgroundRepeat: {
+		Name:          BackgroundRepeat,
+		Syntax:        "<repeat-style>#",
+		Initial:       "repeat",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "listEachItemHasTwoKeywordsOnePerDimension",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-repeat",
+	},
+	BackgroundSize: {
+		Name:          BackgroundSize,
+		Syntax:        "<bg-size>#",
+		Initial:       "auto auto",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/background-size",
+	},
+	BaselineShift: {
+		Name:          BaselineShift,
+		Syntax:        "<length-percentage> | sub | super | baseline",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "inlineLevelBoxesAndSVGTextContentElements",
+		Computed:      "theSpecifiedKeywordOrAComputedLengthPercentageValue",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+	},
+	BlockSize: {
+		Name:          BlockSize,
+		Syntax:        "<'width'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsWidthAndHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/block-size",
+	},
+	Border: {
+		Name:       Border,
+		Syntax:     "<line-width> || <line-style> || <color>",
+		Longhands:  []string{"border-color", "border-style", "border-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border",
+	},
+	BorderBlock: {
+		Name:       BorderBlock,
+		Syntax:     "<'border-block-start'>",
+		Longhands:  []string{"border-block-color", "border-block-style", "border-block-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-block",
+	},
+	BorderBlockColor: {
+		Name:          BorderBlockColor,
+		Syntax:        "<'border-top-color'>{1,2}",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-color",
+	},
+	BorderBlockEnd: {
+		Name:       BorderBlockEnd,
+		Syntax:     "<'border-top-width'> || <'border-top-style'> || <color>",
+		Longhands:  []string{"border-top-color", "border-top-style", "border-top-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-block-end",
+	},
+	BorderBlockEndColor: {
+		Name:          BorderBlockEndColor,
+		Syntax:        "<'border-top-color'>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-end-color",
+	},
+	BorderBlockEndStyle: {
+		Name:          BorderBlockEndStyle,
+		Syntax:        "<'border-top-style'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-end-style",
+	},
+	BorderBlockEndWidth: {
+		Name:          BorderBlockEndWidth,
+		Syntax:        "<'border-top-width'>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-end-width",
+	},
+	BorderBlockStart: {
+		Name:       BorderBlockStart,
+		Syntax:     "<'border-top-width'> || <'border-top-style'> || <color>",
+		Longhands:  []string{"border-style", "border-width", "color"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-block-start",
+	},
+	BorderBlockStartColor: {
+		Name:          BorderBlockStartColor,
+		Syntax:        "<'border-top-color'>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-start-color",
+	},
+	BorderBlockStartStyle: {
+		Name:          BorderBlockStartStyle,
+		Syntax:        "<'border-top-style'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-start-style",
+	},
+	BorderBlockStartWidth: {
+		Name:          BorderBlockStartWidth,
+		Syntax:        "<'border-top-width'>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-start-width",
+	},
+	BorderBlockStyle: {
+		Name:          BorderBlockStyle,
+		Syntax:        "<'border-top-style'>{1,2}",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-style",
+	},
+	BorderBlockWidth: {
+		Name:          BorderBlockWidth,
+		Syntax:        "<'border-top-width'>{1,2}",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-block-width",
+	},
+	BorderBottom: {
+		Name:       BorderBottom,
+		Syntax:     "<line-width> || <line-style> || <color>",
+		Longhands:  []string{"border-bottom-color", "border-bottom-style", "border-bottom-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-bottom",
+	},
+	BorderBottomColor: {
+		Name:          BorderBottomColor,
+		Syntax:        "<'border-top-color'>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-bottom-color",
+	},
+	BorderBottomLeftRadius: {
+		Name:          BorderBottomLeftRadius,
+		Syntax:        "<length-percentage [0,∞]>{1,2}",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-bottom-left-radius",
+	},
+	BorderBottomRightRadius: {
+		Name:          BorderBottomRightRadius,
+		Syntax:        "<length-percentage [0,∞]>{1,2}",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-bottom-right-radius",
+	},
+	BorderBottomStyle: {
+		Name:          BorderBottomStyle,
+		Syntax:        "<line-style>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-bottom-style",
+	},
+	BorderBottomWidth: {
+		Name:          BorderBottomWidth,
+		Syntax:        "<line-width>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthOr0IfBorderBottomStyleNoneOrHidden",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-bottom-width",
+	},
+	BorderCollapse: {
+		Name:          BorderCollapse,
+		Syntax:        "separate | collapse",
+		Initial:       "separate",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "tableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Table"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-collapse",
+	},
+	BorderColor: {
+		Name:       BorderColor,
+		Syntax:     "<color>{1,4}",
+		Longhands:  []string{"border-bottom-color", "border-left-color", "border-right-color", "border-top-color"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-color",
+	},
+	BorderEndEndRadius: {
+		Name:          BorderEndEndRadius,
+		Syntax:        "<'border-top-left-radius'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-end-end-radius",
+	},
+	BorderEndStartRadius: {
+		Name:          BorderEndStartRadius,
+		Syntax:        "<'border-top-left-radius'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-end-start-radius",
+	},
+	BorderImage: {
+		Name:       BorderImage,
+		Syntax:     "<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>",
+		Longhands:  []string{"border-image-outset", "border-image-repeat", "border-image-slice", "border-image-source", "border-image-width"},
+		Animatable: true,
+		AppliesTo:  "allElementsExceptTableElementsWhenCollapse",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-image",
+	},
+	BorderImageOutset: {
+		Name:          BorderImageOutset,
+		Syntax:        "[ <length [0,∞]> | <number [0,∞]> ]{1,4}  ",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsExceptTableElementsWhenCollapse",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-image-outset",
+	},
+	BorderImageRepeat: {
+		Name:          BorderImageRepeat,
+		Syntax:        "[ stretch | repeat | round | space ]{1,2}",
+		Initial:       "stretch",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsExceptTableElementsWhenCollapse",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-image-repeat",
+	},
+	BorderImageSlice: {
+		Name:          BorderImageSlice,
+		Syntax:        "[ <number [0,∞]> | <percentage [0,∞]> ]{1,4}  && fill?",
+		Initial:       "100%",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsExceptTableElementsWhenCollapse",
+		Computed:      "oneToFourPercentagesOrAbsoluteLengthsPlusFill",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-image-slice",
+	},
+	BorderImageSource: {
+		Name:          BorderImageSource,
+		Syntax:        "none | <image>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsExceptTableElementsWhenCollapse",
+		Computed:      "noneOrImageWithAbsoluteURI",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-image-source",
+	},
+	BorderImageWidth: {
+		Name:          BorderImageWidth,
+		Syntax:        "[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}",
+		Initial:       "1",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsExceptTableElementsWhenCollapse",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-image-width",
+	},
+	BorderInline: {
+		Name:       BorderInline,
+		Syntax:     "<'border-block-start'>",
+		Longhands:  []string{"border-inline-color", "border-inline-style", "border-inline-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-inline",
+	},
+	BorderInlineColor: {
+		Name:          BorderInlineColor,
+		Syntax:        "<'border-top-color'>{1,2}",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-color",
+	},
+	BorderInlineEnd: {
+		Name:       BorderInlineEnd,
+		Syntax:     "<'border-top-width'> || <'border-top-style'> || <color>",
+		Longhands:  []string{"border-style", "border-width", "color"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-inline-end",
+	},
+	BorderInlineEndColor: {
+		Name:          BorderInlineEndColor,
+		Syntax:        "<'border-top-color'>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-end-color",
+	},
+	BorderInlineEndStyle: {
+		Name:          BorderInlineEndStyle,
+		Syntax:        "<'border-top-style'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-end-style",
+	},
+	BorderInlineEndWidth: {
+		Name:          BorderInlineEndWidth,
+		Syntax:        "<'border-top-width'>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-end-width",
+	},
+	BorderInlineStart: {
+		Name:       BorderInlineStart,
+		Syntax:     "<'border-top-width'> || <'border-top-style'> || <color>",
+		Longhands:  []string{"border-style", "border-width", "color"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Logical Properties and Values"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-inline-start",
+	},
+	BorderInlineStartColor: {
+		Name:          BorderInlineStartColor,
+		Syntax:        "<'border-top-color'>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-start-color",
+	},
+	BorderInlineStartStyle: {
+		Name:          BorderInlineStartStyle,
+		Syntax:        "<'border-top-style'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-start-style",
+	},
+	BorderInlineStartWidth: {
+		Name:          BorderInlineStartWidth,
+		Syntax:        "<'border-top-width'>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-start-width",
+	},
+	BorderInlineStyle: {
+		Name:          BorderInlineStyle,
+		Syntax:        "<'border-top-style'>{1,2}",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-style",
+	},
+	BorderInlineWidth: {
+		Name:          BorderInlineWidth,
+		Syntax:        "<'border-top-width'>{1,2}",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthZeroIfBorderStyleNoneOrHidden",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-inline-width",
+	},
+	BorderLeft: {
+		Name:       BorderLeft,
+		Syntax:     "<line-width> || <line-style> || <color>",
+		Longhands:  []string{"border-left-color", "border-left-style", "border-left-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-left",
+	},
+	BorderLeftColor: {
+		Name:          BorderLeftColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-left-color",
+	},
+	BorderLeftStyle: {
+		Name:          BorderLeftStyle,
+		Syntax:        "<line-style>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-left-style",
+	},
+	BorderLeftWidth: {
+		Name:          BorderLeftWidth,
+		Syntax:        "<line-width>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthOr0IfBorderLeftStyleNoneOrHidden",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-left-width",
+	},
+	BorderRadius: {
+		Name:       BorderRadius,
+		Syntax:     "<length-percentage [0,∞]>{1,4} [ / <length-percentage [0,∞]>{1,4} ]?",
+		Longhands:  []string{"border-bottom-left-radius", "border-bottom-right-radius", "border-top-left-radius", "border-top-right-radius"},
+		Animatable: true,
+		AppliesTo:  "allElementsUAsNotRequiredWhenCollapse",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-radius",
+	},
+	BorderRight: {
+		Name:       BorderRight,
+		Syntax:     "<line-width> || <line-style> || <color>",
+		Longhands:  []string{"border-right-color", "border-right-style", "border-right-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-right",
+	},
+	BorderRightColor: {
+		Name:          BorderRightColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-right-color",
+	},
+	BorderRightStyle: {
+		Name:          BorderRightStyle,
+		Syntax:        "<line-style>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-right-style",
+	},
+	BorderRightWidth: {
+		Name:          BorderRightWidth,
+		Syntax:        "<line-width>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthOr0IfBorderRightStyleNoneOrHidden",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-right-width",
+	},
+	BorderSpacing: {
+		Name:          BorderSpacing,
+		Syntax:        "<length>{1,2}",
+		Initial:       "0",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "tableElements",
+		Computed:      "twoAbsoluteLengths",
+		Groups:        []string{"CSS Table"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-spacing",
+	},
+	BorderStartEndRadius: {
+		Name:          BorderStartEndRadius,
+		Syntax:        "<'border-top-left-radius'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-start-end-radius",
+	},
+	BorderStartStartRadius: {
+		Name:          BorderStartStartRadius,
+		Syntax:        "<'border-top-left-radius'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-start-start-radius",
+	},
+	BorderStyle: {
+		Name:          BorderStyle,
+		Syntax:        "<line-style>{1,4}",
+		Longhands:     []string{"border-bottom-style", "border-left-style", "border-right-style", "border-top-style"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-style",
+	},
+	BorderTop: {
+		Name:       BorderTop,
+		Syntax:     "<line-width> || <line-style> || <color>",
+		Longhands:  []string{"border-top-color", "border-top-style", "border-top-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-top",
+	},
+	BorderTopColor: {
+		Name:          BorderTopColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-top-color",
+	},
+	BorderTopLeftRadius: {
+		Name:          BorderTopLeftRadius,
+		Syntax:        "<length-percentage [0,∞]>{1,2}",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-top-left-radius",
+	},
+	BorderTopRightRadius: {
+		Name:          BorderTopRightRadius,
+		Syntax:        "<length-percentage [0,∞]>{1,2}",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsUAsNotRequiredWhenCollapse",
+		Computed:      "twoAbsoluteLengthOrPercentages",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-top-right-radius",
+	},
+	BorderTopStyle: {
+		Name:          BorderTopStyle,
+		Syntax:        "<line-style>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-top-style",
+	},
+	BorderTopWidth: {
+		Name:          BorderTopWidth,
+		Syntax:        "<line-width>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthOr0IfBorderTopStyleNoneOrHidden",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/border-top-width",
+	},
+	BorderWidth: {
+		Name:       BorderWidth,
+		Syntax:     "<line-width>{1,4}",
+		Longhands:  []string{"border-bottom-width", "border-left-width", "border-right-width", "border-top-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Backgrounds and Borders"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/border-width",
+	},
+	Bottom: {
+		Name:          Bottom,
+		Syntax:        "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/bottom",
+	},
+	BoxAlign: {
+		Name:          BoxAlign,
+		Syntax:        "start | center | end | baseline | stretch",
+		Initial:       "stretch",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "elementsWithDisplayBoxOrInlineBox",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-align",
+	},
+	BoxDecorationBreak: {
+		Name:          BoxDecorationBreak,
+		Syntax:        "slice | clone",
+		Initial:       "slice",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-decoration-break",
+	},
+	BoxDirection: {
+		Name:          BoxDirection,
+		Syntax:        "normal | reverse | inherit",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "elementsWithDisplayBoxOrInlineBox",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-direction",
+	},
+	BoxFlex: {
+		Name:          BoxFlex,
+		Syntax:        "<number>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "directChildrenOfElementsWithDisplayMozBoxMozInlineBox",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-flex",
+	},
+	BoxFlexGroup: {
+		Name:          BoxFlexGroup,
+		Syntax:        "<integer>",
+		Initial:       "1",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "inFlowChildrenOfBoxElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-flex-group",
+	},
+	BoxLines: {
+		Name:          BoxLines,
+		Syntax:        "single | multiple",
+		Initial:       "single",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "boxElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-lines",
+	},
+	BoxOrdinalGroup: {
+		Name:          BoxOrdinalGroup,
+		Syntax:        "<integer>",
+		Initial:       "1",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "childrenOfBoxElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-ordinal-group",
+	},
+	BoxOrient: {
+		Name:          BoxOrient,
+		Syntax:        "horizontal | vertical | inline-axis | block-axis | inherit",
+		Initial:       "inline-axis",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "elementsWithDisplayBoxOrInlineBox",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-orient",
+	},
+	BoxPack: {
+		Name:          BoxPack,
+		Syntax:        "start | center | end | justify",
+		Initial:       "start",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "elementsWithDisplayMozBoxMozInlineBox",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions", "WebKit Extensions"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-pack",
+	},
+	BoxShadow: {
+		Name:          BoxShadow,
+		Syntax:        "none | <shadow>#",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "shadowList",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthsSpecifiedColorAsSpecified",
+		Groups:        []string{"CSS Backgrounds and Borders"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-shadow",
+	},
+	BoxSizing: {
+		Name:          BoxSizing,
+		Syntax:        "content-box | border-box",
+		Initial:       "content-box",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAcceptingWidthOrHeight",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/box-sizing",
+	},
+	BreakAfter: {
+		Name:          BreakAfter,
+		Syntax:        "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/break-after",
+	},
+	BreakBefore: {
+		Name:          BreakBefore,
+		Syntax:        "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/break-before",
+	},
+	BreakInside: {
+		Name:          BreakInside,
+		Syntax:        "auto | avoid | avoid-page | avoid-column | avoid-region",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/break-inside",
+	},
+	CaptionSide: {
+		Name:          CaptionSide,
+		Syntax:        "top | bottom",
+		Initial:       "top",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "tableCaptionElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Table"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/caption-side",
+	},
+	Caret: {
+		Name:       Caret,
+		Syntax:     "<'caret-color'> || <'caret-shape'>",
+		Longhands:  []string{"caret-color", "caret-shape"},
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "elementsThatAcceptInput",
+		Groups:     []string{"CSS Basic User Interface"},
+		Status:     "standard",
+	},
+	CaretColor: {
+		Name:          CaretColor,
+		Syntax:        "auto | <color>",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "asAutoOrColor",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/caret-color",
+	},
+	CaretShape: {
+		Name:          CaretShape,
+		Syntax:        "auto | bar | block | underscore",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "elementsThatAcceptInput",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+	},
+	Clear: {
+		Name:          Clear,
+		Syntax:        "none | left | right | both | inline-start | inline-end",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/clear",
+	},
+	Clip: {
+		Name:          Clip,
+		Syntax:        "<shape> | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "rectangle",
+		AppliesTo:     "absolutelyPositionedElements",
+		Computed:      "autoOrRectangle",
+		Groups:        []string{"CSS Masking"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/clip",
+	},
+	ClipPath: {
+		Name:          ClipPath,
+		Syntax:        "<clip-source> | [ <basic-shape> || <geometry-box> ] | none",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "basicShapeOtherwiseNo",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/clip-path",
+	},
+	ClipRule: {
+		Name:          ClipRule,
+		Syntax:        "nonzero | evenodd",
+		Initial:       "nonzero",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsGraphics",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/clip-rule",
+	},
+	Color: {
+		Name:          Color,
+		Syntax:        "<color>",
+		Initial:       "canvastext",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Color"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/color",
+	},
+	ColorInterpolationFilters: {
+		Name:          ColorInterpolationFilters,
+		Syntax:        "auto | sRGB | linearRGB",
+		Initial:       "linearRGB",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsFilterPrimitives",
+		Computed:      "asSpecified",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/color-interpolation-filters",
+	},
+	ColorScheme: {
+		Name:          ColorScheme,
+		Syntax:        "normal | [ light | dark | <custom-ident> ]+ && only?",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Color"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/color-scheme",
+	},
+	ColumnCount: {
+		Name:          ColumnCount,
+		Syntax:        "<integer> | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "integer",
+		AppliesTo:     "blockContainersExceptTableWrappers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-count",
+	},
+	ColumnFill: {
+		Name:          ColumnFill,
+		Syntax:        "auto | balance",
+		Initial:       "balance",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "multicolElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-fill",
+	},
+	ColumnGap: {
+		Name:          ColumnGap,
+		Syntax:        "normal | <length-percentage>",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "multiColumnElementsFlexContainersGridContainers",
+		Computed:      "asSpecifiedWithLengthsAbsoluteAndNormalComputingToZeroExceptMultiColumn",
+		Groups:        []string{"CSS Box Alignment", "CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-gap",
+	},
+	ColumnRule: {
+		Name:       ColumnRule,
+		Syntax:     "<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>",
+		Longhands:  []string{"column-rule-color", "column-rule-style", "column-rule-width"},
+		Animatable: true,
+		AppliesTo:  "multicolElements",
+		Groups:     []string{"CSS Multi-column Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/column-rule",
+	},
+	ColumnRuleColor: {
+		Name:          ColumnRuleColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "multicolElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-rule-color",
+	},
+	ColumnRuleStyle: {
+		Name:          ColumnRuleStyle,
+		Syntax:        "<'border-style'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "multicolElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-rule-style",
+	},
+	ColumnRuleWidth: {
+		Name:          ColumnRuleWidth,
+		Syntax:        "<'border-width'>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "multicolElements",
+		Computed:      "absoluteLength0IfColumnRuleStyleNoneOrHidden",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-rule-width",
+	},
+	ColumnSpan: {
+		Name:          ColumnSpan,
+		Syntax:        "none | all",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "inFlowBlockLevelElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-span",
+	},
+	ColumnWidth: {
+		Name:          ColumnWidth,
+		Syntax:        "<length> | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "blockContainersExceptTableWrappers",
+		Computed:      "absoluteLengthZeroOrLarger",
+		Groups:        []string{"CSS Box Sizing", "CSS Multi-column Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-width",
+	},
+	Columns: {
+		Name:       Columns,
+		Syntax:     "<'column-width'> || <'column-count'>",
+		Longhands:  []string{"column-count", "column-width"},
+		Animatable: true,
+		AppliesTo:  "blockContainersExceptTableWrappers",
+		Groups:     []string{"CSS Multi-column Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/columns",
+	},
+	Contain: {
+		Name:          Contain,
+		Syntax:        "none | strict | content | [ [ size || inline-size ] || layout || style || paint ]",
+		Initial:       "none",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Containment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/contain",
+	},
+	ContainIntrinsicBlockSize: {
+		Name:          ContainIntrinsicBlockSize,
+		Syntax:        "auto? [ none | <length> ]",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "elementsForWhichSizeContainmentCanApply",
+		Computed:      "asSpecifiedWithLengthValuesComputed",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-block-size",
+	},
+	ContainIntrinsicHeight: {
+		Name:          ContainIntrinsicHeight,
+		Syntax:        "auto? [ none | <length> ]",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "elementsForWhichSizeContainmentCanApply",
+		Computed:      "asSpecifiedWithLengthValuesComputed",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-height",
+	},
+	ContainIntrinsicInlineSize: {
+		Name:          ContainIntrinsicInlineSize,
+		Syntax:        "auto? [ none | <length> ]",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "elementsForWhichSizeContainmentCanApply",
+		Computed:      "asSpecifiedWithLengthValuesComputed",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-inline-size",
+	},
+	ContainIntrinsicSize: {
+		Name:       ContainIntrinsicSize,
+		Syntax:     "[ auto? [ none | <length> ] ]{1,2}",
+		Longhands:  []string{"contain-intrinsic-height", "contain-intrinsic-width"},
+		Animatable: true,
+		AppliesTo:  "elementsForWhichSizeContainmentCanApply",
+		Groups:     []string{"CSS Box Sizing"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-size",
+	},
+	ContainIntrinsicWidth: {
+		Name:          ContainIntrinsicWidth,
+		Syntax:        "auto? [ none | <length> ]",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "elementsForWhichSizeContainmentCanApply",
+		Computed:      "asSpecifiedWithLengthValuesComputed",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-width",
+	},
+	Container: {
+		Name:       Container,
+		Syntax:     "<'container-name'> [ / <'container-type'> ]?",
+		Longhands:  []string{"container-name", "container-type"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Conditional Rules"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/container",
+	},
+	ContainerName: {
+		Name:          ContainerName,
+		Syntax:        "none | <custom-ident>+",
+		Initial:       "none",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "noneOrOrderedListOfIdentifiers",
+		Groups:        []string{"CSS Conditional Rules"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/container-name",
+	},
+	ContainerType: {
+		Name:          ContainerType,
+		Syntax:        "normal | [ [ size | inline-size ] || scroll-state ]",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Conditional Rules"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/container-type",
+	},
+	Content: {
+		Name:          Content,
+		Syntax:        "normal | none | [ <content-replacement> | <content-list> ] [ / [ <string> | <counter> | <attr()> ]+ ]?",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsTreeAbidingPseudoElementsPageMarginBoxes",
+		Computed:      "normalOnElementsForPseudosNoneAbsoluteURIStringOrAsSpecified",
+		Groups:        []string{"CSS Generated Content"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/content",
+	},
+	ContentVisibility: {
+		Name:          ContentVisibility,
+		Syntax:        "visible | auto | hidden",
+		Initial:       "visible",
+		Animatable:    true,
+		AnimationType: "discreteButVisibleForDurationWhenAnimatedHidden",
+		AppliesTo:     "elementsForWhichSizeContainmentCanApply",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Containment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/content-visibility",
+	},
+	CounterIncrement: {
+		Name:          CounterIncrement,
+		Syntax:        "[ <counter-name> <integer>? ]+ | none",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/counter-increment",
+	},
+	CounterReset: {
+		Name:          CounterReset,
+		Syntax:        "[ <counter-name> <integer>? | <reversed-counter-name> <integer>? ]+ | none",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/counter-reset",
+	},
+	CounterSet: {
+		Name:          CounterSet,
+		Syntax:        "[ <counter-name> <integer>? ]+ | none",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/counter-set",
+	},
+	Cursor: {
+		Name:          Cursor,
+		Syntax:        "[ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/cursor",
+	},
+	Cx: {
+		Name:          Cx,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsEllipse",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/cx",
+	},
+	Cy: {
+		Name:          Cy,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsEllipse",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/cy",
+	},
+	D: {
+		Name:          D,
+		Syntax:        "none | path(<string>)",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "basicShapeOtherwiseNo",
+		AppliesTo:     "limitedSVGElementsPath",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/d",
+	},
+	Direction: {
+		Name:          Direction,
+		Syntax:        "ltr | rtl",
+		Initial:       "ltr",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Writing Modes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/direction",
+	},
+	Display: {
+		Name:          Display,
+		Syntax:        "[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>",
+		Initial:       "inline",
+		Animatable:    true,
+		AnimationType: "discreteButVisibleForDurationWhenAnimatedNone",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedExceptPositionedFloatingAndRootElementsKeywordMaybeDifferent",
+		Groups:        []string{"CSS Display"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/display",
+	},
+	DominantBaseline: {
+		Name:          DominantBaseline,
+		Syntax:        "auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainersInlineBoxesTableRowsSVGTextContentElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Inline", "Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/dominant-baseline",
+	},
+	EmptyCells: {
+		Name:          EmptyCells,
+		Syntax:        "show | hide",
+		Initial:       "show",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "tableCellElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Table"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/empty-cells",
+	},
+	Fill: {
+		Name:          Fill,
+		Syntax:        "<paint>",
+		Initial:       "black",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapeText",
+		Computed:      "asColorOrAbsoluteURL",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/fill",
+	},
+	FillOpacity: {
+		Name:          FillOpacity,
+		Syntax:        "<'opacity'>",
+		Initial:       "1",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapeText",
+		Computed:      "specifiedValueNumberClipped0To1",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/fill-opacity",
+	},
+	FillRule: {
+		Name:          FillRule,
+		Syntax:        "nonzero | evenodd",
+		Initial:       "nonzero",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapeText",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/fill-rule",
+	},
+	Filter: {
+		Name:          Filter,
+		Syntax:        "none | <filter-value-list>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "filterList",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/filter",
+	},
+	Flex: {
+		Name:       Flex,
+		Syntax:     "none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]",
+		Longhands:  []string{"flex-basis", "flex-grow", "flex-shrink"},
+		Animatable: true,
+		AppliesTo:  "flexItemsAndInFlowPseudos",
+		Groups:     []string{"CSS Flexible Box Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/flex",
+	},
+	FlexBasis: {
+		Name:          FlexBasis,
+		Syntax:        "content | <'width'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "flexItemsAndInFlowPseudos",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flex-basis",
+	},
+	FlexDirection: {
+		Name:          FlexDirection,
+		Syntax:        "row | row-reverse | column | column-reverse",
+		Initial:       "row",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "flexContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flex-direction",
+	},
+	FlexFlow: {
+		Name:       FlexFlow,
+		Syntax:     "<'flex-direction'> || <'flex-wrap'>",
+		Longhands:  []string{"flex-direction", "flex-wrap"},
+		Animatable: true,
+		AppliesTo:  "flexContainers",
+		Groups:     []string{"CSS Flexible Box Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/flex-flow",
+	},
+	FlexGrow: {
+		Name:          FlexGrow,
+		Syntax:        "<number>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "number",
+		AppliesTo:     "flexItemsAndInFlowPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flex-grow",
+	},
+	FlexShrink: {
+		Name:          FlexShrink,
+		Syntax:        "<number>",
+		Initial:       "1",
+		Animatable:    true,
+		AnimationType: "number",
+		AppliesTo:     "flexItemsAndInFlowPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flex-shrink",
+	},
+	FlexWrap: {
+		Name:          FlexWrap,
+		Syntax:        "nowrap | wrap | wrap-reverse",
+		Initial:       "nowrap",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "flexContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flex-wrap",
+	},
+	Float: {
+		Name:          Float,
+		Syntax:        "left | right | none | inline-start | inline-end",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsNoEffectIfDisplayNone",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/float",
+	},
+	FloodColor: {
+		Name:          FloodColor,
+		Syntax:        "<color>",
+		Initial:       "black",
+		Animatable:    true,
+		AnimationType: "byComputedValue",
+		AppliesTo:     "limitedSVGElementsFloodAndDropShadow",
+		Computed:      "asSpecified",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flood-color",
+	},
+	FloodOpacity: {
+		Name:          FloodOpacity,
+		Syntax:        "<'opacity'>",
+		Initial:       "black",
+		Animatable:    true,
+		AnimationType: "byComputedValue",
+		AppliesTo:     "limitedSVGElementsFloodAndDropShadow",
+		Computed:      "specifiedValueClipped0To1",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/flood-opacity",
+	},
+	Font: {
+		Name:       Font,
+		Syntax:     "[ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'># ] | <system-family-name>",
+		Longhands:  []string{"font-family", "font-size", "font-stretch", "font-style", "font-variant", "font-weight", "line-height"},
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "allElementsAndText",
+		Groups:     []string{"CSS Fonts"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/font",
+	},
+	FontFamily: {
+		Name:          FontFamily,
+		Syntax:        "[ <family-name> | <generic-family> ]#",
+		Initial:       "dependsOnUserAgent",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-family",
+	},
+	FontFeatureSettings: {
+		Name:          FontFeatureSettings,
+		Syntax:        "normal | <feature-tag-value>#",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-feature-settings",
+	},
+	FontKerning: {
+		Name:          FontKerning,
+		Syntax:        "auto | normal | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-kerning",
+	},
+	FontLanguageOverride: {
+		Name:          FontLanguageOverride,
+		Syntax:        "normal | <string>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-language-override",
+	},
+	FontOpticalSizing: {
+		Name:          FontOpticalSizing,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-optical-sizing",
+	},
+	FontPalette: {
+		Name:          FontPalette,
+		Syntax:        "normal | light | dark | <palette-identifier> | <palette-mix()>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValue",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-palette",
+	},
+	FontSize: {
+		Name:          FontSize,
+		Syntax:        "<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math",
+		Initial:       "medium",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "absoluteLength",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-size",
+	},
+	FontSizeAdjust: {
+		Name:          FontSizeAdjust,
+		Syntax:        "none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "number",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-size-adjust",
+	},
+	FontSmooth: {
+		Name:          FontSmooth,
+		Syntax:        "auto | never | always | <absolute-size> | <length>",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-smooth",
+	},
+	FontStretch: {
+		Name:          FontStretch,
+		Syntax:        "<font-stretch-absolute>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-stretch",
+	},
+	FontStyle: {
+		Name:          FontStyle,
+		Syntax:        "normal | italic | oblique <angle>?",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueTypeNormalAnimatesAsObliqueZeroDeg",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-style",
+	},
+	FontSynthesis: {
+		Name:          FontSynthesis,
+		Syntax:        "none | [ weight || style || small-caps || position]",
+		Initial:       "weight style small-caps position ",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-synthesis",
+	},
+	FontSynthesisSmallCaps: {
+		Name:          FontSynthesisSmallCaps,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-synthesis-small-caps",
+	},
+	FontSynthesisStyle: {
+		Name:          FontSynthesisStyle,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-synthesis-style",
+	},
+	FontSynthesisWeight: {
+		Name:          FontSynthesisWeight,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-synthesis-weight",
+	},
+	FontVariant: {
+		Name:          FontVariant,
+		Syntax:        "normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) || [ small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps ] || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant",
+	},
+	FontVariantAlternates: {
+		Name:          FontVariantAlternates,
+		Syntax:        "normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-alternates",
+	},
+	FontVariantCaps: {
+		Name:          FontVariantCaps,
+		Syntax:        "normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-caps",
+	},
+	FontVariantEastAsian: {
+		Name:          FontVariantEastAsian,
+		Syntax:        "normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-east-asian",
+	},
+	FontVariantEmoji: {
+		Name:          FontVariantEmoji,
+		Syntax:        "normal | text | emoji | unicode",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-emoji",
+	},
+	FontVariantLigatures: {
+		Name:          FontVariantLigatures,
+		Syntax:        "normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-ligatures",
+	},
+	FontVariantNumeric: {
+		Name:          FontVariantNumeric,
+		Syntax:        "normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-numeric",
+	},
+	FontVariantPosition: {
+		Name:          FontVariantPosition,
+		Syntax:        "normal | sub | super",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variant-position",
+	},
+	FontVariationSettings: {
+		Name:          FontVariationSettings,
+		Syntax:        "normal | [ <string> <number> ]#",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "transform",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-variation-settings",
+	},
+	FontWeight: {
+		Name:          FontWeight,
+		Syntax:        "<font-weight-absolute> | bolder | lighter",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "keywordOrNumericalValueBolderLighterTransformedToRealValue",
+		Groups:        []string{"CSS Fonts"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/font-weight",
+	},
+	ForcedColorAdjust: {
+		Name:          ForcedColorAdjust,
+		Syntax:        "auto | none | preserve-parent-color",
+		Initial:       "auto",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndText",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Color"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/forced-color-adjust",
+	},
+	Gap: {
+		Name:       Gap,
+		Syntax:     "<'row-gap'> <'column-gap'>?",
+		Longhands:  []string{"column-gap", "row-gap"},
+		Animatable: true,
+		AppliesTo:  "multiColumnElementsFlexContainersGridContainers",
+		Groups:     []string{"CSS Box Alignment"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/gap",
+	},
+	Grid: {
+		Name:       Grid,
+		Syntax:     "<'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>",
+		Longhands:  []string{"column-gap", "grid-auto-columns", "grid-auto-flow", "grid-auto-rows", "grid-column-gap", "grid-row-gap", "grid-template-areas", "grid-template-columns", "grid-template-rows", "row-gap"},
+		Animatable: true,
+		AppliesTo:  "gridContainers",
+		Groups:     []string{"CSS Grid Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/grid",
+	},
+	GridArea: {
+		Name:          GridArea,
+		Syntax:        "<grid-line> [ / <grid-line> ]{0,3}",
+		Longhands:     []string{"grid-column-end", "grid-column-start", "grid-row-end", "grid-row-start"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-area",
+	},
+	GridAutoColumns: {
+		Name:          GridAutoColumns,
+		Syntax:        "<track-size>+",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "gridContainers",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-auto-columns",
+	},
+	GridAutoFlow: {
+		Name:          GridAutoFlow,
+		Syntax:        "[ row | column ] || dense",
+		Initial:       "row",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow",
+	},
+	GridAutoRows: {
+		Name:          GridAutoRows,
+		Syntax:        "<track-size>+",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "gridContainers",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-auto-rows",
+	},
+	GridColumn: {
+		Name:          GridColumn,
+		Syntax:        "<grid-line> [ / <grid-line> ]?",
+		Longhands:     []string{"grid-column-end", "grid-column-start"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-column",
+	},
+	GridColumnEnd: {
+		Name:          GridColumnEnd,
+		Syntax:        "<grid-line>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-column-end",
+	},
+	GridColumnGap: {
+		Name:          GridColumnGap,
+		Syntax:        "<length-percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "gridContainers",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/column-gap",
+	},
+	GridColumnStart: {
+		Name:          GridColumnStart,
+		Syntax:        "<grid-line>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-column-start",
+	},
+	GridGap: {
+		Name:       GridGap,
+		Syntax:     "<'grid-row-gap'> <'grid-column-gap'>?",
+		Longhands:  []string{"grid-column-gap", "grid-row-gap"},
+		Animatable: true,
+		AppliesTo:  "gridContainers",
+		Groups:     []string{"CSS Grid Layout"},
+		Status:     "obsolete",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/gap",
+	},
+	GridRow: {
+		Name:          GridRow,
+		Syntax:        "<grid-line> [ / <grid-line> ]?",
+		Longhands:     []string{"grid-row-end", "grid-row-start"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-row",
+	},
+	GridRowEnd: {
+		Name:          GridRowEnd,
+		Syntax:        "<grid-line>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-row-end",
+	},
+	GridRowGap: {
+		Name:          GridRowGap,
+		Syntax:        "<length-percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "gridContainers",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/row-gap",
+	},
+	GridRowStart: {
+		Name:          GridRowStart,
+		Syntax:        "<grid-line>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridItemsAndBoxesWithinGridContainer",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-row-start",
+	},
+	GridTemplate: {
+		Name:       GridTemplate,
+		Syntax:     "none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?",
+		Longhands:  []string{"grid-template-areas", "grid-template-columns", "grid-template-rows"},
+		Animatable: true,
+		AppliesTo:  "gridContainers",
+		Groups:     []string{"CSS Grid Layout"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/grid-template",
+	},
+	GridTemplateAreas: {
+		Name:          GridTemplateAreas,
+		Syntax:        "none | <string>+",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-template-areas",
+	},
+	GridTemplateColumns: {
+		Name:          GridTemplateColumns,
+		Syntax:        "none | <track-list> | <auto-track-list> | subgrid <line-name-list>?",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "simpleListOfLpcDifferenceLpc",
+		AppliesTo:     "gridContainers",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-template-columns",
+	},
+	GridTemplateRows: {
+		Name:          GridTemplateRows,
+		Syntax:        "none | <track-list> | <auto-track-list> | subgrid <line-name-list>?",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "simpleListOfLpcDifferenceLpc",
+		AppliesTo:     "gridContainers",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-template-rows",
+	},
+	HangingPunctuation: {
+		Name:          HangingPunctuation,
+		Syntax:        "none | [ first || [ force-end | allow-end ] || last ]",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/hanging-punctuation",
+	},
+	Height: {
+		Name:          Height,
+		Syntax:        "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableColumns",
+		Computed:      "percentageAutoOrAbsoluteLength",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/height",
+	},
+	HyphenateCharacter: {
+		Name:          HyphenateCharacter,
+		Syntax:        "auto | <string>",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/hyphenate-character",
+	},
+	HyphenateLimitChars: {
+		Name:          HyphenateLimitChars,
+		Syntax:        "[ auto | <integer> ]{1,3}",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/hyphenate-limit-chars",
+	},
+	Hyphens: {
+		Name:          Hyphens,
+		Syntax:        "none | manual | auto",
+		Initial:       "manual",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/hyphens",
+	},
+	ImageOrientation: {
+		Name:          ImageOrientation,
+		Syntax:        "from-image | <angle> | [ <angle>? flip ]",
+		Initial:       "from-image",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "angleRoundedToNextQuarter",
+		Groups:        []string{"CSS Images"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/image-orientation",
+	},
+	ImageRendering: {
+		Name:          ImageRendering,
+		Syntax:        "auto | crisp-edges | pixelated | smooth",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Images"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/image-rendering",
+	},
+	ImeMode: {
+		Name:          ImeMode,
+		Syntax:        "auto | normal | active | inactive | disabled",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textFields",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "obsolete",
+	},
+	InitialLetter: {
+		Name:          InitialLetter,
+		Syntax:        "normal | [ <number> <integer>? ]",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "firstLetterPseudoElementsAndInlineLevelFirstChildren",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/initial-letter",
+	},
+	InlineSize: {
+		Name:          InlineSize,
+		Syntax:        "<'width'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsWidthAndHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inline-size",
+	},
+	Inset: {
+		Name:          Inset,
+		Syntax:        "<'top'>{1,4}",
+		Longhands:     []string{"bottom", "left", "right", "top"},
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset",
+	},
+	InsetBlock: {
+		Name:          InsetBlock,
+		Syntax:        "<'top'>{1,2}",
+		Longhands:     []string{"inset-block-end", "inset-block-start"},
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-block",
+	},
+	InsetBlockEnd: {
+		Name:          InsetBlockEnd,
+		Syntax:        "<'top'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "sameAsBoxOffsets",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-block-end",
+	},
+	InsetBlockStart: {
+		Name:          InsetBlockStart,
+		Syntax:        "<'top'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "sameAsBoxOffsets",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-block-start",
+	},
+	InsetInline: {
+		Name:          InsetInline,
+		Syntax:        "<'top'>{1,2}",
+		Longhands:     []string{"inset-inline-end", "inset-inline-start"},
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-inline",
+	},
+	InsetInlineEnd: {
+		Name:          InsetInlineEnd,
+		Syntax:        "<'top'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "sameAsBoxOffsets",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-inline-end",
+	},
+	InsetInlineStart: {
+		Name:          InsetInlineStart,
+		Syntax:        "<'top'>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "sameAsBoxOffsets",
+		Groups:        []string{"CSS Logical Properties and Values", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/inset-inline-start",
+	},
+	Isolation: {
+		Name:          Isolation,
+		Syntax:        "auto | isolate",
+		Initial:       "auto",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsSVGContainerGraphicsAndGraphicsReferencingElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Compositing and Blending"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/isolation",
+	},
+	JustifyContent: {
+		Name:          JustifyContent,
+		Syntax:        "normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "flexContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Alignment", "CSS Flexible Box Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/justify-content",
+	},
+	JustifyItems: {
+		Name:          JustifyItems,
+		Syntax:        "normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ] | anchor-center",
+		Initial:       "legacy",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/justify-items",
+	},
+	JustifySelf: {
+		Name:          JustifySelf,
+		Syntax:        "auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | anchor-center",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelBoxesAndAbsolutelyPositionedBoxesAndGridItems",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/justify-self",
+	},
+	JustifyTracks: {
+		Name:          JustifyTracks,
+		Syntax:        "[ normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ] ]#",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridContainersWithMasonryLayoutInTheirInlineAxis",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "nonstandard",
+	},
+	Left: {
+		Name:          Left,
+		Syntax:        "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/left",
+	},
+	LetterSpacing: {
+		Name:          LetterSpacing,
+		Syntax:        "normal | <length>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "optimumValueOfAbsoluteLengthOrNormal",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/letter-spacing",
+	},
+	LightingColor: {
+		Name:          LightingColor,
+		Syntax:        "<color>",
+		Initial:       "white",
+		Animatable:    true,
+		AnimationType: "byComputedValue",
+		AppliesTo:     "limitedSVGElementsLightSource",
+		Computed:      "asSpecified",
+		Groups:        []string{"Filter Effects"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/lighting-color",
+	},
+	LineBreak: {
+		Name:          LineBreak,
+		Syntax:        "auto | loose | normal | strict | anywhere",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/line-break",
+	},
+	LineClamp: {
+		Name:          LineClamp,
+		Syntax:        "none | <integer>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "integer",
+		AppliesTo:     "blockContainersExceptMultiColumnContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/line-clamp",
+	},
+	LineHeight: {
+		Name:          LineHeight,
+		Syntax:        "normal | <number> | <length> | <percentage>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "numberOrLength",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLengthOrAsSpecified",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/line-height",
+	},
+	ListStyle: {
+		Name:       ListStyle,
+		Syntax:     "<'list-style-type'> || <'list-style-position'> || <'list-style-image'>",
+		Longhands:  []string{"list-style-image", "list-style-position", "list-style-type"},
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "listItems",
+		Groups:     []string{"CSS Lists and Counters"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/list-style",
+	},
+	ListStyleImage: {
+		Name:          ListStyleImage,
+		Syntax:        "<image> | none",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "listItems",
+		Computed:      "theKeywordListStyleImageNoneOrComputedValue",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/list-style-image",
+	},
+	ListStylePosition: {
+		Name:          ListStylePosition,
+		Syntax:        "inside | outside",
+		Initial:       "outside",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "listItems",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/list-style-position",
+	},
+	ListStyleType: {
+		Name:          ListStyleType,
+		Syntax:        "<counter-style> | <string> | none",
+		Initial:       "disc",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "listItems",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Lists and Counters"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/list-style-type",
+	},
+	Margin: {
+		Name:          Margin,
+		Syntax:        "<'margin-top'>{1,4}",
+		Longhands:     []string{"margin-bottom", "margin-left", "margin-right", "margin-top"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptTableDisplayTypes",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin",
+	},
+	MarginBlock: {
+		Name:          MarginBlock,
+		Syntax:        "<'margin-top'>{1,2}",
+		Longhands:     []string{"margin-block-end", "margin-block-start"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-block",
+	},
+	MarginBlockEnd: {
+		Name:          MarginBlockEnd,
+		Syntax:        "<'margin-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-block-end",
+	},
+	MarginBlockStart: {
+		Name:          MarginBlockStart,
+		Syntax:        "<'margin-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-block-start",
+	},
+	MarginBottom: {
+		Name:          MarginBottom,
+		Syntax:        "<length-percentage> | auto | <anchor-size()>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-bottom",
+	},
+	MarginInline: {
+		Name:          MarginInline,
+		Syntax:        "<'margin-top'>{1,2}",
+		Longhands:     []string{"margin-inline-end", "margin-inline-start"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-inline",
+	},
+	MarginInlineEnd: {
+		Name:          MarginInlineEnd,
+		Syntax:        "<'margin-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-inline-end",
+	},
+	MarginInlineStart: {
+		Name:          MarginInlineStart,
+		Syntax:        "<'margin-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "sameAsMargin",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-inline-start",
+	},
+	MarginLeft: {
+		Name:          MarginLeft,
+		Syntax:        "<length-percentage> | auto | <anchor-size()>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-left",
+	},
+	MarginRight: {
+		Name:          MarginRight,
+		Syntax:        "<length-percentage> | auto | <anchor-size()>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-right",
+	},
+	MarginTop: {
+		Name:          MarginTop,
+		Syntax:        "<length-percentage> | auto | <anchor-size()>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/margin-top",
+	},
+	Marker: {
+		Name:          Marker,
+		Syntax:        "none | <url>",
+		Longhands:     []string{"marker-end", "marker-mid", "marker-start"},
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/marker",
+	},
+	MarkerEnd: {
+		Name:          MarkerEnd,
+		Syntax:        "none | <url>",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/marker-end",
+	},
+	MarkerMid: {
+		Name:          MarkerMid,
+		Syntax:        "none | <url>",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/marker-mid",
+	},
+	MarkerStart: {
+		Name:          MarkerStart,
+		Syntax:        "none | <url>",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/marker-start",
+	},
+	Mask: {
+		Name:       Mask,
+		Syntax:     "<mask-layer>#",
+		Longhands:  []string{"mask-clip", "mask-composite", "mask-image", "mask-mode", "mask-origin", "mask-position", "mask-repeat", "mask-size"},
+		Animatable: true,
+		AppliesTo:  "allElementsSVGContainerElements",
+		Groups:     []string{"CSS Masking"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/mask",
+	},
+	MaskBorder: {
+		Name:       MaskBorder,
+		Syntax:     "<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>",
+		Longhands:  []string{"mask-border-mode", "mask-border-outset", "mask-border-repeat", "mask-border-slice", "mask-border-source", "mask-border-width"},
+		Animatable: true,
+		AppliesTo:  "allElementsSVGContainerElements",
+		Groups:     []string{"CSS Masking"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/mask-border",
+	},
+	MaskBorderMode: {
+		Name:          MaskBorderMode,
+		Syntax:        "luminance | alpha",
+		Initial:       "alpha",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-mode",
+	},
+	MaskBorderOutset: {
+		Name:          MaskBorderOutset,
+		Syntax:        "[ <length> | <number> ]{1,4}",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-outset",
+	},
+	MaskBorderRepeat: {
+		Name:          MaskBorderRepeat,
+		Syntax:        "[ stretch | repeat | round | space ]{1,2}",
+		Initial:       "stretch",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-repeat",
+	},
+	MaskBorderSlice: {
+		Name:          MaskBorderSlice,
+		Syntax:        "<number-percentage>{1,4} fill?",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-slice",
+	},
+	MaskBorderSource: {
+		Name:          MaskBorderSource,
+		Syntax:        "none | <image>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-source",
+	},
+	MaskBorderWidth: {
+		Name:          MaskBorderWidth,
+		Syntax:        "[ <length-percentage> | <number> | auto ]{1,4}",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-border-width",
+	},
+	MaskClip: {
+		Name:          MaskClip,
+		Syntax:        "[ <coord-box> | no-clip ]#",
+		Initial:       "border-box",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-clip",
+	},
+	MaskComposite: {
+		Name:          MaskComposite,
+		Syntax:        "<compositing-operator>#",
+		Initial:       "add",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-composite",
+	},
+	MaskImage: {
+		Name:          MaskImage,
+		Syntax:        "<mask-reference>#",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedURLsAbsolute",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-image",
+	},
+	MaskMode: {
+		Name:          MaskMode,
+		Syntax:        "<masking-mode>#",
+		Initial:       "match-source",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-mode",
+	},
+	MaskOrigin: {
+		Name:          MaskOrigin,
+		Syntax:        "<coord-box>#",
+		Initial:       "border-box",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-origin",
+	},
+	MaskPosition: {
+		Name:          MaskPosition,
+		Syntax:        "<position>#",
+		Initial:       "0% 0%",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "consistsOfTwoKeywordsForOriginAndOffsets",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-position",
+	},
+	MaskRepeat: {
+		Name:          MaskRepeat,
+		Syntax:        "<repeat-style>#",
+		Initial:       "repeat",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "consistsOfTwoDimensionKeywords",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-repeat",
+	},
+	MaskSize: {
+		Name:          MaskSize,
+		Syntax:        "<bg-size>#",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "allElementsSVGContainerElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-size",
+	},
+	MaskType: {
+		Name:          MaskType,
+		Syntax:        "luminance | alpha",
+		Initial:       "luminance",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "maskElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Masking"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mask-type",
+	},
+	MasonryAutoFlow: {
+		Name:          MasonryAutoFlow,
+		Syntax:        "[ pack | next ] || [ definite-first | ordered ]",
+		Initial:       "pack",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "gridContainersWithMasonryLayout",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Grid Layout"},
+		Status:        "nonstandard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow",
+	},
+	MathDepth: {
+		Name:          MathDepth,
+		Syntax:        "auto-add | add(<integer>) | <integer>",
+		Initial:       "0",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"MathML"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/math-depth",
+	},
+	MathStyle: {
+		Name:          MathStyle,
+		Syntax:        "normal | compact",
+		Initial:       "normal",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"MathML"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/math-style",
+	},
+	MaxBlockSize: {
+		Name:          MaxBlockSize,
+		Syntax:        "<'max-width'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsMaxWidthAndMaxHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/max-block-size",
+	},
+	MaxHeight: {
+		Name:          MaxHeight,
+		Syntax:        "none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableColumns",
+		Computed:      "percentageAsSpecifiedAbsoluteLengthOrNone",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/max-height",
+	},
+	MaxInlineSize: {
+		Name:          MaxInlineSize,
+		Syntax:        "<'max-width'>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsMaxWidthAndMaxHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/max-inline-size",
+	},
+	MaxWidth: {
+		Name:          MaxWidth,
+		Syntax:        "none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableRows",
+		Computed:      "percentageAsSpecifiedAbsoluteLengthOrNone",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/max-width",
+	},
+	MinBlockSize: {
+		Name:          MinBlockSize,
+		Syntax:        "<'min-width'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsMinWidthAndMinHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/min-block-size",
+	},
+	MinHeight: {
+		Name:          MinHeight,
+		Syntax:        "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableColumns",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/min-height",
+	},
+	MinInlineSize: {
+		Name:          MinInlineSize,
+		Syntax:        "<'min-width'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "sameAsWidthAndHeight",
+		Computed:      "sameAsMinWidthAndMinHeight",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/min-inline-size",
+	},
+	MinWidth: {
+		Name:          MinWidth,
+		Syntax:        "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableRows",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/min-width",
+	},
+	MixBlendMode: {
+		Name:          MixBlendMode,
+		Syntax:        "<blend-mode> | plus-darker | plus-lighter",
+		Initial:       "normal",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Compositing and Blending"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/mix-blend-mode",
+	},
+	ObjectFit: {
+		Name:          ObjectFit,
+		Syntax:        "fill | contain | cover | none | scale-down",
+		Initial:       "fill",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "replacedElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Images"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/object-fit",
+	},
+	ObjectPosition: {
+		Name:          ObjectPosition,
+		Syntax:        "<position>",
+		Initial:       "50% 50%",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "replacedElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Images"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/object-position",
+	},
+	Offset: {
+		Name:       Offset,
+		Syntax:     "[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?",
+		Longhands:  []string{"offset-anchor", "offset-distance", "offset-path", "offset-position", "offset-rotate"},
+		Animatable: true,
+		AppliesTo:  "transformableElements",
+		Groups:     []string{"Motion Path"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/offset",
+	},
+	OffsetAnchor: {
+		Name:          OffsetAnchor,
+		Syntax:        "auto | <position>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "position",
+		AppliesTo:     "transformableElements",
+		Computed:      "forLengthAbsoluteValueOtherwisePercentage",
+		Groups:        []string{"Motion Path"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/offset-anchor",
+	},
+	OffsetDistance: {
+		Name:          OffsetDistance,
+		Syntax:        "<length-percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "transformableElements",
+		Computed:      "forLengthAbsoluteValueOtherwisePercentage",
+		Groups:        []string{"Motion Path"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/offset-distance",
+	},
+	OffsetPath: {
+		Name:          OffsetPath,
+		Syntax:        "none | <offset-path> || <coord-box>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Motion Path"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/offset-path",
+	},
+	OffsetPosition: {
+		Name:          OffsetPosition,
+		Syntax:        "normal | auto | <position>",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "position",
+		AppliesTo:     "transformableElements",
+		Computed:      "forLengthAbsoluteValueOtherwisePercentage",
+		Groups:        []string{"Motion Path"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/offset-position",
+	},
+	OffsetRotate: {
+		Name:          OffsetRotate,
+		Syntax:        "[ auto | reverse ] || <angle>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "angleOrBasicShapeOrPath",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Motion Path"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/offset-rotate",
+	},
+	Opacity: {
+		Name:          Opacity,
+		Syntax:        "<opacity-value>",
+		Initial:       "1",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "specifiedValueNumberClipped0To1",
+		Groups:        []string{"CSS Color"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/opacity",
+	},
+	Order: {
+		Name:          Order,
+		Syntax:        "<integer>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "integer",
+		AppliesTo:     "flexItemsGridItemsAbsolutelyPositionedContainerChildren",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Display"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/order",
+	},
+	Orphans: {
+		Name:          Orphans,
+		Syntax:        "<integer>",
+		Initial:       "2",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "blockContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/orphans",
+	},
+	Outline: {
+		Name:       Outline,
+		Syntax:     "<'outline-width'> || <'outline-style'> || <'outline-color'>",
+		Longhands:  []string{"outline-color", "outline-style", "outline-width"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Basic User Interface"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/outline",
+	},
+	OutlineColor: {
+		Name:          OutlineColor,
+		Syntax:        "auto | <color>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "autoForTranslucentColorRGBAOtherwiseRGB",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/outline-color",
+	},
+	OutlineOffset: {
+		Name:          OutlineOffset,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/outline-offset",
+	},
+	OutlineStyle: {
+		Name:          OutlineStyle,
+		Syntax:        "auto | <outline-line-style>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/outline-style",
+	},
+	OutlineWidth: {
+		Name:          OutlineWidth,
+		Syntax:        "<line-width>",
+		Initial:       "medium",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLength0ForNone",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/outline-width",
+	},
+	Overflow: {
+		Name:          Overflow,
+		Syntax:        "[ visible | hidden | clip | scroll | auto ]{1,2}",
+		Initial:       "visible",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainers",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow",
+	},
+	OverflowAnchor: {
+		Name:          OverflowAnchor,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Anchoring"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-anchor",
+	},
+	OverflowBlock: {
+		Name:          OverflowBlock,
+		Syntax:        "visible | hidden | clip | scroll | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainers",
+		Computed:      "asSpecifiedButVisibleOrClipReplacedToAutoOrHiddenIfOtherValueDifferent",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-block",
+	},
+	OverflowClipBox: {
+		Name:          OverflowClipBox,
+		Syntax:        "padding-box | content-box",
+		Initial:       "padding-box",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Mozilla Extensions"},
+		Status:        "nonstandard",
+	},
+	OverflowClipMargin: {
+		Name:          OverflowClipMargin,
+		Syntax:        "<visual-box> || <length [0,∞]>",
+		Initial:       "0px",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "theComputedLengthAndVisualBox",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-clip-margin",
+	},
+	OverflowInline: {
+		Name:          OverflowInline,
+		Syntax:        "visible | hidden | clip | scroll | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainers",
+		Computed:      "asSpecifiedButVisibleOrClipReplacedToAutoOrHiddenIfOtherValueDifferent",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-inline",
+	},
+	OverflowWrap: {
+		Name:          OverflowWrap,
+		Syntax:        "normal | break-word | anywhere",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-wrap",
+	},
+	OverflowX: {
+		Name:          OverflowX,
+		Syntax:        "visible | hidden | clip | scroll | auto",
+		Initial:       "visible",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainers",
+		Computed:      "asSpecifiedButVisibleOrClipReplacedToAutoOrHiddenIfOtherValueDifferent",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-x",
+	},
+	OverflowY: {
+		Name:          OverflowY,
+		Syntax:        "visible | hidden | clip | scroll | auto",
+		Initial:       "visible",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersFlexContainersGridContainers",
+		Computed:      "asSpecifiedButVisibleOrClipReplacedToAutoOrHiddenIfOtherValueDifferent",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-y",
+	},
+	OverscrollBehavior: {
+		Name:          OverscrollBehavior,
+		Syntax:        "[ contain | none | auto ]{1,2}",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedBlockAndInlineBlockElements",
+		Groups:        []string{"CSS Overscroll Behavior"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior",
+	},
+	OverscrollBehaviorBlock: {
+		Name:          OverscrollBehaviorBlock,
+		Syntax:        "contain | none | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedBlockAndInlineBlockElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overscroll Behavior"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-block",
+	},
+	OverscrollBehaviorInline: {
+		Name:          OverscrollBehaviorInline,
+		Syntax:        "contain | none | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedBlockAndInlineBlockElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overscroll Behavior"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-inline",
+	},
+	OverscrollBehaviorX: {
+		Name:          OverscrollBehaviorX,
+		Syntax:        "contain | none | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedBlockAndInlineBlockElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overscroll Behavior"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-x",
+	},
+	OverscrollBehaviorY: {
+		Name:          OverscrollBehaviorY,
+		Syntax:        "contain | none | auto",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedBlockAndInlineBlockElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overscroll Behavior"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-y",
+	},
+	Padding: {
+		Name:          Padding,
+		Syntax:        "<'padding-top'>{1,4}",
+		Longhands:     []string{"padding-bottom", "padding-left", "padding-right", "padding-top"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding",
+	},
+	PaddingBlock: {
+		Name:          PaddingBlock,
+		Syntax:        "<'padding-top'>{1,2}",
+		Longhands:     []string{"padding-block-end", "padding-block-start"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-block",
+	},
+	PaddingBlockEnd: {
+		Name:          PaddingBlockEnd,
+		Syntax:        "<'padding-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "asLength",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-block-end",
+	},
+	PaddingBlockStart: {
+		Name:          PaddingBlockStart,
+		Syntax:        "<'padding-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "asLength",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-block-start",
+	},
+	PaddingBottom: {
+		Name:          PaddingBottom,
+		Syntax:        "<length-percentage [0,∞]>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-bottom",
+	},
+	PaddingInline: {
+		Name:          PaddingInline,
+		Syntax:        "<'padding-top'>{1,2}",
+		Longhands:     []string{"padding-inline-end", "padding-inline-start"},
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-inline",
+	},
+	PaddingInlineEnd: {
+		Name:          PaddingInlineEnd,
+		Syntax:        "<'padding-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "asLength",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-inline-end",
+	},
+	PaddingInlineStart: {
+		Name:          PaddingInlineStart,
+		Syntax:        "<'padding-top'>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "asLength",
+		Groups:        []string{"CSS Logical Properties and Values"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-inline-start",
+	},
+	PaddingLeft: {
+		Name:          PaddingLeft,
+		Syntax:        "<length-percentage [0,∞]>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-left",
+	},
+	PaddingRight: {
+		Name:          PaddingRight,
+		Syntax:        "<length-percentage [0,∞]>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-right",
+	},
+	PaddingTop: {
+		Name:          PaddingTop,
+		Syntax:        "<length-percentage [0,∞]>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElementsExceptInternalTableDisplayTypes",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"CSS Box Model"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/padding-top",
+	},
+	Page: {
+		Name:          Page,
+		Syntax:        "auto | <custom-ident>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockElementsInNormalFlow",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Paged Media"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/page",
+	},
+	PageBreakAfter: {
+		Name:          PageBreakAfter,
+		Syntax:        "auto | always | avoid | left | right | recto | verso",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockElementsInNormalFlow",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Paged Media"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/page-break-after",
+	},
+	PageBreakBefore: {
+		Name:          PageBreakBefore,
+		Syntax:        "auto | always | avoid | left | right | recto | verso",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockElementsInNormalFlow",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Paged Media"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/page-break-before",
+	},
+	PageBreakInside: {
+		Name:          PageBreakInside,
+		Syntax:        "auto | avoid",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockElementsInNormalFlow",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Paged Media"},
+		Status:        "obsolete",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/page-break-inside",
+	},
+	PaintOrder: {
+		Name:          PaintOrder,
+		Syntax:        "normal | [ fill || stroke || markers ]",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/paint-order",
+	},
+	Perspective: {
+		Name:          Perspective,
+		Syntax:        "none | <length>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "transformableElements",
+		Computed:      "absoluteLengthOrNone",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/perspective",
+	},
+	PerspectiveOrigin: {
+		Name:          PerspectiveOrigin,
+		Syntax:        "<position>",
+		Initial:       "50% 50%",
+		Animatable:    true,
+		AnimationType: "simpleListOfLpc",
+		AppliesTo:     "transformableElements",
+		Computed:      "forLengthAbsoluteValueOtherwisePercentage",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/perspective-origin",
+	},
+	PlaceContent: {
+		Name:          PlaceContent,
+		Syntax:        "<'align-content'> <'justify-content'>?",
+		Longhands:     []string{"align-content", "justify-content"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "multilineFlexContainers",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/place-content",
+	},
+	PlaceItems: {
+		Name:          PlaceItems,
+		Syntax:        "<'align-items'> <'justify-items'>?",
+		Longhands:     []string{"align-items", "justify-items"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/place-items",
+	},
+	PlaceSelf: {
+		Name:          PlaceSelf,
+		Syntax:        "<'align-self'> <'justify-self'>?",
+		Longhands:     []string{"align-self", "justify-self"},
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockLevelBoxesAndAbsolutelyPositionedBoxesAndGridItems",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/place-self",
+	},
+	PointerEvents: {
+		Name:          PointerEvents,
+		Syntax:        "auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/pointer-events",
+	},
+	Position: {
+		Name:          Position,
+		Syntax:        "static | relative | absolute | sticky | fixed",
+		Initial:       "static",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/position",
+	},
+	PrintColorAdjust: {
+		Name:          PrintColorAdjust,
+		Syntax:        "economy | exact",
+		Initial:       "economy",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Color"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/print-color-adjust",
+	},
+	Quotes: {
+		Name:          Quotes,
+		Syntax:        "none | auto | [ <string> <string> ]+",
+		Initial:       "dependsOnUserAgent",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Generated Content"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/quotes",
+	},
+	R: {
+		Name:          R,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsCircle",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/r",
+	},
+	Resize: {
+		Name:          Resize,
+		Syntax:        "none | both | horizontal | vertical | block | inline",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "elementsWithOverflowNotVisibleAndReplacedElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/resize",
+	},
+	Right: {
+		Name:          Right,
+		Syntax:        "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/right",
+	},
+	Rotate: {
+		Name:          Rotate,
+		Syntax:        "none | <angle> | [ x | y | z | <number>{3} ] && <angle>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "transform",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/rotate",
+	},
+	RowGap: {
+		Name:          RowGap,
+		Syntax:        "normal | <length-percentage>",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "multiColumnElementsFlexContainersGridContainers",
+		Computed:      "asSpecifiedWithLengthsAbsoluteAndNormalComputingToZeroExceptMultiColumn",
+		Groups:        []string{"CSS Box Alignment"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/row-gap",
+	},
+	RubyAlign: {
+		Name:          RubyAlign,
+		Syntax:        "start | center | space-between | space-around",
+		Initial:       "space-around",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "rubyBasesAnnotationsBaseAnnotationContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Ruby"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/ruby-align",
+	},
+	RubyOverhang: {
+		Name:          RubyOverhang,
+		Syntax:        "auto | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "rubyAnnotationContainers",
+		Computed:      "theSpecifiedKeyword",
+		Groups:        []string{"CSS Ruby"},
+		Status:        "standard",
+	},
+	RubyPosition: {
+		Name:          RubyPosition,
+		Syntax:        "[ alternate || [ over | under ] ] | inter-character",
+		Initial:       "alternate",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "rubyAnnotationContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Ruby"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/ruby-position",
+	},
+	Rx: {
+		Name:          Rx,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsEllipseRect",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/rx",
+	},
+	Ry: {
+		Name:          Ry,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsEllipseRect",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/ry",
+	},
+	Scale: {
+		Name:          Scale,
+		Syntax:        "none | [ <number> | <percentage> ]{1,3}",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "transform",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scale",
+	},
+	ScrollBehavior: {
+		Name:          ScrollBehavior,
+		Syntax:        "auto | smooth",
+		Initial:       "auto",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "scrollingBoxes",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-behavior",
+	},
+	ScrollMargin: {
+		Name:          ScrollMargin,
+		Syntax:        "<length>{1,4}",
+		Longhands:     []string{"scroll-margin-bottom", "scroll-margin-left", "scroll-margin-right", "scroll-margin-top"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin",
+	},
+	ScrollMarginBlock: {
+		Name:          ScrollMarginBlock,
+		Syntax:        "<length>{1,2}",
+		Longhands:     []string{"scroll-margin-block-end", "scroll-margin-block-start"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block",
+	},
+	ScrollMarginBlockEnd: {
+		Name:          ScrollMarginBlockEnd,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-end",
+	},
+	ScrollMarginBlockStart: {
+		Name:          ScrollMarginBlockStart,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-start",
+	},
+	ScrollMarginBottom: {
+		Name:          ScrollMarginBottom,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-bottom",
+	},
+	ScrollMarginInline: {
+		Name:          ScrollMarginInline,
+		Syntax:        "<length>{1,2}",
+		Longhands:     []string{"scroll-margin-inline-end", "scroll-margin-inline-start"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline",
+	},
+	ScrollMarginInlineEnd: {
+		Name:          ScrollMarginInlineEnd,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-end",
+	},
+	ScrollMarginInlineStart: {
+		Name:          ScrollMarginInlineStart,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-start",
+	},
+	ScrollMarginLeft: {
+		Name:          ScrollMarginLeft,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-left",
+	},
+	ScrollMarginRight: {
+		Name:          ScrollMarginRight,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-right",
+	},
+	ScrollMarginTop: {
+		Name:          ScrollMarginTop,
+		Syntax:        "<length>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-margin-top",
+	},
+	ScrollPadding: {
+		Name:          ScrollPadding,
+		Syntax:        "[ auto | <length-percentage> ]{1,4}",
+		Longhands:     []string{"scroll-padding-bottom", "scroll-padding-left", "scroll-padding-right", "scroll-padding-top"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding",
+	},
+	ScrollPaddingBlock: {
+		Name:          ScrollPaddingBlock,
+		Syntax:        "[ auto | <length-percentage> ]{1,2}",
+		Longhands:     []string{"scroll-padding-block-end", "scroll-padding-block-start"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block",
+	},
+	ScrollPaddingBlockEnd: {
+		Name:          ScrollPaddingBlockEnd,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-end",
+	},
+	ScrollPaddingBlockStart: {
+		Name:          ScrollPaddingBlockStart,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-start",
+	},
+	ScrollPaddingBottom: {
+		Name:          ScrollPaddingBottom,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-bottom",
+	},
+	ScrollPaddingInline: {
+		Name:          ScrollPaddingInline,
+		Syntax:        "[ auto | <length-percentage> ]{1,2}",
+		Longhands:     []string{"scroll-padding-inline-end", "scroll-padding-inline-start"},
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline",
+	},
+	ScrollPaddingInlineEnd: {
+		Name:          ScrollPaddingInlineEnd,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-end",
+	},
+	ScrollPaddingInlineStart: {
+		Name:          ScrollPaddingInlineStart,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-start",
+	},
+	ScrollPaddingLeft: {
+		Name:          ScrollPaddingLeft,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-left",
+	},
+	ScrollPaddingRight: {
+		Name:          ScrollPaddingRight,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-right",
+	},
+	ScrollPaddingTop: {
+		Name:          ScrollPaddingTop,
+		Syntax:        "auto | <length-percentage>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-padding-top",
+	},
+	ScrollSnapAlign: {
+		Name:          ScrollSnapAlign,
+		Syntax:        "[ none | start | end | center ]{1,2}",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-snap-align",
+	},
+	ScrollSnapCoordinate: {
+		Name:          ScrollSnapCoordinate,
+		Syntax:        "none | <position>#",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "position",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollSnapDestination: {
+		Name:          ScrollSnapDestination,
+		Syntax:        "<position>",
+		Initial:       "0px 0px",
+		Animatable:    true,
+		AnimationType: "position",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollSnapPointsX: {
+		Name:          ScrollSnapPointsX,
+		Syntax:        "none | repeat( <length-percentage> )",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollSnapPointsY: {
+		Name:          ScrollSnapPointsY,
+		Syntax:        "none | repeat( <length-percentage> )",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollSnapStop: {
+		Name:          ScrollSnapStop,
+		Syntax:        "normal | always",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-snap-stop",
+	},
+	ScrollSnapType: {
+		Name:          ScrollSnapType,
+		Syntax:        "none | [ x | y | block | inline | both ] [ mandatory | proximity ]?",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scroll-snap-type",
+	},
+	ScrollSnapTypeX: {
+		Name:          ScrollSnapTypeX,
+		Syntax:        "none | mandatory | proximity",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollSnapTypeY: {
+		Name:          ScrollSnapTypeY,
+		Syntax:        "none | mandatory | proximity",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scroll Snap"},
+		Status:        "obsolete",
+	},
+	ScrollbarColor: {
+		Name:          ScrollbarColor,
+		Syntax:        "auto | <color>{2}",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "scrollingBoxes",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scrollbars Styling"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scrollbar-color",
+	},
+	ScrollbarGutter: {
+		Name:          ScrollbarGutter,
+		Syntax:        "auto | stable && both-edges?",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollingBoxes",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scrollbar-gutter",
+	},
+	ScrollbarWidth: {
+		Name:          ScrollbarWidth,
+		Syntax:        "auto | thin | none",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "scrollingBoxes",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Scrollbars Styling"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/scrollbar-width",
+	},
+	ShapeImageThreshold: {
+		Name:          ShapeImageThreshold,
+		Syntax:        "<opacity-value>",
+		Initial:       "0.0",
+		Animatable:    true,
+		AnimationType: "number",
+		AppliesTo:     "floats",
+		Computed:      "specifiedValueNumberClipped0To1",
+		Groups:        []string{"CSS Shapes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/shape-image-threshold",
+	},
+	ShapeMargin: {
+		Name:          ShapeMargin,
+		Syntax:        "<length-percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "floats",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Shapes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/shape-margin",
+	},
+	ShapeOutside: {
+		Name:          ShapeOutside,
+		Syntax:        "none | [ <shape-box> || <basic-shape> ] | <image>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "basicShapeOtherwiseNo",
+		AppliesTo:     "floats",
+		Computed:      "asDefinedForBasicShapeWithAbsoluteURIOtherwiseAsSpecified",
+		Groups:        []string{"CSS Shapes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/shape-outside",
+	},
+	ShapeRendering: {
+		Name:          ShapeRendering,
+		Syntax:        "auto | optimizeSpeed | crispEdges | geometricPrecision",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/shape-rendering",
+	},
+	StopColor: {
+		Name:          StopColor,
+		Syntax:        "<'color'>",
+		Initial:       "black",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsStop",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stop-color",
+	},
+	StopOpacity: {
+		Name:          StopOpacity,
+		Syntax:        "<'opacity'>",
+		Initial:       "black",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsStop",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stop-opacity",
+	},
+	Stroke: {
+		Name:       Stroke,
+		Syntax:     "<paint>",
+		Longhands:  []string{"stroke-dasharray", "stroke-dashoffset", "stroke-linecap", "stroke-linejoin", "stroke-miterlimit", "stroke-opacity", "stroke-width"},
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "asLonghands",
+		Computed:   "asLonghands",
+		Groups:     []string{"Scalable Vector Graphics"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/stroke",
+	},
+	StrokeDasharray: {
+		Name:          StrokeDasharray,
+		Syntax:        "none | <dasharray>",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "repeatableList",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "listEachItemConsistingOfAbsoluteLengthPercentageOrKeyword",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-dasharray",
+	},
+	StrokeDashoffset: {
+		Name:          StrokeDashoffset,
+		Syntax:        "<length-percentage> | <number>",
+		Initial:       "0",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "absoluteLengthOrPercentageNumbersConverted",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-dashoffset",
+	},
+	StrokeLinecap: {
+		Name:          StrokeLinecap,
+		Syntax:        "butt | round | square",
+		Initial:       "butt",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-linecap",
+	},
+	StrokeLinejoin: {
+		Name:          StrokeLinejoin,
+		Syntax:        "miter | miter-clip | round | bevel | arcs",
+		Initial:       "miter",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-linejoin",
+	},
+	StrokeMiterlimit: {
+		Name:          StrokeMiterlimit,
+		Syntax:        "<number>",
+		Initial:       "4",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-miterlimit",
+	},
+	StrokeOpacity: {
+		Name:          StrokeOpacity,
+		Syntax:        "<'opacity'>",
+		Initial:       "1",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "specifiedValueClipped0To1",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-opacity",
+	},
+	StrokeWidth: {
+		Name:          StrokeWidth,
+		Syntax:        "<length-percentage> | <number>",
+		Initial:       "1px",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsShapes",
+		Computed:      "absoluteLengthOrPercentageNumbersConverted",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/stroke-width",
+	},
+	TabSize: {
+		Name:          TabSize,
+		Syntax:        "<integer> | <length>",
+		Initial:       "8",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "blockContainers",
+		Computed:      "specifiedIntegerOrAbsoluteLength",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/tab-size",
+	},
+	TableLayout: {
+		Name:          TableLayout,
+		Syntax:        "auto | fixed",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "tableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Table"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/table-layout",
+	},
+	TextAlign: {
+		Name:          TextAlign,
+		Syntax:        "start | end | left | right | center | justify | match-parent",
+		Initial:       "startOrNamelessValueIfLTRRightIfRTL",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainers",
+		Computed:      "asSpecifiedExceptMatchParent",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-align",
+	},
+	TextAlignLast: {
+		Name:          TextAlignLast,
+		Syntax:        "auto | start | end | left | right | center | justify",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-align-last",
+	},
+	TextAnchor: {
+		Name:          TextAnchor,
+		Syntax:        "start | middle | end",
+		Initial:       "start",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsTextContent",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-anchor",
+	},
+	TextAutospace: {
+		Name:          TextAutospace,
+		Syntax:        "normal | <autospace> | auto",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-autospace",
+	},
+	TextBox: {
+		Name:          TextBox,
+		Syntax:        "normal | <'text-box-trim'> || <'text-box-edge'>",
+		Initial:       "normal",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersAndInlineBoxes",
+		Computed:      "theSpecifiedKeyword",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+	},
+	TextBoxEdge: {
+		Name:          TextBoxEdge,
+		Syntax:        "auto | <text-edge>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersAndInlineBoxes",
+		Computed:      "theSpecifiedKeyword",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+	},
+	TextBoxTrim: {
+		Name:          TextBoxTrim,
+		Syntax:        "none | trim-start | trim-end | trim-both",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainersAndInlineBoxes",
+		Computed:      "theSpecifiedKeyword",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+	},
+	TextCombineUpright: {
+		Name:          TextCombineUpright,
+		Syntax:        "none | all | [ digits <integer>? ]",
+		Initial:       "none",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "nonReplacedInlineElements",
+		Computed:      "keywordPlusIntegerIfDigits",
+		Groups:        []string{"CSS Writing Modes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-combine-upright",
+	},
+	TextDecoration: {
+		Name:       TextDecoration,
+		Syntax:     "<'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>",
+		Longhands:  []string{"text-decoration-color", "text-decoration-line", "text-decoration-style"},
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Text Decoration"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/text-decoration",
+	},
+	TextDecorationColor: {
+		Name:          TextDecorationColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-color",
+	},
+	TextDecorationLine: {
+		Name:          TextDecorationLine,
+		Syntax:        "none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-line",
+	},
+	TextDecorationSkipInk: {
+		Name:          TextDecorationSkipInk,
+		Syntax:        "auto | all | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip-ink",
+	},
+	TextDecorationStyle: {
+		Name:          TextDecorationStyle,
+		Syntax:        "solid | double | dotted | dashed | wavy",
+		Initial:       "solid",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-style",
+	},
+	TextDecorationThickness: {
+		Name:          TextDecorationThickness,
+		Syntax:        "auto | from-font | <length> | <percentage> ",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-decoration-thickness",
+	},
+	TextEmphasis: {
+		Name:       TextEmphasis,
+		Syntax:     "<'text-emphasis-style'> || <'text-emphasis-color'>",
+		Longhands:  []string{"text-emphasis-color", "text-emphasis-style"},
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "allElements",
+		Groups:     []string{"CSS Text Decoration"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/text-emphasis",
+	},
+	TextEmphasisColor: {
+		Name:          TextEmphasisColor,
+		Syntax:        "<color>",
+		Initial:       "currentcolor",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "color",
+		AppliesTo:     "allElements",
+		Computed:      "computedColor",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-emphasis-color",
+	},
+	TextEmphasisPosition: {
+		Name:          TextEmphasisPosition,
+		Syntax:        "auto | [ over | under ] && [ right | left ]?",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-emphasis-position",
+	},
+	TextEmphasisStyle: {
+		Name:          TextEmphasisStyle,
+		Syntax:        "none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-emphasis-style",
+	},
+	TextIndent: {
+		Name:          TextIndent,
+		Syntax:        "<length-percentage> && hanging? && each-line?",
+		Initial:       "0",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "blockContainers",
+		Computed:      "percentageOrAbsoluteLengthPlusKeywords",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-indent",
+	},
+	TextJustify: {
+		Name:          TextJustify,
+		Syntax:        "auto | inter-character | inter-word | none",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "inlineLevelAndTableCellElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-justify",
+	},
+	TextOrientation: {
+		Name:          TextOrientation,
+		Syntax:        "mixed | upright | sideways",
+		Initial:       "mixed",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsExceptTableRowGroupsRowsColumnGroupsAndColumns",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Writing Modes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-orientation",
+	},
+	TextOverflow: {
+		Name:          TextOverflow,
+		Syntax:        "[ clip | ellipsis | <string> ]{1,2}",
+		Initial:       "clip",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "blockContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Overflow"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-overflow",
+	},
+	TextRendering: {
+		Name:          TextRendering,
+		Syntax:        "auto | optimizeSpeed | optimizeLegibility | geometricPrecision",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-rendering",
+	},
+	TextShadow: {
+		Name:          TextShadow,
+		Syntax:        "none | <shadow-t>#",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "shadowList",
+		AppliesTo:     "allElements",
+		Computed:      "colorPlusThreeAbsoluteLengths",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-shadow",
+	},
+	TextTransform: {
+		Name:          TextTransform,
+		Syntax:        "none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto",
+		Initial:       "none",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text", "MathML"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-transform",
+	},
+	TextUnderlineOffset: {
+		Name:          TextUnderlineOffset,
+		Syntax:        "auto | <length> | <percentage> ",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-underline-offset",
+	},
+	TextUnderlinePosition: {
+		Name:          TextUnderlinePosition,
+		Syntax:        "auto | from-font | [ under || [ left | right ] ]",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text Decoration"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-underline-position",
+	},
+	TextWrap: {
+		Name:       TextWrap,
+		Syntax:     "<'text-wrap-mode'> || <'text-wrap-style'>",
+		Initial:    "wrap",
+		Inherited:  true,
+		Animatable: true,
+		AppliesTo:  "textAndBlockContainers",
+		Groups:     []string{"CSS Text"},
+		Status:     "standard",
+		MDNURL:     "https://developer.mozilla.org/docs/Web/CSS/text-wrap",
+	},
+	TextWrapMode: {
+		Name:          TextWrapMode,
+		Syntax:        "wrap | nowrap",
+		Initial:       "wrap",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textAndBlockContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-wrap-mode",
+	},
+	TextWrapStyle: {
+		Name:          TextWrapStyle,
+		Syntax:        "auto | balance | stable | pretty",
+		Initial:       "auto",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "textAndBlockContainers",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/text-wrap-style",
+	},
+	Top: {
+		Name:          Top,
+		Syntax:        "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "positionedElements",
+		Computed:      "lengthAbsolutePercentageAsSpecifiedOtherwiseAuto",
+		Groups:        []string{"CSS Anchor Positioning", "CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/top",
+	},
+	TouchAction: {
+		Name:          TouchAction,
+		Syntax:        "auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation",
+		Initial:       "auto",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsExceptNonReplacedInlineElementsTableRowsColumnsRowColumnGroups",
+		Computed:      "asSpecified",
+		Groups:        []string{"Pointer Events"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/touch-action",
+	},
+	Transform: {
+		Name:          Transform,
+		Syntax:        "none | <transform-list>",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "transform",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transform",
+	},
+	TransformBox: {
+		Name:          TransformBox,
+		Syntax:        "content-box | border-box | fill-box | stroke-box | view-box",
+		Initial:       "view-box",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transform-box",
+	},
+	TransformOrigin: {
+		Name:          TransformOrigin,
+		Syntax:        "[ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?",
+		Initial:       "50% 50% 0",
+		Animatable:    true,
+		AnimationType: "simpleListOfLpc",
+		AppliesTo:     "transformableElements",
+		Computed:      "forLengthAbsoluteValueOtherwisePercentage",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transform-origin",
+	},
+	TransformStyle: {
+		Name:          TransformStyle,
+		Syntax:        "flat | preserve-3d",
+		Initial:       "flat",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transform-style",
+	},
+	Transition: {
+		Name:          Transition,
+		Syntax:        "<single-transition>#",
+		Longhands:     []string{"transition-behavior", "transition-delay", "transition-duration", "transition-property", "transition-timing-function"},
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition",
+	},
+	TransitionBehavior: {
+		Name:          TransitionBehavior,
+		Syntax:        "<transition-behavior-value>#",
+		Initial:       "normal",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition-behavior",
+	},
+	TransitionDelay: {
+		Name:          TransitionDelay,
+		Syntax:        "<time>#",
+		Initial:       "0s",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition-delay",
+	},
+	TransitionDuration: {
+		Name:          TransitionDuration,
+		Syntax:        "<time>#",
+		Initial:       "0s",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition-duration",
+	},
+	TransitionProperty: {
+		Name:          TransitionProperty,
+		Syntax:        "none | <single-transition-property>#",
+		Initial:       "all",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition-property",
+	},
+	TransitionTimingFunction: {
+		Name:          TransitionTimingFunction,
+		Syntax:        "<easing-function>#",
+		Initial:       "ease",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsAndPseudos",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/transition-timing-function",
+	},
+	Translate: {
+		Name:          Translate,
+		Syntax:        "none | <length-percentage> [ <length-percentage> <length>? ]?",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "transform",
+		AppliesTo:     "transformableElements",
+		Computed:      "asSpecifiedRelativeToAbsoluteLengths",
+		Groups:        []string{"CSS Transforms"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/translate",
+	},
+	UnicodeBidi: {
+		Name:          UnicodeBidi,
+		Syntax:        "normal | embed | isolate | bidi-override | isolate-override | plaintext",
+		Initial:       "normal",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsSomeValuesNoEffectOnNonInlineElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Writing Modes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/unicode-bidi",
+	},
+	UserSelect: {
+		Name:          UserSelect,
+		Syntax:        "auto | text | none | all",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Basic User Interface"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/user-select",
+	},
+	VectorEffect: {
+		Name:          VectorEffect,
+		Syntax:        "none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "limitedSVGElementsGraphicsAndUse",
+		Computed:      "asSpecified",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/vector-effect",
+	},
+	VerticalAlign: {
+		Name:          VerticalAlign,
+		Syntax:        "baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>",
+		Initial:       "baseline",
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "inlineLevelAndTableCellElements",
+		Computed:      "absoluteLengthOrKeyword",
+		Groups:        []string{"CSS Inline"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/vertical-align",
+	},
+	ViewTransitionClass: {
+		Name:          ViewTransitionClass,
+		Syntax:        "none | <custom-ident>+",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS View Transitions"},
+		Status:        "standard",
+	},
+	ViewTransitionName: {
+		Name:          ViewTransitionName,
+		Syntax:        "none | <custom-ident> | match-element",
+		Initial:       "none",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS View Transitions"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/view-transition-name",
+	},
+	Visibility: {
+		Name:          Visibility,
+		Syntax:        "visible | hidden | collapse",
+		Initial:       "visible",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "visibility",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Display", "Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/visibility",
+	},
+	WhiteSpace: {
+		Name:          WhiteSpace,
+		Syntax:        "normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/white-space",
+	},
+	WhiteSpaceCollapse: {
+		Name:          WhiteSpaceCollapse,
+		Syntax:        "collapse | preserve | preserve-breaks | preserve-spaces | break-spaces",
+		Initial:       "collapse",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/white-space-collapse",
+	},
+	Widows: {
+		Name:          Widows,
+		Syntax:        "<integer>",
+		Initial:       "2",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "blockContainerElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Fragmentation"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/widows",
+	},
+	Width: {
+		Name:          Width,
+		Syntax:        "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "lpc",
+		AppliesTo:     "allElementsButNonReplacedAndTableRows",
+		Computed:      "percentageAutoOrAbsoluteLength",
+		Groups:        []string{"CSS Box Sizing"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/width",
+	},
+	WillChange: {
+		Name:          WillChange,
+		Syntax:        "auto | <animateable-feature>#",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Will Change"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/will-change",
+	},
+	WordBreak: {
+		Name:          WordBreak,
+		Syntax:        "normal | break-all | keep-all | break-word | auto-phrase",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/word-break",
+	},
+	WordSpacing: {
+		Name:          WordSpacing,
+		Syntax:        "normal | <length>",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "length",
+		AppliesTo:     "allElements",
+		Computed:      "absoluteLength",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/word-spacing",
+	},
+	WordWrap: {
+		Name:          WordWrap,
+		Syntax:        "normal | break-word",
+		Initial:       "normal",
+		Inherited:     true,
+		Animatable:    true,
+		AnimationType: "discrete",
+		AppliesTo:     "nonReplacedInlineElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Text"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/overflow-wrap",
+	},
+	WritingMode: {
+		Name:          WritingMode,
+		Syntax:        "horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr",
+		Initial:       "horizontal-tb",
+		Inherited:     true,
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElementsExceptTableRowColumnGroupsTableRowsColumns",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Writing Modes"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/writing-mode",
+	},
+	X: {
+		Name:          X,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsGeometry",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/x",
+	},
+	Y: {
+		Name:          Y,
+		Syntax:        "<length> | <percentage>",
+		Initial:       "0",
+		Animatable:    true,
+		AnimationType: "byComputedValueType",
+		AppliesTo:     "limitedSVGElementsGeometry",
+		Computed:      "percentageAsSpecifiedOrAbsoluteLength",
+		Groups:        []string{"Scalable Vector Graphics"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/y",
+	},
+	ZIndex: {
+		Name:          ZIndex,
+		Syntax:        "auto | <integer>",
+		Initial:       "auto",
+		Animatable:    true,
+		AnimationType: "integer",
+		AppliesTo:     "positionedElements",
+		Computed:      "asSpecified",
+		Groups:        []string{"CSS Positioned Layout"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/z-index",
+	},
+	Zoom: {
+		Name:          Zoom,
+		Syntax:        "normal | reset | <number [0,∞]> || <percentage [0,∞]>",
+		Initial:       "1",
+		AnimationType: "notAnimatable",
+		AppliesTo:     "allElements",
+		Computed:      "asSpecifiedButWithPercentageConvertedToTheEquivalentNumber",
+		Groups:        []string{"CSS Viewport"},
+		Status:        "standard",
+		MDNURL:        "https://developer.mozilla.org/docs/Web/CSS/zoom",
+	},
+}
+
+// statuses holds the status of the features other than properties.
+var statuses = map[string]Status{
+	"::after":                              "standard",
+	"::backdrop":                           "standard",
+	"::before":                             "standard",
+	"::checkmark":                          "standard",
+	"::cue":                                "standard",
+	"::cue()":                              "standard",
+	"::details-content":                    "standard",
+	"::file-selector-button":               "standard",
+	"::first-letter":                       "standard",
+	"::first-line":                         "standard",
+	"::grammar-error":                      "standard",
+	"::highlight()":                        "standard",
+	"::marker":                             "standard",
+	"::part()":                             "standard",
+	"::picker()":                           "standard",
+	"::picker-icon":                        "standard",
+	"::placeholder":                        "standard",
+	"::selection":                          "standard",
+	"::slotted()":                          "standard",
+	"::spelling-error":                     "standard",
+	"::target-text":                        "standard",
+	"::view-transition":                    "standard",
+	"::view-transition-group()":            "standard",
+	"::view-transition-image-pair()":       "standard",
+	"::view-transition-new()":              "standard",
+	"::view-transition-old()":              "standard",
+	":active":                              "standard",
+	":active-view-transition":              "standard",
+	":active-view-transition-type()":       "standard",
+	":any-link":                            "standard",
+	":autofill":                            "standard",
+	":buffering":                           "standard",
+	":checked":                             "standard",
+	":default":                             "standard",
+	":defined":                             "standard",
+	":dir()":                               "standard",
+	":disabled":                            "standard",
+	":empty":                               "standard",
+	":enabled":                             "standard",
+	":first":                               "standard",
+	":first-child":                         "standard",
+	":first-of-type":                       "standard",
+	":focus":                               "standard",
+	":focus-visible":                       "standard",
+	":focus-within":                        "standard",
+	":fullscreen":                          "standard",
+	":future":                              "standard",
+	":has()":                               "standard",
+	":has-slotted":                         "standard",
+	":host":                                "standard",
+	":host()":                              "standard",
+	":host-context()":                      "standard",
+	":hover":                               "standard",
+	":in-range":                            "standard",
+	":indeterminate":                       "standard",
+	":invalid":                             "standard",
+	":is()":                                "standard",
+	":lang()":                              "standard",
+	":last-child":                          "standard",
+	":last-of-type":                        "standard",
+	":left":                                "standard",
+	":link":                                "standard",
+	":modal":                               "standard",
+	":muted":                               "standard",
+	":not()":                               "standard",
+	":nth-child()":                         "standard",
+	":nth-last-child()":                    "standard",
+	":nth-last-of-type()":                  "standard",
+	":nth-of-type()":                       "standard",
+	":only-child":                          "standard",
+	":only-of-type":                        "standard",
+	":open":                                "standard",
+	":optional":                            "standard",
+	":out-of-range":                        "standard",
+	":past":                                "standard",
+	":paused":                              "standard",
+	":picture-in-picture":                  "standard",
+	":placeholder-shown":                   "standard",
+	":playing":                             "standard",
+	":popover-open":                        "standard",
+	":read-only":                           "standard",
+	":read-write":                          "standard",
+	":required":                            "standard",
+	":right":                               "standard",
+	":root":                                "standard",
+	":scope":                               "standard",
+	":seeking":                             "standard",
+	":stalled":                             "standard",
+	":state()":                             "standard",
+	":target":                              "standard",
+	":user-invalid":                        "standard",
+	":user-valid":                          "standard",
+	":valid":                               "standard",
+	":visited":                             "standard",
+	":volume-locked":                       "standard",
+	":where()":                             "standard",
+	"@charset":                             "standard",
+	"@container":                           "standard",
+	"@counter-style":                       "standard",
+	"@counter-style additive-symbols":      "standard",
+	"@counter-style fallback":              "standard",
+	"@counter-style negative":              "standard",
+	"@counter-style pad":                   "standard",
+	"@counter-style prefix":                "standard",
+	"@counter-style range":                 "standard",
+	"@counter-style speak-as":              "standard",
+	"@counter-style suffix":                "standard",
+	"@counter-style symbols":               "standard",
+	"@counter-style system":                "standard",
+	"@document":                            "nonstandard",
+	"@font-face":                           "standard",
+	"@font-face ascent-override":           "standard",
+	"@font-face descent-override":          "standard",
+	"@font-face font-display":              "standard",
+	"@font-face font-family":               "standard",
+	"@font-face font-feature-settings":     "standard",
+	"@font-face font-stretch":              "obsolete",
+	"@font-face font-style":                "standard",
+	"@font-face font-variation-settings":   "standard",
+	"@font-face font-weight":               "standard",
+	"@font-face line-gap-override":         "standard",
+	"@font-face size-adjust":               "standard",
+	"@font-face src":                       "standard",
+	"@font-face unicode-range":             "standard",
+	"@font-feature-values":                 "standard",
+	"@font-palette-values":                 "standard",
+	"@font-palette-values base-palette":    "standard",
+	"@font-palette-values font-family":     "standard",
+	"@font-palette-values override-colors": "standard",
+	"@import":                              "standard",
+	"@keyframes":                           "standard",
+	"@layer":                               "standard",
+	"@media":                               "standard",
+	"@namespace":                           "standard",
+	"@page":                                "standard",
+	"@page bleed":                          "standard",
+	"@page marks":                          "standard",
+	"@page page-orientation":               "standard",
+	"@page size":                           "standard",
+	"@property":                            "standard",
+	"@property inherits":                   "standard",
+	"@property initial-value":              "standard",
+	"@property syntax":                     "standard",
+	"@scope":                               "standard",
+	"@starting-style":                      "standard",
+	"@supports":                            "standard",
+	"@view-transition":                     "standard",
+	"@view-transition navigation":          "standard",
+	"@view-transition types":               "standard",
+	"abs()":                                "standard",
+	"acos()":                               "standard",
+	"asin()":                               "standard",
+	"atan()":                               "standard",
+	"atan2()":                              "standard",
+	"attr()":                               "standard",
+	"blur()":                               "standard",
+	"brightness()":                         "standard",
+	"calc()":                               "standard",
+	"circle()":                             "standard",
+	"clamp()":                              "standard",
+	"color()":                              "standard",
+	"color-mix()":                          "standard",
+	"conic-gradient()":                     "standard",
+	"contrast()":                           "standard",
+	"cos()":                                "standard",
+	"counter()":                            "standard",
+	"counters()":                           "standard",
+	"cross-fade()":                         "standard",
+	"cubic-bezier()":                       "standard",
+	"drop-shadow()":                        "standard",
+	"ellipse()":                            "standard",
+	"env()":                                "standard",
+	"exp()":                                "standard",
+	"fit-content()":                        "standard",
+	"grayscale()":                          "standard",
+	"hsl()":                                "standard",
+	"hsla()":                               "nonstandard",
+	"hue-rotate()":                         "standard",
+	"hwb()":                                "standard",
+	"hypot()":                              "standard",
+	"image()":                              "standard",
+	"image-set()":                          "standard",
+	"inset()":                              "standard",
+	"invert()":                             "standard",
+	"lab()":                                "standard",
+	"layer()":                              "standard",
+	"lch()":                                "standard",
+	"light-dark()":                         "standard",
+	"linear()":                             "standard",
+	"linear-gradient()":                    "standard",
+	"log()":                                "standard",
+	"matrix()":                             "standard",
+	"matrix3d()":                           "standard",
+	"max()":                                "standard",
+	"min()":                                "standard",
+	"minmax()":                             "standard",
+	"mod()":                                "standard",
+	"oklab()":                              "standard",
+	"oklch()":                              "standard",
+	"opacity()":                            "standard",
+	"paint()":                              "standard",
+	"palette-mix()":                        "standard",
+	"path()":                               "standard",
+	"perspective()":                        "standard",
+	"polygon()":                            "standard",
+	"pow()":                                "standard",
+	"radial-gradient()":                    "standard",
+	"ray()":                                "standard",
+	"rect()":                               "standard",
+	"rem()":                                "standard",
+	"repeating-conic-gradient()":           "standard",
+	"repeating-linear-gradient()":          "standard",
+	"repeating-radial-gradient()":          "standard",
+	"rgb()":                                "standard",
+	"rgba()":                               "nonstandard",
+	"rotate()":                             "standard",
+	"rotate3d()":                           "standard",
+	"rotateX()":                            "standard",
+	"rotateY()":                            "standard",
+	"rotateZ()":                            "standard",
+	"round()":                              "standard",
+	"saturate()":                           "standard",
+	"scale()":                              "standard",
+	"scale3d()":                            "standard",
+	"scaleX()":                             "standard",
+	"scaleY()":                             "standard",
+	"scaleZ()":                             "standard",
+	"sepia()":                              "standard",
+	"sign()":                               "standard",
+	"sin()":                                "standard",
+	"skew()":                               "standard",
+	"skewX()":                              "standard",
+	"skewY()":                              "standard",
+	"sqrt()":                               "standard",
+	"steps()":                              "standard",
+	"symbols()":                            "standard",
+	"tan()":                                "standard",
+	"translate()":                          "standard",
+	"translate3d()":                        "standard",
+	"translateX()":                         "standard",
+	"translateY()":                         "standard",
+	"translateZ()":                         "standard",
+	"var()":                                "standard",
+	"xywh()":                               "standard",
+}
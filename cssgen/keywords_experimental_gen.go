@@ -0,0 +1,3079 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
+
+//go:build cssexperimental
+
+package cssgen
+
+import "strings"
+
+// Keyword types and constants for CSS property values.
+
+// AnchorNameVal represents values for the anchor-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <dashed-ident>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/anchor-name
+type AnchorNameVal string
+
+// AnchorNameVal constants.
+const (
+	AnchorNameValNone AnchorNameVal = "none"
+)
+
+func (v AnchorNameVal) String() string { return string(v) }
+
+// Values returns every AnchorNameVal constant.
+func (AnchorNameVal) Values() []AnchorNameVal {
+	return []AnchorNameVal{
+		AnchorNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the AnchorNameVal constants.
+func (v AnchorNameVal) Valid() bool {
+	switch v {
+	case AnchorNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseAnchorNameVal returns the AnchorNameVal constant for a keyword, ignoring ASCII case.
+func ParseAnchorNameVal(s string) (AnchorNameVal, error) {
+	v := AnchorNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnchorNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnchorNameVal constants.
+func (v AnchorNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnchorNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnchorNameVal.
+func (v *AnchorNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnchorNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// AnchorScopeVal represents values for the anchor-scope property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | all | <dashed-ident>#
+type AnchorScopeVal string
+
+// AnchorScopeVal constants.
+const (
+	AnchorScopeValAll  AnchorScopeVal = "all"
+	AnchorScopeValNone AnchorScopeVal = "none"
+)
+
+func (v AnchorScopeVal) String() string { return string(v) }
+
+// Values returns every AnchorScopeVal constant.
+func (AnchorScopeVal) Values() []AnchorScopeVal {
+	return []AnchorScopeVal{
+		AnchorScopeValAll,
+		AnchorScopeValNone,
+	}
+}
+
+// Valid reports whether v is one of the AnchorScopeVal constants.
+func (v AnchorScopeVal) Valid() bool {
+	switch v {
+	case AnchorScopeValAll,
+		AnchorScopeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseAnchorScopeVal returns the AnchorScopeVal constant for a keyword, ignoring ASCII case.
+func ParseAnchorScopeVal(s string) (AnchorScopeVal, error) {
+	v := AnchorScopeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnchorScopeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnchorScopeVal constants.
+func (v AnchorScopeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnchorScopeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnchorScopeVal.
+func (v *AnchorScopeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnchorScopeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// AnimationRangeVal represents values for the animation-range property.
+//
+//   - Shorthand for: animation-range-end, animation-range-start
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'animation-range-start'> <'animation-range-end'>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range
+type AnimationRangeVal string
+
+// AnimationRangeVal constants.
+const (
+	AnimationRangeValContain       AnimationRangeVal = "contain"
+	AnimationRangeValCover         AnimationRangeVal = "cover"
+	AnimationRangeValEntry         AnimationRangeVal = "entry"
+	AnimationRangeValEntryCrossing AnimationRangeVal = "entry-crossing"
+	AnimationRangeValExit          AnimationRangeVal = "exit"
+	AnimationRangeValExitCrossing  AnimationRangeVal = "exit-crossing"
+	AnimationRangeValNormal        AnimationRangeVal = "normal"
+)
+
+func (v AnimationRangeVal) String() string { return string(v) }
+
+// Values returns every AnimationRangeVal constant.
+func (AnimationRangeVal) Values() []AnimationRangeVal {
+	return []AnimationRangeVal{
+		AnimationRangeValContain,
+		AnimationRangeValCover,
+		AnimationRangeValEntry,
+		AnimationRangeValEntryCrossing,
+		AnimationRangeValExit,
+		AnimationRangeValExitCrossing,
+		AnimationRangeValNormal,
+	}
+}
+
+// Valid reports whether v is one of the AnimationRangeVal constants.
+func (v AnimationRangeVal) Valid() bool {
+	switch v {
+	case AnimationRangeValContain,
+		AnimationRangeValCover,
+		AnimationRangeValEntry,
+		AnimationRangeValEntryCrossing,
+		AnimationRangeValExit,
+		AnimationRangeValExitCrossing,
+		AnimationRangeValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationRangeVal returns the AnimationRangeVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationRangeVal(s string) (AnimationRangeVal, error) {
+	v := AnimationRangeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationRangeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationRangeVal constants.
+func (v AnimationRangeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationRangeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationRangeVal.
+func (v *AnimationRangeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationRangeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// AnimationRangeEndVal represents values for the animation-range-end property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-end
+type AnimationRangeEndVal string
+
+// AnimationRangeEndVal constants.
+const (
+	AnimationRangeEndValContain       AnimationRangeEndVal = "contain"
+	AnimationRangeEndValCover         AnimationRangeEndVal = "cover"
+	AnimationRangeEndValEntry         AnimationRangeEndVal = "entry"
+	AnimationRangeEndValEntryCrossing AnimationRangeEndVal = "entry-crossing"
+	AnimationRangeEndValExit          AnimationRangeEndVal = "exit"
+	AnimationRangeEndValExitCrossing  AnimationRangeEndVal = "exit-crossing"
+	AnimationRangeEndValNormal        AnimationRangeEndVal = "normal"
+)
+
+func (v AnimationRangeEndVal) String() string { return string(v) }
+
+// Values returns every AnimationRangeEndVal constant.
+func (AnimationRangeEndVal) Values() []AnimationRangeEndVal {
+	return []AnimationRangeEndVal{
+		AnimationRangeEndValContain,
+		AnimationRangeEndValCover,
+		AnimationRangeEndValEntry,
+		AnimationRangeEndValEntryCrossing,
+		AnimationRangeEndValExit,
+		AnimationRangeEndValExitCrossing,
+		AnimationRangeEndValNormal,
+	}
+}
+
+// Valid reports whether v is one of the AnimationRangeEndVal constants.
+func (v AnimationRangeEndVal) Valid() bool {
+	switch v {
+	case AnimationRangeEndValContain,
+		AnimationRangeEndValCover,
+		AnimationRangeEndValEntry,
+		AnimationRangeEndValEntryCrossing,
+		AnimationRangeEndValExit,
+		AnimationRangeEndValExitCrossing,
+		AnimationRangeEndValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationRangeEndVal returns the AnimationRangeEndVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationRangeEndVal(s string) (AnimationRangeEndVal, error) {
+	v := AnimationRangeEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationRangeEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationRangeEndVal constants.
+func (v AnimationRangeEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationRangeEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationRangeEndVal.
+func (v *AnimationRangeEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationRangeEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// AnimationRangeStartVal represents values for the animation-range-start property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-start
+type AnimationRangeStartVal string
+
+// AnimationRangeStartVal constants.
+const (
+	AnimationRangeStartValContain       AnimationRangeStartVal = "contain"
+	AnimationRangeStartValCover         AnimationRangeStartVal = "cover"
+	AnimationRangeStartValEntry         AnimationRangeStartVal = "entry"
+	AnimationRangeStartValEntryCrossing AnimationRangeStartVal = "entry-crossing"
+	AnimationRangeStartValExit          AnimationRangeStartVal = "exit"
+	AnimationRangeStartValExitCrossing  AnimationRangeStartVal = "exit-crossing"
+	AnimationRangeStartValNormal        AnimationRangeStartVal = "normal"
+)
+
+func (v AnimationRangeStartVal) String() string { return string(v) }
+
+// Values returns every AnimationRangeStartVal constant.
+func (AnimationRangeStartVal) Values() []AnimationRangeStartVal {
+	return []AnimationRangeStartVal{
+		AnimationRangeStartValContain,
+		AnimationRangeStartValCover,
+		AnimationRangeStartValEntry,
+		AnimationRangeStartValEntryCrossing,
+		AnimationRangeStartValExit,
+		AnimationRangeStartValExitCrossing,
+		AnimationRangeStartValNormal,
+	}
+}
+
+// Valid reports whether v is one of the AnimationRangeStartVal constants.
+func (v AnimationRangeStartVal) Valid() bool {
+	switch v {
+	case AnimationRangeStartValContain,
+		AnimationRangeStartValCover,
+		AnimationRangeStartValEntry,
+		AnimationRangeStartValEntryCrossing,
+		AnimationRangeStartValExit,
+		AnimationRangeStartValExitCrossing,
+		AnimationRangeStartValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationRangeStartVal returns the AnimationRangeStartVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationRangeStartVal(s string) (AnimationRangeStartVal, error) {
+	v := AnimationRangeStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationRangeStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationRangeStartVal constants.
+func (v AnimationRangeStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationRangeStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationRangeStartVal.
+func (v *AnimationRangeStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationRangeStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// AnimationTimelineVal represents values for the animation-timeline property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<single-animation-timeline>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timeline
+type AnimationTimelineVal string
+
+// AnimationTimelineVal constants.
+const (
+	AnimationTimelineValAuto AnimationTimelineVal = "auto"
+	AnimationTimelineValNone AnimationTimelineVal = "none"
+)
+
+func (v AnimationTimelineVal) String() string { return string(v) }
+
+// Values returns every AnimationTimelineVal constant.
+func (AnimationTimelineVal) Values() []AnimationTimelineVal {
+	return []AnimationTimelineVal{
+		AnimationTimelineValAuto,
+		AnimationTimelineValNone,
+	}
+}
+
+// Valid reports whether v is one of the AnimationTimelineVal constants.
+func (v AnimationTimelineVal) Valid() bool {
+	switch v {
+	case AnimationTimelineValAuto,
+		AnimationTimelineValNone:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationTimelineVal returns the AnimationTimelineVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationTimelineVal(s string) (AnimationTimelineVal, error) {
+	v := AnimationTimelineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationTimelineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationTimelineVal constants.
+func (v AnimationTimelineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationTimelineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationTimelineVal.
+func (v *AnimationTimelineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationTimelineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FieldSizingVal represents values for the field-sizing property.
+//
+//   - Initial: fixed
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	content | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/field-sizing
+type FieldSizingVal string
+
+// FieldSizingVal constants.
+const (
+	FieldSizingValContent FieldSizingVal = "content"
+	FieldSizingValFixed   FieldSizingVal = "fixed"
+)
+
+func (v FieldSizingVal) String() string { return string(v) }
+
+// Values returns every FieldSizingVal constant.
+func (FieldSizingVal) Values() []FieldSizingVal {
+	return []FieldSizingVal{
+		FieldSizingValContent,
+		FieldSizingValFixed,
+	}
+}
+
+// Valid reports whether v is one of the FieldSizingVal constants.
+func (v FieldSizingVal) Valid() bool {
+	switch v {
+	case FieldSizingValContent,
+		FieldSizingValFixed:
+		return true
+	}
+	return false
+}
+
+// ParseFieldSizingVal returns the FieldSizingVal constant for a keyword, ignoring ASCII case.
+func ParseFieldSizingVal(s string) (FieldSizingVal, error) {
+	v := FieldSizingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FieldSizingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FieldSizingVal constants.
+func (v FieldSizingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FieldSizingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFieldSizingVal.
+func (v *FieldSizingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFieldSizingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontSynthesisPositionVal represents values for the font-synthesis-position property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-position
+type FontSynthesisPositionVal string
+
+// FontSynthesisPositionVal constants.
+const (
+	FontSynthesisPositionValAuto FontSynthesisPositionVal = "auto"
+	FontSynthesisPositionValNone FontSynthesisPositionVal = "none"
+)
+
+func (v FontSynthesisPositionVal) String() string { return string(v) }
+
+// Values returns every FontSynthesisPositionVal constant.
+func (FontSynthesisPositionVal) Values() []FontSynthesisPositionVal {
+	return []FontSynthesisPositionVal{
+		FontSynthesisPositionValAuto,
+		FontSynthesisPositionValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontSynthesisPositionVal constants.
+func (v FontSynthesisPositionVal) Valid() bool {
+	switch v {
+	case FontSynthesisPositionValAuto,
+		FontSynthesisPositionValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontSynthesisPositionVal returns the FontSynthesisPositionVal constant for a keyword, ignoring ASCII case.
+func ParseFontSynthesisPositionVal(s string) (FontSynthesisPositionVal, error) {
+	v := FontSynthesisPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSynthesisPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSynthesisPositionVal constants.
+func (v FontSynthesisPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSynthesisPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSynthesisPositionVal.
+func (v *FontSynthesisPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSynthesisPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// FontWidthVal represents values for the font-width property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | <percentage [0,∞]> | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded
+type FontWidthVal string
+
+// FontWidthVal constants.
+const (
+	FontWidthValCondensed      FontWidthVal = "condensed"
+	FontWidthValExpanded       FontWidthVal = "expanded"
+	FontWidthValExtraCondensed FontWidthVal = "extra-condensed"
+	FontWidthValExtraExpanded  FontWidthVal = "extra-expanded"
+	FontWidthValNormal         FontWidthVal = "normal"
+	FontWidthValSemiCondensed  FontWidthVal = "semi-condensed"
+	FontWidthValSemiExpanded   FontWidthVal = "semi-expanded"
+	FontWidthValUltraCondensed FontWidthVal = "ultra-condensed"
+	FontWidthValUltraExpanded  FontWidthVal = "ultra-expanded"
+)
+
+func (v FontWidthVal) String() string { return string(v) }
+
+// Values returns every FontWidthVal constant.
+func (FontWidthVal) Values() []FontWidthVal {
+	return []FontWidthVal{
+		FontWidthValCondensed,
+		FontWidthValExpanded,
+		FontWidthValExtraCondensed,
+		FontWidthValExtraExpanded,
+		FontWidthValNormal,
+		FontWidthValSemiCondensed,
+		FontWidthValSemiExpanded,
+		FontWidthValUltraCondensed,
+		FontWidthValUltraExpanded,
+	}
+}
+
+// Valid reports whether v is one of the FontWidthVal constants.
+func (v FontWidthVal) Valid() bool {
+	switch v {
+	case FontWidthValCondensed,
+		FontWidthValExpanded,
+		FontWidthValExtraCondensed,
+		FontWidthValExtraExpanded,
+		FontWidthValNormal,
+		FontWidthValSemiCondensed,
+		FontWidthValSemiExpanded,
+		FontWidthValUltraCondensed,
+		FontWidthValUltraExpanded:
+		return true
+	}
+	return false
+}
+
+// ParseFontWidthVal returns the FontWidthVal constant for a keyword, ignoring ASCII case.
+func ParseFontWidthVal(s string) (FontWidthVal, error) {
+	v := FontWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontWidthVal constants.
+func (v FontWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontWidthVal.
+func (v *FontWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ImageResolutionVal represents values for the image-resolution property.
+//
+//   - Initial: 1dppx
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ from-image || <resolution> ] && snap?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-resolution
+type ImageResolutionVal string
+
+// ImageResolutionVal constants.
+const (
+	ImageResolutionValFromImage ImageResolutionVal = "from-image"
+	ImageResolutionValSnap      ImageResolutionVal = "snap"
+)
+
+func (v ImageResolutionVal) String() string { return string(v) }
+
+// Values returns every ImageResolutionVal constant.
+func (ImageResolutionVal) Values() []ImageResolutionVal {
+	return []ImageResolutionVal{
+		ImageResolutionValFromImage,
+		ImageResolutionValSnap,
+	}
+}
+
+// Valid reports whether v is one of the ImageResolutionVal constants.
+func (v ImageResolutionVal) Valid() bool {
+	switch v {
+	case ImageResolutionValFromImage,
+		ImageResolutionValSnap:
+		return true
+	}
+	return false
+}
+
+// ParseImageResolutionVal returns the ImageResolutionVal constant for a keyword, ignoring ASCII case.
+func ParseImageResolutionVal(s string) (ImageResolutionVal, error) {
+	v := ImageResolutionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ImageResolutionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ImageResolutionVal constants.
+func (v ImageResolutionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ImageResolutionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseImageResolutionVal.
+func (v *ImageResolutionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseImageResolutionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// InitialLetterAlignVal represents values for the initial-letter-align property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ auto | alphabetic | hanging | ideographic ]
+type InitialLetterAlignVal string
+
+// InitialLetterAlignVal constants.
+const (
+	InitialLetterAlignValAlphabetic  InitialLetterAlignVal = "alphabetic"
+	InitialLetterAlignValAuto        InitialLetterAlignVal = "auto"
+	InitialLetterAlignValHanging     InitialLetterAlignVal = "hanging"
+	InitialLetterAlignValIdeographic InitialLetterAlignVal = "ideographic"
+)
+
+func (v InitialLetterAlignVal) String() string { return string(v) }
+
+// Values returns every InitialLetterAlignVal constant.
+func (InitialLetterAlignVal) Values() []InitialLetterAlignVal {
+	return []InitialLetterAlignVal{
+		InitialLetterAlignValAlphabetic,
+		InitialLetterAlignValAuto,
+		InitialLetterAlignValHanging,
+		InitialLetterAlignValIdeographic,
+	}
+}
+
+// Valid reports whether v is one of the InitialLetterAlignVal constants.
+func (v InitialLetterAlignVal) Valid() bool {
+	switch v {
+	case InitialLetterAlignValAlphabetic,
+		InitialLetterAlignValAuto,
+		InitialLetterAlignValHanging,
+		InitialLetterAlignValIdeographic:
+		return true
+	}
+	return false
+}
+
+// ParseInitialLetterAlignVal returns the InitialLetterAlignVal constant for a keyword, ignoring ASCII case.
+func ParseInitialLetterAlignVal(s string) (InitialLetterAlignVal, error) {
+	v := InitialLetterAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InitialLetterAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InitialLetterAlignVal constants.
+func (v InitialLetterAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InitialLetterAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInitialLetterAlignVal.
+func (v *InitialLetterAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInitialLetterAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// InterpolateSizeVal represents values for the interpolate-size property.
+//
+//   - Initial: numeric-only
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	numeric-only | allow-keywords
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/interpolate-size
+type InterpolateSizeVal string
+
+// InterpolateSizeVal constants.
+const (
+	InterpolateSizeValAllowKeywords InterpolateSizeVal = "allow-keywords"
+	InterpolateSizeValNumericOnly   InterpolateSizeVal = "numeric-only"
+)
+
+func (v InterpolateSizeVal) String() string { return string(v) }
+
+// Values returns every InterpolateSizeVal constant.
+func (InterpolateSizeVal) Values() []InterpolateSizeVal {
+	return []InterpolateSizeVal{
+		InterpolateSizeValAllowKeywords,
+		InterpolateSizeValNumericOnly,
+	}
+}
+
+// Valid reports whether v is one of the InterpolateSizeVal constants.
+func (v InterpolateSizeVal) Valid() bool {
+	switch v {
+	case InterpolateSizeValAllowKeywords,
+		InterpolateSizeValNumericOnly:
+		return true
+	}
+	return false
+}
+
+// ParseInterpolateSizeVal returns the InterpolateSizeVal constant for a keyword, ignoring ASCII case.
+func ParseInterpolateSizeVal(s string) (InterpolateSizeVal, error) {
+	v := InterpolateSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InterpolateSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InterpolateSizeVal constants.
+func (v InterpolateSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InterpolateSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInterpolateSizeVal.
+func (v *InterpolateSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInterpolateSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MarginTrimVal represents values for the margin-trim property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | in-flow | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-trim
+type MarginTrimVal string
+
+// MarginTrimVal constants.
+const (
+	MarginTrimValAll    MarginTrimVal = "all"
+	MarginTrimValInFlow MarginTrimVal = "in-flow"
+	MarginTrimValNone   MarginTrimVal = "none"
+)
+
+func (v MarginTrimVal) String() string { return string(v) }
+
+// Values returns every MarginTrimVal constant.
+func (MarginTrimVal) Values() []MarginTrimVal {
+	return []MarginTrimVal{
+		MarginTrimValAll,
+		MarginTrimValInFlow,
+		MarginTrimValNone,
+	}
+}
+
+// Valid reports whether v is one of the MarginTrimVal constants.
+func (v MarginTrimVal) Valid() bool {
+	switch v {
+	case MarginTrimValAll,
+		MarginTrimValInFlow,
+		MarginTrimValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMarginTrimVal returns the MarginTrimVal constant for a keyword, ignoring ASCII case.
+func ParseMarginTrimVal(s string) (MarginTrimVal, error) {
+	v := MarginTrimVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginTrimVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginTrimVal constants.
+func (v MarginTrimVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginTrimVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginTrimVal.
+func (v *MarginTrimVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginTrimVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MathShiftVal represents values for the math-shift property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | compact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-shift
+type MathShiftVal string
+
+// MathShiftVal constants.
+const (
+	MathShiftValCompact MathShiftVal = "compact"
+	MathShiftValNormal  MathShiftVal = "normal"
+)
+
+func (v MathShiftVal) String() string { return string(v) }
+
+// Values returns every MathShiftVal constant.
+func (MathShiftVal) Values() []MathShiftVal {
+	return []MathShiftVal{
+		MathShiftValCompact,
+		MathShiftValNormal,
+	}
+}
+
+// Valid reports whether v is one of the MathShiftVal constants.
+func (v MathShiftVal) Valid() bool {
+	switch v {
+	case MathShiftValCompact,
+		MathShiftValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseMathShiftVal returns the MathShiftVal constant for a keyword, ignoring ASCII case.
+func ParseMathShiftVal(s string) (MathShiftVal, error) {
+	v := MathShiftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MathShiftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MathShiftVal constants.
+func (v MathShiftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MathShiftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMathShiftVal.
+func (v *MathShiftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMathShiftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// MaxLinesVal represents values for the max-lines property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <integer>
+type MaxLinesVal string
+
+// MaxLinesVal constants.
+const (
+	MaxLinesValNone MaxLinesVal = "none"
+)
+
+func (v MaxLinesVal) String() string { return string(v) }
+
+// Values returns every MaxLinesVal constant.
+func (MaxLinesVal) Values() []MaxLinesVal {
+	return []MaxLinesVal{
+		MaxLinesValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaxLinesVal constants.
+func (v MaxLinesVal) Valid() bool {
+	switch v {
+	case MaxLinesValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaxLinesVal returns the MaxLinesVal constant for a keyword, ignoring ASCII case.
+func ParseMaxLinesVal(s string) (MaxLinesVal, error) {
+	v := MaxLinesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaxLinesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaxLinesVal constants.
+func (v MaxLinesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaxLinesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaxLinesVal.
+func (v *MaxLinesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaxLinesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ObjectViewBoxVal represents values for the object-view-box property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <basic-shape-rect>
+type ObjectViewBoxVal string
+
+// ObjectViewBoxVal constants.
+const (
+	ObjectViewBoxValNone ObjectViewBoxVal = "none"
+)
+
+func (v ObjectViewBoxVal) String() string { return string(v) }
+
+// Values returns every ObjectViewBoxVal constant.
+func (ObjectViewBoxVal) Values() []ObjectViewBoxVal {
+	return []ObjectViewBoxVal{
+		ObjectViewBoxValNone,
+	}
+}
+
+// Valid reports whether v is one of the ObjectViewBoxVal constants.
+func (v ObjectViewBoxVal) Valid() bool {
+	switch v {
+	case ObjectViewBoxValNone:
+		return true
+	}
+	return false
+}
+
+// ParseObjectViewBoxVal returns the ObjectViewBoxVal constant for a keyword, ignoring ASCII case.
+func ParseObjectViewBoxVal(s string) (ObjectViewBoxVal, error) {
+	v := ObjectViewBoxVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ObjectViewBoxVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ObjectViewBoxVal constants.
+func (v ObjectViewBoxVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ObjectViewBoxVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseObjectViewBoxVal.
+func (v *ObjectViewBoxVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseObjectViewBoxVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// OverlayVal represents values for the overlay property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overlay
+type OverlayVal string
+
+// OverlayVal constants.
+const (
+	OverlayValAuto OverlayVal = "auto"
+	OverlayValNone OverlayVal = "none"
+)
+
+func (v OverlayVal) String() string { return string(v) }
+
+// Values returns every OverlayVal constant.
+func (OverlayVal) Values() []OverlayVal {
+	return []OverlayVal{
+		OverlayValAuto,
+		OverlayValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverlayVal constants.
+func (v OverlayVal) Valid() bool {
+	switch v {
+	case OverlayValAuto,
+		OverlayValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverlayVal returns the OverlayVal constant for a keyword, ignoring ASCII case.
+func ParseOverlayVal(s string) (OverlayVal, error) {
+	v := OverlayVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverlayVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverlayVal constants.
+func (v OverlayVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverlayVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverlayVal.
+func (v *OverlayVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverlayVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionAnchorVal represents values for the position-anchor property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	auto | <anchor-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-anchor
+type PositionAnchorVal string
+
+// PositionAnchorVal constants.
+const (
+	PositionAnchorValAuto PositionAnchorVal = "auto"
+)
+
+func (v PositionAnchorVal) String() string { return string(v) }
+
+// Values returns every PositionAnchorVal constant.
+func (PositionAnchorVal) Values() []PositionAnchorVal {
+	return []PositionAnchorVal{
+		PositionAnchorValAuto,
+	}
+}
+
+// Valid reports whether v is one of the PositionAnchorVal constants.
+func (v PositionAnchorVal) Valid() bool {
+	switch v {
+	case PositionAnchorValAuto:
+		return true
+	}
+	return false
+}
+
+// ParsePositionAnchorVal returns the PositionAnchorVal constant for a keyword, ignoring ASCII case.
+func ParsePositionAnchorVal(s string) (PositionAnchorVal, error) {
+	v := PositionAnchorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionAnchorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionAnchorVal constants.
+func (v PositionAnchorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionAnchorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionAnchorVal.
+func (v *PositionAnchorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionAnchorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionAreaVal represents values for the position-area property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <position-area>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-area
+type PositionAreaVal string
+
+// PositionAreaVal constants.
+const (
+	PositionAreaValBlockEnd            PositionAreaVal = "block-end"
+	PositionAreaValBlockStart          PositionAreaVal = "block-start"
+	PositionAreaValBottom              PositionAreaVal = "bottom"
+	PositionAreaValCenter              PositionAreaVal = "center"
+	PositionAreaValEnd                 PositionAreaVal = "end"
+	PositionAreaValInlineEnd           PositionAreaVal = "inline-end"
+	PositionAreaValInlineStart         PositionAreaVal = "inline-start"
+	PositionAreaValLeft                PositionAreaVal = "left"
+	PositionAreaValNone                PositionAreaVal = "none"
+	PositionAreaValRight               PositionAreaVal = "right"
+	PositionAreaValSelfBlockEnd        PositionAreaVal = "self-block-end"
+	PositionAreaValSelfBlockStart      PositionAreaVal = "self-block-start"
+	PositionAreaValSelfEnd             PositionAreaVal = "self-end"
+	PositionAreaValSelfInlineEnd       PositionAreaVal = "self-inline-end"
+	PositionAreaValSelfInlineStart     PositionAreaVal = "self-inline-start"
+	PositionAreaValSelfStart           PositionAreaVal = "self-start"
+	PositionAreaValSpanAll             PositionAreaVal = "span-all"
+	PositionAreaValSpanBlockEnd        PositionAreaVal = "span-block-end"
+	PositionAreaValSpanBlockStart      PositionAreaVal = "span-block-start"
+	PositionAreaValSpanBottom          PositionAreaVal = "span-bottom"
+	PositionAreaValSpanEnd             PositionAreaVal = "span-end"
+	PositionAreaValSpanInlineEnd       PositionAreaVal = "span-inline-end"
+	PositionAreaValSpanInlineStart     PositionAreaVal = "span-inline-start"
+	PositionAreaValSpanLeft            PositionAreaVal = "span-left"
+	PositionAreaValSpanRight           PositionAreaVal = "span-right"
+	PositionAreaValSpanSelfBlockEnd    PositionAreaVal = "span-self-block-end"
+	PositionAreaValSpanSelfBlockStart  PositionAreaVal = "span-self-block-start"
+	PositionAreaValSpanSelfEnd         PositionAreaVal = "span-self-end"
+	PositionAreaValSpanSelfInlineEnd   PositionAreaVal = "span-self-inline-end"
+	PositionAreaValSpanSelfInlineStart PositionAreaVal = "span-self-inline-start"
+	PositionAreaValSpanSelfStart       PositionAreaVal = "span-self-start"
+	PositionAreaValSpanStart           PositionAreaVal = "span-start"
+	PositionAreaValSpanTop             PositionAreaVal = "span-top"
+	PositionAreaValSpanXEnd            PositionAreaVal = "span-x-end"
+	PositionAreaValSpanXSelfEnd        PositionAreaVal = "span-x-self-end"
+	PositionAreaValSpanXSelfStart      PositionAreaVal = "span-x-self-start"
+	PositionAreaValSpanXStart          PositionAreaVal = "span-x-start"
+	PositionAreaValSpanYEnd            PositionAreaVal = "span-y-end"
+	PositionAreaValSpanYSelfEnd        PositionAreaVal = "span-y-self-end"
+	PositionAreaValSpanYSelfStart      PositionAreaVal = "span-y-self-start"
+	PositionAreaValSpanYStart          PositionAreaVal = "span-y-start"
+	PositionAreaValStart               PositionAreaVal = "start"
+	PositionAreaValTop                 PositionAreaVal = "top"
+	PositionAreaValXEnd                PositionAreaVal = "x-end"
+	PositionAreaValXSelfEnd            PositionAreaVal = "x-self-end"
+	PositionAreaValXSelfStart          PositionAreaVal = "x-self-start"
+	PositionAreaValXStart              PositionAreaVal = "x-start"
+	PositionAreaValYEnd                PositionAreaVal = "y-end"
+	PositionAreaValYSelfEnd            PositionAreaVal = "y-self-end"
+	PositionAreaValYSelfStart          PositionAreaVal = "y-self-start"
+	PositionAreaValYStart              PositionAreaVal = "y-start"
+)
+
+func (v PositionAreaVal) String() string { return string(v) }
+
+// Values returns every PositionAreaVal constant.
+func (PositionAreaVal) Values() []PositionAreaVal {
+	return []PositionAreaVal{
+		PositionAreaValBlockEnd,
+		PositionAreaValBlockStart,
+		PositionAreaValBottom,
+		PositionAreaValCenter,
+		PositionAreaValEnd,
+		PositionAreaValInlineEnd,
+		PositionAreaValInlineStart,
+		PositionAreaValLeft,
+		PositionAreaValNone,
+		PositionAreaValRight,
+		PositionAreaValSelfBlockEnd,
+		PositionAreaValSelfBlockStart,
+		PositionAreaValSelfEnd,
+		PositionAreaValSelfInlineEnd,
+		PositionAreaValSelfInlineStart,
+		PositionAreaValSelfStart,
+		PositionAreaValSpanAll,
+		PositionAreaValSpanBlockEnd,
+		PositionAreaValSpanBlockStart,
+		PositionAreaValSpanBottom,
+		PositionAreaValSpanEnd,
+		PositionAreaValSpanInlineEnd,
+		PositionAreaValSpanInlineStart,
+		PositionAreaValSpanLeft,
+		PositionAreaValSpanRight,
+		PositionAreaValSpanSelfBlockEnd,
+		PositionAreaValSpanSelfBlockStart,
+		PositionAreaValSpanSelfEnd,
+		PositionAreaValSpanSelfInlineEnd,
+		PositionAreaValSpanSelfInlineStart,
+		PositionAreaValSpanSelfStart,
+		PositionAreaValSpanStart,
+		PositionAreaValSpanTop,
+		PositionAreaValSpanXEnd,
+		PositionAreaValSpanXSelfEnd,
+		PositionAreaValSpanXSelfStart,
+		PositionAreaValSpanXStart,
+		PositionAreaValSpanYEnd,
+		PositionAreaValSpanYSelfEnd,
+		PositionAreaValSpanYSelfStart,
+		PositionAreaValSpanYStart,
+		PositionAreaValStart,
+		PositionAreaValTop,
+		PositionAreaValXEnd,
+		PositionAreaValXSelfEnd,
+		PositionAreaValXSelfStart,
+		PositionAreaValXStart,
+		PositionAreaValYEnd,
+		PositionAreaValYSelfEnd,
+		PositionAreaValYSelfStart,
+		PositionAreaValYStart,
+	}
+}
+
+// Valid reports whether v is one of the PositionAreaVal constants.
+func (v PositionAreaVal) Valid() bool {
+	switch v {
+	case PositionAreaValBlockEnd,
+		PositionAreaValBlockStart,
+		PositionAreaValBottom,
+		PositionAreaValCenter,
+		PositionAreaValEnd,
+		PositionAreaValInlineEnd,
+		PositionAreaValInlineStart,
+		PositionAreaValLeft,
+		PositionAreaValNone,
+		PositionAreaValRight,
+		PositionAreaValSelfBlockEnd,
+		PositionAreaValSelfBlockStart,
+		PositionAreaValSelfEnd,
+		PositionAreaValSelfInlineEnd,
+		PositionAreaValSelfInlineStart,
+		PositionAreaValSelfStart,
+		PositionAreaValSpanAll,
+		PositionAreaValSpanBlockEnd,
+		PositionAreaValSpanBlockStart,
+		PositionAreaValSpanBottom,
+		PositionAreaValSpanEnd,
+		PositionAreaValSpanInlineEnd,
+		PositionAreaValSpanInlineStart,
+		PositionAreaValSpanLeft,
+		PositionAreaValSpanRight,
+		PositionAreaValSpanSelfBlockEnd,
+		PositionAreaValSpanSelfBlockStart,
+		PositionAreaValSpanSelfEnd,
+		PositionAreaValSpanSelfInlineEnd,
+		PositionAreaValSpanSelfInlineStart,
+		PositionAreaValSpanSelfStart,
+		PositionAreaValSpanStart,
+		PositionAreaValSpanTop,
+		PositionAreaValSpanXEnd,
+		PositionAreaValSpanXSelfEnd,
+		PositionAreaValSpanXSelfStart,
+		PositionAreaValSpanXStart,
+		PositionAreaValSpanYEnd,
+		PositionAreaValSpanYSelfEnd,
+		PositionAreaValSpanYSelfStart,
+		PositionAreaValSpanYStart,
+		PositionAreaValStart,
+		PositionAreaValTop,
+		PositionAreaValXEnd,
+		PositionAreaValXSelfEnd,
+		PositionAreaValXSelfStart,
+		PositionAreaValXStart,
+		PositionAreaValYEnd,
+		PositionAreaValYSelfEnd,
+		PositionAreaValYSelfStart,
+		PositionAreaValYStart:
+		return true
+	}
+	return false
+}
+
+// ParsePositionAreaVal returns the PositionAreaVal constant for a keyword, ignoring ASCII case.
+func ParsePositionAreaVal(s string) (PositionAreaVal, error) {
+	v := PositionAreaVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionAreaVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionAreaVal constants.
+func (v PositionAreaVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionAreaVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionAreaVal.
+func (v *PositionAreaVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionAreaVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionTryVal represents values for the position-try property.
+//
+//   - Shorthand for: position-try-fallbacks, position-try-order
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<'position-try-order'>? <'position-try-fallbacks'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try
+type PositionTryVal string
+
+// PositionTryVal constants.
+const (
+	PositionTryValBlockEnd            PositionTryVal = "block-end"
+	PositionTryValBlockStart          PositionTryVal = "block-start"
+	PositionTryValBottom              PositionTryVal = "bottom"
+	PositionTryValCenter              PositionTryVal = "center"
+	PositionTryValEnd                 PositionTryVal = "end"
+	PositionTryValFlipBlock           PositionTryVal = "flip-block"
+	PositionTryValFlipInline          PositionTryVal = "flip-inline"
+	PositionTryValFlipStart           PositionTryVal = "flip-start"
+	PositionTryValInlineEnd           PositionTryVal = "inline-end"
+	PositionTryValInlineStart         PositionTryVal = "inline-start"
+	PositionTryValLeft                PositionTryVal = "left"
+	PositionTryValMostBlockSize       PositionTryVal = "most-block-size"
+	PositionTryValMostHeight          PositionTryVal = "most-height"
+	PositionTryValMostInlineSize      PositionTryVal = "most-inline-size"
+	PositionTryValMostWidth           PositionTryVal = "most-width"
+	PositionTryValNone                PositionTryVal = "none"
+	PositionTryValNormal              PositionTryVal = "normal"
+	PositionTryValRight               PositionTryVal = "right"
+	PositionTryValSelfBlockEnd        PositionTryVal = "self-block-end"
+	PositionTryValSelfBlockStart      PositionTryVal = "self-block-start"
+	PositionTryValSelfEnd             PositionTryVal = "self-end"
+	PositionTryValSelfInlineEnd       PositionTryVal = "self-inline-end"
+	PositionTryValSelfInlineStart     PositionTryVal = "self-inline-start"
+	PositionTryValSelfStart           PositionTryVal = "self-start"
+	PositionTryValSpanAll             PositionTryVal = "span-all"
+	PositionTryValSpanBlockEnd        PositionTryVal = "span-block-end"
+	PositionTryValSpanBlockStart      PositionTryVal = "span-block-start"
+	PositionTryValSpanBottom          PositionTryVal = "span-bottom"
+	PositionTryValSpanEnd             PositionTryVal = "span-end"
+	PositionTryValSpanInlineEnd       PositionTryVal = "span-inline-end"
+	PositionTryValSpanInlineStart     PositionTryVal = "span-inline-start"
+	PositionTryValSpanLeft            PositionTryVal = "span-left"
+	PositionTryValSpanRight           PositionTryVal = "span-right"
+	PositionTryValSpanSelfBlockEnd    PositionTryVal = "span-self-block-end"
+	PositionTryValSpanSelfBlockStart  PositionTryVal = "span-self-block-start"
+	PositionTryValSpanSelfEnd         PositionTryVal = "span-self-end"
+	PositionTryValSpanSelfInlineEnd   PositionTryVal = "span-self-inline-end"
+	PositionTryValSpanSelfInlineStart PositionTryVal = "span-self-inline-start"
+	PositionTryValSpanSelfStart       PositionTryVal = "span-self-start"
+	PositionTryValSpanStart           PositionTryVal = "span-start"
+	PositionTryValSpanTop             PositionTryVal = "span-top"
+	PositionTryValSpanXEnd            PositionTryVal = "span-x-end"
+	PositionTryValSpanXSelfEnd        PositionTryVal = "span-x-self-end"
+	PositionTryValSpanXSelfStart      PositionTryVal = "span-x-self-start"
+	PositionTryValSpanXStart          PositionTryVal = "span-x-start"
+	PositionTryValSpanYEnd            PositionTryVal = "span-y-end"
+	PositionTryValSpanYSelfEnd        PositionTryVal = "span-y-self-end"
+	PositionTryValSpanYSelfStart      PositionTryVal = "span-y-self-start"
+	PositionTryValSpanYStart          PositionTryVal = "span-y-start"
+	PositionTryValStart               PositionTryVal = "start"
+	PositionTryValTop                 PositionTryVal = "top"
+	PositionTryValXEnd                PositionTryVal = "x-end"
+	PositionTryValXSelfEnd            PositionTryVal = "x-self-end"
+	PositionTryValXSelfStart          PositionTryVal = "x-self-start"
+	PositionTryValXStart              PositionTryVal = "x-start"
+	PositionTryValYEnd                PositionTryVal = "y-end"
+	PositionTryValYSelfEnd            PositionTryVal = "y-self-end"
+	PositionTryValYSelfStart          PositionTryVal = "y-self-start"
+	PositionTryValYStart              PositionTryVal = "y-start"
+)
+
+func (v PositionTryVal) String() string { return string(v) }
+
+// Values returns every PositionTryVal constant.
+func (PositionTryVal) Values() []PositionTryVal {
+	return []PositionTryVal{
+		PositionTryValBlockEnd,
+		PositionTryValBlockStart,
+		PositionTryValBottom,
+		PositionTryValCenter,
+		PositionTryValEnd,
+		PositionTryValFlipBlock,
+		PositionTryValFlipInline,
+		PositionTryValFlipStart,
+		PositionTryValInlineEnd,
+		PositionTryValInlineStart,
+		PositionTryValLeft,
+		PositionTryValMostBlockSize,
+		PositionTryValMostHeight,
+		PositionTryValMostInlineSize,
+		PositionTryValMostWidth,
+		PositionTryValNone,
+		PositionTryValNormal,
+		PositionTryValRight,
+		PositionTryValSelfBlockEnd,
+		PositionTryValSelfBlockStart,
+		PositionTryValSelfEnd,
+		PositionTryValSelfInlineEnd,
+		PositionTryValSelfInlineStart,
+		PositionTryValSelfStart,
+		PositionTryValSpanAll,
+		PositionTryValSpanBlockEnd,
+		PositionTryValSpanBlockStart,
+		PositionTryValSpanBottom,
+		PositionTryValSpanEnd,
+		PositionTryValSpanInlineEnd,
+		PositionTryValSpanInlineStart,
+		PositionTryValSpanLeft,
+		PositionTryValSpanRight,
+		PositionTryValSpanSelfBlockEnd,
+		PositionTryValSpanSelfBlockStart,
+		PositionTryValSpanSelfEnd,
+		PositionTryValSpanSelfInlineEnd,
+		PositionTryValSpanSelfInlineStart,
+		PositionTryValSpanSelfStart,
+		PositionTryValSpanStart,
+		PositionTryValSpanTop,
+		PositionTryValSpanXEnd,
+		PositionTryValSpanXSelfEnd,
+		PositionTryValSpanXSelfStart,
+		PositionTryValSpanXStart,
+		PositionTryValSpanYEnd,
+		PositionTryValSpanYSelfEnd,
+		PositionTryValSpanYSelfStart,
+		PositionTryValSpanYStart,
+		PositionTryValStart,
+		PositionTryValTop,
+		PositionTryValXEnd,
+		PositionTryValXSelfEnd,
+		PositionTryValXSelfStart,
+		PositionTryValXStart,
+		PositionTryValYEnd,
+		PositionTryValYSelfEnd,
+		PositionTryValYSelfStart,
+		PositionTryValYStart,
+	}
+}
+
+// Valid reports whether v is one of the PositionTryVal constants.
+func (v PositionTryVal) Valid() bool {
+	switch v {
+	case PositionTryValBlockEnd,
+		PositionTryValBlockStart,
+		PositionTryValBottom,
+		PositionTryValCenter,
+		PositionTryValEnd,
+		PositionTryValFlipBlock,
+		PositionTryValFlipInline,
+		PositionTryValFlipStart,
+		PositionTryValInlineEnd,
+		PositionTryValInlineStart,
+		PositionTryValLeft,
+		PositionTryValMostBlockSize,
+		PositionTryValMostHeight,
+		PositionTryValMostInlineSize,
+		PositionTryValMostWidth,
+		PositionTryValNone,
+		PositionTryValNormal,
+		PositionTryValRight,
+		PositionTryValSelfBlockEnd,
+		PositionTryValSelfBlockStart,
+		PositionTryValSelfEnd,
+		PositionTryValSelfInlineEnd,
+		PositionTryValSelfInlineStart,
+		PositionTryValSelfStart,
+		PositionTryValSpanAll,
+		PositionTryValSpanBlockEnd,
+		PositionTryValSpanBlockStart,
+		PositionTryValSpanBottom,
+		PositionTryValSpanEnd,
+		PositionTryValSpanInlineEnd,
+		PositionTryValSpanInlineStart,
+		PositionTryValSpanLeft,
+		PositionTryValSpanRight,
+		PositionTryValSpanSelfBlockEnd,
+		PositionTryValSpanSelfBlockStart,
+		PositionTryValSpanSelfEnd,
+		PositionTryValSpanSelfInlineEnd,
+		PositionTryValSpanSelfInlineStart,
+		PositionTryValSpanSelfStart,
+		PositionTryValSpanStart,
+		PositionTryValSpanTop,
+		PositionTryValSpanXEnd,
+		PositionTryValSpanXSelfEnd,
+		PositionTryValSpanXSelfStart,
+		PositionTryValSpanXStart,
+		PositionTryValSpanYEnd,
+		PositionTryValSpanYSelfEnd,
+		PositionTryValSpanYSelfStart,
+		PositionTryValSpanYStart,
+		PositionTryValStart,
+		PositionTryValTop,
+		PositionTryValXEnd,
+		PositionTryValXSelfEnd,
+		PositionTryValXSelfStart,
+		PositionTryValXStart,
+		PositionTryValYEnd,
+		PositionTryValYSelfEnd,
+		PositionTryValYSelfStart,
+		PositionTryValYStart:
+		return true
+	}
+	return false
+}
+
+// ParsePositionTryVal returns the PositionTryVal constant for a keyword, ignoring ASCII case.
+func ParsePositionTryVal(s string) (PositionTryVal, error) {
+	v := PositionTryVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionTryVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionTryVal constants.
+func (v PositionTryVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionTryVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionTryVal.
+func (v *PositionTryVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionTryVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionTryFallbacksVal represents values for the position-try-fallbacks property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | [ [<dashed-ident> || <try-tactic>] | <'position-area'> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-fallbacks
+type PositionTryFallbacksVal string
+
+// PositionTryFallbacksVal constants.
+const (
+	PositionTryFallbacksValBlockEnd            PositionTryFallbacksVal = "block-end"
+	PositionTryFallbacksValBlockStart          PositionTryFallbacksVal = "block-start"
+	PositionTryFallbacksValBottom              PositionTryFallbacksVal = "bottom"
+	PositionTryFallbacksValCenter              PositionTryFallbacksVal = "center"
+	PositionTryFallbacksValEnd                 PositionTryFallbacksVal = "end"
+	PositionTryFallbacksValFlipBlock           PositionTryFallbacksVal = "flip-block"
+	PositionTryFallbacksValFlipInline          PositionTryFallbacksVal = "flip-inline"
+	PositionTryFallbacksValFlipStart           PositionTryFallbacksVal = "flip-start"
+	PositionTryFallbacksValInlineEnd           PositionTryFallbacksVal = "inline-end"
+	PositionTryFallbacksValInlineStart         PositionTryFallbacksVal = "inline-start"
+	PositionTryFallbacksValLeft                PositionTryFallbacksVal = "left"
+	PositionTryFallbacksValNone                PositionTryFallbacksVal = "none"
+	PositionTryFallbacksValRight               PositionTryFallbacksVal = "right"
+	PositionTryFallbacksValSelfBlockEnd        PositionTryFallbacksVal = "self-block-end"
+	PositionTryFallbacksValSelfBlockStart      PositionTryFallbacksVal = "self-block-start"
+	PositionTryFallbacksValSelfEnd             PositionTryFallbacksVal = "self-end"
+	PositionTryFallbacksValSelfInlineEnd       PositionTryFallbacksVal = "self-inline-end"
+	PositionTryFallbacksValSelfInlineStart     PositionTryFallbacksVal = "self-inline-start"
+	PositionTryFallbacksValSelfStart           PositionTryFallbacksVal = "self-start"
+	PositionTryFallbacksValSpanAll             PositionTryFallbacksVal = "span-all"
+	PositionTryFallbacksValSpanBlockEnd        PositionTryFallbacksVal = "span-block-end"
+	PositionTryFallbacksValSpanBlockStart      PositionTryFallbacksVal = "span-block-start"
+	PositionTryFallbacksValSpanBottom          PositionTryFallbacksVal = "span-bottom"
+	PositionTryFallbacksValSpanEnd             PositionTryFallbacksVal = "span-end"
+	PositionTryFallbacksValSpanInlineEnd       PositionTryFallbacksVal = "span-inline-end"
+	PositionTryFallbacksValSpanInlineStart     PositionTryFallbacksVal = "span-inline-start"
+	PositionTryFallbacksValSpanLeft            PositionTryFallbacksVal = "span-left"
+	PositionTryFallbacksValSpanRight           PositionTryFallbacksVal = "span-right"
+	PositionTryFallbacksValSpanSelfBlockEnd    PositionTryFallbacksVal = "span-self-block-end"
+	PositionTryFallbacksValSpanSelfBlockStart  PositionTryFallbacksVal = "span-self-block-start"
+	PositionTryFallbacksValSpanSelfEnd         PositionTryFallbacksVal = "span-self-end"
+	PositionTryFallbacksValSpanSelfInlineEnd   PositionTryFallbacksVal = "span-self-inline-end"
+	PositionTryFallbacksValSpanSelfInlineStart PositionTryFallbacksVal = "span-self-inline-start"
+	PositionTryFallbacksValSpanSelfStart       PositionTryFallbacksVal = "span-self-start"
+	PositionTryFallbacksValSpanStart           PositionTryFallbacksVal = "span-start"
+	PositionTryFallbacksValSpanTop             PositionTryFallbacksVal = "span-top"
+	PositionTryFallbacksValSpanXEnd            PositionTryFallbacksVal = "span-x-end"
+	PositionTryFallbacksValSpanXSelfEnd        PositionTryFallbacksVal = "span-x-self-end"
+	PositionTryFallbacksValSpanXSelfStart      PositionTryFallbacksVal = "span-x-self-start"
+	PositionTryFallbacksValSpanXStart          PositionTryFallbacksVal = "span-x-start"
+	PositionTryFallbacksValSpanYEnd            PositionTryFallbacksVal = "span-y-end"
+	PositionTryFallbacksValSpanYSelfEnd        PositionTryFallbacksVal = "span-y-self-end"
+	PositionTryFallbacksValSpanYSelfStart      PositionTryFallbacksVal = "span-y-self-start"
+	PositionTryFallbacksValSpanYStart          PositionTryFallbacksVal = "span-y-start"
+	PositionTryFallbacksValStart               PositionTryFallbacksVal = "start"
+	PositionTryFallbacksValTop                 PositionTryFallbacksVal = "top"
+	PositionTryFallbacksValXEnd                PositionTryFallbacksVal = "x-end"
+	PositionTryFallbacksValXSelfEnd            PositionTryFallbacksVal = "x-self-end"
+	PositionTryFallbacksValXSelfStart          PositionTryFallbacksVal = "x-self-start"
+	PositionTryFallbacksValXStart              PositionTryFallbacksVal = "x-start"
+	PositionTryFallbacksValYEnd                PositionTryFallbacksVal = "y-end"
+	PositionTryFallbacksValYSelfEnd            PositionTryFallbacksVal = "y-self-end"
+	PositionTryFallbacksValYSelfStart          PositionTryFallbacksVal = "y-self-start"
+	PositionTryFallbacksValYStart              PositionTryFallbacksVal = "y-start"
+)
+
+func (v PositionTryFallbacksVal) String() string { return string(v) }
+
+// Values returns every PositionTryFallbacksVal constant.
+func (PositionTryFallbacksVal) Values() []PositionTryFallbacksVal {
+	return []PositionTryFallbacksVal{
+		PositionTryFallbacksValBlockEnd,
+		PositionTryFallbacksValBlockStart,
+		PositionTryFallbacksValBottom,
+		PositionTryFallbacksValCenter,
+		PositionTryFallbacksValEnd,
+		PositionTryFallbacksValFlipBlock,
+		PositionTryFallbacksValFlipInline,
+		PositionTryFallbacksValFlipStart,
+		PositionTryFallbacksValInlineEnd,
+		PositionTryFallbacksValInlineStart,
+		PositionTryFallbacksValLeft,
+		PositionTryFallbacksValNone,
+		PositionTryFallbacksValRight,
+		PositionTryFallbacksValSelfBlockEnd,
+		PositionTryFallbacksValSelfBlockStart,
+		PositionTryFallbacksValSelfEnd,
+		PositionTryFallbacksValSelfInlineEnd,
+		PositionTryFallbacksValSelfInlineStart,
+		PositionTryFallbacksValSelfStart,
+		PositionTryFallbacksValSpanAll,
+		PositionTryFallbacksValSpanBlockEnd,
+		PositionTryFallbacksValSpanBlockStart,
+		PositionTryFallbacksValSpanBottom,
+		PositionTryFallbacksValSpanEnd,
+		PositionTryFallbacksValSpanInlineEnd,
+		PositionTryFallbacksValSpanInlineStart,
+		PositionTryFallbacksValSpanLeft,
+		PositionTryFallbacksValSpanRight,
+		PositionTryFallbacksValSpanSelfBlockEnd,
+		PositionTryFallbacksValSpanSelfBlockStart,
+		PositionTryFallbacksValSpanSelfEnd,
+		PositionTryFallbacksValSpanSelfInlineEnd,
+		PositionTryFallbacksValSpanSelfInlineStart,
+		PositionTryFallbacksValSpanSelfStart,
+		PositionTryFallbacksValSpanStart,
+		PositionTryFallbacksValSpanTop,
+		PositionTryFallbacksValSpanXEnd,
+		PositionTryFallbacksValSpanXSelfEnd,
+		PositionTryFallbacksValSpanXSelfStart,
+		PositionTryFallbacksValSpanXStart,
+		PositionTryFallbacksValSpanYEnd,
+		PositionTryFallbacksValSpanYSelfEnd,
+		PositionTryFallbacksValSpanYSelfStart,
+		PositionTryFallbacksValSpanYStart,
+		PositionTryFallbacksValStart,
+		PositionTryFallbacksValTop,
+		PositionTryFallbacksValXEnd,
+		PositionTryFallbacksValXSelfEnd,
+		PositionTryFallbacksValXSelfStart,
+		PositionTryFallbacksValXStart,
+		PositionTryFallbacksValYEnd,
+		PositionTryFallbacksValYSelfEnd,
+		PositionTryFallbacksValYSelfStart,
+		PositionTryFallbacksValYStart,
+	}
+}
+
+// Valid reports whether v is one of the PositionTryFallbacksVal constants.
+func (v PositionTryFallbacksVal) Valid() bool {
+	switch v {
+	case PositionTryFallbacksValBlockEnd,
+		PositionTryFallbacksValBlockStart,
+		PositionTryFallbacksValBottom,
+		PositionTryFallbacksValCenter,
+		PositionTryFallbacksValEnd,
+		PositionTryFallbacksValFlipBlock,
+		PositionTryFallbacksValFlipInline,
+		PositionTryFallbacksValFlipStart,
+		PositionTryFallbacksValInlineEnd,
+		PositionTryFallbacksValInlineStart,
+		PositionTryFallbacksValLeft,
+		PositionTryFallbacksValNone,
+		PositionTryFallbacksValRight,
+		PositionTryFallbacksValSelfBlockEnd,
+		PositionTryFallbacksValSelfBlockStart,
+		PositionTryFallbacksValSelfEnd,
+		PositionTryFallbacksValSelfInlineEnd,
+		PositionTryFallbacksValSelfInlineStart,
+		PositionTryFallbacksValSelfStart,
+		PositionTryFallbacksValSpanAll,
+		PositionTryFallbacksValSpanBlockEnd,
+		PositionTryFallbacksValSpanBlockStart,
+		PositionTryFallbacksValSpanBottom,
+		PositionTryFallbacksValSpanEnd,
+		PositionTryFallbacksValSpanInlineEnd,
+		PositionTryFallbacksValSpanInlineStart,
+		PositionTryFallbacksValSpanLeft,
+		PositionTryFallbacksValSpanRight,
+		PositionTryFallbacksValSpanSelfBlockEnd,
+		PositionTryFallbacksValSpanSelfBlockStart,
+		PositionTryFallbacksValSpanSelfEnd,
+		PositionTryFallbacksValSpanSelfInlineEnd,
+		PositionTryFallbacksValSpanSelfInlineStart,
+		PositionTryFallbacksValSpanSelfStart,
+		PositionTryFallbacksValSpanStart,
+		PositionTryFallbacksValSpanTop,
+		PositionTryFallbacksValSpanXEnd,
+		PositionTryFallbacksValSpanXSelfEnd,
+		PositionTryFallbacksValSpanXSelfStart,
+		PositionTryFallbacksValSpanXStart,
+		PositionTryFallbacksValSpanYEnd,
+		PositionTryFallbacksValSpanYSelfEnd,
+		PositionTryFallbacksValSpanYSelfStart,
+		PositionTryFallbacksValSpanYStart,
+		PositionTryFallbacksValStart,
+		PositionTryFallbacksValTop,
+		PositionTryFallbacksValXEnd,
+		PositionTryFallbacksValXSelfEnd,
+		PositionTryFallbacksValXSelfStart,
+		PositionTryFallbacksValXStart,
+		PositionTryFallbacksValYEnd,
+		PositionTryFallbacksValYSelfEnd,
+		PositionTryFallbacksValYSelfStart,
+		PositionTryFallbacksValYStart:
+		return true
+	}
+	return false
+}
+
+// ParsePositionTryFallbacksVal returns the PositionTryFallbacksVal constant for a keyword, ignoring ASCII case.
+func ParsePositionTryFallbacksVal(s string) (PositionTryFallbacksVal, error) {
+	v := PositionTryFallbacksVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionTryFallbacksVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionTryFallbacksVal constants.
+func (v PositionTryFallbacksVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionTryFallbacksVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionTryFallbacksVal.
+func (v *PositionTryFallbacksVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionTryFallbacksVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionTryOrderVal represents values for the position-try-order property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | <try-size>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-order
+type PositionTryOrderVal string
+
+// PositionTryOrderVal constants.
+const (
+	PositionTryOrderValMostBlockSize  PositionTryOrderVal = "most-block-size"
+	PositionTryOrderValMostHeight     PositionTryOrderVal = "most-height"
+	PositionTryOrderValMostInlineSize PositionTryOrderVal = "most-inline-size"
+	PositionTryOrderValMostWidth      PositionTryOrderVal = "most-width"
+	PositionTryOrderValNormal         PositionTryOrderVal = "normal"
+)
+
+func (v PositionTryOrderVal) String() string { return string(v) }
+
+// Values returns every PositionTryOrderVal constant.
+func (PositionTryOrderVal) Values() []PositionTryOrderVal {
+	return []PositionTryOrderVal{
+		PositionTryOrderValMostBlockSize,
+		PositionTryOrderValMostHeight,
+		PositionTryOrderValMostInlineSize,
+		PositionTryOrderValMostWidth,
+		PositionTryOrderValNormal,
+	}
+}
+
+// Valid reports whether v is one of the PositionTryOrderVal constants.
+func (v PositionTryOrderVal) Valid() bool {
+	switch v {
+	case PositionTryOrderValMostBlockSize,
+		PositionTryOrderValMostHeight,
+		PositionTryOrderValMostInlineSize,
+		PositionTryOrderValMostWidth,
+		PositionTryOrderValNormal:
+		return true
+	}
+	return false
+}
+
+// ParsePositionTryOrderVal returns the PositionTryOrderVal constant for a keyword, ignoring ASCII case.
+func ParsePositionTryOrderVal(s string) (PositionTryOrderVal, error) {
+	v := PositionTryOrderVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionTryOrderVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionTryOrderVal constants.
+func (v PositionTryOrderVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionTryOrderVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionTryOrderVal.
+func (v *PositionTryOrderVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionTryOrderVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// PositionVisibilityVal represents values for the position-visibility property.
+//
+//   - Initial: anchors-visible
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	always | [ anchors-valid || anchors-visible || no-overflow ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-visibility
+type PositionVisibilityVal string
+
+// PositionVisibilityVal constants.
+const (
+	PositionVisibilityValAlways         PositionVisibilityVal = "always"
+	PositionVisibilityValAnchorsValid   PositionVisibilityVal = "anchors-valid"
+	PositionVisibilityValAnchorsVisible PositionVisibilityVal = "anchors-visible"
+	PositionVisibilityValNoOverflow     PositionVisibilityVal = "no-overflow"
+)
+
+func (v PositionVisibilityVal) String() string { return string(v) }
+
+// Values returns every PositionVisibilityVal constant.
+func (PositionVisibilityVal) Values() []PositionVisibilityVal {
+	return []PositionVisibilityVal{
+		PositionVisibilityValAlways,
+		PositionVisibilityValAnchorsValid,
+		PositionVisibilityValAnchorsVisible,
+		PositionVisibilityValNoOverflow,
+	}
+}
+
+// Valid reports whether v is one of the PositionVisibilityVal constants.
+func (v PositionVisibilityVal) Valid() bool {
+	switch v {
+	case PositionVisibilityValAlways,
+		PositionVisibilityValAnchorsValid,
+		PositionVisibilityValAnchorsVisible,
+		PositionVisibilityValNoOverflow:
+		return true
+	}
+	return false
+}
+
+// ParsePositionVisibilityVal returns the PositionVisibilityVal constant for a keyword, ignoring ASCII case.
+func ParsePositionVisibilityVal(s string) (PositionVisibilityVal, error) {
+	v := PositionVisibilityVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionVisibilityVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionVisibilityVal constants.
+func (v PositionVisibilityVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionVisibilityVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionVisibilityVal.
+func (v *PositionVisibilityVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionVisibilityVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// RubyMergeVal represents values for the ruby-merge property.
+//
+//   - Initial: separate
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	separate | collapse | auto
+type RubyMergeVal string
+
+// RubyMergeVal constants.
+const (
+	RubyMergeValAuto     RubyMergeVal = "auto"
+	RubyMergeValCollapse RubyMergeVal = "collapse"
+	RubyMergeValSeparate RubyMergeVal = "separate"
+)
+
+func (v RubyMergeVal) String() string { return string(v) }
+
+// Values returns every RubyMergeVal constant.
+func (RubyMergeVal) Values() []RubyMergeVal {
+	return []RubyMergeVal{
+		RubyMergeValAuto,
+		RubyMergeValCollapse,
+		RubyMergeValSeparate,
+	}
+}
+
+// Valid reports whether v is one of the RubyMergeVal constants.
+func (v RubyMergeVal) Valid() bool {
+	switch v {
+	case RubyMergeValAuto,
+		RubyMergeValCollapse,
+		RubyMergeValSeparate:
+		return true
+	}
+	return false
+}
+
+// ParseRubyMergeVal returns the RubyMergeVal constant for a keyword, ignoring ASCII case.
+func ParseRubyMergeVal(s string) (RubyMergeVal, error) {
+	v := RubyMergeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RubyMergeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RubyMergeVal constants.
+func (v RubyMergeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RubyMergeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRubyMergeVal.
+func (v *RubyMergeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRubyMergeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ScrollInitialTargetVal represents values for the scroll-initial-target property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | nearest
+type ScrollInitialTargetVal string
+
+// ScrollInitialTargetVal constants.
+const (
+	ScrollInitialTargetValNearest ScrollInitialTargetVal = "nearest"
+	ScrollInitialTargetValNone    ScrollInitialTargetVal = "none"
+)
+
+func (v ScrollInitialTargetVal) String() string { return string(v) }
+
+// Values returns every ScrollInitialTargetVal constant.
+func (ScrollInitialTargetVal) Values() []ScrollInitialTargetVal {
+	return []ScrollInitialTargetVal{
+		ScrollInitialTargetValNearest,
+		ScrollInitialTargetValNone,
+	}
+}
+
+// Valid reports whether v is one of the ScrollInitialTargetVal constants.
+func (v ScrollInitialTargetVal) Valid() bool {
+	switch v {
+	case ScrollInitialTargetValNearest,
+		ScrollInitialTargetValNone:
+		return true
+	}
+	return false
+}
+
+// ParseScrollInitialTargetVal returns the ScrollInitialTargetVal constant for a keyword, ignoring ASCII case.
+func ParseScrollInitialTargetVal(s string) (ScrollInitialTargetVal, error) {
+	v := ScrollInitialTargetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollInitialTargetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollInitialTargetVal constants.
+func (v ScrollInitialTargetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollInitialTargetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollInitialTargetVal.
+func (v *ScrollInitialTargetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollInitialTargetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ScrollTimelineVal represents values for the scroll-timeline property.
+//
+//   - Shorthand for: scroll-timeline-axis, scroll-timeline-name
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline
+type ScrollTimelineVal string
+
+// ScrollTimelineVal constants.
+const (
+	ScrollTimelineValBlock  ScrollTimelineVal = "block"
+	ScrollTimelineValInline ScrollTimelineVal = "inline"
+	ScrollTimelineValNone   ScrollTimelineVal = "none"
+	ScrollTimelineValX      ScrollTimelineVal = "x"
+	ScrollTimelineValY      ScrollTimelineVal = "y"
+)
+
+func (v ScrollTimelineVal) String() string { return string(v) }
+
+// Values returns every ScrollTimelineVal constant.
+func (ScrollTimelineVal) Values() []ScrollTimelineVal {
+	return []ScrollTimelineVal{
+		ScrollTimelineValBlock,
+		ScrollTimelineValInline,
+		ScrollTimelineValNone,
+		ScrollTimelineValX,
+		ScrollTimelineValY,
+	}
+}
+
+// Valid reports whether v is one of the ScrollTimelineVal constants.
+func (v ScrollTimelineVal) Valid() bool {
+	switch v {
+	case ScrollTimelineValBlock,
+		ScrollTimelineValInline,
+		ScrollTimelineValNone,
+		ScrollTimelineValX,
+		ScrollTimelineValY:
+		return true
+	}
+	return false
+}
+
+// ParseScrollTimelineVal returns the ScrollTimelineVal constant for a keyword, ignoring ASCII case.
+func ParseScrollTimelineVal(s string) (ScrollTimelineVal, error) {
+	v := ScrollTimelineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollTimelineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollTimelineVal constants.
+func (v ScrollTimelineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollTimelineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollTimelineVal.
+func (v *ScrollTimelineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollTimelineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ScrollTimelineAxisVal represents values for the scroll-timeline-axis property.
+//
+//   - Initial: block
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ block | inline | x | y ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-axis
+type ScrollTimelineAxisVal string
+
+// ScrollTimelineAxisVal constants.
+const (
+	ScrollTimelineAxisValBlock  ScrollTimelineAxisVal = "block"
+	ScrollTimelineAxisValInline ScrollTimelineAxisVal = "inline"
+	ScrollTimelineAxisValX      ScrollTimelineAxisVal = "x"
+	ScrollTimelineAxisValY      ScrollTimelineAxisVal = "y"
+)
+
+func (v ScrollTimelineAxisVal) String() string { return string(v) }
+
+// Values returns every ScrollTimelineAxisVal constant.
+func (ScrollTimelineAxisVal) Values() []ScrollTimelineAxisVal {
+	return []ScrollTimelineAxisVal{
+		ScrollTimelineAxisValBlock,
+		ScrollTimelineAxisValInline,
+		ScrollTimelineAxisValX,
+		ScrollTimelineAxisValY,
+	}
+}
+
+// Valid reports whether v is one of the ScrollTimelineAxisVal constants.
+func (v ScrollTimelineAxisVal) Valid() bool {
+	switch v {
+	case ScrollTimelineAxisValBlock,
+		ScrollTimelineAxisValInline,
+		ScrollTimelineAxisValX,
+		ScrollTimelineAxisValY:
+		return true
+	}
+	return false
+}
+
+// ParseScrollTimelineAxisVal returns the ScrollTimelineAxisVal constant for a keyword, ignoring ASCII case.
+func ParseScrollTimelineAxisVal(s string) (ScrollTimelineAxisVal, error) {
+	v := ScrollTimelineAxisVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollTimelineAxisVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollTimelineAxisVal constants.
+func (v ScrollTimelineAxisVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollTimelineAxisVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollTimelineAxisVal.
+func (v *ScrollTimelineAxisVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollTimelineAxisVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ScrollTimelineNameVal represents values for the scroll-timeline-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ none | <dashed-ident> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-name
+type ScrollTimelineNameVal string
+
+// ScrollTimelineNameVal constants.
+const (
+	ScrollTimelineNameValNone ScrollTimelineNameVal = "none"
+)
+
+func (v ScrollTimelineNameVal) String() string { return string(v) }
+
+// Values returns every ScrollTimelineNameVal constant.
+func (ScrollTimelineNameVal) Values() []ScrollTimelineNameVal {
+	return []ScrollTimelineNameVal{
+		ScrollTimelineNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the ScrollTimelineNameVal constants.
+func (v ScrollTimelineNameVal) Valid() bool {
+	switch v {
+	case ScrollTimelineNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseScrollTimelineNameVal returns the ScrollTimelineNameVal constant for a keyword, ignoring ASCII case.
+func ParseScrollTimelineNameVal(s string) (ScrollTimelineNameVal, error) {
+	v := ScrollTimelineNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollTimelineNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollTimelineNameVal constants.
+func (v ScrollTimelineNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollTimelineNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollTimelineNameVal.
+func (v *ScrollTimelineNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollTimelineNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// SpeakAsVal represents values for the speak-as property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | spell-out || digits || [ literal-punctuation | no-punctuation ]
+type SpeakAsVal string
+
+// SpeakAsVal constants.
+const (
+	SpeakAsValDigits             SpeakAsVal = "digits"
+	SpeakAsValLiteralPunctuation SpeakAsVal = "literal-punctuation"
+	SpeakAsValNoPunctuation      SpeakAsVal = "no-punctuation"
+	SpeakAsValNormal             SpeakAsVal = "normal"
+	SpeakAsValSpellOut           SpeakAsVal = "spell-out"
+)
+
+func (v SpeakAsVal) String() string { return string(v) }
+
+// Values returns every SpeakAsVal constant.
+func (SpeakAsVal) Values() []SpeakAsVal {
+	return []SpeakAsVal{
+		SpeakAsValDigits,
+		SpeakAsValLiteralPunctuation,
+		SpeakAsValNoPunctuation,
+		SpeakAsValNormal,
+		SpeakAsValSpellOut,
+	}
+}
+
+// Valid reports whether v is one of the SpeakAsVal constants.
+func (v SpeakAsVal) Valid() bool {
+	switch v {
+	case SpeakAsValDigits,
+		SpeakAsValLiteralPunctuation,
+		SpeakAsValNoPunctuation,
+		SpeakAsValNormal,
+		SpeakAsValSpellOut:
+		return true
+	}
+	return false
+}
+
+// ParseSpeakAsVal returns the SpeakAsVal constant for a keyword, ignoring ASCII case.
+func ParseSpeakAsVal(s string) (SpeakAsVal, error) {
+	v := SpeakAsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "SpeakAsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not SpeakAsVal constants.
+func (v SpeakAsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "SpeakAsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseSpeakAsVal.
+func (v *SpeakAsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseSpeakAsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// TextDecorationSkipVal represents values for the text-decoration-skip property.
+//
+//   - Initial: objects
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | [ objects || [ spaces | [ leading-spaces || trailing-spaces ] ] || edges || box-decoration ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip
+type TextDecorationSkipVal string
+
+// TextDecorationSkipVal constants.
+const (
+	TextDecorationSkipValBoxDecoration  TextDecorationSkipVal = "box-decoration"
+	TextDecorationSkipValEdges          TextDecorationSkipVal = "edges"
+	TextDecorationSkipValLeadingSpaces  TextDecorationSkipVal = "leading-spaces"
+	TextDecorationSkipValNone           TextDecorationSkipVal = "none"
+	TextDecorationSkipValObjects        TextDecorationSkipVal = "objects"
+	TextDecorationSkipValSpaces         TextDecorationSkipVal = "spaces"
+	TextDecorationSkipValTrailingSpaces TextDecorationSkipVal = "trailing-spaces"
+)
+
+func (v TextDecorationSkipVal) String() string { return string(v) }
+
+// Values returns every TextDecorationSkipVal constant.
+func (TextDecorationSkipVal) Values() []TextDecorationSkipVal {
+	return []TextDecorationSkipVal{
+		TextDecorationSkipValBoxDecoration,
+		TextDecorationSkipValEdges,
+		TextDecorationSkipValLeadingSpaces,
+		TextDecorationSkipValNone,
+		TextDecorationSkipValObjects,
+		TextDecorationSkipValSpaces,
+		TextDecorationSkipValTrailingSpaces,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationSkipVal constants.
+func (v TextDecorationSkipVal) Valid() bool {
+	switch v {
+	case TextDecorationSkipValBoxDecoration,
+		TextDecorationSkipValEdges,
+		TextDecorationSkipValLeadingSpaces,
+		TextDecorationSkipValNone,
+		TextDecorationSkipValObjects,
+		TextDecorationSkipValSpaces,
+		TextDecorationSkipValTrailingSpaces:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationSkipVal returns the TextDecorationSkipVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationSkipVal(s string) (TextDecorationSkipVal, error) {
+	v := TextDecorationSkipVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationSkipVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationSkipVal constants.
+func (v TextDecorationSkipVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationSkipVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationSkipVal.
+func (v *TextDecorationSkipVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationSkipVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// TextSizeAdjustVal represents values for the text-size-adjust property.
+//
+//   - Initial: autoForSmartphoneBrowsersSupportingInflation
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | auto | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-size-adjust
+type TextSizeAdjustVal string
+
+// TextSizeAdjustVal constants.
+const (
+	TextSizeAdjustValAuto TextSizeAdjustVal = "auto"
+	TextSizeAdjustValNone TextSizeAdjustVal = "none"
+)
+
+func (v TextSizeAdjustVal) String() string { return string(v) }
+
+// Values returns every TextSizeAdjustVal constant.
+func (TextSizeAdjustVal) Values() []TextSizeAdjustVal {
+	return []TextSizeAdjustVal{
+		TextSizeAdjustValAuto,
+		TextSizeAdjustValNone,
+	}
+}
+
+// Valid reports whether v is one of the TextSizeAdjustVal constants.
+func (v TextSizeAdjustVal) Valid() bool {
+	switch v {
+	case TextSizeAdjustValAuto,
+		TextSizeAdjustValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTextSizeAdjustVal returns the TextSizeAdjustVal constant for a keyword, ignoring ASCII case.
+func ParseTextSizeAdjustVal(s string) (TextSizeAdjustVal, error) {
+	v := TextSizeAdjustVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextSizeAdjustVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextSizeAdjustVal constants.
+func (v TextSizeAdjustVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextSizeAdjustVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextSizeAdjustVal.
+func (v *TextSizeAdjustVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextSizeAdjustVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// TextSpacingTrimVal represents values for the text-spacing-trim property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	space-all | normal | space-first | trim-start
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-spacing-trim
+type TextSpacingTrimVal string
+
+// TextSpacingTrimVal constants.
+const (
+	TextSpacingTrimValNormal     TextSpacingTrimVal = "normal"
+	TextSpacingTrimValSpaceAll   TextSpacingTrimVal = "space-all"
+	TextSpacingTrimValSpaceFirst TextSpacingTrimVal = "space-first"
+	TextSpacingTrimValTrimStart  TextSpacingTrimVal = "trim-start"
+)
+
+func (v TextSpacingTrimVal) String() string { return string(v) }
+
+// Values returns every TextSpacingTrimVal constant.
+func (TextSpacingTrimVal) Values() []TextSpacingTrimVal {
+	return []TextSpacingTrimVal{
+		TextSpacingTrimValNormal,
+		TextSpacingTrimValSpaceAll,
+		TextSpacingTrimValSpaceFirst,
+		TextSpacingTrimValTrimStart,
+	}
+}
+
+// Valid reports whether v is one of the TextSpacingTrimVal constants.
+func (v TextSpacingTrimVal) Valid() bool {
+	switch v {
+	case TextSpacingTrimValNormal,
+		TextSpacingTrimValSpaceAll,
+		TextSpacingTrimValSpaceFirst,
+		TextSpacingTrimValTrimStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextSpacingTrimVal returns the TextSpacingTrimVal constant for a keyword, ignoring ASCII case.
+func ParseTextSpacingTrimVal(s string) (TextSpacingTrimVal, error) {
+	v := TextSpacingTrimVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextSpacingTrimVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextSpacingTrimVal constants.
+func (v TextSpacingTrimVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextSpacingTrimVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextSpacingTrimVal.
+func (v *TextSpacingTrimVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextSpacingTrimVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// TimelineScopeVal represents values for the timeline-scope property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <dashed-ident>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/timeline-scope
+type TimelineScopeVal string
+
+// TimelineScopeVal constants.
+const (
+	TimelineScopeValNone TimelineScopeVal = "none"
+)
+
+func (v TimelineScopeVal) String() string { return string(v) }
+
+// Values returns every TimelineScopeVal constant.
+func (TimelineScopeVal) Values() []TimelineScopeVal {
+	return []TimelineScopeVal{
+		TimelineScopeValNone,
+	}
+}
+
+// Valid reports whether v is one of the TimelineScopeVal constants.
+func (v TimelineScopeVal) Valid() bool {
+	switch v {
+	case TimelineScopeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTimelineScopeVal returns the TimelineScopeVal constant for a keyword, ignoring ASCII case.
+func ParseTimelineScopeVal(s string) (TimelineScopeVal, error) {
+	v := TimelineScopeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TimelineScopeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TimelineScopeVal constants.
+func (v TimelineScopeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TimelineScopeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTimelineScopeVal.
+func (v *TimelineScopeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimelineScopeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTimelineVal represents values for the view-timeline property.
+//
+//   - Shorthand for: view-timeline-axis, view-timeline-name
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline
+type ViewTimelineVal string
+
+// ViewTimelineVal constants.
+const (
+	ViewTimelineValAuto   ViewTimelineVal = "auto"
+	ViewTimelineValBlock  ViewTimelineVal = "block"
+	ViewTimelineValInline ViewTimelineVal = "inline"
+	ViewTimelineValNone   ViewTimelineVal = "none"
+	ViewTimelineValX      ViewTimelineVal = "x"
+	ViewTimelineValY      ViewTimelineVal = "y"
+)
+
+func (v ViewTimelineVal) String() string { return string(v) }
+
+// Values returns every ViewTimelineVal constant.
+func (ViewTimelineVal) Values() []ViewTimelineVal {
+	return []ViewTimelineVal{
+		ViewTimelineValAuto,
+		ViewTimelineValBlock,
+		ViewTimelineValInline,
+		ViewTimelineValNone,
+		ViewTimelineValX,
+		ViewTimelineValY,
+	}
+}
+
+// Valid reports whether v is one of the ViewTimelineVal constants.
+func (v ViewTimelineVal) Valid() bool {
+	switch v {
+	case ViewTimelineValAuto,
+		ViewTimelineValBlock,
+		ViewTimelineValInline,
+		ViewTimelineValNone,
+		ViewTimelineValX,
+		ViewTimelineValY:
+		return true
+	}
+	return false
+}
+
+// ParseViewTimelineVal returns the ViewTimelineVal constant for a keyword, ignoring ASCII case.
+func ParseViewTimelineVal(s string) (ViewTimelineVal, error) {
+	v := ViewTimelineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTimelineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTimelineVal constants.
+func (v ViewTimelineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTimelineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTimelineVal.
+func (v *ViewTimelineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTimelineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTimelineAxisVal represents values for the view-timeline-axis property.
+//
+//   - Initial: block
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ block | inline | x | y ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-axis
+type ViewTimelineAxisVal string
+
+// ViewTimelineAxisVal constants.
+const (
+	ViewTimelineAxisValBlock  ViewTimelineAxisVal = "block"
+	ViewTimelineAxisValInline ViewTimelineAxisVal = "inline"
+	ViewTimelineAxisValX      ViewTimelineAxisVal = "x"
+	ViewTimelineAxisValY      ViewTimelineAxisVal = "y"
+)
+
+func (v ViewTimelineAxisVal) String() string { return string(v) }
+
+// Values returns every ViewTimelineAxisVal constant.
+func (ViewTimelineAxisVal) Values() []ViewTimelineAxisVal {
+	return []ViewTimelineAxisVal{
+		ViewTimelineAxisValBlock,
+		ViewTimelineAxisValInline,
+		ViewTimelineAxisValX,
+		ViewTimelineAxisValY,
+	}
+}
+
+// Valid reports whether v is one of the ViewTimelineAxisVal constants.
+func (v ViewTimelineAxisVal) Valid() bool {
+	switch v {
+	case ViewTimelineAxisValBlock,
+		ViewTimelineAxisValInline,
+		ViewTimelineAxisValX,
+		ViewTimelineAxisValY:
+		return true
+	}
+	return false
+}
+
+// ParseViewTimelineAxisVal returns the ViewTimelineAxisVal constant for a keyword, ignoring ASCII case.
+func ParseViewTimelineAxisVal(s string) (ViewTimelineAxisVal, error) {
+	v := ViewTimelineAxisVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTimelineAxisVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTimelineAxisVal constants.
+func (v ViewTimelineAxisVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTimelineAxisVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTimelineAxisVal.
+func (v *ViewTimelineAxisVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTimelineAxisVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTimelineInsetVal represents values for the view-timeline-inset property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ [ auto | <length-percentage> ]{1,2} ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-inset
+type ViewTimelineInsetVal string
+
+// ViewTimelineInsetVal constants.
+const (
+	ViewTimelineInsetValAuto ViewTimelineInsetVal = "auto"
+)
+
+func (v ViewTimelineInsetVal) String() string { return string(v) }
+
+// Values returns every ViewTimelineInsetVal constant.
+func (ViewTimelineInsetVal) Values() []ViewTimelineInsetVal {
+	return []ViewTimelineInsetVal{
+		ViewTimelineInsetValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ViewTimelineInsetVal constants.
+func (v ViewTimelineInsetVal) Valid() bool {
+	switch v {
+	case ViewTimelineInsetValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseViewTimelineInsetVal returns the ViewTimelineInsetVal constant for a keyword, ignoring ASCII case.
+func ParseViewTimelineInsetVal(s string) (ViewTimelineInsetVal, error) {
+	v := ViewTimelineInsetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTimelineInsetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTimelineInsetVal constants.
+func (v ViewTimelineInsetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTimelineInsetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTimelineInsetVal.
+func (v *ViewTimelineInsetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTimelineInsetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ViewTimelineNameVal represents values for the view-timeline-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ none | <dashed-ident> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-name
+type ViewTimelineNameVal string
+
+// ViewTimelineNameVal constants.
+const (
+	ViewTimelineNameValNone ViewTimelineNameVal = "none"
+)
+
+func (v ViewTimelineNameVal) String() string { return string(v) }
+
+// Values returns every ViewTimelineNameVal constant.
+func (ViewTimelineNameVal) Values() []ViewTimelineNameVal {
+	return []ViewTimelineNameVal{
+		ViewTimelineNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the ViewTimelineNameVal constants.
+func (v ViewTimelineNameVal) Valid() bool {
+	switch v {
+	case ViewTimelineNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseViewTimelineNameVal returns the ViewTimelineNameVal constant for a keyword, ignoring ASCII case.
+func ParseViewTimelineNameVal(s string) (ViewTimelineNameVal, error) {
+	v := ViewTimelineNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTimelineNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTimelineNameVal constants.
+func (v ViewTimelineNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTimelineNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTimelineNameVal.
+func (v *ViewTimelineNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTimelineNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -1,23 +1,101 @@
 // Code generated by cssgen; DO NOT EDIT.
-// Source: spec version mdn-latest at 2025-09-01T01:22:06+03:00
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
 
 package cssgen
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Keyword types and constants for CSS property values.
 
+// InvalidKeywordError is returned when parsing or marshaling a string that
+// is not one of the constants of a keyword type.
+type InvalidKeywordError struct {
+	Type  string // the keyword type, e.g. "DisplayVal"
+	Value string
+}
+
+func (e *InvalidKeywordError) Error() string {
+	return fmt.Sprintf("cssgen: %q is not a valid %s", e.Value, e.Type)
+}
+
 // AccentColorVal represents values for the accent-color property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/accent-color
 type AccentColorVal string
 
 // AccentColorVal constants.
 const (
-	AccentColorValAuto                  AccentColorVal = "auto"
-	AccentColorValColorBase             AccentColorVal = "color-base"
-	AccentColorValDeprecatedSystemColor AccentColorVal = "deprecated-system-color"
-	AccentColorValLightDark             AccentColorVal = "light-dark"
-	AccentColorValSystemColor           AccentColorVal = "system-color"
-)
+	AccentColorValAuto AccentColorVal = "auto"
+)
+
+func (v AccentColorVal) String() string { return string(v) }
+
+// Values returns every AccentColorVal constant.
+func (AccentColorVal) Values() []AccentColorVal {
+	return []AccentColorVal{
+		AccentColorValAuto,
+	}
+}
+
+// Valid reports whether v is one of the AccentColorVal constants.
+func (v AccentColorVal) Valid() bool {
+	switch v {
+	case AccentColorValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseAccentColorVal returns the AccentColorVal constant for a keyword, ignoring ASCII case.
+func ParseAccentColorVal(s string) (AccentColorVal, error) {
+	v := AccentColorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AccentColorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AccentColorVal constants.
+func (v AccentColorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AccentColorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAccentColorVal.
+func (v *AccentColorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAccentColorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AlignContentVal represents values for the align-content property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-content
 type AlignContentVal string
 
 // AlignContentVal constants.
@@ -39,7 +117,91 @@ const (
 	AlignContentValUnsafe       AlignContentVal = "unsafe"
 )
 
+func (v AlignContentVal) String() string { return string(v) }
+
+// Values returns every AlignContentVal constant.
+func (AlignContentVal) Values() []AlignContentVal {
+	return []AlignContentVal{
+		AlignContentValBaseline,
+		AlignContentValCenter,
+		AlignContentValEnd,
+		AlignContentValFirst,
+		AlignContentValFlexEnd,
+		AlignContentValFlexStart,
+		AlignContentValLast,
+		AlignContentValNormal,
+		AlignContentValSafe,
+		AlignContentValSpaceAround,
+		AlignContentValSpaceBetween,
+		AlignContentValSpaceEvenly,
+		AlignContentValStart,
+		AlignContentValStretch,
+		AlignContentValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the AlignContentVal constants.
+func (v AlignContentVal) Valid() bool {
+	switch v {
+	case AlignContentValBaseline,
+		AlignContentValCenter,
+		AlignContentValEnd,
+		AlignContentValFirst,
+		AlignContentValFlexEnd,
+		AlignContentValFlexStart,
+		AlignContentValLast,
+		AlignContentValNormal,
+		AlignContentValSafe,
+		AlignContentValSpaceAround,
+		AlignContentValSpaceBetween,
+		AlignContentValSpaceEvenly,
+		AlignContentValStart,
+		AlignContentValStretch,
+		AlignContentValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseAlignContentVal returns the AlignContentVal constant for a keyword, ignoring ASCII case.
+func ParseAlignContentVal(s string) (AlignContentVal, error) {
+	v := AlignContentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AlignContentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AlignContentVal constants.
+func (v AlignContentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AlignContentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAlignContentVal.
+func (v *AlignContentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlignContentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AlignItemsVal represents values for the align-items property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-items
 type AlignItemsVal string
 
 // AlignItemsVal constants.
@@ -61,7 +223,91 @@ const (
 	AlignItemsValUnsafe       AlignItemsVal = "unsafe"
 )
 
+func (v AlignItemsVal) String() string { return string(v) }
+
+// Values returns every AlignItemsVal constant.
+func (AlignItemsVal) Values() []AlignItemsVal {
+	return []AlignItemsVal{
+		AlignItemsValAnchorCenter,
+		AlignItemsValBaseline,
+		AlignItemsValCenter,
+		AlignItemsValEnd,
+		AlignItemsValFirst,
+		AlignItemsValFlexEnd,
+		AlignItemsValFlexStart,
+		AlignItemsValLast,
+		AlignItemsValNormal,
+		AlignItemsValSafe,
+		AlignItemsValSelfEnd,
+		AlignItemsValSelfStart,
+		AlignItemsValStart,
+		AlignItemsValStretch,
+		AlignItemsValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the AlignItemsVal constants.
+func (v AlignItemsVal) Valid() bool {
+	switch v {
+	case AlignItemsValAnchorCenter,
+		AlignItemsValBaseline,
+		AlignItemsValCenter,
+		AlignItemsValEnd,
+		AlignItemsValFirst,
+		AlignItemsValFlexEnd,
+		AlignItemsValFlexStart,
+		AlignItemsValLast,
+		AlignItemsValNormal,
+		AlignItemsValSafe,
+		AlignItemsValSelfEnd,
+		AlignItemsValSelfStart,
+		AlignItemsValStart,
+		AlignItemsValStretch,
+		AlignItemsValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseAlignItemsVal returns the AlignItemsVal constant for a keyword, ignoring ASCII case.
+func ParseAlignItemsVal(s string) (AlignItemsVal, error) {
+	v := AlignItemsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AlignItemsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AlignItemsVal constants.
+func (v AlignItemsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AlignItemsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAlignItemsVal.
+func (v *AlignItemsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlignItemsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AlignSelfVal represents values for the align-self property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position> | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-self
 type AlignSelfVal string
 
 // AlignSelfVal constants.
@@ -84,7 +330,91 @@ const (
 	AlignSelfValUnsafe       AlignSelfVal = "unsafe"
 )
 
+func (v AlignSelfVal) String() string { return string(v) }
+
+// Values returns every AlignSelfVal constant.
+func (AlignSelfVal) Values() []AlignSelfVal {
+	return []AlignSelfVal{
+		AlignSelfValAnchorCenter,
+		AlignSelfValAuto,
+		AlignSelfValBaseline,
+		AlignSelfValCenter,
+		AlignSelfValEnd,
+		AlignSelfValFirst,
+		AlignSelfValFlexEnd,
+		AlignSelfValFlexStart,
+		AlignSelfValLast,
+		AlignSelfValNormal,
+		AlignSelfValSafe,
+		AlignSelfValSelfEnd,
+		AlignSelfValSelfStart,
+		AlignSelfValStart,
+		AlignSelfValStretch,
+		AlignSelfValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the AlignSelfVal constants.
+func (v AlignSelfVal) Valid() bool {
+	switch v {
+	case AlignSelfValAnchorCenter,
+		AlignSelfValAuto,
+		AlignSelfValBaseline,
+		AlignSelfValCenter,
+		AlignSelfValEnd,
+		AlignSelfValFirst,
+		AlignSelfValFlexEnd,
+		AlignSelfValFlexStart,
+		AlignSelfValLast,
+		AlignSelfValNormal,
+		AlignSelfValSafe,
+		AlignSelfValSelfEnd,
+		AlignSelfValSelfStart,
+		AlignSelfValStart,
+		AlignSelfValStretch,
+		AlignSelfValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseAlignSelfVal returns the AlignSelfVal constant for a keyword, ignoring ASCII case.
+func ParseAlignSelfVal(s string) (AlignSelfVal, error) {
+	v := AlignSelfVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AlignSelfVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AlignSelfVal constants.
+func (v AlignSelfVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AlignSelfVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAlignSelfVal.
+func (v *AlignSelfVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlignSelfVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AlignTracksVal represents values for the align-tracks property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position> ]#
 type AlignTracksVal string
 
 // AlignTracksVal constants.
@@ -106,7 +436,91 @@ const (
 	AlignTracksValUnsafe       AlignTracksVal = "unsafe"
 )
 
+func (v AlignTracksVal) String() string { return string(v) }
+
+// Values returns every AlignTracksVal constant.
+func (AlignTracksVal) Values() []AlignTracksVal {
+	return []AlignTracksVal{
+		AlignTracksValBaseline,
+		AlignTracksValCenter,
+		AlignTracksValEnd,
+		AlignTracksValFirst,
+		AlignTracksValFlexEnd,
+		AlignTracksValFlexStart,
+		AlignTracksValLast,
+		AlignTracksValNormal,
+		AlignTracksValSafe,
+		AlignTracksValSpaceAround,
+		AlignTracksValSpaceBetween,
+		AlignTracksValSpaceEvenly,
+		AlignTracksValStart,
+		AlignTracksValStretch,
+		AlignTracksValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the AlignTracksVal constants.
+func (v AlignTracksVal) Valid() bool {
+	switch v {
+	case AlignTracksValBaseline,
+		AlignTracksValCenter,
+		AlignTracksValEnd,
+		AlignTracksValFirst,
+		AlignTracksValFlexEnd,
+		AlignTracksValFlexStart,
+		AlignTracksValLast,
+		AlignTracksValNormal,
+		AlignTracksValSafe,
+		AlignTracksValSpaceAround,
+		AlignTracksValSpaceBetween,
+		AlignTracksValSpaceEvenly,
+		AlignTracksValStart,
+		AlignTracksValStretch,
+		AlignTracksValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseAlignTracksVal returns the AlignTracksVal constant for a keyword, ignoring ASCII case.
+func ParseAlignTracksVal(s string) (AlignTracksVal, error) {
+	v := AlignTracksVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AlignTracksVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AlignTracksVal constants.
+func (v AlignTracksVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AlignTracksVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAlignTracksVal.
+func (v *AlignTracksVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlignTracksVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AlignmentBaselineVal represents values for the alignment-baseline property.
+//
+//   - Initial: baseline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/alignment-baseline
 type AlignmentBaselineVal string
 
 // AlignmentBaselineVal constants.
@@ -121,32 +535,195 @@ const (
 	AlignmentBaselineValTextBeforeEdge AlignmentBaselineVal = "text-before-edge"
 )
 
-// AllVal represents values for the all property.
-type AllVal string
-
-// AllVal constants.
-const (
-	AllValRevertLayer AllVal = "revert-layer"
-)
+func (v AlignmentBaselineVal) String() string { return string(v) }
+
+// Values returns every AlignmentBaselineVal constant.
+func (AlignmentBaselineVal) Values() []AlignmentBaselineVal {
+	return []AlignmentBaselineVal{
+		AlignmentBaselineValAlphabetic,
+		AlignmentBaselineValBaseline,
+		AlignmentBaselineValCentral,
+		AlignmentBaselineValIdeographic,
+		AlignmentBaselineValMathematical,
+		AlignmentBaselineValMiddle,
+		AlignmentBaselineValTextAfterEdge,
+		AlignmentBaselineValTextBeforeEdge,
+	}
+}
+
+// Valid reports whether v is one of the AlignmentBaselineVal constants.
+func (v AlignmentBaselineVal) Valid() bool {
+	switch v {
+	case AlignmentBaselineValAlphabetic,
+		AlignmentBaselineValBaseline,
+		AlignmentBaselineValCentral,
+		AlignmentBaselineValIdeographic,
+		AlignmentBaselineValMathematical,
+		AlignmentBaselineValMiddle,
+		AlignmentBaselineValTextAfterEdge,
+		AlignmentBaselineValTextBeforeEdge:
+		return true
+	}
+	return false
+}
+
+// ParseAlignmentBaselineVal returns the AlignmentBaselineVal constant for a keyword, ignoring ASCII case.
+func ParseAlignmentBaselineVal(s string) (AlignmentBaselineVal, error) {
+	v := AlignmentBaselineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AlignmentBaselineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AlignmentBaselineVal constants.
+func (v AlignmentBaselineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AlignmentBaselineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAlignmentBaselineVal.
+func (v *AlignmentBaselineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAlignmentBaselineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AnimationVal represents values for the animation property.
+//
+//   - Shorthand for: animation-delay, animation-direction, animation-duration, animation-fill-mode, animation-iteration-count, animation-name, animation-play-state, animation-timeline, animation-timing-function
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation
 type AnimationVal string
 
 // AnimationVal constants.
 const (
-	AnimationValAnimationDelay                AnimationVal = "animation-delay"
-	AnimationValAnimationDuration             AnimationVal = "animation-duration"
-	AnimationValEasingFunction                AnimationVal = "easing-function"
-	AnimationValKeyframesName                 AnimationVal = "keyframes-name"
-	AnimationValNone                          AnimationVal = "none"
-	AnimationValSingleAnimationDirection      AnimationVal = "single-animation-direction"
-	AnimationValSingleAnimationFillMode       AnimationVal = "single-animation-fill-mode"
-	AnimationValSingleAnimationIterationCount AnimationVal = "single-animation-iteration-count"
-	AnimationValSingleAnimationPlayState      AnimationVal = "single-animation-play-state"
-	AnimationValSingleAnimationTimeline       AnimationVal = "single-animation-timeline"
-)
+	AnimationValAlternate        AnimationVal = "alternate"
+	AnimationValAlternateReverse AnimationVal = "alternate-reverse"
+	AnimationValAuto             AnimationVal = "auto"
+	AnimationValBackwards        AnimationVal = "backwards"
+	AnimationValBoth             AnimationVal = "both"
+	AnimationValEase             AnimationVal = "ease"
+	AnimationValEaseIn           AnimationVal = "ease-in"
+	AnimationValEaseInOut        AnimationVal = "ease-in-out"
+	AnimationValEaseOut          AnimationVal = "ease-out"
+	AnimationValForwards         AnimationVal = "forwards"
+	AnimationValInfinite         AnimationVal = "infinite"
+	AnimationValLinear           AnimationVal = "linear"
+	AnimationValNone             AnimationVal = "none"
+	AnimationValNormal           AnimationVal = "normal"
+	AnimationValPaused           AnimationVal = "paused"
+	AnimationValReverse          AnimationVal = "reverse"
+	AnimationValRunning          AnimationVal = "running"
+	AnimationValStepEnd          AnimationVal = "step-end"
+	AnimationValStepStart        AnimationVal = "step-start"
+)
+
+func (v AnimationVal) String() string { return string(v) }
+
+// Values returns every AnimationVal constant.
+func (AnimationVal) Values() []AnimationVal {
+	return []AnimationVal{
+		AnimationValAlternate,
+		AnimationValAlternateReverse,
+		AnimationValAuto,
+		AnimationValBackwards,
+		AnimationValBoth,
+		AnimationValEase,
+		AnimationValEaseIn,
+		AnimationValEaseInOut,
+		AnimationValEaseOut,
+		AnimationValForwards,
+		AnimationValInfinite,
+		AnimationValLinear,
+		AnimationValNone,
+		AnimationValNormal,
+		AnimationValPaused,
+		AnimationValReverse,
+		AnimationValRunning,
+		AnimationValStepEnd,
+		AnimationValStepStart,
+	}
+}
+
+// Valid reports whether v is one of the AnimationVal constants.
+func (v AnimationVal) Valid() bool {
+	switch v {
+	case AnimationValAlternate,
+		AnimationValAlternateReverse,
+		AnimationValAuto,
+		AnimationValBackwards,
+		AnimationValBoth,
+		AnimationValEase,
+		AnimationValEaseIn,
+		AnimationValEaseInOut,
+		AnimationValEaseOut,
+		AnimationValForwards,
+		AnimationValInfinite,
+		AnimationValLinear,
+		AnimationValNone,
+		AnimationValNormal,
+		AnimationValPaused,
+		AnimationValReverse,
+		AnimationValRunning,
+		AnimationValStepEnd,
+		AnimationValStepStart:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationVal returns the AnimationVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationVal(s string) (AnimationVal, error) {
+	v := AnimationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationVal constants.
+func (v AnimationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationVal.
+func (v *AnimationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AnimationCompositionVal represents values for the animation-composition property.
+//
+//   - Initial: replace
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-composition>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-composition
 type AnimationCompositionVal string
 
 // AnimationCompositionVal constants.
@@ -156,15 +733,67 @@ const (
 	AnimationCompositionValReplace    AnimationCompositionVal = "replace"
 )
 
-// AnimationDelayVal represents values for the animation-delay property.
-type AnimationDelayVal string
-
-// AnimationDelayVal constants.
-const (
-	AnimationDelayValTime AnimationDelayVal = "time"
-)
+func (v AnimationCompositionVal) String() string { return string(v) }
+
+// Values returns every AnimationCompositionVal constant.
+func (AnimationCompositionVal) Values() []AnimationCompositionVal {
+	return []AnimationCompositionVal{
+		AnimationCompositionValAccumulate,
+		AnimationCompositionValAdd,
+		AnimationCompositionValReplace,
+	}
+}
+
+// Valid reports whether v is one of the AnimationCompositionVal constants.
+func (v AnimationCompositionVal) Valid() bool {
+	switch v {
+	case AnimationCompositionValAccumulate,
+		AnimationCompositionValAdd,
+		AnimationCompositionValReplace:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationCompositionVal returns the AnimationCompositionVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationCompositionVal(s string) (AnimationCompositionVal, error) {
+	v := AnimationCompositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationCompositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationCompositionVal constants.
+func (v AnimationCompositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationCompositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationCompositionVal.
+func (v *AnimationCompositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationCompositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AnimationDirectionVal represents values for the animation-direction property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-direction>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-direction
 type AnimationDirectionVal string
 
 // AnimationDirectionVal constants.
@@ -175,16 +804,133 @@ const (
 	AnimationDirectionValReverse          AnimationDirectionVal = "reverse"
 )
 
+func (v AnimationDirectionVal) String() string { return string(v) }
+
+// Values returns every AnimationDirectionVal constant.
+func (AnimationDirectionVal) Values() []AnimationDirectionVal {
+	return []AnimationDirectionVal{
+		AnimationDirectionValAlternate,
+		AnimationDirectionValAlternateReverse,
+		AnimationDirectionValNormal,
+		AnimationDirectionValReverse,
+	}
+}
+
+// Valid reports whether v is one of the AnimationDirectionVal constants.
+func (v AnimationDirectionVal) Valid() bool {
+	switch v {
+	case AnimationDirectionValAlternate,
+		AnimationDirectionValAlternateReverse,
+		AnimationDirectionValNormal,
+		AnimationDirectionValReverse:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationDirectionVal returns the AnimationDirectionVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationDirectionVal(s string) (AnimationDirectionVal, error) {
+	v := AnimationDirectionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationDirectionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationDirectionVal constants.
+func (v AnimationDirectionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationDirectionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationDirectionVal.
+func (v *AnimationDirectionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationDirectionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AnimationDurationVal represents values for the animation-duration property.
+//
+//   - Initial: 0s
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <time [0s,∞]> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-duration
 type AnimationDurationVal string
 
 // AnimationDurationVal constants.
 const (
 	AnimationDurationValAuto AnimationDurationVal = "auto"
-	AnimationDurationValTime AnimationDurationVal = "time"
 )
 
+func (v AnimationDurationVal) String() string { return string(v) }
+
+// Values returns every AnimationDurationVal constant.
+func (AnimationDurationVal) Values() []AnimationDurationVal {
+	return []AnimationDurationVal{
+		AnimationDurationValAuto,
+	}
+}
+
+// Valid reports whether v is one of the AnimationDurationVal constants.
+func (v AnimationDurationVal) Valid() bool {
+	switch v {
+	case AnimationDurationValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationDurationVal returns the AnimationDurationVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationDurationVal(s string) (AnimationDurationVal, error) {
+	v := AnimationDurationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationDurationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationDurationVal constants.
+func (v AnimationDurationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationDurationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationDurationVal.
+func (v *AnimationDurationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationDurationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AnimationFillModeVal represents values for the animation-fill-mode property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-fill-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-fill-mode
 type AnimationFillModeVal string
 
 // AnimationFillModeVal constants.
@@ -195,7 +941,69 @@ const (
 	AnimationFillModeValNone      AnimationFillModeVal = "none"
 )
 
+func (v AnimationFillModeVal) String() string { return string(v) }
+
+// Values returns every AnimationFillModeVal constant.
+func (AnimationFillModeVal) Values() []AnimationFillModeVal {
+	return []AnimationFillModeVal{
+		AnimationFillModeValBackwards,
+		AnimationFillModeValBoth,
+		AnimationFillModeValForwards,
+		AnimationFillModeValNone,
+	}
+}
+
+// Valid reports whether v is one of the AnimationFillModeVal constants.
+func (v AnimationFillModeVal) Valid() bool {
+	switch v {
+	case AnimationFillModeValBackwards,
+		AnimationFillModeValBoth,
+		AnimationFillModeValForwards,
+		AnimationFillModeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationFillModeVal returns the AnimationFillModeVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationFillModeVal(s string) (AnimationFillModeVal, error) {
+	v := AnimationFillModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationFillModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationFillModeVal constants.
+func (v AnimationFillModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationFillModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationFillModeVal.
+func (v *AnimationFillModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationFillModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AnimationIterationCountVal represents values for the animation-iteration-count property.
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-iteration-count>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-iteration-count
 type AnimationIterationCountVal string
 
 // AnimationIterationCountVal constants.
@@ -203,16 +1011,127 @@ const (
 	AnimationIterationCountValInfinite AnimationIterationCountVal = "infinite"
 )
 
+func (v AnimationIterationCountVal) String() string { return string(v) }
+
+// Values returns every AnimationIterationCountVal constant.
+func (AnimationIterationCountVal) Values() []AnimationIterationCountVal {
+	return []AnimationIterationCountVal{
+		AnimationIterationCountValInfinite,
+	}
+}
+
+// Valid reports whether v is one of the AnimationIterationCountVal constants.
+func (v AnimationIterationCountVal) Valid() bool {
+	switch v {
+	case AnimationIterationCountValInfinite:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationIterationCountVal returns the AnimationIterationCountVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationIterationCountVal(s string) (AnimationIterationCountVal, error) {
+	v := AnimationIterationCountVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationIterationCountVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationIterationCountVal constants.
+func (v AnimationIterationCountVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationIterationCountVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationIterationCountVal.
+func (v *AnimationIterationCountVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationIterationCountVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AnimationNameVal represents values for the animation-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ none | <keyframes-name> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-name
 type AnimationNameVal string
 
 // AnimationNameVal constants.
 const (
-	AnimationNameValCustomIdent AnimationNameVal = "custom-ident"
-	AnimationNameValNone        AnimationNameVal = "none"
-)
+	AnimationNameValNone AnimationNameVal = "none"
+)
+
+func (v AnimationNameVal) String() string { return string(v) }
+
+// Values returns every AnimationNameVal constant.
+func (AnimationNameVal) Values() []AnimationNameVal {
+	return []AnimationNameVal{
+		AnimationNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the AnimationNameVal constants.
+func (v AnimationNameVal) Valid() bool {
+	switch v {
+	case AnimationNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationNameVal returns the AnimationNameVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationNameVal(s string) (AnimationNameVal, error) {
+	v := AnimationNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationNameVal constants.
+func (v AnimationNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationNameVal.
+func (v *AnimationNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AnimationPlayStateVal represents values for the animation-play-state property.
+//
+//   - Initial: running
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-play-state>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-play-state
 type AnimationPlayStateVal string
 
 // AnimationPlayStateVal constants.
@@ -221,17 +1140,147 @@ const (
 	AnimationPlayStateValRunning AnimationPlayStateVal = "running"
 )
 
+func (v AnimationPlayStateVal) String() string { return string(v) }
+
+// Values returns every AnimationPlayStateVal constant.
+func (AnimationPlayStateVal) Values() []AnimationPlayStateVal {
+	return []AnimationPlayStateVal{
+		AnimationPlayStateValPaused,
+		AnimationPlayStateValRunning,
+	}
+}
+
+// Valid reports whether v is one of the AnimationPlayStateVal constants.
+func (v AnimationPlayStateVal) Valid() bool {
+	switch v {
+	case AnimationPlayStateValPaused,
+		AnimationPlayStateValRunning:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationPlayStateVal returns the AnimationPlayStateVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationPlayStateVal(s string) (AnimationPlayStateVal, error) {
+	v := AnimationPlayStateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationPlayStateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationPlayStateVal constants.
+func (v AnimationPlayStateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationPlayStateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationPlayStateVal.
+func (v *AnimationPlayStateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationPlayStateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AnimationTimingFunctionVal represents values for the animation-timing-function property.
+//
+//   - Initial: ease
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<easing-function>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timing-function
 type AnimationTimingFunctionVal string
 
 // AnimationTimingFunctionVal constants.
 const (
-	AnimationTimingFunctionValCubicBezierEasingFunction AnimationTimingFunctionVal = "cubic-bezier-easing-function"
-	AnimationTimingFunctionValLinearEasingFunction      AnimationTimingFunctionVal = "linear-easing-function"
-	AnimationTimingFunctionValStepEasingFunction        AnimationTimingFunctionVal = "step-easing-function"
-)
+	AnimationTimingFunctionValEase      AnimationTimingFunctionVal = "ease"
+	AnimationTimingFunctionValEaseIn    AnimationTimingFunctionVal = "ease-in"
+	AnimationTimingFunctionValEaseInOut AnimationTimingFunctionVal = "ease-in-out"
+	AnimationTimingFunctionValEaseOut   AnimationTimingFunctionVal = "ease-out"
+	AnimationTimingFunctionValLinear    AnimationTimingFunctionVal = "linear"
+	AnimationTimingFunctionValStepEnd   AnimationTimingFunctionVal = "step-end"
+	AnimationTimingFunctionValStepStart AnimationTimingFunctionVal = "step-start"
+)
+
+func (v AnimationTimingFunctionVal) String() string { return string(v) }
+
+// Values returns every AnimationTimingFunctionVal constant.
+func (AnimationTimingFunctionVal) Values() []AnimationTimingFunctionVal {
+	return []AnimationTimingFunctionVal{
+		AnimationTimingFunctionValEase,
+		AnimationTimingFunctionValEaseIn,
+		AnimationTimingFunctionValEaseInOut,
+		AnimationTimingFunctionValEaseOut,
+		AnimationTimingFunctionValLinear,
+		AnimationTimingFunctionValStepEnd,
+		AnimationTimingFunctionValStepStart,
+	}
+}
+
+// Valid reports whether v is one of the AnimationTimingFunctionVal constants.
+func (v AnimationTimingFunctionVal) Valid() bool {
+	switch v {
+	case AnimationTimingFunctionValEase,
+		AnimationTimingFunctionValEaseIn,
+		AnimationTimingFunctionValEaseInOut,
+		AnimationTimingFunctionValEaseOut,
+		AnimationTimingFunctionValLinear,
+		AnimationTimingFunctionValStepEnd,
+		AnimationTimingFunctionValStepStart:
+		return true
+	}
+	return false
+}
+
+// ParseAnimationTimingFunctionVal returns the AnimationTimingFunctionVal constant for a keyword, ignoring ASCII case.
+func ParseAnimationTimingFunctionVal(s string) (AnimationTimingFunctionVal, error) {
+	v := AnimationTimingFunctionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AnimationTimingFunctionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AnimationTimingFunctionVal constants.
+func (v AnimationTimingFunctionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AnimationTimingFunctionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAnimationTimingFunctionVal.
+func (v *AnimationTimingFunctionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAnimationTimingFunctionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // AppearanceVal represents values for the appearance property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | auto | <compat-auto> | <compat-special>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/appearance
 type AppearanceVal string
 
 // AppearanceVal constants.
@@ -251,25 +1300,215 @@ const (
 	AppearanceValTextfield      AppearanceVal = "textfield"
 )
 
+func (v AppearanceVal) String() string { return string(v) }
+
+// Values returns every AppearanceVal constant.
+func (AppearanceVal) Values() []AppearanceVal {
+	return []AppearanceVal{
+		AppearanceValAuto,
+		AppearanceValButton,
+		AppearanceValCheckbox,
+		AppearanceValListbox,
+		AppearanceValMenulist,
+		AppearanceValMenulistButton,
+		AppearanceValMeter,
+		AppearanceValNone,
+		AppearanceValProgressBar,
+		AppearanceValRadio,
+		AppearanceValSearchfield,
+		AppearanceValTextarea,
+		AppearanceValTextfield,
+	}
+}
+
+// Valid reports whether v is one of the AppearanceVal constants.
+func (v AppearanceVal) Valid() bool {
+	switch v {
+	case AppearanceValAuto,
+		AppearanceValButton,
+		AppearanceValCheckbox,
+		AppearanceValListbox,
+		AppearanceValMenulist,
+		AppearanceValMenulistButton,
+		AppearanceValMeter,
+		AppearanceValNone,
+		AppearanceValProgressBar,
+		AppearanceValRadio,
+		AppearanceValSearchfield,
+		AppearanceValTextarea,
+		AppearanceValTextfield:
+		return true
+	}
+	return false
+}
+
+// ParseAppearanceVal returns the AppearanceVal constant for a keyword, ignoring ASCII case.
+func ParseAppearanceVal(s string) (AppearanceVal, error) {
+	v := AppearanceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AppearanceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AppearanceVal constants.
+func (v AppearanceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AppearanceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAppearanceVal.
+func (v *AppearanceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAppearanceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // AspectRatioVal represents values for the aspect-ratio property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto || <ratio>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/aspect-ratio
 type AspectRatioVal string
 
 // AspectRatioVal constants.
 const (
-	AspectRatioVal0    AspectRatioVal = "0"
 	AspectRatioValAuto AspectRatioVal = "auto"
 )
 
+func (v AspectRatioVal) String() string { return string(v) }
+
+// Values returns every AspectRatioVal constant.
+func (AspectRatioVal) Values() []AspectRatioVal {
+	return []AspectRatioVal{
+		AspectRatioValAuto,
+	}
+}
+
+// Valid reports whether v is one of the AspectRatioVal constants.
+func (v AspectRatioVal) Valid() bool {
+	switch v {
+	case AspectRatioValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseAspectRatioVal returns the AspectRatioVal constant for a keyword, ignoring ASCII case.
+func ParseAspectRatioVal(s string) (AspectRatioVal, error) {
+	v := AspectRatioVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "AspectRatioVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not AspectRatioVal constants.
+func (v AspectRatioVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "AspectRatioVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseAspectRatioVal.
+func (v *AspectRatioVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseAspectRatioVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackdropFilterVal represents values for the backdrop-filter property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <filter-value-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/backdrop-filter
 type BackdropFilterVal string
 
 // BackdropFilterVal constants.
 const (
-	BackdropFilterValFilterFunction BackdropFilterVal = "filter-function"
-	BackdropFilterValNone           BackdropFilterVal = "none"
-)
+	BackdropFilterValNone BackdropFilterVal = "none"
+)
+
+func (v BackdropFilterVal) String() string { return string(v) }
+
+// Values returns every BackdropFilterVal constant.
+func (BackdropFilterVal) Values() []BackdropFilterVal {
+	return []BackdropFilterVal{
+		BackdropFilterValNone,
+	}
+}
+
+// Valid reports whether v is one of the BackdropFilterVal constants.
+func (v BackdropFilterVal) Valid() bool {
+	switch v {
+	case BackdropFilterValNone:
+		return true
+	}
+	return false
+}
+
+// ParseBackdropFilterVal returns the BackdropFilterVal constant for a keyword, ignoring ASCII case.
+func ParseBackdropFilterVal(s string) (BackdropFilterVal, error) {
+	v := BackdropFilterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackdropFilterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackdropFilterVal constants.
+func (v BackdropFilterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackdropFilterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackdropFilterVal.
+func (v *BackdropFilterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackdropFilterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BackfaceVisibilityVal represents values for the backface-visibility property.
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/backface-visibility
 type BackfaceVisibilityVal string
 
 // BackfaceVisibilityVal constants.
@@ -278,21 +1517,189 @@ const (
 	BackfaceVisibilityValVisible BackfaceVisibilityVal = "visible"
 )
 
+func (v BackfaceVisibilityVal) String() string { return string(v) }
+
+// Values returns every BackfaceVisibilityVal constant.
+func (BackfaceVisibilityVal) Values() []BackfaceVisibilityVal {
+	return []BackfaceVisibilityVal{
+		BackfaceVisibilityValHidden,
+		BackfaceVisibilityValVisible,
+	}
+}
+
+// Valid reports whether v is one of the BackfaceVisibilityVal constants.
+func (v BackfaceVisibilityVal) Valid() bool {
+	switch v {
+	case BackfaceVisibilityValHidden,
+		BackfaceVisibilityValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseBackfaceVisibilityVal returns the BackfaceVisibilityVal constant for a keyword, ignoring ASCII case.
+func ParseBackfaceVisibilityVal(s string) (BackfaceVisibilityVal, error) {
+	v := BackfaceVisibilityVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackfaceVisibilityVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackfaceVisibilityVal constants.
+func (v BackfaceVisibilityVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackfaceVisibilityVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackfaceVisibilityVal.
+func (v *BackfaceVisibilityVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackfaceVisibilityVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundVal represents values for the background property.
+//
+//   - Shorthand for: background-attachment, background-clip, background-color, background-image, background-origin, background-position, background-repeat, background-size
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-layer>#? , <final-bg-layer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background
 type BackgroundVal string
 
 // BackgroundVal constants.
 const (
-	BackgroundValAttachment      BackgroundVal = "attachment"
-	BackgroundValBackgroundColor BackgroundVal = "background-color"
-	BackgroundValBgImage         BackgroundVal = "bg-image"
-	BackgroundValBgPosition      BackgroundVal = "bg-position"
-	BackgroundValBgSize          BackgroundVal = "bg-size"
-	BackgroundValRepeatStyle     BackgroundVal = "repeat-style"
-	BackgroundValVisualBox       BackgroundVal = "visual-box"
-)
+	BackgroundValAuto       BackgroundVal = "auto"
+	BackgroundValBorderBox  BackgroundVal = "border-box"
+	BackgroundValBottom     BackgroundVal = "bottom"
+	BackgroundValCenter     BackgroundVal = "center"
+	BackgroundValContain    BackgroundVal = "contain"
+	BackgroundValContentBox BackgroundVal = "content-box"
+	BackgroundValCover      BackgroundVal = "cover"
+	BackgroundValFixed      BackgroundVal = "fixed"
+	BackgroundValLeft       BackgroundVal = "left"
+	BackgroundValLocal      BackgroundVal = "local"
+	BackgroundValNoRepeat   BackgroundVal = "no-repeat"
+	BackgroundValNone       BackgroundVal = "none"
+	BackgroundValPaddingBox BackgroundVal = "padding-box"
+	BackgroundValRepeat     BackgroundVal = "repeat"
+	BackgroundValRepeatX    BackgroundVal = "repeat-x"
+	BackgroundValRepeatY    BackgroundVal = "repeat-y"
+	BackgroundValRight      BackgroundVal = "right"
+	BackgroundValRound      BackgroundVal = "round"
+	BackgroundValScroll     BackgroundVal = "scroll"
+	BackgroundValSpace      BackgroundVal = "space"
+	BackgroundValTop        BackgroundVal = "top"
+)
+
+func (v BackgroundVal) String() string { return string(v) }
+
+// Values returns every BackgroundVal constant.
+func (BackgroundVal) Values() []BackgroundVal {
+	return []BackgroundVal{
+		BackgroundValAuto,
+		BackgroundValBorderBox,
+		BackgroundValBottom,
+		BackgroundValCenter,
+		BackgroundValContain,
+		BackgroundValContentBox,
+		BackgroundValCover,
+		BackgroundValFixed,
+		BackgroundValLeft,
+		BackgroundValLocal,
+		BackgroundValNoRepeat,
+		BackgroundValNone,
+		BackgroundValPaddingBox,
+		BackgroundValRepeat,
+		BackgroundValRepeatX,
+		BackgroundValRepeatY,
+		BackgroundValRight,
+		BackgroundValRound,
+		BackgroundValScroll,
+		BackgroundValSpace,
+		BackgroundValTop,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundVal constants.
+func (v BackgroundVal) Valid() bool {
+	switch v {
+	case BackgroundValAuto,
+		BackgroundValBorderBox,
+		BackgroundValBottom,
+		BackgroundValCenter,
+		BackgroundValContain,
+		BackgroundValContentBox,
+		BackgroundValCover,
+		BackgroundValFixed,
+		BackgroundValLeft,
+		BackgroundValLocal,
+		BackgroundValNoRepeat,
+		BackgroundValNone,
+		BackgroundValPaddingBox,
+		BackgroundValRepeat,
+		BackgroundValRepeatX,
+		BackgroundValRepeatY,
+		BackgroundValRight,
+		BackgroundValRound,
+		BackgroundValScroll,
+		BackgroundValSpace,
+		BackgroundValTop:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundVal returns the BackgroundVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundVal(s string) (BackgroundVal, error) {
+	v := BackgroundVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundVal constants.
+func (v BackgroundVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundVal.
+func (v *BackgroundVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BackgroundAttachmentVal represents values for the background-attachment property.
+//
+//   - Initial: scroll
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<attachment>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-attachment
 type BackgroundAttachmentVal string
 
 // BackgroundAttachmentVal constants.
@@ -302,11 +1709,72 @@ const (
 	BackgroundAttachmentValScroll BackgroundAttachmentVal = "scroll"
 )
 
+func (v BackgroundAttachmentVal) String() string { return string(v) }
+
+// Values returns every BackgroundAttachmentVal constant.
+func (BackgroundAttachmentVal) Values() []BackgroundAttachmentVal {
+	return []BackgroundAttachmentVal{
+		BackgroundAttachmentValFixed,
+		BackgroundAttachmentValLocal,
+		BackgroundAttachmentValScroll,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundAttachmentVal constants.
+func (v BackgroundAttachmentVal) Valid() bool {
+	switch v {
+	case BackgroundAttachmentValFixed,
+		BackgroundAttachmentValLocal,
+		BackgroundAttachmentValScroll:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundAttachmentVal returns the BackgroundAttachmentVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundAttachmentVal(s string) (BackgroundAttachmentVal, error) {
+	v := BackgroundAttachmentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundAttachmentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundAttachmentVal constants.
+func (v BackgroundAttachmentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundAttachmentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundAttachmentVal.
+func (v *BackgroundAttachmentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundAttachmentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundBlendModeVal represents values for the background-blend-mode property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<blend-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-blend-mode
 type BackgroundBlendModeVal string
 
 // BackgroundBlendModeVal constants.
 const (
+	BackgroundBlendModeValColor      BackgroundBlendModeVal = "color"
 	BackgroundBlendModeValColorBurn  BackgroundBlendModeVal = "color-burn"
 	BackgroundBlendModeValColorDodge BackgroundBlendModeVal = "color-dodge"
 	BackgroundBlendModeValDarken     BackgroundBlendModeVal = "darken"
@@ -324,28 +1792,169 @@ const (
 	BackgroundBlendModeValSoftLight  BackgroundBlendModeVal = "soft-light"
 )
 
+func (v BackgroundBlendModeVal) String() string { return string(v) }
+
+// Values returns every BackgroundBlendModeVal constant.
+func (BackgroundBlendModeVal) Values() []BackgroundBlendModeVal {
+	return []BackgroundBlendModeVal{
+		BackgroundBlendModeValColor,
+		BackgroundBlendModeValColorBurn,
+		BackgroundBlendModeValColorDodge,
+		BackgroundBlendModeValDarken,
+		BackgroundBlendModeValDifference,
+		BackgroundBlendModeValExclusion,
+		BackgroundBlendModeValHardLight,
+		BackgroundBlendModeValHue,
+		BackgroundBlendModeValLighten,
+		BackgroundBlendModeValLuminosity,
+		BackgroundBlendModeValMultiply,
+		BackgroundBlendModeValNormal,
+		BackgroundBlendModeValOverlay,
+		BackgroundBlendModeValSaturation,
+		BackgroundBlendModeValScreen,
+		BackgroundBlendModeValSoftLight,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundBlendModeVal constants.
+func (v BackgroundBlendModeVal) Valid() bool {
+	switch v {
+	case BackgroundBlendModeValColor,
+		BackgroundBlendModeValColorBurn,
+		BackgroundBlendModeValColorDodge,
+		BackgroundBlendModeValDarken,
+		BackgroundBlendModeValDifference,
+		BackgroundBlendModeValExclusion,
+		BackgroundBlendModeValHardLight,
+		BackgroundBlendModeValHue,
+		BackgroundBlendModeValLighten,
+		BackgroundBlendModeValLuminosity,
+		BackgroundBlendModeValMultiply,
+		BackgroundBlendModeValNormal,
+		BackgroundBlendModeValOverlay,
+		BackgroundBlendModeValSaturation,
+		BackgroundBlendModeValScreen,
+		BackgroundBlendModeValSoftLight:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundBlendModeVal returns the BackgroundBlendModeVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundBlendModeVal(s string) (BackgroundBlendModeVal, error) {
+	v := BackgroundBlendModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundBlendModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundBlendModeVal constants.
+func (v BackgroundBlendModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundBlendModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundBlendModeVal.
+func (v *BackgroundBlendModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundBlendModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundClipVal represents values for the background-clip property.
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-clip>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-clip
 type BackgroundClipVal string
 
 // BackgroundClipVal constants.
 const (
 	BackgroundClipValBorderArea BackgroundClipVal = "border-area"
+	BackgroundClipValBorderBox  BackgroundClipVal = "border-box"
+	BackgroundClipValContentBox BackgroundClipVal = "content-box"
+	BackgroundClipValPaddingBox BackgroundClipVal = "padding-box"
 	BackgroundClipValText       BackgroundClipVal = "text"
-	BackgroundClipValVisualBox  BackgroundClipVal = "visual-box"
 )
 
-// BackgroundColorVal represents values for the background-color property.
-type BackgroundColorVal string
-
-// BackgroundColorVal constants.
-const (
-	BackgroundColorValColorBase             BackgroundColorVal = "color-base"
-	BackgroundColorValDeprecatedSystemColor BackgroundColorVal = "deprecated-system-color"
-	BackgroundColorValLightDark             BackgroundColorVal = "light-dark"
-	BackgroundColorValSystemColor           BackgroundColorVal = "system-color"
-)
+func (v BackgroundClipVal) String() string { return string(v) }
+
+// Values returns every BackgroundClipVal constant.
+func (BackgroundClipVal) Values() []BackgroundClipVal {
+	return []BackgroundClipVal{
+		BackgroundClipValBorderArea,
+		BackgroundClipValBorderBox,
+		BackgroundClipValContentBox,
+		BackgroundClipValPaddingBox,
+		BackgroundClipValText,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundClipVal constants.
+func (v BackgroundClipVal) Valid() bool {
+	switch v {
+	case BackgroundClipValBorderArea,
+		BackgroundClipValBorderBox,
+		BackgroundClipValContentBox,
+		BackgroundClipValPaddingBox,
+		BackgroundClipValText:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundClipVal returns the BackgroundClipVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundClipVal(s string) (BackgroundClipVal, error) {
+	v := BackgroundClipVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundClipVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundClipVal constants.
+func (v BackgroundClipVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundClipVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundClipVal.
+func (v *BackgroundClipVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundClipVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BackgroundImageVal represents values for the background-image property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-image>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-image
 type BackgroundImageVal string
 
 // BackgroundImageVal constants.
@@ -353,7 +1962,63 @@ const (
 	BackgroundImageValNone BackgroundImageVal = "none"
 )
 
+func (v BackgroundImageVal) String() string { return string(v) }
+
+// Values returns every BackgroundImageVal constant.
+func (BackgroundImageVal) Values() []BackgroundImageVal {
+	return []BackgroundImageVal{
+		BackgroundImageValNone,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundImageVal constants.
+func (v BackgroundImageVal) Valid() bool {
+	switch v {
+	case BackgroundImageValNone:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundImageVal returns the BackgroundImageVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundImageVal(s string) (BackgroundImageVal, error) {
+	v := BackgroundImageVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundImageVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundImageVal constants.
+func (v BackgroundImageVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundImageVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundImageVal.
+func (v *BackgroundImageVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundImageVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundOriginVal represents values for the background-origin property.
+//
+//   - Initial: padding-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<visual-box>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-origin
 type BackgroundOriginVal string
 
 // BackgroundOriginVal constants.
@@ -363,20 +2028,143 @@ const (
 	BackgroundOriginValPaddingBox BackgroundOriginVal = "padding-box"
 )
 
+func (v BackgroundOriginVal) String() string { return string(v) }
+
+// Values returns every BackgroundOriginVal constant.
+func (BackgroundOriginVal) Values() []BackgroundOriginVal {
+	return []BackgroundOriginVal{
+		BackgroundOriginValBorderBox,
+		BackgroundOriginValContentBox,
+		BackgroundOriginValPaddingBox,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundOriginVal constants.
+func (v BackgroundOriginVal) Valid() bool {
+	switch v {
+	case BackgroundOriginValBorderBox,
+		BackgroundOriginValContentBox,
+		BackgroundOriginValPaddingBox:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundOriginVal returns the BackgroundOriginVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundOriginVal(s string) (BackgroundOriginVal, error) {
+	v := BackgroundOriginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundOriginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundOriginVal constants.
+func (v BackgroundOriginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundOriginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundOriginVal.
+func (v *BackgroundOriginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundOriginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundPositionVal represents values for the background-position property.
+//
+//   - Initial: 0% 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-position>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position
 type BackgroundPositionVal string
 
 // BackgroundPositionVal constants.
 const (
-	BackgroundPositionValBottom           BackgroundPositionVal = "bottom"
-	BackgroundPositionValCenter           BackgroundPositionVal = "center"
-	BackgroundPositionValLeft             BackgroundPositionVal = "left"
-	BackgroundPositionValLengthPercentage BackgroundPositionVal = "length-percentage"
-	BackgroundPositionValRight            BackgroundPositionVal = "right"
-	BackgroundPositionValTop              BackgroundPositionVal = "top"
-)
+	BackgroundPositionValBottom BackgroundPositionVal = "bottom"
+	BackgroundPositionValCenter BackgroundPositionVal = "center"
+	BackgroundPositionValLeft   BackgroundPositionVal = "left"
+	BackgroundPositionValRight  BackgroundPositionVal = "right"
+	BackgroundPositionValTop    BackgroundPositionVal = "top"
+)
+
+func (v BackgroundPositionVal) String() string { return string(v) }
+
+// Values returns every BackgroundPositionVal constant.
+func (BackgroundPositionVal) Values() []BackgroundPositionVal {
+	return []BackgroundPositionVal{
+		BackgroundPositionValBottom,
+		BackgroundPositionValCenter,
+		BackgroundPositionValLeft,
+		BackgroundPositionValRight,
+		BackgroundPositionValTop,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundPositionVal constants.
+func (v BackgroundPositionVal) Valid() bool {
+	switch v {
+	case BackgroundPositionValBottom,
+		BackgroundPositionValCenter,
+		BackgroundPositionValLeft,
+		BackgroundPositionValRight,
+		BackgroundPositionValTop:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundPositionVal returns the BackgroundPositionVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundPositionVal(s string) (BackgroundPositionVal, error) {
+	v := BackgroundPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundPositionVal constants.
+func (v BackgroundPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundPositionVal.
+func (v *BackgroundPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BackgroundPositionXVal represents values for the background-position-x property.
+//
+//   - Initial: 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-x
 type BackgroundPositionXVal string
 
 // BackgroundPositionXVal constants.
@@ -388,7 +2176,71 @@ const (
 	BackgroundPositionXValXStart BackgroundPositionXVal = "x-start"
 )
 
+func (v BackgroundPositionXVal) String() string { return string(v) }
+
+// Values returns every BackgroundPositionXVal constant.
+func (BackgroundPositionXVal) Values() []BackgroundPositionXVal {
+	return []BackgroundPositionXVal{
+		BackgroundPositionXValCenter,
+		BackgroundPositionXValLeft,
+		BackgroundPositionXValRight,
+		BackgroundPositionXValXEnd,
+		BackgroundPositionXValXStart,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundPositionXVal constants.
+func (v BackgroundPositionXVal) Valid() bool {
+	switch v {
+	case BackgroundPositionXValCenter,
+		BackgroundPositionXValLeft,
+		BackgroundPositionXValRight,
+		BackgroundPositionXValXEnd,
+		BackgroundPositionXValXStart:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundPositionXVal returns the BackgroundPositionXVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundPositionXVal(s string) (BackgroundPositionXVal, error) {
+	v := BackgroundPositionXVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundPositionXVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundPositionXVal constants.
+func (v BackgroundPositionXVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundPositionXVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundPositionXVal.
+func (v *BackgroundPositionXVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundPositionXVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundPositionYVal represents values for the background-position-y property.
+//
+//   - Initial: 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-y
 type BackgroundPositionYVal string
 
 // BackgroundPositionYVal constants.
@@ -400,13 +2252,75 @@ const (
 	BackgroundPositionYValYStart BackgroundPositionYVal = "y-start"
 )
 
+func (v BackgroundPositionYVal) String() string { return string(v) }
+
+// Values returns every BackgroundPositionYVal constant.
+func (BackgroundPositionYVal) Values() []BackgroundPositionYVal {
+	return []BackgroundPositionYVal{
+		BackgroundPositionYValBottom,
+		BackgroundPositionYValCenter,
+		BackgroundPositionYValTop,
+		BackgroundPositionYValYEnd,
+		BackgroundPositionYValYStart,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundPositionYVal constants.
+func (v BackgroundPositionYVal) Valid() bool {
+	switch v {
+	case BackgroundPositionYValBottom,
+		BackgroundPositionYValCenter,
+		BackgroundPositionYValTop,
+		BackgroundPositionYValYEnd,
+		BackgroundPositionYValYStart:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundPositionYVal returns the BackgroundPositionYVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundPositionYVal(s string) (BackgroundPositionYVal, error) {
+	v := BackgroundPositionYVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundPositionYVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundPositionYVal constants.
+func (v BackgroundPositionYVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundPositionYVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundPositionYVal.
+func (v *BackgroundPositionYVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundPositionYVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundRepeatVal represents values for the background-repeat property.
+//
+//   - Initial: repeat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<repeat-style>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-repeat
 type BackgroundRepeatVal string
 
 // BackgroundRepeatVal constants.
 const (
-	BackgroundRepeatVal1        BackgroundRepeatVal = "1"
-	BackgroundRepeatVal2        BackgroundRepeatVal = "2"
 	BackgroundRepeatValNoRepeat BackgroundRepeatVal = "no-repeat"
 	BackgroundRepeatValRepeat   BackgroundRepeatVal = "repeat"
 	BackgroundRepeatValRepeatX  BackgroundRepeatVal = "repeat-x"
@@ -415,21 +2329,141 @@ const (
 	BackgroundRepeatValSpace    BackgroundRepeatVal = "space"
 )
 
+func (v BackgroundRepeatVal) String() string { return string(v) }
+
+// Values returns every BackgroundRepeatVal constant.
+func (BackgroundRepeatVal) Values() []BackgroundRepeatVal {
+	return []BackgroundRepeatVal{
+		BackgroundRepeatValNoRepeat,
+		BackgroundRepeatValRepeat,
+		BackgroundRepeatValRepeatX,
+		BackgroundRepeatValRepeatY,
+		BackgroundRepeatValRound,
+		BackgroundRepeatValSpace,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundRepeatVal constants.
+func (v BackgroundRepeatVal) Valid() bool {
+	switch v {
+	case BackgroundRepeatValNoRepeat,
+		BackgroundRepeatValRepeat,
+		BackgroundRepeatValRepeatX,
+		BackgroundRepeatValRepeatY,
+		BackgroundRepeatValRound,
+		BackgroundRepeatValSpace:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundRepeatVal returns the BackgroundRepeatVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundRepeatVal(s string) (BackgroundRepeatVal, error) {
+	v := BackgroundRepeatVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundRepeatVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundRepeatVal constants.
+func (v BackgroundRepeatVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundRepeatVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundRepeatVal.
+func (v *BackgroundRepeatVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundRepeatVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BackgroundSizeVal represents values for the background-size property.
+//
+//   - Initial: auto auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-size>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-size
 type BackgroundSizeVal string
 
 // BackgroundSizeVal constants.
 const (
-	BackgroundSizeVal0                BackgroundSizeVal = "0"
-	BackgroundSizeVal1                BackgroundSizeVal = "1"
-	BackgroundSizeVal2                BackgroundSizeVal = "2"
-	BackgroundSizeValAuto             BackgroundSizeVal = "auto"
-	BackgroundSizeValContain          BackgroundSizeVal = "contain"
-	BackgroundSizeValCover            BackgroundSizeVal = "cover"
-	BackgroundSizeValLengthPercentage BackgroundSizeVal = "length-percentage"
-)
+	BackgroundSizeValAuto    BackgroundSizeVal = "auto"
+	BackgroundSizeValContain BackgroundSizeVal = "contain"
+	BackgroundSizeValCover   BackgroundSizeVal = "cover"
+)
+
+func (v BackgroundSizeVal) String() string { return string(v) }
+
+// Values returns every BackgroundSizeVal constant.
+func (BackgroundSizeVal) Values() []BackgroundSizeVal {
+	return []BackgroundSizeVal{
+		BackgroundSizeValAuto,
+		BackgroundSizeValContain,
+		BackgroundSizeValCover,
+	}
+}
+
+// Valid reports whether v is one of the BackgroundSizeVal constants.
+func (v BackgroundSizeVal) Valid() bool {
+	switch v {
+	case BackgroundSizeValAuto,
+		BackgroundSizeValContain,
+		BackgroundSizeValCover:
+		return true
+	}
+	return false
+}
+
+// ParseBackgroundSizeVal returns the BackgroundSizeVal constant for a keyword, ignoring ASCII case.
+func ParseBackgroundSizeVal(s string) (BackgroundSizeVal, error) {
+	v := BackgroundSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BackgroundSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BackgroundSizeVal constants.
+func (v BackgroundSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BackgroundSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBackgroundSizeVal.
+func (v *BackgroundSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBackgroundSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BaselineShiftVal represents values for the baseline-shift property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | sub | super | baseline
 type BaselineShiftVal string
 
 // BaselineShiftVal constants.
@@ -439,205 +2473,1123 @@ const (
 	BaselineShiftValSuper    BaselineShiftVal = "super"
 )
 
+func (v BaselineShiftVal) String() string { return string(v) }
+
+// Values returns every BaselineShiftVal constant.
+func (BaselineShiftVal) Values() []BaselineShiftVal {
+	return []BaselineShiftVal{
+		BaselineShiftValBaseline,
+		BaselineShiftValSub,
+		BaselineShiftValSuper,
+	}
+}
+
+// Valid reports whether v is one of the BaselineShiftVal constants.
+func (v BaselineShiftVal) Valid() bool {
+	switch v {
+	case BaselineShiftValBaseline,
+		BaselineShiftValSub,
+		BaselineShiftValSuper:
+		return true
+	}
+	return false
+}
+
+// ParseBaselineShiftVal returns the BaselineShiftVal constant for a keyword, ignoring ASCII case.
+func ParseBaselineShiftVal(s string) (BaselineShiftVal, error) {
+	v := BaselineShiftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BaselineShiftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BaselineShiftVal constants.
+func (v BaselineShiftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BaselineShiftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBaselineShiftVal.
+func (v *BaselineShiftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBaselineShiftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BlockSizeVal represents values for the block-size property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/block-size
 type BlockSizeVal string
 
 // BlockSizeVal constants.
 const (
-	BlockSizeValWidth BlockSizeVal = "width"
-)
+	BlockSizeValAuto       BlockSizeVal = "auto"
+	BlockSizeValFitContent BlockSizeVal = "fit-content"
+	BlockSizeValMaxContent BlockSizeVal = "max-content"
+	BlockSizeValMinContent BlockSizeVal = "min-content"
+)
+
+func (v BlockSizeVal) String() string { return string(v) }
+
+// Values returns every BlockSizeVal constant.
+func (BlockSizeVal) Values() []BlockSizeVal {
+	return []BlockSizeVal{
+		BlockSizeValAuto,
+		BlockSizeValFitContent,
+		BlockSizeValMaxContent,
+		BlockSizeValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the BlockSizeVal constants.
+func (v BlockSizeVal) Valid() bool {
+	switch v {
+	case BlockSizeValAuto,
+		BlockSizeValFitContent,
+		BlockSizeValMaxContent,
+		BlockSizeValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseBlockSizeVal returns the BlockSizeVal constant for a keyword, ignoring ASCII case.
+func ParseBlockSizeVal(s string) (BlockSizeVal, error) {
+	v := BlockSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BlockSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BlockSizeVal constants.
+func (v BlockSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BlockSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBlockSizeVal.
+func (v *BlockSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBlockSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderVal represents values for the border property.
+//
+//   - Shorthand for: border-color, border-style, border-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border
 type BorderVal string
 
 // BorderVal constants.
 const (
-	BorderValColorBase             BorderVal = "color-base"
-	BorderValDashed                BorderVal = "dashed"
-	BorderValDeprecatedSystemColor BorderVal = "deprecated-system-color"
-	BorderValDotted                BorderVal = "dotted"
-	BorderValDouble                BorderVal = "double"
-	BorderValGroove                BorderVal = "groove"
-	BorderValHidden                BorderVal = "hidden"
-	BorderValInset                 BorderVal = "inset"
-	BorderValLightDark             BorderVal = "light-dark"
-	BorderValMedium                BorderVal = "medium"
-	BorderValNone                  BorderVal = "none"
-	BorderValOutset                BorderVal = "outset"
-	BorderValRidge                 BorderVal = "ridge"
-	BorderValSolid                 BorderVal = "solid"
-	BorderValSystemColor           BorderVal = "system-color"
-	BorderValThick                 BorderVal = "thick"
-	BorderValThin                  BorderVal = "thin"
-)
+	BorderValDashed BorderVal = "dashed"
+	BorderValDotted BorderVal = "dotted"
+	BorderValDouble BorderVal = "double"
+	BorderValGroove BorderVal = "groove"
+	BorderValHidden BorderVal = "hidden"
+	BorderValInset  BorderVal = "inset"
+	BorderValMedium BorderVal = "medium"
+	BorderValNone   BorderVal = "none"
+	BorderValOutset BorderVal = "outset"
+	BorderValRidge  BorderVal = "ridge"
+	BorderValSolid  BorderVal = "solid"
+	BorderValThick  BorderVal = "thick"
+	BorderValThin   BorderVal = "thin"
+)
+
+func (v BorderVal) String() string { return string(v) }
+
+// Values returns every BorderVal constant.
+func (BorderVal) Values() []BorderVal {
+	return []BorderVal{
+		BorderValDashed,
+		BorderValDotted,
+		BorderValDouble,
+		BorderValGroove,
+		BorderValHidden,
+		BorderValInset,
+		BorderValMedium,
+		BorderValNone,
+		BorderValOutset,
+		BorderValRidge,
+		BorderValSolid,
+		BorderValThick,
+		BorderValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderVal constants.
+func (v BorderVal) Valid() bool {
+	switch v {
+	case BorderValDashed,
+		BorderValDotted,
+		BorderValDouble,
+		BorderValGroove,
+		BorderValHidden,
+		BorderValInset,
+		BorderValMedium,
+		BorderValNone,
+		BorderValOutset,
+		BorderValRidge,
+		BorderValSolid,
+		BorderValThick,
+		BorderValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderVal returns the BorderVal constant for a keyword, ignoring ASCII case.
+func ParseBorderVal(s string) (BorderVal, error) {
+	v := BorderVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderVal constants.
+func (v BorderVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderVal.
+func (v *BorderVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockVal represents values for the border-block property.
+//
+//   - Shorthand for: border-block-color, border-block-style, border-block-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-block-start'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block
 type BorderBlockVal string
 
 // BorderBlockVal constants.
 const (
-	BorderBlockValBorderBlockStart BorderBlockVal = "border-block-start"
-)
-
-// BorderBlockColorVal represents values for the border-block-color property.
-type BorderBlockColorVal string
-
-// BorderBlockColorVal constants.
-const (
-	BorderBlockColorVal1              BorderBlockColorVal = "1"
-	BorderBlockColorVal2              BorderBlockColorVal = "2"
-	BorderBlockColorValBorderTopColor BorderBlockColorVal = "border-top-color"
-)
+	BorderBlockValDashed BorderBlockVal = "dashed"
+	BorderBlockValDotted BorderBlockVal = "dotted"
+	BorderBlockValDouble BorderBlockVal = "double"
+	BorderBlockValGroove BorderBlockVal = "groove"
+	BorderBlockValHidden BorderBlockVal = "hidden"
+	BorderBlockValInset  BorderBlockVal = "inset"
+	BorderBlockValMedium BorderBlockVal = "medium"
+	BorderBlockValNone   BorderBlockVal = "none"
+	BorderBlockValOutset BorderBlockVal = "outset"
+	BorderBlockValRidge  BorderBlockVal = "ridge"
+	BorderBlockValSolid  BorderBlockVal = "solid"
+	BorderBlockValThick  BorderBlockVal = "thick"
+	BorderBlockValThin   BorderBlockVal = "thin"
+)
+
+func (v BorderBlockVal) String() string { return string(v) }
+
+// Values returns every BorderBlockVal constant.
+func (BorderBlockVal) Values() []BorderBlockVal {
+	return []BorderBlockVal{
+		BorderBlockValDashed,
+		BorderBlockValDotted,
+		BorderBlockValDouble,
+		BorderBlockValGroove,
+		BorderBlockValHidden,
+		BorderBlockValInset,
+		BorderBlockValMedium,
+		BorderBlockValNone,
+		BorderBlockValOutset,
+		BorderBlockValRidge,
+		BorderBlockValSolid,
+		BorderBlockValThick,
+		BorderBlockValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockVal constants.
+func (v BorderBlockVal) Valid() bool {
+	switch v {
+	case BorderBlockValDashed,
+		BorderBlockValDotted,
+		BorderBlockValDouble,
+		BorderBlockValGroove,
+		BorderBlockValHidden,
+		BorderBlockValInset,
+		BorderBlockValMedium,
+		BorderBlockValNone,
+		BorderBlockValOutset,
+		BorderBlockValRidge,
+		BorderBlockValSolid,
+		BorderBlockValThick,
+		BorderBlockValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockVal returns the BorderBlockVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockVal(s string) (BorderBlockVal, error) {
+	v := BorderBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockVal constants.
+func (v BorderBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockVal.
+func (v *BorderBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockEndVal represents values for the border-block-end property.
+//
+//   - Shorthand for: border-top-color, border-top-style, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end
 type BorderBlockEndVal string
 
 // BorderBlockEndVal constants.
 const (
-	BorderBlockEndValBorderTopStyle        BorderBlockEndVal = "border-top-style"
-	BorderBlockEndValBorderTopWidth        BorderBlockEndVal = "border-top-width"
-	BorderBlockEndValColorBase             BorderBlockEndVal = "color-base"
-	BorderBlockEndValDeprecatedSystemColor BorderBlockEndVal = "deprecated-system-color"
-	BorderBlockEndValLightDark             BorderBlockEndVal = "light-dark"
-	BorderBlockEndValSystemColor           BorderBlockEndVal = "system-color"
-)
-
-// BorderBlockEndColorVal represents values for the border-block-end-color property.
-type BorderBlockEndColorVal string
-
-// BorderBlockEndColorVal constants.
-const (
-	BorderBlockEndColorValBorderTopColor BorderBlockEndColorVal = "border-top-color"
-)
+	BorderBlockEndValDashed BorderBlockEndVal = "dashed"
+	BorderBlockEndValDotted BorderBlockEndVal = "dotted"
+	BorderBlockEndValDouble BorderBlockEndVal = "double"
+	BorderBlockEndValGroove BorderBlockEndVal = "groove"
+	BorderBlockEndValHidden BorderBlockEndVal = "hidden"
+	BorderBlockEndValInset  BorderBlockEndVal = "inset"
+	BorderBlockEndValMedium BorderBlockEndVal = "medium"
+	BorderBlockEndValNone   BorderBlockEndVal = "none"
+	BorderBlockEndValOutset BorderBlockEndVal = "outset"
+	BorderBlockEndValRidge  BorderBlockEndVal = "ridge"
+	BorderBlockEndValSolid  BorderBlockEndVal = "solid"
+	BorderBlockEndValThick  BorderBlockEndVal = "thick"
+	BorderBlockEndValThin   BorderBlockEndVal = "thin"
+)
+
+func (v BorderBlockEndVal) String() string { return string(v) }
+
+// Values returns every BorderBlockEndVal constant.
+func (BorderBlockEndVal) Values() []BorderBlockEndVal {
+	return []BorderBlockEndVal{
+		BorderBlockEndValDashed,
+		BorderBlockEndValDotted,
+		BorderBlockEndValDouble,
+		BorderBlockEndValGroove,
+		BorderBlockEndValHidden,
+		BorderBlockEndValInset,
+		BorderBlockEndValMedium,
+		BorderBlockEndValNone,
+		BorderBlockEndValOutset,
+		BorderBlockEndValRidge,
+		BorderBlockEndValSolid,
+		BorderBlockEndValThick,
+		BorderBlockEndValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockEndVal constants.
+func (v BorderBlockEndVal) Valid() bool {
+	switch v {
+	case BorderBlockEndValDashed,
+		BorderBlockEndValDotted,
+		BorderBlockEndValDouble,
+		BorderBlockEndValGroove,
+		BorderBlockEndValHidden,
+		BorderBlockEndValInset,
+		BorderBlockEndValMedium,
+		BorderBlockEndValNone,
+		BorderBlockEndValOutset,
+		BorderBlockEndValRidge,
+		BorderBlockEndValSolid,
+		BorderBlockEndValThick,
+		BorderBlockEndValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockEndVal returns the BorderBlockEndVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockEndVal(s string) (BorderBlockEndVal, error) {
+	v := BorderBlockEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockEndVal constants.
+func (v BorderBlockEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockEndVal.
+func (v *BorderBlockEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockEndStyleVal represents values for the border-block-end-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-style
 type BorderBlockEndStyleVal string
 
 // BorderBlockEndStyleVal constants.
 const (
-	BorderBlockEndStyleValBorderTopStyle BorderBlockEndStyleVal = "border-top-style"
-)
+	BorderBlockEndStyleValDashed BorderBlockEndStyleVal = "dashed"
+	BorderBlockEndStyleValDotted BorderBlockEndStyleVal = "dotted"
+	BorderBlockEndStyleValDouble BorderBlockEndStyleVal = "double"
+	BorderBlockEndStyleValGroove BorderBlockEndStyleVal = "groove"
+	BorderBlockEndStyleValHidden BorderBlockEndStyleVal = "hidden"
+	BorderBlockEndStyleValInset  BorderBlockEndStyleVal = "inset"
+	BorderBlockEndStyleValNone   BorderBlockEndStyleVal = "none"
+	BorderBlockEndStyleValOutset BorderBlockEndStyleVal = "outset"
+	BorderBlockEndStyleValRidge  BorderBlockEndStyleVal = "ridge"
+	BorderBlockEndStyleValSolid  BorderBlockEndStyleVal = "solid"
+)
+
+func (v BorderBlockEndStyleVal) String() string { return string(v) }
+
+// Values returns every BorderBlockEndStyleVal constant.
+func (BorderBlockEndStyleVal) Values() []BorderBlockEndStyleVal {
+	return []BorderBlockEndStyleVal{
+		BorderBlockEndStyleValDashed,
+		BorderBlockEndStyleValDotted,
+		BorderBlockEndStyleValDouble,
+		BorderBlockEndStyleValGroove,
+		BorderBlockEndStyleValHidden,
+		BorderBlockEndStyleValInset,
+		BorderBlockEndStyleValNone,
+		BorderBlockEndStyleValOutset,
+		BorderBlockEndStyleValRidge,
+		BorderBlockEndStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockEndStyleVal constants.
+func (v BorderBlockEndStyleVal) Valid() bool {
+	switch v {
+	case BorderBlockEndStyleValDashed,
+		BorderBlockEndStyleValDotted,
+		BorderBlockEndStyleValDouble,
+		BorderBlockEndStyleValGroove,
+		BorderBlockEndStyleValHidden,
+		BorderBlockEndStyleValInset,
+		BorderBlockEndStyleValNone,
+		BorderBlockEndStyleValOutset,
+		BorderBlockEndStyleValRidge,
+		BorderBlockEndStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockEndStyleVal returns the BorderBlockEndStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockEndStyleVal(s string) (BorderBlockEndStyleVal, error) {
+	v := BorderBlockEndStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockEndStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockEndStyleVal constants.
+func (v BorderBlockEndStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockEndStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockEndStyleVal.
+func (v *BorderBlockEndStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockEndStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockEndWidthVal represents values for the border-block-end-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-width
 type BorderBlockEndWidthVal string
 
 // BorderBlockEndWidthVal constants.
 const (
-	BorderBlockEndWidthValBorderTopWidth BorderBlockEndWidthVal = "border-top-width"
-)
+	BorderBlockEndWidthValMedium BorderBlockEndWidthVal = "medium"
+	BorderBlockEndWidthValThick  BorderBlockEndWidthVal = "thick"
+	BorderBlockEndWidthValThin   BorderBlockEndWidthVal = "thin"
+)
+
+func (v BorderBlockEndWidthVal) String() string { return string(v) }
+
+// Values returns every BorderBlockEndWidthVal constant.
+func (BorderBlockEndWidthVal) Values() []BorderBlockEndWidthVal {
+	return []BorderBlockEndWidthVal{
+		BorderBlockEndWidthValMedium,
+		BorderBlockEndWidthValThick,
+		BorderBlockEndWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockEndWidthVal constants.
+func (v BorderBlockEndWidthVal) Valid() bool {
+	switch v {
+	case BorderBlockEndWidthValMedium,
+		BorderBlockEndWidthValThick,
+		BorderBlockEndWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockEndWidthVal returns the BorderBlockEndWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockEndWidthVal(s string) (BorderBlockEndWidthVal, error) {
+	v := BorderBlockEndWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockEndWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockEndWidthVal constants.
+func (v BorderBlockEndWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockEndWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockEndWidthVal.
+func (v *BorderBlockEndWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockEndWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockStartVal represents values for the border-block-start property.
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start
 type BorderBlockStartVal string
 
 // BorderBlockStartVal constants.
 const (
-	BorderBlockStartValBorderTopStyle        BorderBlockStartVal = "border-top-style"
-	BorderBlockStartValBorderTopWidth        BorderBlockStartVal = "border-top-width"
-	BorderBlockStartValColorBase             BorderBlockStartVal = "color-base"
-	BorderBlockStartValDeprecatedSystemColor BorderBlockStartVal = "deprecated-system-color"
-	BorderBlockStartValLightDark             BorderBlockStartVal = "light-dark"
-	BorderBlockStartValSystemColor           BorderBlockStartVal = "system-color"
-)
-
-// BorderBlockStartColorVal represents values for the border-block-start-color property.
-type BorderBlockStartColorVal string
-
-// BorderBlockStartColorVal constants.
-const (
-	BorderBlockStartColorValBorderTopColor BorderBlockStartColorVal = "border-top-color"
-)
+	BorderBlockStartValDashed BorderBlockStartVal = "dashed"
+	BorderBlockStartValDotted BorderBlockStartVal = "dotted"
+	BorderBlockStartValDouble BorderBlockStartVal = "double"
+	BorderBlockStartValGroove BorderBlockStartVal = "groove"
+	BorderBlockStartValHidden BorderBlockStartVal = "hidden"
+	BorderBlockStartValInset  BorderBlockStartVal = "inset"
+	BorderBlockStartValMedium BorderBlockStartVal = "medium"
+	BorderBlockStartValNone   BorderBlockStartVal = "none"
+	BorderBlockStartValOutset BorderBlockStartVal = "outset"
+	BorderBlockStartValRidge  BorderBlockStartVal = "ridge"
+	BorderBlockStartValSolid  BorderBlockStartVal = "solid"
+	BorderBlockStartValThick  BorderBlockStartVal = "thick"
+	BorderBlockStartValThin   BorderBlockStartVal = "thin"
+)
+
+func (v BorderBlockStartVal) String() string { return string(v) }
+
+// Values returns every BorderBlockStartVal constant.
+func (BorderBlockStartVal) Values() []BorderBlockStartVal {
+	return []BorderBlockStartVal{
+		BorderBlockStartValDashed,
+		BorderBlockStartValDotted,
+		BorderBlockStartValDouble,
+		BorderBlockStartValGroove,
+		BorderBlockStartValHidden,
+		BorderBlockStartValInset,
+		BorderBlockStartValMedium,
+		BorderBlockStartValNone,
+		BorderBlockStartValOutset,
+		BorderBlockStartValRidge,
+		BorderBlockStartValSolid,
+		BorderBlockStartValThick,
+		BorderBlockStartValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockStartVal constants.
+func (v BorderBlockStartVal) Valid() bool {
+	switch v {
+	case BorderBlockStartValDashed,
+		BorderBlockStartValDotted,
+		BorderBlockStartValDouble,
+		BorderBlockStartValGroove,
+		BorderBlockStartValHidden,
+		BorderBlockStartValInset,
+		BorderBlockStartValMedium,
+		BorderBlockStartValNone,
+		BorderBlockStartValOutset,
+		BorderBlockStartValRidge,
+		BorderBlockStartValSolid,
+		BorderBlockStartValThick,
+		BorderBlockStartValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockStartVal returns the BorderBlockStartVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockStartVal(s string) (BorderBlockStartVal, error) {
+	v := BorderBlockStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockStartVal constants.
+func (v BorderBlockStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockStartVal.
+func (v *BorderBlockStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockStartStyleVal represents values for the border-block-start-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-style
 type BorderBlockStartStyleVal string
 
 // BorderBlockStartStyleVal constants.
 const (
-	BorderBlockStartStyleValBorderTopStyle BorderBlockStartStyleVal = "border-top-style"
-)
+	BorderBlockStartStyleValDashed BorderBlockStartStyleVal = "dashed"
+	BorderBlockStartStyleValDotted BorderBlockStartStyleVal = "dotted"
+	BorderBlockStartStyleValDouble BorderBlockStartStyleVal = "double"
+	BorderBlockStartStyleValGroove BorderBlockStartStyleVal = "groove"
+	BorderBlockStartStyleValHidden BorderBlockStartStyleVal = "hidden"
+	BorderBlockStartStyleValInset  BorderBlockStartStyleVal = "inset"
+	BorderBlockStartStyleValNone   BorderBlockStartStyleVal = "none"
+	BorderBlockStartStyleValOutset BorderBlockStartStyleVal = "outset"
+	BorderBlockStartStyleValRidge  BorderBlockStartStyleVal = "ridge"
+	BorderBlockStartStyleValSolid  BorderBlockStartStyleVal = "solid"
+)
+
+func (v BorderBlockStartStyleVal) String() string { return string(v) }
+
+// Values returns every BorderBlockStartStyleVal constant.
+func (BorderBlockStartStyleVal) Values() []BorderBlockStartStyleVal {
+	return []BorderBlockStartStyleVal{
+		BorderBlockStartStyleValDashed,
+		BorderBlockStartStyleValDotted,
+		BorderBlockStartStyleValDouble,
+		BorderBlockStartStyleValGroove,
+		BorderBlockStartStyleValHidden,
+		BorderBlockStartStyleValInset,
+		BorderBlockStartStyleValNone,
+		BorderBlockStartStyleValOutset,
+		BorderBlockStartStyleValRidge,
+		BorderBlockStartStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockStartStyleVal constants.
+func (v BorderBlockStartStyleVal) Valid() bool {
+	switch v {
+	case BorderBlockStartStyleValDashed,
+		BorderBlockStartStyleValDotted,
+		BorderBlockStartStyleValDouble,
+		BorderBlockStartStyleValGroove,
+		BorderBlockStartStyleValHidden,
+		BorderBlockStartStyleValInset,
+		BorderBlockStartStyleValNone,
+		BorderBlockStartStyleValOutset,
+		BorderBlockStartStyleValRidge,
+		BorderBlockStartStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockStartStyleVal returns the BorderBlockStartStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockStartStyleVal(s string) (BorderBlockStartStyleVal, error) {
+	v := BorderBlockStartStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockStartStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockStartStyleVal constants.
+func (v BorderBlockStartStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockStartStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockStartStyleVal.
+func (v *BorderBlockStartStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockStartStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockStartWidthVal represents values for the border-block-start-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-width
 type BorderBlockStartWidthVal string
 
 // BorderBlockStartWidthVal constants.
 const (
-	BorderBlockStartWidthValBorderTopWidth BorderBlockStartWidthVal = "border-top-width"
-)
+	BorderBlockStartWidthValMedium BorderBlockStartWidthVal = "medium"
+	BorderBlockStartWidthValThick  BorderBlockStartWidthVal = "thick"
+	BorderBlockStartWidthValThin   BorderBlockStartWidthVal = "thin"
+)
+
+func (v BorderBlockStartWidthVal) String() string { return string(v) }
+
+// Values returns every BorderBlockStartWidthVal constant.
+func (BorderBlockStartWidthVal) Values() []BorderBlockStartWidthVal {
+	return []BorderBlockStartWidthVal{
+		BorderBlockStartWidthValMedium,
+		BorderBlockStartWidthValThick,
+		BorderBlockStartWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockStartWidthVal constants.
+func (v BorderBlockStartWidthVal) Valid() bool {
+	switch v {
+	case BorderBlockStartWidthValMedium,
+		BorderBlockStartWidthValThick,
+		BorderBlockStartWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockStartWidthVal returns the BorderBlockStartWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockStartWidthVal(s string) (BorderBlockStartWidthVal, error) {
+	v := BorderBlockStartWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockStartWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockStartWidthVal constants.
+func (v BorderBlockStartWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockStartWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockStartWidthVal.
+func (v *BorderBlockStartWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockStartWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockStyleVal represents values for the border-block-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-style
 type BorderBlockStyleVal string
 
 // BorderBlockStyleVal constants.
 const (
-	BorderBlockStyleVal1              BorderBlockStyleVal = "1"
-	BorderBlockStyleVal2              BorderBlockStyleVal = "2"
-	BorderBlockStyleValBorderTopStyle BorderBlockStyleVal = "border-top-style"
-)
+	BorderBlockStyleValDashed BorderBlockStyleVal = "dashed"
+	BorderBlockStyleValDotted BorderBlockStyleVal = "dotted"
+	BorderBlockStyleValDouble BorderBlockStyleVal = "double"
+	BorderBlockStyleValGroove BorderBlockStyleVal = "groove"
+	BorderBlockStyleValHidden BorderBlockStyleVal = "hidden"
+	BorderBlockStyleValInset  BorderBlockStyleVal = "inset"
+	BorderBlockStyleValNone   BorderBlockStyleVal = "none"
+	BorderBlockStyleValOutset BorderBlockStyleVal = "outset"
+	BorderBlockStyleValRidge  BorderBlockStyleVal = "ridge"
+	BorderBlockStyleValSolid  BorderBlockStyleVal = "solid"
+)
+
+func (v BorderBlockStyleVal) String() string { return string(v) }
+
+// Values returns every BorderBlockStyleVal constant.
+func (BorderBlockStyleVal) Values() []BorderBlockStyleVal {
+	return []BorderBlockStyleVal{
+		BorderBlockStyleValDashed,
+		BorderBlockStyleValDotted,
+		BorderBlockStyleValDouble,
+		BorderBlockStyleValGroove,
+		BorderBlockStyleValHidden,
+		BorderBlockStyleValInset,
+		BorderBlockStyleValNone,
+		BorderBlockStyleValOutset,
+		BorderBlockStyleValRidge,
+		BorderBlockStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockStyleVal constants.
+func (v BorderBlockStyleVal) Valid() bool {
+	switch v {
+	case BorderBlockStyleValDashed,
+		BorderBlockStyleValDotted,
+		BorderBlockStyleValDouble,
+		BorderBlockStyleValGroove,
+		BorderBlockStyleValHidden,
+		BorderBlockStyleValInset,
+		BorderBlockStyleValNone,
+		BorderBlockStyleValOutset,
+		BorderBlockStyleValRidge,
+		BorderBlockStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockStyleVal returns the BorderBlockStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockStyleVal(s string) (BorderBlockStyleVal, error) {
+	v := BorderBlockStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockStyleVal constants.
+func (v BorderBlockStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockStyleVal.
+func (v *BorderBlockStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBlockWidthVal represents values for the border-block-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-width
 type BorderBlockWidthVal string
 
 // BorderBlockWidthVal constants.
 const (
-	BorderBlockWidthVal1              BorderBlockWidthVal = "1"
-	BorderBlockWidthVal2              BorderBlockWidthVal = "2"
-	BorderBlockWidthValBorderTopWidth BorderBlockWidthVal = "border-top-width"
-)
+	BorderBlockWidthValMedium BorderBlockWidthVal = "medium"
+	BorderBlockWidthValThick  BorderBlockWidthVal = "thick"
+	BorderBlockWidthValThin   BorderBlockWidthVal = "thin"
+)
+
+func (v BorderBlockWidthVal) String() string { return string(v) }
+
+// Values returns every BorderBlockWidthVal constant.
+func (BorderBlockWidthVal) Values() []BorderBlockWidthVal {
+	return []BorderBlockWidthVal{
+		BorderBlockWidthValMedium,
+		BorderBlockWidthValThick,
+		BorderBlockWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBlockWidthVal constants.
+func (v BorderBlockWidthVal) Valid() bool {
+	switch v {
+	case BorderBlockWidthValMedium,
+		BorderBlockWidthValThick,
+		BorderBlockWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBlockWidthVal returns the BorderBlockWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBlockWidthVal(s string) (BorderBlockWidthVal, error) {
+	v := BorderBlockWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBlockWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBlockWidthVal constants.
+func (v BorderBlockWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBlockWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBlockWidthVal.
+func (v *BorderBlockWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBlockWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBottomVal represents values for the border-bottom property.
+//
+//   - Shorthand for: border-bottom-color, border-bottom-style, border-bottom-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom
 type BorderBottomVal string
 
 // BorderBottomVal constants.
 const (
-	BorderBottomValColorBase             BorderBottomVal = "color-base"
-	BorderBottomValDashed                BorderBottomVal = "dashed"
-	BorderBottomValDeprecatedSystemColor BorderBottomVal = "deprecated-system-color"
-	BorderBottomValDotted                BorderBottomVal = "dotted"
-	BorderBottomValDouble                BorderBottomVal = "double"
-	BorderBottomValGroove                BorderBottomVal = "groove"
-	BorderBottomValHidden                BorderBottomVal = "hidden"
-	BorderBottomValInset                 BorderBottomVal = "inset"
-	BorderBottomValLightDark             BorderBottomVal = "light-dark"
-	BorderBottomValMedium                BorderBottomVal = "medium"
-	BorderBottomValNone                  BorderBottomVal = "none"
-	BorderBottomValOutset                BorderBottomVal = "outset"
-	BorderBottomValRidge                 BorderBottomVal = "ridge"
-	BorderBottomValSolid                 BorderBottomVal = "solid"
-	BorderBottomValSystemColor           BorderBottomVal = "system-color"
-	BorderBottomValThick                 BorderBottomVal = "thick"
-	BorderBottomValThin                  BorderBottomVal = "thin"
-)
-
-// BorderBottomColorVal represents values for the border-bottom-color property.
-type BorderBottomColorVal string
-
-// BorderBottomColorVal constants.
-const (
-	BorderBottomColorValBorderTopColor BorderBottomColorVal = "border-top-color"
-)
-
-// BorderBottomLeftRadiusVal represents values for the border-bottom-left-radius property.
-type BorderBottomLeftRadiusVal string
-
-// BorderBottomLeftRadiusVal constants.
-const (
-	BorderBottomLeftRadiusVal0                BorderBottomLeftRadiusVal = "0"
-	BorderBottomLeftRadiusVal1                BorderBottomLeftRadiusVal = "1"
-	BorderBottomLeftRadiusVal2                BorderBottomLeftRadiusVal = "2"
-	BorderBottomLeftRadiusValLengthPercentage BorderBottomLeftRadiusVal = "length-percentage"
-)
-
-// BorderBottomRightRadiusVal represents values for the border-bottom-right-radius property.
-type BorderBottomRightRadiusVal string
-
-// BorderBottomRightRadiusVal constants.
-const (
-	BorderBottomRightRadiusVal0                BorderBottomRightRadiusVal = "0"
-	BorderBottomRightRadiusVal1                BorderBottomRightRadiusVal = "1"
-	BorderBottomRightRadiusVal2                BorderBottomRightRadiusVal = "2"
-	BorderBottomRightRadiusValLengthPercentage BorderBottomRightRadiusVal = "length-percentage"
-)
+	BorderBottomValDashed BorderBottomVal = "dashed"
+	BorderBottomValDotted BorderBottomVal = "dotted"
+	BorderBottomValDouble BorderBottomVal = "double"
+	BorderBottomValGroove BorderBottomVal = "groove"
+	BorderBottomValHidden BorderBottomVal = "hidden"
+	BorderBottomValInset  BorderBottomVal = "inset"
+	BorderBottomValMedium BorderBottomVal = "medium"
+	BorderBottomValNone   BorderBottomVal = "none"
+	BorderBottomValOutset BorderBottomVal = "outset"
+	BorderBottomValRidge  BorderBottomVal = "ridge"
+	BorderBottomValSolid  BorderBottomVal = "solid"
+	BorderBottomValThick  BorderBottomVal = "thick"
+	BorderBottomValThin   BorderBottomVal = "thin"
+)
+
+func (v BorderBottomVal) String() string { return string(v) }
+
+// Values returns every BorderBottomVal constant.
+func (BorderBottomVal) Values() []BorderBottomVal {
+	return []BorderBottomVal{
+		BorderBottomValDashed,
+		BorderBottomValDotted,
+		BorderBottomValDouble,
+		BorderBottomValGroove,
+		BorderBottomValHidden,
+		BorderBottomValInset,
+		BorderBottomValMedium,
+		BorderBottomValNone,
+		BorderBottomValOutset,
+		BorderBottomValRidge,
+		BorderBottomValSolid,
+		BorderBottomValThick,
+		BorderBottomValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBottomVal constants.
+func (v BorderBottomVal) Valid() bool {
+	switch v {
+	case BorderBottomValDashed,
+		BorderBottomValDotted,
+		BorderBottomValDouble,
+		BorderBottomValGroove,
+		BorderBottomValHidden,
+		BorderBottomValInset,
+		BorderBottomValMedium,
+		BorderBottomValNone,
+		BorderBottomValOutset,
+		BorderBottomValRidge,
+		BorderBottomValSolid,
+		BorderBottomValThick,
+		BorderBottomValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBottomVal returns the BorderBottomVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBottomVal(s string) (BorderBottomVal, error) {
+	v := BorderBottomVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBottomVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBottomVal constants.
+func (v BorderBottomVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBottomVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBottomVal.
+func (v *BorderBottomVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBottomVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderBottomStyleVal represents values for the border-bottom-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-style
 type BorderBottomStyleVal string
 
 // BorderBottomStyleVal constants.
@@ -654,7 +3606,81 @@ const (
 	BorderBottomStyleValSolid  BorderBottomStyleVal = "solid"
 )
 
+func (v BorderBottomStyleVal) String() string { return string(v) }
+
+// Values returns every BorderBottomStyleVal constant.
+func (BorderBottomStyleVal) Values() []BorderBottomStyleVal {
+	return []BorderBottomStyleVal{
+		BorderBottomStyleValDashed,
+		BorderBottomStyleValDotted,
+		BorderBottomStyleValDouble,
+		BorderBottomStyleValGroove,
+		BorderBottomStyleValHidden,
+		BorderBottomStyleValInset,
+		BorderBottomStyleValNone,
+		BorderBottomStyleValOutset,
+		BorderBottomStyleValRidge,
+		BorderBottomStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderBottomStyleVal constants.
+func (v BorderBottomStyleVal) Valid() bool {
+	switch v {
+	case BorderBottomStyleValDashed,
+		BorderBottomStyleValDotted,
+		BorderBottomStyleValDouble,
+		BorderBottomStyleValGroove,
+		BorderBottomStyleValHidden,
+		BorderBottomStyleValInset,
+		BorderBottomStyleValNone,
+		BorderBottomStyleValOutset,
+		BorderBottomStyleValRidge,
+		BorderBottomStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBottomStyleVal returns the BorderBottomStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBottomStyleVal(s string) (BorderBottomStyleVal, error) {
+	v := BorderBottomStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBottomStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBottomStyleVal constants.
+func (v BorderBottomStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBottomStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBottomStyleVal.
+func (v *BorderBottomStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBottomStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderBottomWidthVal represents values for the border-bottom-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-width
 type BorderBottomWidthVal string
 
 // BorderBottomWidthVal constants.
@@ -664,7 +3690,67 @@ const (
 	BorderBottomWidthValThin   BorderBottomWidthVal = "thin"
 )
 
+func (v BorderBottomWidthVal) String() string { return string(v) }
+
+// Values returns every BorderBottomWidthVal constant.
+func (BorderBottomWidthVal) Values() []BorderBottomWidthVal {
+	return []BorderBottomWidthVal{
+		BorderBottomWidthValMedium,
+		BorderBottomWidthValThick,
+		BorderBottomWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderBottomWidthVal constants.
+func (v BorderBottomWidthVal) Valid() bool {
+	switch v {
+	case BorderBottomWidthValMedium,
+		BorderBottomWidthValThick,
+		BorderBottomWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderBottomWidthVal returns the BorderBottomWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderBottomWidthVal(s string) (BorderBottomWidthVal, error) {
+	v := BorderBottomWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderBottomWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderBottomWidthVal constants.
+func (v BorderBottomWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderBottomWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderBottomWidthVal.
+func (v *BorderBottomWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderBottomWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderCollapseVal represents values for the border-collapse property.
+//
+//   - Initial: separate
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	separate | collapse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-collapse
 type BorderCollapseVal string
 
 // BorderCollapseVal constants.
@@ -673,254 +3759,1295 @@ const (
 	BorderCollapseValSeparate BorderCollapseVal = "separate"
 )
 
-// BorderColorVal represents values for the border-color property.
-type BorderColorVal string
-
-// BorderColorVal constants.
-const (
-	BorderColorVal1                     BorderColorVal = "1"
-	BorderColorVal4                     BorderColorVal = "4"
-	BorderColorValColorBase             BorderColorVal = "color-base"
-	BorderColorValDeprecatedSystemColor BorderColorVal = "deprecated-system-color"
-	BorderColorValLightDark             BorderColorVal = "light-dark"
-	BorderColorValSystemColor           BorderColorVal = "system-color"
-)
-
-// BorderEndEndRadiusVal represents values for the border-end-end-radius property.
-type BorderEndEndRadiusVal string
-
-// BorderEndEndRadiusVal constants.
-const (
-	BorderEndEndRadiusValBorderTopLeftRadius BorderEndEndRadiusVal = "border-top-left-radius"
-)
-
-// BorderEndStartRadiusVal represents values for the border-end-start-radius property.
-type BorderEndStartRadiusVal string
-
-// BorderEndStartRadiusVal constants.
-const (
-	BorderEndStartRadiusValBorderTopLeftRadius BorderEndStartRadiusVal = "border-top-left-radius"
-)
+func (v BorderCollapseVal) String() string { return string(v) }
+
+// Values returns every BorderCollapseVal constant.
+func (BorderCollapseVal) Values() []BorderCollapseVal {
+	return []BorderCollapseVal{
+		BorderCollapseValCollapse,
+		BorderCollapseValSeparate,
+	}
+}
+
+// Valid reports whether v is one of the BorderCollapseVal constants.
+func (v BorderCollapseVal) Valid() bool {
+	switch v {
+	case BorderCollapseValCollapse,
+		BorderCollapseValSeparate:
+		return true
+	}
+	return false
+}
+
+// ParseBorderCollapseVal returns the BorderCollapseVal constant for a keyword, ignoring ASCII case.
+func ParseBorderCollapseVal(s string) (BorderCollapseVal, error) {
+	v := BorderCollapseVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderCollapseVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderCollapseVal constants.
+func (v BorderCollapseVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderCollapseVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderCollapseVal.
+func (v *BorderCollapseVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderCollapseVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderImageVal represents values for the border-image property.
+//
+//   - Shorthand for: border-image-outset, border-image-repeat, border-image-slice, border-image-source, border-image-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image
 type BorderImageVal string
 
 // BorderImageVal constants.
 const (
-	BorderImageValBorderImageOutset BorderImageVal = "border-image-outset"
-	BorderImageValBorderImageRepeat BorderImageVal = "border-image-repeat"
-	BorderImageValBorderImageSlice  BorderImageVal = "border-image-slice"
-	BorderImageValBorderImageSource BorderImageVal = "border-image-source"
-	BorderImageValBorderImageWidth  BorderImageVal = "border-image-width"
-)
-
-// BorderImageOutsetVal represents values for the border-image-outset property.
-type BorderImageOutsetVal string
-
-// BorderImageOutsetVal constants.
-const (
-	BorderImageOutsetVal0 BorderImageOutsetVal = "0"
-	BorderImageOutsetVal1 BorderImageOutsetVal = "1"
-	BorderImageOutsetVal4 BorderImageOutsetVal = "4"
-)
+	BorderImageValAuto    BorderImageVal = "auto"
+	BorderImageValFill    BorderImageVal = "fill"
+	BorderImageValNone    BorderImageVal = "none"
+	BorderImageValRepeat  BorderImageVal = "repeat"
+	BorderImageValRound   BorderImageVal = "round"
+	BorderImageValSpace   BorderImageVal = "space"
+	BorderImageValStretch BorderImageVal = "stretch"
+)
+
+func (v BorderImageVal) String() string { return string(v) }
+
+// Values returns every BorderImageVal constant.
+func (BorderImageVal) Values() []BorderImageVal {
+	return []BorderImageVal{
+		BorderImageValAuto,
+		BorderImageValFill,
+		BorderImageValNone,
+		BorderImageValRepeat,
+		BorderImageValRound,
+		BorderImageValSpace,
+		BorderImageValStretch,
+	}
+}
+
+// Valid reports whether v is one of the BorderImageVal constants.
+func (v BorderImageVal) Valid() bool {
+	switch v {
+	case BorderImageValAuto,
+		BorderImageValFill,
+		BorderImageValNone,
+		BorderImageValRepeat,
+		BorderImageValRound,
+		BorderImageValSpace,
+		BorderImageValStretch:
+		return true
+	}
+	return false
+}
+
+// ParseBorderImageVal returns the BorderImageVal constant for a keyword, ignoring ASCII case.
+func ParseBorderImageVal(s string) (BorderImageVal, error) {
+	v := BorderImageVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderImageVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderImageVal constants.
+func (v BorderImageVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderImageVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderImageVal.
+func (v *BorderImageVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderImageVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderImageRepeatVal represents values for the border-image-repeat property.
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ stretch | repeat | round | space ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-repeat
 type BorderImageRepeatVal string
 
 // BorderImageRepeatVal constants.
 const (
-	BorderImageRepeatVal1       BorderImageRepeatVal = "1"
-	BorderImageRepeatVal2       BorderImageRepeatVal = "2"
 	BorderImageRepeatValRepeat  BorderImageRepeatVal = "repeat"
 	BorderImageRepeatValRound   BorderImageRepeatVal = "round"
 	BorderImageRepeatValSpace   BorderImageRepeatVal = "space"
 	BorderImageRepeatValStretch BorderImageRepeatVal = "stretch"
 )
 
+func (v BorderImageRepeatVal) String() string { return string(v) }
+
+// Values returns every BorderImageRepeatVal constant.
+func (BorderImageRepeatVal) Values() []BorderImageRepeatVal {
+	return []BorderImageRepeatVal{
+		BorderImageRepeatValRepeat,
+		BorderImageRepeatValRound,
+		BorderImageRepeatValSpace,
+		BorderImageRepeatValStretch,
+	}
+}
+
+// Valid reports whether v is one of the BorderImageRepeatVal constants.
+func (v BorderImageRepeatVal) Valid() bool {
+	switch v {
+	case BorderImageRepeatValRepeat,
+		BorderImageRepeatValRound,
+		BorderImageRepeatValSpace,
+		BorderImageRepeatValStretch:
+		return true
+	}
+	return false
+}
+
+// ParseBorderImageRepeatVal returns the BorderImageRepeatVal constant for a keyword, ignoring ASCII case.
+func ParseBorderImageRepeatVal(s string) (BorderImageRepeatVal, error) {
+	v := BorderImageRepeatVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderImageRepeatVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderImageRepeatVal constants.
+func (v BorderImageRepeatVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderImageRepeatVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderImageRepeatVal.
+func (v *BorderImageRepeatVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderImageRepeatVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderImageSliceVal represents values for the border-image-slice property.
+//
+//   - Initial: 100%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <number [0,∞]> | <percentage [0,∞]> ]{1,4}  && fill?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-slice
 type BorderImageSliceVal string
 
 // BorderImageSliceVal constants.
 const (
-	BorderImageSliceVal0    BorderImageSliceVal = "0"
-	BorderImageSliceVal1    BorderImageSliceVal = "1"
-	BorderImageSliceVal4    BorderImageSliceVal = "4"
 	BorderImageSliceValFill BorderImageSliceVal = "fill"
 )
 
+func (v BorderImageSliceVal) String() string { return string(v) }
+
+// Values returns every BorderImageSliceVal constant.
+func (BorderImageSliceVal) Values() []BorderImageSliceVal {
+	return []BorderImageSliceVal{
+		BorderImageSliceValFill,
+	}
+}
+
+// Valid reports whether v is one of the BorderImageSliceVal constants.
+func (v BorderImageSliceVal) Valid() bool {
+	switch v {
+	case BorderImageSliceValFill:
+		return true
+	}
+	return false
+}
+
+// ParseBorderImageSliceVal returns the BorderImageSliceVal constant for a keyword, ignoring ASCII case.
+func ParseBorderImageSliceVal(s string) (BorderImageSliceVal, error) {
+	v := BorderImageSliceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderImageSliceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderImageSliceVal constants.
+func (v BorderImageSliceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderImageSliceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderImageSliceVal.
+func (v *BorderImageSliceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderImageSliceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderImageSourceVal represents values for the border-image-source property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-source
 type BorderImageSourceVal string
 
 // BorderImageSourceVal constants.
 const (
-	BorderImageSourceValCrossFade BorderImageSourceVal = "cross-fade"
-	BorderImageSourceValElement   BorderImageSourceVal = "element"
-	BorderImageSourceValGradient  BorderImageSourceVal = "gradient"
-	BorderImageSourceValImageSet  BorderImageSourceVal = "image-set"
-	BorderImageSourceValNone      BorderImageSourceVal = "none"
-	BorderImageSourceValPaint     BorderImageSourceVal = "paint"
-)
+	BorderImageSourceValNone BorderImageSourceVal = "none"
+)
+
+func (v BorderImageSourceVal) String() string { return string(v) }
+
+// Values returns every BorderImageSourceVal constant.
+func (BorderImageSourceVal) Values() []BorderImageSourceVal {
+	return []BorderImageSourceVal{
+		BorderImageSourceValNone,
+	}
+}
+
+// Valid reports whether v is one of the BorderImageSourceVal constants.
+func (v BorderImageSourceVal) Valid() bool {
+	switch v {
+	case BorderImageSourceValNone:
+		return true
+	}
+	return false
+}
+
+// ParseBorderImageSourceVal returns the BorderImageSourceVal constant for a keyword, ignoring ASCII case.
+func ParseBorderImageSourceVal(s string) (BorderImageSourceVal, error) {
+	v := BorderImageSourceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderImageSourceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderImageSourceVal constants.
+func (v BorderImageSourceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderImageSourceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderImageSourceVal.
+func (v *BorderImageSourceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderImageSourceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderImageWidthVal represents values for the border-image-width property.
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-width
 type BorderImageWidthVal string
 
 // BorderImageWidthVal constants.
 const (
-	BorderImageWidthVal0                BorderImageWidthVal = "0"
-	BorderImageWidthVal1                BorderImageWidthVal = "1"
-	BorderImageWidthVal4                BorderImageWidthVal = "4"
-	BorderImageWidthValAuto             BorderImageWidthVal = "auto"
-	BorderImageWidthValLengthPercentage BorderImageWidthVal = "length-percentage"
-)
+	BorderImageWidthValAuto BorderImageWidthVal = "auto"
+)
+
+func (v BorderImageWidthVal) String() string { return string(v) }
+
+// Values returns every BorderImageWidthVal constant.
+func (BorderImageWidthVal) Values() []BorderImageWidthVal {
+	return []BorderImageWidthVal{
+		BorderImageWidthValAuto,
+	}
+}
+
+// Valid reports whether v is one of the BorderImageWidthVal constants.
+func (v BorderImageWidthVal) Valid() bool {
+	switch v {
+	case BorderImageWidthValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseBorderImageWidthVal returns the BorderImageWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderImageWidthVal(s string) (BorderImageWidthVal, error) {
+	v := BorderImageWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderImageWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderImageWidthVal constants.
+func (v BorderImageWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderImageWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderImageWidthVal.
+func (v *BorderImageWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderImageWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineVal represents values for the border-inline property.
+//
+//   - Shorthand for: border-inline-color, border-inline-style, border-inline-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-block-start'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline
 type BorderInlineVal string
 
 // BorderInlineVal constants.
 const (
-	BorderInlineValBorderBlockStart BorderInlineVal = "border-block-start"
-)
-
-// BorderInlineColorVal represents values for the border-inline-color property.
-type BorderInlineColorVal string
-
-// BorderInlineColorVal constants.
-const (
-	BorderInlineColorVal1              BorderInlineColorVal = "1"
-	BorderInlineColorVal2              BorderInlineColorVal = "2"
-	BorderInlineColorValBorderTopColor BorderInlineColorVal = "border-top-color"
-)
+	BorderInlineValDashed BorderInlineVal = "dashed"
+	BorderInlineValDotted BorderInlineVal = "dotted"
+	BorderInlineValDouble BorderInlineVal = "double"
+	BorderInlineValGroove BorderInlineVal = "groove"
+	BorderInlineValHidden BorderInlineVal = "hidden"
+	BorderInlineValInset  BorderInlineVal = "inset"
+	BorderInlineValMedium BorderInlineVal = "medium"
+	BorderInlineValNone   BorderInlineVal = "none"
+	BorderInlineValOutset BorderInlineVal = "outset"
+	BorderInlineValRidge  BorderInlineVal = "ridge"
+	BorderInlineValSolid  BorderInlineVal = "solid"
+	BorderInlineValThick  BorderInlineVal = "thick"
+	BorderInlineValThin   BorderInlineVal = "thin"
+)
+
+func (v BorderInlineVal) String() string { return string(v) }
+
+// Values returns every BorderInlineVal constant.
+func (BorderInlineVal) Values() []BorderInlineVal {
+	return []BorderInlineVal{
+		BorderInlineValDashed,
+		BorderInlineValDotted,
+		BorderInlineValDouble,
+		BorderInlineValGroove,
+		BorderInlineValHidden,
+		BorderInlineValInset,
+		BorderInlineValMedium,
+		BorderInlineValNone,
+		BorderInlineValOutset,
+		BorderInlineValRidge,
+		BorderInlineValSolid,
+		BorderInlineValThick,
+		BorderInlineValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineVal constants.
+func (v BorderInlineVal) Valid() bool {
+	switch v {
+	case BorderInlineValDashed,
+		BorderInlineValDotted,
+		BorderInlineValDouble,
+		BorderInlineValGroove,
+		BorderInlineValHidden,
+		BorderInlineValInset,
+		BorderInlineValMedium,
+		BorderInlineValNone,
+		BorderInlineValOutset,
+		BorderInlineValRidge,
+		BorderInlineValSolid,
+		BorderInlineValThick,
+		BorderInlineValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineVal returns the BorderInlineVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineVal(s string) (BorderInlineVal, error) {
+	v := BorderInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineVal constants.
+func (v BorderInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineVal.
+func (v *BorderInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineEndVal represents values for the border-inline-end property.
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end
 type BorderInlineEndVal string
 
 // BorderInlineEndVal constants.
 const (
-	BorderInlineEndValBorderTopStyle        BorderInlineEndVal = "border-top-style"
-	BorderInlineEndValBorderTopWidth        BorderInlineEndVal = "border-top-width"
-	BorderInlineEndValColorBase             BorderInlineEndVal = "color-base"
-	BorderInlineEndValDeprecatedSystemColor BorderInlineEndVal = "deprecated-system-color"
-	BorderInlineEndValLightDark             BorderInlineEndVal = "light-dark"
-	BorderInlineEndValSystemColor           BorderInlineEndVal = "system-color"
-)
-
-// BorderInlineEndColorVal represents values for the border-inline-end-color property.
-type BorderInlineEndColorVal string
-
-// BorderInlineEndColorVal constants.
-const (
-	BorderInlineEndColorValBorderTopColor BorderInlineEndColorVal = "border-top-color"
-)
+	BorderInlineEndValDashed BorderInlineEndVal = "dashed"
+	BorderInlineEndValDotted BorderInlineEndVal = "dotted"
+	BorderInlineEndValDouble BorderInlineEndVal = "double"
+	BorderInlineEndValGroove BorderInlineEndVal = "groove"
+	BorderInlineEndValHidden BorderInlineEndVal = "hidden"
+	BorderInlineEndValInset  BorderInlineEndVal = "inset"
+	BorderInlineEndValMedium BorderInlineEndVal = "medium"
+	BorderInlineEndValNone   BorderInlineEndVal = "none"
+	BorderInlineEndValOutset BorderInlineEndVal = "outset"
+	BorderInlineEndValRidge  BorderInlineEndVal = "ridge"
+	BorderInlineEndValSolid  BorderInlineEndVal = "solid"
+	BorderInlineEndValThick  BorderInlineEndVal = "thick"
+	BorderInlineEndValThin   BorderInlineEndVal = "thin"
+)
+
+func (v BorderInlineEndVal) String() string { return string(v) }
+
+// Values returns every BorderInlineEndVal constant.
+func (BorderInlineEndVal) Values() []BorderInlineEndVal {
+	return []BorderInlineEndVal{
+		BorderInlineEndValDashed,
+		BorderInlineEndValDotted,
+		BorderInlineEndValDouble,
+		BorderInlineEndValGroove,
+		BorderInlineEndValHidden,
+		BorderInlineEndValInset,
+		BorderInlineEndValMedium,
+		BorderInlineEndValNone,
+		BorderInlineEndValOutset,
+		BorderInlineEndValRidge,
+		BorderInlineEndValSolid,
+		BorderInlineEndValThick,
+		BorderInlineEndValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineEndVal constants.
+func (v BorderInlineEndVal) Valid() bool {
+	switch v {
+	case BorderInlineEndValDashed,
+		BorderInlineEndValDotted,
+		BorderInlineEndValDouble,
+		BorderInlineEndValGroove,
+		BorderInlineEndValHidden,
+		BorderInlineEndValInset,
+		BorderInlineEndValMedium,
+		BorderInlineEndValNone,
+		BorderInlineEndValOutset,
+		BorderInlineEndValRidge,
+		BorderInlineEndValSolid,
+		BorderInlineEndValThick,
+		BorderInlineEndValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineEndVal returns the BorderInlineEndVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineEndVal(s string) (BorderInlineEndVal, error) {
+	v := BorderInlineEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineEndVal constants.
+func (v BorderInlineEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineEndVal.
+func (v *BorderInlineEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineEndStyleVal represents values for the border-inline-end-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-style
 type BorderInlineEndStyleVal string
 
 // BorderInlineEndStyleVal constants.
 const (
-	BorderInlineEndStyleValBorderTopStyle BorderInlineEndStyleVal = "border-top-style"
-)
+	BorderInlineEndStyleValDashed BorderInlineEndStyleVal = "dashed"
+	BorderInlineEndStyleValDotted BorderInlineEndStyleVal = "dotted"
+	BorderInlineEndStyleValDouble BorderInlineEndStyleVal = "double"
+	BorderInlineEndStyleValGroove BorderInlineEndStyleVal = "groove"
+	BorderInlineEndStyleValHidden BorderInlineEndStyleVal = "hidden"
+	BorderInlineEndStyleValInset  BorderInlineEndStyleVal = "inset"
+	BorderInlineEndStyleValNone   BorderInlineEndStyleVal = "none"
+	BorderInlineEndStyleValOutset BorderInlineEndStyleVal = "outset"
+	BorderInlineEndStyleValRidge  BorderInlineEndStyleVal = "ridge"
+	BorderInlineEndStyleValSolid  BorderInlineEndStyleVal = "solid"
+)
+
+func (v BorderInlineEndStyleVal) String() string { return string(v) }
+
+// Values returns every BorderInlineEndStyleVal constant.
+func (BorderInlineEndStyleVal) Values() []BorderInlineEndStyleVal {
+	return []BorderInlineEndStyleVal{
+		BorderInlineEndStyleValDashed,
+		BorderInlineEndStyleValDotted,
+		BorderInlineEndStyleValDouble,
+		BorderInlineEndStyleValGroove,
+		BorderInlineEndStyleValHidden,
+		BorderInlineEndStyleValInset,
+		BorderInlineEndStyleValNone,
+		BorderInlineEndStyleValOutset,
+		BorderInlineEndStyleValRidge,
+		BorderInlineEndStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineEndStyleVal constants.
+func (v BorderInlineEndStyleVal) Valid() bool {
+	switch v {
+	case BorderInlineEndStyleValDashed,
+		BorderInlineEndStyleValDotted,
+		BorderInlineEndStyleValDouble,
+		BorderInlineEndStyleValGroove,
+		BorderInlineEndStyleValHidden,
+		BorderInlineEndStyleValInset,
+		BorderInlineEndStyleValNone,
+		BorderInlineEndStyleValOutset,
+		BorderInlineEndStyleValRidge,
+		BorderInlineEndStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineEndStyleVal returns the BorderInlineEndStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineEndStyleVal(s string) (BorderInlineEndStyleVal, error) {
+	v := BorderInlineEndStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineEndStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineEndStyleVal constants.
+func (v BorderInlineEndStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineEndStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineEndStyleVal.
+func (v *BorderInlineEndStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineEndStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineEndWidthVal represents values for the border-inline-end-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-width
 type BorderInlineEndWidthVal string
 
 // BorderInlineEndWidthVal constants.
 const (
-	BorderInlineEndWidthValBorderTopWidth BorderInlineEndWidthVal = "border-top-width"
-)
+	BorderInlineEndWidthValMedium BorderInlineEndWidthVal = "medium"
+	BorderInlineEndWidthValThick  BorderInlineEndWidthVal = "thick"
+	BorderInlineEndWidthValThin   BorderInlineEndWidthVal = "thin"
+)
+
+func (v BorderInlineEndWidthVal) String() string { return string(v) }
+
+// Values returns every BorderInlineEndWidthVal constant.
+func (BorderInlineEndWidthVal) Values() []BorderInlineEndWidthVal {
+	return []BorderInlineEndWidthVal{
+		BorderInlineEndWidthValMedium,
+		BorderInlineEndWidthValThick,
+		BorderInlineEndWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineEndWidthVal constants.
+func (v BorderInlineEndWidthVal) Valid() bool {
+	switch v {
+	case BorderInlineEndWidthValMedium,
+		BorderInlineEndWidthValThick,
+		BorderInlineEndWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineEndWidthVal returns the BorderInlineEndWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineEndWidthVal(s string) (BorderInlineEndWidthVal, error) {
+	v := BorderInlineEndWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineEndWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineEndWidthVal constants.
+func (v BorderInlineEndWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineEndWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineEndWidthVal.
+func (v *BorderInlineEndWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineEndWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineStartVal represents values for the border-inline-start property.
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start
 type BorderInlineStartVal string
 
 // BorderInlineStartVal constants.
 const (
-	BorderInlineStartValBorderTopStyle        BorderInlineStartVal = "border-top-style"
-	BorderInlineStartValBorderTopWidth        BorderInlineStartVal = "border-top-width"
-	BorderInlineStartValColorBase             BorderInlineStartVal = "color-base"
-	BorderInlineStartValDeprecatedSystemColor BorderInlineStartVal = "deprecated-system-color"
-	BorderInlineStartValLightDark             BorderInlineStartVal = "light-dark"
-	BorderInlineStartValSystemColor           BorderInlineStartVal = "system-color"
-)
-
-// BorderInlineStartColorVal represents values for the border-inline-start-color property.
-type BorderInlineStartColorVal string
-
-// BorderInlineStartColorVal constants.
-const (
-	BorderInlineStartColorValBorderTopColor BorderInlineStartColorVal = "border-top-color"
-)
+	BorderInlineStartValDashed BorderInlineStartVal = "dashed"
+	BorderInlineStartValDotted BorderInlineStartVal = "dotted"
+	BorderInlineStartValDouble BorderInlineStartVal = "double"
+	BorderInlineStartValGroove BorderInlineStartVal = "groove"
+	BorderInlineStartValHidden BorderInlineStartVal = "hidden"
+	BorderInlineStartValInset  BorderInlineStartVal = "inset"
+	BorderInlineStartValMedium BorderInlineStartVal = "medium"
+	BorderInlineStartValNone   BorderInlineStartVal = "none"
+	BorderInlineStartValOutset BorderInlineStartVal = "outset"
+	BorderInlineStartValRidge  BorderInlineStartVal = "ridge"
+	BorderInlineStartValSolid  BorderInlineStartVal = "solid"
+	BorderInlineStartValThick  BorderInlineStartVal = "thick"
+	BorderInlineStartValThin   BorderInlineStartVal = "thin"
+)
+
+func (v BorderInlineStartVal) String() string { return string(v) }
+
+// Values returns every BorderInlineStartVal constant.
+func (BorderInlineStartVal) Values() []BorderInlineStartVal {
+	return []BorderInlineStartVal{
+		BorderInlineStartValDashed,
+		BorderInlineStartValDotted,
+		BorderInlineStartValDouble,
+		BorderInlineStartValGroove,
+		BorderInlineStartValHidden,
+		BorderInlineStartValInset,
+		BorderInlineStartValMedium,
+		BorderInlineStartValNone,
+		BorderInlineStartValOutset,
+		BorderInlineStartValRidge,
+		BorderInlineStartValSolid,
+		BorderInlineStartValThick,
+		BorderInlineStartValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineStartVal constants.
+func (v BorderInlineStartVal) Valid() bool {
+	switch v {
+	case BorderInlineStartValDashed,
+		BorderInlineStartValDotted,
+		BorderInlineStartValDouble,
+		BorderInlineStartValGroove,
+		BorderInlineStartValHidden,
+		BorderInlineStartValInset,
+		BorderInlineStartValMedium,
+		BorderInlineStartValNone,
+		BorderInlineStartValOutset,
+		BorderInlineStartValRidge,
+		BorderInlineStartValSolid,
+		BorderInlineStartValThick,
+		BorderInlineStartValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineStartVal returns the BorderInlineStartVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineStartVal(s string) (BorderInlineStartVal, error) {
+	v := BorderInlineStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineStartVal constants.
+func (v BorderInlineStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineStartVal.
+func (v *BorderInlineStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineStartStyleVal represents values for the border-inline-start-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-style
 type BorderInlineStartStyleVal string
 
 // BorderInlineStartStyleVal constants.
 const (
-	BorderInlineStartStyleValBorderTopStyle BorderInlineStartStyleVal = "border-top-style"
-)
+	BorderInlineStartStyleValDashed BorderInlineStartStyleVal = "dashed"
+	BorderInlineStartStyleValDotted BorderInlineStartStyleVal = "dotted"
+	BorderInlineStartStyleValDouble BorderInlineStartStyleVal = "double"
+	BorderInlineStartStyleValGroove BorderInlineStartStyleVal = "groove"
+	BorderInlineStartStyleValHidden BorderInlineStartStyleVal = "hidden"
+	BorderInlineStartStyleValInset  BorderInlineStartStyleVal = "inset"
+	BorderInlineStartStyleValNone   BorderInlineStartStyleVal = "none"
+	BorderInlineStartStyleValOutset BorderInlineStartStyleVal = "outset"
+	BorderInlineStartStyleValRidge  BorderInlineStartStyleVal = "ridge"
+	BorderInlineStartStyleValSolid  BorderInlineStartStyleVal = "solid"
+)
+
+func (v BorderInlineStartStyleVal) String() string { return string(v) }
+
+// Values returns every BorderInlineStartStyleVal constant.
+func (BorderInlineStartStyleVal) Values() []BorderInlineStartStyleVal {
+	return []BorderInlineStartStyleVal{
+		BorderInlineStartStyleValDashed,
+		BorderInlineStartStyleValDotted,
+		BorderInlineStartStyleValDouble,
+		BorderInlineStartStyleValGroove,
+		BorderInlineStartStyleValHidden,
+		BorderInlineStartStyleValInset,
+		BorderInlineStartStyleValNone,
+		BorderInlineStartStyleValOutset,
+		BorderInlineStartStyleValRidge,
+		BorderInlineStartStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineStartStyleVal constants.
+func (v BorderInlineStartStyleVal) Valid() bool {
+	switch v {
+	case BorderInlineStartStyleValDashed,
+		BorderInlineStartStyleValDotted,
+		BorderInlineStartStyleValDouble,
+		BorderInlineStartStyleValGroove,
+		BorderInlineStartStyleValHidden,
+		BorderInlineStartStyleValInset,
+		BorderInlineStartStyleValNone,
+		BorderInlineStartStyleValOutset,
+		BorderInlineStartStyleValRidge,
+		BorderInlineStartStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineStartStyleVal returns the BorderInlineStartStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineStartStyleVal(s string) (BorderInlineStartStyleVal, error) {
+	v := BorderInlineStartStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineStartStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineStartStyleVal constants.
+func (v BorderInlineStartStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineStartStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineStartStyleVal.
+func (v *BorderInlineStartStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineStartStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineStartWidthVal represents values for the border-inline-start-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-width
 type BorderInlineStartWidthVal string
 
 // BorderInlineStartWidthVal constants.
 const (
-	BorderInlineStartWidthValBorderTopWidth BorderInlineStartWidthVal = "border-top-width"
-)
+	BorderInlineStartWidthValMedium BorderInlineStartWidthVal = "medium"
+	BorderInlineStartWidthValThick  BorderInlineStartWidthVal = "thick"
+	BorderInlineStartWidthValThin   BorderInlineStartWidthVal = "thin"
+)
+
+func (v BorderInlineStartWidthVal) String() string { return string(v) }
+
+// Values returns every BorderInlineStartWidthVal constant.
+func (BorderInlineStartWidthVal) Values() []BorderInlineStartWidthVal {
+	return []BorderInlineStartWidthVal{
+		BorderInlineStartWidthValMedium,
+		BorderInlineStartWidthValThick,
+		BorderInlineStartWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineStartWidthVal constants.
+func (v BorderInlineStartWidthVal) Valid() bool {
+	switch v {
+	case BorderInlineStartWidthValMedium,
+		BorderInlineStartWidthValThick,
+		BorderInlineStartWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineStartWidthVal returns the BorderInlineStartWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineStartWidthVal(s string) (BorderInlineStartWidthVal, error) {
+	v := BorderInlineStartWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineStartWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineStartWidthVal constants.
+func (v BorderInlineStartWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineStartWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineStartWidthVal.
+func (v *BorderInlineStartWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineStartWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineStyleVal represents values for the border-inline-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-style
 type BorderInlineStyleVal string
 
 // BorderInlineStyleVal constants.
 const (
-	BorderInlineStyleVal1              BorderInlineStyleVal = "1"
-	BorderInlineStyleVal2              BorderInlineStyleVal = "2"
-	BorderInlineStyleValBorderTopStyle BorderInlineStyleVal = "border-top-style"
-)
+	BorderInlineStyleValDashed BorderInlineStyleVal = "dashed"
+	BorderInlineStyleValDotted BorderInlineStyleVal = "dotted"
+	BorderInlineStyleValDouble BorderInlineStyleVal = "double"
+	BorderInlineStyleValGroove BorderInlineStyleVal = "groove"
+	BorderInlineStyleValHidden BorderInlineStyleVal = "hidden"
+	BorderInlineStyleValInset  BorderInlineStyleVal = "inset"
+	BorderInlineStyleValNone   BorderInlineStyleVal = "none"
+	BorderInlineStyleValOutset BorderInlineStyleVal = "outset"
+	BorderInlineStyleValRidge  BorderInlineStyleVal = "ridge"
+	BorderInlineStyleValSolid  BorderInlineStyleVal = "solid"
+)
+
+func (v BorderInlineStyleVal) String() string { return string(v) }
+
+// Values returns every BorderInlineStyleVal constant.
+func (BorderInlineStyleVal) Values() []BorderInlineStyleVal {
+	return []BorderInlineStyleVal{
+		BorderInlineStyleValDashed,
+		BorderInlineStyleValDotted,
+		BorderInlineStyleValDouble,
+		BorderInlineStyleValGroove,
+		BorderInlineStyleValHidden,
+		BorderInlineStyleValInset,
+		BorderInlineStyleValNone,
+		BorderInlineStyleValOutset,
+		BorderInlineStyleValRidge,
+		BorderInlineStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineStyleVal constants.
+func (v BorderInlineStyleVal) Valid() bool {
+	switch v {
+	case BorderInlineStyleValDashed,
+		BorderInlineStyleValDotted,
+		BorderInlineStyleValDouble,
+		BorderInlineStyleValGroove,
+		BorderInlineStyleValHidden,
+		BorderInlineStyleValInset,
+		BorderInlineStyleValNone,
+		BorderInlineStyleValOutset,
+		BorderInlineStyleValRidge,
+		BorderInlineStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineStyleVal returns the BorderInlineStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineStyleVal(s string) (BorderInlineStyleVal, error) {
+	v := BorderInlineStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineStyleVal constants.
+func (v BorderInlineStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineStyleVal.
+func (v *BorderInlineStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderInlineWidthVal represents values for the border-inline-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-width
 type BorderInlineWidthVal string
 
 // BorderInlineWidthVal constants.
 const (
-	BorderInlineWidthVal1              BorderInlineWidthVal = "1"
-	BorderInlineWidthVal2              BorderInlineWidthVal = "2"
-	BorderInlineWidthValBorderTopWidth BorderInlineWidthVal = "border-top-width"
-)
+	BorderInlineWidthValMedium BorderInlineWidthVal = "medium"
+	BorderInlineWidthValThick  BorderInlineWidthVal = "thick"
+	BorderInlineWidthValThin   BorderInlineWidthVal = "thin"
+)
+
+func (v BorderInlineWidthVal) String() string { return string(v) }
+
+// Values returns every BorderInlineWidthVal constant.
+func (BorderInlineWidthVal) Values() []BorderInlineWidthVal {
+	return []BorderInlineWidthVal{
+		BorderInlineWidthValMedium,
+		BorderInlineWidthValThick,
+		BorderInlineWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderInlineWidthVal constants.
+func (v BorderInlineWidthVal) Valid() bool {
+	switch v {
+	case BorderInlineWidthValMedium,
+		BorderInlineWidthValThick,
+		BorderInlineWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderInlineWidthVal returns the BorderInlineWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderInlineWidthVal(s string) (BorderInlineWidthVal, error) {
+	v := BorderInlineWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderInlineWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderInlineWidthVal constants.
+func (v BorderInlineWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderInlineWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderInlineWidthVal.
+func (v *BorderInlineWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderInlineWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderLeftVal represents values for the border-left property.
+//
+//   - Shorthand for: border-left-color, border-left-style, border-left-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left
 type BorderLeftVal string
 
 // BorderLeftVal constants.
 const (
-	BorderLeftValColorBase             BorderLeftVal = "color-base"
-	BorderLeftValDashed                BorderLeftVal = "dashed"
-	BorderLeftValDeprecatedSystemColor BorderLeftVal = "deprecated-system-color"
-	BorderLeftValDotted                BorderLeftVal = "dotted"
-	BorderLeftValDouble                BorderLeftVal = "double"
-	BorderLeftValGroove                BorderLeftVal = "groove"
-	BorderLeftValHidden                BorderLeftVal = "hidden"
-	BorderLeftValInset                 BorderLeftVal = "inset"
-	BorderLeftValLightDark             BorderLeftVal = "light-dark"
-	BorderLeftValMedium                BorderLeftVal = "medium"
-	BorderLeftValNone                  BorderLeftVal = "none"
-	BorderLeftValOutset                BorderLeftVal = "outset"
-	BorderLeftValRidge                 BorderLeftVal = "ridge"
-	BorderLeftValSolid                 BorderLeftVal = "solid"
-	BorderLeftValSystemColor           BorderLeftVal = "system-color"
-	BorderLeftValThick                 BorderLeftVal = "thick"
-	BorderLeftValThin                  BorderLeftVal = "thin"
-)
-
-// BorderLeftColorVal represents values for the border-left-color property.
-type BorderLeftColorVal string
-
-// BorderLeftColorVal constants.
-const (
-	BorderLeftColorValColorBase             BorderLeftColorVal = "color-base"
-	BorderLeftColorValDeprecatedSystemColor BorderLeftColorVal = "deprecated-system-color"
-	BorderLeftColorValLightDark             BorderLeftColorVal = "light-dark"
-	BorderLeftColorValSystemColor           BorderLeftColorVal = "system-color"
-)
+	BorderLeftValDashed BorderLeftVal = "dashed"
+	BorderLeftValDotted BorderLeftVal = "dotted"
+	BorderLeftValDouble BorderLeftVal = "double"
+	BorderLeftValGroove BorderLeftVal = "groove"
+	BorderLeftValHidden BorderLeftVal = "hidden"
+	BorderLeftValInset  BorderLeftVal = "inset"
+	BorderLeftValMedium BorderLeftVal = "medium"
+	BorderLeftValNone   BorderLeftVal = "none"
+	BorderLeftValOutset BorderLeftVal = "outset"
+	BorderLeftValRidge  BorderLeftVal = "ridge"
+	BorderLeftValSolid  BorderLeftVal = "solid"
+	BorderLeftValThick  BorderLeftVal = "thick"
+	BorderLeftValThin   BorderLeftVal = "thin"
+)
+
+func (v BorderLeftVal) String() string { return string(v) }
+
+// Values returns every BorderLeftVal constant.
+func (BorderLeftVal) Values() []BorderLeftVal {
+	return []BorderLeftVal{
+		BorderLeftValDashed,
+		BorderLeftValDotted,
+		BorderLeftValDouble,
+		BorderLeftValGroove,
+		BorderLeftValHidden,
+		BorderLeftValInset,
+		BorderLeftValMedium,
+		BorderLeftValNone,
+		BorderLeftValOutset,
+		BorderLeftValRidge,
+		BorderLeftValSolid,
+		BorderLeftValThick,
+		BorderLeftValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderLeftVal constants.
+func (v BorderLeftVal) Valid() bool {
+	switch v {
+	case BorderLeftValDashed,
+		BorderLeftValDotted,
+		BorderLeftValDouble,
+		BorderLeftValGroove,
+		BorderLeftValHidden,
+		BorderLeftValInset,
+		BorderLeftValMedium,
+		BorderLeftValNone,
+		BorderLeftValOutset,
+		BorderLeftValRidge,
+		BorderLeftValSolid,
+		BorderLeftValThick,
+		BorderLeftValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderLeftVal returns the BorderLeftVal constant for a keyword, ignoring ASCII case.
+func ParseBorderLeftVal(s string) (BorderLeftVal, error) {
+	v := BorderLeftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderLeftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderLeftVal constants.
+func (v BorderLeftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderLeftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderLeftVal.
+func (v *BorderLeftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderLeftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderLeftStyleVal represents values for the border-left-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-style
 type BorderLeftStyleVal string
 
 // BorderLeftStyleVal constants.
@@ -937,7 +5064,81 @@ const (
 	BorderLeftStyleValSolid  BorderLeftStyleVal = "solid"
 )
 
+func (v BorderLeftStyleVal) String() string { return string(v) }
+
+// Values returns every BorderLeftStyleVal constant.
+func (BorderLeftStyleVal) Values() []BorderLeftStyleVal {
+	return []BorderLeftStyleVal{
+		BorderLeftStyleValDashed,
+		BorderLeftStyleValDotted,
+		BorderLeftStyleValDouble,
+		BorderLeftStyleValGroove,
+		BorderLeftStyleValHidden,
+		BorderLeftStyleValInset,
+		BorderLeftStyleValNone,
+		BorderLeftStyleValOutset,
+		BorderLeftStyleValRidge,
+		BorderLeftStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderLeftStyleVal constants.
+func (v BorderLeftStyleVal) Valid() bool {
+	switch v {
+	case BorderLeftStyleValDashed,
+		BorderLeftStyleValDotted,
+		BorderLeftStyleValDouble,
+		BorderLeftStyleValGroove,
+		BorderLeftStyleValHidden,
+		BorderLeftStyleValInset,
+		BorderLeftStyleValNone,
+		BorderLeftStyleValOutset,
+		BorderLeftStyleValRidge,
+		BorderLeftStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderLeftStyleVal returns the BorderLeftStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderLeftStyleVal(s string) (BorderLeftStyleVal, error) {
+	v := BorderLeftStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderLeftStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderLeftStyleVal constants.
+func (v BorderLeftStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderLeftStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderLeftStyleVal.
+func (v *BorderLeftStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderLeftStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderLeftWidthVal represents values for the border-left-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-width
 type BorderLeftWidthVal string
 
 // BorderLeftWidthVal constants.
@@ -947,53 +5148,167 @@ const (
 	BorderLeftWidthValThin   BorderLeftWidthVal = "thin"
 )
 
-// BorderRadiusVal represents values for the border-radius property.
-type BorderRadiusVal string
-
-// BorderRadiusVal constants.
-const (
-	BorderRadiusVal0                BorderRadiusVal = "0"
-	BorderRadiusVal1                BorderRadiusVal = "1"
-	BorderRadiusVal4                BorderRadiusVal = "4"
-	BorderRadiusValLengthPercentage BorderRadiusVal = "length-percentage"
-)
+func (v BorderLeftWidthVal) String() string { return string(v) }
+
+// Values returns every BorderLeftWidthVal constant.
+func (BorderLeftWidthVal) Values() []BorderLeftWidthVal {
+	return []BorderLeftWidthVal{
+		BorderLeftWidthValMedium,
+		BorderLeftWidthValThick,
+		BorderLeftWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderLeftWidthVal constants.
+func (v BorderLeftWidthVal) Valid() bool {
+	switch v {
+	case BorderLeftWidthValMedium,
+		BorderLeftWidthValThick,
+		BorderLeftWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderLeftWidthVal returns the BorderLeftWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderLeftWidthVal(s string) (BorderLeftWidthVal, error) {
+	v := BorderLeftWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderLeftWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderLeftWidthVal constants.
+func (v BorderLeftWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderLeftWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderLeftWidthVal.
+func (v *BorderLeftWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderLeftWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderRightVal represents values for the border-right property.
+//
+//   - Shorthand for: border-right-color, border-right-style, border-right-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right
 type BorderRightVal string
 
 // BorderRightVal constants.
 const (
-	BorderRightValColorBase             BorderRightVal = "color-base"
-	BorderRightValDashed                BorderRightVal = "dashed"
-	BorderRightValDeprecatedSystemColor BorderRightVal = "deprecated-system-color"
-	BorderRightValDotted                BorderRightVal = "dotted"
-	BorderRightValDouble                BorderRightVal = "double"
-	BorderRightValGroove                BorderRightVal = "groove"
-	BorderRightValHidden                BorderRightVal = "hidden"
-	BorderRightValInset                 BorderRightVal = "inset"
-	BorderRightValLightDark             BorderRightVal = "light-dark"
-	BorderRightValMedium                BorderRightVal = "medium"
-	BorderRightValNone                  BorderRightVal = "none"
-	BorderRightValOutset                BorderRightVal = "outset"
-	BorderRightValRidge                 BorderRightVal = "ridge"
-	BorderRightValSolid                 BorderRightVal = "solid"
-	BorderRightValSystemColor           BorderRightVal = "system-color"
-	BorderRightValThick                 BorderRightVal = "thick"
-	BorderRightValThin                  BorderRightVal = "thin"
-)
-
-// BorderRightColorVal represents values for the border-right-color property.
-type BorderRightColorVal string
-
-// BorderRightColorVal constants.
-const (
-	BorderRightColorValColorBase             BorderRightColorVal = "color-base"
-	BorderRightColorValDeprecatedSystemColor BorderRightColorVal = "deprecated-system-color"
-	BorderRightColorValLightDark             BorderRightColorVal = "light-dark"
-	BorderRightColorValSystemColor           BorderRightColorVal = "system-color"
-)
+	BorderRightValDashed BorderRightVal = "dashed"
+	BorderRightValDotted BorderRightVal = "dotted"
+	BorderRightValDouble BorderRightVal = "double"
+	BorderRightValGroove BorderRightVal = "groove"
+	BorderRightValHidden BorderRightVal = "hidden"
+	BorderRightValInset  BorderRightVal = "inset"
+	BorderRightValMedium BorderRightVal = "medium"
+	BorderRightValNone   BorderRightVal = "none"
+	BorderRightValOutset BorderRightVal = "outset"
+	BorderRightValRidge  BorderRightVal = "ridge"
+	BorderRightValSolid  BorderRightVal = "solid"
+	BorderRightValThick  BorderRightVal = "thick"
+	BorderRightValThin   BorderRightVal = "thin"
+)
+
+func (v BorderRightVal) String() string { return string(v) }
+
+// Values returns every BorderRightVal constant.
+func (BorderRightVal) Values() []BorderRightVal {
+	return []BorderRightVal{
+		BorderRightValDashed,
+		BorderRightValDotted,
+		BorderRightValDouble,
+		BorderRightValGroove,
+		BorderRightValHidden,
+		BorderRightValInset,
+		BorderRightValMedium,
+		BorderRightValNone,
+		BorderRightValOutset,
+		BorderRightValRidge,
+		BorderRightValSolid,
+		BorderRightValThick,
+		BorderRightValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderRightVal constants.
+func (v BorderRightVal) Valid() bool {
+	switch v {
+	case BorderRightValDashed,
+		BorderRightValDotted,
+		BorderRightValDouble,
+		BorderRightValGroove,
+		BorderRightValHidden,
+		BorderRightValInset,
+		BorderRightValMedium,
+		BorderRightValNone,
+		BorderRightValOutset,
+		BorderRightValRidge,
+		BorderRightValSolid,
+		BorderRightValThick,
+		BorderRightValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderRightVal returns the BorderRightVal constant for a keyword, ignoring ASCII case.
+func ParseBorderRightVal(s string) (BorderRightVal, error) {
+	v := BorderRightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderRightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderRightVal constants.
+func (v BorderRightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderRightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderRightVal.
+func (v *BorderRightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderRightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderRightStyleVal represents values for the border-right-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-style
 type BorderRightStyleVal string
 
 // BorderRightStyleVal constants.
@@ -1010,7 +5325,81 @@ const (
 	BorderRightStyleValSolid  BorderRightStyleVal = "solid"
 )
 
+func (v BorderRightStyleVal) String() string { return string(v) }
+
+// Values returns every BorderRightStyleVal constant.
+func (BorderRightStyleVal) Values() []BorderRightStyleVal {
+	return []BorderRightStyleVal{
+		BorderRightStyleValDashed,
+		BorderRightStyleValDotted,
+		BorderRightStyleValDouble,
+		BorderRightStyleValGroove,
+		BorderRightStyleValHidden,
+		BorderRightStyleValInset,
+		BorderRightStyleValNone,
+		BorderRightStyleValOutset,
+		BorderRightStyleValRidge,
+		BorderRightStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderRightStyleVal constants.
+func (v BorderRightStyleVal) Valid() bool {
+	switch v {
+	case BorderRightStyleValDashed,
+		BorderRightStyleValDotted,
+		BorderRightStyleValDouble,
+		BorderRightStyleValGroove,
+		BorderRightStyleValHidden,
+		BorderRightStyleValInset,
+		BorderRightStyleValNone,
+		BorderRightStyleValOutset,
+		BorderRightStyleValRidge,
+		BorderRightStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderRightStyleVal returns the BorderRightStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderRightStyleVal(s string) (BorderRightStyleVal, error) {
+	v := BorderRightStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderRightStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderRightStyleVal constants.
+func (v BorderRightStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderRightStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderRightStyleVal.
+func (v *BorderRightStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderRightStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderRightWidthVal represents values for the border-right-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-width
 type BorderRightWidthVal string
 
 // BorderRightWidthVal constants.
@@ -1020,38 +5409,71 @@ const (
 	BorderRightWidthValThin   BorderRightWidthVal = "thin"
 )
 
-// BorderSpacingVal represents values for the border-spacing property.
-type BorderSpacingVal string
-
-// BorderSpacingVal constants.
-const (
-	BorderSpacingVal1 BorderSpacingVal = "1"
-	BorderSpacingVal2 BorderSpacingVal = "2"
-)
-
-// BorderStartEndRadiusVal represents values for the border-start-end-radius property.
-type BorderStartEndRadiusVal string
-
-// BorderStartEndRadiusVal constants.
-const (
-	BorderStartEndRadiusValBorderTopLeftRadius BorderStartEndRadiusVal = "border-top-left-radius"
-)
-
-// BorderStartStartRadiusVal represents values for the border-start-start-radius property.
-type BorderStartStartRadiusVal string
-
-// BorderStartStartRadiusVal constants.
-const (
-	BorderStartStartRadiusValBorderTopLeftRadius BorderStartStartRadiusVal = "border-top-left-radius"
-)
+func (v BorderRightWidthVal) String() string { return string(v) }
+
+// Values returns every BorderRightWidthVal constant.
+func (BorderRightWidthVal) Values() []BorderRightWidthVal {
+	return []BorderRightWidthVal{
+		BorderRightWidthValMedium,
+		BorderRightWidthValThick,
+		BorderRightWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderRightWidthVal constants.
+func (v BorderRightWidthVal) Valid() bool {
+	switch v {
+	case BorderRightWidthValMedium,
+		BorderRightWidthValThick,
+		BorderRightWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderRightWidthVal returns the BorderRightWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderRightWidthVal(s string) (BorderRightWidthVal, error) {
+	v := BorderRightWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderRightWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderRightWidthVal constants.
+func (v BorderRightWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderRightWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderRightWidthVal.
+func (v *BorderRightWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderRightWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderStyleVal represents values for the border-style property.
+//
+//   - Shorthand for: border-bottom-style, border-left-style, border-right-style, border-top-style
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-style
 type BorderStyleVal string
 
 // BorderStyleVal constants.
 const (
-	BorderStyleVal1      BorderStyleVal = "1"
-	BorderStyleVal4      BorderStyleVal = "4"
 	BorderStyleValDashed BorderStyleVal = "dashed"
 	BorderStyleValDotted BorderStyleVal = "dotted"
 	BorderStyleValDouble BorderStyleVal = "double"
@@ -1064,64 +5486,181 @@ const (
 	BorderStyleValSolid  BorderStyleVal = "solid"
 )
 
+func (v BorderStyleVal) String() string { return string(v) }
+
+// Values returns every BorderStyleVal constant.
+func (BorderStyleVal) Values() []BorderStyleVal {
+	return []BorderStyleVal{
+		BorderStyleValDashed,
+		BorderStyleValDotted,
+		BorderStyleValDouble,
+		BorderStyleValGroove,
+		BorderStyleValHidden,
+		BorderStyleValInset,
+		BorderStyleValNone,
+		BorderStyleValOutset,
+		BorderStyleValRidge,
+		BorderStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderStyleVal constants.
+func (v BorderStyleVal) Valid() bool {
+	switch v {
+	case BorderStyleValDashed,
+		BorderStyleValDotted,
+		BorderStyleValDouble,
+		BorderStyleValGroove,
+		BorderStyleValHidden,
+		BorderStyleValInset,
+		BorderStyleValNone,
+		BorderStyleValOutset,
+		BorderStyleValRidge,
+		BorderStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderStyleVal returns the BorderStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderStyleVal(s string) (BorderStyleVal, error) {
+	v := BorderStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderStyleVal constants.
+func (v BorderStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderStyleVal.
+func (v *BorderStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderTopVal represents values for the border-top property.
+//
+//   - Shorthand for: border-top-color, border-top-style, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top
 type BorderTopVal string
 
 // BorderTopVal constants.
 const (
-	BorderTopValColorBase             BorderTopVal = "color-base"
-	BorderTopValDashed                BorderTopVal = "dashed"
-	BorderTopValDeprecatedSystemColor BorderTopVal = "deprecated-system-color"
-	BorderTopValDotted                BorderTopVal = "dotted"
-	BorderTopValDouble                BorderTopVal = "double"
-	BorderTopValGroove                BorderTopVal = "groove"
-	BorderTopValHidden                BorderTopVal = "hidden"
-	BorderTopValInset                 BorderTopVal = "inset"
-	BorderTopValLightDark             BorderTopVal = "light-dark"
-	BorderTopValMedium                BorderTopVal = "medium"
-	BorderTopValNone                  BorderTopVal = "none"
-	BorderTopValOutset                BorderTopVal = "outset"
-	BorderTopValRidge                 BorderTopVal = "ridge"
-	BorderTopValSolid                 BorderTopVal = "solid"
-	BorderTopValSystemColor           BorderTopVal = "system-color"
-	BorderTopValThick                 BorderTopVal = "thick"
-	BorderTopValThin                  BorderTopVal = "thin"
-)
-
-// BorderTopColorVal represents values for the border-top-color property.
-type BorderTopColorVal string
-
-// BorderTopColorVal constants.
-const (
-	BorderTopColorValColorBase             BorderTopColorVal = "color-base"
-	BorderTopColorValDeprecatedSystemColor BorderTopColorVal = "deprecated-system-color"
-	BorderTopColorValLightDark             BorderTopColorVal = "light-dark"
-	BorderTopColorValSystemColor           BorderTopColorVal = "system-color"
-)
-
-// BorderTopLeftRadiusVal represents values for the border-top-left-radius property.
-type BorderTopLeftRadiusVal string
-
-// BorderTopLeftRadiusVal constants.
-const (
-	BorderTopLeftRadiusVal0                BorderTopLeftRadiusVal = "0"
-	BorderTopLeftRadiusVal1                BorderTopLeftRadiusVal = "1"
-	BorderTopLeftRadiusVal2                BorderTopLeftRadiusVal = "2"
-	BorderTopLeftRadiusValLengthPercentage BorderTopLeftRadiusVal = "length-percentage"
-)
-
-// BorderTopRightRadiusVal represents values for the border-top-right-radius property.
-type BorderTopRightRadiusVal string
-
-// BorderTopRightRadiusVal constants.
-const (
-	BorderTopRightRadiusVal0                BorderTopRightRadiusVal = "0"
-	BorderTopRightRadiusVal1                BorderTopRightRadiusVal = "1"
-	BorderTopRightRadiusVal2                BorderTopRightRadiusVal = "2"
-	BorderTopRightRadiusValLengthPercentage BorderTopRightRadiusVal = "length-percentage"
-)
+	BorderTopValDashed BorderTopVal = "dashed"
+	BorderTopValDotted BorderTopVal = "dotted"
+	BorderTopValDouble BorderTopVal = "double"
+	BorderTopValGroove BorderTopVal = "groove"
+	BorderTopValHidden BorderTopVal = "hidden"
+	BorderTopValInset  BorderTopVal = "inset"
+	BorderTopValMedium BorderTopVal = "medium"
+	BorderTopValNone   BorderTopVal = "none"
+	BorderTopValOutset BorderTopVal = "outset"
+	BorderTopValRidge  BorderTopVal = "ridge"
+	BorderTopValSolid  BorderTopVal = "solid"
+	BorderTopValThick  BorderTopVal = "thick"
+	BorderTopValThin   BorderTopVal = "thin"
+)
+
+func (v BorderTopVal) String() string { return string(v) }
+
+// Values returns every BorderTopVal constant.
+func (BorderTopVal) Values() []BorderTopVal {
+	return []BorderTopVal{
+		BorderTopValDashed,
+		BorderTopValDotted,
+		BorderTopValDouble,
+		BorderTopValGroove,
+		BorderTopValHidden,
+		BorderTopValInset,
+		BorderTopValMedium,
+		BorderTopValNone,
+		BorderTopValOutset,
+		BorderTopValRidge,
+		BorderTopValSolid,
+		BorderTopValThick,
+		BorderTopValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderTopVal constants.
+func (v BorderTopVal) Valid() bool {
+	switch v {
+	case BorderTopValDashed,
+		BorderTopValDotted,
+		BorderTopValDouble,
+		BorderTopValGroove,
+		BorderTopValHidden,
+		BorderTopValInset,
+		BorderTopValMedium,
+		BorderTopValNone,
+		BorderTopValOutset,
+		BorderTopValRidge,
+		BorderTopValSolid,
+		BorderTopValThick,
+		BorderTopValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderTopVal returns the BorderTopVal constant for a keyword, ignoring ASCII case.
+func ParseBorderTopVal(s string) (BorderTopVal, error) {
+	v := BorderTopVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderTopVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderTopVal constants.
+func (v BorderTopVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderTopVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderTopVal.
+func (v *BorderTopVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderTopVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BorderTopStyleVal represents values for the border-top-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-style
 type BorderTopStyleVal string
 
 // BorderTopStyleVal constants.
@@ -1138,7 +5677,81 @@ const (
 	BorderTopStyleValSolid  BorderTopStyleVal = "solid"
 )
 
+func (v BorderTopStyleVal) String() string { return string(v) }
+
+// Values returns every BorderTopStyleVal constant.
+func (BorderTopStyleVal) Values() []BorderTopStyleVal {
+	return []BorderTopStyleVal{
+		BorderTopStyleValDashed,
+		BorderTopStyleValDotted,
+		BorderTopStyleValDouble,
+		BorderTopStyleValGroove,
+		BorderTopStyleValHidden,
+		BorderTopStyleValInset,
+		BorderTopStyleValNone,
+		BorderTopStyleValOutset,
+		BorderTopStyleValRidge,
+		BorderTopStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the BorderTopStyleVal constants.
+func (v BorderTopStyleVal) Valid() bool {
+	switch v {
+	case BorderTopStyleValDashed,
+		BorderTopStyleValDotted,
+		BorderTopStyleValDouble,
+		BorderTopStyleValGroove,
+		BorderTopStyleValHidden,
+		BorderTopStyleValInset,
+		BorderTopStyleValNone,
+		BorderTopStyleValOutset,
+		BorderTopStyleValRidge,
+		BorderTopStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseBorderTopStyleVal returns the BorderTopStyleVal constant for a keyword, ignoring ASCII case.
+func ParseBorderTopStyleVal(s string) (BorderTopStyleVal, error) {
+	v := BorderTopStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderTopStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderTopStyleVal constants.
+func (v BorderTopStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderTopStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderTopStyleVal.
+func (v *BorderTopStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderTopStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderTopWidthVal represents values for the border-top-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-width
 type BorderTopWidthVal string
 
 // BorderTopWidthVal constants.
@@ -1148,32 +5761,201 @@ const (
 	BorderTopWidthValThin   BorderTopWidthVal = "thin"
 )
 
+func (v BorderTopWidthVal) String() string { return string(v) }
+
+// Values returns every BorderTopWidthVal constant.
+func (BorderTopWidthVal) Values() []BorderTopWidthVal {
+	return []BorderTopWidthVal{
+		BorderTopWidthValMedium,
+		BorderTopWidthValThick,
+		BorderTopWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderTopWidthVal constants.
+func (v BorderTopWidthVal) Valid() bool {
+	switch v {
+	case BorderTopWidthValMedium,
+		BorderTopWidthValThick,
+		BorderTopWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderTopWidthVal returns the BorderTopWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderTopWidthVal(s string) (BorderTopWidthVal, error) {
+	v := BorderTopWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderTopWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderTopWidthVal constants.
+func (v BorderTopWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderTopWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderTopWidthVal.
+func (v *BorderTopWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderTopWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BorderWidthVal represents values for the border-width property.
+//
+//   - Shorthand for: border-bottom-width, border-left-width, border-right-width, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-width
 type BorderWidthVal string
 
 // BorderWidthVal constants.
 const (
-	BorderWidthVal1      BorderWidthVal = "1"
-	BorderWidthVal4      BorderWidthVal = "4"
 	BorderWidthValMedium BorderWidthVal = "medium"
 	BorderWidthValThick  BorderWidthVal = "thick"
 	BorderWidthValThin   BorderWidthVal = "thin"
 )
 
+func (v BorderWidthVal) String() string { return string(v) }
+
+// Values returns every BorderWidthVal constant.
+func (BorderWidthVal) Values() []BorderWidthVal {
+	return []BorderWidthVal{
+		BorderWidthValMedium,
+		BorderWidthValThick,
+		BorderWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the BorderWidthVal constants.
+func (v BorderWidthVal) Valid() bool {
+	switch v {
+	case BorderWidthValMedium,
+		BorderWidthValThick,
+		BorderWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseBorderWidthVal returns the BorderWidthVal constant for a keyword, ignoring ASCII case.
+func ParseBorderWidthVal(s string) (BorderWidthVal, error) {
+	v := BorderWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BorderWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BorderWidthVal constants.
+func (v BorderWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BorderWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBorderWidthVal.
+func (v *BorderWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBorderWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BottomVal represents values for the bottom property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/bottom
 type BottomVal string
 
 // BottomVal constants.
 const (
-	BottomValAnchor           BottomVal = "anchor"
-	BottomValAnchorName       BottomVal = "anchor-name"
-	BottomValAnchorSide       BottomVal = "anchor-side"
-	BottomValAnchorSize       BottomVal = "anchor-size"
-	BottomValAuto             BottomVal = "auto"
-	BottomValLengthPercentage BottomVal = "length-percentage"
-)
+	BottomValAuto BottomVal = "auto"
+)
+
+func (v BottomVal) String() string { return string(v) }
+
+// Values returns every BottomVal constant.
+func (BottomVal) Values() []BottomVal {
+	return []BottomVal{
+		BottomValAuto,
+	}
+}
+
+// Valid reports whether v is one of the BottomVal constants.
+func (v BottomVal) Valid() bool {
+	switch v {
+	case BottomValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseBottomVal returns the BottomVal constant for a keyword, ignoring ASCII case.
+func ParseBottomVal(s string) (BottomVal, error) {
+	v := BottomVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BottomVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BottomVal constants.
+func (v BottomVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BottomVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBottomVal.
+func (v *BottomVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBottomVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BoxAlignVal represents values for the box-align property.
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	start | center | end | baseline | stretch
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-align
 type BoxAlignVal string
 
 // BoxAlignVal constants.
@@ -1185,7 +5967,71 @@ const (
 	BoxAlignValStretch  BoxAlignVal = "stretch"
 )
 
+func (v BoxAlignVal) String() string { return string(v) }
+
+// Values returns every BoxAlignVal constant.
+func (BoxAlignVal) Values() []BoxAlignVal {
+	return []BoxAlignVal{
+		BoxAlignValBaseline,
+		BoxAlignValCenter,
+		BoxAlignValEnd,
+		BoxAlignValStart,
+		BoxAlignValStretch,
+	}
+}
+
+// Valid reports whether v is one of the BoxAlignVal constants.
+func (v BoxAlignVal) Valid() bool {
+	switch v {
+	case BoxAlignValBaseline,
+		BoxAlignValCenter,
+		BoxAlignValEnd,
+		BoxAlignValStart,
+		BoxAlignValStretch:
+		return true
+	}
+	return false
+}
+
+// ParseBoxAlignVal returns the BoxAlignVal constant for a keyword, ignoring ASCII case.
+func ParseBoxAlignVal(s string) (BoxAlignVal, error) {
+	v := BoxAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxAlignVal constants.
+func (v BoxAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxAlignVal.
+func (v *BoxAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BoxDecorationBreakVal represents values for the box-decoration-break property.
+//
+//   - Initial: slice
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	slice | clone
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-decoration-break
 type BoxDecorationBreakVal string
 
 // BoxDecorationBreakVal constants.
@@ -1194,7 +6040,65 @@ const (
 	BoxDecorationBreakValSlice BoxDecorationBreakVal = "slice"
 )
 
+func (v BoxDecorationBreakVal) String() string { return string(v) }
+
+// Values returns every BoxDecorationBreakVal constant.
+func (BoxDecorationBreakVal) Values() []BoxDecorationBreakVal {
+	return []BoxDecorationBreakVal{
+		BoxDecorationBreakValClone,
+		BoxDecorationBreakValSlice,
+	}
+}
+
+// Valid reports whether v is one of the BoxDecorationBreakVal constants.
+func (v BoxDecorationBreakVal) Valid() bool {
+	switch v {
+	case BoxDecorationBreakValClone,
+		BoxDecorationBreakValSlice:
+		return true
+	}
+	return false
+}
+
+// ParseBoxDecorationBreakVal returns the BoxDecorationBreakVal constant for a keyword, ignoring ASCII case.
+func ParseBoxDecorationBreakVal(s string) (BoxDecorationBreakVal, error) {
+	v := BoxDecorationBreakVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxDecorationBreakVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxDecorationBreakVal constants.
+func (v BoxDecorationBreakVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxDecorationBreakVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxDecorationBreakVal.
+func (v *BoxDecorationBreakVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxDecorationBreakVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BoxDirectionVal represents values for the box-direction property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	normal | reverse | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-direction
 type BoxDirectionVal string
 
 // BoxDirectionVal constants.
@@ -1203,15 +6107,65 @@ const (
 	BoxDirectionValReverse BoxDirectionVal = "reverse"
 )
 
-// BoxFlexGroupVal represents values for the box-flex-group property.
-type BoxFlexGroupVal string
-
-// BoxFlexGroupVal constants.
-const (
-	BoxFlexGroupValNumberToken BoxFlexGroupVal = "number-token"
-)
+func (v BoxDirectionVal) String() string { return string(v) }
+
+// Values returns every BoxDirectionVal constant.
+func (BoxDirectionVal) Values() []BoxDirectionVal {
+	return []BoxDirectionVal{
+		BoxDirectionValNormal,
+		BoxDirectionValReverse,
+	}
+}
+
+// Valid reports whether v is one of the BoxDirectionVal constants.
+func (v BoxDirectionVal) Valid() bool {
+	switch v {
+	case BoxDirectionValNormal,
+		BoxDirectionValReverse:
+		return true
+	}
+	return false
+}
+
+// ParseBoxDirectionVal returns the BoxDirectionVal constant for a keyword, ignoring ASCII case.
+func ParseBoxDirectionVal(s string) (BoxDirectionVal, error) {
+	v := BoxDirectionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxDirectionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxDirectionVal constants.
+func (v BoxDirectionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxDirectionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxDirectionVal.
+func (v *BoxDirectionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxDirectionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BoxLinesVal represents values for the box-lines property.
+//
+//   - Initial: single
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	single | multiple
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-lines
 type BoxLinesVal string
 
 // BoxLinesVal constants.
@@ -1220,15 +6174,65 @@ const (
 	BoxLinesValSingle   BoxLinesVal = "single"
 )
 
-// BoxOrdinalGroupVal represents values for the box-ordinal-group property.
-type BoxOrdinalGroupVal string
-
-// BoxOrdinalGroupVal constants.
-const (
-	BoxOrdinalGroupValNumberToken BoxOrdinalGroupVal = "number-token"
-)
+func (v BoxLinesVal) String() string { return string(v) }
+
+// Values returns every BoxLinesVal constant.
+func (BoxLinesVal) Values() []BoxLinesVal {
+	return []BoxLinesVal{
+		BoxLinesValMultiple,
+		BoxLinesValSingle,
+	}
+}
+
+// Valid reports whether v is one of the BoxLinesVal constants.
+func (v BoxLinesVal) Valid() bool {
+	switch v {
+	case BoxLinesValMultiple,
+		BoxLinesValSingle:
+		return true
+	}
+	return false
+}
+
+// ParseBoxLinesVal returns the BoxLinesVal constant for a keyword, ignoring ASCII case.
+func ParseBoxLinesVal(s string) (BoxLinesVal, error) {
+	v := BoxLinesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxLinesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxLinesVal constants.
+func (v BoxLinesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxLinesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxLinesVal.
+func (v *BoxLinesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxLinesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BoxOrientVal represents values for the box-orient property.
+//
+//   - Initial: inline-axis
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	horizontal | vertical | inline-axis | block-axis | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-orient
 type BoxOrientVal string
 
 // BoxOrientVal constants.
@@ -1239,7 +6243,69 @@ const (
 	BoxOrientValVertical   BoxOrientVal = "vertical"
 )
 
+func (v BoxOrientVal) String() string { return string(v) }
+
+// Values returns every BoxOrientVal constant.
+func (BoxOrientVal) Values() []BoxOrientVal {
+	return []BoxOrientVal{
+		BoxOrientValBlockAxis,
+		BoxOrientValHorizontal,
+		BoxOrientValInlineAxis,
+		BoxOrientValVertical,
+	}
+}
+
+// Valid reports whether v is one of the BoxOrientVal constants.
+func (v BoxOrientVal) Valid() bool {
+	switch v {
+	case BoxOrientValBlockAxis,
+		BoxOrientValHorizontal,
+		BoxOrientValInlineAxis,
+		BoxOrientValVertical:
+		return true
+	}
+	return false
+}
+
+// ParseBoxOrientVal returns the BoxOrientVal constant for a keyword, ignoring ASCII case.
+func ParseBoxOrientVal(s string) (BoxOrientVal, error) {
+	v := BoxOrientVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxOrientVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxOrientVal constants.
+func (v BoxOrientVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxOrientVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxOrientVal.
+func (v *BoxOrientVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxOrientVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BoxPackVal represents values for the box-pack property.
+//
+//   - Initial: start
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	start | center | end | justify
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-pack
 type BoxPackVal string
 
 // BoxPackVal constants.
@@ -1250,18 +6316,133 @@ const (
 	BoxPackValStart   BoxPackVal = "start"
 )
 
+func (v BoxPackVal) String() string { return string(v) }
+
+// Values returns every BoxPackVal constant.
+func (BoxPackVal) Values() []BoxPackVal {
+	return []BoxPackVal{
+		BoxPackValCenter,
+		BoxPackValEnd,
+		BoxPackValJustify,
+		BoxPackValStart,
+	}
+}
+
+// Valid reports whether v is one of the BoxPackVal constants.
+func (v BoxPackVal) Valid() bool {
+	switch v {
+	case BoxPackValCenter,
+		BoxPackValEnd,
+		BoxPackValJustify,
+		BoxPackValStart:
+		return true
+	}
+	return false
+}
+
+// ParseBoxPackVal returns the BoxPackVal constant for a keyword, ignoring ASCII case.
+func ParseBoxPackVal(s string) (BoxPackVal, error) {
+	v := BoxPackVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxPackVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxPackVal constants.
+func (v BoxPackVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxPackVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxPackVal.
+func (v *BoxPackVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxPackVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BoxShadowVal represents values for the box-shadow property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <shadow>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-shadow
 type BoxShadowVal string
 
 // BoxShadowVal constants.
 const (
-	BoxShadowVal2     BoxShadowVal = "2"
-	BoxShadowVal4     BoxShadowVal = "4"
-	BoxShadowValInset BoxShadowVal = "inset"
-	BoxShadowValNone  BoxShadowVal = "none"
-)
+	BoxShadowValNone BoxShadowVal = "none"
+)
+
+func (v BoxShadowVal) String() string { return string(v) }
+
+// Values returns every BoxShadowVal constant.
+func (BoxShadowVal) Values() []BoxShadowVal {
+	return []BoxShadowVal{
+		BoxShadowValNone,
+	}
+}
+
+// Valid reports whether v is one of the BoxShadowVal constants.
+func (v BoxShadowVal) Valid() bool {
+	switch v {
+	case BoxShadowValNone:
+		return true
+	}
+	return false
+}
+
+// ParseBoxShadowVal returns the BoxShadowVal constant for a keyword, ignoring ASCII case.
+func ParseBoxShadowVal(s string) (BoxShadowVal, error) {
+	v := BoxShadowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxShadowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxShadowVal constants.
+func (v BoxShadowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxShadowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxShadowVal.
+func (v *BoxShadowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxShadowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // BoxSizingVal represents values for the box-sizing property.
+//
+//   - Initial: content-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content-box | border-box
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-sizing
 type BoxSizingVal string
 
 // BoxSizingVal constants.
@@ -1270,11 +6451,70 @@ const (
 	BoxSizingValContentBox BoxSizingVal = "content-box"
 )
 
+func (v BoxSizingVal) String() string { return string(v) }
+
+// Values returns every BoxSizingVal constant.
+func (BoxSizingVal) Values() []BoxSizingVal {
+	return []BoxSizingVal{
+		BoxSizingValBorderBox,
+		BoxSizingValContentBox,
+	}
+}
+
+// Valid reports whether v is one of the BoxSizingVal constants.
+func (v BoxSizingVal) Valid() bool {
+	switch v {
+	case BoxSizingValBorderBox,
+		BoxSizingValContentBox:
+		return true
+	}
+	return false
+}
+
+// ParseBoxSizingVal returns the BoxSizingVal constant for a keyword, ignoring ASCII case.
+func ParseBoxSizingVal(s string) (BoxSizingVal, error) {
+	v := BoxSizingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BoxSizingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BoxSizingVal constants.
+func (v BoxSizingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BoxSizingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBoxSizingVal.
+func (v *BoxSizingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBoxSizingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BreakAfterVal represents values for the break-after property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-after
 type BreakAfterVal string
 
 // BreakAfterVal constants.
 const (
+	BreakAfterValAll         BreakAfterVal = "all"
 	BreakAfterValAlways      BreakAfterVal = "always"
 	BreakAfterValAuto        BreakAfterVal = "auto"
 	BreakAfterValAvoid       BreakAfterVal = "avoid"
@@ -1290,11 +6530,94 @@ const (
 	BreakAfterValVerso       BreakAfterVal = "verso"
 )
 
+func (v BreakAfterVal) String() string { return string(v) }
+
+// Values returns every BreakAfterVal constant.
+func (BreakAfterVal) Values() []BreakAfterVal {
+	return []BreakAfterVal{
+		BreakAfterValAll,
+		BreakAfterValAlways,
+		BreakAfterValAuto,
+		BreakAfterValAvoid,
+		BreakAfterValAvoidColumn,
+		BreakAfterValAvoidPage,
+		BreakAfterValAvoidRegion,
+		BreakAfterValColumn,
+		BreakAfterValLeft,
+		BreakAfterValPage,
+		BreakAfterValRecto,
+		BreakAfterValRegion,
+		BreakAfterValRight,
+		BreakAfterValVerso,
+	}
+}
+
+// Valid reports whether v is one of the BreakAfterVal constants.
+func (v BreakAfterVal) Valid() bool {
+	switch v {
+	case BreakAfterValAll,
+		BreakAfterValAlways,
+		BreakAfterValAuto,
+		BreakAfterValAvoid,
+		BreakAfterValAvoidColumn,
+		BreakAfterValAvoidPage,
+		BreakAfterValAvoidRegion,
+		BreakAfterValColumn,
+		BreakAfterValLeft,
+		BreakAfterValPage,
+		BreakAfterValRecto,
+		BreakAfterValRegion,
+		BreakAfterValRight,
+		BreakAfterValVerso:
+		return true
+	}
+	return false
+}
+
+// ParseBreakAfterVal returns the BreakAfterVal constant for a keyword, ignoring ASCII case.
+func ParseBreakAfterVal(s string) (BreakAfterVal, error) {
+	v := BreakAfterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BreakAfterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BreakAfterVal constants.
+func (v BreakAfterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BreakAfterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBreakAfterVal.
+func (v *BreakAfterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBreakAfterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BreakBeforeVal represents values for the break-before property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-before
 type BreakBeforeVal string
 
 // BreakBeforeVal constants.
 const (
+	BreakBeforeValAll         BreakBeforeVal = "all"
 	BreakBeforeValAlways      BreakBeforeVal = "always"
 	BreakBeforeValAuto        BreakBeforeVal = "auto"
 	BreakBeforeValAvoid       BreakBeforeVal = "avoid"
@@ -1310,7 +6633,89 @@ const (
 	BreakBeforeValVerso       BreakBeforeVal = "verso"
 )
 
+func (v BreakBeforeVal) String() string { return string(v) }
+
+// Values returns every BreakBeforeVal constant.
+func (BreakBeforeVal) Values() []BreakBeforeVal {
+	return []BreakBeforeVal{
+		BreakBeforeValAll,
+		BreakBeforeValAlways,
+		BreakBeforeValAuto,
+		BreakBeforeValAvoid,
+		BreakBeforeValAvoidColumn,
+		BreakBeforeValAvoidPage,
+		BreakBeforeValAvoidRegion,
+		BreakBeforeValColumn,
+		BreakBeforeValLeft,
+		BreakBeforeValPage,
+		BreakBeforeValRecto,
+		BreakBeforeValRegion,
+		BreakBeforeValRight,
+		BreakBeforeValVerso,
+	}
+}
+
+// Valid reports whether v is one of the BreakBeforeVal constants.
+func (v BreakBeforeVal) Valid() bool {
+	switch v {
+	case BreakBeforeValAll,
+		BreakBeforeValAlways,
+		BreakBeforeValAuto,
+		BreakBeforeValAvoid,
+		BreakBeforeValAvoidColumn,
+		BreakBeforeValAvoidPage,
+		BreakBeforeValAvoidRegion,
+		BreakBeforeValColumn,
+		BreakBeforeValLeft,
+		BreakBeforeValPage,
+		BreakBeforeValRecto,
+		BreakBeforeValRegion,
+		BreakBeforeValRight,
+		BreakBeforeValVerso:
+		return true
+	}
+	return false
+}
+
+// ParseBreakBeforeVal returns the BreakBeforeVal constant for a keyword, ignoring ASCII case.
+func ParseBreakBeforeVal(s string) (BreakBeforeVal, error) {
+	v := BreakBeforeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BreakBeforeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BreakBeforeVal constants.
+func (v BreakBeforeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BreakBeforeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBreakBeforeVal.
+func (v *BreakBeforeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBreakBeforeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // BreakInsideVal represents values for the break-inside property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | avoid-page | avoid-column | avoid-region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-inside
 type BreakInsideVal string
 
 // BreakInsideVal constants.
@@ -1322,7 +6727,71 @@ const (
 	BreakInsideValAvoidRegion BreakInsideVal = "avoid-region"
 )
 
+func (v BreakInsideVal) String() string { return string(v) }
+
+// Values returns every BreakInsideVal constant.
+func (BreakInsideVal) Values() []BreakInsideVal {
+	return []BreakInsideVal{
+		BreakInsideValAuto,
+		BreakInsideValAvoid,
+		BreakInsideValAvoidColumn,
+		BreakInsideValAvoidPage,
+		BreakInsideValAvoidRegion,
+	}
+}
+
+// Valid reports whether v is one of the BreakInsideVal constants.
+func (v BreakInsideVal) Valid() bool {
+	switch v {
+	case BreakInsideValAuto,
+		BreakInsideValAvoid,
+		BreakInsideValAvoidColumn,
+		BreakInsideValAvoidPage,
+		BreakInsideValAvoidRegion:
+		return true
+	}
+	return false
+}
+
+// ParseBreakInsideVal returns the BreakInsideVal constant for a keyword, ignoring ASCII case.
+func ParseBreakInsideVal(s string) (BreakInsideVal, error) {
+	v := BreakInsideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "BreakInsideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not BreakInsideVal constants.
+func (v BreakInsideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "BreakInsideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseBreakInsideVal.
+func (v *BreakInsideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseBreakInsideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // CaptionSideVal represents values for the caption-side property.
+//
+//   - Initial: top
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	top | bottom
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/caption-side
 type CaptionSideVal string
 
 // CaptionSideVal constants.
@@ -1331,28 +6800,198 @@ const (
 	CaptionSideValTop    CaptionSideVal = "top"
 )
 
+func (v CaptionSideVal) String() string { return string(v) }
+
+// Values returns every CaptionSideVal constant.
+func (CaptionSideVal) Values() []CaptionSideVal {
+	return []CaptionSideVal{
+		CaptionSideValBottom,
+		CaptionSideValTop,
+	}
+}
+
+// Valid reports whether v is one of the CaptionSideVal constants.
+func (v CaptionSideVal) Valid() bool {
+	switch v {
+	case CaptionSideValBottom,
+		CaptionSideValTop:
+		return true
+	}
+	return false
+}
+
+// ParseCaptionSideVal returns the CaptionSideVal constant for a keyword, ignoring ASCII case.
+func ParseCaptionSideVal(s string) (CaptionSideVal, error) {
+	v := CaptionSideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CaptionSideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CaptionSideVal constants.
+func (v CaptionSideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CaptionSideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCaptionSideVal.
+func (v *CaptionSideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCaptionSideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // CaretVal represents values for the caret property.
+//
+//   - Shorthand for: caret-color, caret-shape
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'caret-color'> || <'caret-shape'>
 type CaretVal string
 
 // CaretVal constants.
 const (
-	CaretValCaretColor CaretVal = "caret-color"
-	CaretValCaretShape CaretVal = "caret-shape"
-)
+	CaretValAuto       CaretVal = "auto"
+	CaretValBar        CaretVal = "bar"
+	CaretValBlock      CaretVal = "block"
+	CaretValUnderscore CaretVal = "underscore"
+)
+
+func (v CaretVal) String() string { return string(v) }
+
+// Values returns every CaretVal constant.
+func (CaretVal) Values() []CaretVal {
+	return []CaretVal{
+		CaretValAuto,
+		CaretValBar,
+		CaretValBlock,
+		CaretValUnderscore,
+	}
+}
+
+// Valid reports whether v is one of the CaretVal constants.
+func (v CaretVal) Valid() bool {
+	switch v {
+	case CaretValAuto,
+		CaretValBar,
+		CaretValBlock,
+		CaretValUnderscore:
+		return true
+	}
+	return false
+}
+
+// ParseCaretVal returns the CaretVal constant for a keyword, ignoring ASCII case.
+func ParseCaretVal(s string) (CaretVal, error) {
+	v := CaretVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CaretVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CaretVal constants.
+func (v CaretVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CaretVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCaretVal.
+func (v *CaretVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCaretVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // CaretColorVal represents values for the caret-color property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/caret-color
 type CaretColorVal string
 
 // CaretColorVal constants.
 const (
-	CaretColorValAuto                  CaretColorVal = "auto"
-	CaretColorValColorBase             CaretColorVal = "color-base"
-	CaretColorValDeprecatedSystemColor CaretColorVal = "deprecated-system-color"
-	CaretColorValLightDark             CaretColorVal = "light-dark"
-	CaretColorValSystemColor           CaretColorVal = "system-color"
-)
+	CaretColorValAuto CaretColorVal = "auto"
+)
+
+func (v CaretColorVal) String() string { return string(v) }
+
+// Values returns every CaretColorVal constant.
+func (CaretColorVal) Values() []CaretColorVal {
+	return []CaretColorVal{
+		CaretColorValAuto,
+	}
+}
+
+// Valid reports whether v is one of the CaretColorVal constants.
+func (v CaretColorVal) Valid() bool {
+	switch v {
+	case CaretColorValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseCaretColorVal returns the CaretColorVal constant for a keyword, ignoring ASCII case.
+func ParseCaretColorVal(s string) (CaretColorVal, error) {
+	v := CaretColorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CaretColorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CaretColorVal constants.
+func (v CaretColorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CaretColorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCaretColorVal.
+func (v *CaretColorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCaretColorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // CaretShapeVal represents values for the caret-shape property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | bar | block | underscore
 type CaretShapeVal string
 
 // CaretShapeVal constants.
@@ -1363,7 +7002,69 @@ const (
 	CaretShapeValUnderscore CaretShapeVal = "underscore"
 )
 
+func (v CaretShapeVal) String() string { return string(v) }
+
+// Values returns every CaretShapeVal constant.
+func (CaretShapeVal) Values() []CaretShapeVal {
+	return []CaretShapeVal{
+		CaretShapeValAuto,
+		CaretShapeValBar,
+		CaretShapeValBlock,
+		CaretShapeValUnderscore,
+	}
+}
+
+// Valid reports whether v is one of the CaretShapeVal constants.
+func (v CaretShapeVal) Valid() bool {
+	switch v {
+	case CaretShapeValAuto,
+		CaretShapeValBar,
+		CaretShapeValBlock,
+		CaretShapeValUnderscore:
+		return true
+	}
+	return false
+}
+
+// ParseCaretShapeVal returns the CaretShapeVal constant for a keyword, ignoring ASCII case.
+func ParseCaretShapeVal(s string) (CaretShapeVal, error) {
+	v := CaretShapeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CaretShapeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CaretShapeVal constants.
+func (v CaretShapeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CaretShapeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCaretShapeVal.
+func (v *CaretShapeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCaretShapeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ClearVal represents values for the clear property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | left | right | both | inline-start | inline-end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clear
 type ClearVal string
 
 // ClearVal constants.
@@ -1376,39 +7077,224 @@ const (
 	ClearValRight       ClearVal = "right"
 )
 
+func (v ClearVal) String() string { return string(v) }
+
+// Values returns every ClearVal constant.
+func (ClearVal) Values() []ClearVal {
+	return []ClearVal{
+		ClearValBoth,
+		ClearValInlineEnd,
+		ClearValInlineStart,
+		ClearValLeft,
+		ClearValNone,
+		ClearValRight,
+	}
+}
+
+// Valid reports whether v is one of the ClearVal constants.
+func (v ClearVal) Valid() bool {
+	switch v {
+	case ClearValBoth,
+		ClearValInlineEnd,
+		ClearValInlineStart,
+		ClearValLeft,
+		ClearValNone,
+		ClearValRight:
+		return true
+	}
+	return false
+}
+
+// ParseClearVal returns the ClearVal constant for a keyword, ignoring ASCII case.
+func ParseClearVal(s string) (ClearVal, error) {
+	v := ClearVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ClearVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ClearVal constants.
+func (v ClearVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ClearVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseClearVal.
+func (v *ClearVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseClearVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ClipVal represents values for the clip property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<shape> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip
+//
+// Deprecated: the clip property is obsolete.
 type ClipVal string
 
 // ClipVal constants.
 const (
-	ClipValAuto   ClipVal = "auto"
-	ClipValBottom ClipVal = "bottom"
-	ClipValLeft   ClipVal = "left"
-	ClipValRect   ClipVal = "rect"
-	ClipValRight  ClipVal = "right"
-	ClipValTop    ClipVal = "top"
-)
+	ClipValAuto ClipVal = "auto"
+)
+
+func (v ClipVal) String() string { return string(v) }
+
+// Values returns every ClipVal constant.
+func (ClipVal) Values() []ClipVal {
+	return []ClipVal{
+		ClipValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ClipVal constants.
+func (v ClipVal) Valid() bool {
+	switch v {
+	case ClipValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseClipVal returns the ClipVal constant for a keyword, ignoring ASCII case.
+func ParseClipVal(s string) (ClipVal, error) {
+	v := ClipVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ClipVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ClipVal constants.
+func (v ClipVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ClipVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseClipVal.
+func (v *ClipVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseClipVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ClipPathVal represents values for the clip-path property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<clip-source> | [ <basic-shape> || <geometry-box> ] | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-path
 type ClipPathVal string
 
 // ClipPathVal constants.
 const (
-	ClipPathValCircle    ClipPathVal = "circle"
-	ClipPathValEllipse   ClipPathVal = "ellipse"
-	ClipPathValFillBox   ClipPathVal = "fill-box"
-	ClipPathValInset     ClipPathVal = "inset"
-	ClipPathValNone      ClipPathVal = "none"
-	ClipPathValPath      ClipPathVal = "path"
-	ClipPathValPolygon   ClipPathVal = "polygon"
-	ClipPathValRect      ClipPathVal = "rect"
-	ClipPathValShapeBox  ClipPathVal = "shape-box"
-	ClipPathValStrokeBox ClipPathVal = "stroke-box"
-	ClipPathValViewBox   ClipPathVal = "view-box"
-	ClipPathValXywh      ClipPathVal = "xywh"
-)
+	ClipPathValBorderBox  ClipPathVal = "border-box"
+	ClipPathValContentBox ClipPathVal = "content-box"
+	ClipPathValFillBox    ClipPathVal = "fill-box"
+	ClipPathValMarginBox  ClipPathVal = "margin-box"
+	ClipPathValNone       ClipPathVal = "none"
+	ClipPathValPaddingBox ClipPathVal = "padding-box"
+	ClipPathValStrokeBox  ClipPathVal = "stroke-box"
+	ClipPathValViewBox    ClipPathVal = "view-box"
+)
+
+func (v ClipPathVal) String() string { return string(v) }
+
+// Values returns every ClipPathVal constant.
+func (ClipPathVal) Values() []ClipPathVal {
+	return []ClipPathVal{
+		ClipPathValBorderBox,
+		ClipPathValContentBox,
+		ClipPathValFillBox,
+		ClipPathValMarginBox,
+		ClipPathValNone,
+		ClipPathValPaddingBox,
+		ClipPathValStrokeBox,
+		ClipPathValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the ClipPathVal constants.
+func (v ClipPathVal) Valid() bool {
+	switch v {
+	case ClipPathValBorderBox,
+		ClipPathValContentBox,
+		ClipPathValFillBox,
+		ClipPathValMarginBox,
+		ClipPathValNone,
+		ClipPathValPaddingBox,
+		ClipPathValStrokeBox,
+		ClipPathValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseClipPathVal returns the ClipPathVal constant for a keyword, ignoring ASCII case.
+func ParseClipPathVal(s string) (ClipPathVal, error) {
+	v := ClipPathVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ClipPathVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ClipPathVal constants.
+func (v ClipPathVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ClipPathVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseClipPathVal.
+func (v *ClipPathVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseClipPathVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ClipRuleVal represents values for the clip-rule property.
+//
+//   - Initial: nonzero
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	nonzero | evenodd
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-rule
 type ClipRuleVal string
 
 // ClipRuleVal constants.
@@ -1417,47 +7303,272 @@ const (
 	ClipRuleValNonzero ClipRuleVal = "nonzero"
 )
 
-// ColorVal represents values for the color property.
-type ColorVal string
-
-// ColorVal constants.
-const (
-	ColorValColorBase             ColorVal = "color-base"
-	ColorValDeprecatedSystemColor ColorVal = "deprecated-system-color"
-	ColorValLightDark             ColorVal = "light-dark"
-	ColorValSystemColor           ColorVal = "system-color"
-)
+func (v ClipRuleVal) String() string { return string(v) }
+
+// Values returns every ClipRuleVal constant.
+func (ClipRuleVal) Values() []ClipRuleVal {
+	return []ClipRuleVal{
+		ClipRuleValEvenodd,
+		ClipRuleValNonzero,
+	}
+}
+
+// Valid reports whether v is one of the ClipRuleVal constants.
+func (v ClipRuleVal) Valid() bool {
+	switch v {
+	case ClipRuleValEvenodd,
+		ClipRuleValNonzero:
+		return true
+	}
+	return false
+}
+
+// ParseClipRuleVal returns the ClipRuleVal constant for a keyword, ignoring ASCII case.
+func ParseClipRuleVal(s string) (ClipRuleVal, error) {
+	v := ClipRuleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ClipRuleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ClipRuleVal constants.
+func (v ClipRuleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ClipRuleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseClipRuleVal.
+func (v *ClipRuleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseClipRuleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColorInterpolationFiltersVal represents values for the color-interpolation-filters property.
+//
+//   - Initial: linearRGB
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | sRGB | linearRGB
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/color-interpolation-filters
 type ColorInterpolationFiltersVal string
 
 // ColorInterpolationFiltersVal constants.
 const (
-	ColorInterpolationFiltersValAuto ColorInterpolationFiltersVal = "auto"
-)
+	ColorInterpolationFiltersValAuto      ColorInterpolationFiltersVal = "auto"
+	ColorInterpolationFiltersValLinearrgb ColorInterpolationFiltersVal = "linearrgb"
+	ColorInterpolationFiltersValSrgb      ColorInterpolationFiltersVal = "srgb"
+)
+
+func (v ColorInterpolationFiltersVal) String() string { return string(v) }
+
+// Values returns every ColorInterpolationFiltersVal constant.
+func (ColorInterpolationFiltersVal) Values() []ColorInterpolationFiltersVal {
+	return []ColorInterpolationFiltersVal{
+		ColorInterpolationFiltersValAuto,
+		ColorInterpolationFiltersValLinearrgb,
+		ColorInterpolationFiltersValSrgb,
+	}
+}
+
+// Valid reports whether v is one of the ColorInterpolationFiltersVal constants.
+func (v ColorInterpolationFiltersVal) Valid() bool {
+	switch v {
+	case ColorInterpolationFiltersValAuto,
+		ColorInterpolationFiltersValLinearrgb,
+		ColorInterpolationFiltersValSrgb:
+		return true
+	}
+	return false
+}
+
+// ParseColorInterpolationFiltersVal returns the ColorInterpolationFiltersVal constant for a keyword, ignoring ASCII case.
+func ParseColorInterpolationFiltersVal(s string) (ColorInterpolationFiltersVal, error) {
+	v := ColorInterpolationFiltersVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColorInterpolationFiltersVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColorInterpolationFiltersVal constants.
+func (v ColorInterpolationFiltersVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColorInterpolationFiltersVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColorInterpolationFiltersVal.
+func (v *ColorInterpolationFiltersVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColorInterpolationFiltersVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColorSchemeVal represents values for the color-scheme property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ light | dark | <custom-ident> ]+ && only?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/color-scheme
 type ColorSchemeVal string
 
 // ColorSchemeVal constants.
 const (
-	ColorSchemeValCustomIdent ColorSchemeVal = "custom-ident"
-	ColorSchemeValDark        ColorSchemeVal = "dark"
-	ColorSchemeValLight       ColorSchemeVal = "light"
-	ColorSchemeValNormal      ColorSchemeVal = "normal"
-	ColorSchemeValOnly        ColorSchemeVal = "only"
-)
+	ColorSchemeValDark   ColorSchemeVal = "dark"
+	ColorSchemeValLight  ColorSchemeVal = "light"
+	ColorSchemeValNormal ColorSchemeVal = "normal"
+	ColorSchemeValOnly   ColorSchemeVal = "only"
+)
+
+func (v ColorSchemeVal) String() string { return string(v) }
+
+// Values returns every ColorSchemeVal constant.
+func (ColorSchemeVal) Values() []ColorSchemeVal {
+	return []ColorSchemeVal{
+		ColorSchemeValDark,
+		ColorSchemeValLight,
+		ColorSchemeValNormal,
+		ColorSchemeValOnly,
+	}
+}
+
+// Valid reports whether v is one of the ColorSchemeVal constants.
+func (v ColorSchemeVal) Valid() bool {
+	switch v {
+	case ColorSchemeValDark,
+		ColorSchemeValLight,
+		ColorSchemeValNormal,
+		ColorSchemeValOnly:
+		return true
+	}
+	return false
+}
+
+// ParseColorSchemeVal returns the ColorSchemeVal constant for a keyword, ignoring ASCII case.
+func ParseColorSchemeVal(s string) (ColorSchemeVal, error) {
+	v := ColorSchemeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColorSchemeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColorSchemeVal constants.
+func (v ColorSchemeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColorSchemeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColorSchemeVal.
+func (v *ColorSchemeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColorSchemeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColumnCountVal represents values for the column-count property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-count
 type ColumnCountVal string
 
 // ColumnCountVal constants.
 const (
-	ColumnCountValAuto        ColumnCountVal = "auto"
-	ColumnCountValNumberToken ColumnCountVal = "number-token"
-)
+	ColumnCountValAuto ColumnCountVal = "auto"
+)
+
+func (v ColumnCountVal) String() string { return string(v) }
+
+// Values returns every ColumnCountVal constant.
+func (ColumnCountVal) Values() []ColumnCountVal {
+	return []ColumnCountVal{
+		ColumnCountValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ColumnCountVal constants.
+func (v ColumnCountVal) Valid() bool {
+	switch v {
+	case ColumnCountValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseColumnCountVal returns the ColumnCountVal constant for a keyword, ignoring ASCII case.
+func ParseColumnCountVal(s string) (ColumnCountVal, error) {
+	v := ColumnCountVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnCountVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnCountVal constants.
+func (v ColumnCountVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnCountVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnCountVal.
+func (v *ColumnCountVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnCountVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColumnFillVal represents values for the column-fill property.
+//
+//   - Initial: balance
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | balance
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-fill
 type ColumnFillVal string
 
 // ColumnFillVal constants.
@@ -1466,7 +7577,65 @@ const (
 	ColumnFillValBalance ColumnFillVal = "balance"
 )
 
+func (v ColumnFillVal) String() string { return string(v) }
+
+// Values returns every ColumnFillVal constant.
+func (ColumnFillVal) Values() []ColumnFillVal {
+	return []ColumnFillVal{
+		ColumnFillValAuto,
+		ColumnFillValBalance,
+	}
+}
+
+// Valid reports whether v is one of the ColumnFillVal constants.
+func (v ColumnFillVal) Valid() bool {
+	switch v {
+	case ColumnFillValAuto,
+		ColumnFillValBalance:
+		return true
+	}
+	return false
+}
+
+// ParseColumnFillVal returns the ColumnFillVal constant for a keyword, ignoring ASCII case.
+func ParseColumnFillVal(s string) (ColumnFillVal, error) {
+	v := ColumnFillVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnFillVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnFillVal constants.
+func (v ColumnFillVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnFillVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnFillVal.
+func (v *ColumnFillVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnFillVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ColumnGapVal represents values for the column-gap property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-gap
 type ColumnGapVal string
 
 // ColumnGapVal constants.
@@ -1474,52 +7643,391 @@ const (
 	ColumnGapValNormal ColumnGapVal = "normal"
 )
 
+func (v ColumnGapVal) String() string { return string(v) }
+
+// Values returns every ColumnGapVal constant.
+func (ColumnGapVal) Values() []ColumnGapVal {
+	return []ColumnGapVal{
+		ColumnGapValNormal,
+	}
+}
+
+// Valid reports whether v is one of the ColumnGapVal constants.
+func (v ColumnGapVal) Valid() bool {
+	switch v {
+	case ColumnGapValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseColumnGapVal returns the ColumnGapVal constant for a keyword, ignoring ASCII case.
+func ParseColumnGapVal(s string) (ColumnGapVal, error) {
+	v := ColumnGapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnGapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnGapVal constants.
+func (v ColumnGapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnGapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnGapVal.
+func (v *ColumnGapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnGapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ColumnRuleVal represents values for the column-rule property.
+//
+//   - Shorthand for: column-rule-color, column-rule-style, column-rule-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule
 type ColumnRuleVal string
 
 // ColumnRuleVal constants.
 const (
-	ColumnRuleValColumnRuleColor ColumnRuleVal = "column-rule-color"
-	ColumnRuleValColumnRuleStyle ColumnRuleVal = "column-rule-style"
-	ColumnRuleValColumnRuleWidth ColumnRuleVal = "column-rule-width"
-)
-
-// ColumnRuleColorVal represents values for the column-rule-color property.
-type ColumnRuleColorVal string
-
-// ColumnRuleColorVal constants.
-const (
-	ColumnRuleColorValColorBase             ColumnRuleColorVal = "color-base"
-	ColumnRuleColorValDeprecatedSystemColor ColumnRuleColorVal = "deprecated-system-color"
-	ColumnRuleColorValLightDark             ColumnRuleColorVal = "light-dark"
-	ColumnRuleColorValSystemColor           ColumnRuleColorVal = "system-color"
-)
+	ColumnRuleValDashed ColumnRuleVal = "dashed"
+	ColumnRuleValDotted ColumnRuleVal = "dotted"
+	ColumnRuleValDouble ColumnRuleVal = "double"
+	ColumnRuleValGroove ColumnRuleVal = "groove"
+	ColumnRuleValHidden ColumnRuleVal = "hidden"
+	ColumnRuleValInset  ColumnRuleVal = "inset"
+	ColumnRuleValMedium ColumnRuleVal = "medium"
+	ColumnRuleValNone   ColumnRuleVal = "none"
+	ColumnRuleValOutset ColumnRuleVal = "outset"
+	ColumnRuleValRidge  ColumnRuleVal = "ridge"
+	ColumnRuleValSolid  ColumnRuleVal = "solid"
+	ColumnRuleValThick  ColumnRuleVal = "thick"
+	ColumnRuleValThin   ColumnRuleVal = "thin"
+)
+
+func (v ColumnRuleVal) String() string { return string(v) }
+
+// Values returns every ColumnRuleVal constant.
+func (ColumnRuleVal) Values() []ColumnRuleVal {
+	return []ColumnRuleVal{
+		ColumnRuleValDashed,
+		ColumnRuleValDotted,
+		ColumnRuleValDouble,
+		ColumnRuleValGroove,
+		ColumnRuleValHidden,
+		ColumnRuleValInset,
+		ColumnRuleValMedium,
+		ColumnRuleValNone,
+		ColumnRuleValOutset,
+		ColumnRuleValRidge,
+		ColumnRuleValSolid,
+		ColumnRuleValThick,
+		ColumnRuleValThin,
+	}
+}
+
+// Valid reports whether v is one of the ColumnRuleVal constants.
+func (v ColumnRuleVal) Valid() bool {
+	switch v {
+	case ColumnRuleValDashed,
+		ColumnRuleValDotted,
+		ColumnRuleValDouble,
+		ColumnRuleValGroove,
+		ColumnRuleValHidden,
+		ColumnRuleValInset,
+		ColumnRuleValMedium,
+		ColumnRuleValNone,
+		ColumnRuleValOutset,
+		ColumnRuleValRidge,
+		ColumnRuleValSolid,
+		ColumnRuleValThick,
+		ColumnRuleValThin:
+		return true
+	}
+	return false
+}
+
+// ParseColumnRuleVal returns the ColumnRuleVal constant for a keyword, ignoring ASCII case.
+func ParseColumnRuleVal(s string) (ColumnRuleVal, error) {
+	v := ColumnRuleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnRuleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnRuleVal constants.
+func (v ColumnRuleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnRuleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnRuleVal.
+func (v *ColumnRuleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnRuleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColumnRuleStyleVal represents values for the column-rule-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-style
 type ColumnRuleStyleVal string
 
 // ColumnRuleStyleVal constants.
 const (
-	ColumnRuleStyleValBorderStyle ColumnRuleStyleVal = "border-style"
-)
+	ColumnRuleStyleValDashed ColumnRuleStyleVal = "dashed"
+	ColumnRuleStyleValDotted ColumnRuleStyleVal = "dotted"
+	ColumnRuleStyleValDouble ColumnRuleStyleVal = "double"
+	ColumnRuleStyleValGroove ColumnRuleStyleVal = "groove"
+	ColumnRuleStyleValHidden ColumnRuleStyleVal = "hidden"
+	ColumnRuleStyleValInset  ColumnRuleStyleVal = "inset"
+	ColumnRuleStyleValNone   ColumnRuleStyleVal = "none"
+	ColumnRuleStyleValOutset ColumnRuleStyleVal = "outset"
+	ColumnRuleStyleValRidge  ColumnRuleStyleVal = "ridge"
+	ColumnRuleStyleValSolid  ColumnRuleStyleVal = "solid"
+)
+
+func (v ColumnRuleStyleVal) String() string { return string(v) }
+
+// Values returns every ColumnRuleStyleVal constant.
+func (ColumnRuleStyleVal) Values() []ColumnRuleStyleVal {
+	return []ColumnRuleStyleVal{
+		ColumnRuleStyleValDashed,
+		ColumnRuleStyleValDotted,
+		ColumnRuleStyleValDouble,
+		ColumnRuleStyleValGroove,
+		ColumnRuleStyleValHidden,
+		ColumnRuleStyleValInset,
+		ColumnRuleStyleValNone,
+		ColumnRuleStyleValOutset,
+		ColumnRuleStyleValRidge,
+		ColumnRuleStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the ColumnRuleStyleVal constants.
+func (v ColumnRuleStyleVal) Valid() bool {
+	switch v {
+	case ColumnRuleStyleValDashed,
+		ColumnRuleStyleValDotted,
+		ColumnRuleStyleValDouble,
+		ColumnRuleStyleValGroove,
+		ColumnRuleStyleValHidden,
+		ColumnRuleStyleValInset,
+		ColumnRuleStyleValNone,
+		ColumnRuleStyleValOutset,
+		ColumnRuleStyleValRidge,
+		ColumnRuleStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseColumnRuleStyleVal returns the ColumnRuleStyleVal constant for a keyword, ignoring ASCII case.
+func ParseColumnRuleStyleVal(s string) (ColumnRuleStyleVal, error) {
+	v := ColumnRuleStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnRuleStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnRuleStyleVal constants.
+func (v ColumnRuleStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnRuleStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnRuleStyleVal.
+func (v *ColumnRuleStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnRuleStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColumnRuleWidthVal represents values for the column-rule-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-width
 type ColumnRuleWidthVal string
 
 // ColumnRuleWidthVal constants.
 const (
-	ColumnRuleWidthValBorderWidth ColumnRuleWidthVal = "border-width"
-)
+	ColumnRuleWidthValMedium ColumnRuleWidthVal = "medium"
+	ColumnRuleWidthValThick  ColumnRuleWidthVal = "thick"
+	ColumnRuleWidthValThin   ColumnRuleWidthVal = "thin"
+)
+
+func (v ColumnRuleWidthVal) String() string { return string(v) }
+
+// Values returns every ColumnRuleWidthVal constant.
+func (ColumnRuleWidthVal) Values() []ColumnRuleWidthVal {
+	return []ColumnRuleWidthVal{
+		ColumnRuleWidthValMedium,
+		ColumnRuleWidthValThick,
+		ColumnRuleWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the ColumnRuleWidthVal constants.
+func (v ColumnRuleWidthVal) Valid() bool {
+	switch v {
+	case ColumnRuleWidthValMedium,
+		ColumnRuleWidthValThick,
+		ColumnRuleWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseColumnRuleWidthVal returns the ColumnRuleWidthVal constant for a keyword, ignoring ASCII case.
+func ParseColumnRuleWidthVal(s string) (ColumnRuleWidthVal, error) {
+	v := ColumnRuleWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnRuleWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnRuleWidthVal constants.
+func (v ColumnRuleWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnRuleWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnRuleWidthVal.
+func (v *ColumnRuleWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnRuleWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ColumnSpanVal represents values for the column-span property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-span
 type ColumnSpanVal string
 
 // ColumnSpanVal constants.
 const (
+	ColumnSpanValAll  ColumnSpanVal = "all"
 	ColumnSpanValNone ColumnSpanVal = "none"
 )
 
+func (v ColumnSpanVal) String() string { return string(v) }
+
+// Values returns every ColumnSpanVal constant.
+func (ColumnSpanVal) Values() []ColumnSpanVal {
+	return []ColumnSpanVal{
+		ColumnSpanValAll,
+		ColumnSpanValNone,
+	}
+}
+
+// Valid reports whether v is one of the ColumnSpanVal constants.
+func (v ColumnSpanVal) Valid() bool {
+	switch v {
+	case ColumnSpanValAll,
+		ColumnSpanValNone:
+		return true
+	}
+	return false
+}
+
+// ParseColumnSpanVal returns the ColumnSpanVal constant for a keyword, ignoring ASCII case.
+func ParseColumnSpanVal(s string) (ColumnSpanVal, error) {
+	v := ColumnSpanVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnSpanVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnSpanVal constants.
+func (v ColumnSpanVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnSpanVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnSpanVal.
+func (v *ColumnSpanVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnSpanVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ColumnWidthVal represents values for the column-width property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-width
 type ColumnWidthVal string
 
 // ColumnWidthVal constants.
@@ -1527,16 +8035,127 @@ const (
 	ColumnWidthValAuto ColumnWidthVal = "auto"
 )
 
+func (v ColumnWidthVal) String() string { return string(v) }
+
+// Values returns every ColumnWidthVal constant.
+func (ColumnWidthVal) Values() []ColumnWidthVal {
+	return []ColumnWidthVal{
+		ColumnWidthValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ColumnWidthVal constants.
+func (v ColumnWidthVal) Valid() bool {
+	switch v {
+	case ColumnWidthValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseColumnWidthVal returns the ColumnWidthVal constant for a keyword, ignoring ASCII case.
+func ParseColumnWidthVal(s string) (ColumnWidthVal, error) {
+	v := ColumnWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnWidthVal constants.
+func (v ColumnWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnWidthVal.
+func (v *ColumnWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ColumnsVal represents values for the columns property.
+//
+//   - Shorthand for: column-count, column-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'column-width'> || <'column-count'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/columns
 type ColumnsVal string
 
 // ColumnsVal constants.
 const (
-	ColumnsValColumnCount ColumnsVal = "column-count"
-	ColumnsValColumnWidth ColumnsVal = "column-width"
-)
+	ColumnsValAuto ColumnsVal = "auto"
+)
+
+func (v ColumnsVal) String() string { return string(v) }
+
+// Values returns every ColumnsVal constant.
+func (ColumnsVal) Values() []ColumnsVal {
+	return []ColumnsVal{
+		ColumnsValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ColumnsVal constants.
+func (v ColumnsVal) Valid() bool {
+	switch v {
+	case ColumnsValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseColumnsVal returns the ColumnsVal constant for a keyword, ignoring ASCII case.
+func ParseColumnsVal(s string) (ColumnsVal, error) {
+	v := ColumnsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ColumnsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ColumnsVal constants.
+func (v ColumnsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ColumnsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseColumnsVal.
+func (v *ColumnsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseColumnsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ContainVal represents values for the contain property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | strict | content | [ [ size || inline-size ] || layout || style || paint ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain
 type ContainVal string
 
 // ContainVal constants.
@@ -1551,7 +8170,77 @@ const (
 	ContainValStyle      ContainVal = "style"
 )
 
+func (v ContainVal) String() string { return string(v) }
+
+// Values returns every ContainVal constant.
+func (ContainVal) Values() []ContainVal {
+	return []ContainVal{
+		ContainValContent,
+		ContainValInlineSize,
+		ContainValLayout,
+		ContainValNone,
+		ContainValPaint,
+		ContainValSize,
+		ContainValStrict,
+		ContainValStyle,
+	}
+}
+
+// Valid reports whether v is one of the ContainVal constants.
+func (v ContainVal) Valid() bool {
+	switch v {
+	case ContainValContent,
+		ContainValInlineSize,
+		ContainValLayout,
+		ContainValNone,
+		ContainValPaint,
+		ContainValSize,
+		ContainValStrict,
+		ContainValStyle:
+		return true
+	}
+	return false
+}
+
+// ParseContainVal returns the ContainVal constant for a keyword, ignoring ASCII case.
+func ParseContainVal(s string) (ContainVal, error) {
+	v := ContainVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainVal constants.
+func (v ContainVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainVal.
+func (v *ContainVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainIntrinsicBlockSizeVal represents values for the contain-intrinsic-block-size property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-block-size
 type ContainIntrinsicBlockSizeVal string
 
 // ContainIntrinsicBlockSizeVal constants.
@@ -1560,7 +8249,65 @@ const (
 	ContainIntrinsicBlockSizeValNone ContainIntrinsicBlockSizeVal = "none"
 )
 
+func (v ContainIntrinsicBlockSizeVal) String() string { return string(v) }
+
+// Values returns every ContainIntrinsicBlockSizeVal constant.
+func (ContainIntrinsicBlockSizeVal) Values() []ContainIntrinsicBlockSizeVal {
+	return []ContainIntrinsicBlockSizeVal{
+		ContainIntrinsicBlockSizeValAuto,
+		ContainIntrinsicBlockSizeValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainIntrinsicBlockSizeVal constants.
+func (v ContainIntrinsicBlockSizeVal) Valid() bool {
+	switch v {
+	case ContainIntrinsicBlockSizeValAuto,
+		ContainIntrinsicBlockSizeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainIntrinsicBlockSizeVal returns the ContainIntrinsicBlockSizeVal constant for a keyword, ignoring ASCII case.
+func ParseContainIntrinsicBlockSizeVal(s string) (ContainIntrinsicBlockSizeVal, error) {
+	v := ContainIntrinsicBlockSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainIntrinsicBlockSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainIntrinsicBlockSizeVal constants.
+func (v ContainIntrinsicBlockSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainIntrinsicBlockSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainIntrinsicBlockSizeVal.
+func (v *ContainIntrinsicBlockSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainIntrinsicBlockSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainIntrinsicHeightVal represents values for the contain-intrinsic-height property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-height
 type ContainIntrinsicHeightVal string
 
 // ContainIntrinsicHeightVal constants.
@@ -1569,7 +8316,65 @@ const (
 	ContainIntrinsicHeightValNone ContainIntrinsicHeightVal = "none"
 )
 
+func (v ContainIntrinsicHeightVal) String() string { return string(v) }
+
+// Values returns every ContainIntrinsicHeightVal constant.
+func (ContainIntrinsicHeightVal) Values() []ContainIntrinsicHeightVal {
+	return []ContainIntrinsicHeightVal{
+		ContainIntrinsicHeightValAuto,
+		ContainIntrinsicHeightValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainIntrinsicHeightVal constants.
+func (v ContainIntrinsicHeightVal) Valid() bool {
+	switch v {
+	case ContainIntrinsicHeightValAuto,
+		ContainIntrinsicHeightValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainIntrinsicHeightVal returns the ContainIntrinsicHeightVal constant for a keyword, ignoring ASCII case.
+func ParseContainIntrinsicHeightVal(s string) (ContainIntrinsicHeightVal, error) {
+	v := ContainIntrinsicHeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainIntrinsicHeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainIntrinsicHeightVal constants.
+func (v ContainIntrinsicHeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainIntrinsicHeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainIntrinsicHeightVal.
+func (v *ContainIntrinsicHeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainIntrinsicHeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainIntrinsicInlineSizeVal represents values for the contain-intrinsic-inline-size property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-inline-size
 type ContainIntrinsicInlineSizeVal string
 
 // ContainIntrinsicInlineSizeVal constants.
@@ -1578,18 +8383,132 @@ const (
 	ContainIntrinsicInlineSizeValNone ContainIntrinsicInlineSizeVal = "none"
 )
 
+func (v ContainIntrinsicInlineSizeVal) String() string { return string(v) }
+
+// Values returns every ContainIntrinsicInlineSizeVal constant.
+func (ContainIntrinsicInlineSizeVal) Values() []ContainIntrinsicInlineSizeVal {
+	return []ContainIntrinsicInlineSizeVal{
+		ContainIntrinsicInlineSizeValAuto,
+		ContainIntrinsicInlineSizeValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainIntrinsicInlineSizeVal constants.
+func (v ContainIntrinsicInlineSizeVal) Valid() bool {
+	switch v {
+	case ContainIntrinsicInlineSizeValAuto,
+		ContainIntrinsicInlineSizeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainIntrinsicInlineSizeVal returns the ContainIntrinsicInlineSizeVal constant for a keyword, ignoring ASCII case.
+func ParseContainIntrinsicInlineSizeVal(s string) (ContainIntrinsicInlineSizeVal, error) {
+	v := ContainIntrinsicInlineSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainIntrinsicInlineSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainIntrinsicInlineSizeVal constants.
+func (v ContainIntrinsicInlineSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainIntrinsicInlineSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainIntrinsicInlineSizeVal.
+func (v *ContainIntrinsicInlineSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainIntrinsicInlineSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainIntrinsicSizeVal represents values for the contain-intrinsic-size property.
+//
+//   - Shorthand for: contain-intrinsic-height, contain-intrinsic-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto? [ none | <length> ] ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-size
 type ContainIntrinsicSizeVal string
 
 // ContainIntrinsicSizeVal constants.
 const (
-	ContainIntrinsicSizeVal1    ContainIntrinsicSizeVal = "1"
-	ContainIntrinsicSizeVal2    ContainIntrinsicSizeVal = "2"
 	ContainIntrinsicSizeValAuto ContainIntrinsicSizeVal = "auto"
 	ContainIntrinsicSizeValNone ContainIntrinsicSizeVal = "none"
 )
 
+func (v ContainIntrinsicSizeVal) String() string { return string(v) }
+
+// Values returns every ContainIntrinsicSizeVal constant.
+func (ContainIntrinsicSizeVal) Values() []ContainIntrinsicSizeVal {
+	return []ContainIntrinsicSizeVal{
+		ContainIntrinsicSizeValAuto,
+		ContainIntrinsicSizeValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainIntrinsicSizeVal constants.
+func (v ContainIntrinsicSizeVal) Valid() bool {
+	switch v {
+	case ContainIntrinsicSizeValAuto,
+		ContainIntrinsicSizeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainIntrinsicSizeVal returns the ContainIntrinsicSizeVal constant for a keyword, ignoring ASCII case.
+func ParseContainIntrinsicSizeVal(s string) (ContainIntrinsicSizeVal, error) {
+	v := ContainIntrinsicSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainIntrinsicSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainIntrinsicSizeVal constants.
+func (v ContainIntrinsicSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainIntrinsicSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainIntrinsicSizeVal.
+func (v *ContainIntrinsicSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainIntrinsicSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainIntrinsicWidthVal represents values for the contain-intrinsic-width property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-width
 type ContainIntrinsicWidthVal string
 
 // ContainIntrinsicWidthVal constants.
@@ -1598,25 +8517,205 @@ const (
 	ContainIntrinsicWidthValNone ContainIntrinsicWidthVal = "none"
 )
 
+func (v ContainIntrinsicWidthVal) String() string { return string(v) }
+
+// Values returns every ContainIntrinsicWidthVal constant.
+func (ContainIntrinsicWidthVal) Values() []ContainIntrinsicWidthVal {
+	return []ContainIntrinsicWidthVal{
+		ContainIntrinsicWidthValAuto,
+		ContainIntrinsicWidthValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainIntrinsicWidthVal constants.
+func (v ContainIntrinsicWidthVal) Valid() bool {
+	switch v {
+	case ContainIntrinsicWidthValAuto,
+		ContainIntrinsicWidthValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainIntrinsicWidthVal returns the ContainIntrinsicWidthVal constant for a keyword, ignoring ASCII case.
+func ParseContainIntrinsicWidthVal(s string) (ContainIntrinsicWidthVal, error) {
+	v := ContainIntrinsicWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainIntrinsicWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainIntrinsicWidthVal constants.
+func (v ContainIntrinsicWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainIntrinsicWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainIntrinsicWidthVal.
+func (v *ContainIntrinsicWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainIntrinsicWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContainerVal represents values for the container property.
+//
+//   - Shorthand for: container-name, container-type
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'container-name'> [ / <'container-type'> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container
 type ContainerVal string
 
 // ContainerVal constants.
 const (
-	ContainerValContainerName ContainerVal = "container-name"
-	ContainerValContainerType ContainerVal = "container-type"
-)
+	ContainerValInlineSize  ContainerVal = "inline-size"
+	ContainerValNone        ContainerVal = "none"
+	ContainerValNormal      ContainerVal = "normal"
+	ContainerValScrollState ContainerVal = "scroll-state"
+	ContainerValSize        ContainerVal = "size"
+)
+
+func (v ContainerVal) String() string { return string(v) }
+
+// Values returns every ContainerVal constant.
+func (ContainerVal) Values() []ContainerVal {
+	return []ContainerVal{
+		ContainerValInlineSize,
+		ContainerValNone,
+		ContainerValNormal,
+		ContainerValScrollState,
+		ContainerValSize,
+	}
+}
+
+// Valid reports whether v is one of the ContainerVal constants.
+func (v ContainerVal) Valid() bool {
+	switch v {
+	case ContainerValInlineSize,
+		ContainerValNone,
+		ContainerValNormal,
+		ContainerValScrollState,
+		ContainerValSize:
+		return true
+	}
+	return false
+}
+
+// ParseContainerVal returns the ContainerVal constant for a keyword, ignoring ASCII case.
+func ParseContainerVal(s string) (ContainerVal, error) {
+	v := ContainerVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainerVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainerVal constants.
+func (v ContainerVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainerVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainerVal.
+func (v *ContainerVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainerVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ContainerNameVal represents values for the container-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container-name
 type ContainerNameVal string
 
 // ContainerNameVal constants.
 const (
-	ContainerNameValCustomIdent ContainerNameVal = "custom-ident"
-	ContainerNameValNone        ContainerNameVal = "none"
-)
+	ContainerNameValNone ContainerNameVal = "none"
+)
+
+func (v ContainerNameVal) String() string { return string(v) }
+
+// Values returns every ContainerNameVal constant.
+func (ContainerNameVal) Values() []ContainerNameVal {
+	return []ContainerNameVal{
+		ContainerNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the ContainerNameVal constants.
+func (v ContainerNameVal) Valid() bool {
+	switch v {
+	case ContainerNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseContainerNameVal returns the ContainerNameVal constant for a keyword, ignoring ASCII case.
+func ParseContainerNameVal(s string) (ContainerNameVal, error) {
+	v := ContainerNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainerNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainerNameVal constants.
+func (v ContainerNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainerNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainerNameVal.
+func (v *ContainerNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainerNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ContainerTypeVal represents values for the container-type property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ [ size | inline-size ] || scroll-state ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container-type
 type ContainerTypeVal string
 
 // ContainerTypeVal constants.
@@ -1627,22 +8726,151 @@ const (
 	ContainerTypeValSize        ContainerTypeVal = "size"
 )
 
+func (v ContainerTypeVal) String() string { return string(v) }
+
+// Values returns every ContainerTypeVal constant.
+func (ContainerTypeVal) Values() []ContainerTypeVal {
+	return []ContainerTypeVal{
+		ContainerTypeValInlineSize,
+		ContainerTypeValNormal,
+		ContainerTypeValScrollState,
+		ContainerTypeValSize,
+	}
+}
+
+// Valid reports whether v is one of the ContainerTypeVal constants.
+func (v ContainerTypeVal) Valid() bool {
+	switch v {
+	case ContainerTypeValInlineSize,
+		ContainerTypeValNormal,
+		ContainerTypeValScrollState,
+		ContainerTypeValSize:
+		return true
+	}
+	return false
+}
+
+// ParseContainerTypeVal returns the ContainerTypeVal constant for a keyword, ignoring ASCII case.
+func ParseContainerTypeVal(s string) (ContainerTypeVal, error) {
+	v := ContainerTypeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContainerTypeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContainerTypeVal constants.
+func (v ContainerTypeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContainerTypeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContainerTypeVal.
+func (v *ContainerTypeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContainerTypeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ContentVal represents values for the content property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <content-replacement> | <content-list> ] [ / [ <string> | <counter> | <attr()> ]+ ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/content
 type ContentVal string
 
 // ContentVal constants.
 const (
-	ContentValAttrFallback ContentVal = "attr-fallback"
-	ContentValAttrName     ContentVal = "attr-name"
-	ContentValCounter      ContentVal = "counter"
-	ContentValCounters     ContentVal = "counters"
+	ContentValCloseQuote   ContentVal = "close-quote"
+	ContentValImage        ContentVal = "image"
+	ContentValNoCloseQuote ContentVal = "no-close-quote"
+	ContentValNoOpenQuote  ContentVal = "no-open-quote"
 	ContentValNone         ContentVal = "none"
 	ContentValNormal       ContentVal = "normal"
-	ContentValQuote        ContentVal = "quote"
-	ContentValTypeOrUnit   ContentVal = "type-or-unit"
-)
+	ContentValOpenQuote    ContentVal = "open-quote"
+)
+
+func (v ContentVal) String() string { return string(v) }
+
+// Values returns every ContentVal constant.
+func (ContentVal) Values() []ContentVal {
+	return []ContentVal{
+		ContentValCloseQuote,
+		ContentValImage,
+		ContentValNoCloseQuote,
+		ContentValNoOpenQuote,
+		ContentValNone,
+		ContentValNormal,
+		ContentValOpenQuote,
+	}
+}
+
+// Valid reports whether v is one of the ContentVal constants.
+func (v ContentVal) Valid() bool {
+	switch v {
+	case ContentValCloseQuote,
+		ContentValImage,
+		ContentValNoCloseQuote,
+		ContentValNoOpenQuote,
+		ContentValNone,
+		ContentValNormal,
+		ContentValOpenQuote:
+		return true
+	}
+	return false
+}
+
+// ParseContentVal returns the ContentVal constant for a keyword, ignoring ASCII case.
+func ParseContentVal(s string) (ContentVal, error) {
+	v := ContentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContentVal constants.
+func (v ContentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContentVal.
+func (v *ContentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ContentVisibilityVal represents values for the content-visibility property.
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | auto | hidden
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/content-visibility
 type ContentVisibilityVal string
 
 // ContentVisibilityVal constants.
@@ -1652,39 +8880,259 @@ const (
 	ContentVisibilityValVisible ContentVisibilityVal = "visible"
 )
 
+func (v ContentVisibilityVal) String() string { return string(v) }
+
+// Values returns every ContentVisibilityVal constant.
+func (ContentVisibilityVal) Values() []ContentVisibilityVal {
+	return []ContentVisibilityVal{
+		ContentVisibilityValAuto,
+		ContentVisibilityValHidden,
+		ContentVisibilityValVisible,
+	}
+}
+
+// Valid reports whether v is one of the ContentVisibilityVal constants.
+func (v ContentVisibilityVal) Valid() bool {
+	switch v {
+	case ContentVisibilityValAuto,
+		ContentVisibilityValHidden,
+		ContentVisibilityValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseContentVisibilityVal returns the ContentVisibilityVal constant for a keyword, ignoring ASCII case.
+func ParseContentVisibilityVal(s string) (ContentVisibilityVal, error) {
+	v := ContentVisibilityVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ContentVisibilityVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ContentVisibilityVal constants.
+func (v ContentVisibilityVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ContentVisibilityVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseContentVisibilityVal.
+func (v *ContentVisibilityVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseContentVisibilityVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // CounterIncrementVal represents values for the counter-increment property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-increment
 type CounterIncrementVal string
 
 // CounterIncrementVal constants.
 const (
-	CounterIncrementValCustomIdent CounterIncrementVal = "custom-ident"
-	CounterIncrementValNone        CounterIncrementVal = "none"
-	CounterIncrementValNumberToken CounterIncrementVal = "number-token"
-)
+	CounterIncrementValNone CounterIncrementVal = "none"
+)
+
+func (v CounterIncrementVal) String() string { return string(v) }
+
+// Values returns every CounterIncrementVal constant.
+func (CounterIncrementVal) Values() []CounterIncrementVal {
+	return []CounterIncrementVal{
+		CounterIncrementValNone,
+	}
+}
+
+// Valid reports whether v is one of the CounterIncrementVal constants.
+func (v CounterIncrementVal) Valid() bool {
+	switch v {
+	case CounterIncrementValNone:
+		return true
+	}
+	return false
+}
+
+// ParseCounterIncrementVal returns the CounterIncrementVal constant for a keyword, ignoring ASCII case.
+func ParseCounterIncrementVal(s string) (CounterIncrementVal, error) {
+	v := CounterIncrementVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterIncrementVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterIncrementVal constants.
+func (v CounterIncrementVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterIncrementVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterIncrementVal.
+func (v *CounterIncrementVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterIncrementVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // CounterResetVal represents values for the counter-reset property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? | <reversed-counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-reset
 type CounterResetVal string
 
 // CounterResetVal constants.
 const (
-	CounterResetValCounterName CounterResetVal = "counter-name"
-	CounterResetValCustomIdent CounterResetVal = "custom-ident"
-	CounterResetValNone        CounterResetVal = "none"
-	CounterResetValNumberToken CounterResetVal = "number-token"
-	CounterResetValReversed    CounterResetVal = "reversed"
-)
+	CounterResetValNone CounterResetVal = "none"
+)
+
+func (v CounterResetVal) String() string { return string(v) }
+
+// Values returns every CounterResetVal constant.
+func (CounterResetVal) Values() []CounterResetVal {
+	return []CounterResetVal{
+		CounterResetValNone,
+	}
+}
+
+// Valid reports whether v is one of the CounterResetVal constants.
+func (v CounterResetVal) Valid() bool {
+	switch v {
+	case CounterResetValNone:
+		return true
+	}
+	return false
+}
+
+// ParseCounterResetVal returns the CounterResetVal constant for a keyword, ignoring ASCII case.
+func ParseCounterResetVal(s string) (CounterResetVal, error) {
+	v := CounterResetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterResetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterResetVal constants.
+func (v CounterResetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterResetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterResetVal.
+func (v *CounterResetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterResetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // CounterSetVal represents values for the counter-set property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-set
 type CounterSetVal string
 
 // CounterSetVal constants.
 const (
-	CounterSetValCustomIdent CounterSetVal = "custom-ident"
-	CounterSetValNone        CounterSetVal = "none"
-	CounterSetValNumberToken CounterSetVal = "number-token"
-)
+	CounterSetValNone CounterSetVal = "none"
+)
+
+func (v CounterSetVal) String() string { return string(v) }
+
+// Values returns every CounterSetVal constant.
+func (CounterSetVal) Values() []CounterSetVal {
+	return []CounterSetVal{
+		CounterSetValNone,
+	}
+}
+
+// Valid reports whether v is one of the CounterSetVal constants.
+func (v CounterSetVal) Valid() bool {
+	switch v {
+	case CounterSetValNone:
+		return true
+	}
+	return false
+}
+
+// ParseCounterSetVal returns the CounterSetVal constant for a keyword, ignoring ASCII case.
+func ParseCounterSetVal(s string) (CounterSetVal, error) {
+	v := CounterSetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CounterSetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CounterSetVal constants.
+func (v CounterSetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CounterSetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCounterSetVal.
+func (v *CounterSetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCounterSetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // CursorVal represents values for the cursor property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/cursor
 type CursorVal string
 
 // CursorVal constants.
@@ -1727,16 +9175,197 @@ const (
 	CursorValZoomOut      CursorVal = "zoom-out"
 )
 
+func (v CursorVal) String() string { return string(v) }
+
+// Values returns every CursorVal constant.
+func (CursorVal) Values() []CursorVal {
+	return []CursorVal{
+		CursorValAlias,
+		CursorValAllScroll,
+		CursorValAuto,
+		CursorValCell,
+		CursorValColResize,
+		CursorValContextMenu,
+		CursorValCopy,
+		CursorValCrosshair,
+		CursorValDefault,
+		CursorValEResize,
+		CursorValEwResize,
+		CursorValGrab,
+		CursorValGrabbing,
+		CursorValHelp,
+		CursorValMove,
+		CursorValNResize,
+		CursorValNeResize,
+		CursorValNeswResize,
+		CursorValNoDrop,
+		CursorValNone,
+		CursorValNotAllowed,
+		CursorValNsResize,
+		CursorValNwResize,
+		CursorValNwseResize,
+		CursorValPointer,
+		CursorValProgress,
+		CursorValRowResize,
+		CursorValSResize,
+		CursorValSeResize,
+		CursorValSwResize,
+		CursorValText,
+		CursorValVerticalText,
+		CursorValWResize,
+		CursorValWait,
+		CursorValZoomIn,
+		CursorValZoomOut,
+	}
+}
+
+// Valid reports whether v is one of the CursorVal constants.
+func (v CursorVal) Valid() bool {
+	switch v {
+	case CursorValAlias,
+		CursorValAllScroll,
+		CursorValAuto,
+		CursorValCell,
+		CursorValColResize,
+		CursorValContextMenu,
+		CursorValCopy,
+		CursorValCrosshair,
+		CursorValDefault,
+		CursorValEResize,
+		CursorValEwResize,
+		CursorValGrab,
+		CursorValGrabbing,
+		CursorValHelp,
+		CursorValMove,
+		CursorValNResize,
+		CursorValNeResize,
+		CursorValNeswResize,
+		CursorValNoDrop,
+		CursorValNone,
+		CursorValNotAllowed,
+		CursorValNsResize,
+		CursorValNwResize,
+		CursorValNwseResize,
+		CursorValPointer,
+		CursorValProgress,
+		CursorValRowResize,
+		CursorValSResize,
+		CursorValSeResize,
+		CursorValSwResize,
+		CursorValText,
+		CursorValVerticalText,
+		CursorValWResize,
+		CursorValWait,
+		CursorValZoomIn,
+		CursorValZoomOut:
+		return true
+	}
+	return false
+}
+
+// ParseCursorVal returns the CursorVal constant for a keyword, ignoring ASCII case.
+func ParseCursorVal(s string) (CursorVal, error) {
+	v := CursorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "CursorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not CursorVal constants.
+func (v CursorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "CursorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseCursorVal.
+func (v *CursorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseCursorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // DVal represents values for the d property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | path(<string>)
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/d
 type DVal string
 
 // DVal constants.
 const (
 	DValNone DVal = "none"
-	DValPath DVal = "path"
 )
 
+func (v DVal) String() string { return string(v) }
+
+// Values returns every DVal constant.
+func (DVal) Values() []DVal {
+	return []DVal{
+		DValNone,
+	}
+}
+
+// Valid reports whether v is one of the DVal constants.
+func (v DVal) Valid() bool {
+	switch v {
+	case DValNone:
+		return true
+	}
+	return false
+}
+
+// ParseDVal returns the DVal constant for a keyword, ignoring ASCII case.
+func ParseDVal(s string) (DVal, error) {
+	v := DVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "DVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not DVal constants.
+func (v DVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "DVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseDVal.
+func (v *DVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // DirectionVal represents values for the direction property.
+//
+//   - Initial: ltr
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	ltr | rtl
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/direction
 type DirectionVal string
 
 // DirectionVal constants.
@@ -1745,14 +9374,71 @@ const (
 	DirectionValRtl DirectionVal = "rtl"
 )
 
+func (v DirectionVal) String() string { return string(v) }
+
+// Values returns every DirectionVal constant.
+func (DirectionVal) Values() []DirectionVal {
+	return []DirectionVal{
+		DirectionValLtr,
+		DirectionValRtl,
+	}
+}
+
+// Valid reports whether v is one of the DirectionVal constants.
+func (v DirectionVal) Valid() bool {
+	switch v {
+	case DirectionValLtr,
+		DirectionValRtl:
+		return true
+	}
+	return false
+}
+
+// ParseDirectionVal returns the DirectionVal constant for a keyword, ignoring ASCII case.
+func ParseDirectionVal(s string) (DirectionVal, error) {
+	v := DirectionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "DirectionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not DirectionVal constants.
+func (v DirectionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "DirectionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseDirectionVal.
+func (v *DirectionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDirectionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // DisplayVal represents values for the display property.
+//
+//   - Initial: inline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/display
 type DisplayVal string
 
 // DisplayVal constants.
 const (
 	DisplayValBlock             DisplayVal = "block"
 	DisplayValContents          DisplayVal = "contents"
-	DisplayValDisplayOutside    DisplayVal = "display-outside"
 	DisplayValFlex              DisplayVal = "flex"
 	DisplayValFlow              DisplayVal = "flow"
 	DisplayValFlowRoot          DisplayVal = "flow-root"
@@ -1782,7 +9468,119 @@ const (
 	DisplayValTableRowGroup     DisplayVal = "table-row-group"
 )
 
+func (v DisplayVal) String() string { return string(v) }
+
+// Values returns every DisplayVal constant.
+func (DisplayVal) Values() []DisplayVal {
+	return []DisplayVal{
+		DisplayValBlock,
+		DisplayValContents,
+		DisplayValFlex,
+		DisplayValFlow,
+		DisplayValFlowRoot,
+		DisplayValGrid,
+		DisplayValInline,
+		DisplayValInlineBlock,
+		DisplayValInlineFlex,
+		DisplayValInlineGrid,
+		DisplayValInlineListItem,
+		DisplayValInlineTable,
+		DisplayValListItem,
+		DisplayValNone,
+		DisplayValRuby,
+		DisplayValRubyBase,
+		DisplayValRubyBaseContainer,
+		DisplayValRubyText,
+		DisplayValRubyTextContainer,
+		DisplayValRunIn,
+		DisplayValTable,
+		DisplayValTableCaption,
+		DisplayValTableCell,
+		DisplayValTableColumn,
+		DisplayValTableColumnGroup,
+		DisplayValTableFooterGroup,
+		DisplayValTableHeaderGroup,
+		DisplayValTableRow,
+		DisplayValTableRowGroup,
+	}
+}
+
+// Valid reports whether v is one of the DisplayVal constants.
+func (v DisplayVal) Valid() bool {
+	switch v {
+	case DisplayValBlock,
+		DisplayValContents,
+		DisplayValFlex,
+		DisplayValFlow,
+		DisplayValFlowRoot,
+		DisplayValGrid,
+		DisplayValInline,
+		DisplayValInlineBlock,
+		DisplayValInlineFlex,
+		DisplayValInlineGrid,
+		DisplayValInlineListItem,
+		DisplayValInlineTable,
+		DisplayValListItem,
+		DisplayValNone,
+		DisplayValRuby,
+		DisplayValRubyBase,
+		DisplayValRubyBaseContainer,
+		DisplayValRubyText,
+		DisplayValRubyTextContainer,
+		DisplayValRunIn,
+		DisplayValTable,
+		DisplayValTableCaption,
+		DisplayValTableCell,
+		DisplayValTableColumn,
+		DisplayValTableColumnGroup,
+		DisplayValTableFooterGroup,
+		DisplayValTableHeaderGroup,
+		DisplayValTableRow,
+		DisplayValTableRowGroup:
+		return true
+	}
+	return false
+}
+
+// ParseDisplayVal returns the DisplayVal constant for a keyword, ignoring ASCII case.
+func ParseDisplayVal(s string) (DisplayVal, error) {
+	v := DisplayVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "DisplayVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not DisplayVal constants.
+func (v DisplayVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "DisplayVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseDisplayVal.
+func (v *DisplayVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDisplayVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // DominantBaselineVal represents values for the dominant-baseline property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/dominant-baseline
 type DominantBaselineVal string
 
 // DominantBaselineVal constants.
@@ -1798,7 +9596,79 @@ const (
 	DominantBaselineValTextTop      DominantBaselineVal = "text-top"
 )
 
+func (v DominantBaselineVal) String() string { return string(v) }
+
+// Values returns every DominantBaselineVal constant.
+func (DominantBaselineVal) Values() []DominantBaselineVal {
+	return []DominantBaselineVal{
+		DominantBaselineValAlphabetic,
+		DominantBaselineValAuto,
+		DominantBaselineValCentral,
+		DominantBaselineValHanging,
+		DominantBaselineValIdeographic,
+		DominantBaselineValMathematical,
+		DominantBaselineValMiddle,
+		DominantBaselineValTextBottom,
+		DominantBaselineValTextTop,
+	}
+}
+
+// Valid reports whether v is one of the DominantBaselineVal constants.
+func (v DominantBaselineVal) Valid() bool {
+	switch v {
+	case DominantBaselineValAlphabetic,
+		DominantBaselineValAuto,
+		DominantBaselineValCentral,
+		DominantBaselineValHanging,
+		DominantBaselineValIdeographic,
+		DominantBaselineValMathematical,
+		DominantBaselineValMiddle,
+		DominantBaselineValTextBottom,
+		DominantBaselineValTextTop:
+		return true
+	}
+	return false
+}
+
+// ParseDominantBaselineVal returns the DominantBaselineVal constant for a keyword, ignoring ASCII case.
+func ParseDominantBaselineVal(s string) (DominantBaselineVal, error) {
+	v := DominantBaselineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "DominantBaselineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not DominantBaselineVal constants.
+func (v DominantBaselineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "DominantBaselineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseDominantBaselineVal.
+func (v *DominantBaselineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDominantBaselineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // EmptyCellsVal represents values for the empty-cells property.
+//
+//   - Initial: show
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	show | hide
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/empty-cells
 type EmptyCellsVal string
 
 // EmptyCellsVal constants.
@@ -1807,7 +9677,65 @@ const (
 	EmptyCellsValShow EmptyCellsVal = "show"
 )
 
+func (v EmptyCellsVal) String() string { return string(v) }
+
+// Values returns every EmptyCellsVal constant.
+func (EmptyCellsVal) Values() []EmptyCellsVal {
+	return []EmptyCellsVal{
+		EmptyCellsValHide,
+		EmptyCellsValShow,
+	}
+}
+
+// Valid reports whether v is one of the EmptyCellsVal constants.
+func (v EmptyCellsVal) Valid() bool {
+	switch v {
+	case EmptyCellsValHide,
+		EmptyCellsValShow:
+		return true
+	}
+	return false
+}
+
+// ParseEmptyCellsVal returns the EmptyCellsVal constant for a keyword, ignoring ASCII case.
+func ParseEmptyCellsVal(s string) (EmptyCellsVal, error) {
+	v := EmptyCellsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "EmptyCellsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not EmptyCellsVal constants.
+func (v EmptyCellsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "EmptyCellsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseEmptyCellsVal.
+func (v *EmptyCellsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseEmptyCellsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FillVal represents values for the fill property.
+//
+//   - Initial: black
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<paint>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/fill
 type FillVal string
 
 // FillVal constants.
@@ -1817,15 +9745,67 @@ const (
 	FillValNone          FillVal = "none"
 )
 
-// FillOpacityVal represents values for the fill-opacity property.
-type FillOpacityVal string
-
-// FillOpacityVal constants.
-const (
-	FillOpacityValOpacity FillOpacityVal = "opacity"
-)
+func (v FillVal) String() string { return string(v) }
+
+// Values returns every FillVal constant.
+func (FillVal) Values() []FillVal {
+	return []FillVal{
+		FillValContextFill,
+		FillValContextStroke,
+		FillValNone,
+	}
+}
+
+// Valid reports whether v is one of the FillVal constants.
+func (v FillVal) Valid() bool {
+	switch v {
+	case FillValContextFill,
+		FillValContextStroke,
+		FillValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFillVal returns the FillVal constant for a keyword, ignoring ASCII case.
+func ParseFillVal(s string) (FillVal, error) {
+	v := FillVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FillVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FillVal constants.
+func (v FillVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FillVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFillVal.
+func (v *FillVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFillVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FillRuleVal represents values for the fill-rule property.
+//
+//   - Initial: nonzero
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	nonzero | evenodd
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/fill-rule
 type FillRuleVal string
 
 // FillRuleVal constants.
@@ -1834,36 +9814,284 @@ const (
 	FillRuleValNonzero FillRuleVal = "nonzero"
 )
 
+func (v FillRuleVal) String() string { return string(v) }
+
+// Values returns every FillRuleVal constant.
+func (FillRuleVal) Values() []FillRuleVal {
+	return []FillRuleVal{
+		FillRuleValEvenodd,
+		FillRuleValNonzero,
+	}
+}
+
+// Valid reports whether v is one of the FillRuleVal constants.
+func (v FillRuleVal) Valid() bool {
+	switch v {
+	case FillRuleValEvenodd,
+		FillRuleValNonzero:
+		return true
+	}
+	return false
+}
+
+// ParseFillRuleVal returns the FillRuleVal constant for a keyword, ignoring ASCII case.
+func ParseFillRuleVal(s string) (FillRuleVal, error) {
+	v := FillRuleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FillRuleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FillRuleVal constants.
+func (v FillRuleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FillRuleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFillRuleVal.
+func (v *FillRuleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFillRuleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FilterVal represents values for the filter property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <filter-value-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/filter
 type FilterVal string
 
 // FilterVal constants.
 const (
-	FilterValFilterFunction FilterVal = "filter-function"
-	FilterValNone           FilterVal = "none"
-)
+	FilterValNone FilterVal = "none"
+)
+
+func (v FilterVal) String() string { return string(v) }
+
+// Values returns every FilterVal constant.
+func (FilterVal) Values() []FilterVal {
+	return []FilterVal{
+		FilterValNone,
+	}
+}
+
+// Valid reports whether v is one of the FilterVal constants.
+func (v FilterVal) Valid() bool {
+	switch v {
+	case FilterValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFilterVal returns the FilterVal constant for a keyword, ignoring ASCII case.
+func ParseFilterVal(s string) (FilterVal, error) {
+	v := FilterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FilterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FilterVal constants.
+func (v FilterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FilterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFilterVal.
+func (v *FilterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFilterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FlexVal represents values for the flex property.
+//
+//   - Shorthand for: flex-basis, flex-grow, flex-shrink
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex
 type FlexVal string
 
 // FlexVal constants.
 const (
-	FlexValFlexBasis  FlexVal = "flex-basis"
-	FlexValFlexGrow   FlexVal = "flex-grow"
-	FlexValFlexShrink FlexVal = "flex-shrink"
+	FlexValAuto       FlexVal = "auto"
+	FlexValContent    FlexVal = "content"
+	FlexValFitContent FlexVal = "fit-content"
+	FlexValMaxContent FlexVal = "max-content"
+	FlexValMinContent FlexVal = "min-content"
 	FlexValNone       FlexVal = "none"
 )
 
+func (v FlexVal) String() string { return string(v) }
+
+// Values returns every FlexVal constant.
+func (FlexVal) Values() []FlexVal {
+	return []FlexVal{
+		FlexValAuto,
+		FlexValContent,
+		FlexValFitContent,
+		FlexValMaxContent,
+		FlexValMinContent,
+		FlexValNone,
+	}
+}
+
+// Valid reports whether v is one of the FlexVal constants.
+func (v FlexVal) Valid() bool {
+	switch v {
+	case FlexValAuto,
+		FlexValContent,
+		FlexValFitContent,
+		FlexValMaxContent,
+		FlexValMinContent,
+		FlexValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFlexVal returns the FlexVal constant for a keyword, ignoring ASCII case.
+func ParseFlexVal(s string) (FlexVal, error) {
+	v := FlexVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FlexVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FlexVal constants.
+func (v FlexVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FlexVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFlexVal.
+func (v *FlexVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFlexVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FlexBasisVal represents values for the flex-basis property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content | <'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-basis
 type FlexBasisVal string
 
 // FlexBasisVal constants.
 const (
-	FlexBasisValContent FlexBasisVal = "content"
-	FlexBasisValWidth   FlexBasisVal = "width"
-)
+	FlexBasisValAuto       FlexBasisVal = "auto"
+	FlexBasisValContent    FlexBasisVal = "content"
+	FlexBasisValFitContent FlexBasisVal = "fit-content"
+	FlexBasisValMaxContent FlexBasisVal = "max-content"
+	FlexBasisValMinContent FlexBasisVal = "min-content"
+)
+
+func (v FlexBasisVal) String() string { return string(v) }
+
+// Values returns every FlexBasisVal constant.
+func (FlexBasisVal) Values() []FlexBasisVal {
+	return []FlexBasisVal{
+		FlexBasisValAuto,
+		FlexBasisValContent,
+		FlexBasisValFitContent,
+		FlexBasisValMaxContent,
+		FlexBasisValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the FlexBasisVal constants.
+func (v FlexBasisVal) Valid() bool {
+	switch v {
+	case FlexBasisValAuto,
+		FlexBasisValContent,
+		FlexBasisValFitContent,
+		FlexBasisValMaxContent,
+		FlexBasisValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseFlexBasisVal returns the FlexBasisVal constant for a keyword, ignoring ASCII case.
+func ParseFlexBasisVal(s string) (FlexBasisVal, error) {
+	v := FlexBasisVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FlexBasisVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FlexBasisVal constants.
+func (v FlexBasisVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FlexBasisVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFlexBasisVal.
+func (v *FlexBasisVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFlexBasisVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FlexDirectionVal represents values for the flex-direction property.
+//
+//   - Initial: row
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	row | row-reverse | column | column-reverse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-direction
 type FlexDirectionVal string
 
 // FlexDirectionVal constants.
@@ -1874,16 +10102,151 @@ const (
 	FlexDirectionValRowReverse    FlexDirectionVal = "row-reverse"
 )
 
+func (v FlexDirectionVal) String() string { return string(v) }
+
+// Values returns every FlexDirectionVal constant.
+func (FlexDirectionVal) Values() []FlexDirectionVal {
+	return []FlexDirectionVal{
+		FlexDirectionValColumn,
+		FlexDirectionValColumnReverse,
+		FlexDirectionValRow,
+		FlexDirectionValRowReverse,
+	}
+}
+
+// Valid reports whether v is one of the FlexDirectionVal constants.
+func (v FlexDirectionVal) Valid() bool {
+	switch v {
+	case FlexDirectionValColumn,
+		FlexDirectionValColumnReverse,
+		FlexDirectionValRow,
+		FlexDirectionValRowReverse:
+		return true
+	}
+	return false
+}
+
+// ParseFlexDirectionVal returns the FlexDirectionVal constant for a keyword, ignoring ASCII case.
+func ParseFlexDirectionVal(s string) (FlexDirectionVal, error) {
+	v := FlexDirectionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FlexDirectionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FlexDirectionVal constants.
+func (v FlexDirectionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FlexDirectionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFlexDirectionVal.
+func (v *FlexDirectionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFlexDirectionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FlexFlowVal represents values for the flex-flow property.
+//
+//   - Shorthand for: flex-direction, flex-wrap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'flex-direction'> || <'flex-wrap'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-flow
 type FlexFlowVal string
 
 // FlexFlowVal constants.
 const (
-	FlexFlowValFlexDirection FlexFlowVal = "flex-direction"
-	FlexFlowValFlexWrap      FlexFlowVal = "flex-wrap"
-)
+	FlexFlowValColumn        FlexFlowVal = "column"
+	FlexFlowValColumnReverse FlexFlowVal = "column-reverse"
+	FlexFlowValNowrap        FlexFlowVal = "nowrap"
+	FlexFlowValRow           FlexFlowVal = "row"
+	FlexFlowValRowReverse    FlexFlowVal = "row-reverse"
+	FlexFlowValWrap          FlexFlowVal = "wrap"
+	FlexFlowValWrapReverse   FlexFlowVal = "wrap-reverse"
+)
+
+func (v FlexFlowVal) String() string { return string(v) }
+
+// Values returns every FlexFlowVal constant.
+func (FlexFlowVal) Values() []FlexFlowVal {
+	return []FlexFlowVal{
+		FlexFlowValColumn,
+		FlexFlowValColumnReverse,
+		FlexFlowValNowrap,
+		FlexFlowValRow,
+		FlexFlowValRowReverse,
+		FlexFlowValWrap,
+		FlexFlowValWrapReverse,
+	}
+}
+
+// Valid reports whether v is one of the FlexFlowVal constants.
+func (v FlexFlowVal) Valid() bool {
+	switch v {
+	case FlexFlowValColumn,
+		FlexFlowValColumnReverse,
+		FlexFlowValNowrap,
+		FlexFlowValRow,
+		FlexFlowValRowReverse,
+		FlexFlowValWrap,
+		FlexFlowValWrapReverse:
+		return true
+	}
+	return false
+}
+
+// ParseFlexFlowVal returns the FlexFlowVal constant for a keyword, ignoring ASCII case.
+func ParseFlexFlowVal(s string) (FlexFlowVal, error) {
+	v := FlexFlowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FlexFlowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FlexFlowVal constants.
+func (v FlexFlowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FlexFlowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFlexFlowVal.
+func (v *FlexFlowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFlexFlowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FlexWrapVal represents values for the flex-wrap property.
+//
+//   - Initial: nowrap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	nowrap | wrap | wrap-reverse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-wrap
 type FlexWrapVal string
 
 // FlexWrapVal constants.
@@ -1893,7 +10256,67 @@ const (
 	FlexWrapValWrapReverse FlexWrapVal = "wrap-reverse"
 )
 
+func (v FlexWrapVal) String() string { return string(v) }
+
+// Values returns every FlexWrapVal constant.
+func (FlexWrapVal) Values() []FlexWrapVal {
+	return []FlexWrapVal{
+		FlexWrapValNowrap,
+		FlexWrapValWrap,
+		FlexWrapValWrapReverse,
+	}
+}
+
+// Valid reports whether v is one of the FlexWrapVal constants.
+func (v FlexWrapVal) Valid() bool {
+	switch v {
+	case FlexWrapValNowrap,
+		FlexWrapValWrap,
+		FlexWrapValWrapReverse:
+		return true
+	}
+	return false
+}
+
+// ParseFlexWrapVal returns the FlexWrapVal constant for a keyword, ignoring ASCII case.
+func ParseFlexWrapVal(s string) (FlexWrapVal, error) {
+	v := FlexWrapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FlexWrapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FlexWrapVal constants.
+func (v FlexWrapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FlexWrapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFlexWrapVal.
+func (v *FlexWrapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFlexWrapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FloatVal represents values for the float property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	left | right | none | inline-start | inline-end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/float
 type FloatVal string
 
 // FloatVal constants.
@@ -1905,66 +10328,364 @@ const (
 	FloatValRight       FloatVal = "right"
 )
 
-// FloodColorVal represents values for the flood-color property.
-type FloodColorVal string
-
-// FloodColorVal constants.
-const (
-	FloodColorValColorBase             FloodColorVal = "color-base"
-	FloodColorValDeprecatedSystemColor FloodColorVal = "deprecated-system-color"
-	FloodColorValLightDark             FloodColorVal = "light-dark"
-	FloodColorValSystemColor           FloodColorVal = "system-color"
-)
-
-// FloodOpacityVal represents values for the flood-opacity property.
-type FloodOpacityVal string
-
-// FloodOpacityVal constants.
-const (
-	FloodOpacityValOpacity FloodOpacityVal = "opacity"
-)
+func (v FloatVal) String() string { return string(v) }
+
+// Values returns every FloatVal constant.
+func (FloatVal) Values() []FloatVal {
+	return []FloatVal{
+		FloatValInlineEnd,
+		FloatValInlineStart,
+		FloatValLeft,
+		FloatValNone,
+		FloatValRight,
+	}
+}
+
+// Valid reports whether v is one of the FloatVal constants.
+func (v FloatVal) Valid() bool {
+	switch v {
+	case FloatValInlineEnd,
+		FloatValInlineStart,
+		FloatValLeft,
+		FloatValNone,
+		FloatValRight:
+		return true
+	}
+	return false
+}
+
+// ParseFloatVal returns the FloatVal constant for a keyword, ignoring ASCII case.
+func ParseFloatVal(s string) (FloatVal, error) {
+	v := FloatVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FloatVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FloatVal constants.
+func (v FloatVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FloatVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFloatVal.
+func (v *FloatVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFloatVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontVal represents values for the font property.
+//
+//   - Shorthand for: font-family, font-size, font-stretch, font-style, font-variant, font-weight, line-height
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'># ] | <system-family-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font
 type FontVal string
 
 // FontVal constants.
 const (
+	FontValBold           FontVal = "bold"
+	FontValBolder         FontVal = "bolder"
 	FontValCaption        FontVal = "caption"
 	FontValCondensed      FontVal = "condensed"
+	FontValCursive        FontVal = "cursive"
+	FontValEmoji          FontVal = "emoji"
 	FontValExpanded       FontVal = "expanded"
 	FontValExtraCondensed FontVal = "extra-condensed"
 	FontValExtraExpanded  FontVal = "extra-expanded"
-	FontValFontFamily     FontVal = "font-family"
-	FontValFontSize       FontVal = "font-size"
-	FontValFontStyle      FontVal = "font-style"
-	FontValFontWeight     FontVal = "font-weight"
+	FontValFangsong       FontVal = "fangsong"
+	FontValFantasy        FontVal = "fantasy"
 	FontValIcon           FontVal = "icon"
-	FontValLineHeight     FontVal = "line-height"
+	FontValItalic         FontVal = "italic"
+	FontValLarge          FontVal = "large"
+	FontValLarger         FontVal = "larger"
+	FontValLighter        FontVal = "lighter"
+	FontValMath           FontVal = "math"
+	FontValMedium         FontVal = "medium"
 	FontValMenu           FontVal = "menu"
 	FontValMessageBox     FontVal = "message-box"
+	FontValMonospace      FontVal = "monospace"
 	FontValNormal         FontVal = "normal"
+	FontValOblique        FontVal = "oblique"
+	FontValSansSerif      FontVal = "sans-serif"
 	FontValSemiCondensed  FontVal = "semi-condensed"
 	FontValSemiExpanded   FontVal = "semi-expanded"
+	FontValSerif          FontVal = "serif"
+	FontValSmall          FontVal = "small"
 	FontValSmallCaps      FontVal = "small-caps"
 	FontValSmallCaption   FontVal = "small-caption"
+	FontValSmaller        FontVal = "smaller"
 	FontValStatusBar      FontVal = "status-bar"
+	FontValSystemUi       FontVal = "system-ui"
+	FontValUiMonospace    FontVal = "ui-monospace"
+	FontValUiRounded      FontVal = "ui-rounded"
+	FontValUiSansSerif    FontVal = "ui-sans-serif"
+	FontValUiSerif        FontVal = "ui-serif"
 	FontValUltraCondensed FontVal = "ultra-condensed"
 	FontValUltraExpanded  FontVal = "ultra-expanded"
-)
+	FontValXLarge         FontVal = "x-large"
+	FontValXSmall         FontVal = "x-small"
+	FontValXxLarge        FontVal = "xx-large"
+	FontValXxSmall        FontVal = "xx-small"
+	FontValXxxLarge       FontVal = "xxx-large"
+)
+
+func (v FontVal) String() string { return string(v) }
+
+// Values returns every FontVal constant.
+func (FontVal) Values() []FontVal {
+	return []FontVal{
+		FontValBold,
+		FontValBolder,
+		FontValCaption,
+		FontValCondensed,
+		FontValCursive,
+		FontValEmoji,
+		FontValExpanded,
+		FontValExtraCondensed,
+		FontValExtraExpanded,
+		FontValFangsong,
+		FontValFantasy,
+		FontValIcon,
+		FontValItalic,
+		FontValLarge,
+		FontValLarger,
+		FontValLighter,
+		FontValMath,
+		FontValMedium,
+		FontValMenu,
+		FontValMessageBox,
+		FontValMonospace,
+		FontValNormal,
+		FontValOblique,
+		FontValSansSerif,
+		FontValSemiCondensed,
+		FontValSemiExpanded,
+		FontValSerif,
+		FontValSmall,
+		FontValSmallCaps,
+		FontValSmallCaption,
+		FontValSmaller,
+		FontValStatusBar,
+		FontValSystemUi,
+		FontValUiMonospace,
+		FontValUiRounded,
+		FontValUiSansSerif,
+		FontValUiSerif,
+		FontValUltraCondensed,
+		FontValUltraExpanded,
+		FontValXLarge,
+		FontValXSmall,
+		FontValXxLarge,
+		FontValXxSmall,
+		FontValXxxLarge,
+	}
+}
+
+// Valid reports whether v is one of the FontVal constants.
+func (v FontVal) Valid() bool {
+	switch v {
+	case FontValBold,
+		FontValBolder,
+		FontValCaption,
+		FontValCondensed,
+		FontValCursive,
+		FontValEmoji,
+		FontValExpanded,
+		FontValExtraCondensed,
+		FontValExtraExpanded,
+		FontValFangsong,
+		FontValFantasy,
+		FontValIcon,
+		FontValItalic,
+		FontValLarge,
+		FontValLarger,
+		FontValLighter,
+		FontValMath,
+		FontValMedium,
+		FontValMenu,
+		FontValMessageBox,
+		FontValMonospace,
+		FontValNormal,
+		FontValOblique,
+		FontValSansSerif,
+		FontValSemiCondensed,
+		FontValSemiExpanded,
+		FontValSerif,
+		FontValSmall,
+		FontValSmallCaps,
+		FontValSmallCaption,
+		FontValSmaller,
+		FontValStatusBar,
+		FontValSystemUi,
+		FontValUiMonospace,
+		FontValUiRounded,
+		FontValUiSansSerif,
+		FontValUiSerif,
+		FontValUltraCondensed,
+		FontValUltraExpanded,
+		FontValXLarge,
+		FontValXSmall,
+		FontValXxLarge,
+		FontValXxSmall,
+		FontValXxxLarge:
+		return true
+	}
+	return false
+}
+
+// ParseFontVal returns the FontVal constant for a keyword, ignoring ASCII case.
+func ParseFontVal(s string) (FontVal, error) {
+	v := FontVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVal constants.
+func (v FontVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVal.
+func (v *FontVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontFamilyVal represents values for the font-family property.
+//
+//   - Initial: dependsOnUserAgent
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <family-name> | <generic-family> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-family
 type FontFamilyVal string
 
 // FontFamilyVal constants.
 const (
-	FontFamilyValCustomIdent       FontFamilyVal = "custom-ident"
-	FontFamilyValEmoji             FontFamilyVal = "emoji"
-	FontFamilyValFangsong          FontFamilyVal = "fangsong"
-	FontFamilyValGenericComplete   FontFamilyVal = "generic-complete"
-	FontFamilyValGenericIncomplete FontFamilyVal = "generic-incomplete"
-)
+	FontFamilyValCursive     FontFamilyVal = "cursive"
+	FontFamilyValEmoji       FontFamilyVal = "emoji"
+	FontFamilyValFangsong    FontFamilyVal = "fangsong"
+	FontFamilyValFantasy     FontFamilyVal = "fantasy"
+	FontFamilyValMath        FontFamilyVal = "math"
+	FontFamilyValMonospace   FontFamilyVal = "monospace"
+	FontFamilyValSansSerif   FontFamilyVal = "sans-serif"
+	FontFamilyValSerif       FontFamilyVal = "serif"
+	FontFamilyValSystemUi    FontFamilyVal = "system-ui"
+	FontFamilyValUiMonospace FontFamilyVal = "ui-monospace"
+	FontFamilyValUiRounded   FontFamilyVal = "ui-rounded"
+	FontFamilyValUiSansSerif FontFamilyVal = "ui-sans-serif"
+	FontFamilyValUiSerif     FontFamilyVal = "ui-serif"
+)
+
+func (v FontFamilyVal) String() string { return string(v) }
+
+// Values returns every FontFamilyVal constant.
+func (FontFamilyVal) Values() []FontFamilyVal {
+	return []FontFamilyVal{
+		FontFamilyValCursive,
+		FontFamilyValEmoji,
+		FontFamilyValFangsong,
+		FontFamilyValFantasy,
+		FontFamilyValMath,
+		FontFamilyValMonospace,
+		FontFamilyValSansSerif,
+		FontFamilyValSerif,
+		FontFamilyValSystemUi,
+		FontFamilyValUiMonospace,
+		FontFamilyValUiRounded,
+		FontFamilyValUiSansSerif,
+		FontFamilyValUiSerif,
+	}
+}
+
+// Valid reports whether v is one of the FontFamilyVal constants.
+func (v FontFamilyVal) Valid() bool {
+	switch v {
+	case FontFamilyValCursive,
+		FontFamilyValEmoji,
+		FontFamilyValFangsong,
+		FontFamilyValFantasy,
+		FontFamilyValMath,
+		FontFamilyValMonospace,
+		FontFamilyValSansSerif,
+		FontFamilyValSerif,
+		FontFamilyValSystemUi,
+		FontFamilyValUiMonospace,
+		FontFamilyValUiRounded,
+		FontFamilyValUiSansSerif,
+		FontFamilyValUiSerif:
+		return true
+	}
+	return false
+}
+
+// ParseFontFamilyVal returns the FontFamilyVal constant for a keyword, ignoring ASCII case.
+func ParseFontFamilyVal(s string) (FontFamilyVal, error) {
+	v := FontFamilyVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFamilyVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFamilyVal constants.
+func (v FontFamilyVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFamilyVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFamilyVal.
+func (v *FontFamilyVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFamilyVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontFeatureSettingsVal represents values for the font-feature-settings property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <feature-tag-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-feature-settings
 type FontFeatureSettingsVal string
 
 // FontFeatureSettingsVal constants.
@@ -1974,7 +10695,67 @@ const (
 	FontFeatureSettingsValOn     FontFeatureSettingsVal = "on"
 )
 
+func (v FontFeatureSettingsVal) String() string { return string(v) }
+
+// Values returns every FontFeatureSettingsVal constant.
+func (FontFeatureSettingsVal) Values() []FontFeatureSettingsVal {
+	return []FontFeatureSettingsVal{
+		FontFeatureSettingsValNormal,
+		FontFeatureSettingsValOff,
+		FontFeatureSettingsValOn,
+	}
+}
+
+// Valid reports whether v is one of the FontFeatureSettingsVal constants.
+func (v FontFeatureSettingsVal) Valid() bool {
+	switch v {
+	case FontFeatureSettingsValNormal,
+		FontFeatureSettingsValOff,
+		FontFeatureSettingsValOn:
+		return true
+	}
+	return false
+}
+
+// ParseFontFeatureSettingsVal returns the FontFeatureSettingsVal constant for a keyword, ignoring ASCII case.
+func ParseFontFeatureSettingsVal(s string) (FontFeatureSettingsVal, error) {
+	v := FontFeatureSettingsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontFeatureSettingsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontFeatureSettingsVal constants.
+func (v FontFeatureSettingsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontFeatureSettingsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontFeatureSettingsVal.
+func (v *FontFeatureSettingsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontFeatureSettingsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontKerningVal represents values for the font-kerning property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-kerning
 type FontKerningVal string
 
 // FontKerningVal constants.
@@ -1984,7 +10765,67 @@ const (
 	FontKerningValNormal FontKerningVal = "normal"
 )
 
+func (v FontKerningVal) String() string { return string(v) }
+
+// Values returns every FontKerningVal constant.
+func (FontKerningVal) Values() []FontKerningVal {
+	return []FontKerningVal{
+		FontKerningValAuto,
+		FontKerningValNone,
+		FontKerningValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontKerningVal constants.
+func (v FontKerningVal) Valid() bool {
+	switch v {
+	case FontKerningValAuto,
+		FontKerningValNone,
+		FontKerningValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontKerningVal returns the FontKerningVal constant for a keyword, ignoring ASCII case.
+func ParseFontKerningVal(s string) (FontKerningVal, error) {
+	v := FontKerningVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontKerningVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontKerningVal constants.
+func (v FontKerningVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontKerningVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontKerningVal.
+func (v *FontKerningVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontKerningVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontLanguageOverrideVal represents values for the font-language-override property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-language-override
 type FontLanguageOverrideVal string
 
 // FontLanguageOverrideVal constants.
@@ -1992,7 +10833,63 @@ const (
 	FontLanguageOverrideValNormal FontLanguageOverrideVal = "normal"
 )
 
+func (v FontLanguageOverrideVal) String() string { return string(v) }
+
+// Values returns every FontLanguageOverrideVal constant.
+func (FontLanguageOverrideVal) Values() []FontLanguageOverrideVal {
+	return []FontLanguageOverrideVal{
+		FontLanguageOverrideValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontLanguageOverrideVal constants.
+func (v FontLanguageOverrideVal) Valid() bool {
+	switch v {
+	case FontLanguageOverrideValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontLanguageOverrideVal returns the FontLanguageOverrideVal constant for a keyword, ignoring ASCII case.
+func ParseFontLanguageOverrideVal(s string) (FontLanguageOverrideVal, error) {
+	v := FontLanguageOverrideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontLanguageOverrideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontLanguageOverrideVal constants.
+func (v FontLanguageOverrideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontLanguageOverrideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontLanguageOverrideVal.
+func (v *FontLanguageOverrideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontLanguageOverrideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontOpticalSizingVal represents values for the font-optical-sizing property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-optical-sizing
 type FontOpticalSizingVal string
 
 // FontOpticalSizingVal constants.
@@ -2001,44 +10898,229 @@ const (
 	FontOpticalSizingValNone FontOpticalSizingVal = "none"
 )
 
+func (v FontOpticalSizingVal) String() string { return string(v) }
+
+// Values returns every FontOpticalSizingVal constant.
+func (FontOpticalSizingVal) Values() []FontOpticalSizingVal {
+	return []FontOpticalSizingVal{
+		FontOpticalSizingValAuto,
+		FontOpticalSizingValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontOpticalSizingVal constants.
+func (v FontOpticalSizingVal) Valid() bool {
+	switch v {
+	case FontOpticalSizingValAuto,
+		FontOpticalSizingValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontOpticalSizingVal returns the FontOpticalSizingVal constant for a keyword, ignoring ASCII case.
+func ParseFontOpticalSizingVal(s string) (FontOpticalSizingVal, error) {
+	v := FontOpticalSizingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontOpticalSizingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontOpticalSizingVal constants.
+func (v FontOpticalSizingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontOpticalSizingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontOpticalSizingVal.
+func (v *FontOpticalSizingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontOpticalSizingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontPaletteVal represents values for the font-palette property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | light | dark | <palette-identifier> | <palette-mix()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-palette
 type FontPaletteVal string
 
 // FontPaletteVal constants.
 const (
-	FontPaletteVal0                        FontPaletteVal = "0"
-	FontPaletteVal100                      FontPaletteVal = "100"
-	FontPaletteVal2                        FontPaletteVal = "2"
-	FontPaletteValColorInterpolationMethod FontPaletteVal = "color-interpolation-method"
-	FontPaletteValDark                     FontPaletteVal = "dark"
-	FontPaletteValDashedIdent              FontPaletteVal = "dashed-ident"
-	FontPaletteValLight                    FontPaletteVal = "light"
-	FontPaletteValNormal                   FontPaletteVal = "normal"
-	FontPaletteValPaletteIdentifier        FontPaletteVal = "palette-identifier"
-	FontPaletteValPaletteMix               FontPaletteVal = "palette-mix"
-)
+	FontPaletteValDark   FontPaletteVal = "dark"
+	FontPaletteValLight  FontPaletteVal = "light"
+	FontPaletteValNormal FontPaletteVal = "normal"
+)
+
+func (v FontPaletteVal) String() string { return string(v) }
+
+// Values returns every FontPaletteVal constant.
+func (FontPaletteVal) Values() []FontPaletteVal {
+	return []FontPaletteVal{
+		FontPaletteValDark,
+		FontPaletteValLight,
+		FontPaletteValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontPaletteVal constants.
+func (v FontPaletteVal) Valid() bool {
+	switch v {
+	case FontPaletteValDark,
+		FontPaletteValLight,
+		FontPaletteValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontPaletteVal returns the FontPaletteVal constant for a keyword, ignoring ASCII case.
+func ParseFontPaletteVal(s string) (FontPaletteVal, error) {
+	v := FontPaletteVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontPaletteVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontPaletteVal constants.
+func (v FontPaletteVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontPaletteVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontPaletteVal.
+func (v *FontPaletteVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontPaletteVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontSizeVal represents values for the font-size property.
+//
+//   - Initial: medium
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size
 type FontSizeVal string
 
 // FontSizeVal constants.
 const (
-	FontSizeVal0                FontSizeVal = "0"
-	FontSizeValLarge            FontSizeVal = "large"
-	FontSizeValLarger           FontSizeVal = "larger"
-	FontSizeValLengthPercentage FontSizeVal = "length-percentage"
-	FontSizeValMath             FontSizeVal = "math"
-	FontSizeValMedium           FontSizeVal = "medium"
-	FontSizeValSmall            FontSizeVal = "small"
-	FontSizeValSmaller          FontSizeVal = "smaller"
-	FontSizeValXLarge           FontSizeVal = "x-large"
-	FontSizeValXSmall           FontSizeVal = "x-small"
-	FontSizeValXxLarge          FontSizeVal = "xx-large"
-	FontSizeValXxSmall          FontSizeVal = "xx-small"
-	FontSizeValXxxLarge         FontSizeVal = "xxx-large"
-)
+	FontSizeValLarge    FontSizeVal = "large"
+	FontSizeValLarger   FontSizeVal = "larger"
+	FontSizeValMath     FontSizeVal = "math"
+	FontSizeValMedium   FontSizeVal = "medium"
+	FontSizeValSmall    FontSizeVal = "small"
+	FontSizeValSmaller  FontSizeVal = "smaller"
+	FontSizeValXLarge   FontSizeVal = "x-large"
+	FontSizeValXSmall   FontSizeVal = "x-small"
+	FontSizeValXxLarge  FontSizeVal = "xx-large"
+	FontSizeValXxSmall  FontSizeVal = "xx-small"
+	FontSizeValXxxLarge FontSizeVal = "xxx-large"
+)
+
+func (v FontSizeVal) String() string { return string(v) }
+
+// Values returns every FontSizeVal constant.
+func (FontSizeVal) Values() []FontSizeVal {
+	return []FontSizeVal{
+		FontSizeValLarge,
+		FontSizeValLarger,
+		FontSizeValMath,
+		FontSizeValMedium,
+		FontSizeValSmall,
+		FontSizeValSmaller,
+		FontSizeValXLarge,
+		FontSizeValXSmall,
+		FontSizeValXxLarge,
+		FontSizeValXxSmall,
+		FontSizeValXxxLarge,
+	}
+}
+
+// Valid reports whether v is one of the FontSizeVal constants.
+func (v FontSizeVal) Valid() bool {
+	switch v {
+	case FontSizeValLarge,
+		FontSizeValLarger,
+		FontSizeValMath,
+		FontSizeValMedium,
+		FontSizeValSmall,
+		FontSizeValSmaller,
+		FontSizeValXLarge,
+		FontSizeValXSmall,
+		FontSizeValXxLarge,
+		FontSizeValXxSmall,
+		FontSizeValXxxLarge:
+		return true
+	}
+	return false
+}
+
+// ParseFontSizeVal returns the FontSizeVal constant for a keyword, ignoring ASCII case.
+func ParseFontSizeVal(s string) (FontSizeVal, error) {
+	v := FontSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSizeVal constants.
+func (v FontSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSizeVal.
+func (v *FontSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontSizeAdjustVal represents values for the font-size-adjust property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size-adjust
 type FontSizeAdjustVal string
 
 // FontSizeAdjustVal constants.
@@ -2052,7 +11134,75 @@ const (
 	FontSizeAdjustValNone      FontSizeAdjustVal = "none"
 )
 
+func (v FontSizeAdjustVal) String() string { return string(v) }
+
+// Values returns every FontSizeAdjustVal constant.
+func (FontSizeAdjustVal) Values() []FontSizeAdjustVal {
+	return []FontSizeAdjustVal{
+		FontSizeAdjustValCapHeight,
+		FontSizeAdjustValChWidth,
+		FontSizeAdjustValExHeight,
+		FontSizeAdjustValFromFont,
+		FontSizeAdjustValIcHeight,
+		FontSizeAdjustValIcWidth,
+		FontSizeAdjustValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontSizeAdjustVal constants.
+func (v FontSizeAdjustVal) Valid() bool {
+	switch v {
+	case FontSizeAdjustValCapHeight,
+		FontSizeAdjustValChWidth,
+		FontSizeAdjustValExHeight,
+		FontSizeAdjustValFromFont,
+		FontSizeAdjustValIcHeight,
+		FontSizeAdjustValIcWidth,
+		FontSizeAdjustValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontSizeAdjustVal returns the FontSizeAdjustVal constant for a keyword, ignoring ASCII case.
+func ParseFontSizeAdjustVal(s string) (FontSizeAdjustVal, error) {
+	v := FontSizeAdjustVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSizeAdjustVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSizeAdjustVal constants.
+func (v FontSizeAdjustVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSizeAdjustVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSizeAdjustVal.
+func (v *FontSizeAdjustVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSizeAdjustVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontSmoothVal represents values for the font-smooth property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	auto | never | always | <absolute-size> | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-smooth
 type FontSmoothVal string
 
 // FontSmoothVal constants.
@@ -2070,7 +11220,85 @@ const (
 	FontSmoothValXxxLarge FontSmoothVal = "xxx-large"
 )
 
+func (v FontSmoothVal) String() string { return string(v) }
+
+// Values returns every FontSmoothVal constant.
+func (FontSmoothVal) Values() []FontSmoothVal {
+	return []FontSmoothVal{
+		FontSmoothValAlways,
+		FontSmoothValAuto,
+		FontSmoothValLarge,
+		FontSmoothValMedium,
+		FontSmoothValNever,
+		FontSmoothValSmall,
+		FontSmoothValXLarge,
+		FontSmoothValXSmall,
+		FontSmoothValXxLarge,
+		FontSmoothValXxSmall,
+		FontSmoothValXxxLarge,
+	}
+}
+
+// Valid reports whether v is one of the FontSmoothVal constants.
+func (v FontSmoothVal) Valid() bool {
+	switch v {
+	case FontSmoothValAlways,
+		FontSmoothValAuto,
+		FontSmoothValLarge,
+		FontSmoothValMedium,
+		FontSmoothValNever,
+		FontSmoothValSmall,
+		FontSmoothValXLarge,
+		FontSmoothValXSmall,
+		FontSmoothValXxLarge,
+		FontSmoothValXxSmall,
+		FontSmoothValXxxLarge:
+		return true
+	}
+	return false
+}
+
+// ParseFontSmoothVal returns the FontSmoothVal constant for a keyword, ignoring ASCII case.
+func ParseFontSmoothVal(s string) (FontSmoothVal, error) {
+	v := FontSmoothVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSmoothVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSmoothVal constants.
+func (v FontSmoothVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSmoothVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSmoothVal.
+func (v *FontSmoothVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSmoothVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontStretchVal represents values for the font-stretch property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<font-stretch-absolute>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-stretch
+//
+// Deprecated: the font-stretch property is obsolete.
 type FontStretchVal string
 
 // FontStretchVal constants.
@@ -2086,18 +11314,149 @@ const (
 	FontStretchValUltraExpanded  FontStretchVal = "ultra-expanded"
 )
 
+func (v FontStretchVal) String() string { return string(v) }
+
+// Values returns every FontStretchVal constant.
+func (FontStretchVal) Values() []FontStretchVal {
+	return []FontStretchVal{
+		FontStretchValCondensed,
+		FontStretchValExpanded,
+		FontStretchValExtraCondensed,
+		FontStretchValExtraExpanded,
+		FontStretchValNormal,
+		FontStretchValSemiCondensed,
+		FontStretchValSemiExpanded,
+		FontStretchValUltraCondensed,
+		FontStretchValUltraExpanded,
+	}
+}
+
+// Valid reports whether v is one of the FontStretchVal constants.
+func (v FontStretchVal) Valid() bool {
+	switch v {
+	case FontStretchValCondensed,
+		FontStretchValExpanded,
+		FontStretchValExtraCondensed,
+		FontStretchValExtraExpanded,
+		FontStretchValNormal,
+		FontStretchValSemiCondensed,
+		FontStretchValSemiExpanded,
+		FontStretchValUltraCondensed,
+		FontStretchValUltraExpanded:
+		return true
+	}
+	return false
+}
+
+// ParseFontStretchVal returns the FontStretchVal constant for a keyword, ignoring ASCII case.
+func ParseFontStretchVal(s string) (FontStretchVal, error) {
+	v := FontStretchVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontStretchVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontStretchVal constants.
+func (v FontStretchVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontStretchVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontStretchVal.
+func (v *FontStretchVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontStretchVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontStyleVal represents values for the font-style property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | italic | oblique <angle>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-style
 type FontStyleVal string
 
 // FontStyleVal constants.
 const (
-	FontStyleValAngle   FontStyleVal = "angle"
 	FontStyleValItalic  FontStyleVal = "italic"
 	FontStyleValNormal  FontStyleVal = "normal"
 	FontStyleValOblique FontStyleVal = "oblique"
 )
 
+func (v FontStyleVal) String() string { return string(v) }
+
+// Values returns every FontStyleVal constant.
+func (FontStyleVal) Values() []FontStyleVal {
+	return []FontStyleVal{
+		FontStyleValItalic,
+		FontStyleValNormal,
+		FontStyleValOblique,
+	}
+}
+
+// Valid reports whether v is one of the FontStyleVal constants.
+func (v FontStyleVal) Valid() bool {
+	switch v {
+	case FontStyleValItalic,
+		FontStyleValNormal,
+		FontStyleValOblique:
+		return true
+	}
+	return false
+}
+
+// ParseFontStyleVal returns the FontStyleVal constant for a keyword, ignoring ASCII case.
+func ParseFontStyleVal(s string) (FontStyleVal, error) {
+	v := FontStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontStyleVal constants.
+func (v FontStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontStyleVal.
+func (v *FontStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontSynthesisVal represents values for the font-synthesis property.
+//
+//   - Initial: weight style small-caps position
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ weight || style || small-caps || position]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis
 type FontSynthesisVal string
 
 // FontSynthesisVal constants.
@@ -2109,7 +11468,71 @@ const (
 	FontSynthesisValWeight    FontSynthesisVal = "weight"
 )
 
+func (v FontSynthesisVal) String() string { return string(v) }
+
+// Values returns every FontSynthesisVal constant.
+func (FontSynthesisVal) Values() []FontSynthesisVal {
+	return []FontSynthesisVal{
+		FontSynthesisValNone,
+		FontSynthesisValPosition,
+		FontSynthesisValSmallCaps,
+		FontSynthesisValStyle,
+		FontSynthesisValWeight,
+	}
+}
+
+// Valid reports whether v is one of the FontSynthesisVal constants.
+func (v FontSynthesisVal) Valid() bool {
+	switch v {
+	case FontSynthesisValNone,
+		FontSynthesisValPosition,
+		FontSynthesisValSmallCaps,
+		FontSynthesisValStyle,
+		FontSynthesisValWeight:
+		return true
+	}
+	return false
+}
+
+// ParseFontSynthesisVal returns the FontSynthesisVal constant for a keyword, ignoring ASCII case.
+func ParseFontSynthesisVal(s string) (FontSynthesisVal, error) {
+	v := FontSynthesisVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSynthesisVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSynthesisVal constants.
+func (v FontSynthesisVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSynthesisVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSynthesisVal.
+func (v *FontSynthesisVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSynthesisVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontSynthesisSmallCapsVal represents values for the font-synthesis-small-caps property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-small-caps
 type FontSynthesisSmallCapsVal string
 
 // FontSynthesisSmallCapsVal constants.
@@ -2118,7 +11541,65 @@ const (
 	FontSynthesisSmallCapsValNone FontSynthesisSmallCapsVal = "none"
 )
 
+func (v FontSynthesisSmallCapsVal) String() string { return string(v) }
+
+// Values returns every FontSynthesisSmallCapsVal constant.
+func (FontSynthesisSmallCapsVal) Values() []FontSynthesisSmallCapsVal {
+	return []FontSynthesisSmallCapsVal{
+		FontSynthesisSmallCapsValAuto,
+		FontSynthesisSmallCapsValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontSynthesisSmallCapsVal constants.
+func (v FontSynthesisSmallCapsVal) Valid() bool {
+	switch v {
+	case FontSynthesisSmallCapsValAuto,
+		FontSynthesisSmallCapsValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontSynthesisSmallCapsVal returns the FontSynthesisSmallCapsVal constant for a keyword, ignoring ASCII case.
+func ParseFontSynthesisSmallCapsVal(s string) (FontSynthesisSmallCapsVal, error) {
+	v := FontSynthesisSmallCapsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSynthesisSmallCapsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSynthesisSmallCapsVal constants.
+func (v FontSynthesisSmallCapsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSynthesisSmallCapsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSynthesisSmallCapsVal.
+func (v *FontSynthesisSmallCapsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSynthesisSmallCapsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontSynthesisStyleVal represents values for the font-synthesis-style property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-style
 type FontSynthesisStyleVal string
 
 // FontSynthesisStyleVal constants.
@@ -2127,7 +11608,65 @@ const (
 	FontSynthesisStyleValNone FontSynthesisStyleVal = "none"
 )
 
+func (v FontSynthesisStyleVal) String() string { return string(v) }
+
+// Values returns every FontSynthesisStyleVal constant.
+func (FontSynthesisStyleVal) Values() []FontSynthesisStyleVal {
+	return []FontSynthesisStyleVal{
+		FontSynthesisStyleValAuto,
+		FontSynthesisStyleValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontSynthesisStyleVal constants.
+func (v FontSynthesisStyleVal) Valid() bool {
+	switch v {
+	case FontSynthesisStyleValAuto,
+		FontSynthesisStyleValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontSynthesisStyleVal returns the FontSynthesisStyleVal constant for a keyword, ignoring ASCII case.
+func ParseFontSynthesisStyleVal(s string) (FontSynthesisStyleVal, error) {
+	v := FontSynthesisStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSynthesisStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSynthesisStyleVal constants.
+func (v FontSynthesisStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSynthesisStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSynthesisStyleVal.
+func (v *FontSynthesisStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSynthesisStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontSynthesisWeightVal represents values for the font-synthesis-weight property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-weight
 type FontSynthesisWeightVal string
 
 // FontSynthesisWeightVal constants.
@@ -2136,23 +11675,82 @@ const (
 	FontSynthesisWeightValNone FontSynthesisWeightVal = "none"
 )
 
+func (v FontSynthesisWeightVal) String() string { return string(v) }
+
+// Values returns every FontSynthesisWeightVal constant.
+func (FontSynthesisWeightVal) Values() []FontSynthesisWeightVal {
+	return []FontSynthesisWeightVal{
+		FontSynthesisWeightValAuto,
+		FontSynthesisWeightValNone,
+	}
+}
+
+// Valid reports whether v is one of the FontSynthesisWeightVal constants.
+func (v FontSynthesisWeightVal) Valid() bool {
+	switch v {
+	case FontSynthesisWeightValAuto,
+		FontSynthesisWeightValNone:
+		return true
+	}
+	return false
+}
+
+// ParseFontSynthesisWeightVal returns the FontSynthesisWeightVal constant for a keyword, ignoring ASCII case.
+func ParseFontSynthesisWeightVal(s string) (FontSynthesisWeightVal, error) {
+	v := FontSynthesisWeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontSynthesisWeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontSynthesisWeightVal constants.
+func (v FontSynthesisWeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontSynthesisWeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontSynthesisWeightVal.
+func (v *FontSynthesisWeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontSynthesisWeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantVal represents values for the font-variant property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) || [ small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps ] || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant
 type FontVariantVal string
 
 // FontVariantVal constants.
 const (
 	FontVariantValAllPetiteCaps            FontVariantVal = "all-petite-caps"
 	FontVariantValAllSmallCaps             FontVariantVal = "all-small-caps"
-	FontVariantValAnnotation               FontVariantVal = "annotation"
-	FontVariantValCharacterVariant         FontVariantVal = "character-variant"
 	FontVariantValCommonLigatures          FontVariantVal = "common-ligatures"
 	FontVariantValContextual               FontVariantVal = "contextual"
-	FontVariantValCustomIdent              FontVariantVal = "custom-ident"
 	FontVariantValDiagonalFractions        FontVariantVal = "diagonal-fractions"
 	FontVariantValDiscretionaryLigatures   FontVariantVal = "discretionary-ligatures"
 	FontVariantValFullWidth                FontVariantVal = "full-width"
 	FontVariantValHistoricalForms          FontVariantVal = "historical-forms"
 	FontVariantValHistoricalLigatures      FontVariantVal = "historical-ligatures"
+	FontVariantValJis04                    FontVariantVal = "jis04"
+	FontVariantValJis78                    FontVariantVal = "jis78"
+	FontVariantValJis83                    FontVariantVal = "jis83"
+	FontVariantValJis90                    FontVariantVal = "jis90"
 	FontVariantValLiningNums               FontVariantVal = "lining-nums"
 	FontVariantValNoCommonLigatures        FontVariantVal = "no-common-ligatures"
 	FontVariantValNoContextual             FontVariantVal = "no-contextual"
@@ -2162,7 +11760,6 @@ const (
 	FontVariantValNormal                   FontVariantVal = "normal"
 	FontVariantValOldstyleNums             FontVariantVal = "oldstyle-nums"
 	FontVariantValOrdinal                  FontVariantVal = "ordinal"
-	FontVariantValOrnaments                FontVariantVal = "ornaments"
 	FontVariantValPetiteCaps               FontVariantVal = "petite-caps"
 	FontVariantValProportionalNums         FontVariantVal = "proportional-nums"
 	FontVariantValProportionalWidth        FontVariantVal = "proportional-width"
@@ -2171,32 +11768,202 @@ const (
 	FontVariantValSlashedZero              FontVariantVal = "slashed-zero"
 	FontVariantValSmallCaps                FontVariantVal = "small-caps"
 	FontVariantValStackedFractions         FontVariantVal = "stacked-fractions"
-	FontVariantValStyleset                 FontVariantVal = "styleset"
-	FontVariantValStylistic                FontVariantVal = "stylistic"
-	FontVariantValSwash                    FontVariantVal = "swash"
 	FontVariantValTabularNums              FontVariantVal = "tabular-nums"
 	FontVariantValTitlingCaps              FontVariantVal = "titling-caps"
 	FontVariantValTraditional              FontVariantVal = "traditional"
 	FontVariantValUnicase                  FontVariantVal = "unicase"
 )
 
+func (v FontVariantVal) String() string { return string(v) }
+
+// Values returns every FontVariantVal constant.
+func (FontVariantVal) Values() []FontVariantVal {
+	return []FontVariantVal{
+		FontVariantValAllPetiteCaps,
+		FontVariantValAllSmallCaps,
+		FontVariantValCommonLigatures,
+		FontVariantValContextual,
+		FontVariantValDiagonalFractions,
+		FontVariantValDiscretionaryLigatures,
+		FontVariantValFullWidth,
+		FontVariantValHistoricalForms,
+		FontVariantValHistoricalLigatures,
+		FontVariantValJis04,
+		FontVariantValJis78,
+		FontVariantValJis83,
+		FontVariantValJis90,
+		FontVariantValLiningNums,
+		FontVariantValNoCommonLigatures,
+		FontVariantValNoContextual,
+		FontVariantValNoDiscretionaryLigatures,
+		FontVariantValNoHistoricalLigatures,
+		FontVariantValNone,
+		FontVariantValNormal,
+		FontVariantValOldstyleNums,
+		FontVariantValOrdinal,
+		FontVariantValPetiteCaps,
+		FontVariantValProportionalNums,
+		FontVariantValProportionalWidth,
+		FontVariantValRuby,
+		FontVariantValSimplified,
+		FontVariantValSlashedZero,
+		FontVariantValSmallCaps,
+		FontVariantValStackedFractions,
+		FontVariantValTabularNums,
+		FontVariantValTitlingCaps,
+		FontVariantValTraditional,
+		FontVariantValUnicase,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantVal constants.
+func (v FontVariantVal) Valid() bool {
+	switch v {
+	case FontVariantValAllPetiteCaps,
+		FontVariantValAllSmallCaps,
+		FontVariantValCommonLigatures,
+		FontVariantValContextual,
+		FontVariantValDiagonalFractions,
+		FontVariantValDiscretionaryLigatures,
+		FontVariantValFullWidth,
+		FontVariantValHistoricalForms,
+		FontVariantValHistoricalLigatures,
+		FontVariantValJis04,
+		FontVariantValJis78,
+		FontVariantValJis83,
+		FontVariantValJis90,
+		FontVariantValLiningNums,
+		FontVariantValNoCommonLigatures,
+		FontVariantValNoContextual,
+		FontVariantValNoDiscretionaryLigatures,
+		FontVariantValNoHistoricalLigatures,
+		FontVariantValNone,
+		FontVariantValNormal,
+		FontVariantValOldstyleNums,
+		FontVariantValOrdinal,
+		FontVariantValPetiteCaps,
+		FontVariantValProportionalNums,
+		FontVariantValProportionalWidth,
+		FontVariantValRuby,
+		FontVariantValSimplified,
+		FontVariantValSlashedZero,
+		FontVariantValSmallCaps,
+		FontVariantValStackedFractions,
+		FontVariantValTabularNums,
+		FontVariantValTitlingCaps,
+		FontVariantValTraditional,
+		FontVariantValUnicase:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantVal returns the FontVariantVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantVal(s string) (FontVariantVal, error) {
+	v := FontVariantVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantVal constants.
+func (v FontVariantVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantVal.
+func (v *FontVariantVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantAlternatesVal represents values for the font-variant-alternates property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-alternates
 type FontVariantAlternatesVal string
 
 // FontVariantAlternatesVal constants.
 const (
-	FontVariantAlternatesValAnnotation       FontVariantAlternatesVal = "annotation"
-	FontVariantAlternatesValCharacterVariant FontVariantAlternatesVal = "character-variant"
-	FontVariantAlternatesValCustomIdent      FontVariantAlternatesVal = "custom-ident"
-	FontVariantAlternatesValHistoricalForms  FontVariantAlternatesVal = "historical-forms"
-	FontVariantAlternatesValNormal           FontVariantAlternatesVal = "normal"
-	FontVariantAlternatesValOrnaments        FontVariantAlternatesVal = "ornaments"
-	FontVariantAlternatesValStyleset         FontVariantAlternatesVal = "styleset"
-	FontVariantAlternatesValStylistic        FontVariantAlternatesVal = "stylistic"
-	FontVariantAlternatesValSwash            FontVariantAlternatesVal = "swash"
-)
+	FontVariantAlternatesValHistoricalForms FontVariantAlternatesVal = "historical-forms"
+	FontVariantAlternatesValNormal          FontVariantAlternatesVal = "normal"
+)
+
+func (v FontVariantAlternatesVal) String() string { return string(v) }
+
+// Values returns every FontVariantAlternatesVal constant.
+func (FontVariantAlternatesVal) Values() []FontVariantAlternatesVal {
+	return []FontVariantAlternatesVal{
+		FontVariantAlternatesValHistoricalForms,
+		FontVariantAlternatesValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantAlternatesVal constants.
+func (v FontVariantAlternatesVal) Valid() bool {
+	switch v {
+	case FontVariantAlternatesValHistoricalForms,
+		FontVariantAlternatesValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantAlternatesVal returns the FontVariantAlternatesVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantAlternatesVal(s string) (FontVariantAlternatesVal, error) {
+	v := FontVariantAlternatesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantAlternatesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantAlternatesVal constants.
+func (v FontVariantAlternatesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantAlternatesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantAlternatesVal.
+func (v *FontVariantAlternatesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantAlternatesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // FontVariantCapsVal represents values for the font-variant-caps property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-caps
 type FontVariantCapsVal string
 
 // FontVariantCapsVal constants.
@@ -2210,12 +11977,84 @@ const (
 	FontVariantCapsValUnicase       FontVariantCapsVal = "unicase"
 )
 
+func (v FontVariantCapsVal) String() string { return string(v) }
+
+// Values returns every FontVariantCapsVal constant.
+func (FontVariantCapsVal) Values() []FontVariantCapsVal {
+	return []FontVariantCapsVal{
+		FontVariantCapsValAllPetiteCaps,
+		FontVariantCapsValAllSmallCaps,
+		FontVariantCapsValNormal,
+		FontVariantCapsValPetiteCaps,
+		FontVariantCapsValSmallCaps,
+		FontVariantCapsValTitlingCaps,
+		FontVariantCapsValUnicase,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantCapsVal constants.
+func (v FontVariantCapsVal) Valid() bool {
+	switch v {
+	case FontVariantCapsValAllPetiteCaps,
+		FontVariantCapsValAllSmallCaps,
+		FontVariantCapsValNormal,
+		FontVariantCapsValPetiteCaps,
+		FontVariantCapsValSmallCaps,
+		FontVariantCapsValTitlingCaps,
+		FontVariantCapsValUnicase:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantCapsVal returns the FontVariantCapsVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantCapsVal(s string) (FontVariantCapsVal, error) {
+	v := FontVariantCapsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantCapsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantCapsVal constants.
+func (v FontVariantCapsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantCapsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantCapsVal.
+func (v *FontVariantCapsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantCapsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantEastAsianVal represents values for the font-variant-east-asian property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-east-asian
 type FontVariantEastAsianVal string
 
 // FontVariantEastAsianVal constants.
 const (
 	FontVariantEastAsianValFullWidth         FontVariantEastAsianVal = "full-width"
+	FontVariantEastAsianValJis04             FontVariantEastAsianVal = "jis04"
+	FontVariantEastAsianValJis78             FontVariantEastAsianVal = "jis78"
+	FontVariantEastAsianValJis83             FontVariantEastAsianVal = "jis83"
+	FontVariantEastAsianValJis90             FontVariantEastAsianVal = "jis90"
 	FontVariantEastAsianValNormal            FontVariantEastAsianVal = "normal"
 	FontVariantEastAsianValProportionalWidth FontVariantEastAsianVal = "proportional-width"
 	FontVariantEastAsianValRuby              FontVariantEastAsianVal = "ruby"
@@ -2223,7 +12062,81 @@ const (
 	FontVariantEastAsianValTraditional       FontVariantEastAsianVal = "traditional"
 )
 
+func (v FontVariantEastAsianVal) String() string { return string(v) }
+
+// Values returns every FontVariantEastAsianVal constant.
+func (FontVariantEastAsianVal) Values() []FontVariantEastAsianVal {
+	return []FontVariantEastAsianVal{
+		FontVariantEastAsianValFullWidth,
+		FontVariantEastAsianValJis04,
+		FontVariantEastAsianValJis78,
+		FontVariantEastAsianValJis83,
+		FontVariantEastAsianValJis90,
+		FontVariantEastAsianValNormal,
+		FontVariantEastAsianValProportionalWidth,
+		FontVariantEastAsianValRuby,
+		FontVariantEastAsianValSimplified,
+		FontVariantEastAsianValTraditional,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantEastAsianVal constants.
+func (v FontVariantEastAsianVal) Valid() bool {
+	switch v {
+	case FontVariantEastAsianValFullWidth,
+		FontVariantEastAsianValJis04,
+		FontVariantEastAsianValJis78,
+		FontVariantEastAsianValJis83,
+		FontVariantEastAsianValJis90,
+		FontVariantEastAsianValNormal,
+		FontVariantEastAsianValProportionalWidth,
+		FontVariantEastAsianValRuby,
+		FontVariantEastAsianValSimplified,
+		FontVariantEastAsianValTraditional:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantEastAsianVal returns the FontVariantEastAsianVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantEastAsianVal(s string) (FontVariantEastAsianVal, error) {
+	v := FontVariantEastAsianVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantEastAsianVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantEastAsianVal constants.
+func (v FontVariantEastAsianVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantEastAsianVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantEastAsianVal.
+func (v *FontVariantEastAsianVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantEastAsianVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantEmojiVal represents values for the font-variant-emoji property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | text | emoji | unicode
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-emoji
 type FontVariantEmojiVal string
 
 // FontVariantEmojiVal constants.
@@ -2234,7 +12147,69 @@ const (
 	FontVariantEmojiValUnicode FontVariantEmojiVal = "unicode"
 )
 
+func (v FontVariantEmojiVal) String() string { return string(v) }
+
+// Values returns every FontVariantEmojiVal constant.
+func (FontVariantEmojiVal) Values() []FontVariantEmojiVal {
+	return []FontVariantEmojiVal{
+		FontVariantEmojiValEmoji,
+		FontVariantEmojiValNormal,
+		FontVariantEmojiValText,
+		FontVariantEmojiValUnicode,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantEmojiVal constants.
+func (v FontVariantEmojiVal) Valid() bool {
+	switch v {
+	case FontVariantEmojiValEmoji,
+		FontVariantEmojiValNormal,
+		FontVariantEmojiValText,
+		FontVariantEmojiValUnicode:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantEmojiVal returns the FontVariantEmojiVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantEmojiVal(s string) (FontVariantEmojiVal, error) {
+	v := FontVariantEmojiVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantEmojiVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantEmojiVal constants.
+func (v FontVariantEmojiVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantEmojiVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantEmojiVal.
+func (v *FontVariantEmojiVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantEmojiVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantLigaturesVal represents values for the font-variant-ligatures property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-ligatures
 type FontVariantLigaturesVal string
 
 // FontVariantLigaturesVal constants.
@@ -2251,7 +12226,81 @@ const (
 	FontVariantLigaturesValNormal                   FontVariantLigaturesVal = "normal"
 )
 
+func (v FontVariantLigaturesVal) String() string { return string(v) }
+
+// Values returns every FontVariantLigaturesVal constant.
+func (FontVariantLigaturesVal) Values() []FontVariantLigaturesVal {
+	return []FontVariantLigaturesVal{
+		FontVariantLigaturesValCommonLigatures,
+		FontVariantLigaturesValContextual,
+		FontVariantLigaturesValDiscretionaryLigatures,
+		FontVariantLigaturesValHistoricalLigatures,
+		FontVariantLigaturesValNoCommonLigatures,
+		FontVariantLigaturesValNoContextual,
+		FontVariantLigaturesValNoDiscretionaryLigatures,
+		FontVariantLigaturesValNoHistoricalLigatures,
+		FontVariantLigaturesValNone,
+		FontVariantLigaturesValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantLigaturesVal constants.
+func (v FontVariantLigaturesVal) Valid() bool {
+	switch v {
+	case FontVariantLigaturesValCommonLigatures,
+		FontVariantLigaturesValContextual,
+		FontVariantLigaturesValDiscretionaryLigatures,
+		FontVariantLigaturesValHistoricalLigatures,
+		FontVariantLigaturesValNoCommonLigatures,
+		FontVariantLigaturesValNoContextual,
+		FontVariantLigaturesValNoDiscretionaryLigatures,
+		FontVariantLigaturesValNoHistoricalLigatures,
+		FontVariantLigaturesValNone,
+		FontVariantLigaturesValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantLigaturesVal returns the FontVariantLigaturesVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantLigaturesVal(s string) (FontVariantLigaturesVal, error) {
+	v := FontVariantLigaturesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantLigaturesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantLigaturesVal constants.
+func (v FontVariantLigaturesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantLigaturesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantLigaturesVal.
+func (v *FontVariantLigaturesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantLigaturesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantNumericVal represents values for the font-variant-numeric property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-numeric
 type FontVariantNumericVal string
 
 // FontVariantNumericVal constants.
@@ -2267,7 +12316,79 @@ const (
 	FontVariantNumericValTabularNums       FontVariantNumericVal = "tabular-nums"
 )
 
+func (v FontVariantNumericVal) String() string { return string(v) }
+
+// Values returns every FontVariantNumericVal constant.
+func (FontVariantNumericVal) Values() []FontVariantNumericVal {
+	return []FontVariantNumericVal{
+		FontVariantNumericValDiagonalFractions,
+		FontVariantNumericValLiningNums,
+		FontVariantNumericValNormal,
+		FontVariantNumericValOldstyleNums,
+		FontVariantNumericValOrdinal,
+		FontVariantNumericValProportionalNums,
+		FontVariantNumericValSlashedZero,
+		FontVariantNumericValStackedFractions,
+		FontVariantNumericValTabularNums,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantNumericVal constants.
+func (v FontVariantNumericVal) Valid() bool {
+	switch v {
+	case FontVariantNumericValDiagonalFractions,
+		FontVariantNumericValLiningNums,
+		FontVariantNumericValNormal,
+		FontVariantNumericValOldstyleNums,
+		FontVariantNumericValOrdinal,
+		FontVariantNumericValProportionalNums,
+		FontVariantNumericValSlashedZero,
+		FontVariantNumericValStackedFractions,
+		FontVariantNumericValTabularNums:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantNumericVal returns the FontVariantNumericVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantNumericVal(s string) (FontVariantNumericVal, error) {
+	v := FontVariantNumericVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantNumericVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantNumericVal constants.
+func (v FontVariantNumericVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantNumericVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantNumericVal.
+func (v *FontVariantNumericVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantNumericVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariantPositionVal represents values for the font-variant-position property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | sub | super
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-position
 type FontVariantPositionVal string
 
 // FontVariantPositionVal constants.
@@ -2277,7 +12398,67 @@ const (
 	FontVariantPositionValSuper  FontVariantPositionVal = "super"
 )
 
+func (v FontVariantPositionVal) String() string { return string(v) }
+
+// Values returns every FontVariantPositionVal constant.
+func (FontVariantPositionVal) Values() []FontVariantPositionVal {
+	return []FontVariantPositionVal{
+		FontVariantPositionValNormal,
+		FontVariantPositionValSub,
+		FontVariantPositionValSuper,
+	}
+}
+
+// Valid reports whether v is one of the FontVariantPositionVal constants.
+func (v FontVariantPositionVal) Valid() bool {
+	switch v {
+	case FontVariantPositionValNormal,
+		FontVariantPositionValSub,
+		FontVariantPositionValSuper:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariantPositionVal returns the FontVariantPositionVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariantPositionVal(s string) (FontVariantPositionVal, error) {
+	v := FontVariantPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariantPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariantPositionVal constants.
+func (v FontVariantPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariantPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariantPositionVal.
+func (v *FontVariantPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariantPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontVariationSettingsVal represents values for the font-variation-settings property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <string> <number> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variation-settings
 type FontVariationSettingsVal string
 
 // FontVariationSettingsVal constants.
@@ -2285,20 +12466,136 @@ const (
 	FontVariationSettingsValNormal FontVariationSettingsVal = "normal"
 )
 
+func (v FontVariationSettingsVal) String() string { return string(v) }
+
+// Values returns every FontVariationSettingsVal constant.
+func (FontVariationSettingsVal) Values() []FontVariationSettingsVal {
+	return []FontVariationSettingsVal{
+		FontVariationSettingsValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontVariationSettingsVal constants.
+func (v FontVariationSettingsVal) Valid() bool {
+	switch v {
+	case FontVariationSettingsValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontVariationSettingsVal returns the FontVariationSettingsVal constant for a keyword, ignoring ASCII case.
+func ParseFontVariationSettingsVal(s string) (FontVariationSettingsVal, error) {
+	v := FontVariationSettingsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontVariationSettingsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontVariationSettingsVal constants.
+func (v FontVariationSettingsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontVariationSettingsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontVariationSettingsVal.
+func (v *FontVariationSettingsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontVariationSettingsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // FontWeightVal represents values for the font-weight property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<font-weight-absolute> | bolder | lighter
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-weight
 type FontWeightVal string
 
 // FontWeightVal constants.
 const (
-	FontWeightVal1       FontWeightVal = "1"
-	FontWeightVal1000    FontWeightVal = "1000"
 	FontWeightValBold    FontWeightVal = "bold"
 	FontWeightValBolder  FontWeightVal = "bolder"
 	FontWeightValLighter FontWeightVal = "lighter"
 	FontWeightValNormal  FontWeightVal = "normal"
 )
 
+func (v FontWeightVal) String() string { return string(v) }
+
+// Values returns every FontWeightVal constant.
+func (FontWeightVal) Values() []FontWeightVal {
+	return []FontWeightVal{
+		FontWeightValBold,
+		FontWeightValBolder,
+		FontWeightValLighter,
+		FontWeightValNormal,
+	}
+}
+
+// Valid reports whether v is one of the FontWeightVal constants.
+func (v FontWeightVal) Valid() bool {
+	switch v {
+	case FontWeightValBold,
+		FontWeightValBolder,
+		FontWeightValLighter,
+		FontWeightValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseFontWeightVal returns the FontWeightVal constant for a keyword, ignoring ASCII case.
+func ParseFontWeightVal(s string) (FontWeightVal, error) {
+	v := FontWeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "FontWeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not FontWeightVal constants.
+func (v FontWeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "FontWeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseFontWeightVal.
+func (v *FontWeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseFontWeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ForcedColorAdjustVal represents values for the forced-color-adjust property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | preserve-parent-color
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/forced-color-adjust
 type ForcedColorAdjustVal string
 
 // ForcedColorAdjustVal constants.
@@ -2308,54 +12605,350 @@ const (
 	ForcedColorAdjustValPreserveParentColor ForcedColorAdjustVal = "preserve-parent-color"
 )
 
+func (v ForcedColorAdjustVal) String() string { return string(v) }
+
+// Values returns every ForcedColorAdjustVal constant.
+func (ForcedColorAdjustVal) Values() []ForcedColorAdjustVal {
+	return []ForcedColorAdjustVal{
+		ForcedColorAdjustValAuto,
+		ForcedColorAdjustValNone,
+		ForcedColorAdjustValPreserveParentColor,
+	}
+}
+
+// Valid reports whether v is one of the ForcedColorAdjustVal constants.
+func (v ForcedColorAdjustVal) Valid() bool {
+	switch v {
+	case ForcedColorAdjustValAuto,
+		ForcedColorAdjustValNone,
+		ForcedColorAdjustValPreserveParentColor:
+		return true
+	}
+	return false
+}
+
+// ParseForcedColorAdjustVal returns the ForcedColorAdjustVal constant for a keyword, ignoring ASCII case.
+func ParseForcedColorAdjustVal(s string) (ForcedColorAdjustVal, error) {
+	v := ForcedColorAdjustVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ForcedColorAdjustVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ForcedColorAdjustVal constants.
+func (v ForcedColorAdjustVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ForcedColorAdjustVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseForcedColorAdjustVal.
+func (v *ForcedColorAdjustVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseForcedColorAdjustVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // GapVal represents values for the gap property.
+//
+//   - Shorthand for: column-gap, row-gap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'row-gap'> <'column-gap'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/gap
 type GapVal string
 
 // GapVal constants.
 const (
-	GapValColumnGap GapVal = "column-gap"
-	GapValRowGap    GapVal = "row-gap"
-)
+	GapValNormal GapVal = "normal"
+)
+
+func (v GapVal) String() string { return string(v) }
+
+// Values returns every GapVal constant.
+func (GapVal) Values() []GapVal {
+	return []GapVal{
+		GapValNormal,
+	}
+}
+
+// Valid reports whether v is one of the GapVal constants.
+func (v GapVal) Valid() bool {
+	switch v {
+	case GapValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseGapVal returns the GapVal constant for a keyword, ignoring ASCII case.
+func ParseGapVal(s string) (GapVal, error) {
+	v := GapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GapVal constants.
+func (v GapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGapVal.
+func (v *GapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridVal represents values for the grid property.
+//
+//   - Shorthand for: column-gap, grid-auto-columns, grid-auto-flow, grid-auto-rows, grid-column-gap, grid-row-gap, grid-template-areas, grid-template-columns, grid-template-rows, row-gap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid
 type GridVal string
 
 // GridVal constants.
 const (
-	GridValAutoFlow            GridVal = "auto-flow"
-	GridValDense               GridVal = "dense"
-	GridValGridAutoColumns     GridVal = "grid-auto-columns"
-	GridValGridAutoRows        GridVal = "grid-auto-rows"
-	GridValGridTemplate        GridVal = "grid-template"
-	GridValGridTemplateColumns GridVal = "grid-template-columns"
-	GridValGridTemplateRows    GridVal = "grid-template-rows"
-)
+	GridValAuto       GridVal = "auto"
+	GridValAutoFlow   GridVal = "auto-flow"
+	GridValDense      GridVal = "dense"
+	GridValMaxContent GridVal = "max-content"
+	GridValMinContent GridVal = "min-content"
+	GridValNone       GridVal = "none"
+	GridValSubgrid    GridVal = "subgrid"
+)
+
+func (v GridVal) String() string { return string(v) }
+
+// Values returns every GridVal constant.
+func (GridVal) Values() []GridVal {
+	return []GridVal{
+		GridValAuto,
+		GridValAutoFlow,
+		GridValDense,
+		GridValMaxContent,
+		GridValMinContent,
+		GridValNone,
+		GridValSubgrid,
+	}
+}
+
+// Valid reports whether v is one of the GridVal constants.
+func (v GridVal) Valid() bool {
+	switch v {
+	case GridValAuto,
+		GridValAutoFlow,
+		GridValDense,
+		GridValMaxContent,
+		GridValMinContent,
+		GridValNone,
+		GridValSubgrid:
+		return true
+	}
+	return false
+}
+
+// ParseGridVal returns the GridVal constant for a keyword, ignoring ASCII case.
+func ParseGridVal(s string) (GridVal, error) {
+	v := GridVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridVal constants.
+func (v GridVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridVal.
+func (v *GridVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridAreaVal represents values for the grid-area property.
+//
+//   - Shorthand for: grid-column-end, grid-column-start, grid-row-end, grid-row-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]{0,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-area
 type GridAreaVal string
 
 // GridAreaVal constants.
 const (
-	GridAreaVal0           GridAreaVal = "0"
-	GridAreaVal3           GridAreaVal = "3"
-	GridAreaValAuto        GridAreaVal = "auto"
-	GridAreaValCustomIdent GridAreaVal = "custom-ident"
-	GridAreaValSpan        GridAreaVal = "span"
-)
+	GridAreaValAuto GridAreaVal = "auto"
+	GridAreaValSpan GridAreaVal = "span"
+)
+
+func (v GridAreaVal) String() string { return string(v) }
+
+// Values returns every GridAreaVal constant.
+func (GridAreaVal) Values() []GridAreaVal {
+	return []GridAreaVal{
+		GridAreaValAuto,
+		GridAreaValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridAreaVal constants.
+func (v GridAreaVal) Valid() bool {
+	switch v {
+	case GridAreaValAuto,
+		GridAreaValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridAreaVal returns the GridAreaVal constant for a keyword, ignoring ASCII case.
+func ParseGridAreaVal(s string) (GridAreaVal, error) {
+	v := GridAreaVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridAreaVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridAreaVal constants.
+func (v GridAreaVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridAreaVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridAreaVal.
+func (v *GridAreaVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridAreaVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridAutoColumnsVal represents values for the grid-auto-columns property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<track-size>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-columns
 type GridAutoColumnsVal string
 
 // GridAutoColumnsVal constants.
 const (
-	GridAutoColumnsValFitContent        GridAutoColumnsVal = "fit-content"
-	GridAutoColumnsValInflexibleBreadth GridAutoColumnsVal = "inflexible-breadth"
-	GridAutoColumnsValLengthPercentage  GridAutoColumnsVal = "length-percentage"
-	GridAutoColumnsValMinmax            GridAutoColumnsVal = "minmax"
-	GridAutoColumnsValTrackBreadth      GridAutoColumnsVal = "track-breadth"
-)
+	GridAutoColumnsValAuto       GridAutoColumnsVal = "auto"
+	GridAutoColumnsValMaxContent GridAutoColumnsVal = "max-content"
+	GridAutoColumnsValMinContent GridAutoColumnsVal = "min-content"
+)
+
+func (v GridAutoColumnsVal) String() string { return string(v) }
+
+// Values returns every GridAutoColumnsVal constant.
+func (GridAutoColumnsVal) Values() []GridAutoColumnsVal {
+	return []GridAutoColumnsVal{
+		GridAutoColumnsValAuto,
+		GridAutoColumnsValMaxContent,
+		GridAutoColumnsValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the GridAutoColumnsVal constants.
+func (v GridAutoColumnsVal) Valid() bool {
+	switch v {
+	case GridAutoColumnsValAuto,
+		GridAutoColumnsValMaxContent,
+		GridAutoColumnsValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseGridAutoColumnsVal returns the GridAutoColumnsVal constant for a keyword, ignoring ASCII case.
+func ParseGridAutoColumnsVal(s string) (GridAutoColumnsVal, error) {
+	v := GridAutoColumnsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridAutoColumnsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridAutoColumnsVal constants.
+func (v GridAutoColumnsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridAutoColumnsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridAutoColumnsVal.
+func (v *GridAutoColumnsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridAutoColumnsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridAutoFlowVal represents values for the grid-auto-flow property.
+//
+//   - Initial: row
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ row | column ] || dense
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
 type GridAutoFlowVal string
 
 // GridAutoFlowVal constants.
@@ -2365,106 +12958,615 @@ const (
 	GridAutoFlowValRow    GridAutoFlowVal = "row"
 )
 
+func (v GridAutoFlowVal) String() string { return string(v) }
+
+// Values returns every GridAutoFlowVal constant.
+func (GridAutoFlowVal) Values() []GridAutoFlowVal {
+	return []GridAutoFlowVal{
+		GridAutoFlowValColumn,
+		GridAutoFlowValDense,
+		GridAutoFlowValRow,
+	}
+}
+
+// Valid reports whether v is one of the GridAutoFlowVal constants.
+func (v GridAutoFlowVal) Valid() bool {
+	switch v {
+	case GridAutoFlowValColumn,
+		GridAutoFlowValDense,
+		GridAutoFlowValRow:
+		return true
+	}
+	return false
+}
+
+// ParseGridAutoFlowVal returns the GridAutoFlowVal constant for a keyword, ignoring ASCII case.
+func ParseGridAutoFlowVal(s string) (GridAutoFlowVal, error) {
+	v := GridAutoFlowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridAutoFlowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridAutoFlowVal constants.
+func (v GridAutoFlowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridAutoFlowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridAutoFlowVal.
+func (v *GridAutoFlowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridAutoFlowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // GridAutoRowsVal represents values for the grid-auto-rows property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<track-size>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-rows
 type GridAutoRowsVal string
 
 // GridAutoRowsVal constants.
 const (
-	GridAutoRowsValFitContent        GridAutoRowsVal = "fit-content"
-	GridAutoRowsValInflexibleBreadth GridAutoRowsVal = "inflexible-breadth"
-	GridAutoRowsValLengthPercentage  GridAutoRowsVal = "length-percentage"
-	GridAutoRowsValMinmax            GridAutoRowsVal = "minmax"
-	GridAutoRowsValTrackBreadth      GridAutoRowsVal = "track-breadth"
-)
+	GridAutoRowsValAuto       GridAutoRowsVal = "auto"
+	GridAutoRowsValMaxContent GridAutoRowsVal = "max-content"
+	GridAutoRowsValMinContent GridAutoRowsVal = "min-content"
+)
+
+func (v GridAutoRowsVal) String() string { return string(v) }
+
+// Values returns every GridAutoRowsVal constant.
+func (GridAutoRowsVal) Values() []GridAutoRowsVal {
+	return []GridAutoRowsVal{
+		GridAutoRowsValAuto,
+		GridAutoRowsValMaxContent,
+		GridAutoRowsValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the GridAutoRowsVal constants.
+func (v GridAutoRowsVal) Valid() bool {
+	switch v {
+	case GridAutoRowsValAuto,
+		GridAutoRowsValMaxContent,
+		GridAutoRowsValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseGridAutoRowsVal returns the GridAutoRowsVal constant for a keyword, ignoring ASCII case.
+func ParseGridAutoRowsVal(s string) (GridAutoRowsVal, error) {
+	v := GridAutoRowsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridAutoRowsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridAutoRowsVal constants.
+func (v GridAutoRowsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridAutoRowsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridAutoRowsVal.
+func (v *GridAutoRowsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridAutoRowsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridColumnVal represents values for the grid-column property.
+//
+//   - Shorthand for: grid-column-end, grid-column-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column
 type GridColumnVal string
 
 // GridColumnVal constants.
 const (
-	GridColumnValAuto        GridColumnVal = "auto"
-	GridColumnValCustomIdent GridColumnVal = "custom-ident"
-	GridColumnValSpan        GridColumnVal = "span"
-)
+	GridColumnValAuto GridColumnVal = "auto"
+	GridColumnValSpan GridColumnVal = "span"
+)
+
+func (v GridColumnVal) String() string { return string(v) }
+
+// Values returns every GridColumnVal constant.
+func (GridColumnVal) Values() []GridColumnVal {
+	return []GridColumnVal{
+		GridColumnValAuto,
+		GridColumnValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridColumnVal constants.
+func (v GridColumnVal) Valid() bool {
+	switch v {
+	case GridColumnValAuto,
+		GridColumnValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridColumnVal returns the GridColumnVal constant for a keyword, ignoring ASCII case.
+func ParseGridColumnVal(s string) (GridColumnVal, error) {
+	v := GridColumnVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridColumnVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridColumnVal constants.
+func (v GridColumnVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridColumnVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridColumnVal.
+func (v *GridColumnVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridColumnVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridColumnEndVal represents values for the grid-column-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-end
 type GridColumnEndVal string
 
 // GridColumnEndVal constants.
 const (
-	GridColumnEndValAuto        GridColumnEndVal = "auto"
-	GridColumnEndValCustomIdent GridColumnEndVal = "custom-ident"
-	GridColumnEndValSpan        GridColumnEndVal = "span"
-)
+	GridColumnEndValAuto GridColumnEndVal = "auto"
+	GridColumnEndValSpan GridColumnEndVal = "span"
+)
+
+func (v GridColumnEndVal) String() string { return string(v) }
+
+// Values returns every GridColumnEndVal constant.
+func (GridColumnEndVal) Values() []GridColumnEndVal {
+	return []GridColumnEndVal{
+		GridColumnEndValAuto,
+		GridColumnEndValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridColumnEndVal constants.
+func (v GridColumnEndVal) Valid() bool {
+	switch v {
+	case GridColumnEndValAuto,
+		GridColumnEndValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridColumnEndVal returns the GridColumnEndVal constant for a keyword, ignoring ASCII case.
+func ParseGridColumnEndVal(s string) (GridColumnEndVal, error) {
+	v := GridColumnEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridColumnEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridColumnEndVal constants.
+func (v GridColumnEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridColumnEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridColumnEndVal.
+func (v *GridColumnEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridColumnEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridColumnStartVal represents values for the grid-column-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-start
 type GridColumnStartVal string
 
 // GridColumnStartVal constants.
 const (
-	GridColumnStartValAuto        GridColumnStartVal = "auto"
-	GridColumnStartValCustomIdent GridColumnStartVal = "custom-ident"
-	GridColumnStartValSpan        GridColumnStartVal = "span"
-)
-
-// GridGapVal represents values for the grid-gap property.
-type GridGapVal string
-
-// GridGapVal constants.
-const (
-	GridGapValGridColumnGap GridGapVal = "grid-column-gap"
-	GridGapValGridRowGap    GridGapVal = "grid-row-gap"
-)
+	GridColumnStartValAuto GridColumnStartVal = "auto"
+	GridColumnStartValSpan GridColumnStartVal = "span"
+)
+
+func (v GridColumnStartVal) String() string { return string(v) }
+
+// Values returns every GridColumnStartVal constant.
+func (GridColumnStartVal) Values() []GridColumnStartVal {
+	return []GridColumnStartVal{
+		GridColumnStartValAuto,
+		GridColumnStartValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridColumnStartVal constants.
+func (v GridColumnStartVal) Valid() bool {
+	switch v {
+	case GridColumnStartValAuto,
+		GridColumnStartValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridColumnStartVal returns the GridColumnStartVal constant for a keyword, ignoring ASCII case.
+func ParseGridColumnStartVal(s string) (GridColumnStartVal, error) {
+	v := GridColumnStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridColumnStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridColumnStartVal constants.
+func (v GridColumnStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridColumnStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridColumnStartVal.
+func (v *GridColumnStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridColumnStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridRowVal represents values for the grid-row property.
+//
+//   - Shorthand for: grid-row-end, grid-row-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row
 type GridRowVal string
 
 // GridRowVal constants.
 const (
-	GridRowValAuto        GridRowVal = "auto"
-	GridRowValCustomIdent GridRowVal = "custom-ident"
-	GridRowValSpan        GridRowVal = "span"
-)
+	GridRowValAuto GridRowVal = "auto"
+	GridRowValSpan GridRowVal = "span"
+)
+
+func (v GridRowVal) String() string { return string(v) }
+
+// Values returns every GridRowVal constant.
+func (GridRowVal) Values() []GridRowVal {
+	return []GridRowVal{
+		GridRowValAuto,
+		GridRowValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridRowVal constants.
+func (v GridRowVal) Valid() bool {
+	switch v {
+	case GridRowValAuto,
+		GridRowValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridRowVal returns the GridRowVal constant for a keyword, ignoring ASCII case.
+func ParseGridRowVal(s string) (GridRowVal, error) {
+	v := GridRowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridRowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridRowVal constants.
+func (v GridRowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridRowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridRowVal.
+func (v *GridRowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridRowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridRowEndVal represents values for the grid-row-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-end
 type GridRowEndVal string
 
 // GridRowEndVal constants.
 const (
-	GridRowEndValAuto        GridRowEndVal = "auto"
-	GridRowEndValCustomIdent GridRowEndVal = "custom-ident"
-	GridRowEndValSpan        GridRowEndVal = "span"
-)
+	GridRowEndValAuto GridRowEndVal = "auto"
+	GridRowEndValSpan GridRowEndVal = "span"
+)
+
+func (v GridRowEndVal) String() string { return string(v) }
+
+// Values returns every GridRowEndVal constant.
+func (GridRowEndVal) Values() []GridRowEndVal {
+	return []GridRowEndVal{
+		GridRowEndValAuto,
+		GridRowEndValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridRowEndVal constants.
+func (v GridRowEndVal) Valid() bool {
+	switch v {
+	case GridRowEndValAuto,
+		GridRowEndValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridRowEndVal returns the GridRowEndVal constant for a keyword, ignoring ASCII case.
+func ParseGridRowEndVal(s string) (GridRowEndVal, error) {
+	v := GridRowEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridRowEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridRowEndVal constants.
+func (v GridRowEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridRowEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridRowEndVal.
+func (v *GridRowEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridRowEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridRowStartVal represents values for the grid-row-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-start
 type GridRowStartVal string
 
 // GridRowStartVal constants.
 const (
-	GridRowStartValAuto        GridRowStartVal = "auto"
-	GridRowStartValCustomIdent GridRowStartVal = "custom-ident"
-	GridRowStartValSpan        GridRowStartVal = "span"
-)
+	GridRowStartValAuto GridRowStartVal = "auto"
+	GridRowStartValSpan GridRowStartVal = "span"
+)
+
+func (v GridRowStartVal) String() string { return string(v) }
+
+// Values returns every GridRowStartVal constant.
+func (GridRowStartVal) Values() []GridRowStartVal {
+	return []GridRowStartVal{
+		GridRowStartValAuto,
+		GridRowStartValSpan,
+	}
+}
+
+// Valid reports whether v is one of the GridRowStartVal constants.
+func (v GridRowStartVal) Valid() bool {
+	switch v {
+	case GridRowStartValAuto,
+		GridRowStartValSpan:
+		return true
+	}
+	return false
+}
+
+// ParseGridRowStartVal returns the GridRowStartVal constant for a keyword, ignoring ASCII case.
+func ParseGridRowStartVal(s string) (GridRowStartVal, error) {
+	v := GridRowStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridRowStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridRowStartVal constants.
+func (v GridRowStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridRowStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridRowStartVal.
+func (v *GridRowStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridRowStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridTemplateVal represents values for the grid-template property.
+//
+//   - Shorthand for: grid-template-areas, grid-template-columns, grid-template-rows
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template
 type GridTemplateVal string
 
 // GridTemplateVal constants.
 const (
-	GridTemplateValCustomIdent         GridTemplateVal = "custom-ident"
-	GridTemplateValFitContent          GridTemplateVal = "fit-content"
-	GridTemplateValGridTemplateColumns GridTemplateVal = "grid-template-columns"
-	GridTemplateValGridTemplateRows    GridTemplateVal = "grid-template-rows"
-	GridTemplateValInflexibleBreadth   GridTemplateVal = "inflexible-breadth"
-	GridTemplateValLengthPercentage    GridTemplateVal = "length-percentage"
-	GridTemplateValLineNames           GridTemplateVal = "line-names"
-	GridTemplateValMinmax              GridTemplateVal = "minmax"
-	GridTemplateValNone                GridTemplateVal = "none"
-	GridTemplateValTrackBreadth        GridTemplateVal = "track-breadth"
-	GridTemplateValTrackSize           GridTemplateVal = "track-size"
-)
+	GridTemplateValAuto       GridTemplateVal = "auto"
+	GridTemplateValMaxContent GridTemplateVal = "max-content"
+	GridTemplateValMinContent GridTemplateVal = "min-content"
+	GridTemplateValNone       GridTemplateVal = "none"
+	GridTemplateValSubgrid    GridTemplateVal = "subgrid"
+)
+
+func (v GridTemplateVal) String() string { return string(v) }
+
+// Values returns every GridTemplateVal constant.
+func (GridTemplateVal) Values() []GridTemplateVal {
+	return []GridTemplateVal{
+		GridTemplateValAuto,
+		GridTemplateValMaxContent,
+		GridTemplateValMinContent,
+		GridTemplateValNone,
+		GridTemplateValSubgrid,
+	}
+}
+
+// Valid reports whether v is one of the GridTemplateVal constants.
+func (v GridTemplateVal) Valid() bool {
+	switch v {
+	case GridTemplateValAuto,
+		GridTemplateValMaxContent,
+		GridTemplateValMinContent,
+		GridTemplateValNone,
+		GridTemplateValSubgrid:
+		return true
+	}
+	return false
+}
+
+// ParseGridTemplateVal returns the GridTemplateVal constant for a keyword, ignoring ASCII case.
+func ParseGridTemplateVal(s string) (GridTemplateVal, error) {
+	v := GridTemplateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridTemplateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridTemplateVal constants.
+func (v GridTemplateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridTemplateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridTemplateVal.
+func (v *GridTemplateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridTemplateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridTemplateAreasVal represents values for the grid-template-areas property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <string>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-areas
 type GridTemplateAreasVal string
 
 // GridTemplateAreasVal constants.
@@ -2472,39 +13574,215 @@ const (
 	GridTemplateAreasValNone GridTemplateAreasVal = "none"
 )
 
+func (v GridTemplateAreasVal) String() string { return string(v) }
+
+// Values returns every GridTemplateAreasVal constant.
+func (GridTemplateAreasVal) Values() []GridTemplateAreasVal {
+	return []GridTemplateAreasVal{
+		GridTemplateAreasValNone,
+	}
+}
+
+// Valid reports whether v is one of the GridTemplateAreasVal constants.
+func (v GridTemplateAreasVal) Valid() bool {
+	switch v {
+	case GridTemplateAreasValNone:
+		return true
+	}
+	return false
+}
+
+// ParseGridTemplateAreasVal returns the GridTemplateAreasVal constant for a keyword, ignoring ASCII case.
+func ParseGridTemplateAreasVal(s string) (GridTemplateAreasVal, error) {
+	v := GridTemplateAreasVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridTemplateAreasVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridTemplateAreasVal constants.
+func (v GridTemplateAreasVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridTemplateAreasVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridTemplateAreasVal.
+func (v *GridTemplateAreasVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridTemplateAreasVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // GridTemplateColumnsVal represents values for the grid-template-columns property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-columns
 type GridTemplateColumnsVal string
 
 // GridTemplateColumnsVal constants.
 const (
-	GridTemplateColumnsValAutoRepeat  GridTemplateColumnsVal = "auto-repeat"
-	GridTemplateColumnsValFixedRepeat GridTemplateColumnsVal = "fixed-repeat"
-	GridTemplateColumnsValFixedSize   GridTemplateColumnsVal = "fixed-size"
-	GridTemplateColumnsValLineNames   GridTemplateColumnsVal = "line-names"
-	GridTemplateColumnsValNameRepeat  GridTemplateColumnsVal = "name-repeat"
-	GridTemplateColumnsValNone        GridTemplateColumnsVal = "none"
-	GridTemplateColumnsValSubgrid     GridTemplateColumnsVal = "subgrid"
-	GridTemplateColumnsValTrackRepeat GridTemplateColumnsVal = "track-repeat"
-	GridTemplateColumnsValTrackSize   GridTemplateColumnsVal = "track-size"
-)
+	GridTemplateColumnsValAuto       GridTemplateColumnsVal = "auto"
+	GridTemplateColumnsValMaxContent GridTemplateColumnsVal = "max-content"
+	GridTemplateColumnsValMinContent GridTemplateColumnsVal = "min-content"
+	GridTemplateColumnsValNone       GridTemplateColumnsVal = "none"
+	GridTemplateColumnsValSubgrid    GridTemplateColumnsVal = "subgrid"
+)
+
+func (v GridTemplateColumnsVal) String() string { return string(v) }
+
+// Values returns every GridTemplateColumnsVal constant.
+func (GridTemplateColumnsVal) Values() []GridTemplateColumnsVal {
+	return []GridTemplateColumnsVal{
+		GridTemplateColumnsValAuto,
+		GridTemplateColumnsValMaxContent,
+		GridTemplateColumnsValMinContent,
+		GridTemplateColumnsValNone,
+		GridTemplateColumnsValSubgrid,
+	}
+}
+
+// Valid reports whether v is one of the GridTemplateColumnsVal constants.
+func (v GridTemplateColumnsVal) Valid() bool {
+	switch v {
+	case GridTemplateColumnsValAuto,
+		GridTemplateColumnsValMaxContent,
+		GridTemplateColumnsValMinContent,
+		GridTemplateColumnsValNone,
+		GridTemplateColumnsValSubgrid:
+		return true
+	}
+	return false
+}
+
+// ParseGridTemplateColumnsVal returns the GridTemplateColumnsVal constant for a keyword, ignoring ASCII case.
+func ParseGridTemplateColumnsVal(s string) (GridTemplateColumnsVal, error) {
+	v := GridTemplateColumnsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridTemplateColumnsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridTemplateColumnsVal constants.
+func (v GridTemplateColumnsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridTemplateColumnsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridTemplateColumnsVal.
+func (v *GridTemplateColumnsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridTemplateColumnsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // GridTemplateRowsVal represents values for the grid-template-rows property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-rows
 type GridTemplateRowsVal string
 
 // GridTemplateRowsVal constants.
 const (
-	GridTemplateRowsValAutoRepeat  GridTemplateRowsVal = "auto-repeat"
-	GridTemplateRowsValFixedRepeat GridTemplateRowsVal = "fixed-repeat"
-	GridTemplateRowsValFixedSize   GridTemplateRowsVal = "fixed-size"
-	GridTemplateRowsValLineNames   GridTemplateRowsVal = "line-names"
-	GridTemplateRowsValNameRepeat  GridTemplateRowsVal = "name-repeat"
-	GridTemplateRowsValNone        GridTemplateRowsVal = "none"
-	GridTemplateRowsValSubgrid     GridTemplateRowsVal = "subgrid"
-	GridTemplateRowsValTrackRepeat GridTemplateRowsVal = "track-repeat"
-	GridTemplateRowsValTrackSize   GridTemplateRowsVal = "track-size"
-)
+	GridTemplateRowsValAuto       GridTemplateRowsVal = "auto"
+	GridTemplateRowsValMaxContent GridTemplateRowsVal = "max-content"
+	GridTemplateRowsValMinContent GridTemplateRowsVal = "min-content"
+	GridTemplateRowsValNone       GridTemplateRowsVal = "none"
+	GridTemplateRowsValSubgrid    GridTemplateRowsVal = "subgrid"
+)
+
+func (v GridTemplateRowsVal) String() string { return string(v) }
+
+// Values returns every GridTemplateRowsVal constant.
+func (GridTemplateRowsVal) Values() []GridTemplateRowsVal {
+	return []GridTemplateRowsVal{
+		GridTemplateRowsValAuto,
+		GridTemplateRowsValMaxContent,
+		GridTemplateRowsValMinContent,
+		GridTemplateRowsValNone,
+		GridTemplateRowsValSubgrid,
+	}
+}
+
+// Valid reports whether v is one of the GridTemplateRowsVal constants.
+func (v GridTemplateRowsVal) Valid() bool {
+	switch v {
+	case GridTemplateRowsValAuto,
+		GridTemplateRowsValMaxContent,
+		GridTemplateRowsValMinContent,
+		GridTemplateRowsValNone,
+		GridTemplateRowsValSubgrid:
+		return true
+	}
+	return false
+}
+
+// ParseGridTemplateRowsVal returns the GridTemplateRowsVal constant for a keyword, ignoring ASCII case.
+func ParseGridTemplateRowsVal(s string) (GridTemplateRowsVal, error) {
+	v := GridTemplateRowsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "GridTemplateRowsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not GridTemplateRowsVal constants.
+func (v GridTemplateRowsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "GridTemplateRowsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseGridTemplateRowsVal.
+func (v *GridTemplateRowsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseGridTemplateRowsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // HangingPunctuationVal represents values for the hanging-punctuation property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ first || [ force-end | allow-end ] || last ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hanging-punctuation
 type HangingPunctuationVal string
 
 // HangingPunctuationVal constants.
@@ -2516,25 +13794,144 @@ const (
 	HangingPunctuationValNone     HangingPunctuationVal = "none"
 )
 
+func (v HangingPunctuationVal) String() string { return string(v) }
+
+// Values returns every HangingPunctuationVal constant.
+func (HangingPunctuationVal) Values() []HangingPunctuationVal {
+	return []HangingPunctuationVal{
+		HangingPunctuationValAllowEnd,
+		HangingPunctuationValFirst,
+		HangingPunctuationValForceEnd,
+		HangingPunctuationValLast,
+		HangingPunctuationValNone,
+	}
+}
+
+// Valid reports whether v is one of the HangingPunctuationVal constants.
+func (v HangingPunctuationVal) Valid() bool {
+	switch v {
+	case HangingPunctuationValAllowEnd,
+		HangingPunctuationValFirst,
+		HangingPunctuationValForceEnd,
+		HangingPunctuationValLast,
+		HangingPunctuationValNone:
+		return true
+	}
+	return false
+}
+
+// ParseHangingPunctuationVal returns the HangingPunctuationVal constant for a keyword, ignoring ASCII case.
+func ParseHangingPunctuationVal(s string) (HangingPunctuationVal, error) {
+	v := HangingPunctuationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "HangingPunctuationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not HangingPunctuationVal constants.
+func (v HangingPunctuationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "HangingPunctuationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseHangingPunctuationVal.
+func (v *HangingPunctuationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseHangingPunctuationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // HeightVal represents values for the height property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/height
 type HeightVal string
 
 // HeightVal constants.
 const (
-	HeightVal0                HeightVal = "0"
-	HeightValAnchorName       HeightVal = "anchor-name"
-	HeightValAnchorSize       HeightVal = "anchor-size"
-	HeightValAuto             HeightVal = "auto"
-	HeightValCalcSize         HeightVal = "calc-size"
-	HeightValCalcSizeBasis    HeightVal = "calc-size-basis"
-	HeightValCalcSum          HeightVal = "calc-sum"
-	HeightValFitContent       HeightVal = "fit-content"
-	HeightValLengthPercentage HeightVal = "length-percentage"
-	HeightValMaxContent       HeightVal = "max-content"
-	HeightValMinContent       HeightVal = "min-content"
-)
+	HeightValAuto       HeightVal = "auto"
+	HeightValFitContent HeightVal = "fit-content"
+	HeightValMaxContent HeightVal = "max-content"
+	HeightValMinContent HeightVal = "min-content"
+)
+
+func (v HeightVal) String() string { return string(v) }
+
+// Values returns every HeightVal constant.
+func (HeightVal) Values() []HeightVal {
+	return []HeightVal{
+		HeightValAuto,
+		HeightValFitContent,
+		HeightValMaxContent,
+		HeightValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the HeightVal constants.
+func (v HeightVal) Valid() bool {
+	switch v {
+	case HeightValAuto,
+		HeightValFitContent,
+		HeightValMaxContent,
+		HeightValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseHeightVal returns the HeightVal constant for a keyword, ignoring ASCII case.
+func ParseHeightVal(s string) (HeightVal, error) {
+	v := HeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "HeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not HeightVal constants.
+func (v HeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "HeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseHeightVal.
+func (v *HeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseHeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // HyphenateCharacterVal represents values for the hyphenate-character property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-character
 type HyphenateCharacterVal string
 
 // HyphenateCharacterVal constants.
@@ -2542,18 +13939,127 @@ const (
 	HyphenateCharacterValAuto HyphenateCharacterVal = "auto"
 )
 
+func (v HyphenateCharacterVal) String() string { return string(v) }
+
+// Values returns every HyphenateCharacterVal constant.
+func (HyphenateCharacterVal) Values() []HyphenateCharacterVal {
+	return []HyphenateCharacterVal{
+		HyphenateCharacterValAuto,
+	}
+}
+
+// Valid reports whether v is one of the HyphenateCharacterVal constants.
+func (v HyphenateCharacterVal) Valid() bool {
+	switch v {
+	case HyphenateCharacterValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseHyphenateCharacterVal returns the HyphenateCharacterVal constant for a keyword, ignoring ASCII case.
+func ParseHyphenateCharacterVal(s string) (HyphenateCharacterVal, error) {
+	v := HyphenateCharacterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "HyphenateCharacterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not HyphenateCharacterVal constants.
+func (v HyphenateCharacterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "HyphenateCharacterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseHyphenateCharacterVal.
+func (v *HyphenateCharacterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseHyphenateCharacterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // HyphenateLimitCharsVal represents values for the hyphenate-limit-chars property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <integer> ]{1,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-limit-chars
 type HyphenateLimitCharsVal string
 
 // HyphenateLimitCharsVal constants.
 const (
-	HyphenateLimitCharsVal1           HyphenateLimitCharsVal = "1"
-	HyphenateLimitCharsVal3           HyphenateLimitCharsVal = "3"
-	HyphenateLimitCharsValAuto        HyphenateLimitCharsVal = "auto"
-	HyphenateLimitCharsValNumberToken HyphenateLimitCharsVal = "number-token"
-)
+	HyphenateLimitCharsValAuto HyphenateLimitCharsVal = "auto"
+)
+
+func (v HyphenateLimitCharsVal) String() string { return string(v) }
+
+// Values returns every HyphenateLimitCharsVal constant.
+func (HyphenateLimitCharsVal) Values() []HyphenateLimitCharsVal {
+	return []HyphenateLimitCharsVal{
+		HyphenateLimitCharsValAuto,
+	}
+}
+
+// Valid reports whether v is one of the HyphenateLimitCharsVal constants.
+func (v HyphenateLimitCharsVal) Valid() bool {
+	switch v {
+	case HyphenateLimitCharsValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseHyphenateLimitCharsVal returns the HyphenateLimitCharsVal constant for a keyword, ignoring ASCII case.
+func ParseHyphenateLimitCharsVal(s string) (HyphenateLimitCharsVal, error) {
+	v := HyphenateLimitCharsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "HyphenateLimitCharsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not HyphenateLimitCharsVal constants.
+func (v HyphenateLimitCharsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "HyphenateLimitCharsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseHyphenateLimitCharsVal.
+func (v *HyphenateLimitCharsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseHyphenateLimitCharsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // HyphensVal represents values for the hyphens property.
+//
+//   - Initial: manual
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | manual | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphens
 type HyphensVal string
 
 // HyphensVal constants.
@@ -2563,17 +14069,134 @@ const (
 	HyphensValNone   HyphensVal = "none"
 )
 
+func (v HyphensVal) String() string { return string(v) }
+
+// Values returns every HyphensVal constant.
+func (HyphensVal) Values() []HyphensVal {
+	return []HyphensVal{
+		HyphensValAuto,
+		HyphensValManual,
+		HyphensValNone,
+	}
+}
+
+// Valid reports whether v is one of the HyphensVal constants.
+func (v HyphensVal) Valid() bool {
+	switch v {
+	case HyphensValAuto,
+		HyphensValManual,
+		HyphensValNone:
+		return true
+	}
+	return false
+}
+
+// ParseHyphensVal returns the HyphensVal constant for a keyword, ignoring ASCII case.
+func ParseHyphensVal(s string) (HyphensVal, error) {
+	v := HyphensVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "HyphensVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not HyphensVal constants.
+func (v HyphensVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "HyphensVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseHyphensVal.
+func (v *HyphensVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseHyphensVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ImageOrientationVal represents values for the image-orientation property.
+//
+//   - Initial: from-image
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	from-image | <angle> | [ <angle>? flip ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-orientation
 type ImageOrientationVal string
 
 // ImageOrientationVal constants.
 const (
-	ImageOrientationValAngle     ImageOrientationVal = "angle"
 	ImageOrientationValFlip      ImageOrientationVal = "flip"
 	ImageOrientationValFromImage ImageOrientationVal = "from-image"
 )
 
+func (v ImageOrientationVal) String() string { return string(v) }
+
+// Values returns every ImageOrientationVal constant.
+func (ImageOrientationVal) Values() []ImageOrientationVal {
+	return []ImageOrientationVal{
+		ImageOrientationValFlip,
+		ImageOrientationValFromImage,
+	}
+}
+
+// Valid reports whether v is one of the ImageOrientationVal constants.
+func (v ImageOrientationVal) Valid() bool {
+	switch v {
+	case ImageOrientationValFlip,
+		ImageOrientationValFromImage:
+		return true
+	}
+	return false
+}
+
+// ParseImageOrientationVal returns the ImageOrientationVal constant for a keyword, ignoring ASCII case.
+func ParseImageOrientationVal(s string) (ImageOrientationVal, error) {
+	v := ImageOrientationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ImageOrientationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ImageOrientationVal constants.
+func (v ImageOrientationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ImageOrientationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseImageOrientationVal.
+func (v *ImageOrientationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseImageOrientationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ImageRenderingVal represents values for the image-rendering property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | crisp-edges | pixelated | smooth
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-rendering
 type ImageRenderingVal string
 
 // ImageRenderingVal constants.
@@ -2584,7 +14207,69 @@ const (
 	ImageRenderingValSmooth     ImageRenderingVal = "smooth"
 )
 
+func (v ImageRenderingVal) String() string { return string(v) }
+
+// Values returns every ImageRenderingVal constant.
+func (ImageRenderingVal) Values() []ImageRenderingVal {
+	return []ImageRenderingVal{
+		ImageRenderingValAuto,
+		ImageRenderingValCrispEdges,
+		ImageRenderingValPixelated,
+		ImageRenderingValSmooth,
+	}
+}
+
+// Valid reports whether v is one of the ImageRenderingVal constants.
+func (v ImageRenderingVal) Valid() bool {
+	switch v {
+	case ImageRenderingValAuto,
+		ImageRenderingValCrispEdges,
+		ImageRenderingValPixelated,
+		ImageRenderingValSmooth:
+		return true
+	}
+	return false
+}
+
+// ParseImageRenderingVal returns the ImageRenderingVal constant for a keyword, ignoring ASCII case.
+func ParseImageRenderingVal(s string) (ImageRenderingVal, error) {
+	v := ImageRenderingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ImageRenderingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ImageRenderingVal constants.
+func (v ImageRenderingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ImageRenderingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseImageRenderingVal.
+func (v *ImageRenderingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseImageRenderingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ImeModeVal represents values for the ime-mode property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | normal | active | inactive | disabled
+//
+// Deprecated: the ime-mode property is obsolete.
 type ImeModeVal string
 
 // ImeModeVal constants.
@@ -2596,86 +14281,656 @@ const (
 	ImeModeValNormal   ImeModeVal = "normal"
 )
 
+func (v ImeModeVal) String() string { return string(v) }
+
+// Values returns every ImeModeVal constant.
+func (ImeModeVal) Values() []ImeModeVal {
+	return []ImeModeVal{
+		ImeModeValActive,
+		ImeModeValAuto,
+		ImeModeValDisabled,
+		ImeModeValInactive,
+		ImeModeValNormal,
+	}
+}
+
+// Valid reports whether v is one of the ImeModeVal constants.
+func (v ImeModeVal) Valid() bool {
+	switch v {
+	case ImeModeValActive,
+		ImeModeValAuto,
+		ImeModeValDisabled,
+		ImeModeValInactive,
+		ImeModeValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseImeModeVal returns the ImeModeVal constant for a keyword, ignoring ASCII case.
+func ParseImeModeVal(s string) (ImeModeVal, error) {
+	v := ImeModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ImeModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ImeModeVal constants.
+func (v ImeModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ImeModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseImeModeVal.
+func (v *ImeModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseImeModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // InitialLetterVal represents values for the initial-letter property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <number> <integer>? ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/initial-letter
 type InitialLetterVal string
 
 // InitialLetterVal constants.
 const (
-	InitialLetterValNormal      InitialLetterVal = "normal"
-	InitialLetterValNumberToken InitialLetterVal = "number-token"
-)
+	InitialLetterValNormal InitialLetterVal = "normal"
+)
+
+func (v InitialLetterVal) String() string { return string(v) }
+
+// Values returns every InitialLetterVal constant.
+func (InitialLetterVal) Values() []InitialLetterVal {
+	return []InitialLetterVal{
+		InitialLetterValNormal,
+	}
+}
+
+// Valid reports whether v is one of the InitialLetterVal constants.
+func (v InitialLetterVal) Valid() bool {
+	switch v {
+	case InitialLetterValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseInitialLetterVal returns the InitialLetterVal constant for a keyword, ignoring ASCII case.
+func ParseInitialLetterVal(s string) (InitialLetterVal, error) {
+	v := InitialLetterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InitialLetterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InitialLetterVal constants.
+func (v InitialLetterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InitialLetterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInitialLetterVal.
+func (v *InitialLetterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInitialLetterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InlineSizeVal represents values for the inline-size property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inline-size
 type InlineSizeVal string
 
 // InlineSizeVal constants.
 const (
-	InlineSizeValWidth InlineSizeVal = "width"
-)
+	InlineSizeValAuto       InlineSizeVal = "auto"
+	InlineSizeValFitContent InlineSizeVal = "fit-content"
+	InlineSizeValMaxContent InlineSizeVal = "max-content"
+	InlineSizeValMinContent InlineSizeVal = "min-content"
+)
+
+func (v InlineSizeVal) String() string { return string(v) }
+
+// Values returns every InlineSizeVal constant.
+func (InlineSizeVal) Values() []InlineSizeVal {
+	return []InlineSizeVal{
+		InlineSizeValAuto,
+		InlineSizeValFitContent,
+		InlineSizeValMaxContent,
+		InlineSizeValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the InlineSizeVal constants.
+func (v InlineSizeVal) Valid() bool {
+	switch v {
+	case InlineSizeValAuto,
+		InlineSizeValFitContent,
+		InlineSizeValMaxContent,
+		InlineSizeValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseInlineSizeVal returns the InlineSizeVal constant for a keyword, ignoring ASCII case.
+func ParseInlineSizeVal(s string) (InlineSizeVal, error) {
+	v := InlineSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InlineSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InlineSizeVal constants.
+func (v InlineSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InlineSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInlineSizeVal.
+func (v *InlineSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInlineSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetVal represents values for the inset property.
+//
+//   - Shorthand for: bottom, left, right, top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset
 type InsetVal string
 
 // InsetVal constants.
 const (
-	InsetVal1   InsetVal = "1"
-	InsetVal4   InsetVal = "4"
-	InsetValTop InsetVal = "top"
-)
+	InsetValAuto InsetVal = "auto"
+)
+
+func (v InsetVal) String() string { return string(v) }
+
+// Values returns every InsetVal constant.
+func (InsetVal) Values() []InsetVal {
+	return []InsetVal{
+		InsetValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetVal constants.
+func (v InsetVal) Valid() bool {
+	switch v {
+	case InsetValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetVal returns the InsetVal constant for a keyword, ignoring ASCII case.
+func ParseInsetVal(s string) (InsetVal, error) {
+	v := InsetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetVal constants.
+func (v InsetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetVal.
+func (v *InsetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetBlockVal represents values for the inset-block property.
+//
+//   - Shorthand for: inset-block-end, inset-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block
 type InsetBlockVal string
 
 // InsetBlockVal constants.
 const (
-	InsetBlockVal1   InsetBlockVal = "1"
-	InsetBlockVal2   InsetBlockVal = "2"
-	InsetBlockValTop InsetBlockVal = "top"
-)
+	InsetBlockValAuto InsetBlockVal = "auto"
+)
+
+func (v InsetBlockVal) String() string { return string(v) }
+
+// Values returns every InsetBlockVal constant.
+func (InsetBlockVal) Values() []InsetBlockVal {
+	return []InsetBlockVal{
+		InsetBlockValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetBlockVal constants.
+func (v InsetBlockVal) Valid() bool {
+	switch v {
+	case InsetBlockValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetBlockVal returns the InsetBlockVal constant for a keyword, ignoring ASCII case.
+func ParseInsetBlockVal(s string) (InsetBlockVal, error) {
+	v := InsetBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetBlockVal constants.
+func (v InsetBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetBlockVal.
+func (v *InsetBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetBlockEndVal represents values for the inset-block-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-end
 type InsetBlockEndVal string
 
 // InsetBlockEndVal constants.
 const (
-	InsetBlockEndValTop InsetBlockEndVal = "top"
-)
+	InsetBlockEndValAuto InsetBlockEndVal = "auto"
+)
+
+func (v InsetBlockEndVal) String() string { return string(v) }
+
+// Values returns every InsetBlockEndVal constant.
+func (InsetBlockEndVal) Values() []InsetBlockEndVal {
+	return []InsetBlockEndVal{
+		InsetBlockEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetBlockEndVal constants.
+func (v InsetBlockEndVal) Valid() bool {
+	switch v {
+	case InsetBlockEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetBlockEndVal returns the InsetBlockEndVal constant for a keyword, ignoring ASCII case.
+func ParseInsetBlockEndVal(s string) (InsetBlockEndVal, error) {
+	v := InsetBlockEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetBlockEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetBlockEndVal constants.
+func (v InsetBlockEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetBlockEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetBlockEndVal.
+func (v *InsetBlockEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetBlockEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetBlockStartVal represents values for the inset-block-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-start
 type InsetBlockStartVal string
 
 // InsetBlockStartVal constants.
 const (
-	InsetBlockStartValTop InsetBlockStartVal = "top"
-)
+	InsetBlockStartValAuto InsetBlockStartVal = "auto"
+)
+
+func (v InsetBlockStartVal) String() string { return string(v) }
+
+// Values returns every InsetBlockStartVal constant.
+func (InsetBlockStartVal) Values() []InsetBlockStartVal {
+	return []InsetBlockStartVal{
+		InsetBlockStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetBlockStartVal constants.
+func (v InsetBlockStartVal) Valid() bool {
+	switch v {
+	case InsetBlockStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetBlockStartVal returns the InsetBlockStartVal constant for a keyword, ignoring ASCII case.
+func ParseInsetBlockStartVal(s string) (InsetBlockStartVal, error) {
+	v := InsetBlockStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetBlockStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetBlockStartVal constants.
+func (v InsetBlockStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetBlockStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetBlockStartVal.
+func (v *InsetBlockStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetBlockStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetInlineVal represents values for the inset-inline property.
+//
+//   - Shorthand for: inset-inline-end, inset-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline
 type InsetInlineVal string
 
 // InsetInlineVal constants.
 const (
-	InsetInlineVal1   InsetInlineVal = "1"
-	InsetInlineVal2   InsetInlineVal = "2"
-	InsetInlineValTop InsetInlineVal = "top"
-)
+	InsetInlineValAuto InsetInlineVal = "auto"
+)
+
+func (v InsetInlineVal) String() string { return string(v) }
+
+// Values returns every InsetInlineVal constant.
+func (InsetInlineVal) Values() []InsetInlineVal {
+	return []InsetInlineVal{
+		InsetInlineValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetInlineVal constants.
+func (v InsetInlineVal) Valid() bool {
+	switch v {
+	case InsetInlineValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetInlineVal returns the InsetInlineVal constant for a keyword, ignoring ASCII case.
+func ParseInsetInlineVal(s string) (InsetInlineVal, error) {
+	v := InsetInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetInlineVal constants.
+func (v InsetInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetInlineVal.
+func (v *InsetInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetInlineEndVal represents values for the inset-inline-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-end
 type InsetInlineEndVal string
 
 // InsetInlineEndVal constants.
 const (
-	InsetInlineEndValTop InsetInlineEndVal = "top"
-)
+	InsetInlineEndValAuto InsetInlineEndVal = "auto"
+)
+
+func (v InsetInlineEndVal) String() string { return string(v) }
+
+// Values returns every InsetInlineEndVal constant.
+func (InsetInlineEndVal) Values() []InsetInlineEndVal {
+	return []InsetInlineEndVal{
+		InsetInlineEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetInlineEndVal constants.
+func (v InsetInlineEndVal) Valid() bool {
+	switch v {
+	case InsetInlineEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetInlineEndVal returns the InsetInlineEndVal constant for a keyword, ignoring ASCII case.
+func ParseInsetInlineEndVal(s string) (InsetInlineEndVal, error) {
+	v := InsetInlineEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetInlineEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetInlineEndVal constants.
+func (v InsetInlineEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetInlineEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetInlineEndVal.
+func (v *InsetInlineEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetInlineEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // InsetInlineStartVal represents values for the inset-inline-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-start
 type InsetInlineStartVal string
 
 // InsetInlineStartVal constants.
 const (
-	InsetInlineStartValTop InsetInlineStartVal = "top"
-)
+	InsetInlineStartValAuto InsetInlineStartVal = "auto"
+)
+
+func (v InsetInlineStartVal) String() string { return string(v) }
+
+// Values returns every InsetInlineStartVal constant.
+func (InsetInlineStartVal) Values() []InsetInlineStartVal {
+	return []InsetInlineStartVal{
+		InsetInlineStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the InsetInlineStartVal constants.
+func (v InsetInlineStartVal) Valid() bool {
+	switch v {
+	case InsetInlineStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseInsetInlineStartVal returns the InsetInlineStartVal constant for a keyword, ignoring ASCII case.
+func ParseInsetInlineStartVal(s string) (InsetInlineStartVal, error) {
+	v := InsetInlineStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "InsetInlineStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not InsetInlineStartVal constants.
+func (v InsetInlineStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "InsetInlineStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseInsetInlineStartVal.
+func (v *InsetInlineStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseInsetInlineStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // IsolationVal represents values for the isolation property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | isolate
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/isolation
 type IsolationVal string
 
 // IsolationVal constants.
@@ -2684,7 +14939,65 @@ const (
 	IsolationValIsolate IsolationVal = "isolate"
 )
 
+func (v IsolationVal) String() string { return string(v) }
+
+// Values returns every IsolationVal constant.
+func (IsolationVal) Values() []IsolationVal {
+	return []IsolationVal{
+		IsolationValAuto,
+		IsolationValIsolate,
+	}
+}
+
+// Valid reports whether v is one of the IsolationVal constants.
+func (v IsolationVal) Valid() bool {
+	switch v {
+	case IsolationValAuto,
+		IsolationValIsolate:
+		return true
+	}
+	return false
+}
+
+// ParseIsolationVal returns the IsolationVal constant for a keyword, ignoring ASCII case.
+func ParseIsolationVal(s string) (IsolationVal, error) {
+	v := IsolationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "IsolationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not IsolationVal constants.
+func (v IsolationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "IsolationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseIsolationVal.
+func (v *IsolationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseIsolationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // JustifyContentVal represents values for the justify-content property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-content
 type JustifyContentVal string
 
 // JustifyContentVal constants.
@@ -2705,7 +15018,89 @@ const (
 	JustifyContentValUnsafe       JustifyContentVal = "unsafe"
 )
 
+func (v JustifyContentVal) String() string { return string(v) }
+
+// Values returns every JustifyContentVal constant.
+func (JustifyContentVal) Values() []JustifyContentVal {
+	return []JustifyContentVal{
+		JustifyContentValCenter,
+		JustifyContentValEnd,
+		JustifyContentValFlexEnd,
+		JustifyContentValFlexStart,
+		JustifyContentValLeft,
+		JustifyContentValNormal,
+		JustifyContentValRight,
+		JustifyContentValSafe,
+		JustifyContentValSpaceAround,
+		JustifyContentValSpaceBetween,
+		JustifyContentValSpaceEvenly,
+		JustifyContentValStart,
+		JustifyContentValStretch,
+		JustifyContentValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the JustifyContentVal constants.
+func (v JustifyContentVal) Valid() bool {
+	switch v {
+	case JustifyContentValCenter,
+		JustifyContentValEnd,
+		JustifyContentValFlexEnd,
+		JustifyContentValFlexStart,
+		JustifyContentValLeft,
+		JustifyContentValNormal,
+		JustifyContentValRight,
+		JustifyContentValSafe,
+		JustifyContentValSpaceAround,
+		JustifyContentValSpaceBetween,
+		JustifyContentValSpaceEvenly,
+		JustifyContentValStart,
+		JustifyContentValStretch,
+		JustifyContentValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseJustifyContentVal returns the JustifyContentVal constant for a keyword, ignoring ASCII case.
+func ParseJustifyContentVal(s string) (JustifyContentVal, error) {
+	v := JustifyContentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "JustifyContentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not JustifyContentVal constants.
+func (v JustifyContentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "JustifyContentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseJustifyContentVal.
+func (v *JustifyContentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseJustifyContentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // JustifyItemsVal represents values for the justify-items property.
+//
+//   - Initial: legacy
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-items
 type JustifyItemsVal string
 
 // JustifyItemsVal constants.
@@ -2730,7 +15125,97 @@ const (
 	JustifyItemsValUnsafe       JustifyItemsVal = "unsafe"
 )
 
+func (v JustifyItemsVal) String() string { return string(v) }
+
+// Values returns every JustifyItemsVal constant.
+func (JustifyItemsVal) Values() []JustifyItemsVal {
+	return []JustifyItemsVal{
+		JustifyItemsValAnchorCenter,
+		JustifyItemsValBaseline,
+		JustifyItemsValCenter,
+		JustifyItemsValEnd,
+		JustifyItemsValFirst,
+		JustifyItemsValFlexEnd,
+		JustifyItemsValFlexStart,
+		JustifyItemsValLast,
+		JustifyItemsValLeft,
+		JustifyItemsValLegacy,
+		JustifyItemsValNormal,
+		JustifyItemsValRight,
+		JustifyItemsValSafe,
+		JustifyItemsValSelfEnd,
+		JustifyItemsValSelfStart,
+		JustifyItemsValStart,
+		JustifyItemsValStretch,
+		JustifyItemsValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the JustifyItemsVal constants.
+func (v JustifyItemsVal) Valid() bool {
+	switch v {
+	case JustifyItemsValAnchorCenter,
+		JustifyItemsValBaseline,
+		JustifyItemsValCenter,
+		JustifyItemsValEnd,
+		JustifyItemsValFirst,
+		JustifyItemsValFlexEnd,
+		JustifyItemsValFlexStart,
+		JustifyItemsValLast,
+		JustifyItemsValLeft,
+		JustifyItemsValLegacy,
+		JustifyItemsValNormal,
+		JustifyItemsValRight,
+		JustifyItemsValSafe,
+		JustifyItemsValSelfEnd,
+		JustifyItemsValSelfStart,
+		JustifyItemsValStart,
+		JustifyItemsValStretch,
+		JustifyItemsValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseJustifyItemsVal returns the JustifyItemsVal constant for a keyword, ignoring ASCII case.
+func ParseJustifyItemsVal(s string) (JustifyItemsVal, error) {
+	v := JustifyItemsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "JustifyItemsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not JustifyItemsVal constants.
+func (v JustifyItemsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "JustifyItemsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseJustifyItemsVal.
+func (v *JustifyItemsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseJustifyItemsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // JustifySelfVal represents values for the justify-self property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-self
 type JustifySelfVal string
 
 // JustifySelfVal constants.
@@ -2755,7 +15240,95 @@ const (
 	JustifySelfValUnsafe       JustifySelfVal = "unsafe"
 )
 
+func (v JustifySelfVal) String() string { return string(v) }
+
+// Values returns every JustifySelfVal constant.
+func (JustifySelfVal) Values() []JustifySelfVal {
+	return []JustifySelfVal{
+		JustifySelfValAnchorCenter,
+		JustifySelfValAuto,
+		JustifySelfValBaseline,
+		JustifySelfValCenter,
+		JustifySelfValEnd,
+		JustifySelfValFirst,
+		JustifySelfValFlexEnd,
+		JustifySelfValFlexStart,
+		JustifySelfValLast,
+		JustifySelfValLeft,
+		JustifySelfValNormal,
+		JustifySelfValRight,
+		JustifySelfValSafe,
+		JustifySelfValSelfEnd,
+		JustifySelfValSelfStart,
+		JustifySelfValStart,
+		JustifySelfValStretch,
+		JustifySelfValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the JustifySelfVal constants.
+func (v JustifySelfVal) Valid() bool {
+	switch v {
+	case JustifySelfValAnchorCenter,
+		JustifySelfValAuto,
+		JustifySelfValBaseline,
+		JustifySelfValCenter,
+		JustifySelfValEnd,
+		JustifySelfValFirst,
+		JustifySelfValFlexEnd,
+		JustifySelfValFlexStart,
+		JustifySelfValLast,
+		JustifySelfValLeft,
+		JustifySelfValNormal,
+		JustifySelfValRight,
+		JustifySelfValSafe,
+		JustifySelfValSelfEnd,
+		JustifySelfValSelfStart,
+		JustifySelfValStart,
+		JustifySelfValStretch,
+		JustifySelfValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseJustifySelfVal returns the JustifySelfVal constant for a keyword, ignoring ASCII case.
+func ParseJustifySelfVal(s string) (JustifySelfVal, error) {
+	v := JustifySelfVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "JustifySelfVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not JustifySelfVal constants.
+func (v JustifySelfVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "JustifySelfVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseJustifySelfVal.
+func (v *JustifySelfVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseJustifySelfVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // JustifyTracksVal represents values for the justify-tracks property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ] ]#
 type JustifyTracksVal string
 
 // JustifyTracksVal constants.
@@ -2776,20 +15349,153 @@ const (
 	JustifyTracksValUnsafe       JustifyTracksVal = "unsafe"
 )
 
+func (v JustifyTracksVal) String() string { return string(v) }
+
+// Values returns every JustifyTracksVal constant.
+func (JustifyTracksVal) Values() []JustifyTracksVal {
+	return []JustifyTracksVal{
+		JustifyTracksValCenter,
+		JustifyTracksValEnd,
+		JustifyTracksValFlexEnd,
+		JustifyTracksValFlexStart,
+		JustifyTracksValLeft,
+		JustifyTracksValNormal,
+		JustifyTracksValRight,
+		JustifyTracksValSafe,
+		JustifyTracksValSpaceAround,
+		JustifyTracksValSpaceBetween,
+		JustifyTracksValSpaceEvenly,
+		JustifyTracksValStart,
+		JustifyTracksValStretch,
+		JustifyTracksValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the JustifyTracksVal constants.
+func (v JustifyTracksVal) Valid() bool {
+	switch v {
+	case JustifyTracksValCenter,
+		JustifyTracksValEnd,
+		JustifyTracksValFlexEnd,
+		JustifyTracksValFlexStart,
+		JustifyTracksValLeft,
+		JustifyTracksValNormal,
+		JustifyTracksValRight,
+		JustifyTracksValSafe,
+		JustifyTracksValSpaceAround,
+		JustifyTracksValSpaceBetween,
+		JustifyTracksValSpaceEvenly,
+		JustifyTracksValStart,
+		JustifyTracksValStretch,
+		JustifyTracksValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParseJustifyTracksVal returns the JustifyTracksVal constant for a keyword, ignoring ASCII case.
+func ParseJustifyTracksVal(s string) (JustifyTracksVal, error) {
+	v := JustifyTracksVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "JustifyTracksVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not JustifyTracksVal constants.
+func (v JustifyTracksVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "JustifyTracksVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseJustifyTracksVal.
+func (v *JustifyTracksVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseJustifyTracksVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // LeftVal represents values for the left property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/left
 type LeftVal string
 
 // LeftVal constants.
 const (
-	LeftValAnchor           LeftVal = "anchor"
-	LeftValAnchorName       LeftVal = "anchor-name"
-	LeftValAnchorSide       LeftVal = "anchor-side"
-	LeftValAnchorSize       LeftVal = "anchor-size"
-	LeftValAuto             LeftVal = "auto"
-	LeftValLengthPercentage LeftVal = "length-percentage"
-)
+	LeftValAuto LeftVal = "auto"
+)
+
+func (v LeftVal) String() string { return string(v) }
+
+// Values returns every LeftVal constant.
+func (LeftVal) Values() []LeftVal {
+	return []LeftVal{
+		LeftValAuto,
+	}
+}
+
+// Valid reports whether v is one of the LeftVal constants.
+func (v LeftVal) Valid() bool {
+	switch v {
+	case LeftValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseLeftVal returns the LeftVal constant for a keyword, ignoring ASCII case.
+func ParseLeftVal(s string) (LeftVal, error) {
+	v := LeftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "LeftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not LeftVal constants.
+func (v LeftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "LeftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseLeftVal.
+func (v *LeftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseLeftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // LetterSpacingVal represents values for the letter-spacing property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/letter-spacing
 type LetterSpacingVal string
 
 // LetterSpacingVal constants.
@@ -2797,18 +15503,63 @@ const (
 	LetterSpacingValNormal LetterSpacingVal = "normal"
 )
 
-// LightingColorVal represents values for the lighting-color property.
-type LightingColorVal string
-
-// LightingColorVal constants.
-const (
-	LightingColorValColorBase             LightingColorVal = "color-base"
-	LightingColorValDeprecatedSystemColor LightingColorVal = "deprecated-system-color"
-	LightingColorValLightDark             LightingColorVal = "light-dark"
-	LightingColorValSystemColor           LightingColorVal = "system-color"
-)
+func (v LetterSpacingVal) String() string { return string(v) }
+
+// Values returns every LetterSpacingVal constant.
+func (LetterSpacingVal) Values() []LetterSpacingVal {
+	return []LetterSpacingVal{
+		LetterSpacingValNormal,
+	}
+}
+
+// Valid reports whether v is one of the LetterSpacingVal constants.
+func (v LetterSpacingVal) Valid() bool {
+	switch v {
+	case LetterSpacingValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseLetterSpacingVal returns the LetterSpacingVal constant for a keyword, ignoring ASCII case.
+func ParseLetterSpacingVal(s string) (LetterSpacingVal, error) {
+	v := LetterSpacingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "LetterSpacingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not LetterSpacingVal constants.
+func (v LetterSpacingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "LetterSpacingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseLetterSpacingVal.
+func (v *LetterSpacingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseLetterSpacingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // LineBreakVal represents values for the line-break property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | loose | normal | strict | anywhere
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-break
 type LineBreakVal string
 
 // LineBreakVal constants.
@@ -2820,16 +15571,135 @@ const (
 	LineBreakValStrict   LineBreakVal = "strict"
 )
 
+func (v LineBreakVal) String() string { return string(v) }
+
+// Values returns every LineBreakVal constant.
+func (LineBreakVal) Values() []LineBreakVal {
+	return []LineBreakVal{
+		LineBreakValAnywhere,
+		LineBreakValAuto,
+		LineBreakValLoose,
+		LineBreakValNormal,
+		LineBreakValStrict,
+	}
+}
+
+// Valid reports whether v is one of the LineBreakVal constants.
+func (v LineBreakVal) Valid() bool {
+	switch v {
+	case LineBreakValAnywhere,
+		LineBreakValAuto,
+		LineBreakValLoose,
+		LineBreakValNormal,
+		LineBreakValStrict:
+		return true
+	}
+	return false
+}
+
+// ParseLineBreakVal returns the LineBreakVal constant for a keyword, ignoring ASCII case.
+func ParseLineBreakVal(s string) (LineBreakVal, error) {
+	v := LineBreakVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "LineBreakVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not LineBreakVal constants.
+func (v LineBreakVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "LineBreakVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseLineBreakVal.
+func (v *LineBreakVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseLineBreakVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // LineClampVal represents values for the line-clamp property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-clamp
 type LineClampVal string
 
 // LineClampVal constants.
 const (
-	LineClampValNone        LineClampVal = "none"
-	LineClampValNumberToken LineClampVal = "number-token"
-)
+	LineClampValNone LineClampVal = "none"
+)
+
+func (v LineClampVal) String() string { return string(v) }
+
+// Values returns every LineClampVal constant.
+func (LineClampVal) Values() []LineClampVal {
+	return []LineClampVal{
+		LineClampValNone,
+	}
+}
+
+// Valid reports whether v is one of the LineClampVal constants.
+func (v LineClampVal) Valid() bool {
+	switch v {
+	case LineClampValNone:
+		return true
+	}
+	return false
+}
+
+// ParseLineClampVal returns the LineClampVal constant for a keyword, ignoring ASCII case.
+func ParseLineClampVal(s string) (LineClampVal, error) {
+	v := LineClampVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "LineClampVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not LineClampVal constants.
+func (v LineClampVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "LineClampVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseLineClampVal.
+func (v *LineClampVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseLineClampVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // LineHeightVal represents values for the line-height property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <number> | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-height
 type LineHeightVal string
 
 // LineHeightVal constants.
@@ -2837,30 +15707,197 @@ const (
 	LineHeightValNormal LineHeightVal = "normal"
 )
 
+func (v LineHeightVal) String() string { return string(v) }
+
+// Values returns every LineHeightVal constant.
+func (LineHeightVal) Values() []LineHeightVal {
+	return []LineHeightVal{
+		LineHeightValNormal,
+	}
+}
+
+// Valid reports whether v is one of the LineHeightVal constants.
+func (v LineHeightVal) Valid() bool {
+	switch v {
+	case LineHeightValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseLineHeightVal returns the LineHeightVal constant for a keyword, ignoring ASCII case.
+func ParseLineHeightVal(s string) (LineHeightVal, error) {
+	v := LineHeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "LineHeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not LineHeightVal constants.
+func (v LineHeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "LineHeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseLineHeightVal.
+func (v *LineHeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseLineHeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ListStyleVal represents values for the list-style property.
+//
+//   - Shorthand for: list-style-image, list-style-position, list-style-type
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'list-style-type'> || <'list-style-position'> || <'list-style-image'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style
 type ListStyleVal string
 
 // ListStyleVal constants.
 const (
-	ListStyleValListStyleImage    ListStyleVal = "list-style-image"
-	ListStyleValListStylePosition ListStyleVal = "list-style-position"
-	ListStyleValListStyleType     ListStyleVal = "list-style-type"
-)
+	ListStyleValInside  ListStyleVal = "inside"
+	ListStyleValNone    ListStyleVal = "none"
+	ListStyleValOutside ListStyleVal = "outside"
+)
+
+func (v ListStyleVal) String() string { return string(v) }
+
+// Values returns every ListStyleVal constant.
+func (ListStyleVal) Values() []ListStyleVal {
+	return []ListStyleVal{
+		ListStyleValInside,
+		ListStyleValNone,
+		ListStyleValOutside,
+	}
+}
+
+// Valid reports whether v is one of the ListStyleVal constants.
+func (v ListStyleVal) Valid() bool {
+	switch v {
+	case ListStyleValInside,
+		ListStyleValNone,
+		ListStyleValOutside:
+		return true
+	}
+	return false
+}
+
+// ParseListStyleVal returns the ListStyleVal constant for a keyword, ignoring ASCII case.
+func ParseListStyleVal(s string) (ListStyleVal, error) {
+	v := ListStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ListStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ListStyleVal constants.
+func (v ListStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ListStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseListStyleVal.
+func (v *ListStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseListStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ListStyleImageVal represents values for the list-style-image property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<image> | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-image
 type ListStyleImageVal string
 
 // ListStyleImageVal constants.
 const (
-	ListStyleImageValCrossFade ListStyleImageVal = "cross-fade"
-	ListStyleImageValElement   ListStyleImageVal = "element"
-	ListStyleImageValGradient  ListStyleImageVal = "gradient"
-	ListStyleImageValImageSet  ListStyleImageVal = "image-set"
-	ListStyleImageValNone      ListStyleImageVal = "none"
-	ListStyleImageValPaint     ListStyleImageVal = "paint"
-)
+	ListStyleImageValNone ListStyleImageVal = "none"
+)
+
+func (v ListStyleImageVal) String() string { return string(v) }
+
+// Values returns every ListStyleImageVal constant.
+func (ListStyleImageVal) Values() []ListStyleImageVal {
+	return []ListStyleImageVal{
+		ListStyleImageValNone,
+	}
+}
+
+// Valid reports whether v is one of the ListStyleImageVal constants.
+func (v ListStyleImageVal) Valid() bool {
+	switch v {
+	case ListStyleImageValNone:
+		return true
+	}
+	return false
+}
+
+// ParseListStyleImageVal returns the ListStyleImageVal constant for a keyword, ignoring ASCII case.
+func ParseListStyleImageVal(s string) (ListStyleImageVal, error) {
+	v := ListStyleImageVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ListStyleImageVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ListStyleImageVal constants.
+func (v ListStyleImageVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ListStyleImageVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseListStyleImageVal.
+func (v *ListStyleImageVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseListStyleImageVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ListStylePositionVal represents values for the list-style-position property.
+//
+//   - Initial: outside
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	inside | outside
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-position
 type ListStylePositionVal string
 
 // ListStylePositionVal constants.
@@ -2869,123 +15906,833 @@ const (
 	ListStylePositionValOutside ListStylePositionVal = "outside"
 )
 
+func (v ListStylePositionVal) String() string { return string(v) }
+
+// Values returns every ListStylePositionVal constant.
+func (ListStylePositionVal) Values() []ListStylePositionVal {
+	return []ListStylePositionVal{
+		ListStylePositionValInside,
+		ListStylePositionValOutside,
+	}
+}
+
+// Valid reports whether v is one of the ListStylePositionVal constants.
+func (v ListStylePositionVal) Valid() bool {
+	switch v {
+	case ListStylePositionValInside,
+		ListStylePositionValOutside:
+		return true
+	}
+	return false
+}
+
+// ParseListStylePositionVal returns the ListStylePositionVal constant for a keyword, ignoring ASCII case.
+func ParseListStylePositionVal(s string) (ListStylePositionVal, error) {
+	v := ListStylePositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ListStylePositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ListStylePositionVal constants.
+func (v ListStylePositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ListStylePositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseListStylePositionVal.
+func (v *ListStylePositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseListStylePositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ListStyleTypeVal represents values for the list-style-type property.
+//
+//   - Initial: disc
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<counter-style> | <string> | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-type
 type ListStyleTypeVal string
 
 // ListStyleTypeVal constants.
 const (
-	ListStyleTypeValCounterStyleName ListStyleTypeVal = "counter-style-name"
-	ListStyleTypeValNone             ListStyleTypeVal = "none"
-	ListStyleTypeValSymbols          ListStyleTypeVal = "symbols"
-)
+	ListStyleTypeValNone ListStyleTypeVal = "none"
+)
+
+func (v ListStyleTypeVal) String() string { return string(v) }
+
+// Values returns every ListStyleTypeVal constant.
+func (ListStyleTypeVal) Values() []ListStyleTypeVal {
+	return []ListStyleTypeVal{
+		ListStyleTypeValNone,
+	}
+}
+
+// Valid reports whether v is one of the ListStyleTypeVal constants.
+func (v ListStyleTypeVal) Valid() bool {
+	switch v {
+	case ListStyleTypeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseListStyleTypeVal returns the ListStyleTypeVal constant for a keyword, ignoring ASCII case.
+func ParseListStyleTypeVal(s string) (ListStyleTypeVal, error) {
+	v := ListStyleTypeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ListStyleTypeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ListStyleTypeVal constants.
+func (v ListStyleTypeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ListStyleTypeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseListStyleTypeVal.
+func (v *ListStyleTypeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseListStyleTypeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginVal represents values for the margin property.
+//
+//   - Shorthand for: margin-bottom, margin-left, margin-right, margin-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin
 type MarginVal string
 
 // MarginVal constants.
 const (
-	MarginVal1         MarginVal = "1"
-	MarginVal4         MarginVal = "4"
-	MarginValMarginTop MarginVal = "margin-top"
-)
+	MarginValAuto MarginVal = "auto"
+)
+
+func (v MarginVal) String() string { return string(v) }
+
+// Values returns every MarginVal constant.
+func (MarginVal) Values() []MarginVal {
+	return []MarginVal{
+		MarginValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginVal constants.
+func (v MarginVal) Valid() bool {
+	switch v {
+	case MarginValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginVal returns the MarginVal constant for a keyword, ignoring ASCII case.
+func ParseMarginVal(s string) (MarginVal, error) {
+	v := MarginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginVal constants.
+func (v MarginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginVal.
+func (v *MarginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginBlockVal represents values for the margin-block property.
+//
+//   - Shorthand for: margin-block-end, margin-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block
 type MarginBlockVal string
 
 // MarginBlockVal constants.
 const (
-	MarginBlockVal1         MarginBlockVal = "1"
-	MarginBlockVal2         MarginBlockVal = "2"
-	MarginBlockValMarginTop MarginBlockVal = "margin-top"
-)
+	MarginBlockValAuto MarginBlockVal = "auto"
+)
+
+func (v MarginBlockVal) String() string { return string(v) }
+
+// Values returns every MarginBlockVal constant.
+func (MarginBlockVal) Values() []MarginBlockVal {
+	return []MarginBlockVal{
+		MarginBlockValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginBlockVal constants.
+func (v MarginBlockVal) Valid() bool {
+	switch v {
+	case MarginBlockValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginBlockVal returns the MarginBlockVal constant for a keyword, ignoring ASCII case.
+func ParseMarginBlockVal(s string) (MarginBlockVal, error) {
+	v := MarginBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginBlockVal constants.
+func (v MarginBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginBlockVal.
+func (v *MarginBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginBlockEndVal represents values for the margin-block-end property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-end
 type MarginBlockEndVal string
 
 // MarginBlockEndVal constants.
 const (
-	MarginBlockEndValMarginTop MarginBlockEndVal = "margin-top"
-)
+	MarginBlockEndValAuto MarginBlockEndVal = "auto"
+)
+
+func (v MarginBlockEndVal) String() string { return string(v) }
+
+// Values returns every MarginBlockEndVal constant.
+func (MarginBlockEndVal) Values() []MarginBlockEndVal {
+	return []MarginBlockEndVal{
+		MarginBlockEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginBlockEndVal constants.
+func (v MarginBlockEndVal) Valid() bool {
+	switch v {
+	case MarginBlockEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginBlockEndVal returns the MarginBlockEndVal constant for a keyword, ignoring ASCII case.
+func ParseMarginBlockEndVal(s string) (MarginBlockEndVal, error) {
+	v := MarginBlockEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginBlockEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginBlockEndVal constants.
+func (v MarginBlockEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginBlockEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginBlockEndVal.
+func (v *MarginBlockEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginBlockEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginBlockStartVal represents values for the margin-block-start property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-start
 type MarginBlockStartVal string
 
 // MarginBlockStartVal constants.
 const (
-	MarginBlockStartValMarginTop MarginBlockStartVal = "margin-top"
-)
+	MarginBlockStartValAuto MarginBlockStartVal = "auto"
+)
+
+func (v MarginBlockStartVal) String() string { return string(v) }
+
+// Values returns every MarginBlockStartVal constant.
+func (MarginBlockStartVal) Values() []MarginBlockStartVal {
+	return []MarginBlockStartVal{
+		MarginBlockStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginBlockStartVal constants.
+func (v MarginBlockStartVal) Valid() bool {
+	switch v {
+	case MarginBlockStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginBlockStartVal returns the MarginBlockStartVal constant for a keyword, ignoring ASCII case.
+func ParseMarginBlockStartVal(s string) (MarginBlockStartVal, error) {
+	v := MarginBlockStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginBlockStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginBlockStartVal constants.
+func (v MarginBlockStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginBlockStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginBlockStartVal.
+func (v *MarginBlockStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginBlockStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginBottomVal represents values for the margin-bottom property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-bottom
 type MarginBottomVal string
 
 // MarginBottomVal constants.
 const (
-	MarginBottomValAnchorName       MarginBottomVal = "anchor-name"
-	MarginBottomValAnchorSize       MarginBottomVal = "anchor-size"
-	MarginBottomValAuto             MarginBottomVal = "auto"
-	MarginBottomValLengthPercentage MarginBottomVal = "length-percentage"
-)
+	MarginBottomValAuto MarginBottomVal = "auto"
+)
+
+func (v MarginBottomVal) String() string { return string(v) }
+
+// Values returns every MarginBottomVal constant.
+func (MarginBottomVal) Values() []MarginBottomVal {
+	return []MarginBottomVal{
+		MarginBottomValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginBottomVal constants.
+func (v MarginBottomVal) Valid() bool {
+	switch v {
+	case MarginBottomValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginBottomVal returns the MarginBottomVal constant for a keyword, ignoring ASCII case.
+func ParseMarginBottomVal(s string) (MarginBottomVal, error) {
+	v := MarginBottomVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginBottomVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginBottomVal constants.
+func (v MarginBottomVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginBottomVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginBottomVal.
+func (v *MarginBottomVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginBottomVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginInlineVal represents values for the margin-inline property.
+//
+//   - Shorthand for: margin-inline-end, margin-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline
 type MarginInlineVal string
 
 // MarginInlineVal constants.
 const (
-	MarginInlineVal1         MarginInlineVal = "1"
-	MarginInlineVal2         MarginInlineVal = "2"
-	MarginInlineValMarginTop MarginInlineVal = "margin-top"
-)
+	MarginInlineValAuto MarginInlineVal = "auto"
+)
+
+func (v MarginInlineVal) String() string { return string(v) }
+
+// Values returns every MarginInlineVal constant.
+func (MarginInlineVal) Values() []MarginInlineVal {
+	return []MarginInlineVal{
+		MarginInlineValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginInlineVal constants.
+func (v MarginInlineVal) Valid() bool {
+	switch v {
+	case MarginInlineValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginInlineVal returns the MarginInlineVal constant for a keyword, ignoring ASCII case.
+func ParseMarginInlineVal(s string) (MarginInlineVal, error) {
+	v := MarginInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginInlineVal constants.
+func (v MarginInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginInlineVal.
+func (v *MarginInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginInlineEndVal represents values for the margin-inline-end property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-end
 type MarginInlineEndVal string
 
 // MarginInlineEndVal constants.
 const (
-	MarginInlineEndValMarginTop MarginInlineEndVal = "margin-top"
-)
+	MarginInlineEndValAuto MarginInlineEndVal = "auto"
+)
+
+func (v MarginInlineEndVal) String() string { return string(v) }
+
+// Values returns every MarginInlineEndVal constant.
+func (MarginInlineEndVal) Values() []MarginInlineEndVal {
+	return []MarginInlineEndVal{
+		MarginInlineEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginInlineEndVal constants.
+func (v MarginInlineEndVal) Valid() bool {
+	switch v {
+	case MarginInlineEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginInlineEndVal returns the MarginInlineEndVal constant for a keyword, ignoring ASCII case.
+func ParseMarginInlineEndVal(s string) (MarginInlineEndVal, error) {
+	v := MarginInlineEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginInlineEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginInlineEndVal constants.
+func (v MarginInlineEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginInlineEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginInlineEndVal.
+func (v *MarginInlineEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginInlineEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginInlineStartVal represents values for the margin-inline-start property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-start
 type MarginInlineStartVal string
 
 // MarginInlineStartVal constants.
 const (
-	MarginInlineStartValMarginTop MarginInlineStartVal = "margin-top"
-)
+	MarginInlineStartValAuto MarginInlineStartVal = "auto"
+)
+
+func (v MarginInlineStartVal) String() string { return string(v) }
+
+// Values returns every MarginInlineStartVal constant.
+func (MarginInlineStartVal) Values() []MarginInlineStartVal {
+	return []MarginInlineStartVal{
+		MarginInlineStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginInlineStartVal constants.
+func (v MarginInlineStartVal) Valid() bool {
+	switch v {
+	case MarginInlineStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginInlineStartVal returns the MarginInlineStartVal constant for a keyword, ignoring ASCII case.
+func ParseMarginInlineStartVal(s string) (MarginInlineStartVal, error) {
+	v := MarginInlineStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginInlineStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginInlineStartVal constants.
+func (v MarginInlineStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginInlineStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginInlineStartVal.
+func (v *MarginInlineStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginInlineStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginLeftVal represents values for the margin-left property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-left
 type MarginLeftVal string
 
 // MarginLeftVal constants.
 const (
-	MarginLeftValAnchorName       MarginLeftVal = "anchor-name"
-	MarginLeftValAnchorSize       MarginLeftVal = "anchor-size"
-	MarginLeftValAuto             MarginLeftVal = "auto"
-	MarginLeftValLengthPercentage MarginLeftVal = "length-percentage"
-)
+	MarginLeftValAuto MarginLeftVal = "auto"
+)
+
+func (v MarginLeftVal) String() string { return string(v) }
+
+// Values returns every MarginLeftVal constant.
+func (MarginLeftVal) Values() []MarginLeftVal {
+	return []MarginLeftVal{
+		MarginLeftValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginLeftVal constants.
+func (v MarginLeftVal) Valid() bool {
+	switch v {
+	case MarginLeftValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginLeftVal returns the MarginLeftVal constant for a keyword, ignoring ASCII case.
+func ParseMarginLeftVal(s string) (MarginLeftVal, error) {
+	v := MarginLeftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginLeftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginLeftVal constants.
+func (v MarginLeftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginLeftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginLeftVal.
+func (v *MarginLeftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginLeftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginRightVal represents values for the margin-right property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-right
 type MarginRightVal string
 
 // MarginRightVal constants.
 const (
-	MarginRightValAnchorName       MarginRightVal = "anchor-name"
-	MarginRightValAnchorSize       MarginRightVal = "anchor-size"
-	MarginRightValAuto             MarginRightVal = "auto"
-	MarginRightValLengthPercentage MarginRightVal = "length-percentage"
-)
+	MarginRightValAuto MarginRightVal = "auto"
+)
+
+func (v MarginRightVal) String() string { return string(v) }
+
+// Values returns every MarginRightVal constant.
+func (MarginRightVal) Values() []MarginRightVal {
+	return []MarginRightVal{
+		MarginRightValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginRightVal constants.
+func (v MarginRightVal) Valid() bool {
+	switch v {
+	case MarginRightValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginRightVal returns the MarginRightVal constant for a keyword, ignoring ASCII case.
+func ParseMarginRightVal(s string) (MarginRightVal, error) {
+	v := MarginRightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginRightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginRightVal constants.
+func (v MarginRightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginRightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginRightVal.
+func (v *MarginRightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginRightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarginTopVal represents values for the margin-top property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-top
 type MarginTopVal string
 
 // MarginTopVal constants.
 const (
-	MarginTopValAnchorName       MarginTopVal = "anchor-name"
-	MarginTopValAnchorSize       MarginTopVal = "anchor-size"
-	MarginTopValAuto             MarginTopVal = "auto"
-	MarginTopValLengthPercentage MarginTopVal = "length-percentage"
-)
+	MarginTopValAuto MarginTopVal = "auto"
+)
+
+func (v MarginTopVal) String() string { return string(v) }
+
+// Values returns every MarginTopVal constant.
+func (MarginTopVal) Values() []MarginTopVal {
+	return []MarginTopVal{
+		MarginTopValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MarginTopVal constants.
+func (v MarginTopVal) Valid() bool {
+	switch v {
+	case MarginTopValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMarginTopVal returns the MarginTopVal constant for a keyword, ignoring ASCII case.
+func ParseMarginTopVal(s string) (MarginTopVal, error) {
+	v := MarginTopVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarginTopVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarginTopVal constants.
+func (v MarginTopVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarginTopVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarginTopVal.
+func (v *MarginTopVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarginTopVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MarkerVal represents values for the marker property.
+//
+//   - Shorthand for: marker-end, marker-mid, marker-start
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker
 type MarkerVal string
 
 // MarkerVal constants.
@@ -2993,7 +16740,63 @@ const (
 	MarkerValNone MarkerVal = "none"
 )
 
+func (v MarkerVal) String() string { return string(v) }
+
+// Values returns every MarkerVal constant.
+func (MarkerVal) Values() []MarkerVal {
+	return []MarkerVal{
+		MarkerValNone,
+	}
+}
+
+// Valid reports whether v is one of the MarkerVal constants.
+func (v MarkerVal) Valid() bool {
+	switch v {
+	case MarkerValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMarkerVal returns the MarkerVal constant for a keyword, ignoring ASCII case.
+func ParseMarkerVal(s string) (MarkerVal, error) {
+	v := MarkerVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarkerVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarkerVal constants.
+func (v MarkerVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarkerVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarkerVal.
+func (v *MarkerVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarkerVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MarkerEndVal represents values for the marker-end property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-end
 type MarkerEndVal string
 
 // MarkerEndVal constants.
@@ -3001,7 +16804,63 @@ const (
 	MarkerEndValNone MarkerEndVal = "none"
 )
 
+func (v MarkerEndVal) String() string { return string(v) }
+
+// Values returns every MarkerEndVal constant.
+func (MarkerEndVal) Values() []MarkerEndVal {
+	return []MarkerEndVal{
+		MarkerEndValNone,
+	}
+}
+
+// Valid reports whether v is one of the MarkerEndVal constants.
+func (v MarkerEndVal) Valid() bool {
+	switch v {
+	case MarkerEndValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMarkerEndVal returns the MarkerEndVal constant for a keyword, ignoring ASCII case.
+func ParseMarkerEndVal(s string) (MarkerEndVal, error) {
+	v := MarkerEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarkerEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarkerEndVal constants.
+func (v MarkerEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarkerEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarkerEndVal.
+func (v *MarkerEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarkerEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MarkerMidVal represents values for the marker-mid property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-mid
 type MarkerMidVal string
 
 // MarkerMidVal constants.
@@ -3009,7 +16868,63 @@ const (
 	MarkerMidValNone MarkerMidVal = "none"
 )
 
+func (v MarkerMidVal) String() string { return string(v) }
+
+// Values returns every MarkerMidVal constant.
+func (MarkerMidVal) Values() []MarkerMidVal {
+	return []MarkerMidVal{
+		MarkerMidValNone,
+	}
+}
+
+// Valid reports whether v is one of the MarkerMidVal constants.
+func (v MarkerMidVal) Valid() bool {
+	switch v {
+	case MarkerMidValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMarkerMidVal returns the MarkerMidVal constant for a keyword, ignoring ASCII case.
+func ParseMarkerMidVal(s string) (MarkerMidVal, error) {
+	v := MarkerMidVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarkerMidVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarkerMidVal constants.
+func (v MarkerMidVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarkerMidVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarkerMidVal.
+func (v *MarkerMidVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarkerMidVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MarkerStartVal represents values for the marker-start property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-start
 type MarkerStartVal string
 
 // MarkerStartVal constants.
@@ -3017,35 +16932,302 @@ const (
 	MarkerStartValNone MarkerStartVal = "none"
 )
 
+func (v MarkerStartVal) String() string { return string(v) }
+
+// Values returns every MarkerStartVal constant.
+func (MarkerStartVal) Values() []MarkerStartVal {
+	return []MarkerStartVal{
+		MarkerStartValNone,
+	}
+}
+
+// Valid reports whether v is one of the MarkerStartVal constants.
+func (v MarkerStartVal) Valid() bool {
+	switch v {
+	case MarkerStartValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMarkerStartVal returns the MarkerStartVal constant for a keyword, ignoring ASCII case.
+func ParseMarkerStartVal(s string) (MarkerStartVal, error) {
+	v := MarkerStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MarkerStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MarkerStartVal constants.
+func (v MarkerStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MarkerStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMarkerStartVal.
+func (v *MarkerStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMarkerStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskVal represents values for the mask property.
+//
+//   - Shorthand for: mask-clip, mask-composite, mask-image, mask-mode, mask-origin, mask-position, mask-repeat, mask-size
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<mask-layer>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask
 type MaskVal string
 
 // MaskVal constants.
 const (
-	MaskValBgSize              MaskVal = "bg-size"
-	MaskValCompositingOperator MaskVal = "compositing-operator"
-	MaskValGeometryBox         MaskVal = "geometry-box"
-	MaskValMaskReference       MaskVal = "mask-reference"
-	MaskValMaskingMode         MaskVal = "masking-mode"
-	MaskValNoClip              MaskVal = "no-clip"
-	MaskValPosition            MaskVal = "position"
-	MaskValRepeatStyle         MaskVal = "repeat-style"
-)
+	MaskValAdd         MaskVal = "add"
+	MaskValAlpha       MaskVal = "alpha"
+	MaskValAuto        MaskVal = "auto"
+	MaskValBorderBox   MaskVal = "border-box"
+	MaskValBottom      MaskVal = "bottom"
+	MaskValCenter      MaskVal = "center"
+	MaskValContain     MaskVal = "contain"
+	MaskValContentBox  MaskVal = "content-box"
+	MaskValCover       MaskVal = "cover"
+	MaskValExclude     MaskVal = "exclude"
+	MaskValFillBox     MaskVal = "fill-box"
+	MaskValIntersect   MaskVal = "intersect"
+	MaskValLeft        MaskVal = "left"
+	MaskValLuminance   MaskVal = "luminance"
+	MaskValMarginBox   MaskVal = "margin-box"
+	MaskValMatchSource MaskVal = "match-source"
+	MaskValNoClip      MaskVal = "no-clip"
+	MaskValNoRepeat    MaskVal = "no-repeat"
+	MaskValNone        MaskVal = "none"
+	MaskValPaddingBox  MaskVal = "padding-box"
+	MaskValRepeat      MaskVal = "repeat"
+	MaskValRepeatX     MaskVal = "repeat-x"
+	MaskValRepeatY     MaskVal = "repeat-y"
+	MaskValRight       MaskVal = "right"
+	MaskValRound       MaskVal = "round"
+	MaskValSpace       MaskVal = "space"
+	MaskValStrokeBox   MaskVal = "stroke-box"
+	MaskValSubtract    MaskVal = "subtract"
+	MaskValTop         MaskVal = "top"
+	MaskValViewBox     MaskVal = "view-box"
+)
+
+func (v MaskVal) String() string { return string(v) }
+
+// Values returns every MaskVal constant.
+func (MaskVal) Values() []MaskVal {
+	return []MaskVal{
+		MaskValAdd,
+		MaskValAlpha,
+		MaskValAuto,
+		MaskValBorderBox,
+		MaskValBottom,
+		MaskValCenter,
+		MaskValContain,
+		MaskValContentBox,
+		MaskValCover,
+		MaskValExclude,
+		MaskValFillBox,
+		MaskValIntersect,
+		MaskValLeft,
+		MaskValLuminance,
+		MaskValMarginBox,
+		MaskValMatchSource,
+		MaskValNoClip,
+		MaskValNoRepeat,
+		MaskValNone,
+		MaskValPaddingBox,
+		MaskValRepeat,
+		MaskValRepeatX,
+		MaskValRepeatY,
+		MaskValRight,
+		MaskValRound,
+		MaskValSpace,
+		MaskValStrokeBox,
+		MaskValSubtract,
+		MaskValTop,
+		MaskValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the MaskVal constants.
+func (v MaskVal) Valid() bool {
+	switch v {
+	case MaskValAdd,
+		MaskValAlpha,
+		MaskValAuto,
+		MaskValBorderBox,
+		MaskValBottom,
+		MaskValCenter,
+		MaskValContain,
+		MaskValContentBox,
+		MaskValCover,
+		MaskValExclude,
+		MaskValFillBox,
+		MaskValIntersect,
+		MaskValLeft,
+		MaskValLuminance,
+		MaskValMarginBox,
+		MaskValMatchSource,
+		MaskValNoClip,
+		MaskValNoRepeat,
+		MaskValNone,
+		MaskValPaddingBox,
+		MaskValRepeat,
+		MaskValRepeatX,
+		MaskValRepeatY,
+		MaskValRight,
+		MaskValRound,
+		MaskValSpace,
+		MaskValStrokeBox,
+		MaskValSubtract,
+		MaskValTop,
+		MaskValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseMaskVal returns the MaskVal constant for a keyword, ignoring ASCII case.
+func ParseMaskVal(s string) (MaskVal, error) {
+	v := MaskVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskVal constants.
+func (v MaskVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskVal.
+func (v *MaskVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskBorderVal represents values for the mask-border property.
+//
+//   - Shorthand for: mask-border-mode, mask-border-outset, mask-border-repeat, mask-border-slice, mask-border-source, mask-border-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border
 type MaskBorderVal string
 
 // MaskBorderVal constants.
 const (
-	MaskBorderValMaskBorderMode   MaskBorderVal = "mask-border-mode"
-	MaskBorderValMaskBorderOutset MaskBorderVal = "mask-border-outset"
-	MaskBorderValMaskBorderRepeat MaskBorderVal = "mask-border-repeat"
-	MaskBorderValMaskBorderSlice  MaskBorderVal = "mask-border-slice"
-	MaskBorderValMaskBorderSource MaskBorderVal = "mask-border-source"
-	MaskBorderValMaskBorderWidth  MaskBorderVal = "mask-border-width"
-)
+	MaskBorderValAlpha     MaskBorderVal = "alpha"
+	MaskBorderValAuto      MaskBorderVal = "auto"
+	MaskBorderValFill      MaskBorderVal = "fill"
+	MaskBorderValLuminance MaskBorderVal = "luminance"
+	MaskBorderValNone      MaskBorderVal = "none"
+	MaskBorderValRepeat    MaskBorderVal = "repeat"
+	MaskBorderValRound     MaskBorderVal = "round"
+	MaskBorderValSpace     MaskBorderVal = "space"
+	MaskBorderValStretch   MaskBorderVal = "stretch"
+)
+
+func (v MaskBorderVal) String() string { return string(v) }
+
+// Values returns every MaskBorderVal constant.
+func (MaskBorderVal) Values() []MaskBorderVal {
+	return []MaskBorderVal{
+		MaskBorderValAlpha,
+		MaskBorderValAuto,
+		MaskBorderValFill,
+		MaskBorderValLuminance,
+		MaskBorderValNone,
+		MaskBorderValRepeat,
+		MaskBorderValRound,
+		MaskBorderValSpace,
+		MaskBorderValStretch,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderVal constants.
+func (v MaskBorderVal) Valid() bool {
+	switch v {
+	case MaskBorderValAlpha,
+		MaskBorderValAuto,
+		MaskBorderValFill,
+		MaskBorderValLuminance,
+		MaskBorderValNone,
+		MaskBorderValRepeat,
+		MaskBorderValRound,
+		MaskBorderValSpace,
+		MaskBorderValStretch:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderVal returns the MaskBorderVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderVal(s string) (MaskBorderVal, error) {
+	v := MaskBorderVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderVal constants.
+func (v MaskBorderVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderVal.
+func (v *MaskBorderVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskBorderModeVal represents values for the mask-border-mode property.
+//
+//   - Initial: alpha
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	luminance | alpha
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-mode
 type MaskBorderModeVal string
 
 // MaskBorderModeVal constants.
@@ -3054,72 +17236,412 @@ const (
 	MaskBorderModeValLuminance MaskBorderModeVal = "luminance"
 )
 
-// MaskBorderOutsetVal represents values for the mask-border-outset property.
-type MaskBorderOutsetVal string
-
-// MaskBorderOutsetVal constants.
-const (
-	MaskBorderOutsetVal1 MaskBorderOutsetVal = "1"
-	MaskBorderOutsetVal4 MaskBorderOutsetVal = "4"
-)
+func (v MaskBorderModeVal) String() string { return string(v) }
+
+// Values returns every MaskBorderModeVal constant.
+func (MaskBorderModeVal) Values() []MaskBorderModeVal {
+	return []MaskBorderModeVal{
+		MaskBorderModeValAlpha,
+		MaskBorderModeValLuminance,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderModeVal constants.
+func (v MaskBorderModeVal) Valid() bool {
+	switch v {
+	case MaskBorderModeValAlpha,
+		MaskBorderModeValLuminance:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderModeVal returns the MaskBorderModeVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderModeVal(s string) (MaskBorderModeVal, error) {
+	v := MaskBorderModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderModeVal constants.
+func (v MaskBorderModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderModeVal.
+func (v *MaskBorderModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskBorderRepeatVal represents values for the mask-border-repeat property.
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ stretch | repeat | round | space ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-repeat
 type MaskBorderRepeatVal string
 
 // MaskBorderRepeatVal constants.
 const (
-	MaskBorderRepeatVal1       MaskBorderRepeatVal = "1"
-	MaskBorderRepeatVal2       MaskBorderRepeatVal = "2"
 	MaskBorderRepeatValRepeat  MaskBorderRepeatVal = "repeat"
 	MaskBorderRepeatValRound   MaskBorderRepeatVal = "round"
 	MaskBorderRepeatValSpace   MaskBorderRepeatVal = "space"
 	MaskBorderRepeatValStretch MaskBorderRepeatVal = "stretch"
 )
 
+func (v MaskBorderRepeatVal) String() string { return string(v) }
+
+// Values returns every MaskBorderRepeatVal constant.
+func (MaskBorderRepeatVal) Values() []MaskBorderRepeatVal {
+	return []MaskBorderRepeatVal{
+		MaskBorderRepeatValRepeat,
+		MaskBorderRepeatValRound,
+		MaskBorderRepeatValSpace,
+		MaskBorderRepeatValStretch,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderRepeatVal constants.
+func (v MaskBorderRepeatVal) Valid() bool {
+	switch v {
+	case MaskBorderRepeatValRepeat,
+		MaskBorderRepeatValRound,
+		MaskBorderRepeatValSpace,
+		MaskBorderRepeatValStretch:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderRepeatVal returns the MaskBorderRepeatVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderRepeatVal(s string) (MaskBorderRepeatVal, error) {
+	v := MaskBorderRepeatVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderRepeatVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderRepeatVal constants.
+func (v MaskBorderRepeatVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderRepeatVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderRepeatVal.
+func (v *MaskBorderRepeatVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderRepeatVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskBorderSliceVal represents values for the mask-border-slice property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<number-percentage>{1,4} fill?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-slice
 type MaskBorderSliceVal string
 
 // MaskBorderSliceVal constants.
 const (
-	MaskBorderSliceVal1    MaskBorderSliceVal = "1"
-	MaskBorderSliceVal4    MaskBorderSliceVal = "4"
 	MaskBorderSliceValFill MaskBorderSliceVal = "fill"
 )
 
+func (v MaskBorderSliceVal) String() string { return string(v) }
+
+// Values returns every MaskBorderSliceVal constant.
+func (MaskBorderSliceVal) Values() []MaskBorderSliceVal {
+	return []MaskBorderSliceVal{
+		MaskBorderSliceValFill,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderSliceVal constants.
+func (v MaskBorderSliceVal) Valid() bool {
+	switch v {
+	case MaskBorderSliceValFill:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderSliceVal returns the MaskBorderSliceVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderSliceVal(s string) (MaskBorderSliceVal, error) {
+	v := MaskBorderSliceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderSliceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderSliceVal constants.
+func (v MaskBorderSliceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderSliceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderSliceVal.
+func (v *MaskBorderSliceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderSliceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskBorderSourceVal represents values for the mask-border-source property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-source
 type MaskBorderSourceVal string
 
 // MaskBorderSourceVal constants.
 const (
-	MaskBorderSourceValCrossFade MaskBorderSourceVal = "cross-fade"
-	MaskBorderSourceValElement   MaskBorderSourceVal = "element"
-	MaskBorderSourceValGradient  MaskBorderSourceVal = "gradient"
-	MaskBorderSourceValImageSet  MaskBorderSourceVal = "image-set"
-	MaskBorderSourceValNone      MaskBorderSourceVal = "none"
-	MaskBorderSourceValPaint     MaskBorderSourceVal = "paint"
-)
+	MaskBorderSourceValNone MaskBorderSourceVal = "none"
+)
+
+func (v MaskBorderSourceVal) String() string { return string(v) }
+
+// Values returns every MaskBorderSourceVal constant.
+func (MaskBorderSourceVal) Values() []MaskBorderSourceVal {
+	return []MaskBorderSourceVal{
+		MaskBorderSourceValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderSourceVal constants.
+func (v MaskBorderSourceVal) Valid() bool {
+	switch v {
+	case MaskBorderSourceValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderSourceVal returns the MaskBorderSourceVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderSourceVal(s string) (MaskBorderSourceVal, error) {
+	v := MaskBorderSourceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderSourceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderSourceVal constants.
+func (v MaskBorderSourceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderSourceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderSourceVal.
+func (v *MaskBorderSourceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderSourceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskBorderWidthVal represents values for the mask-border-width property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage> | <number> | auto ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-width
 type MaskBorderWidthVal string
 
 // MaskBorderWidthVal constants.
 const (
-	MaskBorderWidthVal1    MaskBorderWidthVal = "1"
-	MaskBorderWidthVal4    MaskBorderWidthVal = "4"
 	MaskBorderWidthValAuto MaskBorderWidthVal = "auto"
 )
 
+func (v MaskBorderWidthVal) String() string { return string(v) }
+
+// Values returns every MaskBorderWidthVal constant.
+func (MaskBorderWidthVal) Values() []MaskBorderWidthVal {
+	return []MaskBorderWidthVal{
+		MaskBorderWidthValAuto,
+	}
+}
+
+// Valid reports whether v is one of the MaskBorderWidthVal constants.
+func (v MaskBorderWidthVal) Valid() bool {
+	switch v {
+	case MaskBorderWidthValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseMaskBorderWidthVal returns the MaskBorderWidthVal constant for a keyword, ignoring ASCII case.
+func ParseMaskBorderWidthVal(s string) (MaskBorderWidthVal, error) {
+	v := MaskBorderWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskBorderWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskBorderWidthVal constants.
+func (v MaskBorderWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskBorderWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskBorderWidthVal.
+func (v *MaskBorderWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskBorderWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskClipVal represents values for the mask-clip property.
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <coord-box> | no-clip ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-clip
 type MaskClipVal string
 
 // MaskClipVal constants.
 const (
-	MaskClipValNoClip   MaskClipVal = "no-clip"
-	MaskClipValPaintBox MaskClipVal = "paint-box"
-	MaskClipValViewBox  MaskClipVal = "view-box"
-)
+	MaskClipValBorderBox  MaskClipVal = "border-box"
+	MaskClipValContentBox MaskClipVal = "content-box"
+	MaskClipValFillBox    MaskClipVal = "fill-box"
+	MaskClipValNoClip     MaskClipVal = "no-clip"
+	MaskClipValPaddingBox MaskClipVal = "padding-box"
+	MaskClipValStrokeBox  MaskClipVal = "stroke-box"
+	MaskClipValViewBox    MaskClipVal = "view-box"
+)
+
+func (v MaskClipVal) String() string { return string(v) }
+
+// Values returns every MaskClipVal constant.
+func (MaskClipVal) Values() []MaskClipVal {
+	return []MaskClipVal{
+		MaskClipValBorderBox,
+		MaskClipValContentBox,
+		MaskClipValFillBox,
+		MaskClipValNoClip,
+		MaskClipValPaddingBox,
+		MaskClipValStrokeBox,
+		MaskClipValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the MaskClipVal constants.
+func (v MaskClipVal) Valid() bool {
+	switch v {
+	case MaskClipValBorderBox,
+		MaskClipValContentBox,
+		MaskClipValFillBox,
+		MaskClipValNoClip,
+		MaskClipValPaddingBox,
+		MaskClipValStrokeBox,
+		MaskClipValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseMaskClipVal returns the MaskClipVal constant for a keyword, ignoring ASCII case.
+func ParseMaskClipVal(s string) (MaskClipVal, error) {
+	v := MaskClipVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskClipVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskClipVal constants.
+func (v MaskClipVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskClipVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskClipVal.
+func (v *MaskClipVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskClipVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskCompositeVal represents values for the mask-composite property.
+//
+//   - Initial: add
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<compositing-operator>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-composite
 type MaskCompositeVal string
 
 // MaskCompositeVal constants.
@@ -3130,16 +17652,133 @@ const (
 	MaskCompositeValSubtract  MaskCompositeVal = "subtract"
 )
 
+func (v MaskCompositeVal) String() string { return string(v) }
+
+// Values returns every MaskCompositeVal constant.
+func (MaskCompositeVal) Values() []MaskCompositeVal {
+	return []MaskCompositeVal{
+		MaskCompositeValAdd,
+		MaskCompositeValExclude,
+		MaskCompositeValIntersect,
+		MaskCompositeValSubtract,
+	}
+}
+
+// Valid reports whether v is one of the MaskCompositeVal constants.
+func (v MaskCompositeVal) Valid() bool {
+	switch v {
+	case MaskCompositeValAdd,
+		MaskCompositeValExclude,
+		MaskCompositeValIntersect,
+		MaskCompositeValSubtract:
+		return true
+	}
+	return false
+}
+
+// ParseMaskCompositeVal returns the MaskCompositeVal constant for a keyword, ignoring ASCII case.
+func ParseMaskCompositeVal(s string) (MaskCompositeVal, error) {
+	v := MaskCompositeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskCompositeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskCompositeVal constants.
+func (v MaskCompositeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskCompositeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskCompositeVal.
+func (v *MaskCompositeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskCompositeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskImageVal represents values for the mask-image property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<mask-reference>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-image
 type MaskImageVal string
 
 // MaskImageVal constants.
 const (
-	MaskImageValMaskSource MaskImageVal = "mask-source"
-	MaskImageValNone       MaskImageVal = "none"
-)
+	MaskImageValNone MaskImageVal = "none"
+)
+
+func (v MaskImageVal) String() string { return string(v) }
+
+// Values returns every MaskImageVal constant.
+func (MaskImageVal) Values() []MaskImageVal {
+	return []MaskImageVal{
+		MaskImageValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaskImageVal constants.
+func (v MaskImageVal) Valid() bool {
+	switch v {
+	case MaskImageValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaskImageVal returns the MaskImageVal constant for a keyword, ignoring ASCII case.
+func ParseMaskImageVal(s string) (MaskImageVal, error) {
+	v := MaskImageVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskImageVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskImageVal constants.
+func (v MaskImageVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskImageVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskImageVal.
+func (v *MaskImageVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskImageVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskModeVal represents values for the mask-mode property.
+//
+//   - Initial: match-source
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<masking-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-mode
 type MaskModeVal string
 
 // MaskModeVal constants.
@@ -3149,35 +17788,226 @@ const (
 	MaskModeValMatchSource MaskModeVal = "match-source"
 )
 
+func (v MaskModeVal) String() string { return string(v) }
+
+// Values returns every MaskModeVal constant.
+func (MaskModeVal) Values() []MaskModeVal {
+	return []MaskModeVal{
+		MaskModeValAlpha,
+		MaskModeValLuminance,
+		MaskModeValMatchSource,
+	}
+}
+
+// Valid reports whether v is one of the MaskModeVal constants.
+func (v MaskModeVal) Valid() bool {
+	switch v {
+	case MaskModeValAlpha,
+		MaskModeValLuminance,
+		MaskModeValMatchSource:
+		return true
+	}
+	return false
+}
+
+// ParseMaskModeVal returns the MaskModeVal constant for a keyword, ignoring ASCII case.
+func ParseMaskModeVal(s string) (MaskModeVal, error) {
+	v := MaskModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskModeVal constants.
+func (v MaskModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskModeVal.
+func (v *MaskModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskOriginVal represents values for the mask-origin property.
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<coord-box>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-origin
 type MaskOriginVal string
 
 // MaskOriginVal constants.
 const (
-	MaskOriginValPaintBox MaskOriginVal = "paint-box"
-	MaskOriginValViewBox  MaskOriginVal = "view-box"
-)
+	MaskOriginValBorderBox  MaskOriginVal = "border-box"
+	MaskOriginValContentBox MaskOriginVal = "content-box"
+	MaskOriginValFillBox    MaskOriginVal = "fill-box"
+	MaskOriginValPaddingBox MaskOriginVal = "padding-box"
+	MaskOriginValStrokeBox  MaskOriginVal = "stroke-box"
+	MaskOriginValViewBox    MaskOriginVal = "view-box"
+)
+
+func (v MaskOriginVal) String() string { return string(v) }
+
+// Values returns every MaskOriginVal constant.
+func (MaskOriginVal) Values() []MaskOriginVal {
+	return []MaskOriginVal{
+		MaskOriginValBorderBox,
+		MaskOriginValContentBox,
+		MaskOriginValFillBox,
+		MaskOriginValPaddingBox,
+		MaskOriginValStrokeBox,
+		MaskOriginValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the MaskOriginVal constants.
+func (v MaskOriginVal) Valid() bool {
+	switch v {
+	case MaskOriginValBorderBox,
+		MaskOriginValContentBox,
+		MaskOriginValFillBox,
+		MaskOriginValPaddingBox,
+		MaskOriginValStrokeBox,
+		MaskOriginValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseMaskOriginVal returns the MaskOriginVal constant for a keyword, ignoring ASCII case.
+func ParseMaskOriginVal(s string) (MaskOriginVal, error) {
+	v := MaskOriginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskOriginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskOriginVal constants.
+func (v MaskOriginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskOriginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskOriginVal.
+func (v *MaskOriginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskOriginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskPositionVal represents values for the mask-position property.
+//
+//   - Initial: 0% 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-position
 type MaskPositionVal string
 
 // MaskPositionVal constants.
 const (
-	MaskPositionValBottom           MaskPositionVal = "bottom"
-	MaskPositionValCenter           MaskPositionVal = "center"
-	MaskPositionValLeft             MaskPositionVal = "left"
-	MaskPositionValLengthPercentage MaskPositionVal = "length-percentage"
-	MaskPositionValRight            MaskPositionVal = "right"
-	MaskPositionValTop              MaskPositionVal = "top"
-)
+	MaskPositionValBottom MaskPositionVal = "bottom"
+	MaskPositionValCenter MaskPositionVal = "center"
+	MaskPositionValLeft   MaskPositionVal = "left"
+	MaskPositionValRight  MaskPositionVal = "right"
+	MaskPositionValTop    MaskPositionVal = "top"
+)
+
+func (v MaskPositionVal) String() string { return string(v) }
+
+// Values returns every MaskPositionVal constant.
+func (MaskPositionVal) Values() []MaskPositionVal {
+	return []MaskPositionVal{
+		MaskPositionValBottom,
+		MaskPositionValCenter,
+		MaskPositionValLeft,
+		MaskPositionValRight,
+		MaskPositionValTop,
+	}
+}
+
+// Valid reports whether v is one of the MaskPositionVal constants.
+func (v MaskPositionVal) Valid() bool {
+	switch v {
+	case MaskPositionValBottom,
+		MaskPositionValCenter,
+		MaskPositionValLeft,
+		MaskPositionValRight,
+		MaskPositionValTop:
+		return true
+	}
+	return false
+}
+
+// ParseMaskPositionVal returns the MaskPositionVal constant for a keyword, ignoring ASCII case.
+func ParseMaskPositionVal(s string) (MaskPositionVal, error) {
+	v := MaskPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskPositionVal constants.
+func (v MaskPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskPositionVal.
+func (v *MaskPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskRepeatVal represents values for the mask-repeat property.
+//
+//   - Initial: repeat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<repeat-style>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-repeat
 type MaskRepeatVal string
 
 // MaskRepeatVal constants.
 const (
-	MaskRepeatVal1        MaskRepeatVal = "1"
-	MaskRepeatVal2        MaskRepeatVal = "2"
 	MaskRepeatValNoRepeat MaskRepeatVal = "no-repeat"
 	MaskRepeatValRepeat   MaskRepeatVal = "repeat"
 	MaskRepeatValRepeatX  MaskRepeatVal = "repeat-x"
@@ -3186,21 +18016,143 @@ const (
 	MaskRepeatValSpace    MaskRepeatVal = "space"
 )
 
+func (v MaskRepeatVal) String() string { return string(v) }
+
+// Values returns every MaskRepeatVal constant.
+func (MaskRepeatVal) Values() []MaskRepeatVal {
+	return []MaskRepeatVal{
+		MaskRepeatValNoRepeat,
+		MaskRepeatValRepeat,
+		MaskRepeatValRepeatX,
+		MaskRepeatValRepeatY,
+		MaskRepeatValRound,
+		MaskRepeatValSpace,
+	}
+}
+
+// Valid reports whether v is one of the MaskRepeatVal constants.
+func (v MaskRepeatVal) Valid() bool {
+	switch v {
+	case MaskRepeatValNoRepeat,
+		MaskRepeatValRepeat,
+		MaskRepeatValRepeatX,
+		MaskRepeatValRepeatY,
+		MaskRepeatValRound,
+		MaskRepeatValSpace:
+		return true
+	}
+	return false
+}
+
+// ParseMaskRepeatVal returns the MaskRepeatVal constant for a keyword, ignoring ASCII case.
+func ParseMaskRepeatVal(s string) (MaskRepeatVal, error) {
+	v := MaskRepeatVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskRepeatVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskRepeatVal constants.
+func (v MaskRepeatVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskRepeatVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskRepeatVal.
+func (v *MaskRepeatVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskRepeatVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaskSizeVal represents values for the mask-size property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-size>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-size
 type MaskSizeVal string
 
 // MaskSizeVal constants.
 const (
-	MaskSizeVal0                MaskSizeVal = "0"
-	MaskSizeVal1                MaskSizeVal = "1"
-	MaskSizeVal2                MaskSizeVal = "2"
-	MaskSizeValAuto             MaskSizeVal = "auto"
-	MaskSizeValContain          MaskSizeVal = "contain"
-	MaskSizeValCover            MaskSizeVal = "cover"
-	MaskSizeValLengthPercentage MaskSizeVal = "length-percentage"
-)
+	MaskSizeValAuto    MaskSizeVal = "auto"
+	MaskSizeValContain MaskSizeVal = "contain"
+	MaskSizeValCover   MaskSizeVal = "cover"
+)
+
+func (v MaskSizeVal) String() string { return string(v) }
+
+// Values returns every MaskSizeVal constant.
+func (MaskSizeVal) Values() []MaskSizeVal {
+	return []MaskSizeVal{
+		MaskSizeValAuto,
+		MaskSizeValContain,
+		MaskSizeValCover,
+	}
+}
+
+// Valid reports whether v is one of the MaskSizeVal constants.
+func (v MaskSizeVal) Valid() bool {
+	switch v {
+	case MaskSizeValAuto,
+		MaskSizeValContain,
+		MaskSizeValCover:
+		return true
+	}
+	return false
+}
+
+// ParseMaskSizeVal returns the MaskSizeVal constant for a keyword, ignoring ASCII case.
+func ParseMaskSizeVal(s string) (MaskSizeVal, error) {
+	v := MaskSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskSizeVal constants.
+func (v MaskSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskSizeVal.
+func (v *MaskSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaskTypeVal represents values for the mask-type property.
+//
+//   - Initial: luminance
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	luminance | alpha
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-type
 type MaskTypeVal string
 
 // MaskTypeVal constants.
@@ -3209,7 +18161,65 @@ const (
 	MaskTypeValLuminance MaskTypeVal = "luminance"
 )
 
+func (v MaskTypeVal) String() string { return string(v) }
+
+// Values returns every MaskTypeVal constant.
+func (MaskTypeVal) Values() []MaskTypeVal {
+	return []MaskTypeVal{
+		MaskTypeValAlpha,
+		MaskTypeValLuminance,
+	}
+}
+
+// Valid reports whether v is one of the MaskTypeVal constants.
+func (v MaskTypeVal) Valid() bool {
+	switch v {
+	case MaskTypeValAlpha,
+		MaskTypeValLuminance:
+		return true
+	}
+	return false
+}
+
+// ParseMaskTypeVal returns the MaskTypeVal constant for a keyword, ignoring ASCII case.
+func ParseMaskTypeVal(s string) (MaskTypeVal, error) {
+	v := MaskTypeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaskTypeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaskTypeVal constants.
+func (v MaskTypeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaskTypeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaskTypeVal.
+func (v *MaskTypeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaskTypeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MasonryAutoFlowVal represents values for the masonry-auto-flow property.
+//
+//   - Initial: pack
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ pack | next ] || [ definite-first | ordered ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
 type MasonryAutoFlowVal string
 
 // MasonryAutoFlowVal constants.
@@ -3220,17 +18230,133 @@ const (
 	MasonryAutoFlowValPack          MasonryAutoFlowVal = "pack"
 )
 
+func (v MasonryAutoFlowVal) String() string { return string(v) }
+
+// Values returns every MasonryAutoFlowVal constant.
+func (MasonryAutoFlowVal) Values() []MasonryAutoFlowVal {
+	return []MasonryAutoFlowVal{
+		MasonryAutoFlowValDefiniteFirst,
+		MasonryAutoFlowValNext,
+		MasonryAutoFlowValOrdered,
+		MasonryAutoFlowValPack,
+	}
+}
+
+// Valid reports whether v is one of the MasonryAutoFlowVal constants.
+func (v MasonryAutoFlowVal) Valid() bool {
+	switch v {
+	case MasonryAutoFlowValDefiniteFirst,
+		MasonryAutoFlowValNext,
+		MasonryAutoFlowValOrdered,
+		MasonryAutoFlowValPack:
+		return true
+	}
+	return false
+}
+
+// ParseMasonryAutoFlowVal returns the MasonryAutoFlowVal constant for a keyword, ignoring ASCII case.
+func ParseMasonryAutoFlowVal(s string) (MasonryAutoFlowVal, error) {
+	v := MasonryAutoFlowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MasonryAutoFlowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MasonryAutoFlowVal constants.
+func (v MasonryAutoFlowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MasonryAutoFlowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMasonryAutoFlowVal.
+func (v *MasonryAutoFlowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMasonryAutoFlowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MathDepthVal represents values for the math-depth property.
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto-add | add(<integer>) | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-depth
 type MathDepthVal string
 
 // MathDepthVal constants.
 const (
-	MathDepthValAdd         MathDepthVal = "add"
-	MathDepthValAutoAdd     MathDepthVal = "auto-add"
-	MathDepthValNumberToken MathDepthVal = "number-token"
-)
+	MathDepthValAutoAdd MathDepthVal = "auto-add"
+)
+
+func (v MathDepthVal) String() string { return string(v) }
+
+// Values returns every MathDepthVal constant.
+func (MathDepthVal) Values() []MathDepthVal {
+	return []MathDepthVal{
+		MathDepthValAutoAdd,
+	}
+}
+
+// Valid reports whether v is one of the MathDepthVal constants.
+func (v MathDepthVal) Valid() bool {
+	switch v {
+	case MathDepthValAutoAdd:
+		return true
+	}
+	return false
+}
+
+// ParseMathDepthVal returns the MathDepthVal constant for a keyword, ignoring ASCII case.
+func ParseMathDepthVal(s string) (MathDepthVal, error) {
+	v := MathDepthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MathDepthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MathDepthVal constants.
+func (v MathDepthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MathDepthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMathDepthVal.
+func (v *MathDepthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMathDepthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MathStyleVal represents values for the math-style property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | compact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-style
 type MathStyleVal string
 
 // MathStyleVal constants.
@@ -3239,115 +18365,654 @@ const (
 	MathStyleValNormal  MathStyleVal = "normal"
 )
 
+func (v MathStyleVal) String() string { return string(v) }
+
+// Values returns every MathStyleVal constant.
+func (MathStyleVal) Values() []MathStyleVal {
+	return []MathStyleVal{
+		MathStyleValCompact,
+		MathStyleValNormal,
+	}
+}
+
+// Valid reports whether v is one of the MathStyleVal constants.
+func (v MathStyleVal) Valid() bool {
+	switch v {
+	case MathStyleValCompact,
+		MathStyleValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseMathStyleVal returns the MathStyleVal constant for a keyword, ignoring ASCII case.
+func ParseMathStyleVal(s string) (MathStyleVal, error) {
+	v := MathStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MathStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MathStyleVal constants.
+func (v MathStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MathStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMathStyleVal.
+func (v *MathStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMathStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // MaxBlockSizeVal represents values for the max-block-size property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'max-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-block-size
 type MaxBlockSizeVal string
 
 // MaxBlockSizeVal constants.
 const (
-	MaxBlockSizeValMaxWidth MaxBlockSizeVal = "max-width"
-)
+	MaxBlockSizeValFitContent MaxBlockSizeVal = "fit-content"
+	MaxBlockSizeValMaxContent MaxBlockSizeVal = "max-content"
+	MaxBlockSizeValMinContent MaxBlockSizeVal = "min-content"
+	MaxBlockSizeValNone       MaxBlockSizeVal = "none"
+)
+
+func (v MaxBlockSizeVal) String() string { return string(v) }
+
+// Values returns every MaxBlockSizeVal constant.
+func (MaxBlockSizeVal) Values() []MaxBlockSizeVal {
+	return []MaxBlockSizeVal{
+		MaxBlockSizeValFitContent,
+		MaxBlockSizeValMaxContent,
+		MaxBlockSizeValMinContent,
+		MaxBlockSizeValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaxBlockSizeVal constants.
+func (v MaxBlockSizeVal) Valid() bool {
+	switch v {
+	case MaxBlockSizeValFitContent,
+		MaxBlockSizeValMaxContent,
+		MaxBlockSizeValMinContent,
+		MaxBlockSizeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaxBlockSizeVal returns the MaxBlockSizeVal constant for a keyword, ignoring ASCII case.
+func ParseMaxBlockSizeVal(s string) (MaxBlockSizeVal, error) {
+	v := MaxBlockSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaxBlockSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaxBlockSizeVal constants.
+func (v MaxBlockSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaxBlockSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaxBlockSizeVal.
+func (v *MaxBlockSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaxBlockSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaxHeightVal represents values for the max-height property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-height
 type MaxHeightVal string
 
 // MaxHeightVal constants.
 const (
-	MaxHeightVal0                MaxHeightVal = "0"
-	MaxHeightValAnchorName       MaxHeightVal = "anchor-name"
-	MaxHeightValAnchorSize       MaxHeightVal = "anchor-size"
-	MaxHeightValCalcSize         MaxHeightVal = "calc-size"
-	MaxHeightValCalcSizeBasis    MaxHeightVal = "calc-size-basis"
-	MaxHeightValCalcSum          MaxHeightVal = "calc-sum"
-	MaxHeightValFitContent       MaxHeightVal = "fit-content"
-	MaxHeightValLengthPercentage MaxHeightVal = "length-percentage"
-	MaxHeightValMaxContent       MaxHeightVal = "max-content"
-	MaxHeightValMinContent       MaxHeightVal = "min-content"
-	MaxHeightValNone             MaxHeightVal = "none"
-)
+	MaxHeightValFitContent MaxHeightVal = "fit-content"
+	MaxHeightValMaxContent MaxHeightVal = "max-content"
+	MaxHeightValMinContent MaxHeightVal = "min-content"
+	MaxHeightValNone       MaxHeightVal = "none"
+)
+
+func (v MaxHeightVal) String() string { return string(v) }
+
+// Values returns every MaxHeightVal constant.
+func (MaxHeightVal) Values() []MaxHeightVal {
+	return []MaxHeightVal{
+		MaxHeightValFitContent,
+		MaxHeightValMaxContent,
+		MaxHeightValMinContent,
+		MaxHeightValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaxHeightVal constants.
+func (v MaxHeightVal) Valid() bool {
+	switch v {
+	case MaxHeightValFitContent,
+		MaxHeightValMaxContent,
+		MaxHeightValMinContent,
+		MaxHeightValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaxHeightVal returns the MaxHeightVal constant for a keyword, ignoring ASCII case.
+func ParseMaxHeightVal(s string) (MaxHeightVal, error) {
+	v := MaxHeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaxHeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaxHeightVal constants.
+func (v MaxHeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaxHeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaxHeightVal.
+func (v *MaxHeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaxHeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaxInlineSizeVal represents values for the max-inline-size property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'max-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-inline-size
 type MaxInlineSizeVal string
 
 // MaxInlineSizeVal constants.
 const (
-	MaxInlineSizeValMaxWidth MaxInlineSizeVal = "max-width"
-)
+	MaxInlineSizeValFitContent MaxInlineSizeVal = "fit-content"
+	MaxInlineSizeValMaxContent MaxInlineSizeVal = "max-content"
+	MaxInlineSizeValMinContent MaxInlineSizeVal = "min-content"
+	MaxInlineSizeValNone       MaxInlineSizeVal = "none"
+)
+
+func (v MaxInlineSizeVal) String() string { return string(v) }
+
+// Values returns every MaxInlineSizeVal constant.
+func (MaxInlineSizeVal) Values() []MaxInlineSizeVal {
+	return []MaxInlineSizeVal{
+		MaxInlineSizeValFitContent,
+		MaxInlineSizeValMaxContent,
+		MaxInlineSizeValMinContent,
+		MaxInlineSizeValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaxInlineSizeVal constants.
+func (v MaxInlineSizeVal) Valid() bool {
+	switch v {
+	case MaxInlineSizeValFitContent,
+		MaxInlineSizeValMaxContent,
+		MaxInlineSizeValMinContent,
+		MaxInlineSizeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaxInlineSizeVal returns the MaxInlineSizeVal constant for a keyword, ignoring ASCII case.
+func ParseMaxInlineSizeVal(s string) (MaxInlineSizeVal, error) {
+	v := MaxInlineSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaxInlineSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaxInlineSizeVal constants.
+func (v MaxInlineSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaxInlineSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaxInlineSizeVal.
+func (v *MaxInlineSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaxInlineSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MaxWidthVal represents values for the max-width property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-width
 type MaxWidthVal string
 
 // MaxWidthVal constants.
 const (
-	MaxWidthVal0                MaxWidthVal = "0"
-	MaxWidthValAnchorName       MaxWidthVal = "anchor-name"
-	MaxWidthValAnchorSize       MaxWidthVal = "anchor-size"
-	MaxWidthValCalcSize         MaxWidthVal = "calc-size"
-	MaxWidthValCalcSizeBasis    MaxWidthVal = "calc-size-basis"
-	MaxWidthValCalcSum          MaxWidthVal = "calc-sum"
-	MaxWidthValFitContent       MaxWidthVal = "fit-content"
-	MaxWidthValLengthPercentage MaxWidthVal = "length-percentage"
-	MaxWidthValMaxContent       MaxWidthVal = "max-content"
-	MaxWidthValMinContent       MaxWidthVal = "min-content"
-	MaxWidthValNone             MaxWidthVal = "none"
-)
+	MaxWidthValFitContent MaxWidthVal = "fit-content"
+	MaxWidthValMaxContent MaxWidthVal = "max-content"
+	MaxWidthValMinContent MaxWidthVal = "min-content"
+	MaxWidthValNone       MaxWidthVal = "none"
+)
+
+func (v MaxWidthVal) String() string { return string(v) }
+
+// Values returns every MaxWidthVal constant.
+func (MaxWidthVal) Values() []MaxWidthVal {
+	return []MaxWidthVal{
+		MaxWidthValFitContent,
+		MaxWidthValMaxContent,
+		MaxWidthValMinContent,
+		MaxWidthValNone,
+	}
+}
+
+// Valid reports whether v is one of the MaxWidthVal constants.
+func (v MaxWidthVal) Valid() bool {
+	switch v {
+	case MaxWidthValFitContent,
+		MaxWidthValMaxContent,
+		MaxWidthValMinContent,
+		MaxWidthValNone:
+		return true
+	}
+	return false
+}
+
+// ParseMaxWidthVal returns the MaxWidthVal constant for a keyword, ignoring ASCII case.
+func ParseMaxWidthVal(s string) (MaxWidthVal, error) {
+	v := MaxWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MaxWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MaxWidthVal constants.
+func (v MaxWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MaxWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMaxWidthVal.
+func (v *MaxWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMaxWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MinBlockSizeVal represents values for the min-block-size property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'min-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-block-size
 type MinBlockSizeVal string
 
 // MinBlockSizeVal constants.
 const (
-	MinBlockSizeValMinWidth MinBlockSizeVal = "min-width"
-)
+	MinBlockSizeValAuto       MinBlockSizeVal = "auto"
+	MinBlockSizeValFitContent MinBlockSizeVal = "fit-content"
+	MinBlockSizeValMaxContent MinBlockSizeVal = "max-content"
+	MinBlockSizeValMinContent MinBlockSizeVal = "min-content"
+)
+
+func (v MinBlockSizeVal) String() string { return string(v) }
+
+// Values returns every MinBlockSizeVal constant.
+func (MinBlockSizeVal) Values() []MinBlockSizeVal {
+	return []MinBlockSizeVal{
+		MinBlockSizeValAuto,
+		MinBlockSizeValFitContent,
+		MinBlockSizeValMaxContent,
+		MinBlockSizeValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the MinBlockSizeVal constants.
+func (v MinBlockSizeVal) Valid() bool {
+	switch v {
+	case MinBlockSizeValAuto,
+		MinBlockSizeValFitContent,
+		MinBlockSizeValMaxContent,
+		MinBlockSizeValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseMinBlockSizeVal returns the MinBlockSizeVal constant for a keyword, ignoring ASCII case.
+func ParseMinBlockSizeVal(s string) (MinBlockSizeVal, error) {
+	v := MinBlockSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MinBlockSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MinBlockSizeVal constants.
+func (v MinBlockSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MinBlockSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMinBlockSizeVal.
+func (v *MinBlockSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMinBlockSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MinHeightVal represents values for the min-height property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-height
 type MinHeightVal string
 
 // MinHeightVal constants.
 const (
-	MinHeightVal0                MinHeightVal = "0"
-	MinHeightValAnchorName       MinHeightVal = "anchor-name"
-	MinHeightValAnchorSize       MinHeightVal = "anchor-size"
-	MinHeightValAuto             MinHeightVal = "auto"
-	MinHeightValCalcSize         MinHeightVal = "calc-size"
-	MinHeightValCalcSizeBasis    MinHeightVal = "calc-size-basis"
-	MinHeightValCalcSum          MinHeightVal = "calc-sum"
-	MinHeightValFitContent       MinHeightVal = "fit-content"
-	MinHeightValLengthPercentage MinHeightVal = "length-percentage"
-	MinHeightValMaxContent       MinHeightVal = "max-content"
-	MinHeightValMinContent       MinHeightVal = "min-content"
-)
+	MinHeightValAuto       MinHeightVal = "auto"
+	MinHeightValFitContent MinHeightVal = "fit-content"
+	MinHeightValMaxContent MinHeightVal = "max-content"
+	MinHeightValMinContent MinHeightVal = "min-content"
+)
+
+func (v MinHeightVal) String() string { return string(v) }
+
+// Values returns every MinHeightVal constant.
+func (MinHeightVal) Values() []MinHeightVal {
+	return []MinHeightVal{
+		MinHeightValAuto,
+		MinHeightValFitContent,
+		MinHeightValMaxContent,
+		MinHeightValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the MinHeightVal constants.
+func (v MinHeightVal) Valid() bool {
+	switch v {
+	case MinHeightValAuto,
+		MinHeightValFitContent,
+		MinHeightValMaxContent,
+		MinHeightValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseMinHeightVal returns the MinHeightVal constant for a keyword, ignoring ASCII case.
+func ParseMinHeightVal(s string) (MinHeightVal, error) {
+	v := MinHeightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MinHeightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MinHeightVal constants.
+func (v MinHeightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MinHeightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMinHeightVal.
+func (v *MinHeightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMinHeightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MinInlineSizeVal represents values for the min-inline-size property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'min-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-inline-size
 type MinInlineSizeVal string
 
 // MinInlineSizeVal constants.
 const (
-	MinInlineSizeValMinWidth MinInlineSizeVal = "min-width"
-)
+	MinInlineSizeValAuto       MinInlineSizeVal = "auto"
+	MinInlineSizeValFitContent MinInlineSizeVal = "fit-content"
+	MinInlineSizeValMaxContent MinInlineSizeVal = "max-content"
+	MinInlineSizeValMinContent MinInlineSizeVal = "min-content"
+)
+
+func (v MinInlineSizeVal) String() string { return string(v) }
+
+// Values returns every MinInlineSizeVal constant.
+func (MinInlineSizeVal) Values() []MinInlineSizeVal {
+	return []MinInlineSizeVal{
+		MinInlineSizeValAuto,
+		MinInlineSizeValFitContent,
+		MinInlineSizeValMaxContent,
+		MinInlineSizeValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the MinInlineSizeVal constants.
+func (v MinInlineSizeVal) Valid() bool {
+	switch v {
+	case MinInlineSizeValAuto,
+		MinInlineSizeValFitContent,
+		MinInlineSizeValMaxContent,
+		MinInlineSizeValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseMinInlineSizeVal returns the MinInlineSizeVal constant for a keyword, ignoring ASCII case.
+func ParseMinInlineSizeVal(s string) (MinInlineSizeVal, error) {
+	v := MinInlineSizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MinInlineSizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MinInlineSizeVal constants.
+func (v MinInlineSizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MinInlineSizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMinInlineSizeVal.
+func (v *MinInlineSizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMinInlineSizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MinWidthVal represents values for the min-width property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-width
 type MinWidthVal string
 
 // MinWidthVal constants.
 const (
-	MinWidthVal0                MinWidthVal = "0"
-	MinWidthValAnchorName       MinWidthVal = "anchor-name"
-	MinWidthValAnchorSize       MinWidthVal = "anchor-size"
-	MinWidthValAuto             MinWidthVal = "auto"
-	MinWidthValCalcSize         MinWidthVal = "calc-size"
-	MinWidthValCalcSizeBasis    MinWidthVal = "calc-size-basis"
-	MinWidthValCalcSum          MinWidthVal = "calc-sum"
-	MinWidthValFitContent       MinWidthVal = "fit-content"
-	MinWidthValLengthPercentage MinWidthVal = "length-percentage"
-	MinWidthValMaxContent       MinWidthVal = "max-content"
-	MinWidthValMinContent       MinWidthVal = "min-content"
-)
+	MinWidthValAuto       MinWidthVal = "auto"
+	MinWidthValFitContent MinWidthVal = "fit-content"
+	MinWidthValMaxContent MinWidthVal = "max-content"
+	MinWidthValMinContent MinWidthVal = "min-content"
+)
+
+func (v MinWidthVal) String() string { return string(v) }
+
+// Values returns every MinWidthVal constant.
+func (MinWidthVal) Values() []MinWidthVal {
+	return []MinWidthVal{
+		MinWidthValAuto,
+		MinWidthValFitContent,
+		MinWidthValMaxContent,
+		MinWidthValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the MinWidthVal constants.
+func (v MinWidthVal) Valid() bool {
+	switch v {
+	case MinWidthValAuto,
+		MinWidthValFitContent,
+		MinWidthValMaxContent,
+		MinWidthValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseMinWidthVal returns the MinWidthVal constant for a keyword, ignoring ASCII case.
+func ParseMinWidthVal(s string) (MinWidthVal, error) {
+	v := MinWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MinWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MinWidthVal constants.
+func (v MinWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MinWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMinWidthVal.
+func (v *MinWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMinWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // MixBlendModeVal represents values for the mix-blend-mode property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<blend-mode> | plus-darker | plus-lighter
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mix-blend-mode
 type MixBlendModeVal string
 
 // MixBlendModeVal constants.
 const (
+	MixBlendModeValColor       MixBlendModeVal = "color"
 	MixBlendModeValColorBurn   MixBlendModeVal = "color-burn"
 	MixBlendModeValColorDodge  MixBlendModeVal = "color-dodge"
 	MixBlendModeValDarken      MixBlendModeVal = "darken"
@@ -3367,7 +19032,97 @@ const (
 	MixBlendModeValSoftLight   MixBlendModeVal = "soft-light"
 )
 
+func (v MixBlendModeVal) String() string { return string(v) }
+
+// Values returns every MixBlendModeVal constant.
+func (MixBlendModeVal) Values() []MixBlendModeVal {
+	return []MixBlendModeVal{
+		MixBlendModeValColor,
+		MixBlendModeValColorBurn,
+		MixBlendModeValColorDodge,
+		MixBlendModeValDarken,
+		MixBlendModeValDifference,
+		MixBlendModeValExclusion,
+		MixBlendModeValHardLight,
+		MixBlendModeValHue,
+		MixBlendModeValLighten,
+		MixBlendModeValLuminosity,
+		MixBlendModeValMultiply,
+		MixBlendModeValNormal,
+		MixBlendModeValOverlay,
+		MixBlendModeValPlusDarker,
+		MixBlendModeValPlusLighter,
+		MixBlendModeValSaturation,
+		MixBlendModeValScreen,
+		MixBlendModeValSoftLight,
+	}
+}
+
+// Valid reports whether v is one of the MixBlendModeVal constants.
+func (v MixBlendModeVal) Valid() bool {
+	switch v {
+	case MixBlendModeValColor,
+		MixBlendModeValColorBurn,
+		MixBlendModeValColorDodge,
+		MixBlendModeValDarken,
+		MixBlendModeValDifference,
+		MixBlendModeValExclusion,
+		MixBlendModeValHardLight,
+		MixBlendModeValHue,
+		MixBlendModeValLighten,
+		MixBlendModeValLuminosity,
+		MixBlendModeValMultiply,
+		MixBlendModeValNormal,
+		MixBlendModeValOverlay,
+		MixBlendModeValPlusDarker,
+		MixBlendModeValPlusLighter,
+		MixBlendModeValSaturation,
+		MixBlendModeValScreen,
+		MixBlendModeValSoftLight:
+		return true
+	}
+	return false
+}
+
+// ParseMixBlendModeVal returns the MixBlendModeVal constant for a keyword, ignoring ASCII case.
+func ParseMixBlendModeVal(s string) (MixBlendModeVal, error) {
+	v := MixBlendModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "MixBlendModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not MixBlendModeVal constants.
+func (v MixBlendModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "MixBlendModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseMixBlendModeVal.
+func (v *MixBlendModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseMixBlendModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ObjectFitVal represents values for the object-fit property.
+//
+//   - Initial: fill
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	fill | contain | cover | none | scale-down
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/object-fit
 type ObjectFitVal string
 
 // ObjectFitVal constants.
@@ -3379,121 +19134,727 @@ const (
 	ObjectFitValScaleDown ObjectFitVal = "scale-down"
 )
 
+func (v ObjectFitVal) String() string { return string(v) }
+
+// Values returns every ObjectFitVal constant.
+func (ObjectFitVal) Values() []ObjectFitVal {
+	return []ObjectFitVal{
+		ObjectFitValContain,
+		ObjectFitValCover,
+		ObjectFitValFill,
+		ObjectFitValNone,
+		ObjectFitValScaleDown,
+	}
+}
+
+// Valid reports whether v is one of the ObjectFitVal constants.
+func (v ObjectFitVal) Valid() bool {
+	switch v {
+	case ObjectFitValContain,
+		ObjectFitValCover,
+		ObjectFitValFill,
+		ObjectFitValNone,
+		ObjectFitValScaleDown:
+		return true
+	}
+	return false
+}
+
+// ParseObjectFitVal returns the ObjectFitVal constant for a keyword, ignoring ASCII case.
+func ParseObjectFitVal(s string) (ObjectFitVal, error) {
+	v := ObjectFitVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ObjectFitVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ObjectFitVal constants.
+func (v ObjectFitVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ObjectFitVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseObjectFitVal.
+func (v *ObjectFitVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseObjectFitVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ObjectPositionVal represents values for the object-position property.
+//
+//   - Initial: 50% 50%
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/object-position
 type ObjectPositionVal string
 
 // ObjectPositionVal constants.
 const (
-	ObjectPositionValBottom           ObjectPositionVal = "bottom"
-	ObjectPositionValCenter           ObjectPositionVal = "center"
-	ObjectPositionValLeft             ObjectPositionVal = "left"
-	ObjectPositionValLengthPercentage ObjectPositionVal = "length-percentage"
-	ObjectPositionValRight            ObjectPositionVal = "right"
-	ObjectPositionValTop              ObjectPositionVal = "top"
-)
+	ObjectPositionValBottom ObjectPositionVal = "bottom"
+	ObjectPositionValCenter ObjectPositionVal = "center"
+	ObjectPositionValLeft   ObjectPositionVal = "left"
+	ObjectPositionValRight  ObjectPositionVal = "right"
+	ObjectPositionValTop    ObjectPositionVal = "top"
+)
+
+func (v ObjectPositionVal) String() string { return string(v) }
+
+// Values returns every ObjectPositionVal constant.
+func (ObjectPositionVal) Values() []ObjectPositionVal {
+	return []ObjectPositionVal{
+		ObjectPositionValBottom,
+		ObjectPositionValCenter,
+		ObjectPositionValLeft,
+		ObjectPositionValRight,
+		ObjectPositionValTop,
+	}
+}
+
+// Valid reports whether v is one of the ObjectPositionVal constants.
+func (v ObjectPositionVal) Valid() bool {
+	switch v {
+	case ObjectPositionValBottom,
+		ObjectPositionValCenter,
+		ObjectPositionValLeft,
+		ObjectPositionValRight,
+		ObjectPositionValTop:
+		return true
+	}
+	return false
+}
+
+// ParseObjectPositionVal returns the ObjectPositionVal constant for a keyword, ignoring ASCII case.
+func ParseObjectPositionVal(s string) (ObjectPositionVal, error) {
+	v := ObjectPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ObjectPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ObjectPositionVal constants.
+func (v ObjectPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ObjectPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseObjectPositionVal.
+func (v *ObjectPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseObjectPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OffsetVal represents values for the offset property.
+//
+//   - Shorthand for: offset-anchor, offset-distance, offset-path, offset-position, offset-rotate
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset
 type OffsetVal string
 
 // OffsetVal constants.
 const (
-	OffsetValOffsetAnchor   OffsetVal = "offset-anchor"
-	OffsetValOffsetDistance OffsetVal = "offset-distance"
-	OffsetValOffsetPath     OffsetVal = "offset-path"
-	OffsetValOffsetPosition OffsetVal = "offset-position"
-	OffsetValOffsetRotate   OffsetVal = "offset-rotate"
-)
+	OffsetValAuto       OffsetVal = "auto"
+	OffsetValBorderBox  OffsetVal = "border-box"
+	OffsetValBottom     OffsetVal = "bottom"
+	OffsetValCenter     OffsetVal = "center"
+	OffsetValContentBox OffsetVal = "content-box"
+	OffsetValFillBox    OffsetVal = "fill-box"
+	OffsetValLeft       OffsetVal = "left"
+	OffsetValNone       OffsetVal = "none"
+	OffsetValNormal     OffsetVal = "normal"
+	OffsetValPaddingBox OffsetVal = "padding-box"
+	OffsetValReverse    OffsetVal = "reverse"
+	OffsetValRight      OffsetVal = "right"
+	OffsetValStrokeBox  OffsetVal = "stroke-box"
+	OffsetValTop        OffsetVal = "top"
+	OffsetValViewBox    OffsetVal = "view-box"
+)
+
+func (v OffsetVal) String() string { return string(v) }
+
+// Values returns every OffsetVal constant.
+func (OffsetVal) Values() []OffsetVal {
+	return []OffsetVal{
+		OffsetValAuto,
+		OffsetValBorderBox,
+		OffsetValBottom,
+		OffsetValCenter,
+		OffsetValContentBox,
+		OffsetValFillBox,
+		OffsetValLeft,
+		OffsetValNone,
+		OffsetValNormal,
+		OffsetValPaddingBox,
+		OffsetValReverse,
+		OffsetValRight,
+		OffsetValStrokeBox,
+		OffsetValTop,
+		OffsetValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the OffsetVal constants.
+func (v OffsetVal) Valid() bool {
+	switch v {
+	case OffsetValAuto,
+		OffsetValBorderBox,
+		OffsetValBottom,
+		OffsetValCenter,
+		OffsetValContentBox,
+		OffsetValFillBox,
+		OffsetValLeft,
+		OffsetValNone,
+		OffsetValNormal,
+		OffsetValPaddingBox,
+		OffsetValReverse,
+		OffsetValRight,
+		OffsetValStrokeBox,
+		OffsetValTop,
+		OffsetValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseOffsetVal returns the OffsetVal constant for a keyword, ignoring ASCII case.
+func ParseOffsetVal(s string) (OffsetVal, error) {
+	v := OffsetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OffsetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OffsetVal constants.
+func (v OffsetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OffsetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOffsetVal.
+func (v *OffsetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOffsetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OffsetAnchorVal represents values for the offset-anchor property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-anchor
 type OffsetAnchorVal string
 
 // OffsetAnchorVal constants.
 const (
-	OffsetAnchorValAuto             OffsetAnchorVal = "auto"
-	OffsetAnchorValBottom           OffsetAnchorVal = "bottom"
-	OffsetAnchorValCenter           OffsetAnchorVal = "center"
-	OffsetAnchorValLeft             OffsetAnchorVal = "left"
-	OffsetAnchorValLengthPercentage OffsetAnchorVal = "length-percentage"
-	OffsetAnchorValRight            OffsetAnchorVal = "right"
-	OffsetAnchorValTop              OffsetAnchorVal = "top"
-)
+	OffsetAnchorValAuto   OffsetAnchorVal = "auto"
+	OffsetAnchorValBottom OffsetAnchorVal = "bottom"
+	OffsetAnchorValCenter OffsetAnchorVal = "center"
+	OffsetAnchorValLeft   OffsetAnchorVal = "left"
+	OffsetAnchorValRight  OffsetAnchorVal = "right"
+	OffsetAnchorValTop    OffsetAnchorVal = "top"
+)
+
+func (v OffsetAnchorVal) String() string { return string(v) }
+
+// Values returns every OffsetAnchorVal constant.
+func (OffsetAnchorVal) Values() []OffsetAnchorVal {
+	return []OffsetAnchorVal{
+		OffsetAnchorValAuto,
+		OffsetAnchorValBottom,
+		OffsetAnchorValCenter,
+		OffsetAnchorValLeft,
+		OffsetAnchorValRight,
+		OffsetAnchorValTop,
+	}
+}
+
+// Valid reports whether v is one of the OffsetAnchorVal constants.
+func (v OffsetAnchorVal) Valid() bool {
+	switch v {
+	case OffsetAnchorValAuto,
+		OffsetAnchorValBottom,
+		OffsetAnchorValCenter,
+		OffsetAnchorValLeft,
+		OffsetAnchorValRight,
+		OffsetAnchorValTop:
+		return true
+	}
+	return false
+}
+
+// ParseOffsetAnchorVal returns the OffsetAnchorVal constant for a keyword, ignoring ASCII case.
+func ParseOffsetAnchorVal(s string) (OffsetAnchorVal, error) {
+	v := OffsetAnchorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OffsetAnchorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OffsetAnchorVal constants.
+func (v OffsetAnchorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OffsetAnchorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOffsetAnchorVal.
+func (v *OffsetAnchorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOffsetAnchorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OffsetPathVal represents values for the offset-path property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <offset-path> || <coord-box>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-path
 type OffsetPathVal string
 
 // OffsetPathVal constants.
 const (
-	OffsetPathValBasicShape OffsetPathVal = "basic-shape"
+	OffsetPathValBorderBox  OffsetPathVal = "border-box"
+	OffsetPathValContentBox OffsetPathVal = "content-box"
+	OffsetPathValFillBox    OffsetPathVal = "fill-box"
 	OffsetPathValNone       OffsetPathVal = "none"
-	OffsetPathValPaintBox   OffsetPathVal = "paint-box"
-	OffsetPathValRay        OffsetPathVal = "ray"
+	OffsetPathValPaddingBox OffsetPathVal = "padding-box"
+	OffsetPathValStrokeBox  OffsetPathVal = "stroke-box"
 	OffsetPathValViewBox    OffsetPathVal = "view-box"
 )
 
+func (v OffsetPathVal) String() string { return string(v) }
+
+// Values returns every OffsetPathVal constant.
+func (OffsetPathVal) Values() []OffsetPathVal {
+	return []OffsetPathVal{
+		OffsetPathValBorderBox,
+		OffsetPathValContentBox,
+		OffsetPathValFillBox,
+		OffsetPathValNone,
+		OffsetPathValPaddingBox,
+		OffsetPathValStrokeBox,
+		OffsetPathValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the OffsetPathVal constants.
+func (v OffsetPathVal) Valid() bool {
+	switch v {
+	case OffsetPathValBorderBox,
+		OffsetPathValContentBox,
+		OffsetPathValFillBox,
+		OffsetPathValNone,
+		OffsetPathValPaddingBox,
+		OffsetPathValStrokeBox,
+		OffsetPathValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseOffsetPathVal returns the OffsetPathVal constant for a keyword, ignoring ASCII case.
+func ParseOffsetPathVal(s string) (OffsetPathVal, error) {
+	v := OffsetPathVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OffsetPathVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OffsetPathVal constants.
+func (v OffsetPathVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OffsetPathVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOffsetPathVal.
+func (v *OffsetPathVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOffsetPathVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OffsetPositionVal represents values for the offset-position property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | auto | <position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-position
 type OffsetPositionVal string
 
 // OffsetPositionVal constants.
 const (
-	OffsetPositionValAuto             OffsetPositionVal = "auto"
-	OffsetPositionValBottom           OffsetPositionVal = "bottom"
-	OffsetPositionValCenter           OffsetPositionVal = "center"
-	OffsetPositionValLeft             OffsetPositionVal = "left"
-	OffsetPositionValLengthPercentage OffsetPositionVal = "length-percentage"
-	OffsetPositionValNormal           OffsetPositionVal = "normal"
-	OffsetPositionValRight            OffsetPositionVal = "right"
-	OffsetPositionValTop              OffsetPositionVal = "top"
-)
+	OffsetPositionValAuto   OffsetPositionVal = "auto"
+	OffsetPositionValBottom OffsetPositionVal = "bottom"
+	OffsetPositionValCenter OffsetPositionVal = "center"
+	OffsetPositionValLeft   OffsetPositionVal = "left"
+	OffsetPositionValNormal OffsetPositionVal = "normal"
+	OffsetPositionValRight  OffsetPositionVal = "right"
+	OffsetPositionValTop    OffsetPositionVal = "top"
+)
+
+func (v OffsetPositionVal) String() string { return string(v) }
+
+// Values returns every OffsetPositionVal constant.
+func (OffsetPositionVal) Values() []OffsetPositionVal {
+	return []OffsetPositionVal{
+		OffsetPositionValAuto,
+		OffsetPositionValBottom,
+		OffsetPositionValCenter,
+		OffsetPositionValLeft,
+		OffsetPositionValNormal,
+		OffsetPositionValRight,
+		OffsetPositionValTop,
+	}
+}
+
+// Valid reports whether v is one of the OffsetPositionVal constants.
+func (v OffsetPositionVal) Valid() bool {
+	switch v {
+	case OffsetPositionValAuto,
+		OffsetPositionValBottom,
+		OffsetPositionValCenter,
+		OffsetPositionValLeft,
+		OffsetPositionValNormal,
+		OffsetPositionValRight,
+		OffsetPositionValTop:
+		return true
+	}
+	return false
+}
+
+// ParseOffsetPositionVal returns the OffsetPositionVal constant for a keyword, ignoring ASCII case.
+func ParseOffsetPositionVal(s string) (OffsetPositionVal, error) {
+	v := OffsetPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OffsetPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OffsetPositionVal constants.
+func (v OffsetPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OffsetPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOffsetPositionVal.
+func (v *OffsetPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOffsetPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OffsetRotateVal represents values for the offset-rotate property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | reverse ] || <angle>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-rotate
 type OffsetRotateVal string
 
 // OffsetRotateVal constants.
 const (
-	OffsetRotateValAngle   OffsetRotateVal = "angle"
 	OffsetRotateValAuto    OffsetRotateVal = "auto"
 	OffsetRotateValReverse OffsetRotateVal = "reverse"
 )
 
-// OrderVal represents values for the order property.
-type OrderVal string
-
-// OrderVal constants.
-const (
-	OrderValNumberToken OrderVal = "number-token"
-)
-
-// OrphansVal represents values for the orphans property.
-type OrphansVal string
-
-// OrphansVal constants.
-const (
-	OrphansValNumberToken OrphansVal = "number-token"
-)
+func (v OffsetRotateVal) String() string { return string(v) }
+
+// Values returns every OffsetRotateVal constant.
+func (OffsetRotateVal) Values() []OffsetRotateVal {
+	return []OffsetRotateVal{
+		OffsetRotateValAuto,
+		OffsetRotateValReverse,
+	}
+}
+
+// Valid reports whether v is one of the OffsetRotateVal constants.
+func (v OffsetRotateVal) Valid() bool {
+	switch v {
+	case OffsetRotateValAuto,
+		OffsetRotateValReverse:
+		return true
+	}
+	return false
+}
+
+// ParseOffsetRotateVal returns the OffsetRotateVal constant for a keyword, ignoring ASCII case.
+func ParseOffsetRotateVal(s string) (OffsetRotateVal, error) {
+	v := OffsetRotateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OffsetRotateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OffsetRotateVal constants.
+func (v OffsetRotateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OffsetRotateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOffsetRotateVal.
+func (v *OffsetRotateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOffsetRotateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OutlineVal represents values for the outline property.
+//
+//   - Shorthand for: outline-color, outline-style, outline-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'outline-width'> || <'outline-style'> || <'outline-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline
 type OutlineVal string
 
 // OutlineVal constants.
 const (
-	OutlineValOutlineColor OutlineVal = "outline-color"
-	OutlineValOutlineStyle OutlineVal = "outline-style"
-	OutlineValOutlineWidth OutlineVal = "outline-width"
-)
+	OutlineValAuto   OutlineVal = "auto"
+	OutlineValDashed OutlineVal = "dashed"
+	OutlineValDotted OutlineVal = "dotted"
+	OutlineValDouble OutlineVal = "double"
+	OutlineValGroove OutlineVal = "groove"
+	OutlineValInset  OutlineVal = "inset"
+	OutlineValMedium OutlineVal = "medium"
+	OutlineValNone   OutlineVal = "none"
+	OutlineValOutset OutlineVal = "outset"
+	OutlineValRidge  OutlineVal = "ridge"
+	OutlineValSolid  OutlineVal = "solid"
+	OutlineValThick  OutlineVal = "thick"
+	OutlineValThin   OutlineVal = "thin"
+)
+
+func (v OutlineVal) String() string { return string(v) }
+
+// Values returns every OutlineVal constant.
+func (OutlineVal) Values() []OutlineVal {
+	return []OutlineVal{
+		OutlineValAuto,
+		OutlineValDashed,
+		OutlineValDotted,
+		OutlineValDouble,
+		OutlineValGroove,
+		OutlineValInset,
+		OutlineValMedium,
+		OutlineValNone,
+		OutlineValOutset,
+		OutlineValRidge,
+		OutlineValSolid,
+		OutlineValThick,
+		OutlineValThin,
+	}
+}
+
+// Valid reports whether v is one of the OutlineVal constants.
+func (v OutlineVal) Valid() bool {
+	switch v {
+	case OutlineValAuto,
+		OutlineValDashed,
+		OutlineValDotted,
+		OutlineValDouble,
+		OutlineValGroove,
+		OutlineValInset,
+		OutlineValMedium,
+		OutlineValNone,
+		OutlineValOutset,
+		OutlineValRidge,
+		OutlineValSolid,
+		OutlineValThick,
+		OutlineValThin:
+		return true
+	}
+	return false
+}
+
+// ParseOutlineVal returns the OutlineVal constant for a keyword, ignoring ASCII case.
+func ParseOutlineVal(s string) (OutlineVal, error) {
+	v := OutlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OutlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OutlineVal constants.
+func (v OutlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OutlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOutlineVal.
+func (v *OutlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOutlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OutlineColorVal represents values for the outline-color property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-color
 type OutlineColorVal string
 
 // OutlineColorVal constants.
 const (
-	OutlineColorValAuto                  OutlineColorVal = "auto"
-	OutlineColorValColorBase             OutlineColorVal = "color-base"
-	OutlineColorValDeprecatedSystemColor OutlineColorVal = "deprecated-system-color"
-	OutlineColorValLightDark             OutlineColorVal = "light-dark"
-	OutlineColorValSystemColor           OutlineColorVal = "system-color"
-)
+	OutlineColorValAuto OutlineColorVal = "auto"
+)
+
+func (v OutlineColorVal) String() string { return string(v) }
+
+// Values returns every OutlineColorVal constant.
+func (OutlineColorVal) Values() []OutlineColorVal {
+	return []OutlineColorVal{
+		OutlineColorValAuto,
+	}
+}
+
+// Valid reports whether v is one of the OutlineColorVal constants.
+func (v OutlineColorVal) Valid() bool {
+	switch v {
+	case OutlineColorValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseOutlineColorVal returns the OutlineColorVal constant for a keyword, ignoring ASCII case.
+func ParseOutlineColorVal(s string) (OutlineColorVal, error) {
+	v := OutlineColorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OutlineColorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OutlineColorVal constants.
+func (v OutlineColorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OutlineColorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOutlineColorVal.
+func (v *OutlineColorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOutlineColorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // OutlineStyleVal represents values for the outline-style property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <outline-line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-style
 type OutlineStyleVal string
 
 // OutlineStyleVal constants.
@@ -3510,7 +19871,81 @@ const (
 	OutlineStyleValSolid  OutlineStyleVal = "solid"
 )
 
+func (v OutlineStyleVal) String() string { return string(v) }
+
+// Values returns every OutlineStyleVal constant.
+func (OutlineStyleVal) Values() []OutlineStyleVal {
+	return []OutlineStyleVal{
+		OutlineStyleValAuto,
+		OutlineStyleValDashed,
+		OutlineStyleValDotted,
+		OutlineStyleValDouble,
+		OutlineStyleValGroove,
+		OutlineStyleValInset,
+		OutlineStyleValNone,
+		OutlineStyleValOutset,
+		OutlineStyleValRidge,
+		OutlineStyleValSolid,
+	}
+}
+
+// Valid reports whether v is one of the OutlineStyleVal constants.
+func (v OutlineStyleVal) Valid() bool {
+	switch v {
+	case OutlineStyleValAuto,
+		OutlineStyleValDashed,
+		OutlineStyleValDotted,
+		OutlineStyleValDouble,
+		OutlineStyleValGroove,
+		OutlineStyleValInset,
+		OutlineStyleValNone,
+		OutlineStyleValOutset,
+		OutlineStyleValRidge,
+		OutlineStyleValSolid:
+		return true
+	}
+	return false
+}
+
+// ParseOutlineStyleVal returns the OutlineStyleVal constant for a keyword, ignoring ASCII case.
+func ParseOutlineStyleVal(s string) (OutlineStyleVal, error) {
+	v := OutlineStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OutlineStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OutlineStyleVal constants.
+func (v OutlineStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OutlineStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOutlineStyleVal.
+func (v *OutlineStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOutlineStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OutlineWidthVal represents values for the outline-width property.
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-width
 type OutlineWidthVal string
 
 // OutlineWidthVal constants.
@@ -3520,13 +19955,71 @@ const (
 	OutlineWidthValThin   OutlineWidthVal = "thin"
 )
 
+func (v OutlineWidthVal) String() string { return string(v) }
+
+// Values returns every OutlineWidthVal constant.
+func (OutlineWidthVal) Values() []OutlineWidthVal {
+	return []OutlineWidthVal{
+		OutlineWidthValMedium,
+		OutlineWidthValThick,
+		OutlineWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the OutlineWidthVal constants.
+func (v OutlineWidthVal) Valid() bool {
+	switch v {
+	case OutlineWidthValMedium,
+		OutlineWidthValThick,
+		OutlineWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseOutlineWidthVal returns the OutlineWidthVal constant for a keyword, ignoring ASCII case.
+func ParseOutlineWidthVal(s string) (OutlineWidthVal, error) {
+	v := OutlineWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OutlineWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OutlineWidthVal constants.
+func (v OutlineWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OutlineWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOutlineWidthVal.
+func (v *OutlineWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOutlineWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowVal represents values for the overflow property.
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ visible | hidden | clip | scroll | auto ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow
 type OverflowVal string
 
 // OverflowVal constants.
 const (
-	OverflowVal1       OverflowVal = "1"
-	OverflowVal2       OverflowVal = "2"
 	OverflowValAuto    OverflowVal = "auto"
 	OverflowValClip    OverflowVal = "clip"
 	OverflowValHidden  OverflowVal = "hidden"
@@ -3534,7 +20027,71 @@ const (
 	OverflowValVisible OverflowVal = "visible"
 )
 
+func (v OverflowVal) String() string { return string(v) }
+
+// Values returns every OverflowVal constant.
+func (OverflowVal) Values() []OverflowVal {
+	return []OverflowVal{
+		OverflowValAuto,
+		OverflowValClip,
+		OverflowValHidden,
+		OverflowValScroll,
+		OverflowValVisible,
+	}
+}
+
+// Valid reports whether v is one of the OverflowVal constants.
+func (v OverflowVal) Valid() bool {
+	switch v {
+	case OverflowValAuto,
+		OverflowValClip,
+		OverflowValHidden,
+		OverflowValScroll,
+		OverflowValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowVal returns the OverflowVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowVal(s string) (OverflowVal, error) {
+	v := OverflowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowVal constants.
+func (v OverflowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowVal.
+func (v *OverflowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowAnchorVal represents values for the overflow-anchor property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-anchor
 type OverflowAnchorVal string
 
 // OverflowAnchorVal constants.
@@ -3543,7 +20100,65 @@ const (
 	OverflowAnchorValNone OverflowAnchorVal = "none"
 )
 
+func (v OverflowAnchorVal) String() string { return string(v) }
+
+// Values returns every OverflowAnchorVal constant.
+func (OverflowAnchorVal) Values() []OverflowAnchorVal {
+	return []OverflowAnchorVal{
+		OverflowAnchorValAuto,
+		OverflowAnchorValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverflowAnchorVal constants.
+func (v OverflowAnchorVal) Valid() bool {
+	switch v {
+	case OverflowAnchorValAuto,
+		OverflowAnchorValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowAnchorVal returns the OverflowAnchorVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowAnchorVal(s string) (OverflowAnchorVal, error) {
+	v := OverflowAnchorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowAnchorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowAnchorVal constants.
+func (v OverflowAnchorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowAnchorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowAnchorVal.
+func (v *OverflowAnchorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowAnchorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowBlockVal represents values for the overflow-block property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-block
 type OverflowBlockVal string
 
 // OverflowBlockVal constants.
@@ -3555,7 +20170,69 @@ const (
 	OverflowBlockValVisible OverflowBlockVal = "visible"
 )
 
+func (v OverflowBlockVal) String() string { return string(v) }
+
+// Values returns every OverflowBlockVal constant.
+func (OverflowBlockVal) Values() []OverflowBlockVal {
+	return []OverflowBlockVal{
+		OverflowBlockValAuto,
+		OverflowBlockValClip,
+		OverflowBlockValHidden,
+		OverflowBlockValScroll,
+		OverflowBlockValVisible,
+	}
+}
+
+// Valid reports whether v is one of the OverflowBlockVal constants.
+func (v OverflowBlockVal) Valid() bool {
+	switch v {
+	case OverflowBlockValAuto,
+		OverflowBlockValClip,
+		OverflowBlockValHidden,
+		OverflowBlockValScroll,
+		OverflowBlockValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowBlockVal returns the OverflowBlockVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowBlockVal(s string) (OverflowBlockVal, error) {
+	v := OverflowBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowBlockVal constants.
+func (v OverflowBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowBlockVal.
+func (v *OverflowBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowClipBoxVal represents values for the overflow-clip-box property.
+//
+//   - Initial: padding-box
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	padding-box | content-box
 type OverflowClipBoxVal string
 
 // OverflowClipBoxVal constants.
@@ -3564,18 +20241,135 @@ const (
 	OverflowClipBoxValPaddingBox OverflowClipBoxVal = "padding-box"
 )
 
+func (v OverflowClipBoxVal) String() string { return string(v) }
+
+// Values returns every OverflowClipBoxVal constant.
+func (OverflowClipBoxVal) Values() []OverflowClipBoxVal {
+	return []OverflowClipBoxVal{
+		OverflowClipBoxValContentBox,
+		OverflowClipBoxValPaddingBox,
+	}
+}
+
+// Valid reports whether v is one of the OverflowClipBoxVal constants.
+func (v OverflowClipBoxVal) Valid() bool {
+	switch v {
+	case OverflowClipBoxValContentBox,
+		OverflowClipBoxValPaddingBox:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowClipBoxVal returns the OverflowClipBoxVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowClipBoxVal(s string) (OverflowClipBoxVal, error) {
+	v := OverflowClipBoxVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowClipBoxVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowClipBoxVal constants.
+func (v OverflowClipBoxVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowClipBoxVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowClipBoxVal.
+func (v *OverflowClipBoxVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowClipBoxVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowClipMarginVal represents values for the overflow-clip-margin property.
+//
+//   - Initial: 0px
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<visual-box> || <length [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-clip-margin
 type OverflowClipMarginVal string
 
 // OverflowClipMarginVal constants.
 const (
-	OverflowClipMarginVal0          OverflowClipMarginVal = "0"
 	OverflowClipMarginValBorderBox  OverflowClipMarginVal = "border-box"
 	OverflowClipMarginValContentBox OverflowClipMarginVal = "content-box"
 	OverflowClipMarginValPaddingBox OverflowClipMarginVal = "padding-box"
 )
 
+func (v OverflowClipMarginVal) String() string { return string(v) }
+
+// Values returns every OverflowClipMarginVal constant.
+func (OverflowClipMarginVal) Values() []OverflowClipMarginVal {
+	return []OverflowClipMarginVal{
+		OverflowClipMarginValBorderBox,
+		OverflowClipMarginValContentBox,
+		OverflowClipMarginValPaddingBox,
+	}
+}
+
+// Valid reports whether v is one of the OverflowClipMarginVal constants.
+func (v OverflowClipMarginVal) Valid() bool {
+	switch v {
+	case OverflowClipMarginValBorderBox,
+		OverflowClipMarginValContentBox,
+		OverflowClipMarginValPaddingBox:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowClipMarginVal returns the OverflowClipMarginVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowClipMarginVal(s string) (OverflowClipMarginVal, error) {
+	v := OverflowClipMarginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowClipMarginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowClipMarginVal constants.
+func (v OverflowClipMarginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowClipMarginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowClipMarginVal.
+func (v *OverflowClipMarginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowClipMarginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowInlineVal represents values for the overflow-inline property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-inline
 type OverflowInlineVal string
 
 // OverflowInlineVal constants.
@@ -3587,7 +20381,71 @@ const (
 	OverflowInlineValVisible OverflowInlineVal = "visible"
 )
 
+func (v OverflowInlineVal) String() string { return string(v) }
+
+// Values returns every OverflowInlineVal constant.
+func (OverflowInlineVal) Values() []OverflowInlineVal {
+	return []OverflowInlineVal{
+		OverflowInlineValAuto,
+		OverflowInlineValClip,
+		OverflowInlineValHidden,
+		OverflowInlineValScroll,
+		OverflowInlineValVisible,
+	}
+}
+
+// Valid reports whether v is one of the OverflowInlineVal constants.
+func (v OverflowInlineVal) Valid() bool {
+	switch v {
+	case OverflowInlineValAuto,
+		OverflowInlineValClip,
+		OverflowInlineValHidden,
+		OverflowInlineValScroll,
+		OverflowInlineValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowInlineVal returns the OverflowInlineVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowInlineVal(s string) (OverflowInlineVal, error) {
+	v := OverflowInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowInlineVal constants.
+func (v OverflowInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowInlineVal.
+func (v *OverflowInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowWrapVal represents values for the overflow-wrap property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-word | anywhere
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
 type OverflowWrapVal string
 
 // OverflowWrapVal constants.
@@ -3597,7 +20455,67 @@ const (
 	OverflowWrapValNormal    OverflowWrapVal = "normal"
 )
 
+func (v OverflowWrapVal) String() string { return string(v) }
+
+// Values returns every OverflowWrapVal constant.
+func (OverflowWrapVal) Values() []OverflowWrapVal {
+	return []OverflowWrapVal{
+		OverflowWrapValAnywhere,
+		OverflowWrapValBreakWord,
+		OverflowWrapValNormal,
+	}
+}
+
+// Valid reports whether v is one of the OverflowWrapVal constants.
+func (v OverflowWrapVal) Valid() bool {
+	switch v {
+	case OverflowWrapValAnywhere,
+		OverflowWrapValBreakWord,
+		OverflowWrapValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowWrapVal returns the OverflowWrapVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowWrapVal(s string) (OverflowWrapVal, error) {
+	v := OverflowWrapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowWrapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowWrapVal constants.
+func (v OverflowWrapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowWrapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowWrapVal.
+func (v *OverflowWrapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowWrapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowXVal represents values for the overflow-x property.
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-x
 type OverflowXVal string
 
 // OverflowXVal constants.
@@ -3609,7 +20527,71 @@ const (
 	OverflowXValVisible OverflowXVal = "visible"
 )
 
+func (v OverflowXVal) String() string { return string(v) }
+
+// Values returns every OverflowXVal constant.
+func (OverflowXVal) Values() []OverflowXVal {
+	return []OverflowXVal{
+		OverflowXValAuto,
+		OverflowXValClip,
+		OverflowXValHidden,
+		OverflowXValScroll,
+		OverflowXValVisible,
+	}
+}
+
+// Valid reports whether v is one of the OverflowXVal constants.
+func (v OverflowXVal) Valid() bool {
+	switch v {
+	case OverflowXValAuto,
+		OverflowXValClip,
+		OverflowXValHidden,
+		OverflowXValScroll,
+		OverflowXValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowXVal returns the OverflowXVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowXVal(s string) (OverflowXVal, error) {
+	v := OverflowXVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowXVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowXVal constants.
+func (v OverflowXVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowXVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowXVal.
+func (v *OverflowXVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowXVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverflowYVal represents values for the overflow-y property.
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-y
 type OverflowYVal string
 
 // OverflowYVal constants.
@@ -3621,19 +20603,141 @@ const (
 	OverflowYValVisible OverflowYVal = "visible"
 )
 
+func (v OverflowYVal) String() string { return string(v) }
+
+// Values returns every OverflowYVal constant.
+func (OverflowYVal) Values() []OverflowYVal {
+	return []OverflowYVal{
+		OverflowYValAuto,
+		OverflowYValClip,
+		OverflowYValHidden,
+		OverflowYValScroll,
+		OverflowYValVisible,
+	}
+}
+
+// Valid reports whether v is one of the OverflowYVal constants.
+func (v OverflowYVal) Valid() bool {
+	switch v {
+	case OverflowYValAuto,
+		OverflowYValClip,
+		OverflowYValHidden,
+		OverflowYValScroll,
+		OverflowYValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseOverflowYVal returns the OverflowYVal constant for a keyword, ignoring ASCII case.
+func ParseOverflowYVal(s string) (OverflowYVal, error) {
+	v := OverflowYVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverflowYVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverflowYVal constants.
+func (v OverflowYVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverflowYVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverflowYVal.
+func (v *OverflowYVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverflowYVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverscrollBehaviorVal represents values for the overscroll-behavior property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ contain | none | auto ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior
 type OverscrollBehaviorVal string
 
 // OverscrollBehaviorVal constants.
 const (
-	OverscrollBehaviorVal1       OverscrollBehaviorVal = "1"
-	OverscrollBehaviorVal2       OverscrollBehaviorVal = "2"
 	OverscrollBehaviorValAuto    OverscrollBehaviorVal = "auto"
 	OverscrollBehaviorValContain OverscrollBehaviorVal = "contain"
 	OverscrollBehaviorValNone    OverscrollBehaviorVal = "none"
 )
 
+func (v OverscrollBehaviorVal) String() string { return string(v) }
+
+// Values returns every OverscrollBehaviorVal constant.
+func (OverscrollBehaviorVal) Values() []OverscrollBehaviorVal {
+	return []OverscrollBehaviorVal{
+		OverscrollBehaviorValAuto,
+		OverscrollBehaviorValContain,
+		OverscrollBehaviorValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverscrollBehaviorVal constants.
+func (v OverscrollBehaviorVal) Valid() bool {
+	switch v {
+	case OverscrollBehaviorValAuto,
+		OverscrollBehaviorValContain,
+		OverscrollBehaviorValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverscrollBehaviorVal returns the OverscrollBehaviorVal constant for a keyword, ignoring ASCII case.
+func ParseOverscrollBehaviorVal(s string) (OverscrollBehaviorVal, error) {
+	v := OverscrollBehaviorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverscrollBehaviorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverscrollBehaviorVal constants.
+func (v OverscrollBehaviorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverscrollBehaviorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverscrollBehaviorVal.
+func (v *OverscrollBehaviorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverscrollBehaviorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverscrollBehaviorBlockVal represents values for the overscroll-behavior-block property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-block
 type OverscrollBehaviorBlockVal string
 
 // OverscrollBehaviorBlockVal constants.
@@ -3643,7 +20747,67 @@ const (
 	OverscrollBehaviorBlockValNone    OverscrollBehaviorBlockVal = "none"
 )
 
+func (v OverscrollBehaviorBlockVal) String() string { return string(v) }
+
+// Values returns every OverscrollBehaviorBlockVal constant.
+func (OverscrollBehaviorBlockVal) Values() []OverscrollBehaviorBlockVal {
+	return []OverscrollBehaviorBlockVal{
+		OverscrollBehaviorBlockValAuto,
+		OverscrollBehaviorBlockValContain,
+		OverscrollBehaviorBlockValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverscrollBehaviorBlockVal constants.
+func (v OverscrollBehaviorBlockVal) Valid() bool {
+	switch v {
+	case OverscrollBehaviorBlockValAuto,
+		OverscrollBehaviorBlockValContain,
+		OverscrollBehaviorBlockValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverscrollBehaviorBlockVal returns the OverscrollBehaviorBlockVal constant for a keyword, ignoring ASCII case.
+func ParseOverscrollBehaviorBlockVal(s string) (OverscrollBehaviorBlockVal, error) {
+	v := OverscrollBehaviorBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverscrollBehaviorBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverscrollBehaviorBlockVal constants.
+func (v OverscrollBehaviorBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverscrollBehaviorBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverscrollBehaviorBlockVal.
+func (v *OverscrollBehaviorBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverscrollBehaviorBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverscrollBehaviorInlineVal represents values for the overscroll-behavior-inline property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-inline
 type OverscrollBehaviorInlineVal string
 
 // OverscrollBehaviorInlineVal constants.
@@ -3653,7 +20817,67 @@ const (
 	OverscrollBehaviorInlineValNone    OverscrollBehaviorInlineVal = "none"
 )
 
+func (v OverscrollBehaviorInlineVal) String() string { return string(v) }
+
+// Values returns every OverscrollBehaviorInlineVal constant.
+func (OverscrollBehaviorInlineVal) Values() []OverscrollBehaviorInlineVal {
+	return []OverscrollBehaviorInlineVal{
+		OverscrollBehaviorInlineValAuto,
+		OverscrollBehaviorInlineValContain,
+		OverscrollBehaviorInlineValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverscrollBehaviorInlineVal constants.
+func (v OverscrollBehaviorInlineVal) Valid() bool {
+	switch v {
+	case OverscrollBehaviorInlineValAuto,
+		OverscrollBehaviorInlineValContain,
+		OverscrollBehaviorInlineValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverscrollBehaviorInlineVal returns the OverscrollBehaviorInlineVal constant for a keyword, ignoring ASCII case.
+func ParseOverscrollBehaviorInlineVal(s string) (OverscrollBehaviorInlineVal, error) {
+	v := OverscrollBehaviorInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverscrollBehaviorInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverscrollBehaviorInlineVal constants.
+func (v OverscrollBehaviorInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverscrollBehaviorInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverscrollBehaviorInlineVal.
+func (v *OverscrollBehaviorInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverscrollBehaviorInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverscrollBehaviorXVal represents values for the overscroll-behavior-x property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-x
 type OverscrollBehaviorXVal string
 
 // OverscrollBehaviorXVal constants.
@@ -3663,7 +20887,67 @@ const (
 	OverscrollBehaviorXValNone    OverscrollBehaviorXVal = "none"
 )
 
+func (v OverscrollBehaviorXVal) String() string { return string(v) }
+
+// Values returns every OverscrollBehaviorXVal constant.
+func (OverscrollBehaviorXVal) Values() []OverscrollBehaviorXVal {
+	return []OverscrollBehaviorXVal{
+		OverscrollBehaviorXValAuto,
+		OverscrollBehaviorXValContain,
+		OverscrollBehaviorXValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverscrollBehaviorXVal constants.
+func (v OverscrollBehaviorXVal) Valid() bool {
+	switch v {
+	case OverscrollBehaviorXValAuto,
+		OverscrollBehaviorXValContain,
+		OverscrollBehaviorXValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverscrollBehaviorXVal returns the OverscrollBehaviorXVal constant for a keyword, ignoring ASCII case.
+func ParseOverscrollBehaviorXVal(s string) (OverscrollBehaviorXVal, error) {
+	v := OverscrollBehaviorXVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverscrollBehaviorXVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverscrollBehaviorXVal constants.
+func (v OverscrollBehaviorXVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverscrollBehaviorXVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverscrollBehaviorXVal.
+func (v *OverscrollBehaviorXVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverscrollBehaviorXVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // OverscrollBehaviorYVal represents values for the overscroll-behavior-y property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-y
 type OverscrollBehaviorYVal string
 
 // OverscrollBehaviorYVal constants.
@@ -3673,114 +20957,133 @@ const (
 	OverscrollBehaviorYValNone    OverscrollBehaviorYVal = "none"
 )
 
-// PaddingVal represents values for the padding property.
-type PaddingVal string
-
-// PaddingVal constants.
-const (
-	PaddingVal1          PaddingVal = "1"
-	PaddingVal4          PaddingVal = "4"
-	PaddingValPaddingTop PaddingVal = "padding-top"
-)
-
-// PaddingBlockVal represents values for the padding-block property.
-type PaddingBlockVal string
-
-// PaddingBlockVal constants.
-const (
-	PaddingBlockVal1          PaddingBlockVal = "1"
-	PaddingBlockVal2          PaddingBlockVal = "2"
-	PaddingBlockValPaddingTop PaddingBlockVal = "padding-top"
-)
-
-// PaddingBlockEndVal represents values for the padding-block-end property.
-type PaddingBlockEndVal string
-
-// PaddingBlockEndVal constants.
-const (
-	PaddingBlockEndValPaddingTop PaddingBlockEndVal = "padding-top"
-)
-
-// PaddingBlockStartVal represents values for the padding-block-start property.
-type PaddingBlockStartVal string
-
-// PaddingBlockStartVal constants.
-const (
-	PaddingBlockStartValPaddingTop PaddingBlockStartVal = "padding-top"
-)
-
-// PaddingBottomVal represents values for the padding-bottom property.
-type PaddingBottomVal string
-
-// PaddingBottomVal constants.
-const (
-	PaddingBottomVal0                PaddingBottomVal = "0"
-	PaddingBottomValLengthPercentage PaddingBottomVal = "length-percentage"
-)
-
-// PaddingInlineVal represents values for the padding-inline property.
-type PaddingInlineVal string
-
-// PaddingInlineVal constants.
-const (
-	PaddingInlineVal1          PaddingInlineVal = "1"
-	PaddingInlineVal2          PaddingInlineVal = "2"
-	PaddingInlineValPaddingTop PaddingInlineVal = "padding-top"
-)
-
-// PaddingInlineEndVal represents values for the padding-inline-end property.
-type PaddingInlineEndVal string
-
-// PaddingInlineEndVal constants.
-const (
-	PaddingInlineEndValPaddingTop PaddingInlineEndVal = "padding-top"
-)
-
-// PaddingInlineStartVal represents values for the padding-inline-start property.
-type PaddingInlineStartVal string
-
-// PaddingInlineStartVal constants.
-const (
-	PaddingInlineStartValPaddingTop PaddingInlineStartVal = "padding-top"
-)
-
-// PaddingLeftVal represents values for the padding-left property.
-type PaddingLeftVal string
-
-// PaddingLeftVal constants.
-const (
-	PaddingLeftVal0                PaddingLeftVal = "0"
-	PaddingLeftValLengthPercentage PaddingLeftVal = "length-percentage"
-)
-
-// PaddingRightVal represents values for the padding-right property.
-type PaddingRightVal string
-
-// PaddingRightVal constants.
-const (
-	PaddingRightVal0                PaddingRightVal = "0"
-	PaddingRightValLengthPercentage PaddingRightVal = "length-percentage"
-)
-
-// PaddingTopVal represents values for the padding-top property.
-type PaddingTopVal string
-
-// PaddingTopVal constants.
-const (
-	PaddingTopVal0                PaddingTopVal = "0"
-	PaddingTopValLengthPercentage PaddingTopVal = "length-percentage"
-)
+func (v OverscrollBehaviorYVal) String() string { return string(v) }
+
+// Values returns every OverscrollBehaviorYVal constant.
+func (OverscrollBehaviorYVal) Values() []OverscrollBehaviorYVal {
+	return []OverscrollBehaviorYVal{
+		OverscrollBehaviorYValAuto,
+		OverscrollBehaviorYValContain,
+		OverscrollBehaviorYValNone,
+	}
+}
+
+// Valid reports whether v is one of the OverscrollBehaviorYVal constants.
+func (v OverscrollBehaviorYVal) Valid() bool {
+	switch v {
+	case OverscrollBehaviorYValAuto,
+		OverscrollBehaviorYValContain,
+		OverscrollBehaviorYValNone:
+		return true
+	}
+	return false
+}
+
+// ParseOverscrollBehaviorYVal returns the OverscrollBehaviorYVal constant for a keyword, ignoring ASCII case.
+func ParseOverscrollBehaviorYVal(s string) (OverscrollBehaviorYVal, error) {
+	v := OverscrollBehaviorYVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "OverscrollBehaviorYVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not OverscrollBehaviorYVal constants.
+func (v OverscrollBehaviorYVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "OverscrollBehaviorYVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseOverscrollBehaviorYVal.
+func (v *OverscrollBehaviorYVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseOverscrollBehaviorYVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PageVal represents values for the page property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <custom-ident>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page
 type PageVal string
 
 // PageVal constants.
 const (
-	PageValAuto        PageVal = "auto"
-	PageValCustomIdent PageVal = "custom-ident"
-)
+	PageValAuto PageVal = "auto"
+)
+
+func (v PageVal) String() string { return string(v) }
+
+// Values returns every PageVal constant.
+func (PageVal) Values() []PageVal {
+	return []PageVal{
+		PageValAuto,
+	}
+}
+
+// Valid reports whether v is one of the PageVal constants.
+func (v PageVal) Valid() bool {
+	switch v {
+	case PageValAuto:
+		return true
+	}
+	return false
+}
+
+// ParsePageVal returns the PageVal constant for a keyword, ignoring ASCII case.
+func ParsePageVal(s string) (PageVal, error) {
+	v := PageVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageVal constants.
+func (v PageVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageVal.
+func (v *PageVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PageBreakAfterVal represents values for the page-break-after property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | always | avoid | left | right | recto | verso
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-after
+//
+// Deprecated: the page-break-after property is obsolete.
 type PageBreakAfterVal string
 
 // PageBreakAfterVal constants.
@@ -3794,7 +21097,77 @@ const (
 	PageBreakAfterValVerso  PageBreakAfterVal = "verso"
 )
 
+func (v PageBreakAfterVal) String() string { return string(v) }
+
+// Values returns every PageBreakAfterVal constant.
+func (PageBreakAfterVal) Values() []PageBreakAfterVal {
+	return []PageBreakAfterVal{
+		PageBreakAfterValAlways,
+		PageBreakAfterValAuto,
+		PageBreakAfterValAvoid,
+		PageBreakAfterValLeft,
+		PageBreakAfterValRecto,
+		PageBreakAfterValRight,
+		PageBreakAfterValVerso,
+	}
+}
+
+// Valid reports whether v is one of the PageBreakAfterVal constants.
+func (v PageBreakAfterVal) Valid() bool {
+	switch v {
+	case PageBreakAfterValAlways,
+		PageBreakAfterValAuto,
+		PageBreakAfterValAvoid,
+		PageBreakAfterValLeft,
+		PageBreakAfterValRecto,
+		PageBreakAfterValRight,
+		PageBreakAfterValVerso:
+		return true
+	}
+	return false
+}
+
+// ParsePageBreakAfterVal returns the PageBreakAfterVal constant for a keyword, ignoring ASCII case.
+func ParsePageBreakAfterVal(s string) (PageBreakAfterVal, error) {
+	v := PageBreakAfterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageBreakAfterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageBreakAfterVal constants.
+func (v PageBreakAfterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageBreakAfterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageBreakAfterVal.
+func (v *PageBreakAfterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageBreakAfterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PageBreakBeforeVal represents values for the page-break-before property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | always | avoid | left | right | recto | verso
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-before
+//
+// Deprecated: the page-break-before property is obsolete.
 type PageBreakBeforeVal string
 
 // PageBreakBeforeVal constants.
@@ -3808,7 +21181,77 @@ const (
 	PageBreakBeforeValVerso  PageBreakBeforeVal = "verso"
 )
 
+func (v PageBreakBeforeVal) String() string { return string(v) }
+
+// Values returns every PageBreakBeforeVal constant.
+func (PageBreakBeforeVal) Values() []PageBreakBeforeVal {
+	return []PageBreakBeforeVal{
+		PageBreakBeforeValAlways,
+		PageBreakBeforeValAuto,
+		PageBreakBeforeValAvoid,
+		PageBreakBeforeValLeft,
+		PageBreakBeforeValRecto,
+		PageBreakBeforeValRight,
+		PageBreakBeforeValVerso,
+	}
+}
+
+// Valid reports whether v is one of the PageBreakBeforeVal constants.
+func (v PageBreakBeforeVal) Valid() bool {
+	switch v {
+	case PageBreakBeforeValAlways,
+		PageBreakBeforeValAuto,
+		PageBreakBeforeValAvoid,
+		PageBreakBeforeValLeft,
+		PageBreakBeforeValRecto,
+		PageBreakBeforeValRight,
+		PageBreakBeforeValVerso:
+		return true
+	}
+	return false
+}
+
+// ParsePageBreakBeforeVal returns the PageBreakBeforeVal constant for a keyword, ignoring ASCII case.
+func ParsePageBreakBeforeVal(s string) (PageBreakBeforeVal, error) {
+	v := PageBreakBeforeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageBreakBeforeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageBreakBeforeVal constants.
+func (v PageBreakBeforeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageBreakBeforeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageBreakBeforeVal.
+func (v *PageBreakBeforeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageBreakBeforeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PageBreakInsideVal represents values for the page-break-inside property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | avoid
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-inside
+//
+// Deprecated: the page-break-inside property is obsolete.
 type PageBreakInsideVal string
 
 // PageBreakInsideVal constants.
@@ -3817,7 +21260,65 @@ const (
 	PageBreakInsideValAvoid PageBreakInsideVal = "avoid"
 )
 
+func (v PageBreakInsideVal) String() string { return string(v) }
+
+// Values returns every PageBreakInsideVal constant.
+func (PageBreakInsideVal) Values() []PageBreakInsideVal {
+	return []PageBreakInsideVal{
+		PageBreakInsideValAuto,
+		PageBreakInsideValAvoid,
+	}
+}
+
+// Valid reports whether v is one of the PageBreakInsideVal constants.
+func (v PageBreakInsideVal) Valid() bool {
+	switch v {
+	case PageBreakInsideValAuto,
+		PageBreakInsideValAvoid:
+		return true
+	}
+	return false
+}
+
+// ParsePageBreakInsideVal returns the PageBreakInsideVal constant for a keyword, ignoring ASCII case.
+func ParsePageBreakInsideVal(s string) (PageBreakInsideVal, error) {
+	v := PageBreakInsideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PageBreakInsideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PageBreakInsideVal constants.
+func (v PageBreakInsideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PageBreakInsideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePageBreakInsideVal.
+func (v *PageBreakInsideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePageBreakInsideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PaintOrderVal represents values for the paint-order property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ fill || stroke || markers ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/paint-order
 type PaintOrderVal string
 
 // PaintOrderVal constants.
@@ -3828,7 +21329,69 @@ const (
 	PaintOrderValStroke  PaintOrderVal = "stroke"
 )
 
+func (v PaintOrderVal) String() string { return string(v) }
+
+// Values returns every PaintOrderVal constant.
+func (PaintOrderVal) Values() []PaintOrderVal {
+	return []PaintOrderVal{
+		PaintOrderValFill,
+		PaintOrderValMarkers,
+		PaintOrderValNormal,
+		PaintOrderValStroke,
+	}
+}
+
+// Valid reports whether v is one of the PaintOrderVal constants.
+func (v PaintOrderVal) Valid() bool {
+	switch v {
+	case PaintOrderValFill,
+		PaintOrderValMarkers,
+		PaintOrderValNormal,
+		PaintOrderValStroke:
+		return true
+	}
+	return false
+}
+
+// ParsePaintOrderVal returns the PaintOrderVal constant for a keyword, ignoring ASCII case.
+func ParsePaintOrderVal(s string) (PaintOrderVal, error) {
+	v := PaintOrderVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PaintOrderVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PaintOrderVal constants.
+func (v PaintOrderVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PaintOrderVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePaintOrderVal.
+func (v *PaintOrderVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePaintOrderVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PerspectiveVal represents values for the perspective property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective
 type PerspectiveVal string
 
 // PerspectiveVal constants.
@@ -3836,60 +21399,572 @@ const (
 	PerspectiveValNone PerspectiveVal = "none"
 )
 
+func (v PerspectiveVal) String() string { return string(v) }
+
+// Values returns every PerspectiveVal constant.
+func (PerspectiveVal) Values() []PerspectiveVal {
+	return []PerspectiveVal{
+		PerspectiveValNone,
+	}
+}
+
+// Valid reports whether v is one of the PerspectiveVal constants.
+func (v PerspectiveVal) Valid() bool {
+	switch v {
+	case PerspectiveValNone:
+		return true
+	}
+	return false
+}
+
+// ParsePerspectiveVal returns the PerspectiveVal constant for a keyword, ignoring ASCII case.
+func ParsePerspectiveVal(s string) (PerspectiveVal, error) {
+	v := PerspectiveVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PerspectiveVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PerspectiveVal constants.
+func (v PerspectiveVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PerspectiveVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePerspectiveVal.
+func (v *PerspectiveVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePerspectiveVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PerspectiveOriginVal represents values for the perspective-origin property.
+//
+//   - Initial: 50% 50%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective-origin
 type PerspectiveOriginVal string
 
 // PerspectiveOriginVal constants.
 const (
-	PerspectiveOriginValBottom           PerspectiveOriginVal = "bottom"
-	PerspectiveOriginValCenter           PerspectiveOriginVal = "center"
-	PerspectiveOriginValLeft             PerspectiveOriginVal = "left"
-	PerspectiveOriginValLengthPercentage PerspectiveOriginVal = "length-percentage"
-	PerspectiveOriginValRight            PerspectiveOriginVal = "right"
-	PerspectiveOriginValTop              PerspectiveOriginVal = "top"
-)
+	PerspectiveOriginValBottom PerspectiveOriginVal = "bottom"
+	PerspectiveOriginValCenter PerspectiveOriginVal = "center"
+	PerspectiveOriginValLeft   PerspectiveOriginVal = "left"
+	PerspectiveOriginValRight  PerspectiveOriginVal = "right"
+	PerspectiveOriginValTop    PerspectiveOriginVal = "top"
+)
+
+func (v PerspectiveOriginVal) String() string { return string(v) }
+
+// Values returns every PerspectiveOriginVal constant.
+func (PerspectiveOriginVal) Values() []PerspectiveOriginVal {
+	return []PerspectiveOriginVal{
+		PerspectiveOriginValBottom,
+		PerspectiveOriginValCenter,
+		PerspectiveOriginValLeft,
+		PerspectiveOriginValRight,
+		PerspectiveOriginValTop,
+	}
+}
+
+// Valid reports whether v is one of the PerspectiveOriginVal constants.
+func (v PerspectiveOriginVal) Valid() bool {
+	switch v {
+	case PerspectiveOriginValBottom,
+		PerspectiveOriginValCenter,
+		PerspectiveOriginValLeft,
+		PerspectiveOriginValRight,
+		PerspectiveOriginValTop:
+		return true
+	}
+	return false
+}
+
+// ParsePerspectiveOriginVal returns the PerspectiveOriginVal constant for a keyword, ignoring ASCII case.
+func ParsePerspectiveOriginVal(s string) (PerspectiveOriginVal, error) {
+	v := PerspectiveOriginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PerspectiveOriginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PerspectiveOriginVal constants.
+func (v PerspectiveOriginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PerspectiveOriginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePerspectiveOriginVal.
+func (v *PerspectiveOriginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePerspectiveOriginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PlaceContentVal represents values for the place-content property.
+//
+//   - Shorthand for: align-content, justify-content
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-content'> <'justify-content'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-content
 type PlaceContentVal string
 
 // PlaceContentVal constants.
 const (
-	PlaceContentValAlignContent   PlaceContentVal = "align-content"
-	PlaceContentValJustifyContent PlaceContentVal = "justify-content"
-)
+	PlaceContentValBaseline     PlaceContentVal = "baseline"
+	PlaceContentValCenter       PlaceContentVal = "center"
+	PlaceContentValEnd          PlaceContentVal = "end"
+	PlaceContentValFirst        PlaceContentVal = "first"
+	PlaceContentValFlexEnd      PlaceContentVal = "flex-end"
+	PlaceContentValFlexStart    PlaceContentVal = "flex-start"
+	PlaceContentValLast         PlaceContentVal = "last"
+	PlaceContentValLeft         PlaceContentVal = "left"
+	PlaceContentValNormal       PlaceContentVal = "normal"
+	PlaceContentValRight        PlaceContentVal = "right"
+	PlaceContentValSafe         PlaceContentVal = "safe"
+	PlaceContentValSpaceAround  PlaceContentVal = "space-around"
+	PlaceContentValSpaceBetween PlaceContentVal = "space-between"
+	PlaceContentValSpaceEvenly  PlaceContentVal = "space-evenly"
+	PlaceContentValStart        PlaceContentVal = "start"
+	PlaceContentValStretch      PlaceContentVal = "stretch"
+	PlaceContentValUnsafe       PlaceContentVal = "unsafe"
+)
+
+func (v PlaceContentVal) String() string { return string(v) }
+
+// Values returns every PlaceContentVal constant.
+func (PlaceContentVal) Values() []PlaceContentVal {
+	return []PlaceContentVal{
+		PlaceContentValBaseline,
+		PlaceContentValCenter,
+		PlaceContentValEnd,
+		PlaceContentValFirst,
+		PlaceContentValFlexEnd,
+		PlaceContentValFlexStart,
+		PlaceContentValLast,
+		PlaceContentValLeft,
+		PlaceContentValNormal,
+		PlaceContentValRight,
+		PlaceContentValSafe,
+		PlaceContentValSpaceAround,
+		PlaceContentValSpaceBetween,
+		PlaceContentValSpaceEvenly,
+		PlaceContentValStart,
+		PlaceContentValStretch,
+		PlaceContentValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the PlaceContentVal constants.
+func (v PlaceContentVal) Valid() bool {
+	switch v {
+	case PlaceContentValBaseline,
+		PlaceContentValCenter,
+		PlaceContentValEnd,
+		PlaceContentValFirst,
+		PlaceContentValFlexEnd,
+		PlaceContentValFlexStart,
+		PlaceContentValLast,
+		PlaceContentValLeft,
+		PlaceContentValNormal,
+		PlaceContentValRight,
+		PlaceContentValSafe,
+		PlaceContentValSpaceAround,
+		PlaceContentValSpaceBetween,
+		PlaceContentValSpaceEvenly,
+		PlaceContentValStart,
+		PlaceContentValStretch,
+		PlaceContentValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParsePlaceContentVal returns the PlaceContentVal constant for a keyword, ignoring ASCII case.
+func ParsePlaceContentVal(s string) (PlaceContentVal, error) {
+	v := PlaceContentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PlaceContentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PlaceContentVal constants.
+func (v PlaceContentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PlaceContentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePlaceContentVal.
+func (v *PlaceContentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePlaceContentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PlaceItemsVal represents values for the place-items property.
+//
+//   - Shorthand for: align-items, justify-items
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-items'> <'justify-items'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-items
 type PlaceItemsVal string
 
 // PlaceItemsVal constants.
 const (
-	PlaceItemsValAlignItems   PlaceItemsVal = "align-items"
-	PlaceItemsValJustifyItems PlaceItemsVal = "justify-items"
-)
+	PlaceItemsValAnchorCenter PlaceItemsVal = "anchor-center"
+	PlaceItemsValBaseline     PlaceItemsVal = "baseline"
+	PlaceItemsValCenter       PlaceItemsVal = "center"
+	PlaceItemsValEnd          PlaceItemsVal = "end"
+	PlaceItemsValFirst        PlaceItemsVal = "first"
+	PlaceItemsValFlexEnd      PlaceItemsVal = "flex-end"
+	PlaceItemsValFlexStart    PlaceItemsVal = "flex-start"
+	PlaceItemsValLast         PlaceItemsVal = "last"
+	PlaceItemsValLeft         PlaceItemsVal = "left"
+	PlaceItemsValLegacy       PlaceItemsVal = "legacy"
+	PlaceItemsValNormal       PlaceItemsVal = "normal"
+	PlaceItemsValRight        PlaceItemsVal = "right"
+	PlaceItemsValSafe         PlaceItemsVal = "safe"
+	PlaceItemsValSelfEnd      PlaceItemsVal = "self-end"
+	PlaceItemsValSelfStart    PlaceItemsVal = "self-start"
+	PlaceItemsValStart        PlaceItemsVal = "start"
+	PlaceItemsValStretch      PlaceItemsVal = "stretch"
+	PlaceItemsValUnsafe       PlaceItemsVal = "unsafe"
+)
+
+func (v PlaceItemsVal) String() string { return string(v) }
+
+// Values returns every PlaceItemsVal constant.
+func (PlaceItemsVal) Values() []PlaceItemsVal {
+	return []PlaceItemsVal{
+		PlaceItemsValAnchorCenter,
+		PlaceItemsValBaseline,
+		PlaceItemsValCenter,
+		PlaceItemsValEnd,
+		PlaceItemsValFirst,
+		PlaceItemsValFlexEnd,
+		PlaceItemsValFlexStart,
+		PlaceItemsValLast,
+		PlaceItemsValLeft,
+		PlaceItemsValLegacy,
+		PlaceItemsValNormal,
+		PlaceItemsValRight,
+		PlaceItemsValSafe,
+		PlaceItemsValSelfEnd,
+		PlaceItemsValSelfStart,
+		PlaceItemsValStart,
+		PlaceItemsValStretch,
+		PlaceItemsValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the PlaceItemsVal constants.
+func (v PlaceItemsVal) Valid() bool {
+	switch v {
+	case PlaceItemsValAnchorCenter,
+		PlaceItemsValBaseline,
+		PlaceItemsValCenter,
+		PlaceItemsValEnd,
+		PlaceItemsValFirst,
+		PlaceItemsValFlexEnd,
+		PlaceItemsValFlexStart,
+		PlaceItemsValLast,
+		PlaceItemsValLeft,
+		PlaceItemsValLegacy,
+		PlaceItemsValNormal,
+		PlaceItemsValRight,
+		PlaceItemsValSafe,
+		PlaceItemsValSelfEnd,
+		PlaceItemsValSelfStart,
+		PlaceItemsValStart,
+		PlaceItemsValStretch,
+		PlaceItemsValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParsePlaceItemsVal returns the PlaceItemsVal constant for a keyword, ignoring ASCII case.
+func ParsePlaceItemsVal(s string) (PlaceItemsVal, error) {
+	v := PlaceItemsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PlaceItemsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PlaceItemsVal constants.
+func (v PlaceItemsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PlaceItemsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePlaceItemsVal.
+func (v *PlaceItemsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePlaceItemsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PlaceSelfVal represents values for the place-self property.
+//
+//   - Shorthand for: align-self, justify-self
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-self'> <'justify-self'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-self
 type PlaceSelfVal string
 
 // PlaceSelfVal constants.
 const (
-	PlaceSelfValAlignSelf   PlaceSelfVal = "align-self"
-	PlaceSelfValJustifySelf PlaceSelfVal = "justify-self"
-)
+	PlaceSelfValAnchorCenter PlaceSelfVal = "anchor-center"
+	PlaceSelfValAuto         PlaceSelfVal = "auto"
+	PlaceSelfValBaseline     PlaceSelfVal = "baseline"
+	PlaceSelfValCenter       PlaceSelfVal = "center"
+	PlaceSelfValEnd          PlaceSelfVal = "end"
+	PlaceSelfValFirst        PlaceSelfVal = "first"
+	PlaceSelfValFlexEnd      PlaceSelfVal = "flex-end"
+	PlaceSelfValFlexStart    PlaceSelfVal = "flex-start"
+	PlaceSelfValLast         PlaceSelfVal = "last"
+	PlaceSelfValLeft         PlaceSelfVal = "left"
+	PlaceSelfValNormal       PlaceSelfVal = "normal"
+	PlaceSelfValRight        PlaceSelfVal = "right"
+	PlaceSelfValSafe         PlaceSelfVal = "safe"
+	PlaceSelfValSelfEnd      PlaceSelfVal = "self-end"
+	PlaceSelfValSelfStart    PlaceSelfVal = "self-start"
+	PlaceSelfValStart        PlaceSelfVal = "start"
+	PlaceSelfValStretch      PlaceSelfVal = "stretch"
+	PlaceSelfValUnsafe       PlaceSelfVal = "unsafe"
+)
+
+func (v PlaceSelfVal) String() string { return string(v) }
+
+// Values returns every PlaceSelfVal constant.
+func (PlaceSelfVal) Values() []PlaceSelfVal {
+	return []PlaceSelfVal{
+		PlaceSelfValAnchorCenter,
+		PlaceSelfValAuto,
+		PlaceSelfValBaseline,
+		PlaceSelfValCenter,
+		PlaceSelfValEnd,
+		PlaceSelfValFirst,
+		PlaceSelfValFlexEnd,
+		PlaceSelfValFlexStart,
+		PlaceSelfValLast,
+		PlaceSelfValLeft,
+		PlaceSelfValNormal,
+		PlaceSelfValRight,
+		PlaceSelfValSafe,
+		PlaceSelfValSelfEnd,
+		PlaceSelfValSelfStart,
+		PlaceSelfValStart,
+		PlaceSelfValStretch,
+		PlaceSelfValUnsafe,
+	}
+}
+
+// Valid reports whether v is one of the PlaceSelfVal constants.
+func (v PlaceSelfVal) Valid() bool {
+	switch v {
+	case PlaceSelfValAnchorCenter,
+		PlaceSelfValAuto,
+		PlaceSelfValBaseline,
+		PlaceSelfValCenter,
+		PlaceSelfValEnd,
+		PlaceSelfValFirst,
+		PlaceSelfValFlexEnd,
+		PlaceSelfValFlexStart,
+		PlaceSelfValLast,
+		PlaceSelfValLeft,
+		PlaceSelfValNormal,
+		PlaceSelfValRight,
+		PlaceSelfValSafe,
+		PlaceSelfValSelfEnd,
+		PlaceSelfValSelfStart,
+		PlaceSelfValStart,
+		PlaceSelfValStretch,
+		PlaceSelfValUnsafe:
+		return true
+	}
+	return false
+}
+
+// ParsePlaceSelfVal returns the PlaceSelfVal constant for a keyword, ignoring ASCII case.
+func ParsePlaceSelfVal(s string) (PlaceSelfVal, error) {
+	v := PlaceSelfVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PlaceSelfVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PlaceSelfVal constants.
+func (v PlaceSelfVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PlaceSelfVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePlaceSelfVal.
+func (v *PlaceSelfVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePlaceSelfVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PointerEventsVal represents values for the pointer-events property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/pointer-events
 type PointerEventsVal string
 
 // PointerEventsVal constants.
 const (
-	PointerEventsValAuto    PointerEventsVal = "auto"
-	PointerEventsValFill    PointerEventsVal = "fill"
-	PointerEventsValNone    PointerEventsVal = "none"
-	PointerEventsValPainted PointerEventsVal = "painted"
-	PointerEventsValStroke  PointerEventsVal = "stroke"
-	PointerEventsValVisible PointerEventsVal = "visible"
-)
+	PointerEventsValAll            PointerEventsVal = "all"
+	PointerEventsValAuto           PointerEventsVal = "auto"
+	PointerEventsValFill           PointerEventsVal = "fill"
+	PointerEventsValNone           PointerEventsVal = "none"
+	PointerEventsValPainted        PointerEventsVal = "painted"
+	PointerEventsValStroke         PointerEventsVal = "stroke"
+	PointerEventsValVisible        PointerEventsVal = "visible"
+	PointerEventsValVisiblefill    PointerEventsVal = "visiblefill"
+	PointerEventsValVisiblepainted PointerEventsVal = "visiblepainted"
+	PointerEventsValVisiblestroke  PointerEventsVal = "visiblestroke"
+)
+
+func (v PointerEventsVal) String() string { return string(v) }
+
+// Values returns every PointerEventsVal constant.
+func (PointerEventsVal) Values() []PointerEventsVal {
+	return []PointerEventsVal{
+		PointerEventsValAll,
+		PointerEventsValAuto,
+		PointerEventsValFill,
+		PointerEventsValNone,
+		PointerEventsValPainted,
+		PointerEventsValStroke,
+		PointerEventsValVisible,
+		PointerEventsValVisiblefill,
+		PointerEventsValVisiblepainted,
+		PointerEventsValVisiblestroke,
+	}
+}
+
+// Valid reports whether v is one of the PointerEventsVal constants.
+func (v PointerEventsVal) Valid() bool {
+	switch v {
+	case PointerEventsValAll,
+		PointerEventsValAuto,
+		PointerEventsValFill,
+		PointerEventsValNone,
+		PointerEventsValPainted,
+		PointerEventsValStroke,
+		PointerEventsValVisible,
+		PointerEventsValVisiblefill,
+		PointerEventsValVisiblepainted,
+		PointerEventsValVisiblestroke:
+		return true
+	}
+	return false
+}
+
+// ParsePointerEventsVal returns the PointerEventsVal constant for a keyword, ignoring ASCII case.
+func ParsePointerEventsVal(s string) (PointerEventsVal, error) {
+	v := PointerEventsVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PointerEventsVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PointerEventsVal constants.
+func (v PointerEventsVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PointerEventsVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePointerEventsVal.
+func (v *PointerEventsVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePointerEventsVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // PositionVal represents values for the position property.
+//
+//   - Initial: static
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	static | relative | absolute | sticky | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position
 type PositionVal string
 
 // PositionVal constants.
@@ -3901,7 +21976,71 @@ const (
 	PositionValSticky   PositionVal = "sticky"
 )
 
+func (v PositionVal) String() string { return string(v) }
+
+// Values returns every PositionVal constant.
+func (PositionVal) Values() []PositionVal {
+	return []PositionVal{
+		PositionValAbsolute,
+		PositionValFixed,
+		PositionValRelative,
+		PositionValStatic,
+		PositionValSticky,
+	}
+}
+
+// Valid reports whether v is one of the PositionVal constants.
+func (v PositionVal) Valid() bool {
+	switch v {
+	case PositionValAbsolute,
+		PositionValFixed,
+		PositionValRelative,
+		PositionValStatic,
+		PositionValSticky:
+		return true
+	}
+	return false
+}
+
+// ParsePositionVal returns the PositionVal constant for a keyword, ignoring ASCII case.
+func ParsePositionVal(s string) (PositionVal, error) {
+	v := PositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PositionVal constants.
+func (v PositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePositionVal.
+func (v *PositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // PrintColorAdjustVal represents values for the print-color-adjust property.
+//
+//   - Initial: economy
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	economy | exact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/print-color-adjust
 type PrintColorAdjustVal string
 
 // PrintColorAdjustVal constants.
@@ -3910,7 +22049,65 @@ const (
 	PrintColorAdjustValExact   PrintColorAdjustVal = "exact"
 )
 
+func (v PrintColorAdjustVal) String() string { return string(v) }
+
+// Values returns every PrintColorAdjustVal constant.
+func (PrintColorAdjustVal) Values() []PrintColorAdjustVal {
+	return []PrintColorAdjustVal{
+		PrintColorAdjustValEconomy,
+		PrintColorAdjustValExact,
+	}
+}
+
+// Valid reports whether v is one of the PrintColorAdjustVal constants.
+func (v PrintColorAdjustVal) Valid() bool {
+	switch v {
+	case PrintColorAdjustValEconomy,
+		PrintColorAdjustValExact:
+		return true
+	}
+	return false
+}
+
+// ParsePrintColorAdjustVal returns the PrintColorAdjustVal constant for a keyword, ignoring ASCII case.
+func ParsePrintColorAdjustVal(s string) (PrintColorAdjustVal, error) {
+	v := PrintColorAdjustVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "PrintColorAdjustVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not PrintColorAdjustVal constants.
+func (v PrintColorAdjustVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "PrintColorAdjustVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParsePrintColorAdjustVal.
+func (v *PrintColorAdjustVal) UnmarshalText(text []byte) error {
+	parsed, err := ParsePrintColorAdjustVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // QuotesVal represents values for the quotes property.
+//
+//   - Initial: dependsOnUserAgent
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | auto | [ <string> <string> ]+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/quotes
 type QuotesVal string
 
 // QuotesVal constants.
@@ -3919,7 +22116,65 @@ const (
 	QuotesValNone QuotesVal = "none"
 )
 
+func (v QuotesVal) String() string { return string(v) }
+
+// Values returns every QuotesVal constant.
+func (QuotesVal) Values() []QuotesVal {
+	return []QuotesVal{
+		QuotesValAuto,
+		QuotesValNone,
+	}
+}
+
+// Valid reports whether v is one of the QuotesVal constants.
+func (v QuotesVal) Valid() bool {
+	switch v {
+	case QuotesValAuto,
+		QuotesValNone:
+		return true
+	}
+	return false
+}
+
+// ParseQuotesVal returns the QuotesVal constant for a keyword, ignoring ASCII case.
+func ParseQuotesVal(s string) (QuotesVal, error) {
+	v := QuotesVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "QuotesVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not QuotesVal constants.
+func (v QuotesVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "QuotesVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseQuotesVal.
+func (v *QuotesVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseQuotesVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ResizeVal represents values for the resize property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | both | horizontal | vertical | block | inline
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/resize
 type ResizeVal string
 
 // ResizeVal constants.
@@ -3932,30 +22187,210 @@ const (
 	ResizeValVertical   ResizeVal = "vertical"
 )
 
+func (v ResizeVal) String() string { return string(v) }
+
+// Values returns every ResizeVal constant.
+func (ResizeVal) Values() []ResizeVal {
+	return []ResizeVal{
+		ResizeValBlock,
+		ResizeValBoth,
+		ResizeValHorizontal,
+		ResizeValInline,
+		ResizeValNone,
+		ResizeValVertical,
+	}
+}
+
+// Valid reports whether v is one of the ResizeVal constants.
+func (v ResizeVal) Valid() bool {
+	switch v {
+	case ResizeValBlock,
+		ResizeValBoth,
+		ResizeValHorizontal,
+		ResizeValInline,
+		ResizeValNone,
+		ResizeValVertical:
+		return true
+	}
+	return false
+}
+
+// ParseResizeVal returns the ResizeVal constant for a keyword, ignoring ASCII case.
+func ParseResizeVal(s string) (ResizeVal, error) {
+	v := ResizeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ResizeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ResizeVal constants.
+func (v ResizeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ResizeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseResizeVal.
+func (v *ResizeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseResizeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // RightVal represents values for the right property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/right
 type RightVal string
 
 // RightVal constants.
 const (
-	RightValAnchor           RightVal = "anchor"
-	RightValAnchorName       RightVal = "anchor-name"
-	RightValAnchorSide       RightVal = "anchor-side"
-	RightValAnchorSize       RightVal = "anchor-size"
-	RightValAuto             RightVal = "auto"
-	RightValLengthPercentage RightVal = "length-percentage"
-)
+	RightValAuto RightVal = "auto"
+)
+
+func (v RightVal) String() string { return string(v) }
+
+// Values returns every RightVal constant.
+func (RightVal) Values() []RightVal {
+	return []RightVal{
+		RightValAuto,
+	}
+}
+
+// Valid reports whether v is one of the RightVal constants.
+func (v RightVal) Valid() bool {
+	switch v {
+	case RightValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseRightVal returns the RightVal constant for a keyword, ignoring ASCII case.
+func ParseRightVal(s string) (RightVal, error) {
+	v := RightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RightVal constants.
+func (v RightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRightVal.
+func (v *RightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // RotateVal represents values for the rotate property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <angle> | [ x | y | z | <number>{3} ] && <angle>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/rotate
 type RotateVal string
 
 // RotateVal constants.
 const (
-	RotateVal3     RotateVal = "3"
-	RotateValAngle RotateVal = "angle"
-	RotateValNone  RotateVal = "none"
-)
+	RotateValNone RotateVal = "none"
+	RotateValX    RotateVal = "x"
+	RotateValY    RotateVal = "y"
+	RotateValZ    RotateVal = "z"
+)
+
+func (v RotateVal) String() string { return string(v) }
+
+// Values returns every RotateVal constant.
+func (RotateVal) Values() []RotateVal {
+	return []RotateVal{
+		RotateValNone,
+		RotateValX,
+		RotateValY,
+		RotateValZ,
+	}
+}
+
+// Valid reports whether v is one of the RotateVal constants.
+func (v RotateVal) Valid() bool {
+	switch v {
+	case RotateValNone,
+		RotateValX,
+		RotateValY,
+		RotateValZ:
+		return true
+	}
+	return false
+}
+
+// ParseRotateVal returns the RotateVal constant for a keyword, ignoring ASCII case.
+func ParseRotateVal(s string) (RotateVal, error) {
+	v := RotateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RotateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RotateVal constants.
+func (v RotateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RotateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRotateVal.
+func (v *RotateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRotateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // RowGapVal represents values for the row-gap property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/row-gap
 type RowGapVal string
 
 // RowGapVal constants.
@@ -3963,7 +22398,63 @@ const (
 	RowGapValNormal RowGapVal = "normal"
 )
 
+func (v RowGapVal) String() string { return string(v) }
+
+// Values returns every RowGapVal constant.
+func (RowGapVal) Values() []RowGapVal {
+	return []RowGapVal{
+		RowGapValNormal,
+	}
+}
+
+// Valid reports whether v is one of the RowGapVal constants.
+func (v RowGapVal) Valid() bool {
+	switch v {
+	case RowGapValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseRowGapVal returns the RowGapVal constant for a keyword, ignoring ASCII case.
+func ParseRowGapVal(s string) (RowGapVal, error) {
+	v := RowGapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RowGapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RowGapVal constants.
+func (v RowGapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RowGapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRowGapVal.
+func (v *RowGapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRowGapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // RubyAlignVal represents values for the ruby-align property.
+//
+//   - Initial: space-around
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | center | space-between | space-around
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-align
 type RubyAlignVal string
 
 // RubyAlignVal constants.
@@ -3974,7 +22465,67 @@ const (
 	RubyAlignValStart        RubyAlignVal = "start"
 )
 
+func (v RubyAlignVal) String() string { return string(v) }
+
+// Values returns every RubyAlignVal constant.
+func (RubyAlignVal) Values() []RubyAlignVal {
+	return []RubyAlignVal{
+		RubyAlignValCenter,
+		RubyAlignValSpaceAround,
+		RubyAlignValSpaceBetween,
+		RubyAlignValStart,
+	}
+}
+
+// Valid reports whether v is one of the RubyAlignVal constants.
+func (v RubyAlignVal) Valid() bool {
+	switch v {
+	case RubyAlignValCenter,
+		RubyAlignValSpaceAround,
+		RubyAlignValSpaceBetween,
+		RubyAlignValStart:
+		return true
+	}
+	return false
+}
+
+// ParseRubyAlignVal returns the RubyAlignVal constant for a keyword, ignoring ASCII case.
+func ParseRubyAlignVal(s string) (RubyAlignVal, error) {
+	v := RubyAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RubyAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RubyAlignVal constants.
+func (v RubyAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RubyAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRubyAlignVal.
+func (v *RubyAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRubyAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // RubyOverhangVal represents values for the ruby-overhang property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
 type RubyOverhangVal string
 
 // RubyOverhangVal constants.
@@ -3983,7 +22534,65 @@ const (
 	RubyOverhangValNone RubyOverhangVal = "none"
 )
 
+func (v RubyOverhangVal) String() string { return string(v) }
+
+// Values returns every RubyOverhangVal constant.
+func (RubyOverhangVal) Values() []RubyOverhangVal {
+	return []RubyOverhangVal{
+		RubyOverhangValAuto,
+		RubyOverhangValNone,
+	}
+}
+
+// Valid reports whether v is one of the RubyOverhangVal constants.
+func (v RubyOverhangVal) Valid() bool {
+	switch v {
+	case RubyOverhangValAuto,
+		RubyOverhangValNone:
+		return true
+	}
+	return false
+}
+
+// ParseRubyOverhangVal returns the RubyOverhangVal constant for a keyword, ignoring ASCII case.
+func ParseRubyOverhangVal(s string) (RubyOverhangVal, error) {
+	v := RubyOverhangVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RubyOverhangVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RubyOverhangVal constants.
+func (v RubyOverhangVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RubyOverhangVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRubyOverhangVal.
+func (v *RubyOverhangVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRubyOverhangVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // RubyPositionVal represents values for the ruby-position property.
+//
+//   - Initial: alternate
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ alternate || [ over | under ] ] | inter-character
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-position
 type RubyPositionVal string
 
 // RubyPositionVal constants.
@@ -3994,17 +22603,133 @@ const (
 	RubyPositionValUnder          RubyPositionVal = "under"
 )
 
+func (v RubyPositionVal) String() string { return string(v) }
+
+// Values returns every RubyPositionVal constant.
+func (RubyPositionVal) Values() []RubyPositionVal {
+	return []RubyPositionVal{
+		RubyPositionValAlternate,
+		RubyPositionValInterCharacter,
+		RubyPositionValOver,
+		RubyPositionValUnder,
+	}
+}
+
+// Valid reports whether v is one of the RubyPositionVal constants.
+func (v RubyPositionVal) Valid() bool {
+	switch v {
+	case RubyPositionValAlternate,
+		RubyPositionValInterCharacter,
+		RubyPositionValOver,
+		RubyPositionValUnder:
+		return true
+	}
+	return false
+}
+
+// ParseRubyPositionVal returns the RubyPositionVal constant for a keyword, ignoring ASCII case.
+func ParseRubyPositionVal(s string) (RubyPositionVal, error) {
+	v := RubyPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "RubyPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not RubyPositionVal constants.
+func (v RubyPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "RubyPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseRubyPositionVal.
+func (v *RubyPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseRubyPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScaleVal represents values for the scale property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <number> | <percentage> ]{1,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scale
 type ScaleVal string
 
 // ScaleVal constants.
 const (
-	ScaleVal1    ScaleVal = "1"
-	ScaleVal3    ScaleVal = "3"
 	ScaleValNone ScaleVal = "none"
 )
 
+func (v ScaleVal) String() string { return string(v) }
+
+// Values returns every ScaleVal constant.
+func (ScaleVal) Values() []ScaleVal {
+	return []ScaleVal{
+		ScaleValNone,
+	}
+}
+
+// Valid reports whether v is one of the ScaleVal constants.
+func (v ScaleVal) Valid() bool {
+	switch v {
+	case ScaleValNone:
+		return true
+	}
+	return false
+}
+
+// ParseScaleVal returns the ScaleVal constant for a keyword, ignoring ASCII case.
+func ParseScaleVal(s string) (ScaleVal, error) {
+	v := ScaleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScaleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScaleVal constants.
+func (v ScaleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScaleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScaleVal.
+func (v *ScaleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScaleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollBehaviorVal represents values for the scroll-behavior property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | smooth
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-behavior
 type ScrollBehaviorVal string
 
 // ScrollBehaviorVal constants.
@@ -4013,54 +22738,193 @@ const (
 	ScrollBehaviorValSmooth ScrollBehaviorVal = "smooth"
 )
 
-// ScrollMarginVal represents values for the scroll-margin property.
-type ScrollMarginVal string
-
-// ScrollMarginVal constants.
-const (
-	ScrollMarginVal1 ScrollMarginVal = "1"
-	ScrollMarginVal4 ScrollMarginVal = "4"
-)
-
-// ScrollMarginBlockVal represents values for the scroll-margin-block property.
-type ScrollMarginBlockVal string
-
-// ScrollMarginBlockVal constants.
-const (
-	ScrollMarginBlockVal1 ScrollMarginBlockVal = "1"
-	ScrollMarginBlockVal2 ScrollMarginBlockVal = "2"
-)
-
-// ScrollMarginInlineVal represents values for the scroll-margin-inline property.
-type ScrollMarginInlineVal string
-
-// ScrollMarginInlineVal constants.
-const (
-	ScrollMarginInlineVal1 ScrollMarginInlineVal = "1"
-	ScrollMarginInlineVal2 ScrollMarginInlineVal = "2"
-)
+func (v ScrollBehaviorVal) String() string { return string(v) }
+
+// Values returns every ScrollBehaviorVal constant.
+func (ScrollBehaviorVal) Values() []ScrollBehaviorVal {
+	return []ScrollBehaviorVal{
+		ScrollBehaviorValAuto,
+		ScrollBehaviorValSmooth,
+	}
+}
+
+// Valid reports whether v is one of the ScrollBehaviorVal constants.
+func (v ScrollBehaviorVal) Valid() bool {
+	switch v {
+	case ScrollBehaviorValAuto,
+		ScrollBehaviorValSmooth:
+		return true
+	}
+	return false
+}
+
+// ParseScrollBehaviorVal returns the ScrollBehaviorVal constant for a keyword, ignoring ASCII case.
+func ParseScrollBehaviorVal(s string) (ScrollBehaviorVal, error) {
+	v := ScrollBehaviorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollBehaviorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollBehaviorVal constants.
+func (v ScrollBehaviorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollBehaviorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollBehaviorVal.
+func (v *ScrollBehaviorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollBehaviorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollPaddingVal represents values for the scroll-padding property.
+//
+//   - Shorthand for: scroll-padding-bottom, scroll-padding-left, scroll-padding-right, scroll-padding-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding
 type ScrollPaddingVal string
 
 // ScrollPaddingVal constants.
 const (
-	ScrollPaddingVal1    ScrollPaddingVal = "1"
-	ScrollPaddingVal4    ScrollPaddingVal = "4"
 	ScrollPaddingValAuto ScrollPaddingVal = "auto"
 )
 
+func (v ScrollPaddingVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingVal constant.
+func (ScrollPaddingVal) Values() []ScrollPaddingVal {
+	return []ScrollPaddingVal{
+		ScrollPaddingValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingVal constants.
+func (v ScrollPaddingVal) Valid() bool {
+	switch v {
+	case ScrollPaddingValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingVal returns the ScrollPaddingVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingVal(s string) (ScrollPaddingVal, error) {
+	v := ScrollPaddingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingVal constants.
+func (v ScrollPaddingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingVal.
+func (v *ScrollPaddingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingBlockVal represents values for the scroll-padding-block property.
+//
+//   - Shorthand for: scroll-padding-block-end, scroll-padding-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block
 type ScrollPaddingBlockVal string
 
 // ScrollPaddingBlockVal constants.
 const (
-	ScrollPaddingBlockVal1    ScrollPaddingBlockVal = "1"
-	ScrollPaddingBlockVal2    ScrollPaddingBlockVal = "2"
 	ScrollPaddingBlockValAuto ScrollPaddingBlockVal = "auto"
 )
 
+func (v ScrollPaddingBlockVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingBlockVal constant.
+func (ScrollPaddingBlockVal) Values() []ScrollPaddingBlockVal {
+	return []ScrollPaddingBlockVal{
+		ScrollPaddingBlockValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingBlockVal constants.
+func (v ScrollPaddingBlockVal) Valid() bool {
+	switch v {
+	case ScrollPaddingBlockValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingBlockVal returns the ScrollPaddingBlockVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingBlockVal(s string) (ScrollPaddingBlockVal, error) {
+	v := ScrollPaddingBlockVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingBlockVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingBlockVal constants.
+func (v ScrollPaddingBlockVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingBlockVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingBlockVal.
+func (v *ScrollPaddingBlockVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingBlockVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingBlockEndVal represents values for the scroll-padding-block-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-end
 type ScrollPaddingBlockEndVal string
 
 // ScrollPaddingBlockEndVal constants.
@@ -4068,7 +22932,63 @@ const (
 	ScrollPaddingBlockEndValAuto ScrollPaddingBlockEndVal = "auto"
 )
 
+func (v ScrollPaddingBlockEndVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingBlockEndVal constant.
+func (ScrollPaddingBlockEndVal) Values() []ScrollPaddingBlockEndVal {
+	return []ScrollPaddingBlockEndVal{
+		ScrollPaddingBlockEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingBlockEndVal constants.
+func (v ScrollPaddingBlockEndVal) Valid() bool {
+	switch v {
+	case ScrollPaddingBlockEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingBlockEndVal returns the ScrollPaddingBlockEndVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingBlockEndVal(s string) (ScrollPaddingBlockEndVal, error) {
+	v := ScrollPaddingBlockEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingBlockEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingBlockEndVal constants.
+func (v ScrollPaddingBlockEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingBlockEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingBlockEndVal.
+func (v *ScrollPaddingBlockEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingBlockEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingBlockStartVal represents values for the scroll-padding-block-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-start
 type ScrollPaddingBlockStartVal string
 
 // ScrollPaddingBlockStartVal constants.
@@ -4076,7 +22996,63 @@ const (
 	ScrollPaddingBlockStartValAuto ScrollPaddingBlockStartVal = "auto"
 )
 
+func (v ScrollPaddingBlockStartVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingBlockStartVal constant.
+func (ScrollPaddingBlockStartVal) Values() []ScrollPaddingBlockStartVal {
+	return []ScrollPaddingBlockStartVal{
+		ScrollPaddingBlockStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingBlockStartVal constants.
+func (v ScrollPaddingBlockStartVal) Valid() bool {
+	switch v {
+	case ScrollPaddingBlockStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingBlockStartVal returns the ScrollPaddingBlockStartVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingBlockStartVal(s string) (ScrollPaddingBlockStartVal, error) {
+	v := ScrollPaddingBlockStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingBlockStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingBlockStartVal constants.
+func (v ScrollPaddingBlockStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingBlockStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingBlockStartVal.
+func (v *ScrollPaddingBlockStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingBlockStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingBottomVal represents values for the scroll-padding-bottom property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-bottom
 type ScrollPaddingBottomVal string
 
 // ScrollPaddingBottomVal constants.
@@ -4084,17 +23060,127 @@ const (
 	ScrollPaddingBottomValAuto ScrollPaddingBottomVal = "auto"
 )
 
+func (v ScrollPaddingBottomVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingBottomVal constant.
+func (ScrollPaddingBottomVal) Values() []ScrollPaddingBottomVal {
+	return []ScrollPaddingBottomVal{
+		ScrollPaddingBottomValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingBottomVal constants.
+func (v ScrollPaddingBottomVal) Valid() bool {
+	switch v {
+	case ScrollPaddingBottomValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingBottomVal returns the ScrollPaddingBottomVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingBottomVal(s string) (ScrollPaddingBottomVal, error) {
+	v := ScrollPaddingBottomVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingBottomVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingBottomVal constants.
+func (v ScrollPaddingBottomVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingBottomVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingBottomVal.
+func (v *ScrollPaddingBottomVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingBottomVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingInlineVal represents values for the scroll-padding-inline property.
+//
+//   - Shorthand for: scroll-padding-inline-end, scroll-padding-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline
 type ScrollPaddingInlineVal string
 
 // ScrollPaddingInlineVal constants.
 const (
-	ScrollPaddingInlineVal1    ScrollPaddingInlineVal = "1"
-	ScrollPaddingInlineVal2    ScrollPaddingInlineVal = "2"
 	ScrollPaddingInlineValAuto ScrollPaddingInlineVal = "auto"
 )
 
+func (v ScrollPaddingInlineVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingInlineVal constant.
+func (ScrollPaddingInlineVal) Values() []ScrollPaddingInlineVal {
+	return []ScrollPaddingInlineVal{
+		ScrollPaddingInlineValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingInlineVal constants.
+func (v ScrollPaddingInlineVal) Valid() bool {
+	switch v {
+	case ScrollPaddingInlineValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingInlineVal returns the ScrollPaddingInlineVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingInlineVal(s string) (ScrollPaddingInlineVal, error) {
+	v := ScrollPaddingInlineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingInlineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingInlineVal constants.
+func (v ScrollPaddingInlineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingInlineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingInlineVal.
+func (v *ScrollPaddingInlineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingInlineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingInlineEndVal represents values for the scroll-padding-inline-end property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-end
 type ScrollPaddingInlineEndVal string
 
 // ScrollPaddingInlineEndVal constants.
@@ -4102,7 +23188,63 @@ const (
 	ScrollPaddingInlineEndValAuto ScrollPaddingInlineEndVal = "auto"
 )
 
+func (v ScrollPaddingInlineEndVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingInlineEndVal constant.
+func (ScrollPaddingInlineEndVal) Values() []ScrollPaddingInlineEndVal {
+	return []ScrollPaddingInlineEndVal{
+		ScrollPaddingInlineEndValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingInlineEndVal constants.
+func (v ScrollPaddingInlineEndVal) Valid() bool {
+	switch v {
+	case ScrollPaddingInlineEndValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingInlineEndVal returns the ScrollPaddingInlineEndVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingInlineEndVal(s string) (ScrollPaddingInlineEndVal, error) {
+	v := ScrollPaddingInlineEndVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingInlineEndVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingInlineEndVal constants.
+func (v ScrollPaddingInlineEndVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingInlineEndVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingInlineEndVal.
+func (v *ScrollPaddingInlineEndVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingInlineEndVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingInlineStartVal represents values for the scroll-padding-inline-start property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-start
 type ScrollPaddingInlineStartVal string
 
 // ScrollPaddingInlineStartVal constants.
@@ -4110,7 +23252,63 @@ const (
 	ScrollPaddingInlineStartValAuto ScrollPaddingInlineStartVal = "auto"
 )
 
+func (v ScrollPaddingInlineStartVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingInlineStartVal constant.
+func (ScrollPaddingInlineStartVal) Values() []ScrollPaddingInlineStartVal {
+	return []ScrollPaddingInlineStartVal{
+		ScrollPaddingInlineStartValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingInlineStartVal constants.
+func (v ScrollPaddingInlineStartVal) Valid() bool {
+	switch v {
+	case ScrollPaddingInlineStartValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingInlineStartVal returns the ScrollPaddingInlineStartVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingInlineStartVal(s string) (ScrollPaddingInlineStartVal, error) {
+	v := ScrollPaddingInlineStartVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingInlineStartVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingInlineStartVal constants.
+func (v ScrollPaddingInlineStartVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingInlineStartVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingInlineStartVal.
+func (v *ScrollPaddingInlineStartVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingInlineStartVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingLeftVal represents values for the scroll-padding-left property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-left
 type ScrollPaddingLeftVal string
 
 // ScrollPaddingLeftVal constants.
@@ -4118,7 +23316,63 @@ const (
 	ScrollPaddingLeftValAuto ScrollPaddingLeftVal = "auto"
 )
 
+func (v ScrollPaddingLeftVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingLeftVal constant.
+func (ScrollPaddingLeftVal) Values() []ScrollPaddingLeftVal {
+	return []ScrollPaddingLeftVal{
+		ScrollPaddingLeftValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingLeftVal constants.
+func (v ScrollPaddingLeftVal) Valid() bool {
+	switch v {
+	case ScrollPaddingLeftValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingLeftVal returns the ScrollPaddingLeftVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingLeftVal(s string) (ScrollPaddingLeftVal, error) {
+	v := ScrollPaddingLeftVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingLeftVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingLeftVal constants.
+func (v ScrollPaddingLeftVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingLeftVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingLeftVal.
+func (v *ScrollPaddingLeftVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingLeftVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingRightVal represents values for the scroll-padding-right property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-right
 type ScrollPaddingRightVal string
 
 // ScrollPaddingRightVal constants.
@@ -4126,7 +23380,63 @@ const (
 	ScrollPaddingRightValAuto ScrollPaddingRightVal = "auto"
 )
 
+func (v ScrollPaddingRightVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingRightVal constant.
+func (ScrollPaddingRightVal) Values() []ScrollPaddingRightVal {
+	return []ScrollPaddingRightVal{
+		ScrollPaddingRightValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingRightVal constants.
+func (v ScrollPaddingRightVal) Valid() bool {
+	switch v {
+	case ScrollPaddingRightValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingRightVal returns the ScrollPaddingRightVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingRightVal(s string) (ScrollPaddingRightVal, error) {
+	v := ScrollPaddingRightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingRightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingRightVal constants.
+func (v ScrollPaddingRightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingRightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingRightVal.
+func (v *ScrollPaddingRightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingRightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollPaddingTopVal represents values for the scroll-padding-top property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-top
 type ScrollPaddingTopVal string
 
 // ScrollPaddingTopVal constants.
@@ -4134,65 +23444,419 @@ const (
 	ScrollPaddingTopValAuto ScrollPaddingTopVal = "auto"
 )
 
+func (v ScrollPaddingTopVal) String() string { return string(v) }
+
+// Values returns every ScrollPaddingTopVal constant.
+func (ScrollPaddingTopVal) Values() []ScrollPaddingTopVal {
+	return []ScrollPaddingTopVal{
+		ScrollPaddingTopValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollPaddingTopVal constants.
+func (v ScrollPaddingTopVal) Valid() bool {
+	switch v {
+	case ScrollPaddingTopValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollPaddingTopVal returns the ScrollPaddingTopVal constant for a keyword, ignoring ASCII case.
+func ParseScrollPaddingTopVal(s string) (ScrollPaddingTopVal, error) {
+	v := ScrollPaddingTopVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollPaddingTopVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollPaddingTopVal constants.
+func (v ScrollPaddingTopVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollPaddingTopVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollPaddingTopVal.
+func (v *ScrollPaddingTopVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollPaddingTopVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollSnapAlignVal represents values for the scroll-snap-align property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ none | start | end | center ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-align
 type ScrollSnapAlignVal string
 
 // ScrollSnapAlignVal constants.
 const (
-	ScrollSnapAlignVal1      ScrollSnapAlignVal = "1"
-	ScrollSnapAlignVal2      ScrollSnapAlignVal = "2"
 	ScrollSnapAlignValCenter ScrollSnapAlignVal = "center"
 	ScrollSnapAlignValEnd    ScrollSnapAlignVal = "end"
 	ScrollSnapAlignValNone   ScrollSnapAlignVal = "none"
 	ScrollSnapAlignValStart  ScrollSnapAlignVal = "start"
 )
 
+func (v ScrollSnapAlignVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapAlignVal constant.
+func (ScrollSnapAlignVal) Values() []ScrollSnapAlignVal {
+	return []ScrollSnapAlignVal{
+		ScrollSnapAlignValCenter,
+		ScrollSnapAlignValEnd,
+		ScrollSnapAlignValNone,
+		ScrollSnapAlignValStart,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapAlignVal constants.
+func (v ScrollSnapAlignVal) Valid() bool {
+	switch v {
+	case ScrollSnapAlignValCenter,
+		ScrollSnapAlignValEnd,
+		ScrollSnapAlignValNone,
+		ScrollSnapAlignValStart:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapAlignVal returns the ScrollSnapAlignVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapAlignVal(s string) (ScrollSnapAlignVal, error) {
+	v := ScrollSnapAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapAlignVal constants.
+func (v ScrollSnapAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapAlignVal.
+func (v *ScrollSnapAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollSnapCoordinateVal represents values for the scroll-snap-coordinate property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | <position>#
+//
+// Deprecated: the scroll-snap-coordinate property is obsolete.
 type ScrollSnapCoordinateVal string
 
 // ScrollSnapCoordinateVal constants.
 const (
-	ScrollSnapCoordinateValBottom           ScrollSnapCoordinateVal = "bottom"
-	ScrollSnapCoordinateValCenter           ScrollSnapCoordinateVal = "center"
-	ScrollSnapCoordinateValLeft             ScrollSnapCoordinateVal = "left"
-	ScrollSnapCoordinateValLengthPercentage ScrollSnapCoordinateVal = "length-percentage"
-	ScrollSnapCoordinateValNone             ScrollSnapCoordinateVal = "none"
-	ScrollSnapCoordinateValRight            ScrollSnapCoordinateVal = "right"
-	ScrollSnapCoordinateValTop              ScrollSnapCoordinateVal = "top"
-)
+	ScrollSnapCoordinateValBottom ScrollSnapCoordinateVal = "bottom"
+	ScrollSnapCoordinateValCenter ScrollSnapCoordinateVal = "center"
+	ScrollSnapCoordinateValLeft   ScrollSnapCoordinateVal = "left"
+	ScrollSnapCoordinateValNone   ScrollSnapCoordinateVal = "none"
+	ScrollSnapCoordinateValRight  ScrollSnapCoordinateVal = "right"
+	ScrollSnapCoordinateValTop    ScrollSnapCoordinateVal = "top"
+)
+
+func (v ScrollSnapCoordinateVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapCoordinateVal constant.
+func (ScrollSnapCoordinateVal) Values() []ScrollSnapCoordinateVal {
+	return []ScrollSnapCoordinateVal{
+		ScrollSnapCoordinateValBottom,
+		ScrollSnapCoordinateValCenter,
+		ScrollSnapCoordinateValLeft,
+		ScrollSnapCoordinateValNone,
+		ScrollSnapCoordinateValRight,
+		ScrollSnapCoordinateValTop,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapCoordinateVal constants.
+func (v ScrollSnapCoordinateVal) Valid() bool {
+	switch v {
+	case ScrollSnapCoordinateValBottom,
+		ScrollSnapCoordinateValCenter,
+		ScrollSnapCoordinateValLeft,
+		ScrollSnapCoordinateValNone,
+		ScrollSnapCoordinateValRight,
+		ScrollSnapCoordinateValTop:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapCoordinateVal returns the ScrollSnapCoordinateVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapCoordinateVal(s string) (ScrollSnapCoordinateVal, error) {
+	v := ScrollSnapCoordinateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapCoordinateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapCoordinateVal constants.
+func (v ScrollSnapCoordinateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapCoordinateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapCoordinateVal.
+func (v *ScrollSnapCoordinateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapCoordinateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollSnapDestinationVal represents values for the scroll-snap-destination property.
+//
+//   - Initial: 0px 0px
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<position>
+//
+// Deprecated: the scroll-snap-destination property is obsolete.
 type ScrollSnapDestinationVal string
 
 // ScrollSnapDestinationVal constants.
 const (
-	ScrollSnapDestinationValBottom           ScrollSnapDestinationVal = "bottom"
-	ScrollSnapDestinationValCenter           ScrollSnapDestinationVal = "center"
-	ScrollSnapDestinationValLeft             ScrollSnapDestinationVal = "left"
-	ScrollSnapDestinationValLengthPercentage ScrollSnapDestinationVal = "length-percentage"
-	ScrollSnapDestinationValRight            ScrollSnapDestinationVal = "right"
-	ScrollSnapDestinationValTop              ScrollSnapDestinationVal = "top"
-)
+	ScrollSnapDestinationValBottom ScrollSnapDestinationVal = "bottom"
+	ScrollSnapDestinationValCenter ScrollSnapDestinationVal = "center"
+	ScrollSnapDestinationValLeft   ScrollSnapDestinationVal = "left"
+	ScrollSnapDestinationValRight  ScrollSnapDestinationVal = "right"
+	ScrollSnapDestinationValTop    ScrollSnapDestinationVal = "top"
+)
+
+func (v ScrollSnapDestinationVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapDestinationVal constant.
+func (ScrollSnapDestinationVal) Values() []ScrollSnapDestinationVal {
+	return []ScrollSnapDestinationVal{
+		ScrollSnapDestinationValBottom,
+		ScrollSnapDestinationValCenter,
+		ScrollSnapDestinationValLeft,
+		ScrollSnapDestinationValRight,
+		ScrollSnapDestinationValTop,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapDestinationVal constants.
+func (v ScrollSnapDestinationVal) Valid() bool {
+	switch v {
+	case ScrollSnapDestinationValBottom,
+		ScrollSnapDestinationValCenter,
+		ScrollSnapDestinationValLeft,
+		ScrollSnapDestinationValRight,
+		ScrollSnapDestinationValTop:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapDestinationVal returns the ScrollSnapDestinationVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapDestinationVal(s string) (ScrollSnapDestinationVal, error) {
+	v := ScrollSnapDestinationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapDestinationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapDestinationVal constants.
+func (v ScrollSnapDestinationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapDestinationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapDestinationVal.
+func (v *ScrollSnapDestinationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapDestinationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollSnapPointsXVal represents values for the scroll-snap-points-x property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | repeat( <length-percentage> )
+//
+// Deprecated: the scroll-snap-points-x property is obsolete.
 type ScrollSnapPointsXVal string
 
 // ScrollSnapPointsXVal constants.
 const (
-	ScrollSnapPointsXValNone   ScrollSnapPointsXVal = "none"
-	ScrollSnapPointsXValRepeat ScrollSnapPointsXVal = "repeat"
-)
+	ScrollSnapPointsXValNone ScrollSnapPointsXVal = "none"
+)
+
+func (v ScrollSnapPointsXVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapPointsXVal constant.
+func (ScrollSnapPointsXVal) Values() []ScrollSnapPointsXVal {
+	return []ScrollSnapPointsXVal{
+		ScrollSnapPointsXValNone,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapPointsXVal constants.
+func (v ScrollSnapPointsXVal) Valid() bool {
+	switch v {
+	case ScrollSnapPointsXValNone:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapPointsXVal returns the ScrollSnapPointsXVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapPointsXVal(s string) (ScrollSnapPointsXVal, error) {
+	v := ScrollSnapPointsXVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapPointsXVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapPointsXVal constants.
+func (v ScrollSnapPointsXVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapPointsXVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapPointsXVal.
+func (v *ScrollSnapPointsXVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapPointsXVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollSnapPointsYVal represents values for the scroll-snap-points-y property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | repeat( <length-percentage> )
+//
+// Deprecated: the scroll-snap-points-y property is obsolete.
 type ScrollSnapPointsYVal string
 
 // ScrollSnapPointsYVal constants.
 const (
-	ScrollSnapPointsYValNone   ScrollSnapPointsYVal = "none"
-	ScrollSnapPointsYValRepeat ScrollSnapPointsYVal = "repeat"
-)
+	ScrollSnapPointsYValNone ScrollSnapPointsYVal = "none"
+)
+
+func (v ScrollSnapPointsYVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapPointsYVal constant.
+func (ScrollSnapPointsYVal) Values() []ScrollSnapPointsYVal {
+	return []ScrollSnapPointsYVal{
+		ScrollSnapPointsYValNone,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapPointsYVal constants.
+func (v ScrollSnapPointsYVal) Valid() bool {
+	switch v {
+	case ScrollSnapPointsYValNone:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapPointsYVal returns the ScrollSnapPointsYVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapPointsYVal(s string) (ScrollSnapPointsYVal, error) {
+	v := ScrollSnapPointsYVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapPointsYVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapPointsYVal constants.
+func (v ScrollSnapPointsYVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapPointsYVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapPointsYVal.
+func (v *ScrollSnapPointsYVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapPointsYVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollSnapStopVal represents values for the scroll-snap-stop property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | always
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-stop
 type ScrollSnapStopVal string
 
 // ScrollSnapStopVal constants.
@@ -4201,7 +23865,65 @@ const (
 	ScrollSnapStopValNormal ScrollSnapStopVal = "normal"
 )
 
+func (v ScrollSnapStopVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapStopVal constant.
+func (ScrollSnapStopVal) Values() []ScrollSnapStopVal {
+	return []ScrollSnapStopVal{
+		ScrollSnapStopValAlways,
+		ScrollSnapStopValNormal,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapStopVal constants.
+func (v ScrollSnapStopVal) Valid() bool {
+	switch v {
+	case ScrollSnapStopValAlways,
+		ScrollSnapStopValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapStopVal returns the ScrollSnapStopVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapStopVal(s string) (ScrollSnapStopVal, error) {
+	v := ScrollSnapStopVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapStopVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapStopVal constants.
+func (v ScrollSnapStopVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapStopVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapStopVal.
+func (v *ScrollSnapStopVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapStopVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollSnapTypeVal represents values for the scroll-snap-type property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ x | y | block | inline | both ] [ mandatory | proximity ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-type
 type ScrollSnapTypeVal string
 
 // ScrollSnapTypeVal constants.
@@ -4212,9 +23934,81 @@ const (
 	ScrollSnapTypeValMandatory ScrollSnapTypeVal = "mandatory"
 	ScrollSnapTypeValNone      ScrollSnapTypeVal = "none"
 	ScrollSnapTypeValProximity ScrollSnapTypeVal = "proximity"
-)
+	ScrollSnapTypeValX         ScrollSnapTypeVal = "x"
+	ScrollSnapTypeValY         ScrollSnapTypeVal = "y"
+)
+
+func (v ScrollSnapTypeVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapTypeVal constant.
+func (ScrollSnapTypeVal) Values() []ScrollSnapTypeVal {
+	return []ScrollSnapTypeVal{
+		ScrollSnapTypeValBlock,
+		ScrollSnapTypeValBoth,
+		ScrollSnapTypeValInline,
+		ScrollSnapTypeValMandatory,
+		ScrollSnapTypeValNone,
+		ScrollSnapTypeValProximity,
+		ScrollSnapTypeValX,
+		ScrollSnapTypeValY,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapTypeVal constants.
+func (v ScrollSnapTypeVal) Valid() bool {
+	switch v {
+	case ScrollSnapTypeValBlock,
+		ScrollSnapTypeValBoth,
+		ScrollSnapTypeValInline,
+		ScrollSnapTypeValMandatory,
+		ScrollSnapTypeValNone,
+		ScrollSnapTypeValProximity,
+		ScrollSnapTypeValX,
+		ScrollSnapTypeValY:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapTypeVal returns the ScrollSnapTypeVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapTypeVal(s string) (ScrollSnapTypeVal, error) {
+	v := ScrollSnapTypeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapTypeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapTypeVal constants.
+func (v ScrollSnapTypeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapTypeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapTypeVal.
+func (v *ScrollSnapTypeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapTypeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollSnapTypeXVal represents values for the scroll-snap-type-x property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | mandatory | proximity
+//
+// Deprecated: the scroll-snap-type-x property is obsolete.
 type ScrollSnapTypeXVal string
 
 // ScrollSnapTypeXVal constants.
@@ -4224,7 +24018,67 @@ const (
 	ScrollSnapTypeXValProximity ScrollSnapTypeXVal = "proximity"
 )
 
+func (v ScrollSnapTypeXVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapTypeXVal constant.
+func (ScrollSnapTypeXVal) Values() []ScrollSnapTypeXVal {
+	return []ScrollSnapTypeXVal{
+		ScrollSnapTypeXValMandatory,
+		ScrollSnapTypeXValNone,
+		ScrollSnapTypeXValProximity,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapTypeXVal constants.
+func (v ScrollSnapTypeXVal) Valid() bool {
+	switch v {
+	case ScrollSnapTypeXValMandatory,
+		ScrollSnapTypeXValNone,
+		ScrollSnapTypeXValProximity:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapTypeXVal returns the ScrollSnapTypeXVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapTypeXVal(s string) (ScrollSnapTypeXVal, error) {
+	v := ScrollSnapTypeXVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapTypeXVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapTypeXVal constants.
+func (v ScrollSnapTypeXVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapTypeXVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapTypeXVal.
+func (v *ScrollSnapTypeXVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapTypeXVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollSnapTypeYVal represents values for the scroll-snap-type-y property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | mandatory | proximity
+//
+// Deprecated: the scroll-snap-type-y property is obsolete.
 type ScrollSnapTypeYVal string
 
 // ScrollSnapTypeYVal constants.
@@ -4234,20 +24088,131 @@ const (
 	ScrollSnapTypeYValProximity ScrollSnapTypeYVal = "proximity"
 )
 
+func (v ScrollSnapTypeYVal) String() string { return string(v) }
+
+// Values returns every ScrollSnapTypeYVal constant.
+func (ScrollSnapTypeYVal) Values() []ScrollSnapTypeYVal {
+	return []ScrollSnapTypeYVal{
+		ScrollSnapTypeYValMandatory,
+		ScrollSnapTypeYValNone,
+		ScrollSnapTypeYValProximity,
+	}
+}
+
+// Valid reports whether v is one of the ScrollSnapTypeYVal constants.
+func (v ScrollSnapTypeYVal) Valid() bool {
+	switch v {
+	case ScrollSnapTypeYValMandatory,
+		ScrollSnapTypeYValNone,
+		ScrollSnapTypeYValProximity:
+		return true
+	}
+	return false
+}
+
+// ParseScrollSnapTypeYVal returns the ScrollSnapTypeYVal constant for a keyword, ignoring ASCII case.
+func ParseScrollSnapTypeYVal(s string) (ScrollSnapTypeYVal, error) {
+	v := ScrollSnapTypeYVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollSnapTypeYVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollSnapTypeYVal constants.
+func (v ScrollSnapTypeYVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollSnapTypeYVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollSnapTypeYVal.
+func (v *ScrollSnapTypeYVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollSnapTypeYVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollbarColorVal represents values for the scrollbar-color property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>{2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-color
 type ScrollbarColorVal string
 
 // ScrollbarColorVal constants.
 const (
-	ScrollbarColorVal2                     ScrollbarColorVal = "2"
-	ScrollbarColorValAuto                  ScrollbarColorVal = "auto"
-	ScrollbarColorValColorBase             ScrollbarColorVal = "color-base"
-	ScrollbarColorValDeprecatedSystemColor ScrollbarColorVal = "deprecated-system-color"
-	ScrollbarColorValLightDark             ScrollbarColorVal = "light-dark"
-	ScrollbarColorValSystemColor           ScrollbarColorVal = "system-color"
-)
+	ScrollbarColorValAuto ScrollbarColorVal = "auto"
+)
+
+func (v ScrollbarColorVal) String() string { return string(v) }
+
+// Values returns every ScrollbarColorVal constant.
+func (ScrollbarColorVal) Values() []ScrollbarColorVal {
+	return []ScrollbarColorVal{
+		ScrollbarColorValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ScrollbarColorVal constants.
+func (v ScrollbarColorVal) Valid() bool {
+	switch v {
+	case ScrollbarColorValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseScrollbarColorVal returns the ScrollbarColorVal constant for a keyword, ignoring ASCII case.
+func ParseScrollbarColorVal(s string) (ScrollbarColorVal, error) {
+	v := ScrollbarColorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollbarColorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollbarColorVal constants.
+func (v ScrollbarColorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollbarColorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollbarColorVal.
+func (v *ScrollbarColorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollbarColorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ScrollbarGutterVal represents values for the scrollbar-gutter property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | stable && both-edges?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-gutter
 type ScrollbarGutterVal string
 
 // ScrollbarGutterVal constants.
@@ -4257,7 +24222,67 @@ const (
 	ScrollbarGutterValStable    ScrollbarGutterVal = "stable"
 )
 
+func (v ScrollbarGutterVal) String() string { return string(v) }
+
+// Values returns every ScrollbarGutterVal constant.
+func (ScrollbarGutterVal) Values() []ScrollbarGutterVal {
+	return []ScrollbarGutterVal{
+		ScrollbarGutterValAuto,
+		ScrollbarGutterValBothEdges,
+		ScrollbarGutterValStable,
+	}
+}
+
+// Valid reports whether v is one of the ScrollbarGutterVal constants.
+func (v ScrollbarGutterVal) Valid() bool {
+	switch v {
+	case ScrollbarGutterValAuto,
+		ScrollbarGutterValBothEdges,
+		ScrollbarGutterValStable:
+		return true
+	}
+	return false
+}
+
+// ParseScrollbarGutterVal returns the ScrollbarGutterVal constant for a keyword, ignoring ASCII case.
+func ParseScrollbarGutterVal(s string) (ScrollbarGutterVal, error) {
+	v := ScrollbarGutterVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollbarGutterVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollbarGutterVal constants.
+func (v ScrollbarGutterVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollbarGutterVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollbarGutterVal.
+func (v *ScrollbarGutterVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollbarGutterVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ScrollbarWidthVal represents values for the scrollbar-width property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | thin | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-width
 type ScrollbarWidthVal string
 
 // ScrollbarWidthVal constants.
@@ -4267,45 +24292,216 @@ const (
 	ScrollbarWidthValThin ScrollbarWidthVal = "thin"
 )
 
+func (v ScrollbarWidthVal) String() string { return string(v) }
+
+// Values returns every ScrollbarWidthVal constant.
+func (ScrollbarWidthVal) Values() []ScrollbarWidthVal {
+	return []ScrollbarWidthVal{
+		ScrollbarWidthValAuto,
+		ScrollbarWidthValNone,
+		ScrollbarWidthValThin,
+	}
+}
+
+// Valid reports whether v is one of the ScrollbarWidthVal constants.
+func (v ScrollbarWidthVal) Valid() bool {
+	switch v {
+	case ScrollbarWidthValAuto,
+		ScrollbarWidthValNone,
+		ScrollbarWidthValThin:
+		return true
+	}
+	return false
+}
+
+// ParseScrollbarWidthVal returns the ScrollbarWidthVal constant for a keyword, ignoring ASCII case.
+func ParseScrollbarWidthVal(s string) (ScrollbarWidthVal, error) {
+	v := ScrollbarWidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ScrollbarWidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ScrollbarWidthVal constants.
+func (v ScrollbarWidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ScrollbarWidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseScrollbarWidthVal.
+func (v *ScrollbarWidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseScrollbarWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ShapeOutsideVal represents values for the shape-outside property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <shape-box> || <basic-shape> ] | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-outside
 type ShapeOutsideVal string
 
 // ShapeOutsideVal constants.
 const (
-	ShapeOutsideValCircle    ShapeOutsideVal = "circle"
-	ShapeOutsideValCrossFade ShapeOutsideVal = "cross-fade"
-	ShapeOutsideValElement   ShapeOutsideVal = "element"
-	ShapeOutsideValEllipse   ShapeOutsideVal = "ellipse"
-	ShapeOutsideValGradient  ShapeOutsideVal = "gradient"
-	ShapeOutsideValImageSet  ShapeOutsideVal = "image-set"
-	ShapeOutsideValInset     ShapeOutsideVal = "inset"
-	ShapeOutsideValMarginBox ShapeOutsideVal = "margin-box"
-	ShapeOutsideValNone      ShapeOutsideVal = "none"
-	ShapeOutsideValPaint     ShapeOutsideVal = "paint"
-	ShapeOutsideValPath      ShapeOutsideVal = "path"
-	ShapeOutsideValPolygon   ShapeOutsideVal = "polygon"
-	ShapeOutsideValRect      ShapeOutsideVal = "rect"
-	ShapeOutsideValVisualBox ShapeOutsideVal = "visual-box"
-	ShapeOutsideValXywh      ShapeOutsideVal = "xywh"
-)
+	ShapeOutsideValBorderBox  ShapeOutsideVal = "border-box"
+	ShapeOutsideValContentBox ShapeOutsideVal = "content-box"
+	ShapeOutsideValMarginBox  ShapeOutsideVal = "margin-box"
+	ShapeOutsideValNone       ShapeOutsideVal = "none"
+	ShapeOutsideValPaddingBox ShapeOutsideVal = "padding-box"
+)
+
+func (v ShapeOutsideVal) String() string { return string(v) }
+
+// Values returns every ShapeOutsideVal constant.
+func (ShapeOutsideVal) Values() []ShapeOutsideVal {
+	return []ShapeOutsideVal{
+		ShapeOutsideValBorderBox,
+		ShapeOutsideValContentBox,
+		ShapeOutsideValMarginBox,
+		ShapeOutsideValNone,
+		ShapeOutsideValPaddingBox,
+	}
+}
+
+// Valid reports whether v is one of the ShapeOutsideVal constants.
+func (v ShapeOutsideVal) Valid() bool {
+	switch v {
+	case ShapeOutsideValBorderBox,
+		ShapeOutsideValContentBox,
+		ShapeOutsideValMarginBox,
+		ShapeOutsideValNone,
+		ShapeOutsideValPaddingBox:
+		return true
+	}
+	return false
+}
+
+// ParseShapeOutsideVal returns the ShapeOutsideVal constant for a keyword, ignoring ASCII case.
+func ParseShapeOutsideVal(s string) (ShapeOutsideVal, error) {
+	v := ShapeOutsideVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ShapeOutsideVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ShapeOutsideVal constants.
+func (v ShapeOutsideVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ShapeOutsideVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseShapeOutsideVal.
+func (v *ShapeOutsideVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseShapeOutsideVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ShapeRenderingVal represents values for the shape-rendering property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | optimizeSpeed | crispEdges | geometricPrecision
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-rendering
 type ShapeRenderingVal string
 
 // ShapeRenderingVal constants.
 const (
-	ShapeRenderingValAuto ShapeRenderingVal = "auto"
-)
-
-// StopOpacityVal represents values for the stop-opacity property.
-type StopOpacityVal string
-
-// StopOpacityVal constants.
-const (
-	StopOpacityValOpacity StopOpacityVal = "opacity"
-)
+	ShapeRenderingValAuto               ShapeRenderingVal = "auto"
+	ShapeRenderingValCrispedges         ShapeRenderingVal = "crispedges"
+	ShapeRenderingValGeometricprecision ShapeRenderingVal = "geometricprecision"
+	ShapeRenderingValOptimizespeed      ShapeRenderingVal = "optimizespeed"
+)
+
+func (v ShapeRenderingVal) String() string { return string(v) }
+
+// Values returns every ShapeRenderingVal constant.
+func (ShapeRenderingVal) Values() []ShapeRenderingVal {
+	return []ShapeRenderingVal{
+		ShapeRenderingValAuto,
+		ShapeRenderingValCrispedges,
+		ShapeRenderingValGeometricprecision,
+		ShapeRenderingValOptimizespeed,
+	}
+}
+
+// Valid reports whether v is one of the ShapeRenderingVal constants.
+func (v ShapeRenderingVal) Valid() bool {
+	switch v {
+	case ShapeRenderingValAuto,
+		ShapeRenderingValCrispedges,
+		ShapeRenderingValGeometricprecision,
+		ShapeRenderingValOptimizespeed:
+		return true
+	}
+	return false
+}
+
+// ParseShapeRenderingVal returns the ShapeRenderingVal constant for a keyword, ignoring ASCII case.
+func ParseShapeRenderingVal(s string) (ShapeRenderingVal, error) {
+	v := ShapeRenderingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ShapeRenderingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ShapeRenderingVal constants.
+func (v ShapeRenderingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ShapeRenderingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseShapeRenderingVal.
+func (v *ShapeRenderingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseShapeRenderingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // StrokeVal represents values for the stroke property.
+//
+//   - Shorthand for: stroke-dasharray, stroke-dashoffset, stroke-linecap, stroke-linejoin, stroke-miterlimit, stroke-opacity, stroke-width
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<paint>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke
 type StrokeVal string
 
 // StrokeVal constants.
@@ -4315,16 +24511,131 @@ const (
 	StrokeValNone          StrokeVal = "none"
 )
 
+func (v StrokeVal) String() string { return string(v) }
+
+// Values returns every StrokeVal constant.
+func (StrokeVal) Values() []StrokeVal {
+	return []StrokeVal{
+		StrokeValContextFill,
+		StrokeValContextStroke,
+		StrokeValNone,
+	}
+}
+
+// Valid reports whether v is one of the StrokeVal constants.
+func (v StrokeVal) Valid() bool {
+	switch v {
+	case StrokeValContextFill,
+		StrokeValContextStroke,
+		StrokeValNone:
+		return true
+	}
+	return false
+}
+
+// ParseStrokeVal returns the StrokeVal constant for a keyword, ignoring ASCII case.
+func ParseStrokeVal(s string) (StrokeVal, error) {
+	v := StrokeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "StrokeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not StrokeVal constants.
+func (v StrokeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "StrokeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseStrokeVal.
+func (v *StrokeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseStrokeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // StrokeDasharrayVal represents values for the stroke-dasharray property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <dasharray>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-dasharray
 type StrokeDasharrayVal string
 
 // StrokeDasharrayVal constants.
 const (
-	StrokeDasharrayValLengthPercentage StrokeDasharrayVal = "length-percentage"
-	StrokeDasharrayValNone             StrokeDasharrayVal = "none"
-)
+	StrokeDasharrayValNone StrokeDasharrayVal = "none"
+)
+
+func (v StrokeDasharrayVal) String() string { return string(v) }
+
+// Values returns every StrokeDasharrayVal constant.
+func (StrokeDasharrayVal) Values() []StrokeDasharrayVal {
+	return []StrokeDasharrayVal{
+		StrokeDasharrayValNone,
+	}
+}
+
+// Valid reports whether v is one of the StrokeDasharrayVal constants.
+func (v StrokeDasharrayVal) Valid() bool {
+	switch v {
+	case StrokeDasharrayValNone:
+		return true
+	}
+	return false
+}
+
+// ParseStrokeDasharrayVal returns the StrokeDasharrayVal constant for a keyword, ignoring ASCII case.
+func ParseStrokeDasharrayVal(s string) (StrokeDasharrayVal, error) {
+	v := StrokeDasharrayVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "StrokeDasharrayVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not StrokeDasharrayVal constants.
+func (v StrokeDasharrayVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "StrokeDasharrayVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseStrokeDasharrayVal.
+func (v *StrokeDasharrayVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseStrokeDasharrayVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // StrokeLinecapVal represents values for the stroke-linecap property.
+//
+//   - Initial: butt
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	butt | round | square
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linecap
 type StrokeLinecapVal string
 
 // StrokeLinecapVal constants.
@@ -4334,7 +24645,67 @@ const (
 	StrokeLinecapValSquare StrokeLinecapVal = "square"
 )
 
+func (v StrokeLinecapVal) String() string { return string(v) }
+
+// Values returns every StrokeLinecapVal constant.
+func (StrokeLinecapVal) Values() []StrokeLinecapVal {
+	return []StrokeLinecapVal{
+		StrokeLinecapValButt,
+		StrokeLinecapValRound,
+		StrokeLinecapValSquare,
+	}
+}
+
+// Valid reports whether v is one of the StrokeLinecapVal constants.
+func (v StrokeLinecapVal) Valid() bool {
+	switch v {
+	case StrokeLinecapValButt,
+		StrokeLinecapValRound,
+		StrokeLinecapValSquare:
+		return true
+	}
+	return false
+}
+
+// ParseStrokeLinecapVal returns the StrokeLinecapVal constant for a keyword, ignoring ASCII case.
+func ParseStrokeLinecapVal(s string) (StrokeLinecapVal, error) {
+	v := StrokeLinecapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "StrokeLinecapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not StrokeLinecapVal constants.
+func (v StrokeLinecapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "StrokeLinecapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseStrokeLinecapVal.
+func (v *StrokeLinecapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseStrokeLinecapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // StrokeLinejoinVal represents values for the stroke-linejoin property.
+//
+//   - Initial: miter
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	miter | miter-clip | round | bevel | arcs
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linejoin
 type StrokeLinejoinVal string
 
 // StrokeLinejoinVal constants.
@@ -4346,23 +24717,71 @@ const (
 	StrokeLinejoinValRound     StrokeLinejoinVal = "round"
 )
 
-// StrokeOpacityVal represents values for the stroke-opacity property.
-type StrokeOpacityVal string
-
-// StrokeOpacityVal constants.
-const (
-	StrokeOpacityValOpacity StrokeOpacityVal = "opacity"
-)
-
-// TabSizeVal represents values for the tab-size property.
-type TabSizeVal string
-
-// TabSizeVal constants.
-const (
-	TabSizeValNumberToken TabSizeVal = "number-token"
-)
+func (v StrokeLinejoinVal) String() string { return string(v) }
+
+// Values returns every StrokeLinejoinVal constant.
+func (StrokeLinejoinVal) Values() []StrokeLinejoinVal {
+	return []StrokeLinejoinVal{
+		StrokeLinejoinValArcs,
+		StrokeLinejoinValBevel,
+		StrokeLinejoinValMiter,
+		StrokeLinejoinValMiterClip,
+		StrokeLinejoinValRound,
+	}
+}
+
+// Valid reports whether v is one of the StrokeLinejoinVal constants.
+func (v StrokeLinejoinVal) Valid() bool {
+	switch v {
+	case StrokeLinejoinValArcs,
+		StrokeLinejoinValBevel,
+		StrokeLinejoinValMiter,
+		StrokeLinejoinValMiterClip,
+		StrokeLinejoinValRound:
+		return true
+	}
+	return false
+}
+
+// ParseStrokeLinejoinVal returns the StrokeLinejoinVal constant for a keyword, ignoring ASCII case.
+func ParseStrokeLinejoinVal(s string) (StrokeLinejoinVal, error) {
+	v := StrokeLinejoinVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "StrokeLinejoinVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not StrokeLinejoinVal constants.
+func (v StrokeLinejoinVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "StrokeLinejoinVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseStrokeLinejoinVal.
+func (v *StrokeLinejoinVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseStrokeLinejoinVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TableLayoutVal represents values for the table-layout property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/table-layout
 type TableLayoutVal string
 
 // TableLayoutVal constants.
@@ -4371,7 +24790,65 @@ const (
 	TableLayoutValFixed TableLayoutVal = "fixed"
 )
 
+func (v TableLayoutVal) String() string { return string(v) }
+
+// Values returns every TableLayoutVal constant.
+func (TableLayoutVal) Values() []TableLayoutVal {
+	return []TableLayoutVal{
+		TableLayoutValAuto,
+		TableLayoutValFixed,
+	}
+}
+
+// Valid reports whether v is one of the TableLayoutVal constants.
+func (v TableLayoutVal) Valid() bool {
+	switch v {
+	case TableLayoutValAuto,
+		TableLayoutValFixed:
+		return true
+	}
+	return false
+}
+
+// ParseTableLayoutVal returns the TableLayoutVal constant for a keyword, ignoring ASCII case.
+func ParseTableLayoutVal(s string) (TableLayoutVal, error) {
+	v := TableLayoutVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TableLayoutVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TableLayoutVal constants.
+func (v TableLayoutVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TableLayoutVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTableLayoutVal.
+func (v *TableLayoutVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTableLayoutVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextAlignVal represents values for the text-align property.
+//
+//   - Initial: startOrNamelessValueIfLTRRightIfRTL
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | end | left | right | center | justify | match-parent
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align
 type TextAlignVal string
 
 // TextAlignVal constants.
@@ -4385,7 +24862,75 @@ const (
 	TextAlignValStart       TextAlignVal = "start"
 )
 
+func (v TextAlignVal) String() string { return string(v) }
+
+// Values returns every TextAlignVal constant.
+func (TextAlignVal) Values() []TextAlignVal {
+	return []TextAlignVal{
+		TextAlignValCenter,
+		TextAlignValEnd,
+		TextAlignValJustify,
+		TextAlignValLeft,
+		TextAlignValMatchParent,
+		TextAlignValRight,
+		TextAlignValStart,
+	}
+}
+
+// Valid reports whether v is one of the TextAlignVal constants.
+func (v TextAlignVal) Valid() bool {
+	switch v {
+	case TextAlignValCenter,
+		TextAlignValEnd,
+		TextAlignValJustify,
+		TextAlignValLeft,
+		TextAlignValMatchParent,
+		TextAlignValRight,
+		TextAlignValStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextAlignVal returns the TextAlignVal constant for a keyword, ignoring ASCII case.
+func ParseTextAlignVal(s string) (TextAlignVal, error) {
+	v := TextAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextAlignVal constants.
+func (v TextAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextAlignVal.
+func (v *TextAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextAlignLastVal represents values for the text-align-last property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | start | end | left | right | center | justify
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align-last
 type TextAlignLastVal string
 
 // TextAlignLastVal constants.
@@ -4399,7 +24944,75 @@ const (
 	TextAlignLastValStart   TextAlignLastVal = "start"
 )
 
+func (v TextAlignLastVal) String() string { return string(v) }
+
+// Values returns every TextAlignLastVal constant.
+func (TextAlignLastVal) Values() []TextAlignLastVal {
+	return []TextAlignLastVal{
+		TextAlignLastValAuto,
+		TextAlignLastValCenter,
+		TextAlignLastValEnd,
+		TextAlignLastValJustify,
+		TextAlignLastValLeft,
+		TextAlignLastValRight,
+		TextAlignLastValStart,
+	}
+}
+
+// Valid reports whether v is one of the TextAlignLastVal constants.
+func (v TextAlignLastVal) Valid() bool {
+	switch v {
+	case TextAlignLastValAuto,
+		TextAlignLastValCenter,
+		TextAlignLastValEnd,
+		TextAlignLastValJustify,
+		TextAlignLastValLeft,
+		TextAlignLastValRight,
+		TextAlignLastValStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextAlignLastVal returns the TextAlignLastVal constant for a keyword, ignoring ASCII case.
+func ParseTextAlignLastVal(s string) (TextAlignLastVal, error) {
+	v := TextAlignLastVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextAlignLastVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextAlignLastVal constants.
+func (v TextAlignLastVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextAlignLastVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextAlignLastVal.
+func (v *TextAlignLastVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextAlignLastVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextAnchorVal represents values for the text-anchor property.
+//
+//   - Initial: start
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | middle | end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-anchor
 type TextAnchorVal string
 
 // TextAnchorVal constants.
@@ -4409,27 +25022,227 @@ const (
 	TextAnchorValStart  TextAnchorVal = "start"
 )
 
+func (v TextAnchorVal) String() string { return string(v) }
+
+// Values returns every TextAnchorVal constant.
+func (TextAnchorVal) Values() []TextAnchorVal {
+	return []TextAnchorVal{
+		TextAnchorValEnd,
+		TextAnchorValMiddle,
+		TextAnchorValStart,
+	}
+}
+
+// Valid reports whether v is one of the TextAnchorVal constants.
+func (v TextAnchorVal) Valid() bool {
+	switch v {
+	case TextAnchorValEnd,
+		TextAnchorValMiddle,
+		TextAnchorValStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextAnchorVal returns the TextAnchorVal constant for a keyword, ignoring ASCII case.
+func ParseTextAnchorVal(s string) (TextAnchorVal, error) {
+	v := TextAnchorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextAnchorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextAnchorVal constants.
+func (v TextAnchorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextAnchorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextAnchorVal.
+func (v *TextAnchorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextAnchorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextAutospaceVal represents values for the text-autospace property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <autospace> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-autospace
 type TextAutospaceVal string
 
 // TextAutospaceVal constants.
 const (
-	TextAutospaceValAuto      TextAutospaceVal = "auto"
-	TextAutospaceValAutospace TextAutospaceVal = "autospace"
-	TextAutospaceValNormal    TextAutospaceVal = "normal"
-)
+	TextAutospaceValAuto   TextAutospaceVal = "auto"
+	TextAutospaceValNormal TextAutospaceVal = "normal"
+)
+
+func (v TextAutospaceVal) String() string { return string(v) }
+
+// Values returns every TextAutospaceVal constant.
+func (TextAutospaceVal) Values() []TextAutospaceVal {
+	return []TextAutospaceVal{
+		TextAutospaceValAuto,
+		TextAutospaceValNormal,
+	}
+}
+
+// Valid reports whether v is one of the TextAutospaceVal constants.
+func (v TextAutospaceVal) Valid() bool {
+	switch v {
+	case TextAutospaceValAuto,
+		TextAutospaceValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseTextAutospaceVal returns the TextAutospaceVal constant for a keyword, ignoring ASCII case.
+func ParseTextAutospaceVal(s string) (TextAutospaceVal, error) {
+	v := TextAutospaceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextAutospaceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextAutospaceVal constants.
+func (v TextAutospaceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextAutospaceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextAutospaceVal.
+func (v *TextAutospaceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextAutospaceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextBoxVal represents values for the text-box property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <'text-box-trim'> || <'text-box-edge'>
 type TextBoxVal string
 
 // TextBoxVal constants.
 const (
-	TextBoxValNormal      TextBoxVal = "normal"
-	TextBoxValTextBoxEdge TextBoxVal = "text-box-edge"
-	TextBoxValTextBoxTrim TextBoxVal = "text-box-trim"
-)
+	TextBoxValAlphabetic     TextBoxVal = "alphabetic"
+	TextBoxValAuto           TextBoxVal = "auto"
+	TextBoxValCap            TextBoxVal = "cap"
+	TextBoxValEx             TextBoxVal = "ex"
+	TextBoxValIdeographic    TextBoxVal = "ideographic"
+	TextBoxValIdeographicInk TextBoxVal = "ideographic-ink"
+	TextBoxValNone           TextBoxVal = "none"
+	TextBoxValNormal         TextBoxVal = "normal"
+	TextBoxValText           TextBoxVal = "text"
+	TextBoxValTrimBoth       TextBoxVal = "trim-both"
+	TextBoxValTrimEnd        TextBoxVal = "trim-end"
+	TextBoxValTrimStart      TextBoxVal = "trim-start"
+)
+
+func (v TextBoxVal) String() string { return string(v) }
+
+// Values returns every TextBoxVal constant.
+func (TextBoxVal) Values() []TextBoxVal {
+	return []TextBoxVal{
+		TextBoxValAlphabetic,
+		TextBoxValAuto,
+		TextBoxValCap,
+		TextBoxValEx,
+		TextBoxValIdeographic,
+		TextBoxValIdeographicInk,
+		TextBoxValNone,
+		TextBoxValNormal,
+		TextBoxValText,
+		TextBoxValTrimBoth,
+		TextBoxValTrimEnd,
+		TextBoxValTrimStart,
+	}
+}
+
+// Valid reports whether v is one of the TextBoxVal constants.
+func (v TextBoxVal) Valid() bool {
+	switch v {
+	case TextBoxValAlphabetic,
+		TextBoxValAuto,
+		TextBoxValCap,
+		TextBoxValEx,
+		TextBoxValIdeographic,
+		TextBoxValIdeographicInk,
+		TextBoxValNone,
+		TextBoxValNormal,
+		TextBoxValText,
+		TextBoxValTrimBoth,
+		TextBoxValTrimEnd,
+		TextBoxValTrimStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextBoxVal returns the TextBoxVal constant for a keyword, ignoring ASCII case.
+func ParseTextBoxVal(s string) (TextBoxVal, error) {
+	v := TextBoxVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextBoxVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextBoxVal constants.
+func (v TextBoxVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextBoxVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextBoxVal.
+func (v *TextBoxVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextBoxVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextBoxEdgeVal represents values for the text-box-edge property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <text-edge>
 type TextBoxEdgeVal string
 
 // TextBoxEdgeVal constants.
@@ -4443,7 +25256,73 @@ const (
 	TextBoxEdgeValText           TextBoxEdgeVal = "text"
 )
 
+func (v TextBoxEdgeVal) String() string { return string(v) }
+
+// Values returns every TextBoxEdgeVal constant.
+func (TextBoxEdgeVal) Values() []TextBoxEdgeVal {
+	return []TextBoxEdgeVal{
+		TextBoxEdgeValAlphabetic,
+		TextBoxEdgeValAuto,
+		TextBoxEdgeValCap,
+		TextBoxEdgeValEx,
+		TextBoxEdgeValIdeographic,
+		TextBoxEdgeValIdeographicInk,
+		TextBoxEdgeValText,
+	}
+}
+
+// Valid reports whether v is one of the TextBoxEdgeVal constants.
+func (v TextBoxEdgeVal) Valid() bool {
+	switch v {
+	case TextBoxEdgeValAlphabetic,
+		TextBoxEdgeValAuto,
+		TextBoxEdgeValCap,
+		TextBoxEdgeValEx,
+		TextBoxEdgeValIdeographic,
+		TextBoxEdgeValIdeographicInk,
+		TextBoxEdgeValText:
+		return true
+	}
+	return false
+}
+
+// ParseTextBoxEdgeVal returns the TextBoxEdgeVal constant for a keyword, ignoring ASCII case.
+func ParseTextBoxEdgeVal(s string) (TextBoxEdgeVal, error) {
+	v := TextBoxEdgeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextBoxEdgeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextBoxEdgeVal constants.
+func (v TextBoxEdgeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextBoxEdgeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextBoxEdgeVal.
+func (v *TextBoxEdgeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextBoxEdgeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextBoxTrimVal represents values for the text-box-trim property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | trim-start | trim-end | trim-both
 type TextBoxTrimVal string
 
 // TextBoxTrimVal constants.
@@ -4454,39 +25333,242 @@ const (
 	TextBoxTrimValTrimStart TextBoxTrimVal = "trim-start"
 )
 
+func (v TextBoxTrimVal) String() string { return string(v) }
+
+// Values returns every TextBoxTrimVal constant.
+func (TextBoxTrimVal) Values() []TextBoxTrimVal {
+	return []TextBoxTrimVal{
+		TextBoxTrimValNone,
+		TextBoxTrimValTrimBoth,
+		TextBoxTrimValTrimEnd,
+		TextBoxTrimValTrimStart,
+	}
+}
+
+// Valid reports whether v is one of the TextBoxTrimVal constants.
+func (v TextBoxTrimVal) Valid() bool {
+	switch v {
+	case TextBoxTrimValNone,
+		TextBoxTrimValTrimBoth,
+		TextBoxTrimValTrimEnd,
+		TextBoxTrimValTrimStart:
+		return true
+	}
+	return false
+}
+
+// ParseTextBoxTrimVal returns the TextBoxTrimVal constant for a keyword, ignoring ASCII case.
+func ParseTextBoxTrimVal(s string) (TextBoxTrimVal, error) {
+	v := TextBoxTrimVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextBoxTrimVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextBoxTrimVal constants.
+func (v TextBoxTrimVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextBoxTrimVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextBoxTrimVal.
+func (v *TextBoxTrimVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextBoxTrimVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextCombineUprightVal represents values for the text-combine-upright property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | all | [ digits <integer>? ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-combine-upright
 type TextCombineUprightVal string
 
 // TextCombineUprightVal constants.
 const (
-	TextCombineUprightValDigits      TextCombineUprightVal = "digits"
-	TextCombineUprightValNone        TextCombineUprightVal = "none"
-	TextCombineUprightValNumberToken TextCombineUprightVal = "number-token"
-)
+	TextCombineUprightValAll    TextCombineUprightVal = "all"
+	TextCombineUprightValDigits TextCombineUprightVal = "digits"
+	TextCombineUprightValNone   TextCombineUprightVal = "none"
+)
+
+func (v TextCombineUprightVal) String() string { return string(v) }
+
+// Values returns every TextCombineUprightVal constant.
+func (TextCombineUprightVal) Values() []TextCombineUprightVal {
+	return []TextCombineUprightVal{
+		TextCombineUprightValAll,
+		TextCombineUprightValDigits,
+		TextCombineUprightValNone,
+	}
+}
+
+// Valid reports whether v is one of the TextCombineUprightVal constants.
+func (v TextCombineUprightVal) Valid() bool {
+	switch v {
+	case TextCombineUprightValAll,
+		TextCombineUprightValDigits,
+		TextCombineUprightValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTextCombineUprightVal returns the TextCombineUprightVal constant for a keyword, ignoring ASCII case.
+func ParseTextCombineUprightVal(s string) (TextCombineUprightVal, error) {
+	v := TextCombineUprightVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextCombineUprightVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextCombineUprightVal constants.
+func (v TextCombineUprightVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextCombineUprightVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextCombineUprightVal.
+func (v *TextCombineUprightVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextCombineUprightVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextDecorationVal represents values for the text-decoration property.
+//
+//   - Shorthand for: text-decoration-color, text-decoration-line, text-decoration-style
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration
 type TextDecorationVal string
 
 // TextDecorationVal constants.
 const (
-	TextDecorationValTextDecorationColor     TextDecorationVal = "text-decoration-color"
-	TextDecorationValTextDecorationLine      TextDecorationVal = "text-decoration-line"
-	TextDecorationValTextDecorationStyle     TextDecorationVal = "text-decoration-style"
-	TextDecorationValTextDecorationThickness TextDecorationVal = "text-decoration-thickness"
-)
-
-// TextDecorationColorVal represents values for the text-decoration-color property.
-type TextDecorationColorVal string
-
-// TextDecorationColorVal constants.
-const (
-	TextDecorationColorValColorBase             TextDecorationColorVal = "color-base"
-	TextDecorationColorValDeprecatedSystemColor TextDecorationColorVal = "deprecated-system-color"
-	TextDecorationColorValLightDark             TextDecorationColorVal = "light-dark"
-	TextDecorationColorValSystemColor           TextDecorationColorVal = "system-color"
-)
+	TextDecorationValAuto          TextDecorationVal = "auto"
+	TextDecorationValBlink         TextDecorationVal = "blink"
+	TextDecorationValDashed        TextDecorationVal = "dashed"
+	TextDecorationValDotted        TextDecorationVal = "dotted"
+	TextDecorationValDouble        TextDecorationVal = "double"
+	TextDecorationValFromFont      TextDecorationVal = "from-font"
+	TextDecorationValGrammarError  TextDecorationVal = "grammar-error"
+	TextDecorationValLineThrough   TextDecorationVal = "line-through"
+	TextDecorationValNone          TextDecorationVal = "none"
+	TextDecorationValOverline      TextDecorationVal = "overline"
+	TextDecorationValSolid         TextDecorationVal = "solid"
+	TextDecorationValSpellingError TextDecorationVal = "spelling-error"
+	TextDecorationValUnderline     TextDecorationVal = "underline"
+	TextDecorationValWavy          TextDecorationVal = "wavy"
+)
+
+func (v TextDecorationVal) String() string { return string(v) }
+
+// Values returns every TextDecorationVal constant.
+func (TextDecorationVal) Values() []TextDecorationVal {
+	return []TextDecorationVal{
+		TextDecorationValAuto,
+		TextDecorationValBlink,
+		TextDecorationValDashed,
+		TextDecorationValDotted,
+		TextDecorationValDouble,
+		TextDecorationValFromFont,
+		TextDecorationValGrammarError,
+		TextDecorationValLineThrough,
+		TextDecorationValNone,
+		TextDecorationValOverline,
+		TextDecorationValSolid,
+		TextDecorationValSpellingError,
+		TextDecorationValUnderline,
+		TextDecorationValWavy,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationVal constants.
+func (v TextDecorationVal) Valid() bool {
+	switch v {
+	case TextDecorationValAuto,
+		TextDecorationValBlink,
+		TextDecorationValDashed,
+		TextDecorationValDotted,
+		TextDecorationValDouble,
+		TextDecorationValFromFont,
+		TextDecorationValGrammarError,
+		TextDecorationValLineThrough,
+		TextDecorationValNone,
+		TextDecorationValOverline,
+		TextDecorationValSolid,
+		TextDecorationValSpellingError,
+		TextDecorationValUnderline,
+		TextDecorationValWavy:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationVal returns the TextDecorationVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationVal(s string) (TextDecorationVal, error) {
+	v := TextDecorationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationVal constants.
+func (v TextDecorationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationVal.
+func (v *TextDecorationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextDecorationLineVal represents values for the text-decoration-line property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-line
 type TextDecorationLineVal string
 
 // TextDecorationLineVal constants.
@@ -4500,16 +25582,145 @@ const (
 	TextDecorationLineValUnderline     TextDecorationLineVal = "underline"
 )
 
+func (v TextDecorationLineVal) String() string { return string(v) }
+
+// Values returns every TextDecorationLineVal constant.
+func (TextDecorationLineVal) Values() []TextDecorationLineVal {
+	return []TextDecorationLineVal{
+		TextDecorationLineValBlink,
+		TextDecorationLineValGrammarError,
+		TextDecorationLineValLineThrough,
+		TextDecorationLineValNone,
+		TextDecorationLineValOverline,
+		TextDecorationLineValSpellingError,
+		TextDecorationLineValUnderline,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationLineVal constants.
+func (v TextDecorationLineVal) Valid() bool {
+	switch v {
+	case TextDecorationLineValBlink,
+		TextDecorationLineValGrammarError,
+		TextDecorationLineValLineThrough,
+		TextDecorationLineValNone,
+		TextDecorationLineValOverline,
+		TextDecorationLineValSpellingError,
+		TextDecorationLineValUnderline:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationLineVal returns the TextDecorationLineVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationLineVal(s string) (TextDecorationLineVal, error) {
+	v := TextDecorationLineVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationLineVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationLineVal constants.
+func (v TextDecorationLineVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationLineVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationLineVal.
+func (v *TextDecorationLineVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationLineVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextDecorationSkipInkVal represents values for the text-decoration-skip-ink property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | all | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip-ink
 type TextDecorationSkipInkVal string
 
 // TextDecorationSkipInkVal constants.
 const (
+	TextDecorationSkipInkValAll  TextDecorationSkipInkVal = "all"
 	TextDecorationSkipInkValAuto TextDecorationSkipInkVal = "auto"
 	TextDecorationSkipInkValNone TextDecorationSkipInkVal = "none"
 )
 
+func (v TextDecorationSkipInkVal) String() string { return string(v) }
+
+// Values returns every TextDecorationSkipInkVal constant.
+func (TextDecorationSkipInkVal) Values() []TextDecorationSkipInkVal {
+	return []TextDecorationSkipInkVal{
+		TextDecorationSkipInkValAll,
+		TextDecorationSkipInkValAuto,
+		TextDecorationSkipInkValNone,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationSkipInkVal constants.
+func (v TextDecorationSkipInkVal) Valid() bool {
+	switch v {
+	case TextDecorationSkipInkValAll,
+		TextDecorationSkipInkValAuto,
+		TextDecorationSkipInkValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationSkipInkVal returns the TextDecorationSkipInkVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationSkipInkVal(s string) (TextDecorationSkipInkVal, error) {
+	v := TextDecorationSkipInkVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationSkipInkVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationSkipInkVal constants.
+func (v TextDecorationSkipInkVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationSkipInkVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationSkipInkVal.
+func (v *TextDecorationSkipInkVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationSkipInkVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextDecorationStyleVal represents values for the text-decoration-style property.
+//
+//   - Initial: solid
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	solid | double | dotted | dashed | wavy
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-style
 type TextDecorationStyleVal string
 
 // TextDecorationStyleVal constants.
@@ -4521,7 +25732,71 @@ const (
 	TextDecorationStyleValWavy   TextDecorationStyleVal = "wavy"
 )
 
+func (v TextDecorationStyleVal) String() string { return string(v) }
+
+// Values returns every TextDecorationStyleVal constant.
+func (TextDecorationStyleVal) Values() []TextDecorationStyleVal {
+	return []TextDecorationStyleVal{
+		TextDecorationStyleValDashed,
+		TextDecorationStyleValDotted,
+		TextDecorationStyleValDouble,
+		TextDecorationStyleValSolid,
+		TextDecorationStyleValWavy,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationStyleVal constants.
+func (v TextDecorationStyleVal) Valid() bool {
+	switch v {
+	case TextDecorationStyleValDashed,
+		TextDecorationStyleValDotted,
+		TextDecorationStyleValDouble,
+		TextDecorationStyleValSolid,
+		TextDecorationStyleValWavy:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationStyleVal returns the TextDecorationStyleVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationStyleVal(s string) (TextDecorationStyleVal, error) {
+	v := TextDecorationStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationStyleVal constants.
+func (v TextDecorationStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationStyleVal.
+func (v *TextDecorationStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextDecorationThicknessVal represents values for the text-decoration-thickness property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | from-font | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-thickness
 type TextDecorationThicknessVal string
 
 // TextDecorationThicknessVal constants.
@@ -4530,27 +25805,150 @@ const (
 	TextDecorationThicknessValFromFont TextDecorationThicknessVal = "from-font"
 )
 
+func (v TextDecorationThicknessVal) String() string { return string(v) }
+
+// Values returns every TextDecorationThicknessVal constant.
+func (TextDecorationThicknessVal) Values() []TextDecorationThicknessVal {
+	return []TextDecorationThicknessVal{
+		TextDecorationThicknessValAuto,
+		TextDecorationThicknessValFromFont,
+	}
+}
+
+// Valid reports whether v is one of the TextDecorationThicknessVal constants.
+func (v TextDecorationThicknessVal) Valid() bool {
+	switch v {
+	case TextDecorationThicknessValAuto,
+		TextDecorationThicknessValFromFont:
+		return true
+	}
+	return false
+}
+
+// ParseTextDecorationThicknessVal returns the TextDecorationThicknessVal constant for a keyword, ignoring ASCII case.
+func ParseTextDecorationThicknessVal(s string) (TextDecorationThicknessVal, error) {
+	v := TextDecorationThicknessVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextDecorationThicknessVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextDecorationThicknessVal constants.
+func (v TextDecorationThicknessVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextDecorationThicknessVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextDecorationThicknessVal.
+func (v *TextDecorationThicknessVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextDecorationThicknessVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextEmphasisVal represents values for the text-emphasis property.
+//
+//   - Shorthand for: text-emphasis-color, text-emphasis-style
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-emphasis-style'> || <'text-emphasis-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis
 type TextEmphasisVal string
 
 // TextEmphasisVal constants.
 const (
-	TextEmphasisValTextEmphasisColor TextEmphasisVal = "text-emphasis-color"
-	TextEmphasisValTextEmphasisStyle TextEmphasisVal = "text-emphasis-style"
-)
-
-// TextEmphasisColorVal represents values for the text-emphasis-color property.
-type TextEmphasisColorVal string
-
-// TextEmphasisColorVal constants.
-const (
-	TextEmphasisColorValColorBase             TextEmphasisColorVal = "color-base"
-	TextEmphasisColorValDeprecatedSystemColor TextEmphasisColorVal = "deprecated-system-color"
-	TextEmphasisColorValLightDark             TextEmphasisColorVal = "light-dark"
-	TextEmphasisColorValSystemColor           TextEmphasisColorVal = "system-color"
-)
+	TextEmphasisValCircle       TextEmphasisVal = "circle"
+	TextEmphasisValDot          TextEmphasisVal = "dot"
+	TextEmphasisValDoubleCircle TextEmphasisVal = "double-circle"
+	TextEmphasisValFilled       TextEmphasisVal = "filled"
+	TextEmphasisValNone         TextEmphasisVal = "none"
+	TextEmphasisValOpen         TextEmphasisVal = "open"
+	TextEmphasisValSesame       TextEmphasisVal = "sesame"
+	TextEmphasisValTriangle     TextEmphasisVal = "triangle"
+)
+
+func (v TextEmphasisVal) String() string { return string(v) }
+
+// Values returns every TextEmphasisVal constant.
+func (TextEmphasisVal) Values() []TextEmphasisVal {
+	return []TextEmphasisVal{
+		TextEmphasisValCircle,
+		TextEmphasisValDot,
+		TextEmphasisValDoubleCircle,
+		TextEmphasisValFilled,
+		TextEmphasisValNone,
+		TextEmphasisValOpen,
+		TextEmphasisValSesame,
+		TextEmphasisValTriangle,
+	}
+}
+
+// Valid reports whether v is one of the TextEmphasisVal constants.
+func (v TextEmphasisVal) Valid() bool {
+	switch v {
+	case TextEmphasisValCircle,
+		TextEmphasisValDot,
+		TextEmphasisValDoubleCircle,
+		TextEmphasisValFilled,
+		TextEmphasisValNone,
+		TextEmphasisValOpen,
+		TextEmphasisValSesame,
+		TextEmphasisValTriangle:
+		return true
+	}
+	return false
+}
+
+// ParseTextEmphasisVal returns the TextEmphasisVal constant for a keyword, ignoring ASCII case.
+func ParseTextEmphasisVal(s string) (TextEmphasisVal, error) {
+	v := TextEmphasisVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextEmphasisVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextEmphasisVal constants.
+func (v TextEmphasisVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextEmphasisVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextEmphasisVal.
+func (v *TextEmphasisVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextEmphasisVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextEmphasisPositionVal represents values for the text-emphasis-position property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | [ over | under ] && [ right | left ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-position
 type TextEmphasisPositionVal string
 
 // TextEmphasisPositionVal constants.
@@ -4562,7 +25960,71 @@ const (
 	TextEmphasisPositionValUnder TextEmphasisPositionVal = "under"
 )
 
+func (v TextEmphasisPositionVal) String() string { return string(v) }
+
+// Values returns every TextEmphasisPositionVal constant.
+func (TextEmphasisPositionVal) Values() []TextEmphasisPositionVal {
+	return []TextEmphasisPositionVal{
+		TextEmphasisPositionValAuto,
+		TextEmphasisPositionValLeft,
+		TextEmphasisPositionValOver,
+		TextEmphasisPositionValRight,
+		TextEmphasisPositionValUnder,
+	}
+}
+
+// Valid reports whether v is one of the TextEmphasisPositionVal constants.
+func (v TextEmphasisPositionVal) Valid() bool {
+	switch v {
+	case TextEmphasisPositionValAuto,
+		TextEmphasisPositionValLeft,
+		TextEmphasisPositionValOver,
+		TextEmphasisPositionValRight,
+		TextEmphasisPositionValUnder:
+		return true
+	}
+	return false
+}
+
+// ParseTextEmphasisPositionVal returns the TextEmphasisPositionVal constant for a keyword, ignoring ASCII case.
+func ParseTextEmphasisPositionVal(s string) (TextEmphasisPositionVal, error) {
+	v := TextEmphasisPositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextEmphasisPositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextEmphasisPositionVal constants.
+func (v TextEmphasisPositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextEmphasisPositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextEmphasisPositionVal.
+func (v *TextEmphasisPositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextEmphasisPositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextEmphasisStyleVal represents values for the text-emphasis-style property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-style
 type TextEmphasisStyleVal string
 
 // TextEmphasisStyleVal constants.
@@ -4577,7 +26039,77 @@ const (
 	TextEmphasisStyleValTriangle     TextEmphasisStyleVal = "triangle"
 )
 
+func (v TextEmphasisStyleVal) String() string { return string(v) }
+
+// Values returns every TextEmphasisStyleVal constant.
+func (TextEmphasisStyleVal) Values() []TextEmphasisStyleVal {
+	return []TextEmphasisStyleVal{
+		TextEmphasisStyleValCircle,
+		TextEmphasisStyleValDot,
+		TextEmphasisStyleValDoubleCircle,
+		TextEmphasisStyleValFilled,
+		TextEmphasisStyleValNone,
+		TextEmphasisStyleValOpen,
+		TextEmphasisStyleValSesame,
+		TextEmphasisStyleValTriangle,
+	}
+}
+
+// Valid reports whether v is one of the TextEmphasisStyleVal constants.
+func (v TextEmphasisStyleVal) Valid() bool {
+	switch v {
+	case TextEmphasisStyleValCircle,
+		TextEmphasisStyleValDot,
+		TextEmphasisStyleValDoubleCircle,
+		TextEmphasisStyleValFilled,
+		TextEmphasisStyleValNone,
+		TextEmphasisStyleValOpen,
+		TextEmphasisStyleValSesame,
+		TextEmphasisStyleValTriangle:
+		return true
+	}
+	return false
+}
+
+// ParseTextEmphasisStyleVal returns the TextEmphasisStyleVal constant for a keyword, ignoring ASCII case.
+func ParseTextEmphasisStyleVal(s string) (TextEmphasisStyleVal, error) {
+	v := TextEmphasisStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextEmphasisStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextEmphasisStyleVal constants.
+func (v TextEmphasisStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextEmphasisStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextEmphasisStyleVal.
+func (v *TextEmphasisStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextEmphasisStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextIndentVal represents values for the text-indent property.
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> && hanging? && each-line?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-indent
 type TextIndentVal string
 
 // TextIndentVal constants.
@@ -4586,7 +26118,65 @@ const (
 	TextIndentValHanging  TextIndentVal = "hanging"
 )
 
+func (v TextIndentVal) String() string { return string(v) }
+
+// Values returns every TextIndentVal constant.
+func (TextIndentVal) Values() []TextIndentVal {
+	return []TextIndentVal{
+		TextIndentValEachLine,
+		TextIndentValHanging,
+	}
+}
+
+// Valid reports whether v is one of the TextIndentVal constants.
+func (v TextIndentVal) Valid() bool {
+	switch v {
+	case TextIndentValEachLine,
+		TextIndentValHanging:
+		return true
+	}
+	return false
+}
+
+// ParseTextIndentVal returns the TextIndentVal constant for a keyword, ignoring ASCII case.
+func ParseTextIndentVal(s string) (TextIndentVal, error) {
+	v := TextIndentVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextIndentVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextIndentVal constants.
+func (v TextIndentVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextIndentVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextIndentVal.
+func (v *TextIndentVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextIndentVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextJustifyVal represents values for the text-justify property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | inter-character | inter-word | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-justify
 type TextJustifyVal string
 
 // TextJustifyVal constants.
@@ -4597,7 +26187,69 @@ const (
 	TextJustifyValNone           TextJustifyVal = "none"
 )
 
+func (v TextJustifyVal) String() string { return string(v) }
+
+// Values returns every TextJustifyVal constant.
+func (TextJustifyVal) Values() []TextJustifyVal {
+	return []TextJustifyVal{
+		TextJustifyValAuto,
+		TextJustifyValInterCharacter,
+		TextJustifyValInterWord,
+		TextJustifyValNone,
+	}
+}
+
+// Valid reports whether v is one of the TextJustifyVal constants.
+func (v TextJustifyVal) Valid() bool {
+	switch v {
+	case TextJustifyValAuto,
+		TextJustifyValInterCharacter,
+		TextJustifyValInterWord,
+		TextJustifyValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTextJustifyVal returns the TextJustifyVal constant for a keyword, ignoring ASCII case.
+func ParseTextJustifyVal(s string) (TextJustifyVal, error) {
+	v := TextJustifyVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextJustifyVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextJustifyVal constants.
+func (v TextJustifyVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextJustifyVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextJustifyVal.
+func (v *TextJustifyVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextJustifyVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextOrientationVal represents values for the text-orientation property.
+//
+//   - Initial: mixed
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	mixed | upright | sideways
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-orientation
 type TextOrientationVal string
 
 // TextOrientationVal constants.
@@ -4607,36 +26259,271 @@ const (
 	TextOrientationValUpright  TextOrientationVal = "upright"
 )
 
+func (v TextOrientationVal) String() string { return string(v) }
+
+// Values returns every TextOrientationVal constant.
+func (TextOrientationVal) Values() []TextOrientationVal {
+	return []TextOrientationVal{
+		TextOrientationValMixed,
+		TextOrientationValSideways,
+		TextOrientationValUpright,
+	}
+}
+
+// Valid reports whether v is one of the TextOrientationVal constants.
+func (v TextOrientationVal) Valid() bool {
+	switch v {
+	case TextOrientationValMixed,
+		TextOrientationValSideways,
+		TextOrientationValUpright:
+		return true
+	}
+	return false
+}
+
+// ParseTextOrientationVal returns the TextOrientationVal constant for a keyword, ignoring ASCII case.
+func ParseTextOrientationVal(s string) (TextOrientationVal, error) {
+	v := TextOrientationVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextOrientationVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextOrientationVal constants.
+func (v TextOrientationVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextOrientationVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextOrientationVal.
+func (v *TextOrientationVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextOrientationVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextOverflowVal represents values for the text-overflow property.
+//
+//   - Initial: clip
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ clip | ellipsis | <string> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-overflow
 type TextOverflowVal string
 
 // TextOverflowVal constants.
 const (
-	TextOverflowVal1        TextOverflowVal = "1"
-	TextOverflowVal2        TextOverflowVal = "2"
 	TextOverflowValClip     TextOverflowVal = "clip"
 	TextOverflowValEllipsis TextOverflowVal = "ellipsis"
 )
 
+func (v TextOverflowVal) String() string { return string(v) }
+
+// Values returns every TextOverflowVal constant.
+func (TextOverflowVal) Values() []TextOverflowVal {
+	return []TextOverflowVal{
+		TextOverflowValClip,
+		TextOverflowValEllipsis,
+	}
+}
+
+// Valid reports whether v is one of the TextOverflowVal constants.
+func (v TextOverflowVal) Valid() bool {
+	switch v {
+	case TextOverflowValClip,
+		TextOverflowValEllipsis:
+		return true
+	}
+	return false
+}
+
+// ParseTextOverflowVal returns the TextOverflowVal constant for a keyword, ignoring ASCII case.
+func ParseTextOverflowVal(s string) (TextOverflowVal, error) {
+	v := TextOverflowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextOverflowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextOverflowVal constants.
+func (v TextOverflowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextOverflowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextOverflowVal.
+func (v *TextOverflowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextOverflowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextRenderingVal represents values for the text-rendering property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | optimizeSpeed | optimizeLegibility | geometricPrecision
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-rendering
 type TextRenderingVal string
 
 // TextRenderingVal constants.
 const (
-	TextRenderingValAuto TextRenderingVal = "auto"
-)
+	TextRenderingValAuto               TextRenderingVal = "auto"
+	TextRenderingValGeometricprecision TextRenderingVal = "geometricprecision"
+	TextRenderingValOptimizelegibility TextRenderingVal = "optimizelegibility"
+	TextRenderingValOptimizespeed      TextRenderingVal = "optimizespeed"
+)
+
+func (v TextRenderingVal) String() string { return string(v) }
+
+// Values returns every TextRenderingVal constant.
+func (TextRenderingVal) Values() []TextRenderingVal {
+	return []TextRenderingVal{
+		TextRenderingValAuto,
+		TextRenderingValGeometricprecision,
+		TextRenderingValOptimizelegibility,
+		TextRenderingValOptimizespeed,
+	}
+}
+
+// Valid reports whether v is one of the TextRenderingVal constants.
+func (v TextRenderingVal) Valid() bool {
+	switch v {
+	case TextRenderingValAuto,
+		TextRenderingValGeometricprecision,
+		TextRenderingValOptimizelegibility,
+		TextRenderingValOptimizespeed:
+		return true
+	}
+	return false
+}
+
+// ParseTextRenderingVal returns the TextRenderingVal constant for a keyword, ignoring ASCII case.
+func ParseTextRenderingVal(s string) (TextRenderingVal, error) {
+	v := TextRenderingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextRenderingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextRenderingVal constants.
+func (v TextRenderingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextRenderingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextRenderingVal.
+func (v *TextRenderingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextRenderingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextShadowVal represents values for the text-shadow property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <shadow-t>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-shadow
 type TextShadowVal string
 
 // TextShadowVal constants.
 const (
-	TextShadowVal2    TextShadowVal = "2"
-	TextShadowVal3    TextShadowVal = "3"
 	TextShadowValNone TextShadowVal = "none"
 )
 
+func (v TextShadowVal) String() string { return string(v) }
+
+// Values returns every TextShadowVal constant.
+func (TextShadowVal) Values() []TextShadowVal {
+	return []TextShadowVal{
+		TextShadowValNone,
+	}
+}
+
+// Valid reports whether v is one of the TextShadowVal constants.
+func (v TextShadowVal) Valid() bool {
+	switch v {
+	case TextShadowValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTextShadowVal returns the TextShadowVal constant for a keyword, ignoring ASCII case.
+func ParseTextShadowVal(s string) (TextShadowVal, error) {
+	v := TextShadowVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextShadowVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextShadowVal constants.
+func (v TextShadowVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextShadowVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextShadowVal.
+func (v *TextShadowVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextShadowVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextTransformVal represents values for the text-transform property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-transform
 type TextTransformVal string
 
 // TextTransformVal constants.
@@ -4650,7 +26537,75 @@ const (
 	TextTransformValUppercase    TextTransformVal = "uppercase"
 )
 
+func (v TextTransformVal) String() string { return string(v) }
+
+// Values returns every TextTransformVal constant.
+func (TextTransformVal) Values() []TextTransformVal {
+	return []TextTransformVal{
+		TextTransformValCapitalize,
+		TextTransformValFullSizeKana,
+		TextTransformValFullWidth,
+		TextTransformValLowercase,
+		TextTransformValMathAuto,
+		TextTransformValNone,
+		TextTransformValUppercase,
+	}
+}
+
+// Valid reports whether v is one of the TextTransformVal constants.
+func (v TextTransformVal) Valid() bool {
+	switch v {
+	case TextTransformValCapitalize,
+		TextTransformValFullSizeKana,
+		TextTransformValFullWidth,
+		TextTransformValLowercase,
+		TextTransformValMathAuto,
+		TextTransformValNone,
+		TextTransformValUppercase:
+		return true
+	}
+	return false
+}
+
+// ParseTextTransformVal returns the TextTransformVal constant for a keyword, ignoring ASCII case.
+func ParseTextTransformVal(s string) (TextTransformVal, error) {
+	v := TextTransformVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextTransformVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextTransformVal constants.
+func (v TextTransformVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextTransformVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextTransformVal.
+func (v *TextTransformVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextTransformVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextUnderlineOffsetVal represents values for the text-underline-offset property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-offset
 type TextUnderlineOffsetVal string
 
 // TextUnderlineOffsetVal constants.
@@ -4658,7 +26613,63 @@ const (
 	TextUnderlineOffsetValAuto TextUnderlineOffsetVal = "auto"
 )
 
+func (v TextUnderlineOffsetVal) String() string { return string(v) }
+
+// Values returns every TextUnderlineOffsetVal constant.
+func (TextUnderlineOffsetVal) Values() []TextUnderlineOffsetVal {
+	return []TextUnderlineOffsetVal{
+		TextUnderlineOffsetValAuto,
+	}
+}
+
+// Valid reports whether v is one of the TextUnderlineOffsetVal constants.
+func (v TextUnderlineOffsetVal) Valid() bool {
+	switch v {
+	case TextUnderlineOffsetValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseTextUnderlineOffsetVal returns the TextUnderlineOffsetVal constant for a keyword, ignoring ASCII case.
+func ParseTextUnderlineOffsetVal(s string) (TextUnderlineOffsetVal, error) {
+	v := TextUnderlineOffsetVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextUnderlineOffsetVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextUnderlineOffsetVal constants.
+func (v TextUnderlineOffsetVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextUnderlineOffsetVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextUnderlineOffsetVal.
+func (v *TextUnderlineOffsetVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextUnderlineOffsetVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextUnderlinePositionVal represents values for the text-underline-position property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | from-font | [ under || [ left | right ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-position
 type TextUnderlinePositionVal string
 
 // TextUnderlinePositionVal constants.
@@ -4670,16 +26681,150 @@ const (
 	TextUnderlinePositionValUnder    TextUnderlinePositionVal = "under"
 )
 
+func (v TextUnderlinePositionVal) String() string { return string(v) }
+
+// Values returns every TextUnderlinePositionVal constant.
+func (TextUnderlinePositionVal) Values() []TextUnderlinePositionVal {
+	return []TextUnderlinePositionVal{
+		TextUnderlinePositionValAuto,
+		TextUnderlinePositionValFromFont,
+		TextUnderlinePositionValLeft,
+		TextUnderlinePositionValRight,
+		TextUnderlinePositionValUnder,
+	}
+}
+
+// Valid reports whether v is one of the TextUnderlinePositionVal constants.
+func (v TextUnderlinePositionVal) Valid() bool {
+	switch v {
+	case TextUnderlinePositionValAuto,
+		TextUnderlinePositionValFromFont,
+		TextUnderlinePositionValLeft,
+		TextUnderlinePositionValRight,
+		TextUnderlinePositionValUnder:
+		return true
+	}
+	return false
+}
+
+// ParseTextUnderlinePositionVal returns the TextUnderlinePositionVal constant for a keyword, ignoring ASCII case.
+func ParseTextUnderlinePositionVal(s string) (TextUnderlinePositionVal, error) {
+	v := TextUnderlinePositionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextUnderlinePositionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextUnderlinePositionVal constants.
+func (v TextUnderlinePositionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextUnderlinePositionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextUnderlinePositionVal.
+func (v *TextUnderlinePositionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextUnderlinePositionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextWrapVal represents values for the text-wrap property.
+//
+//   - Initial: wrap
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-wrap-mode'> || <'text-wrap-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap
 type TextWrapVal string
 
 // TextWrapVal constants.
 const (
-	TextWrapValTextWrapMode  TextWrapVal = "text-wrap-mode"
-	TextWrapValTextWrapStyle TextWrapVal = "text-wrap-style"
-)
+	TextWrapValAuto    TextWrapVal = "auto"
+	TextWrapValBalance TextWrapVal = "balance"
+	TextWrapValNowrap  TextWrapVal = "nowrap"
+	TextWrapValPretty  TextWrapVal = "pretty"
+	TextWrapValStable  TextWrapVal = "stable"
+	TextWrapValWrap    TextWrapVal = "wrap"
+)
+
+func (v TextWrapVal) String() string { return string(v) }
+
+// Values returns every TextWrapVal constant.
+func (TextWrapVal) Values() []TextWrapVal {
+	return []TextWrapVal{
+		TextWrapValAuto,
+		TextWrapValBalance,
+		TextWrapValNowrap,
+		TextWrapValPretty,
+		TextWrapValStable,
+		TextWrapValWrap,
+	}
+}
+
+// Valid reports whether v is one of the TextWrapVal constants.
+func (v TextWrapVal) Valid() bool {
+	switch v {
+	case TextWrapValAuto,
+		TextWrapValBalance,
+		TextWrapValNowrap,
+		TextWrapValPretty,
+		TextWrapValStable,
+		TextWrapValWrap:
+		return true
+	}
+	return false
+}
+
+// ParseTextWrapVal returns the TextWrapVal constant for a keyword, ignoring ASCII case.
+func ParseTextWrapVal(s string) (TextWrapVal, error) {
+	v := TextWrapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextWrapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextWrapVal constants.
+func (v TextWrapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextWrapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextWrapVal.
+func (v *TextWrapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextWrapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TextWrapModeVal represents values for the text-wrap-mode property.
+//
+//   - Initial: wrap
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	wrap | nowrap
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-mode
 type TextWrapModeVal string
 
 // TextWrapModeVal constants.
@@ -4688,7 +26833,65 @@ const (
 	TextWrapModeValWrap   TextWrapModeVal = "wrap"
 )
 
+func (v TextWrapModeVal) String() string { return string(v) }
+
+// Values returns every TextWrapModeVal constant.
+func (TextWrapModeVal) Values() []TextWrapModeVal {
+	return []TextWrapModeVal{
+		TextWrapModeValNowrap,
+		TextWrapModeValWrap,
+	}
+}
+
+// Valid reports whether v is one of the TextWrapModeVal constants.
+func (v TextWrapModeVal) Valid() bool {
+	switch v {
+	case TextWrapModeValNowrap,
+		TextWrapModeValWrap:
+		return true
+	}
+	return false
+}
+
+// ParseTextWrapModeVal returns the TextWrapModeVal constant for a keyword, ignoring ASCII case.
+func ParseTextWrapModeVal(s string) (TextWrapModeVal, error) {
+	v := TextWrapModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextWrapModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextWrapModeVal constants.
+func (v TextWrapModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextWrapModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextWrapModeVal.
+func (v *TextWrapModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextWrapModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TextWrapStyleVal represents values for the text-wrap-style property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | balance | stable | pretty
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-style
 type TextWrapStyleVal string
 
 // TextWrapStyleVal constants.
@@ -4699,20 +26902,133 @@ const (
 	TextWrapStyleValStable  TextWrapStyleVal = "stable"
 )
 
+func (v TextWrapStyleVal) String() string { return string(v) }
+
+// Values returns every TextWrapStyleVal constant.
+func (TextWrapStyleVal) Values() []TextWrapStyleVal {
+	return []TextWrapStyleVal{
+		TextWrapStyleValAuto,
+		TextWrapStyleValBalance,
+		TextWrapStyleValPretty,
+		TextWrapStyleValStable,
+	}
+}
+
+// Valid reports whether v is one of the TextWrapStyleVal constants.
+func (v TextWrapStyleVal) Valid() bool {
+	switch v {
+	case TextWrapStyleValAuto,
+		TextWrapStyleValBalance,
+		TextWrapStyleValPretty,
+		TextWrapStyleValStable:
+		return true
+	}
+	return false
+}
+
+// ParseTextWrapStyleVal returns the TextWrapStyleVal constant for a keyword, ignoring ASCII case.
+func ParseTextWrapStyleVal(s string) (TextWrapStyleVal, error) {
+	v := TextWrapStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TextWrapStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TextWrapStyleVal constants.
+func (v TextWrapStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TextWrapStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTextWrapStyleVal.
+func (v *TextWrapStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTextWrapStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TopVal represents values for the top property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/top
 type TopVal string
 
 // TopVal constants.
 const (
-	TopValAnchor           TopVal = "anchor"
-	TopValAnchorName       TopVal = "anchor-name"
-	TopValAnchorSide       TopVal = "anchor-side"
-	TopValAnchorSize       TopVal = "anchor-size"
-	TopValAuto             TopVal = "auto"
-	TopValLengthPercentage TopVal = "length-percentage"
-)
+	TopValAuto TopVal = "auto"
+)
+
+func (v TopVal) String() string { return string(v) }
+
+// Values returns every TopVal constant.
+func (TopVal) Values() []TopVal {
+	return []TopVal{
+		TopValAuto,
+	}
+}
+
+// Valid reports whether v is one of the TopVal constants.
+func (v TopVal) Valid() bool {
+	switch v {
+	case TopValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseTopVal returns the TopVal constant for a keyword, ignoring ASCII case.
+func ParseTopVal(s string) (TopVal, error) {
+	v := TopVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TopVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TopVal constants.
+func (v TopVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TopVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTopVal.
+func (v *TopVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTopVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TouchActionVal represents values for the touch-action property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/touch-action
 type TouchActionVal string
 
 // TouchActionVal constants.
@@ -4729,16 +27045,145 @@ const (
 	TouchActionValPinchZoom    TouchActionVal = "pinch-zoom"
 )
 
+func (v TouchActionVal) String() string { return string(v) }
+
+// Values returns every TouchActionVal constant.
+func (TouchActionVal) Values() []TouchActionVal {
+	return []TouchActionVal{
+		TouchActionValAuto,
+		TouchActionValManipulation,
+		TouchActionValNone,
+		TouchActionValPanDown,
+		TouchActionValPanLeft,
+		TouchActionValPanRight,
+		TouchActionValPanUp,
+		TouchActionValPanX,
+		TouchActionValPanY,
+		TouchActionValPinchZoom,
+	}
+}
+
+// Valid reports whether v is one of the TouchActionVal constants.
+func (v TouchActionVal) Valid() bool {
+	switch v {
+	case TouchActionValAuto,
+		TouchActionValManipulation,
+		TouchActionValNone,
+		TouchActionValPanDown,
+		TouchActionValPanLeft,
+		TouchActionValPanRight,
+		TouchActionValPanUp,
+		TouchActionValPanX,
+		TouchActionValPanY,
+		TouchActionValPinchZoom:
+		return true
+	}
+	return false
+}
+
+// ParseTouchActionVal returns the TouchActionVal constant for a keyword, ignoring ASCII case.
+func ParseTouchActionVal(s string) (TouchActionVal, error) {
+	v := TouchActionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TouchActionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TouchActionVal constants.
+func (v TouchActionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TouchActionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTouchActionVal.
+func (v *TouchActionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTouchActionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TransformVal represents values for the transform property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <transform-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform
 type TransformVal string
 
 // TransformVal constants.
 const (
-	TransformValNone              TransformVal = "none"
-	TransformValTransformFunction TransformVal = "transform-function"
-)
+	TransformValNone TransformVal = "none"
+)
+
+func (v TransformVal) String() string { return string(v) }
+
+// Values returns every TransformVal constant.
+func (TransformVal) Values() []TransformVal {
+	return []TransformVal{
+		TransformValNone,
+	}
+}
+
+// Valid reports whether v is one of the TransformVal constants.
+func (v TransformVal) Valid() bool {
+	switch v {
+	case TransformValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTransformVal returns the TransformVal constant for a keyword, ignoring ASCII case.
+func ParseTransformVal(s string) (TransformVal, error) {
+	v := TransformVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransformVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransformVal constants.
+func (v TransformVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransformVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransformVal.
+func (v *TransformVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransformVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TransformBoxVal represents values for the transform-box property.
+//
+//   - Initial: view-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content-box | border-box | fill-box | stroke-box | view-box
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-box
 type TransformBoxVal string
 
 // TransformBoxVal constants.
@@ -4750,7 +27195,71 @@ const (
 	TransformBoxValViewBox    TransformBoxVal = "view-box"
 )
 
+func (v TransformBoxVal) String() string { return string(v) }
+
+// Values returns every TransformBoxVal constant.
+func (TransformBoxVal) Values() []TransformBoxVal {
+	return []TransformBoxVal{
+		TransformBoxValBorderBox,
+		TransformBoxValContentBox,
+		TransformBoxValFillBox,
+		TransformBoxValStrokeBox,
+		TransformBoxValViewBox,
+	}
+}
+
+// Valid reports whether v is one of the TransformBoxVal constants.
+func (v TransformBoxVal) Valid() bool {
+	switch v {
+	case TransformBoxValBorderBox,
+		TransformBoxValContentBox,
+		TransformBoxValFillBox,
+		TransformBoxValStrokeBox,
+		TransformBoxValViewBox:
+		return true
+	}
+	return false
+}
+
+// ParseTransformBoxVal returns the TransformBoxVal constant for a keyword, ignoring ASCII case.
+func ParseTransformBoxVal(s string) (TransformBoxVal, error) {
+	v := TransformBoxVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransformBoxVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransformBoxVal constants.
+func (v TransformBoxVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransformBoxVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransformBoxVal.
+func (v *TransformBoxVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransformBoxVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TransformOriginVal represents values for the transform-origin property.
+//
+//   - Initial: 50% 50% 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-origin
 type TransformOriginVal string
 
 // TransformOriginVal constants.
@@ -4762,28 +27271,232 @@ const (
 	TransformOriginValTop    TransformOriginVal = "top"
 )
 
+func (v TransformOriginVal) String() string { return string(v) }
+
+// Values returns every TransformOriginVal constant.
+func (TransformOriginVal) Values() []TransformOriginVal {
+	return []TransformOriginVal{
+		TransformOriginValBottom,
+		TransformOriginValCenter,
+		TransformOriginValLeft,
+		TransformOriginValRight,
+		TransformOriginValTop,
+	}
+}
+
+// Valid reports whether v is one of the TransformOriginVal constants.
+func (v TransformOriginVal) Valid() bool {
+	switch v {
+	case TransformOriginValBottom,
+		TransformOriginValCenter,
+		TransformOriginValLeft,
+		TransformOriginValRight,
+		TransformOriginValTop:
+		return true
+	}
+	return false
+}
+
+// ParseTransformOriginVal returns the TransformOriginVal constant for a keyword, ignoring ASCII case.
+func ParseTransformOriginVal(s string) (TransformOriginVal, error) {
+	v := TransformOriginVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransformOriginVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransformOriginVal constants.
+func (v TransformOriginVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransformOriginVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransformOriginVal.
+func (v *TransformOriginVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransformOriginVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // TransformStyleVal represents values for the transform-style property.
+//
+//   - Initial: flat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	flat | preserve-3d
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-style
 type TransformStyleVal string
 
 // TransformStyleVal constants.
 const (
-	TransformStyleValFlat     TransformStyleVal = "flat"
-	TransformStyleValPreserve TransformStyleVal = "preserve-"
-)
+	TransformStyleValFlat       TransformStyleVal = "flat"
+	TransformStyleValPreserve3d TransformStyleVal = "preserve-3d"
+)
+
+func (v TransformStyleVal) String() string { return string(v) }
+
+// Values returns every TransformStyleVal constant.
+func (TransformStyleVal) Values() []TransformStyleVal {
+	return []TransformStyleVal{
+		TransformStyleValFlat,
+		TransformStyleValPreserve3d,
+	}
+}
+
+// Valid reports whether v is one of the TransformStyleVal constants.
+func (v TransformStyleVal) Valid() bool {
+	switch v {
+	case TransformStyleValFlat,
+		TransformStyleValPreserve3d:
+		return true
+	}
+	return false
+}
+
+// ParseTransformStyleVal returns the TransformStyleVal constant for a keyword, ignoring ASCII case.
+func ParseTransformStyleVal(s string) (TransformStyleVal, error) {
+	v := TransformStyleVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransformStyleVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransformStyleVal constants.
+func (v TransformStyleVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransformStyleVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransformStyleVal.
+func (v *TransformStyleVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransformStyleVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TransitionVal represents values for the transition property.
+//
+//   - Shorthand for: transition-behavior, transition-delay, transition-duration, transition-property, transition-timing-function
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-transition>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition
 type TransitionVal string
 
 // TransitionVal constants.
 const (
-	TransitionValEasingFunction           TransitionVal = "easing-function"
-	TransitionValNone                     TransitionVal = "none"
-	TransitionValSingleTransitionProperty TransitionVal = "single-transition-property"
-	TransitionValTime                     TransitionVal = "time"
-	TransitionValTransitionBehaviorValue  TransitionVal = "transition-behavior-value"
-)
+	TransitionValAll           TransitionVal = "all"
+	TransitionValAllowDiscrete TransitionVal = "allow-discrete"
+	TransitionValEase          TransitionVal = "ease"
+	TransitionValEaseIn        TransitionVal = "ease-in"
+	TransitionValEaseInOut     TransitionVal = "ease-in-out"
+	TransitionValEaseOut       TransitionVal = "ease-out"
+	TransitionValLinear        TransitionVal = "linear"
+	TransitionValNone          TransitionVal = "none"
+	TransitionValNormal        TransitionVal = "normal"
+	TransitionValStepEnd       TransitionVal = "step-end"
+	TransitionValStepStart     TransitionVal = "step-start"
+)
+
+func (v TransitionVal) String() string { return string(v) }
+
+// Values returns every TransitionVal constant.
+func (TransitionVal) Values() []TransitionVal {
+	return []TransitionVal{
+		TransitionValAll,
+		TransitionValAllowDiscrete,
+		TransitionValEase,
+		TransitionValEaseIn,
+		TransitionValEaseInOut,
+		TransitionValEaseOut,
+		TransitionValLinear,
+		TransitionValNone,
+		TransitionValNormal,
+		TransitionValStepEnd,
+		TransitionValStepStart,
+	}
+}
+
+// Valid reports whether v is one of the TransitionVal constants.
+func (v TransitionVal) Valid() bool {
+	switch v {
+	case TransitionValAll,
+		TransitionValAllowDiscrete,
+		TransitionValEase,
+		TransitionValEaseIn,
+		TransitionValEaseInOut,
+		TransitionValEaseOut,
+		TransitionValLinear,
+		TransitionValNone,
+		TransitionValNormal,
+		TransitionValStepEnd,
+		TransitionValStepStart:
+		return true
+	}
+	return false
+}
+
+// ParseTransitionVal returns the TransitionVal constant for a keyword, ignoring ASCII case.
+func ParseTransitionVal(s string) (TransitionVal, error) {
+	v := TransitionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransitionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransitionVal constants.
+func (v TransitionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransitionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransitionVal.
+func (v *TransitionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransitionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TransitionBehaviorVal represents values for the transition-behavior property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<transition-behavior-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-behavior
 type TransitionBehaviorVal string
 
 // TransitionBehaviorVal constants.
@@ -4792,42 +27505,214 @@ const (
 	TransitionBehaviorValNormal        TransitionBehaviorVal = "normal"
 )
 
-// TransitionDelayVal represents values for the transition-delay property.
-type TransitionDelayVal string
-
-// TransitionDelayVal constants.
-const (
-	TransitionDelayValTime TransitionDelayVal = "time"
-)
-
-// TransitionDurationVal represents values for the transition-duration property.
-type TransitionDurationVal string
-
-// TransitionDurationVal constants.
-const (
-	TransitionDurationValTime TransitionDurationVal = "time"
-)
+func (v TransitionBehaviorVal) String() string { return string(v) }
+
+// Values returns every TransitionBehaviorVal constant.
+func (TransitionBehaviorVal) Values() []TransitionBehaviorVal {
+	return []TransitionBehaviorVal{
+		TransitionBehaviorValAllowDiscrete,
+		TransitionBehaviorValNormal,
+	}
+}
+
+// Valid reports whether v is one of the TransitionBehaviorVal constants.
+func (v TransitionBehaviorVal) Valid() bool {
+	switch v {
+	case TransitionBehaviorValAllowDiscrete,
+		TransitionBehaviorValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseTransitionBehaviorVal returns the TransitionBehaviorVal constant for a keyword, ignoring ASCII case.
+func ParseTransitionBehaviorVal(s string) (TransitionBehaviorVal, error) {
+	v := TransitionBehaviorVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransitionBehaviorVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransitionBehaviorVal constants.
+func (v TransitionBehaviorVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransitionBehaviorVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransitionBehaviorVal.
+func (v *TransitionBehaviorVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransitionBehaviorVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TransitionPropertyVal represents values for the transition-property property.
+//
+//   - Initial: all
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <single-transition-property>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-property
 type TransitionPropertyVal string
 
 // TransitionPropertyVal constants.
 const (
-	TransitionPropertyValCustomIdent TransitionPropertyVal = "custom-ident"
-	TransitionPropertyValNone        TransitionPropertyVal = "none"
-)
+	TransitionPropertyValAll  TransitionPropertyVal = "all"
+	TransitionPropertyValNone TransitionPropertyVal = "none"
+)
+
+func (v TransitionPropertyVal) String() string { return string(v) }
+
+// Values returns every TransitionPropertyVal constant.
+func (TransitionPropertyVal) Values() []TransitionPropertyVal {
+	return []TransitionPropertyVal{
+		TransitionPropertyValAll,
+		TransitionPropertyValNone,
+	}
+}
+
+// Valid reports whether v is one of the TransitionPropertyVal constants.
+func (v TransitionPropertyVal) Valid() bool {
+	switch v {
+	case TransitionPropertyValAll,
+		TransitionPropertyValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTransitionPropertyVal returns the TransitionPropertyVal constant for a keyword, ignoring ASCII case.
+func ParseTransitionPropertyVal(s string) (TransitionPropertyVal, error) {
+	v := TransitionPropertyVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransitionPropertyVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransitionPropertyVal constants.
+func (v TransitionPropertyVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransitionPropertyVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransitionPropertyVal.
+func (v *TransitionPropertyVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransitionPropertyVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TransitionTimingFunctionVal represents values for the transition-timing-function property.
+//
+//   - Initial: ease
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<easing-function>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-timing-function
 type TransitionTimingFunctionVal string
 
 // TransitionTimingFunctionVal constants.
 const (
-	TransitionTimingFunctionValCubicBezierEasingFunction TransitionTimingFunctionVal = "cubic-bezier-easing-function"
-	TransitionTimingFunctionValLinearEasingFunction      TransitionTimingFunctionVal = "linear-easing-function"
-	TransitionTimingFunctionValStepEasingFunction        TransitionTimingFunctionVal = "step-easing-function"
-)
+	TransitionTimingFunctionValEase      TransitionTimingFunctionVal = "ease"
+	TransitionTimingFunctionValEaseIn    TransitionTimingFunctionVal = "ease-in"
+	TransitionTimingFunctionValEaseInOut TransitionTimingFunctionVal = "ease-in-out"
+	TransitionTimingFunctionValEaseOut   TransitionTimingFunctionVal = "ease-out"
+	TransitionTimingFunctionValLinear    TransitionTimingFunctionVal = "linear"
+	TransitionTimingFunctionValStepEnd   TransitionTimingFunctionVal = "step-end"
+	TransitionTimingFunctionValStepStart TransitionTimingFunctionVal = "step-start"
+)
+
+func (v TransitionTimingFunctionVal) String() string { return string(v) }
+
+// Values returns every TransitionTimingFunctionVal constant.
+func (TransitionTimingFunctionVal) Values() []TransitionTimingFunctionVal {
+	return []TransitionTimingFunctionVal{
+		TransitionTimingFunctionValEase,
+		TransitionTimingFunctionValEaseIn,
+		TransitionTimingFunctionValEaseInOut,
+		TransitionTimingFunctionValEaseOut,
+		TransitionTimingFunctionValLinear,
+		TransitionTimingFunctionValStepEnd,
+		TransitionTimingFunctionValStepStart,
+	}
+}
+
+// Valid reports whether v is one of the TransitionTimingFunctionVal constants.
+func (v TransitionTimingFunctionVal) Valid() bool {
+	switch v {
+	case TransitionTimingFunctionValEase,
+		TransitionTimingFunctionValEaseIn,
+		TransitionTimingFunctionValEaseInOut,
+		TransitionTimingFunctionValEaseOut,
+		TransitionTimingFunctionValLinear,
+		TransitionTimingFunctionValStepEnd,
+		TransitionTimingFunctionValStepStart:
+		return true
+	}
+	return false
+}
+
+// ParseTransitionTimingFunctionVal returns the TransitionTimingFunctionVal constant for a keyword, ignoring ASCII case.
+func ParseTransitionTimingFunctionVal(s string) (TransitionTimingFunctionVal, error) {
+	v := TransitionTimingFunctionVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TransitionTimingFunctionVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TransitionTimingFunctionVal constants.
+func (v TransitionTimingFunctionVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TransitionTimingFunctionVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTransitionTimingFunctionVal.
+func (v *TransitionTimingFunctionVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTransitionTimingFunctionVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // TranslateVal represents values for the translate property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage> [ <length-percentage> <length>? ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/translate
 type TranslateVal string
 
 // TranslateVal constants.
@@ -4835,7 +27720,63 @@ const (
 	TranslateValNone TranslateVal = "none"
 )
 
+func (v TranslateVal) String() string { return string(v) }
+
+// Values returns every TranslateVal constant.
+func (TranslateVal) Values() []TranslateVal {
+	return []TranslateVal{
+		TranslateValNone,
+	}
+}
+
+// Valid reports whether v is one of the TranslateVal constants.
+func (v TranslateVal) Valid() bool {
+	switch v {
+	case TranslateValNone:
+		return true
+	}
+	return false
+}
+
+// ParseTranslateVal returns the TranslateVal constant for a keyword, ignoring ASCII case.
+func ParseTranslateVal(s string) (TranslateVal, error) {
+	v := TranslateVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "TranslateVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not TranslateVal constants.
+func (v TranslateVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "TranslateVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseTranslateVal.
+func (v *TranslateVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseTranslateVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // UnicodeBidiVal represents values for the unicode-bidi property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | embed | isolate | bidi-override | isolate-override | plaintext
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/unicode-bidi
 type UnicodeBidiVal string
 
 // UnicodeBidiVal constants.
@@ -4848,17 +27789,146 @@ const (
 	UnicodeBidiValPlaintext       UnicodeBidiVal = "plaintext"
 )
 
+func (v UnicodeBidiVal) String() string { return string(v) }
+
+// Values returns every UnicodeBidiVal constant.
+func (UnicodeBidiVal) Values() []UnicodeBidiVal {
+	return []UnicodeBidiVal{
+		UnicodeBidiValBidiOverride,
+		UnicodeBidiValEmbed,
+		UnicodeBidiValIsolate,
+		UnicodeBidiValIsolateOverride,
+		UnicodeBidiValNormal,
+		UnicodeBidiValPlaintext,
+	}
+}
+
+// Valid reports whether v is one of the UnicodeBidiVal constants.
+func (v UnicodeBidiVal) Valid() bool {
+	switch v {
+	case UnicodeBidiValBidiOverride,
+		UnicodeBidiValEmbed,
+		UnicodeBidiValIsolate,
+		UnicodeBidiValIsolateOverride,
+		UnicodeBidiValNormal,
+		UnicodeBidiValPlaintext:
+		return true
+	}
+	return false
+}
+
+// ParseUnicodeBidiVal returns the UnicodeBidiVal constant for a keyword, ignoring ASCII case.
+func ParseUnicodeBidiVal(s string) (UnicodeBidiVal, error) {
+	v := UnicodeBidiVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "UnicodeBidiVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not UnicodeBidiVal constants.
+func (v UnicodeBidiVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "UnicodeBidiVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseUnicodeBidiVal.
+func (v *UnicodeBidiVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseUnicodeBidiVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // UserSelectVal represents values for the user-select property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | text | none | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/user-select
 type UserSelectVal string
 
 // UserSelectVal constants.
 const (
+	UserSelectValAll  UserSelectVal = "all"
 	UserSelectValAuto UserSelectVal = "auto"
 	UserSelectValNone UserSelectVal = "none"
 	UserSelectValText UserSelectVal = "text"
 )
 
+func (v UserSelectVal) String() string { return string(v) }
+
+// Values returns every UserSelectVal constant.
+func (UserSelectVal) Values() []UserSelectVal {
+	return []UserSelectVal{
+		UserSelectValAll,
+		UserSelectValAuto,
+		UserSelectValNone,
+		UserSelectValText,
+	}
+}
+
+// Valid reports whether v is one of the UserSelectVal constants.
+func (v UserSelectVal) Valid() bool {
+	switch v {
+	case UserSelectValAll,
+		UserSelectValAuto,
+		UserSelectValNone,
+		UserSelectValText:
+		return true
+	}
+	return false
+}
+
+// ParseUserSelectVal returns the UserSelectVal constant for a keyword, ignoring ASCII case.
+func ParseUserSelectVal(s string) (UserSelectVal, error) {
+	v := UserSelectVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "UserSelectVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not UserSelectVal constants.
+func (v UserSelectVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "UserSelectVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseUserSelectVal.
+func (v *UserSelectVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseUserSelectVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // VectorEffectVal represents values for the vector-effect property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/vector-effect
 type VectorEffectVal string
 
 // VectorEffectVal constants.
@@ -4870,7 +27940,71 @@ const (
 	VectorEffectValNone             VectorEffectVal = "none"
 )
 
+func (v VectorEffectVal) String() string { return string(v) }
+
+// Values returns every VectorEffectVal constant.
+func (VectorEffectVal) Values() []VectorEffectVal {
+	return []VectorEffectVal{
+		VectorEffectValFixedPosition,
+		VectorEffectValNonRotation,
+		VectorEffectValNonScalingSize,
+		VectorEffectValNonScalingStroke,
+		VectorEffectValNone,
+	}
+}
+
+// Valid reports whether v is one of the VectorEffectVal constants.
+func (v VectorEffectVal) Valid() bool {
+	switch v {
+	case VectorEffectValFixedPosition,
+		VectorEffectValNonRotation,
+		VectorEffectValNonScalingSize,
+		VectorEffectValNonScalingStroke,
+		VectorEffectValNone:
+		return true
+	}
+	return false
+}
+
+// ParseVectorEffectVal returns the VectorEffectVal constant for a keyword, ignoring ASCII case.
+func ParseVectorEffectVal(s string) (VectorEffectVal, error) {
+	v := VectorEffectVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "VectorEffectVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not VectorEffectVal constants.
+func (v VectorEffectVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "VectorEffectVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseVectorEffectVal.
+func (v *VectorEffectVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseVectorEffectVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // VerticalAlignVal represents values for the vertical-align property.
+//
+//   - Initial: baseline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/vertical-align
 type VerticalAlignVal string
 
 // VerticalAlignVal constants.
@@ -4885,26 +28019,206 @@ const (
 	VerticalAlignValTop        VerticalAlignVal = "top"
 )
 
+func (v VerticalAlignVal) String() string { return string(v) }
+
+// Values returns every VerticalAlignVal constant.
+func (VerticalAlignVal) Values() []VerticalAlignVal {
+	return []VerticalAlignVal{
+		VerticalAlignValBaseline,
+		VerticalAlignValBottom,
+		VerticalAlignValMiddle,
+		VerticalAlignValSub,
+		VerticalAlignValSuper,
+		VerticalAlignValTextBottom,
+		VerticalAlignValTextTop,
+		VerticalAlignValTop,
+	}
+}
+
+// Valid reports whether v is one of the VerticalAlignVal constants.
+func (v VerticalAlignVal) Valid() bool {
+	switch v {
+	case VerticalAlignValBaseline,
+		VerticalAlignValBottom,
+		VerticalAlignValMiddle,
+		VerticalAlignValSub,
+		VerticalAlignValSuper,
+		VerticalAlignValTextBottom,
+		VerticalAlignValTextTop,
+		VerticalAlignValTop:
+		return true
+	}
+	return false
+}
+
+// ParseVerticalAlignVal returns the VerticalAlignVal constant for a keyword, ignoring ASCII case.
+func ParseVerticalAlignVal(s string) (VerticalAlignVal, error) {
+	v := VerticalAlignVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "VerticalAlignVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not VerticalAlignVal constants.
+func (v VerticalAlignVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "VerticalAlignVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseVerticalAlignVal.
+func (v *VerticalAlignVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseVerticalAlignVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ViewTransitionClassVal represents values for the view-transition-class property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
 type ViewTransitionClassVal string
 
 // ViewTransitionClassVal constants.
 const (
-	ViewTransitionClassValCustomIdent ViewTransitionClassVal = "custom-ident"
-	ViewTransitionClassValNone        ViewTransitionClassVal = "none"
-)
+	ViewTransitionClassValNone ViewTransitionClassVal = "none"
+)
+
+func (v ViewTransitionClassVal) String() string { return string(v) }
+
+// Values returns every ViewTransitionClassVal constant.
+func (ViewTransitionClassVal) Values() []ViewTransitionClassVal {
+	return []ViewTransitionClassVal{
+		ViewTransitionClassValNone,
+	}
+}
+
+// Valid reports whether v is one of the ViewTransitionClassVal constants.
+func (v ViewTransitionClassVal) Valid() bool {
+	switch v {
+	case ViewTransitionClassValNone:
+		return true
+	}
+	return false
+}
+
+// ParseViewTransitionClassVal returns the ViewTransitionClassVal constant for a keyword, ignoring ASCII case.
+func ParseViewTransitionClassVal(s string) (ViewTransitionClassVal, error) {
+	v := ViewTransitionClassVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTransitionClassVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTransitionClassVal constants.
+func (v ViewTransitionClassVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTransitionClassVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTransitionClassVal.
+func (v *ViewTransitionClassVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTransitionClassVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ViewTransitionNameVal represents values for the view-transition-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident> | match-element
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-transition-name
 type ViewTransitionNameVal string
 
 // ViewTransitionNameVal constants.
 const (
-	ViewTransitionNameValCustomIdent  ViewTransitionNameVal = "custom-ident"
 	ViewTransitionNameValMatchElement ViewTransitionNameVal = "match-element"
 	ViewTransitionNameValNone         ViewTransitionNameVal = "none"
 )
 
+func (v ViewTransitionNameVal) String() string { return string(v) }
+
+// Values returns every ViewTransitionNameVal constant.
+func (ViewTransitionNameVal) Values() []ViewTransitionNameVal {
+	return []ViewTransitionNameVal{
+		ViewTransitionNameValMatchElement,
+		ViewTransitionNameValNone,
+	}
+}
+
+// Valid reports whether v is one of the ViewTransitionNameVal constants.
+func (v ViewTransitionNameVal) Valid() bool {
+	switch v {
+	case ViewTransitionNameValMatchElement,
+		ViewTransitionNameValNone:
+		return true
+	}
+	return false
+}
+
+// ParseViewTransitionNameVal returns the ViewTransitionNameVal constant for a keyword, ignoring ASCII case.
+func ParseViewTransitionNameVal(s string) (ViewTransitionNameVal, error) {
+	v := ViewTransitionNameVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ViewTransitionNameVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ViewTransitionNameVal constants.
+func (v ViewTransitionNameVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ViewTransitionNameVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseViewTransitionNameVal.
+func (v *ViewTransitionNameVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseViewTransitionNameVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // VisibilityVal represents values for the visibility property.
+//
+//   - Initial: visible
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | collapse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/visibility
 type VisibilityVal string
 
 // VisibilityVal constants.
@@ -4914,20 +28228,161 @@ const (
 	VisibilityValVisible  VisibilityVal = "visible"
 )
 
+func (v VisibilityVal) String() string { return string(v) }
+
+// Values returns every VisibilityVal constant.
+func (VisibilityVal) Values() []VisibilityVal {
+	return []VisibilityVal{
+		VisibilityValCollapse,
+		VisibilityValHidden,
+		VisibilityValVisible,
+	}
+}
+
+// Valid reports whether v is one of the VisibilityVal constants.
+func (v VisibilityVal) Valid() bool {
+	switch v {
+	case VisibilityValCollapse,
+		VisibilityValHidden,
+		VisibilityValVisible:
+		return true
+	}
+	return false
+}
+
+// ParseVisibilityVal returns the VisibilityVal constant for a keyword, ignoring ASCII case.
+func ParseVisibilityVal(s string) (VisibilityVal, error) {
+	v := VisibilityVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "VisibilityVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not VisibilityVal constants.
+func (v VisibilityVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "VisibilityVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseVisibilityVal.
+func (v *VisibilityVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseVisibilityVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // WhiteSpaceVal represents values for the white-space property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space
 type WhiteSpaceVal string
 
 // WhiteSpaceVal constants.
 const (
-	WhiteSpaceValNormal             WhiteSpaceVal = "normal"
-	WhiteSpaceValPre                WhiteSpaceVal = "pre"
-	WhiteSpaceValPreLine            WhiteSpaceVal = "pre-line"
-	WhiteSpaceValPreWrap            WhiteSpaceVal = "pre-wrap"
-	WhiteSpaceValTextWrapMode       WhiteSpaceVal = "text-wrap-mode"
-	WhiteSpaceValWhiteSpaceCollapse WhiteSpaceVal = "white-space-collapse"
-)
+	WhiteSpaceValBreakSpaces    WhiteSpaceVal = "break-spaces"
+	WhiteSpaceValCollapse       WhiteSpaceVal = "collapse"
+	WhiteSpaceValNormal         WhiteSpaceVal = "normal"
+	WhiteSpaceValNowrap         WhiteSpaceVal = "nowrap"
+	WhiteSpaceValPre            WhiteSpaceVal = "pre"
+	WhiteSpaceValPreLine        WhiteSpaceVal = "pre-line"
+	WhiteSpaceValPreWrap        WhiteSpaceVal = "pre-wrap"
+	WhiteSpaceValPreserve       WhiteSpaceVal = "preserve"
+	WhiteSpaceValPreserveBreaks WhiteSpaceVal = "preserve-breaks"
+	WhiteSpaceValPreserveSpaces WhiteSpaceVal = "preserve-spaces"
+	WhiteSpaceValWrap           WhiteSpaceVal = "wrap"
+)
+
+func (v WhiteSpaceVal) String() string { return string(v) }
+
+// Values returns every WhiteSpaceVal constant.
+func (WhiteSpaceVal) Values() []WhiteSpaceVal {
+	return []WhiteSpaceVal{
+		WhiteSpaceValBreakSpaces,
+		WhiteSpaceValCollapse,
+		WhiteSpaceValNormal,
+		WhiteSpaceValNowrap,
+		WhiteSpaceValPre,
+		WhiteSpaceValPreLine,
+		WhiteSpaceValPreWrap,
+		WhiteSpaceValPreserve,
+		WhiteSpaceValPreserveBreaks,
+		WhiteSpaceValPreserveSpaces,
+		WhiteSpaceValWrap,
+	}
+}
+
+// Valid reports whether v is one of the WhiteSpaceVal constants.
+func (v WhiteSpaceVal) Valid() bool {
+	switch v {
+	case WhiteSpaceValBreakSpaces,
+		WhiteSpaceValCollapse,
+		WhiteSpaceValNormal,
+		WhiteSpaceValNowrap,
+		WhiteSpaceValPre,
+		WhiteSpaceValPreLine,
+		WhiteSpaceValPreWrap,
+		WhiteSpaceValPreserve,
+		WhiteSpaceValPreserveBreaks,
+		WhiteSpaceValPreserveSpaces,
+		WhiteSpaceValWrap:
+		return true
+	}
+	return false
+}
+
+// ParseWhiteSpaceVal returns the WhiteSpaceVal constant for a keyword, ignoring ASCII case.
+func ParseWhiteSpaceVal(s string) (WhiteSpaceVal, error) {
+	v := WhiteSpaceVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WhiteSpaceVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WhiteSpaceVal constants.
+func (v WhiteSpaceVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WhiteSpaceVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWhiteSpaceVal.
+func (v *WhiteSpaceVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWhiteSpaceVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // WhiteSpaceCollapseVal represents values for the white-space-collapse property.
+//
+//   - Initial: collapse
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	collapse | preserve | preserve-breaks | preserve-spaces | break-spaces
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space-collapse
 type WhiteSpaceCollapseVal string
 
 // WhiteSpaceCollapseVal constants.
@@ -4939,44 +28394,214 @@ const (
 	WhiteSpaceCollapseValPreserveSpaces WhiteSpaceCollapseVal = "preserve-spaces"
 )
 
-// WidowsVal represents values for the widows property.
-type WidowsVal string
-
-// WidowsVal constants.
-const (
-	WidowsValNumberToken WidowsVal = "number-token"
-)
+func (v WhiteSpaceCollapseVal) String() string { return string(v) }
+
+// Values returns every WhiteSpaceCollapseVal constant.
+func (WhiteSpaceCollapseVal) Values() []WhiteSpaceCollapseVal {
+	return []WhiteSpaceCollapseVal{
+		WhiteSpaceCollapseValBreakSpaces,
+		WhiteSpaceCollapseValCollapse,
+		WhiteSpaceCollapseValPreserve,
+		WhiteSpaceCollapseValPreserveBreaks,
+		WhiteSpaceCollapseValPreserveSpaces,
+	}
+}
+
+// Valid reports whether v is one of the WhiteSpaceCollapseVal constants.
+func (v WhiteSpaceCollapseVal) Valid() bool {
+	switch v {
+	case WhiteSpaceCollapseValBreakSpaces,
+		WhiteSpaceCollapseValCollapse,
+		WhiteSpaceCollapseValPreserve,
+		WhiteSpaceCollapseValPreserveBreaks,
+		WhiteSpaceCollapseValPreserveSpaces:
+		return true
+	}
+	return false
+}
+
+// ParseWhiteSpaceCollapseVal returns the WhiteSpaceCollapseVal constant for a keyword, ignoring ASCII case.
+func ParseWhiteSpaceCollapseVal(s string) (WhiteSpaceCollapseVal, error) {
+	v := WhiteSpaceCollapseVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WhiteSpaceCollapseVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WhiteSpaceCollapseVal constants.
+func (v WhiteSpaceCollapseVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WhiteSpaceCollapseVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWhiteSpaceCollapseVal.
+func (v *WhiteSpaceCollapseVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWhiteSpaceCollapseVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // WidthVal represents values for the width property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/width
 type WidthVal string
 
 // WidthVal constants.
 const (
-	WidthVal0                WidthVal = "0"
-	WidthValAnchorName       WidthVal = "anchor-name"
-	WidthValAnchorSize       WidthVal = "anchor-size"
-	WidthValAuto             WidthVal = "auto"
-	WidthValCalcSize         WidthVal = "calc-size"
-	WidthValCalcSizeBasis    WidthVal = "calc-size-basis"
-	WidthValCalcSum          WidthVal = "calc-sum"
-	WidthValFitContent       WidthVal = "fit-content"
-	WidthValLengthPercentage WidthVal = "length-percentage"
-	WidthValMaxContent       WidthVal = "max-content"
-	WidthValMinContent       WidthVal = "min-content"
-)
+	WidthValAuto       WidthVal = "auto"
+	WidthValFitContent WidthVal = "fit-content"
+	WidthValMaxContent WidthVal = "max-content"
+	WidthValMinContent WidthVal = "min-content"
+)
+
+func (v WidthVal) String() string { return string(v) }
+
+// Values returns every WidthVal constant.
+func (WidthVal) Values() []WidthVal {
+	return []WidthVal{
+		WidthValAuto,
+		WidthValFitContent,
+		WidthValMaxContent,
+		WidthValMinContent,
+	}
+}
+
+// Valid reports whether v is one of the WidthVal constants.
+func (v WidthVal) Valid() bool {
+	switch v {
+	case WidthValAuto,
+		WidthValFitContent,
+		WidthValMaxContent,
+		WidthValMinContent:
+		return true
+	}
+	return false
+}
+
+// ParseWidthVal returns the WidthVal constant for a keyword, ignoring ASCII case.
+func ParseWidthVal(s string) (WidthVal, error) {
+	v := WidthVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WidthVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WidthVal constants.
+func (v WidthVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WidthVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWidthVal.
+func (v *WidthVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWidthVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // WillChangeVal represents values for the will-change property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <animateable-feature>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/will-change
 type WillChangeVal string
 
 // WillChangeVal constants.
 const (
 	WillChangeValAuto           WillChangeVal = "auto"
 	WillChangeValContents       WillChangeVal = "contents"
-	WillChangeValCustomIdent    WillChangeVal = "custom-ident"
 	WillChangeValScrollPosition WillChangeVal = "scroll-position"
 )
 
+func (v WillChangeVal) String() string { return string(v) }
+
+// Values returns every WillChangeVal constant.
+func (WillChangeVal) Values() []WillChangeVal {
+	return []WillChangeVal{
+		WillChangeValAuto,
+		WillChangeValContents,
+		WillChangeValScrollPosition,
+	}
+}
+
+// Valid reports whether v is one of the WillChangeVal constants.
+func (v WillChangeVal) Valid() bool {
+	switch v {
+	case WillChangeValAuto,
+		WillChangeValContents,
+		WillChangeValScrollPosition:
+		return true
+	}
+	return false
+}
+
+// ParseWillChangeVal returns the WillChangeVal constant for a keyword, ignoring ASCII case.
+func ParseWillChangeVal(s string) (WillChangeVal, error) {
+	v := WillChangeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WillChangeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WillChangeVal constants.
+func (v WillChangeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WillChangeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWillChangeVal.
+func (v *WillChangeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWillChangeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // WordBreakVal represents values for the word-break property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-all | keep-all | break-word | auto-phrase
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/word-break
 type WordBreakVal string
 
 // WordBreakVal constants.
@@ -4988,7 +28613,71 @@ const (
 	WordBreakValNormal     WordBreakVal = "normal"
 )
 
+func (v WordBreakVal) String() string { return string(v) }
+
+// Values returns every WordBreakVal constant.
+func (WordBreakVal) Values() []WordBreakVal {
+	return []WordBreakVal{
+		WordBreakValAutoPhrase,
+		WordBreakValBreakAll,
+		WordBreakValBreakWord,
+		WordBreakValKeepAll,
+		WordBreakValNormal,
+	}
+}
+
+// Valid reports whether v is one of the WordBreakVal constants.
+func (v WordBreakVal) Valid() bool {
+	switch v {
+	case WordBreakValAutoPhrase,
+		WordBreakValBreakAll,
+		WordBreakValBreakWord,
+		WordBreakValKeepAll,
+		WordBreakValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseWordBreakVal returns the WordBreakVal constant for a keyword, ignoring ASCII case.
+func ParseWordBreakVal(s string) (WordBreakVal, error) {
+	v := WordBreakVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WordBreakVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WordBreakVal constants.
+func (v WordBreakVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WordBreakVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWordBreakVal.
+func (v *WordBreakVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWordBreakVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // WordSpacingVal represents values for the word-spacing property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/word-spacing
 type WordSpacingVal string
 
 // WordSpacingVal constants.
@@ -4996,7 +28685,63 @@ const (
 	WordSpacingValNormal WordSpacingVal = "normal"
 )
 
+func (v WordSpacingVal) String() string { return string(v) }
+
+// Values returns every WordSpacingVal constant.
+func (WordSpacingVal) Values() []WordSpacingVal {
+	return []WordSpacingVal{
+		WordSpacingValNormal,
+	}
+}
+
+// Valid reports whether v is one of the WordSpacingVal constants.
+func (v WordSpacingVal) Valid() bool {
+	switch v {
+	case WordSpacingValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseWordSpacingVal returns the WordSpacingVal constant for a keyword, ignoring ASCII case.
+func ParseWordSpacingVal(s string) (WordSpacingVal, error) {
+	v := WordSpacingVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WordSpacingVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WordSpacingVal constants.
+func (v WordSpacingVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WordSpacingVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWordSpacingVal.
+func (v *WordSpacingVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWordSpacingVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // WordWrapVal represents values for the word-wrap property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-word
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
 type WordWrapVal string
 
 // WordWrapVal constants.
@@ -5005,7 +28750,65 @@ const (
 	WordWrapValNormal    WordWrapVal = "normal"
 )
 
+func (v WordWrapVal) String() string { return string(v) }
+
+// Values returns every WordWrapVal constant.
+func (WordWrapVal) Values() []WordWrapVal {
+	return []WordWrapVal{
+		WordWrapValBreakWord,
+		WordWrapValNormal,
+	}
+}
+
+// Valid reports whether v is one of the WordWrapVal constants.
+func (v WordWrapVal) Valid() bool {
+	switch v {
+	case WordWrapValBreakWord,
+		WordWrapValNormal:
+		return true
+	}
+	return false
+}
+
+// ParseWordWrapVal returns the WordWrapVal constant for a keyword, ignoring ASCII case.
+func ParseWordWrapVal(s string) (WordWrapVal, error) {
+	v := WordWrapVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WordWrapVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WordWrapVal constants.
+func (v WordWrapVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WordWrapVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWordWrapVal.
+func (v *WordWrapVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWordWrapVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // WritingModeVal represents values for the writing-mode property.
+//
+//   - Initial: horizontal-tb
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/writing-mode
 type WritingModeVal string
 
 // WritingModeVal constants.
@@ -5017,21 +28820,187 @@ const (
 	WritingModeValVerticalRl   WritingModeVal = "vertical-rl"
 )
 
+func (v WritingModeVal) String() string { return string(v) }
+
+// Values returns every WritingModeVal constant.
+func (WritingModeVal) Values() []WritingModeVal {
+	return []WritingModeVal{
+		WritingModeValHorizontalTb,
+		WritingModeValSidewaysLr,
+		WritingModeValSidewaysRl,
+		WritingModeValVerticalLr,
+		WritingModeValVerticalRl,
+	}
+}
+
+// Valid reports whether v is one of the WritingModeVal constants.
+func (v WritingModeVal) Valid() bool {
+	switch v {
+	case WritingModeValHorizontalTb,
+		WritingModeValSidewaysLr,
+		WritingModeValSidewaysRl,
+		WritingModeValVerticalLr,
+		WritingModeValVerticalRl:
+		return true
+	}
+	return false
+}
+
+// ParseWritingModeVal returns the WritingModeVal constant for a keyword, ignoring ASCII case.
+func ParseWritingModeVal(s string) (WritingModeVal, error) {
+	v := WritingModeVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "WritingModeVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not WritingModeVal constants.
+func (v WritingModeVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "WritingModeVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseWritingModeVal.
+func (v *WritingModeVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseWritingModeVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
 // ZIndexVal represents values for the z-index property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/z-index
 type ZIndexVal string
 
 // ZIndexVal constants.
 const (
-	ZIndexValAuto        ZIndexVal = "auto"
-	ZIndexValNumberToken ZIndexVal = "number-token"
-)
+	ZIndexValAuto ZIndexVal = "auto"
+)
+
+func (v ZIndexVal) String() string { return string(v) }
+
+// Values returns every ZIndexVal constant.
+func (ZIndexVal) Values() []ZIndexVal {
+	return []ZIndexVal{
+		ZIndexValAuto,
+	}
+}
+
+// Valid reports whether v is one of the ZIndexVal constants.
+func (v ZIndexVal) Valid() bool {
+	switch v {
+	case ZIndexValAuto:
+		return true
+	}
+	return false
+}
+
+// ParseZIndexVal returns the ZIndexVal constant for a keyword, ignoring ASCII case.
+func ParseZIndexVal(s string) (ZIndexVal, error) {
+	v := ZIndexVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ZIndexVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ZIndexVal constants.
+func (v ZIndexVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ZIndexVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseZIndexVal.
+func (v *ZIndexVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseZIndexVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
 
 // ZoomVal represents values for the zoom property.
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | reset | <number [0,∞]> || <percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/zoom
 type ZoomVal string
 
 // ZoomVal constants.
 const (
-	ZoomVal0      ZoomVal = "0"
 	ZoomValNormal ZoomVal = "normal"
 	ZoomValReset  ZoomVal = "reset"
 )
+
+func (v ZoomVal) String() string { return string(v) }
+
+// Values returns every ZoomVal constant.
+func (ZoomVal) Values() []ZoomVal {
+	return []ZoomVal{
+		ZoomValNormal,
+		ZoomValReset,
+	}
+}
+
+// Valid reports whether v is one of the ZoomVal constants.
+func (v ZoomVal) Valid() bool {
+	switch v {
+	case ZoomValNormal,
+		ZoomValReset:
+		return true
+	}
+	return false
+}
+
+// ParseZoomVal returns the ZoomVal constant for a keyword, ignoring ASCII case.
+func ParseZoomVal(s string) (ZoomVal, error) {
+	v := ZoomVal(strings.ToLower(s))
+	if !v.Valid() {
+		return "", &InvalidKeywordError{Type: "ZoomVal", Value: s}
+	}
+	return v, nil
+}
+
+// MarshalText implements encoding.TextMarshaler, rejecting values that are
+// not ZoomVal constants.
+func (v ZoomVal) MarshalText() ([]byte, error) {
+	if !v.Valid() {
+		return nil, &InvalidKeywordError{Type: "ZoomVal", Value: string(v)}
+	}
+	return []byte(v), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseZoomVal.
+func (v *ZoomVal) UnmarshalText(text []byte) error {
+	parsed, err := ParseZoomVal(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
@@ -0,0 +1,500 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
+
+//go:build cssexperimental
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// Property constants for CSS properties.
+const (
+	// AnchorName is the anchor-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | <dashed-ident>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/anchor-name
+	AnchorName css.Property = "anchor-name"
+
+	// AnchorScope is the anchor-scope property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | all | <dashed-ident>#
+	AnchorScope css.Property = "anchor-scope"
+
+	// AnimationRange is the animation-range property.
+	//
+	//   - Shorthand for: animation-range-end, animation-range-start
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ <'animation-range-start'> <'animation-range-end'>? ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range
+	AnimationRange css.Property = "animation-range"
+
+	// AnimationRangeEnd is the animation-range-end property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-end
+	AnimationRangeEnd css.Property = "animation-range-end"
+
+	// AnimationRangeStart is the animation-range-start property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-start
+	AnimationRangeStart css.Property = "animation-range-start"
+
+	// AnimationTimeline is the animation-timeline property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	<single-animation-timeline>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timeline
+	AnimationTimeline css.Property = "animation-timeline"
+
+	// FieldSizing is the field-sizing property.
+	//
+	//   - Initial: fixed
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	content | fixed
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/field-sizing
+	FieldSizing css.Property = "field-sizing"
+
+	// FontSynthesisPosition is the font-synthesis-position property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-position
+	FontSynthesisPosition css.Property = "font-synthesis-position"
+
+	// FontWidth is the font-width property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	normal | <percentage [0,∞]> | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded
+	FontWidth css.Property = "font-width"
+
+	// ImageResolution is the image-resolution property.
+	//
+	//   - Initial: 1dppx
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ from-image || <resolution> ] && snap?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/image-resolution
+	ImageResolution css.Property = "image-resolution"
+
+	// InitialLetterAlign is the initial-letter-align property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ auto | alphabetic | hanging | ideographic ]
+	InitialLetterAlign css.Property = "initial-letter-align"
+
+	// InterpolateSize is the interpolate-size property.
+	//
+	//   - Initial: numeric-only
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	numeric-only | allow-keywords
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/interpolate-size
+	InterpolateSize css.Property = "interpolate-size"
+
+	// LineHeightStep is the line-height-step property.
+	//
+	//   - Initial: 0
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/line-height-step
+	LineHeightStep css.Property = "line-height-step"
+
+	// MarginTrim is the margin-trim property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | in-flow | all
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-trim
+	MarginTrim css.Property = "margin-trim"
+
+	// MathShift is the math-shift property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	normal | compact
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/math-shift
+	MathShift css.Property = "math-shift"
+
+	// MaxLines is the max-lines property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | <integer>
+	MaxLines css.Property = "max-lines"
+
+	// ObjectViewBox is the object-view-box property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | <basic-shape-rect>
+	ObjectViewBox css.Property = "object-view-box"
+
+	// Overlay is the overlay property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overlay
+	Overlay css.Property = "overlay"
+
+	// PositionAnchor is the position-anchor property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	auto | <anchor-name>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-anchor
+	PositionAnchor css.Property = "position-anchor"
+
+	// PositionArea is the position-area property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | <position-area>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-area
+	PositionArea css.Property = "position-area"
+
+	// PositionTry is the position-try property.
+	//
+	//   - Shorthand for: position-try-fallbacks, position-try-order
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	<'position-try-order'>? <'position-try-fallbacks'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try
+	PositionTry css.Property = "position-try"
+
+	// PositionTryFallbacks is the position-try-fallbacks property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | [ [<dashed-ident> || <try-tactic>] | <'position-area'> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-fallbacks
+	PositionTryFallbacks css.Property = "position-try-fallbacks"
+
+	// PositionTryOrder is the position-try-order property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	normal | <try-size>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-order
+	PositionTryOrder css.Property = "position-try-order"
+
+	// PositionVisibility is the position-visibility property.
+	//
+	//   - Initial: anchors-visible
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	always | [ anchors-valid || anchors-visible || no-overflow ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position-visibility
+	PositionVisibility css.Property = "position-visibility"
+
+	// RubyMerge is the ruby-merge property.
+	//
+	//   - Initial: separate
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	separate | collapse | auto
+	RubyMerge css.Property = "ruby-merge"
+
+	// ScrollInitialTarget is the scroll-initial-target property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | nearest
+	ScrollInitialTarget css.Property = "scroll-initial-target"
+
+	// ScrollTimeline is the scroll-timeline property.
+	//
+	//   - Shorthand for: scroll-timeline-axis, scroll-timeline-name
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline
+	ScrollTimeline css.Property = "scroll-timeline"
+
+	// ScrollTimelineAxis is the scroll-timeline-axis property.
+	//
+	//   - Initial: block
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ block | inline | x | y ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-axis
+	ScrollTimelineAxis css.Property = "scroll-timeline-axis"
+
+	// ScrollTimelineName is the scroll-timeline-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ none | <dashed-ident> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-name
+	ScrollTimelineName css.Property = "scroll-timeline-name"
+
+	// SpeakAs is the speak-as property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	normal | spell-out || digits || [ literal-punctuation | no-punctuation ]
+	SpeakAs css.Property = "speak-as"
+
+	// StrokeColor is the stroke-color property.
+	//
+	//   - Initial: transparent
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	<color>
+	StrokeColor css.Property = "stroke-color"
+
+	// TextDecorationSkip is the text-decoration-skip property.
+	//
+	//   - Initial: objects
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | [ objects || [ spaces | [ leading-spaces || trailing-spaces ] ] || edges || box-decoration ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip
+	TextDecorationSkip css.Property = "text-decoration-skip"
+
+	// TextSizeAdjust is the text-size-adjust property.
+	//
+	//   - Initial: autoForSmartphoneBrowsersSupportingInflation
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | auto | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-size-adjust
+	TextSizeAdjust css.Property = "text-size-adjust"
+
+	// TextSpacingTrim is the text-spacing-trim property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	space-all | normal | space-first | trim-start
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-spacing-trim
+	TextSpacingTrim css.Property = "text-spacing-trim"
+
+	// TimelineScope is the timeline-scope property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	none | <dashed-ident>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/timeline-scope
+	TimelineScope css.Property = "timeline-scope"
+
+	// ViewTimeline is the view-timeline property.
+	//
+	//   - Shorthand for: view-timeline-axis, view-timeline-name
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline
+	ViewTimeline css.Property = "view-timeline"
+
+	// ViewTimelineAxis is the view-timeline-axis property.
+	//
+	//   - Initial: block
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ block | inline | x | y ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-axis
+	ViewTimelineAxis css.Property = "view-timeline-axis"
+
+	// ViewTimelineInset is the view-timeline-inset property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ [ auto | <length-percentage> ]{1,2} ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-inset
+	ViewTimelineInset css.Property = "view-timeline-inset"
+
+	// ViewTimelineName is the view-timeline-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: experimental
+	//
+	// Syntax:
+	//
+	//	[ none | <dashed-ident> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-name
+	ViewTimelineName css.Property = "view-timeline-name"
+)
@@ -1,5 +1,5 @@
 // Code generated by cssgen; DO NOT EDIT.
-// Source: spec version mdn-latest at 2025-09-01T01:22:06+03:00
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
 
 package cssgen
 
@@ -7,474 +7,6107 @@ import "github.com/ahmed-com/typesafe-css/css"
 
 // Property constants for CSS properties.
 const (
-	AccentColor                css.Property = "accent-color"
-	AlignContent               css.Property = "align-content"
-	AlignItems                 css.Property = "align-items"
-	AlignSelf                  css.Property = "align-self"
-	AlignTracks                css.Property = "align-tracks"
-	AlignmentBaseline          css.Property = "alignment-baseline"
-	All                        css.Property = "all"
-	Animation                  css.Property = "animation"
-	AnimationComposition       css.Property = "animation-composition"
-	AnimationDelay             css.Property = "animation-delay"
-	AnimationDirection         css.Property = "animation-direction"
-	AnimationDuration          css.Property = "animation-duration"
-	AnimationFillMode          css.Property = "animation-fill-mode"
-	AnimationIterationCount    css.Property = "animation-iteration-count"
-	AnimationName              css.Property = "animation-name"
-	AnimationPlayState         css.Property = "animation-play-state"
-	AnimationTimingFunction    css.Property = "animation-timing-function"
-	Appearance                 css.Property = "appearance"
-	AspectRatio                css.Property = "aspect-ratio"
-	BackdropFilter             css.Property = "backdrop-filter"
-	BackfaceVisibility         css.Property = "backface-visibility"
-	Background                 css.Property = "background"
-	BackgroundAttachment       css.Property = "background-attachment"
-	BackgroundBlendMode        css.Property = "background-blend-mode"
-	BackgroundClip             css.Property = "background-clip"
-	BackgroundColor            css.Property = "background-color"
-	BackgroundImage            css.Property = "background-image"
-	BackgroundOrigin           css.Property = "background-origin"
-	BackgroundPosition         css.Property = "background-position"
-	BackgroundPositionX        css.Property = "background-position-x"
-	BackgroundPositionY        css.Property = "background-position-y"
-	BackgroundRepeat           css.Property = "background-repeat"
-	BackgroundSize             css.Property = "background-size"
-	BaselineShift              css.Property = "baseline-shift"
-	BlockSize                  css.Property = "block-size"
-	Border                     css.Property = "border"
-	BorderBlock                css.Property = "border-block"
-	BorderBlockColor           css.Property = "border-block-color"
-	BorderBlockEnd             css.Property = "border-block-end"
-	BorderBlockEndColor        css.Property = "border-block-end-color"
-	BorderBlockEndStyle        css.Property = "border-block-end-style"
-	BorderBlockEndWidth        css.Property = "border-block-end-width"
-	BorderBlockStart           css.Property = "border-block-start"
-	BorderBlockStartColor      css.Property = "border-block-start-color"
-	BorderBlockStartStyle      css.Property = "border-block-start-style"
-	BorderBlockStartWidth      css.Property = "border-block-start-width"
-	BorderBlockStyle           css.Property = "border-block-style"
-	BorderBlockWidth           css.Property = "border-block-width"
-	BorderBottom               css.Property = "border-bottom"
-	BorderBottomColor          css.Property = "border-bottom-color"
-	BorderBottomLeftRadius     css.Property = "border-bottom-left-radius"
-	BorderBottomRightRadius    css.Property = "border-bottom-right-radius"
-	BorderBottomStyle          css.Property = "border-bottom-style"
-	BorderBottomWidth          css.Property = "border-bottom-width"
-	BorderCollapse             css.Property = "border-collapse"
-	BorderColor                css.Property = "border-color"
-	BorderEndEndRadius         css.Property = "border-end-end-radius"
-	BorderEndStartRadius       css.Property = "border-end-start-radius"
-	BorderImage                css.Property = "border-image"
-	BorderImageOutset          css.Property = "border-image-outset"
-	BorderImageRepeat          css.Property = "border-image-repeat"
-	BorderImageSlice           css.Property = "border-image-slice"
-	BorderImageSource          css.Property = "border-image-source"
-	BorderImageWidth           css.Property = "border-image-width"
-	BorderInline               css.Property = "border-inline"
-	BorderInlineColor          css.Property = "border-inline-color"
-	BorderInlineEnd            css.Property = "border-inline-end"
-	BorderInlineEndColor       css.Property = "border-inline-end-color"
-	BorderInlineEndStyle       css.Property = "border-inline-end-style"
-	BorderInlineEndWidth       css.Property = "border-inline-end-width"
-	BorderInlineStart          css.Property = "border-inline-start"
-	BorderInlineStartColor     css.Property = "border-inline-start-color"
-	BorderInlineStartStyle     css.Property = "border-inline-start-style"
-	BorderInlineStartWidth     css.Property = "border-inline-start-width"
-	BorderInlineStyle          css.Property = "border-inline-style"
-	BorderInlineWidth          css.Property = "border-inline-width"
-	BorderLeft                 css.Property = "border-left"
-	BorderLeftColor            css.Property = "border-left-color"
-	BorderLeftStyle            css.Property = "border-left-style"
-	BorderLeftWidth            css.Property = "border-left-width"
-	BorderRadius               css.Property = "border-radius"
-	BorderRight                css.Property = "border-right"
-	BorderRightColor           css.Property = "border-right-color"
-	BorderRightStyle           css.Property = "border-right-style"
-	BorderRightWidth           css.Property = "border-right-width"
-	BorderSpacing              css.Property = "border-spacing"
-	BorderStartEndRadius       css.Property = "border-start-end-radius"
-	BorderStartStartRadius     css.Property = "border-start-start-radius"
-	BorderStyle                css.Property = "border-style"
-	BorderTop                  css.Property = "border-top"
-	BorderTopColor             css.Property = "border-top-color"
-	BorderTopLeftRadius        css.Property = "border-top-left-radius"
-	BorderTopRightRadius       css.Property = "border-top-right-radius"
-	BorderTopStyle             css.Property = "border-top-style"
-	BorderTopWidth             css.Property = "border-top-width"
-	BorderWidth                css.Property = "border-width"
-	Bottom                     css.Property = "bottom"
-	BoxAlign                   css.Property = "box-align"
-	BoxDecorationBreak         css.Property = "box-decoration-break"
-	BoxDirection               css.Property = "box-direction"
-	BoxFlex                    css.Property = "box-flex"
-	BoxFlexGroup               css.Property = "box-flex-group"
-	BoxLines                   css.Property = "box-lines"
-	BoxOrdinalGroup            css.Property = "box-ordinal-group"
-	BoxOrient                  css.Property = "box-orient"
-	BoxPack                    css.Property = "box-pack"
-	BoxShadow                  css.Property = "box-shadow"
-	BoxSizing                  css.Property = "box-sizing"
-	BreakAfter                 css.Property = "break-after"
-	BreakBefore                css.Property = "break-before"
-	BreakInside                css.Property = "break-inside"
-	CaptionSide                css.Property = "caption-side"
-	Caret                      css.Property = "caret"
-	CaretColor                 css.Property = "caret-color"
-	CaretShape                 css.Property = "caret-shape"
-	Clear                      css.Property = "clear"
-	Clip                       css.Property = "clip"
-	ClipPath                   css.Property = "clip-path"
-	ClipRule                   css.Property = "clip-rule"
-	Color                      css.Property = "color"
-	ColorInterpolationFilters  css.Property = "color-interpolation-filters"
-	ColorScheme                css.Property = "color-scheme"
-	ColumnCount                css.Property = "column-count"
-	ColumnFill                 css.Property = "column-fill"
-	ColumnGap                  css.Property = "column-gap"
-	ColumnRule                 css.Property = "column-rule"
-	ColumnRuleColor            css.Property = "column-rule-color"
-	ColumnRuleStyle            css.Property = "column-rule-style"
-	ColumnRuleWidth            css.Property = "column-rule-width"
-	ColumnSpan                 css.Property = "column-span"
-	ColumnWidth                css.Property = "column-width"
-	Columns                    css.Property = "columns"
-	Contain                    css.Property = "contain"
-	ContainIntrinsicBlockSize  css.Property = "contain-intrinsic-block-size"
-	ContainIntrinsicHeight     css.Property = "contain-intrinsic-height"
+	// AccentColor is the accent-color property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/accent-color
+	AccentColor css.Property = "accent-color"
+
+	// AlignContent is the align-content property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/align-content
+	AlignContent css.Property = "align-content"
+
+	// AlignItems is the align-items property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ] | anchor-center
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/align-items
+	AlignItems css.Property = "align-items"
+
+	// AlignSelf is the align-self property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position> | anchor-center
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/align-self
+	AlignSelf css.Property = "align-self"
+
+	// AlignTracks is the align-tracks property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	[ normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position> ]#
+	AlignTracks css.Property = "align-tracks"
+
+	// AlignmentBaseline is the alignment-baseline property.
+	//
+	//   - Initial: baseline
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/alignment-baseline
+	AlignmentBaseline css.Property = "alignment-baseline"
+
+	// All is the all property.
+	//
+	//   - Initial: noPracticalInitialValue
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	initial | inherit | unset | revert | revert-layer
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/all
+	All css.Property = "all"
+
+	// Animation is the animation property.
+	//
+	//   - Shorthand for: animation-delay, animation-direction, animation-duration, animation-fill-mode, animation-iteration-count, animation-name, animation-play-state, animation-timeline, animation-timing-function
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation
+	Animation css.Property = "animation"
+
+	// AnimationComposition is the animation-composition property.
+	//
+	//   - Initial: replace
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation-composition>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-composition
+	AnimationComposition css.Property = "animation-composition"
+
+	// AnimationDelay is the animation-delay property.
+	//
+	//   - Initial: 0s
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<time>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-delay
+	AnimationDelay css.Property = "animation-delay"
+
+	// AnimationDirection is the animation-direction property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation-direction>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-direction
+	AnimationDirection css.Property = "animation-direction"
+
+	// AnimationDuration is the animation-duration property.
+	//
+	//   - Initial: 0s
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | <time [0s,∞]> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-duration
+	AnimationDuration css.Property = "animation-duration"
+
+	// AnimationFillMode is the animation-fill-mode property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation-fill-mode>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-fill-mode
+	AnimationFillMode css.Property = "animation-fill-mode"
+
+	// AnimationIterationCount is the animation-iteration-count property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation-iteration-count>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-iteration-count
+	AnimationIterationCount css.Property = "animation-iteration-count"
+
+	// AnimationName is the animation-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ none | <keyframes-name> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-name
+	AnimationName css.Property = "animation-name"
+
+	// AnimationPlayState is the animation-play-state property.
+	//
+	//   - Initial: running
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-animation-play-state>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-play-state
+	AnimationPlayState css.Property = "animation-play-state"
+
+	// AnimationTimingFunction is the animation-timing-function property.
+	//
+	//   - Initial: ease
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<easing-function>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timing-function
+	AnimationTimingFunction css.Property = "animation-timing-function"
+
+	// Appearance is the appearance property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | auto | <compat-auto> | <compat-special>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/appearance
+	Appearance css.Property = "appearance"
+
+	// AspectRatio is the aspect-ratio property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto || <ratio>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/aspect-ratio
+	AspectRatio css.Property = "aspect-ratio"
+
+	// BackdropFilter is the backdrop-filter property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <filter-value-list>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/backdrop-filter
+	BackdropFilter css.Property = "backdrop-filter"
+
+	// BackfaceVisibility is the backface-visibility property.
+	//
+	//   - Initial: visible
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/backface-visibility
+	BackfaceVisibility css.Property = "backface-visibility"
+
+	// Background is the background property.
+	//
+	//   - Shorthand for: background-attachment, background-clip, background-color, background-image, background-origin, background-position, background-repeat, background-size
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-layer>#? , <final-bg-layer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background
+	Background css.Property = "background"
+
+	// BackgroundAttachment is the background-attachment property.
+	//
+	//   - Initial: scroll
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<attachment>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-attachment
+	BackgroundAttachment css.Property = "background-attachment"
+
+	// BackgroundBlendMode is the background-blend-mode property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<blend-mode>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-blend-mode
+	BackgroundBlendMode css.Property = "background-blend-mode"
+
+	// BackgroundClip is the background-clip property.
+	//
+	//   - Initial: border-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-clip>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-clip
+	BackgroundClip css.Property = "background-clip"
+
+	// BackgroundColor is the background-color property.
+	//
+	//   - Initial: transparent
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-color
+	BackgroundColor css.Property = "background-color"
+
+	// BackgroundImage is the background-image property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-image>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-image
+	BackgroundImage css.Property = "background-image"
+
+	// BackgroundOrigin is the background-origin property.
+	//
+	//   - Initial: padding-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<visual-box>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-origin
+	BackgroundOrigin css.Property = "background-origin"
+
+	// BackgroundPosition is the background-position property.
+	//
+	//   - Initial: 0% 0%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-position>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position
+	BackgroundPosition css.Property = "background-position"
+
+	// BackgroundPositionX is the background-position-x property.
+	//
+	//   - Initial: 0%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-x
+	BackgroundPositionX css.Property = "background-position-x"
+
+	// BackgroundPositionY is the background-position-y property.
+	//
+	//   - Initial: 0%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-y
+	BackgroundPositionY css.Property = "background-position-y"
+
+	// BackgroundRepeat is the background-repeat property.
+	//
+	//   - Initial: repeat
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<repeat-style>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-repeat
+	BackgroundRepeat css.Property = "background-repeat"
+
+	// BackgroundSize is the background-size property.
+	//
+	//   - Initial: auto auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-size>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/background-size
+	BackgroundSize css.Property = "background-size"
+
+	// BaselineShift is the baseline-shift property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | sub | super | baseline
+	BaselineShift css.Property = "baseline-shift"
+
+	// BlockSize is the block-size property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/block-size
+	BlockSize css.Property = "block-size"
+
+	// Border is the border property.
+	//
+	//   - Shorthand for: border-color, border-style, border-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width> || <line-style> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border
+	Border css.Property = "border"
+
+	// BorderBlock is the border-block property.
+	//
+	//   - Shorthand for: border-block-color, border-block-style, border-block-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-block-start'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block
+	BorderBlock css.Property = "border-block"
+
+	// BorderBlockColor is the border-block-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-color
+	BorderBlockColor css.Property = "border-block-color"
+
+	// BorderBlockEnd is the border-block-end property.
+	//
+	//   - Shorthand for: border-top-color, border-top-style, border-top-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'> || <'border-top-style'> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end
+	BorderBlockEnd css.Property = "border-block-end"
+
+	// BorderBlockEndColor is the border-block-end-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-color
+	BorderBlockEndColor css.Property = "border-block-end-color"
+
+	// BorderBlockEndStyle is the border-block-end-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-style
+	BorderBlockEndStyle css.Property = "border-block-end-style"
+
+	// BorderBlockEndWidth is the border-block-end-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-width
+	BorderBlockEndWidth css.Property = "border-block-end-width"
+
+	// BorderBlockStart is the border-block-start property.
+	//
+	//   - Shorthand for: border-style, border-width, color
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'> || <'border-top-style'> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start
+	BorderBlockStart css.Property = "border-block-start"
+
+	// BorderBlockStartColor is the border-block-start-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-color
+	BorderBlockStartColor css.Property = "border-block-start-color"
+
+	// BorderBlockStartStyle is the border-block-start-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-style
+	BorderBlockStartStyle css.Property = "border-block-start-style"
+
+	// BorderBlockStartWidth is the border-block-start-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-width
+	BorderBlockStartWidth css.Property = "border-block-start-width"
+
+	// BorderBlockStyle is the border-block-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-style
+	BorderBlockStyle css.Property = "border-block-style"
+
+	// BorderBlockWidth is the border-block-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-width
+	BorderBlockWidth css.Property = "border-block-width"
+
+	// BorderBottom is the border-bottom property.
+	//
+	//   - Shorthand for: border-bottom-color, border-bottom-style, border-bottom-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width> || <line-style> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom
+	BorderBottom css.Property = "border-bottom"
+
+	// BorderBottomColor is the border-bottom-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-color
+	BorderBottomColor css.Property = "border-bottom-color"
+
+	// BorderBottomLeftRadius is the border-bottom-left-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-left-radius
+	BorderBottomLeftRadius css.Property = "border-bottom-left-radius"
+
+	// BorderBottomRightRadius is the border-bottom-right-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-right-radius
+	BorderBottomRightRadius css.Property = "border-bottom-right-radius"
+
+	// BorderBottomStyle is the border-bottom-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-style>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-style
+	BorderBottomStyle css.Property = "border-bottom-style"
+
+	// BorderBottomWidth is the border-bottom-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-width
+	BorderBottomWidth css.Property = "border-bottom-width"
+
+	// BorderCollapse is the border-collapse property.
+	//
+	//   - Initial: separate
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	separate | collapse
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-collapse
+	BorderCollapse css.Property = "border-collapse"
+
+	// BorderColor is the border-color property.
+	//
+	//   - Shorthand for: border-bottom-color, border-left-color, border-right-color, border-top-color
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-color
+	BorderColor css.Property = "border-color"
+
+	// BorderEndEndRadius is the border-end-end-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-left-radius'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-end-end-radius
+	BorderEndEndRadius css.Property = "border-end-end-radius"
+
+	// BorderEndStartRadius is the border-end-start-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-left-radius'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-end-start-radius
+	BorderEndStartRadius css.Property = "border-end-start-radius"
+
+	// BorderImage is the border-image property.
+	//
+	//   - Shorthand for: border-image-outset, border-image-repeat, border-image-slice, border-image-source, border-image-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image
+	BorderImage css.Property = "border-image"
+
+	// BorderImageOutset is the border-image-outset property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <length [0,∞]> | <number [0,∞]> ]{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-outset
+	BorderImageOutset css.Property = "border-image-outset"
+
+	// BorderImageRepeat is the border-image-repeat property.
+	//
+	//   - Initial: stretch
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ stretch | repeat | round | space ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-repeat
+	BorderImageRepeat css.Property = "border-image-repeat"
+
+	// BorderImageSlice is the border-image-slice property.
+	//
+	//   - Initial: 100%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <number [0,∞]> | <percentage [0,∞]> ]{1,4}  && fill?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-slice
+	BorderImageSlice css.Property = "border-image-slice"
+
+	// BorderImageSource is the border-image-source property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <image>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-source
+	BorderImageSource css.Property = "border-image-source"
+
+	// BorderImageWidth is the border-image-width property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-width
+	BorderImageWidth css.Property = "border-image-width"
+
+	// BorderInline is the border-inline property.
+	//
+	//   - Shorthand for: border-inline-color, border-inline-style, border-inline-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-block-start'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline
+	BorderInline css.Property = "border-inline"
+
+	// BorderInlineColor is the border-inline-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-color
+	BorderInlineColor css.Property = "border-inline-color"
+
+	// BorderInlineEnd is the border-inline-end property.
+	//
+	//   - Shorthand for: border-style, border-width, color
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'> || <'border-top-style'> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end
+	BorderInlineEnd css.Property = "border-inline-end"
+
+	// BorderInlineEndColor is the border-inline-end-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-color
+	BorderInlineEndColor css.Property = "border-inline-end-color"
+
+	// BorderInlineEndStyle is the border-inline-end-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-style
+	BorderInlineEndStyle css.Property = "border-inline-end-style"
+
+	// BorderInlineEndWidth is the border-inline-end-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-width
+	BorderInlineEndWidth css.Property = "border-inline-end-width"
+
+	// BorderInlineStart is the border-inline-start property.
+	//
+	//   - Shorthand for: border-style, border-width, color
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'> || <'border-top-style'> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start
+	BorderInlineStart css.Property = "border-inline-start"
+
+	// BorderInlineStartColor is the border-inline-start-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-color
+	BorderInlineStartColor css.Property = "border-inline-start-color"
+
+	// BorderInlineStartStyle is the border-inline-start-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-style
+	BorderInlineStartStyle css.Property = "border-inline-start-style"
+
+	// BorderInlineStartWidth is the border-inline-start-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-width
+	BorderInlineStartWidth css.Property = "border-inline-start-width"
+
+	// BorderInlineStyle is the border-inline-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-style'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-style
+	BorderInlineStyle css.Property = "border-inline-style"
+
+	// BorderInlineWidth is the border-inline-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-width'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-width
+	BorderInlineWidth css.Property = "border-inline-width"
+
+	// BorderLeft is the border-left property.
+	//
+	//   - Shorthand for: border-left-color, border-left-style, border-left-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width> || <line-style> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left
+	BorderLeft css.Property = "border-left"
+
+	// BorderLeftColor is the border-left-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-color
+	BorderLeftColor css.Property = "border-left-color"
+
+	// BorderLeftStyle is the border-left-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-style>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-style
+	BorderLeftStyle css.Property = "border-left-style"
+
+	// BorderLeftWidth is the border-left-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-width
+	BorderLeftWidth css.Property = "border-left-width"
+
+	// BorderRadius is the border-radius property.
+	//
+	//   - Shorthand for: border-bottom-left-radius, border-bottom-right-radius, border-top-left-radius, border-top-right-radius
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>{1,4} [ / <length-percentage [0,∞]>{1,4} ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-radius
+	BorderRadius css.Property = "border-radius"
+
+	// BorderRight is the border-right property.
+	//
+	//   - Shorthand for: border-right-color, border-right-style, border-right-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width> || <line-style> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right
+	BorderRight css.Property = "border-right"
+
+	// BorderRightColor is the border-right-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-color
+	BorderRightColor css.Property = "border-right-color"
+
+	// BorderRightStyle is the border-right-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-style>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-style
+	BorderRightStyle css.Property = "border-right-style"
+
+	// BorderRightWidth is the border-right-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-width
+	BorderRightWidth css.Property = "border-right-width"
+
+	// BorderSpacing is the border-spacing property.
+	//
+	//   - Initial: 0
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-spacing
+	BorderSpacing css.Property = "border-spacing"
+
+	// BorderStartEndRadius is the border-start-end-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-left-radius'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-start-end-radius
+	BorderStartEndRadius css.Property = "border-start-end-radius"
+
+	// BorderStartStartRadius is the border-start-start-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-top-left-radius'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-start-start-radius
+	BorderStartStartRadius css.Property = "border-start-start-radius"
+
+	// BorderStyle is the border-style property.
+	//
+	//   - Shorthand for: border-bottom-style, border-left-style, border-right-style, border-top-style
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-style>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-style
+	BorderStyle css.Property = "border-style"
+
+	// BorderTop is the border-top property.
+	//
+	//   - Shorthand for: border-top-color, border-top-style, border-top-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width> || <line-style> || <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top
+	BorderTop css.Property = "border-top"
+
+	// BorderTopColor is the border-top-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-color
+	BorderTopColor css.Property = "border-top-color"
+
+	// BorderTopLeftRadius is the border-top-left-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-left-radius
+	BorderTopLeftRadius css.Property = "border-top-left-radius"
+
+	// BorderTopRightRadius is the border-top-right-radius property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-right-radius
+	BorderTopRightRadius css.Property = "border-top-right-radius"
+
+	// BorderTopStyle is the border-top-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-style>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-style
+	BorderTopStyle css.Property = "border-top-style"
+
+	// BorderTopWidth is the border-top-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-width
+	BorderTopWidth css.Property = "border-top-width"
+
+	// BorderWidth is the border-width property.
+	//
+	//   - Shorthand for: border-bottom-width, border-left-width, border-right-width, border-top-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/border-width
+	BorderWidth css.Property = "border-width"
+
+	// Bottom is the bottom property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/bottom
+	Bottom css.Property = "bottom"
+
+	// BoxAlign is the box-align property.
+	//
+	//   - Initial: stretch
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	start | center | end | baseline | stretch
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-align
+	BoxAlign css.Property = "box-align"
+
+	// BoxDecorationBreak is the box-decoration-break property.
+	//
+	//   - Initial: slice
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	slice | clone
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-decoration-break
+	BoxDecorationBreak css.Property = "box-decoration-break"
+
+	// BoxDirection is the box-direction property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	normal | reverse | inherit
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-direction
+	BoxDirection css.Property = "box-direction"
+
+	// BoxFlex is the box-flex property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	<number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-flex
+	BoxFlex css.Property = "box-flex"
+
+	// BoxFlexGroup is the box-flex-group property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	<integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-flex-group
+	BoxFlexGroup css.Property = "box-flex-group"
+
+	// BoxLines is the box-lines property.
+	//
+	//   - Initial: single
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	single | multiple
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-lines
+	BoxLines css.Property = "box-lines"
+
+	// BoxOrdinalGroup is the box-ordinal-group property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	<integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-ordinal-group
+	BoxOrdinalGroup css.Property = "box-ordinal-group"
+
+	// BoxOrient is the box-orient property.
+	//
+	//   - Initial: inline-axis
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	horizontal | vertical | inline-axis | block-axis | inherit
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-orient
+	BoxOrient css.Property = "box-orient"
+
+	// BoxPack is the box-pack property.
+	//
+	//   - Initial: start
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	start | center | end | justify
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-pack
+	BoxPack css.Property = "box-pack"
+
+	// BoxShadow is the box-shadow property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <shadow>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-shadow
+	BoxShadow css.Property = "box-shadow"
+
+	// BoxSizing is the box-sizing property.
+	//
+	//   - Initial: content-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	content-box | border-box
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/box-sizing
+	BoxSizing css.Property = "box-sizing"
+
+	// BreakAfter is the break-after property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/break-after
+	BreakAfter css.Property = "break-after"
+
+	// BreakBefore is the break-before property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/break-before
+	BreakBefore css.Property = "break-before"
+
+	// BreakInside is the break-inside property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | avoid | avoid-page | avoid-column | avoid-region
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/break-inside
+	BreakInside css.Property = "break-inside"
+
+	// CaptionSide is the caption-side property.
+	//
+	//   - Initial: top
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	top | bottom
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/caption-side
+	CaptionSide css.Property = "caption-side"
+
+	// Caret is the caret property.
+	//
+	//   - Shorthand for: caret-color, caret-shape
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'caret-color'> || <'caret-shape'>
+	Caret css.Property = "caret"
+
+	// CaretColor is the caret-color property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/caret-color
+	CaretColor css.Property = "caret-color"
+
+	// CaretShape is the caret-shape property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | bar | block | underscore
+	CaretShape css.Property = "caret-shape"
+
+	// Clear is the clear property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | left | right | both | inline-start | inline-end
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/clear
+	Clear css.Property = "clear"
+
+	// Clip is the clip property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<shape> | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/clip
+	//
+	// Deprecated: the clip property is obsolete.
+	Clip css.Property = "clip"
+
+	// ClipPath is the clip-path property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<clip-source> | [ <basic-shape> || <geometry-box> ] | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-path
+	ClipPath css.Property = "clip-path"
+
+	// ClipRule is the clip-rule property.
+	//
+	//   - Initial: nonzero
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	nonzero | evenodd
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-rule
+	ClipRule css.Property = "clip-rule"
+
+	// Color is the color property.
+	//
+	//   - Initial: canvastext
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/color
+	Color css.Property = "color"
+
+	// ColorInterpolationFilters is the color-interpolation-filters property.
+	//
+	//   - Initial: linearRGB
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | sRGB | linearRGB
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/color-interpolation-filters
+	ColorInterpolationFilters css.Property = "color-interpolation-filters"
+
+	// ColorScheme is the color-scheme property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ light | dark | <custom-ident> ]+ && only?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/color-scheme
+	ColorScheme css.Property = "color-scheme"
+
+	// ColumnCount is the column-count property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer> | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-count
+	ColumnCount css.Property = "column-count"
+
+	// ColumnFill is the column-fill property.
+	//
+	//   - Initial: balance
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | balance
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-fill
+	ColumnFill css.Property = "column-fill"
+
+	// ColumnGap is the column-gap property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-gap
+	ColumnGap css.Property = "column-gap"
+
+	// ColumnRule is the column-rule property.
+	//
+	//   - Shorthand for: column-rule-color, column-rule-style, column-rule-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule
+	ColumnRule css.Property = "column-rule"
+
+	// ColumnRuleColor is the column-rule-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-color
+	ColumnRuleColor css.Property = "column-rule-color"
+
+	// ColumnRuleStyle is the column-rule-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-style
+	ColumnRuleStyle css.Property = "column-rule-style"
+
+	// ColumnRuleWidth is the column-rule-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'border-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-width
+	ColumnRuleWidth css.Property = "column-rule-width"
+
+	// ColumnSpan is the column-span property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | all
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-span
+	ColumnSpan css.Property = "column-span"
+
+	// ColumnWidth is the column-width property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-width
+	ColumnWidth css.Property = "column-width"
+
+	// Columns is the columns property.
+	//
+	//   - Shorthand for: column-count, column-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'column-width'> || <'column-count'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/columns
+	Columns css.Property = "columns"
+
+	// Contain is the contain property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | strict | content | [ [ size || inline-size ] || layout || style || paint ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain
+	Contain css.Property = "contain"
+
+	// ContainIntrinsicBlockSize is the contain-intrinsic-block-size property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto? [ none | <length> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-block-size
+	ContainIntrinsicBlockSize css.Property = "contain-intrinsic-block-size"
+
+	// ContainIntrinsicHeight is the contain-intrinsic-height property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto? [ none | <length> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-height
+	ContainIntrinsicHeight css.Property = "contain-intrinsic-height"
+
+	// ContainIntrinsicInlineSize is the contain-intrinsic-inline-size property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto? [ none | <length> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-inline-size
 	ContainIntrinsicInlineSize css.Property = "contain-intrinsic-inline-size"
-	ContainIntrinsicSize       css.Property = "contain-intrinsic-size"
-	ContainIntrinsicWidth      css.Property = "contain-intrinsic-width"
-	Container                  css.Property = "container"
-	ContainerName              css.Property = "container-name"
-	ContainerType              css.Property = "container-type"
-	Content                    css.Property = "content"
-	ContentVisibility          css.Property = "content-visibility"
-	CounterIncrement           css.Property = "counter-increment"
-	CounterReset               css.Property = "counter-reset"
-	CounterSet                 css.Property = "counter-set"
-	Cursor                     css.Property = "cursor"
-	Cx                         css.Property = "cx"
-	Cy                         css.Property = "cy"
-	D                          css.Property = "d"
-	Direction                  css.Property = "direction"
-	Display                    css.Property = "display"
-	DominantBaseline           css.Property = "dominant-baseline"
-	EmptyCells                 css.Property = "empty-cells"
-	Fill                       css.Property = "fill"
-	FillOpacity                css.Property = "fill-opacity"
-	FillRule                   css.Property = "fill-rule"
-	Filter                     css.Property = "filter"
-	Flex                       css.Property = "flex"
-	FlexBasis                  css.Property = "flex-basis"
-	FlexDirection              css.Property = "flex-direction"
-	FlexFlow                   css.Property = "flex-flow"
-	FlexGrow                   css.Property = "flex-grow"
-	FlexShrink                 css.Property = "flex-shrink"
-	FlexWrap                   css.Property = "flex-wrap"
-	Float                      css.Property = "float"
-	FloodColor                 css.Property = "flood-color"
-	FloodOpacity               css.Property = "flood-opacity"
-	Font                       css.Property = "font"
-	FontFamily                 css.Property = "font-family"
-	FontFeatureSettings        css.Property = "font-feature-settings"
-	FontKerning                css.Property = "font-kerning"
-	FontLanguageOverride       css.Property = "font-language-override"
-	FontOpticalSizing          css.Property = "font-optical-sizing"
-	FontPalette                css.Property = "font-palette"
-	FontSize                   css.Property = "font-size"
-	FontSizeAdjust             css.Property = "font-size-adjust"
-	FontSmooth                 css.Property = "font-smooth"
-	FontStretch                css.Property = "font-stretch"
-	FontStyle                  css.Property = "font-style"
-	FontSynthesis              css.Property = "font-synthesis"
-	FontSynthesisSmallCaps     css.Property = "font-synthesis-small-caps"
-	FontSynthesisStyle         css.Property = "font-synthesis-style"
-	FontSynthesisWeight        css.Property = "font-synthesis-weight"
-	FontVariant                css.Property = "font-variant"
-	FontVariantAlternates      css.Property = "font-variant-alternates"
-	FontVariantCaps            css.Property = "font-variant-caps"
-	FontVariantEastAsian       css.Property = "font-variant-east-asian"
-	FontVariantEmoji           css.Property = "font-variant-emoji"
-	FontVariantLigatures       css.Property = "font-variant-ligatures"
-	FontVariantNumeric         css.Property = "font-variant-numeric"
-	FontVariantPosition        css.Property = "font-variant-position"
-	FontVariationSettings      css.Property = "font-variation-settings"
-	FontWeight                 css.Property = "font-weight"
-	ForcedColorAdjust          css.Property = "forced-color-adjust"
-	Gap                        css.Property = "gap"
-	Grid                       css.Property = "grid"
-	GridArea                   css.Property = "grid-area"
-	GridAutoColumns            css.Property = "grid-auto-columns"
-	GridAutoFlow               css.Property = "grid-auto-flow"
-	GridAutoRows               css.Property = "grid-auto-rows"
-	GridColumn                 css.Property = "grid-column"
-	GridColumnEnd              css.Property = "grid-column-end"
-	GridColumnGap              css.Property = "grid-column-gap"
-	GridColumnStart            css.Property = "grid-column-start"
-	GridGap                    css.Property = "grid-gap"
-	GridRow                    css.Property = "grid-row"
-	GridRowEnd                 css.Property = "grid-row-end"
-	GridRowGap                 css.Property = "grid-row-gap"
-	GridRowStart               css.Property = "grid-row-start"
-	GridTemplate               css.Property = "grid-template"
-	GridTemplateAreas          css.Property = "grid-template-areas"
-	GridTemplateColumns        css.Property = "grid-template-columns"
-	GridTemplateRows           css.Property = "grid-template-rows"
-	HangingPunctuation         css.Property = "hanging-punctuation"
-	Height                     css.Property = "height"
-	HyphenateCharacter         css.Property = "hyphenate-character"
-	HyphenateLimitChars        css.Property = "hyphenate-limit-chars"
-	Hyphens                    css.Property = "hyphens"
-	ImageOrientation           css.Property = "image-orientation"
-	ImageRendering             css.Property = "image-rendering"
-	ImeMode                    css.Property = "ime-mode"
-	InitialLetter              css.Property = "initial-letter"
-	InlineSize                 css.Property = "inline-size"
-	Inset                      css.Property = "inset"
-	InsetBlock                 css.Property = "inset-block"
-	InsetBlockEnd              css.Property = "inset-block-end"
-	InsetBlockStart            css.Property = "inset-block-start"
-	InsetInline                css.Property = "inset-inline"
-	InsetInlineEnd             css.Property = "inset-inline-end"
-	InsetInlineStart           css.Property = "inset-inline-start"
-	Isolation                  css.Property = "isolation"
-	JustifyContent             css.Property = "justify-content"
-	JustifyItems               css.Property = "justify-items"
-	JustifySelf                css.Property = "justify-self"
-	JustifyTracks              css.Property = "justify-tracks"
-	Left                       css.Property = "left"
-	LetterSpacing              css.Property = "letter-spacing"
-	LightingColor              css.Property = "lighting-color"
-	LineBreak                  css.Property = "line-break"
-	LineClamp                  css.Property = "line-clamp"
-	LineHeight                 css.Property = "line-height"
-	ListStyle                  css.Property = "list-style"
-	ListStyleImage             css.Property = "list-style-image"
-	ListStylePosition          css.Property = "list-style-position"
-	ListStyleType              css.Property = "list-style-type"
-	Margin                     css.Property = "margin"
-	MarginBlock                css.Property = "margin-block"
-	MarginBlockEnd             css.Property = "margin-block-end"
-	MarginBlockStart           css.Property = "margin-block-start"
-	MarginBottom               css.Property = "margin-bottom"
-	MarginInline               css.Property = "margin-inline"
-	MarginInlineEnd            css.Property = "margin-inline-end"
-	MarginInlineStart          css.Property = "margin-inline-start"
-	MarginLeft                 css.Property = "margin-left"
-	MarginRight                css.Property = "margin-right"
-	MarginTop                  css.Property = "margin-top"
-	Marker                     css.Property = "marker"
-	MarkerEnd                  css.Property = "marker-end"
-	MarkerMid                  css.Property = "marker-mid"
-	MarkerStart                css.Property = "marker-start"
-	Mask                       css.Property = "mask"
-	MaskBorder                 css.Property = "mask-border"
-	MaskBorderMode             css.Property = "mask-border-mode"
-	MaskBorderOutset           css.Property = "mask-border-outset"
-	MaskBorderRepeat           css.Property = "mask-border-repeat"
-	MaskBorderSlice            css.Property = "mask-border-slice"
-	MaskBorderSource           css.Property = "mask-border-source"
-	MaskBorderWidth            css.Property = "mask-border-width"
-	MaskClip                   css.Property = "mask-clip"
-	MaskComposite              css.Property = "mask-composite"
-	MaskImage                  css.Property = "mask-image"
-	MaskMode                   css.Property = "mask-mode"
-	MaskOrigin                 css.Property = "mask-origin"
-	MaskPosition               css.Property = "mask-position"
-	MaskRepeat                 css.Property = "mask-repeat"
-	MaskSize                   css.Property = "mask-size"
-	MaskType                   css.Property = "mask-type"
-	MasonryAutoFlow            css.Property = "masonry-auto-flow"
-	MathDepth                  css.Property = "math-depth"
-	MathStyle                  css.Property = "math-style"
-	MaxBlockSize               css.Property = "max-block-size"
-	MaxHeight                  css.Property = "max-height"
-	MaxInlineSize              css.Property = "max-inline-size"
-	MaxWidth                   css.Property = "max-width"
-	MinBlockSize               css.Property = "min-block-size"
-	MinHeight                  css.Property = "min-height"
-	MinInlineSize              css.Property = "min-inline-size"
-	MinWidth                   css.Property = "min-width"
-	MixBlendMode               css.Property = "mix-blend-mode"
-	ObjectFit                  css.Property = "object-fit"
-	ObjectPosition             css.Property = "object-position"
-	Offset                     css.Property = "offset"
-	OffsetAnchor               css.Property = "offset-anchor"
-	OffsetDistance             css.Property = "offset-distance"
-	OffsetPath                 css.Property = "offset-path"
-	OffsetPosition             css.Property = "offset-position"
-	OffsetRotate               css.Property = "offset-rotate"
-	Opacity                    css.Property = "opacity"
-	Order                      css.Property = "order"
-	Orphans                    css.Property = "orphans"
-	Outline                    css.Property = "outline"
-	OutlineColor               css.Property = "outline-color"
-	OutlineOffset              css.Property = "outline-offset"
-	OutlineStyle               css.Property = "outline-style"
-	OutlineWidth               css.Property = "outline-width"
-	Overflow                   css.Property = "overflow"
-	OverflowAnchor             css.Property = "overflow-anchor"
-	OverflowBlock              css.Property = "overflow-block"
-	OverflowClipBox            css.Property = "overflow-clip-box"
-	OverflowClipMargin         css.Property = "overflow-clip-margin"
-	OverflowInline             css.Property = "overflow-inline"
-	OverflowWrap               css.Property = "overflow-wrap"
-	OverflowX                  css.Property = "overflow-x"
-	OverflowY                  css.Property = "overflow-y"
-	OverscrollBehavior         css.Property = "overscroll-behavior"
-	OverscrollBehaviorBlock    css.Property = "overscroll-behavior-block"
-	OverscrollBehaviorInline   css.Property = "overscroll-behavior-inline"
-	OverscrollBehaviorX        css.Property = "overscroll-behavior-x"
-	OverscrollBehaviorY        css.Property = "overscroll-behavior-y"
-	Padding                    css.Property = "padding"
-	PaddingBlock               css.Property = "padding-block"
-	PaddingBlockEnd            css.Property = "padding-block-end"
-	PaddingBlockStart          css.Property = "padding-block-start"
-	PaddingBottom              css.Property = "padding-bottom"
-	PaddingInline              css.Property = "padding-inline"
-	PaddingInlineEnd           css.Property = "padding-inline-end"
-	PaddingInlineStart         css.Property = "padding-inline-start"
-	PaddingLeft                css.Property = "padding-left"
-	PaddingRight               css.Property = "padding-right"
-	PaddingTop                 css.Property = "padding-top"
-	Page                       css.Property = "page"
-	PageBreakAfter             css.Property = "page-break-after"
-	PageBreakBefore            css.Property = "page-break-before"
-	PageBreakInside            css.Property = "page-break-inside"
-	PaintOrder                 css.Property = "paint-order"
-	Perspective                css.Property = "perspective"
-	PerspectiveOrigin          css.Property = "perspective-origin"
-	PlaceContent               css.Property = "place-content"
-	PlaceItems                 css.Property = "place-items"
-	PlaceSelf                  css.Property = "place-self"
-	PointerEvents              css.Property = "pointer-events"
-	Position                   css.Property = "position"
-	PrintColorAdjust           css.Property = "print-color-adjust"
-	Quotes                     css.Property = "quotes"
-	R                          css.Property = "r"
-	Resize                     css.Property = "resize"
-	Right                      css.Property = "right"
-	Rotate                     css.Property = "rotate"
-	RowGap                     css.Property = "row-gap"
-	RubyAlign                  css.Property = "ruby-align"
-	RubyOverhang               css.Property = "ruby-overhang"
-	RubyPosition               css.Property = "ruby-position"
-	Rx                         css.Property = "rx"
-	Ry                         css.Property = "ry"
-	Scale                      css.Property = "scale"
-	ScrollBehavior             css.Property = "scroll-behavior"
-	ScrollMargin               css.Property = "scroll-margin"
-	ScrollMarginBlock          css.Property = "scroll-margin-block"
-	ScrollMarginBlockEnd       css.Property = "scroll-margin-block-end"
-	ScrollMarginBlockStart     css.Property = "scroll-margin-block-start"
-	ScrollMarginBottom         css.Property = "scroll-margin-bottom"
-	ScrollMarginInline         css.Property = "scroll-margin-inline"
-	ScrollMarginInlineEnd      css.Property = "scroll-margin-inline-end"
-	ScrollMarginInlineStart    css.Property = "scroll-margin-inline-start"
-	ScrollMarginLeft           css.Property = "scroll-margin-left"
-	ScrollMarginRight          css.Property = "scroll-margin-right"
-	ScrollMarginTop            css.Property = "scroll-margin-top"
-	ScrollPadding              css.Property = "scroll-padding"
-	ScrollPaddingBlock         css.Property = "scroll-padding-block"
-	ScrollPaddingBlockEnd      css.Property = "scroll-padding-block-end"
-	ScrollPaddingBlockStart    css.Property = "scroll-padding-block-start"
-	ScrollPaddingBottom        css.Property = "scroll-padding-bottom"
-	ScrollPaddingInline        css.Property = "scroll-padding-inline"
-	ScrollPaddingInlineEnd     css.Property = "scroll-padding-inline-end"
-	ScrollPaddingInlineStart   css.Property = "scroll-padding-inline-start"
-	ScrollPaddingLeft          css.Property = "scroll-padding-left"
-	ScrollPaddingRight         css.Property = "scroll-padding-right"
-	ScrollPaddingTop           css.Property = "scroll-padding-top"
-	ScrollSnapAlign            css.Property = "scroll-snap-align"
-	ScrollSnapCoordinate       css.Property = "scroll-snap-coordinate"
-	ScrollSnapDestination      css.Property = "scroll-snap-destination"
-	ScrollSnapPointsX          css.Property = "scroll-snap-points-x"
-	ScrollSnapPointsY          css.Property = "scroll-snap-points-y"
-	ScrollSnapStop             css.Property = "scroll-snap-stop"
-	ScrollSnapType             css.Property = "scroll-snap-type"
-	ScrollSnapTypeX            css.Property = "scroll-snap-type-x"
-	ScrollSnapTypeY            css.Property = "scroll-snap-type-y"
-	ScrollbarColor             css.Property = "scrollbar-color"
-	ScrollbarGutter            css.Property = "scrollbar-gutter"
-	ScrollbarWidth             css.Property = "scrollbar-width"
-	ShapeImageThreshold        css.Property = "shape-image-threshold"
-	ShapeMargin                css.Property = "shape-margin"
-	ShapeOutside               css.Property = "shape-outside"
-	ShapeRendering             css.Property = "shape-rendering"
-	StopColor                  css.Property = "stop-color"
-	StopOpacity                css.Property = "stop-opacity"
-	Stroke                     css.Property = "stroke"
-	StrokeDasharray            css.Property = "stroke-dasharray"
-	StrokeDashoffset           css.Property = "stroke-dashoffset"
-	StrokeLinecap              css.Property = "stroke-linecap"
-	StrokeLinejoin             css.Property = "stroke-linejoin"
-	StrokeMiterlimit           css.Property = "stroke-miterlimit"
-	StrokeOpacity              css.Property = "stroke-opacity"
-	StrokeWidth                css.Property = "stroke-width"
-	TabSize                    css.Property = "tab-size"
-	TableLayout                css.Property = "table-layout"
-	TextAlign                  css.Property = "text-align"
-	TextAlignLast              css.Property = "text-align-last"
-	TextAnchor                 css.Property = "text-anchor"
-	TextAutospace              css.Property = "text-autospace"
-	TextBox                    css.Property = "text-box"
-	TextBoxEdge                css.Property = "text-box-edge"
-	TextBoxTrim                css.Property = "text-box-trim"
-	TextCombineUpright         css.Property = "text-combine-upright"
-	TextDecoration             css.Property = "text-decoration"
-	TextDecorationColor        css.Property = "text-decoration-color"
-	TextDecorationLine         css.Property = "text-decoration-line"
-	TextDecorationSkipInk      css.Property = "text-decoration-skip-ink"
-	TextDecorationStyle        css.Property = "text-decoration-style"
-	TextDecorationThickness    css.Property = "text-decoration-thickness"
-	TextEmphasis               css.Property = "text-emphasis"
-	TextEmphasisColor          css.Property = "text-emphasis-color"
-	TextEmphasisPosition       css.Property = "text-emphasis-position"
-	TextEmphasisStyle          css.Property = "text-emphasis-style"
-	TextIndent                 css.Property = "text-indent"
-	TextJustify                css.Property = "text-justify"
-	TextOrientation            css.Property = "text-orientation"
-	TextOverflow               css.Property = "text-overflow"
-	TextRendering              css.Property = "text-rendering"
-	TextShadow                 css.Property = "text-shadow"
-	TextTransform              css.Property = "text-transform"
-	TextUnderlineOffset        css.Property = "text-underline-offset"
-	TextUnderlinePosition      css.Property = "text-underline-position"
-	TextWrap                   css.Property = "text-wrap"
-	TextWrapMode               css.Property = "text-wrap-mode"
-	TextWrapStyle              css.Property = "text-wrap-style"
-	Top                        css.Property = "top"
-	TouchAction                css.Property = "touch-action"
-	Transform                  css.Property = "transform"
-	TransformBox               css.Property = "transform-box"
-	TransformOrigin            css.Property = "transform-origin"
-	TransformStyle             css.Property = "transform-style"
-	Transition                 css.Property = "transition"
-	TransitionBehavior         css.Property = "transition-behavior"
-	TransitionDelay            css.Property = "transition-delay"
-	TransitionDuration         css.Property = "transition-duration"
-	TransitionProperty         css.Property = "transition-property"
-	TransitionTimingFunction   css.Property = "transition-timing-function"
-	Translate                  css.Property = "translate"
-	UnicodeBidi                css.Property = "unicode-bidi"
-	UserSelect                 css.Property = "user-select"
-	VectorEffect               css.Property = "vector-effect"
-	VerticalAlign              css.Property = "vertical-align"
-	ViewTransitionClass        css.Property = "view-transition-class"
-	ViewTransitionName         css.Property = "view-transition-name"
-	Visibility                 css.Property = "visibility"
-	WhiteSpace                 css.Property = "white-space"
-	WhiteSpaceCollapse         css.Property = "white-space-collapse"
-	Widows                     css.Property = "widows"
-	Width                      css.Property = "width"
-	WillChange                 css.Property = "will-change"
-	WordBreak                  css.Property = "word-break"
-	WordSpacing                css.Property = "word-spacing"
-	WordWrap                   css.Property = "word-wrap"
-	WritingMode                css.Property = "writing-mode"
-	X                          css.Property = "x"
-	Y                          css.Property = "y"
-	ZIndex                     css.Property = "z-index"
-	Zoom                       css.Property = "zoom"
+
+	// ContainIntrinsicSize is the contain-intrinsic-size property.
+	//
+	//   - Shorthand for: contain-intrinsic-height, contain-intrinsic-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto? [ none | <length> ] ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-size
+	ContainIntrinsicSize css.Property = "contain-intrinsic-size"
+
+	// ContainIntrinsicWidth is the contain-intrinsic-width property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto? [ none | <length> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-width
+	ContainIntrinsicWidth css.Property = "contain-intrinsic-width"
+
+	// Container is the container property.
+	//
+	//   - Shorthand for: container-name, container-type
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'container-name'> [ / <'container-type'> ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/container
+	Container css.Property = "container"
+
+	// ContainerName is the container-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <custom-ident>+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/container-name
+	ContainerName css.Property = "container-name"
+
+	// ContainerType is the container-type property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ [ size | inline-size ] || scroll-state ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/container-type
+	ContainerType css.Property = "container-type"
+
+	// Content is the content property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | none | [ <content-replacement> | <content-list> ] [ / [ <string> | <counter> | <attr()> ]+ ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/content
+	Content css.Property = "content"
+
+	// ContentVisibility is the content-visibility property.
+	//
+	//   - Initial: visible
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | auto | hidden
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/content-visibility
+	ContentVisibility css.Property = "content-visibility"
+
+	// CounterIncrement is the counter-increment property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <counter-name> <integer>? ]+ | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-increment
+	CounterIncrement css.Property = "counter-increment"
+
+	// CounterReset is the counter-reset property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <counter-name> <integer>? | <reversed-counter-name> <integer>? ]+ | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-reset
+	CounterReset css.Property = "counter-reset"
+
+	// CounterSet is the counter-set property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <counter-name> <integer>? ]+ | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-set
+	CounterSet css.Property = "counter-set"
+
+	// Cursor is the cursor property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/cursor
+	Cursor css.Property = "cursor"
+
+	// Cx is the cx property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/cx
+	Cx css.Property = "cx"
+
+	// Cy is the cy property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/cy
+	Cy css.Property = "cy"
+
+	// D is the d property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | path(<string>)
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/d
+	D css.Property = "d"
+
+	// Direction is the direction property.
+	//
+	//   - Initial: ltr
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	ltr | rtl
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/direction
+	Direction css.Property = "direction"
+
+	// Display is the display property.
+	//
+	//   - Initial: inline
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/display
+	Display css.Property = "display"
+
+	// DominantBaseline is the dominant-baseline property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/dominant-baseline
+	DominantBaseline css.Property = "dominant-baseline"
+
+	// EmptyCells is the empty-cells property.
+	//
+	//   - Initial: show
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	show | hide
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/empty-cells
+	EmptyCells css.Property = "empty-cells"
+
+	// Fill is the fill property.
+	//
+	//   - Initial: black
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<paint>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/fill
+	Fill css.Property = "fill"
+
+	// FillOpacity is the fill-opacity property.
+	//
+	//   - Initial: 1
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'opacity'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/fill-opacity
+	FillOpacity css.Property = "fill-opacity"
+
+	// FillRule is the fill-rule property.
+	//
+	//   - Initial: nonzero
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	nonzero | evenodd
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/fill-rule
+	FillRule css.Property = "fill-rule"
+
+	// Filter is the filter property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <filter-value-list>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/filter
+	Filter css.Property = "filter"
+
+	// Flex is the flex property.
+	//
+	//   - Shorthand for: flex-basis, flex-grow, flex-shrink
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex
+	Flex css.Property = "flex"
+
+	// FlexBasis is the flex-basis property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	content | <'width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-basis
+	FlexBasis css.Property = "flex-basis"
+
+	// FlexDirection is the flex-direction property.
+	//
+	//   - Initial: row
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	row | row-reverse | column | column-reverse
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-direction
+	FlexDirection css.Property = "flex-direction"
+
+	// FlexFlow is the flex-flow property.
+	//
+	//   - Shorthand for: flex-direction, flex-wrap
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'flex-direction'> || <'flex-wrap'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-flow
+	FlexFlow css.Property = "flex-flow"
+
+	// FlexGrow is the flex-grow property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-grow
+	FlexGrow css.Property = "flex-grow"
+
+	// FlexShrink is the flex-shrink property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-shrink
+	FlexShrink css.Property = "flex-shrink"
+
+	// FlexWrap is the flex-wrap property.
+	//
+	//   - Initial: nowrap
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	nowrap | wrap | wrap-reverse
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-wrap
+	FlexWrap css.Property = "flex-wrap"
+
+	// Float is the float property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	left | right | none | inline-start | inline-end
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/float
+	Float css.Property = "float"
+
+	// FloodColor is the flood-color property.
+	//
+	//   - Initial: black
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flood-color
+	FloodColor css.Property = "flood-color"
+
+	// FloodOpacity is the flood-opacity property.
+	//
+	//   - Initial: black
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'opacity'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/flood-opacity
+	FloodOpacity css.Property = "flood-opacity"
+
+	// Font is the font property.
+	//
+	//   - Shorthand for: font-family, font-size, font-stretch, font-style, font-variant, font-weight, line-height
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'># ] | <system-family-name>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font
+	Font css.Property = "font"
+
+	// FontFamily is the font-family property.
+	//
+	//   - Initial: dependsOnUserAgent
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <family-name> | <generic-family> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-family
+	FontFamily css.Property = "font-family"
+
+	// FontFeatureSettings is the font-feature-settings property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <feature-tag-value>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-feature-settings
+	FontFeatureSettings css.Property = "font-feature-settings"
+
+	// FontKerning is the font-kerning property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | normal | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-kerning
+	FontKerning css.Property = "font-kerning"
+
+	// FontLanguageOverride is the font-language-override property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <string>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-language-override
+	FontLanguageOverride css.Property = "font-language-override"
+
+	// FontOpticalSizing is the font-optical-sizing property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-optical-sizing
+	FontOpticalSizing css.Property = "font-optical-sizing"
+
+	// FontPalette is the font-palette property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | light | dark | <palette-identifier> | <palette-mix()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-palette
+	FontPalette css.Property = "font-palette"
+
+	// FontSize is the font-size property.
+	//
+	//   - Initial: medium
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size
+	FontSize css.Property = "font-size"
+
+	// FontSizeAdjust is the font-size-adjust property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size-adjust
+	FontSizeAdjust css.Property = "font-size-adjust"
+
+	// FontSmooth is the font-smooth property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	auto | never | always | <absolute-size> | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-smooth
+	FontSmooth css.Property = "font-smooth"
+
+	// FontStretch is the font-stretch property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<font-stretch-absolute>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-stretch
+	//
+	// Deprecated: the font-stretch property is obsolete.
+	FontStretch css.Property = "font-stretch"
+
+	// FontStyle is the font-style property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | italic | oblique <angle>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-style
+	FontStyle css.Property = "font-style"
+
+	// FontSynthesis is the font-synthesis property.
+	//
+	//   - Initial: weight style small-caps position
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ weight || style || small-caps || position]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis
+	FontSynthesis css.Property = "font-synthesis"
+
+	// FontSynthesisSmallCaps is the font-synthesis-small-caps property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-small-caps
+	FontSynthesisSmallCaps css.Property = "font-synthesis-small-caps"
+
+	// FontSynthesisStyle is the font-synthesis-style property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-style
+	FontSynthesisStyle css.Property = "font-synthesis-style"
+
+	// FontSynthesisWeight is the font-synthesis-weight property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-weight
+	FontSynthesisWeight css.Property = "font-synthesis-weight"
+
+	// FontVariant is the font-variant property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) || [ small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps ] || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant
+	FontVariant css.Property = "font-variant"
+
+	// FontVariantAlternates is the font-variant-alternates property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-alternates
+	FontVariantAlternates css.Property = "font-variant-alternates"
+
+	// FontVariantCaps is the font-variant-caps property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-caps
+	FontVariantCaps css.Property = "font-variant-caps"
+
+	// FontVariantEastAsian is the font-variant-east-asian property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-east-asian
+	FontVariantEastAsian css.Property = "font-variant-east-asian"
+
+	// FontVariantEmoji is the font-variant-emoji property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | text | emoji | unicode
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-emoji
+	FontVariantEmoji css.Property = "font-variant-emoji"
+
+	// FontVariantLigatures is the font-variant-ligatures property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-ligatures
+	FontVariantLigatures css.Property = "font-variant-ligatures"
+
+	// FontVariantNumeric is the font-variant-numeric property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-numeric
+	FontVariantNumeric css.Property = "font-variant-numeric"
+
+	// FontVariantPosition is the font-variant-position property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | sub | super
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-position
+	FontVariantPosition css.Property = "font-variant-position"
+
+	// FontVariationSettings is the font-variation-settings property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ <string> <number> ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variation-settings
+	FontVariationSettings css.Property = "font-variation-settings"
+
+	// FontWeight is the font-weight property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<font-weight-absolute> | bolder | lighter
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/font-weight
+	FontWeight css.Property = "font-weight"
+
+	// ForcedColorAdjust is the forced-color-adjust property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none | preserve-parent-color
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/forced-color-adjust
+	ForcedColorAdjust css.Property = "forced-color-adjust"
+
+	// Gap is the gap property.
+	//
+	//   - Shorthand for: column-gap, row-gap
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'row-gap'> <'column-gap'>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/gap
+	Gap css.Property = "gap"
+
+	// Grid is the grid property.
+	//
+	//   - Shorthand for: column-gap, grid-auto-columns, grid-auto-flow, grid-auto-rows, grid-column-gap, grid-row-gap, grid-template-areas, grid-template-columns, grid-template-rows, row-gap
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid
+	Grid css.Property = "grid"
+
+	// GridArea is the grid-area property.
+	//
+	//   - Shorthand for: grid-column-end, grid-column-start, grid-row-end, grid-row-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line> [ / <grid-line> ]{0,3}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-area
+	GridArea css.Property = "grid-area"
+
+	// GridAutoColumns is the grid-auto-columns property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<track-size>+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-columns
+	GridAutoColumns css.Property = "grid-auto-columns"
+
+	// GridAutoFlow is the grid-auto-flow property.
+	//
+	//   - Initial: row
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ row | column ] || dense
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
+	GridAutoFlow css.Property = "grid-auto-flow"
+
+	// GridAutoRows is the grid-auto-rows property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<track-size>+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-rows
+	GridAutoRows css.Property = "grid-auto-rows"
+
+	// GridColumn is the grid-column property.
+	//
+	//   - Shorthand for: grid-column-end, grid-column-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line> [ / <grid-line> ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column
+	GridColumn css.Property = "grid-column"
+
+	// GridColumnEnd is the grid-column-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-end
+	GridColumnEnd css.Property = "grid-column-end"
+
+	// GridColumnGap is the grid-column-gap property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/column-gap
+	//
+	// Deprecated: the grid-column-gap property is obsolete.
+	GridColumnGap css.Property = "grid-column-gap"
+
+	// GridColumnStart is the grid-column-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-start
+	GridColumnStart css.Property = "grid-column-start"
+
+	// GridGap is the grid-gap property.
+	//
+	//   - Shorthand for: grid-column-gap, grid-row-gap
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<'grid-row-gap'> <'grid-column-gap'>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/gap
+	//
+	// Deprecated: the grid-gap property is obsolete.
+	GridGap css.Property = "grid-gap"
+
+	// GridRow is the grid-row property.
+	//
+	//   - Shorthand for: grid-row-end, grid-row-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line> [ / <grid-line> ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row
+	GridRow css.Property = "grid-row"
+
+	// GridRowEnd is the grid-row-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-end
+	GridRowEnd css.Property = "grid-row-end"
+
+	// GridRowGap is the grid-row-gap property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/row-gap
+	//
+	// Deprecated: the grid-row-gap property is obsolete.
+	GridRowGap css.Property = "grid-row-gap"
+
+	// GridRowStart is the grid-row-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<grid-line>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-start
+	GridRowStart css.Property = "grid-row-start"
+
+	// GridTemplate is the grid-template property.
+	//
+	//   - Shorthand for: grid-template-areas, grid-template-columns, grid-template-rows
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template
+	GridTemplate css.Property = "grid-template"
+
+	// GridTemplateAreas is the grid-template-areas property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <string>+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-areas
+	GridTemplateAreas css.Property = "grid-template-areas"
+
+	// GridTemplateColumns is the grid-template-columns property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-columns
+	GridTemplateColumns css.Property = "grid-template-columns"
+
+	// GridTemplateRows is the grid-template-rows property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-rows
+	GridTemplateRows css.Property = "grid-template-rows"
+
+	// HangingPunctuation is the hanging-punctuation property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ first || [ force-end | allow-end ] || last ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/hanging-punctuation
+	HangingPunctuation css.Property = "hanging-punctuation"
+
+	// Height is the height property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/height
+	Height css.Property = "height"
+
+	// HyphenateCharacter is the hyphenate-character property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <string>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-character
+	HyphenateCharacter css.Property = "hyphenate-character"
+
+	// HyphenateLimitChars is the hyphenate-limit-chars property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | <integer> ]{1,3}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-limit-chars
+	HyphenateLimitChars css.Property = "hyphenate-limit-chars"
+
+	// Hyphens is the hyphens property.
+	//
+	//   - Initial: manual
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | manual | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphens
+	Hyphens css.Property = "hyphens"
+
+	// ImageOrientation is the image-orientation property.
+	//
+	//   - Initial: from-image
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	from-image | <angle> | [ <angle>? flip ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/image-orientation
+	ImageOrientation css.Property = "image-orientation"
+
+	// ImageRendering is the image-rendering property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | crisp-edges | pixelated | smooth
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/image-rendering
+	ImageRendering css.Property = "image-rendering"
+
+	// ImeMode is the ime-mode property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	auto | normal | active | inactive | disabled
+	//
+	// Deprecated: the ime-mode property is obsolete.
+	ImeMode css.Property = "ime-mode"
+
+	// InitialLetter is the initial-letter property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ <number> <integer>? ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/initial-letter
+	InitialLetter css.Property = "initial-letter"
+
+	// InlineSize is the inline-size property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inline-size
+	InlineSize css.Property = "inline-size"
+
+	// Inset is the inset property.
+	//
+	//   - Shorthand for: bottom, left, right, top
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset
+	Inset css.Property = "inset"
+
+	// InsetBlock is the inset-block property.
+	//
+	//   - Shorthand for: inset-block-end, inset-block-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block
+	InsetBlock css.Property = "inset-block"
+
+	// InsetBlockEnd is the inset-block-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-end
+	InsetBlockEnd css.Property = "inset-block-end"
+
+	// InsetBlockStart is the inset-block-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-start
+	InsetBlockStart css.Property = "inset-block-start"
+
+	// InsetInline is the inset-inline property.
+	//
+	//   - Shorthand for: inset-inline-end, inset-inline-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline
+	InsetInline css.Property = "inset-inline"
+
+	// InsetInlineEnd is the inset-inline-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-end
+	InsetInlineEnd css.Property = "inset-inline-end"
+
+	// InsetInlineStart is the inset-inline-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-start
+	InsetInlineStart css.Property = "inset-inline-start"
+
+	// Isolation is the isolation property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | isolate
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/isolation
+	Isolation css.Property = "isolation"
+
+	// JustifyContent is the justify-content property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-content
+	JustifyContent css.Property = "justify-content"
+
+	// JustifyItems is the justify-items property.
+	//
+	//   - Initial: legacy
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ] | anchor-center
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-items
+	JustifyItems css.Property = "justify-items"
+
+	// JustifySelf is the justify-self property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | anchor-center
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-self
+	JustifySelf css.Property = "justify-self"
+
+	// JustifyTracks is the justify-tracks property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	[ normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ] ]#
+	JustifyTracks css.Property = "justify-tracks"
+
+	// Left is the left property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/left
+	Left css.Property = "left"
+
+	// LetterSpacing is the letter-spacing property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/letter-spacing
+	LetterSpacing css.Property = "letter-spacing"
+
+	// LightingColor is the lighting-color property.
+	//
+	//   - Initial: white
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/lighting-color
+	LightingColor css.Property = "lighting-color"
+
+	// LineBreak is the line-break property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | loose | normal | strict | anywhere
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/line-break
+	LineBreak css.Property = "line-break"
+
+	// LineClamp is the line-clamp property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/line-clamp
+	LineClamp css.Property = "line-clamp"
+
+	// LineHeight is the line-height property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <number> | <length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/line-height
+	LineHeight css.Property = "line-height"
+
+	// ListStyle is the list-style property.
+	//
+	//   - Shorthand for: list-style-image, list-style-position, list-style-type
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'list-style-type'> || <'list-style-position'> || <'list-style-image'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style
+	ListStyle css.Property = "list-style"
+
+	// ListStyleImage is the list-style-image property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<image> | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-image
+	ListStyleImage css.Property = "list-style-image"
+
+	// ListStylePosition is the list-style-position property.
+	//
+	//   - Initial: outside
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	inside | outside
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-position
+	ListStylePosition css.Property = "list-style-position"
+
+	// ListStyleType is the list-style-type property.
+	//
+	//   - Initial: disc
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<counter-style> | <string> | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-type
+	ListStyleType css.Property = "list-style-type"
+
+	// Margin is the margin property.
+	//
+	//   - Shorthand for: margin-bottom, margin-left, margin-right, margin-top
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin
+	Margin css.Property = "margin"
+
+	// MarginBlock is the margin-block property.
+	//
+	//   - Shorthand for: margin-block-end, margin-block-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block
+	MarginBlock css.Property = "margin-block"
+
+	// MarginBlockEnd is the margin-block-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-end
+	MarginBlockEnd css.Property = "margin-block-end"
+
+	// MarginBlockStart is the margin-block-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-start
+	MarginBlockStart css.Property = "margin-block-start"
+
+	// MarginBottom is the margin-bottom property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | auto | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-bottom
+	MarginBottom css.Property = "margin-bottom"
+
+	// MarginInline is the margin-inline property.
+	//
+	//   - Shorthand for: margin-inline-end, margin-inline-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline
+	MarginInline css.Property = "margin-inline"
+
+	// MarginInlineEnd is the margin-inline-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-end
+	MarginInlineEnd css.Property = "margin-inline-end"
+
+	// MarginInlineStart is the margin-inline-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'margin-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-start
+	MarginInlineStart css.Property = "margin-inline-start"
+
+	// MarginLeft is the margin-left property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | auto | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-left
+	MarginLeft css.Property = "margin-left"
+
+	// MarginRight is the margin-right property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | auto | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-right
+	MarginRight css.Property = "margin-right"
+
+	// MarginTop is the margin-top property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | auto | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-top
+	MarginTop css.Property = "margin-top"
+
+	// Marker is the marker property.
+	//
+	//   - Shorthand for: marker-end, marker-mid, marker-start
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <url>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/marker
+	Marker css.Property = "marker"
+
+	// MarkerEnd is the marker-end property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <url>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-end
+	MarkerEnd css.Property = "marker-end"
+
+	// MarkerMid is the marker-mid property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <url>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-mid
+	MarkerMid css.Property = "marker-mid"
+
+	// MarkerStart is the marker-start property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <url>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-start
+	MarkerStart css.Property = "marker-start"
+
+	// Mask is the mask property.
+	//
+	//   - Shorthand for: mask-clip, mask-composite, mask-image, mask-mode, mask-origin, mask-position, mask-repeat, mask-size
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<mask-layer>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask
+	Mask css.Property = "mask"
+
+	// MaskBorder is the mask-border property.
+	//
+	//   - Shorthand for: mask-border-mode, mask-border-outset, mask-border-repeat, mask-border-slice, mask-border-source, mask-border-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border
+	MaskBorder css.Property = "mask-border"
+
+	// MaskBorderMode is the mask-border-mode property.
+	//
+	//   - Initial: alpha
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	luminance | alpha
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-mode
+	MaskBorderMode css.Property = "mask-border-mode"
+
+	// MaskBorderOutset is the mask-border-outset property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <length> | <number> ]{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-outset
+	MaskBorderOutset css.Property = "mask-border-outset"
+
+	// MaskBorderRepeat is the mask-border-repeat property.
+	//
+	//   - Initial: stretch
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ stretch | repeat | round | space ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-repeat
+	MaskBorderRepeat css.Property = "mask-border-repeat"
+
+	// MaskBorderSlice is the mask-border-slice property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<number-percentage>{1,4} fill?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-slice
+	MaskBorderSlice css.Property = "mask-border-slice"
+
+	// MaskBorderSource is the mask-border-source property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <image>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-source
+	MaskBorderSource css.Property = "mask-border-source"
+
+	// MaskBorderWidth is the mask-border-width property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <length-percentage> | <number> | auto ]{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-width
+	MaskBorderWidth css.Property = "mask-border-width"
+
+	// MaskClip is the mask-clip property.
+	//
+	//   - Initial: border-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <coord-box> | no-clip ]#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-clip
+	MaskClip css.Property = "mask-clip"
+
+	// MaskComposite is the mask-composite property.
+	//
+	//   - Initial: add
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<compositing-operator>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-composite
+	MaskComposite css.Property = "mask-composite"
+
+	// MaskImage is the mask-image property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<mask-reference>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-image
+	MaskImage css.Property = "mask-image"
+
+	// MaskMode is the mask-mode property.
+	//
+	//   - Initial: match-source
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<masking-mode>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-mode
+	MaskMode css.Property = "mask-mode"
+
+	// MaskOrigin is the mask-origin property.
+	//
+	//   - Initial: border-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<coord-box>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-origin
+	MaskOrigin css.Property = "mask-origin"
+
+	// MaskPosition is the mask-position property.
+	//
+	//   - Initial: 0% 0%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<position>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-position
+	MaskPosition css.Property = "mask-position"
+
+	// MaskRepeat is the mask-repeat property.
+	//
+	//   - Initial: repeat
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<repeat-style>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-repeat
+	MaskRepeat css.Property = "mask-repeat"
+
+	// MaskSize is the mask-size property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<bg-size>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-size
+	MaskSize css.Property = "mask-size"
+
+	// MaskType is the mask-type property.
+	//
+	//   - Initial: luminance
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	luminance | alpha
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-type
+	MaskType css.Property = "mask-type"
+
+	// MasonryAutoFlow is the masonry-auto-flow property.
+	//
+	//   - Initial: pack
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	[ pack | next ] || [ definite-first | ordered ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
+	MasonryAutoFlow css.Property = "masonry-auto-flow"
+
+	// MathDepth is the math-depth property.
+	//
+	//   - Initial: 0
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto-add | add(<integer>) | <integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/math-depth
+	MathDepth css.Property = "math-depth"
+
+	// MathStyle is the math-style property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | compact
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/math-style
+	MathStyle css.Property = "math-style"
+
+	// MaxBlockSize is the max-block-size property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'max-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/max-block-size
+	MaxBlockSize css.Property = "max-block-size"
+
+	// MaxHeight is the max-height property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/max-height
+	MaxHeight css.Property = "max-height"
+
+	// MaxInlineSize is the max-inline-size property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'max-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/max-inline-size
+	MaxInlineSize css.Property = "max-inline-size"
+
+	// MaxWidth is the max-width property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/max-width
+	MaxWidth css.Property = "max-width"
+
+	// MinBlockSize is the min-block-size property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'min-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/min-block-size
+	MinBlockSize css.Property = "min-block-size"
+
+	// MinHeight is the min-height property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/min-height
+	MinHeight css.Property = "min-height"
+
+	// MinInlineSize is the min-inline-size property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'min-width'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/min-inline-size
+	MinInlineSize css.Property = "min-inline-size"
+
+	// MinWidth is the min-width property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/min-width
+	MinWidth css.Property = "min-width"
+
+	// MixBlendMode is the mix-blend-mode property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<blend-mode> | plus-darker | plus-lighter
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/mix-blend-mode
+	MixBlendMode css.Property = "mix-blend-mode"
+
+	// ObjectFit is the object-fit property.
+	//
+	//   - Initial: fill
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	fill | contain | cover | none | scale-down
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/object-fit
+	ObjectFit css.Property = "object-fit"
+
+	// ObjectPosition is the object-position property.
+	//
+	//   - Initial: 50% 50%
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<position>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/object-position
+	ObjectPosition css.Property = "object-position"
+
+	// Offset is the offset property.
+	//
+	//   - Shorthand for: offset-anchor, offset-distance, offset-path, offset-position, offset-rotate
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset
+	Offset css.Property = "offset"
+
+	// OffsetAnchor is the offset-anchor property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <position>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-anchor
+	OffsetAnchor css.Property = "offset-anchor"
+
+	// OffsetDistance is the offset-distance property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-distance
+	OffsetDistance css.Property = "offset-distance"
+
+	// OffsetPath is the offset-path property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <offset-path> || <coord-box>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-path
+	OffsetPath css.Property = "offset-path"
+
+	// OffsetPosition is the offset-position property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | auto | <position>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-position
+	OffsetPosition css.Property = "offset-position"
+
+	// OffsetRotate is the offset-rotate property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | reverse ] || <angle>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-rotate
+	OffsetRotate css.Property = "offset-rotate"
+
+	// Opacity is the opacity property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<opacity-value>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/opacity
+	Opacity css.Property = "opacity"
+
+	// Order is the order property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/order
+	Order css.Property = "order"
+
+	// Orphans is the orphans property.
+	//
+	//   - Initial: 2
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/orphans
+	Orphans css.Property = "orphans"
+
+	// Outline is the outline property.
+	//
+	//   - Shorthand for: outline-color, outline-style, outline-width
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'outline-width'> || <'outline-style'> || <'outline-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/outline
+	Outline css.Property = "outline"
+
+	// OutlineColor is the outline-color property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-color
+	OutlineColor css.Property = "outline-color"
+
+	// OutlineOffset is the outline-offset property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-offset
+	OutlineOffset css.Property = "outline-offset"
+
+	// OutlineStyle is the outline-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <outline-line-style>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-style
+	OutlineStyle css.Property = "outline-style"
+
+	// OutlineWidth is the outline-width property.
+	//
+	//   - Initial: medium
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<line-width>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-width
+	OutlineWidth css.Property = "outline-width"
+
+	// Overflow is the overflow property.
+	//
+	//   - Initial: visible
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ visible | hidden | clip | scroll | auto ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow
+	Overflow css.Property = "overflow"
+
+	// OverflowAnchor is the overflow-anchor property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-anchor
+	OverflowAnchor css.Property = "overflow-anchor"
+
+	// OverflowBlock is the overflow-block property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden | clip | scroll | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-block
+	OverflowBlock css.Property = "overflow-block"
+
+	// OverflowClipBox is the overflow-clip-box property.
+	//
+	//   - Initial: padding-box
+	//   - Inherited: no
+	//   - Status: nonstandard
+	//
+	// Syntax:
+	//
+	//	padding-box | content-box
+	OverflowClipBox css.Property = "overflow-clip-box"
+
+	// OverflowClipMargin is the overflow-clip-margin property.
+	//
+	//   - Initial: 0px
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<visual-box> || <length [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-clip-margin
+	OverflowClipMargin css.Property = "overflow-clip-margin"
+
+	// OverflowInline is the overflow-inline property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden | clip | scroll | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-inline
+	OverflowInline css.Property = "overflow-inline"
+
+	// OverflowWrap is the overflow-wrap property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | break-word | anywhere
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
+	OverflowWrap css.Property = "overflow-wrap"
+
+	// OverflowX is the overflow-x property.
+	//
+	//   - Initial: visible
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden | clip | scroll | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-x
+	OverflowX css.Property = "overflow-x"
+
+	// OverflowY is the overflow-y property.
+	//
+	//   - Initial: visible
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden | clip | scroll | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-y
+	OverflowY css.Property = "overflow-y"
+
+	// OverscrollBehavior is the overscroll-behavior property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ contain | none | auto ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior
+	OverscrollBehavior css.Property = "overscroll-behavior"
+
+	// OverscrollBehaviorBlock is the overscroll-behavior-block property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	contain | none | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-block
+	OverscrollBehaviorBlock css.Property = "overscroll-behavior-block"
+
+	// OverscrollBehaviorInline is the overscroll-behavior-inline property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	contain | none | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-inline
+	OverscrollBehaviorInline css.Property = "overscroll-behavior-inline"
+
+	// OverscrollBehaviorX is the overscroll-behavior-x property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	contain | none | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-x
+	OverscrollBehaviorX css.Property = "overscroll-behavior-x"
+
+	// OverscrollBehaviorY is the overscroll-behavior-y property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	contain | none | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-y
+	OverscrollBehaviorY css.Property = "overscroll-behavior-y"
+
+	// Padding is the padding property.
+	//
+	//   - Shorthand for: padding-bottom, padding-left, padding-right, padding-top
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding
+	Padding css.Property = "padding"
+
+	// PaddingBlock is the padding-block property.
+	//
+	//   - Shorthand for: padding-block-end, padding-block-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block
+	PaddingBlock css.Property = "padding-block"
+
+	// PaddingBlockEnd is the padding-block-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block-end
+	PaddingBlockEnd css.Property = "padding-block-end"
+
+	// PaddingBlockStart is the padding-block-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block-start
+	PaddingBlockStart css.Property = "padding-block-start"
+
+	// PaddingBottom is the padding-bottom property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-bottom
+	PaddingBottom css.Property = "padding-bottom"
+
+	// PaddingInline is the padding-inline property.
+	//
+	//   - Shorthand for: padding-inline-end, padding-inline-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline
+	PaddingInline css.Property = "padding-inline"
+
+	// PaddingInlineEnd is the padding-inline-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline-end
+	PaddingInlineEnd css.Property = "padding-inline-end"
+
+	// PaddingInlineStart is the padding-inline-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'padding-top'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline-start
+	PaddingInlineStart css.Property = "padding-inline-start"
+
+	// PaddingLeft is the padding-left property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-left
+	PaddingLeft css.Property = "padding-left"
+
+	// PaddingRight is the padding-right property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-right
+	PaddingRight css.Property = "padding-right"
+
+	// PaddingTop is the padding-top property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-top
+	PaddingTop css.Property = "padding-top"
+
+	// Page is the page property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <custom-ident>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/page
+	Page css.Property = "page"
+
+	// PageBreakAfter is the page-break-after property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	auto | always | avoid | left | right | recto | verso
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-after
+	//
+	// Deprecated: the page-break-after property is obsolete.
+	PageBreakAfter css.Property = "page-break-after"
+
+	// PageBreakBefore is the page-break-before property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	auto | always | avoid | left | right | recto | verso
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-before
+	//
+	// Deprecated: the page-break-before property is obsolete.
+	PageBreakBefore css.Property = "page-break-before"
+
+	// PageBreakInside is the page-break-inside property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	auto | avoid
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-inside
+	//
+	// Deprecated: the page-break-inside property is obsolete.
+	PageBreakInside css.Property = "page-break-inside"
+
+	// PaintOrder is the paint-order property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | [ fill || stroke || markers ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/paint-order
+	PaintOrder css.Property = "paint-order"
+
+	// Perspective is the perspective property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective
+	Perspective css.Property = "perspective"
+
+	// PerspectiveOrigin is the perspective-origin property.
+	//
+	//   - Initial: 50% 50%
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<position>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective-origin
+	PerspectiveOrigin css.Property = "perspective-origin"
+
+	// PlaceContent is the place-content property.
+	//
+	//   - Shorthand for: align-content, justify-content
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'align-content'> <'justify-content'>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/place-content
+	PlaceContent css.Property = "place-content"
+
+	// PlaceItems is the place-items property.
+	//
+	//   - Shorthand for: align-items, justify-items
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'align-items'> <'justify-items'>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/place-items
+	PlaceItems css.Property = "place-items"
+
+	// PlaceSelf is the place-self property.
+	//
+	//   - Shorthand for: align-self, justify-self
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'align-self'> <'justify-self'>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/place-self
+	PlaceSelf css.Property = "place-self"
+
+	// PointerEvents is the pointer-events property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/pointer-events
+	PointerEvents css.Property = "pointer-events"
+
+	// Position is the position property.
+	//
+	//   - Initial: static
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	static | relative | absolute | sticky | fixed
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/position
+	Position css.Property = "position"
+
+	// PrintColorAdjust is the print-color-adjust property.
+	//
+	//   - Initial: economy
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	economy | exact
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/print-color-adjust
+	PrintColorAdjust css.Property = "print-color-adjust"
+
+	// Quotes is the quotes property.
+	//
+	//   - Initial: dependsOnUserAgent
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | auto | [ <string> <string> ]+
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/quotes
+	Quotes css.Property = "quotes"
+
+	// R is the r property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/r
+	R css.Property = "r"
+
+	// Resize is the resize property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | both | horizontal | vertical | block | inline
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/resize
+	Resize css.Property = "resize"
+
+	// Right is the right property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/right
+	Right css.Property = "right"
+
+	// Rotate is the rotate property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <angle> | [ x | y | z | <number>{3} ] && <angle>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/rotate
+	Rotate css.Property = "rotate"
+
+	// RowGap is the row-gap property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/row-gap
+	RowGap css.Property = "row-gap"
+
+	// RubyAlign is the ruby-align property.
+	//
+	//   - Initial: space-around
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	start | center | space-between | space-around
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-align
+	RubyAlign css.Property = "ruby-align"
+
+	// RubyOverhang is the ruby-overhang property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none
+	RubyOverhang css.Property = "ruby-overhang"
+
+	// RubyPosition is the ruby-position property.
+	//
+	//   - Initial: alternate
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ alternate || [ over | under ] ] | inter-character
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-position
+	RubyPosition css.Property = "ruby-position"
+
+	// Rx is the rx property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/rx
+	Rx css.Property = "rx"
+
+	// Ry is the ry property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/ry
+	Ry css.Property = "ry"
+
+	// Scale is the scale property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ <number> | <percentage> ]{1,3}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scale
+	Scale css.Property = "scale"
+
+	// ScrollBehavior is the scroll-behavior property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | smooth
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-behavior
+	ScrollBehavior css.Property = "scroll-behavior"
+
+	// ScrollMargin is the scroll-margin property.
+	//
+	//   - Shorthand for: scroll-margin-bottom, scroll-margin-left, scroll-margin-right, scroll-margin-top
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin
+	ScrollMargin css.Property = "scroll-margin"
+
+	// ScrollMarginBlock is the scroll-margin-block property.
+	//
+	//   - Shorthand for: scroll-margin-block-end, scroll-margin-block-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block
+	ScrollMarginBlock css.Property = "scroll-margin-block"
+
+	// ScrollMarginBlockEnd is the scroll-margin-block-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-end
+	ScrollMarginBlockEnd css.Property = "scroll-margin-block-end"
+
+	// ScrollMarginBlockStart is the scroll-margin-block-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-start
+	ScrollMarginBlockStart css.Property = "scroll-margin-block-start"
+
+	// ScrollMarginBottom is the scroll-margin-bottom property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-bottom
+	ScrollMarginBottom css.Property = "scroll-margin-bottom"
+
+	// ScrollMarginInline is the scroll-margin-inline property.
+	//
+	//   - Shorthand for: scroll-margin-inline-end, scroll-margin-inline-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline
+	ScrollMarginInline css.Property = "scroll-margin-inline"
+
+	// ScrollMarginInlineEnd is the scroll-margin-inline-end property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-end
+	ScrollMarginInlineEnd css.Property = "scroll-margin-inline-end"
+
+	// ScrollMarginInlineStart is the scroll-margin-inline-start property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-start
+	ScrollMarginInlineStart css.Property = "scroll-margin-inline-start"
+
+	// ScrollMarginLeft is the scroll-margin-left property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-left
+	ScrollMarginLeft css.Property = "scroll-margin-left"
+
+	// ScrollMarginRight is the scroll-margin-right property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-right
+	ScrollMarginRight css.Property = "scroll-margin-right"
+
+	// ScrollMarginTop is the scroll-margin-top property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-top
+	ScrollMarginTop css.Property = "scroll-margin-top"
+
+	// ScrollPadding is the scroll-padding property.
+	//
+	//   - Shorthand for: scroll-padding-bottom, scroll-padding-left, scroll-padding-right, scroll-padding-top
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | <length-percentage> ]{1,4}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding
+	ScrollPadding css.Property = "scroll-padding"
+
+	// ScrollPaddingBlock is the scroll-padding-block property.
+	//
+	//   - Shorthand for: scroll-padding-block-end, scroll-padding-block-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | <length-percentage> ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block
+	ScrollPaddingBlock css.Property = "scroll-padding-block"
+
+	// ScrollPaddingBlockEnd is the scroll-padding-block-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-end
+	ScrollPaddingBlockEnd css.Property = "scroll-padding-block-end"
+
+	// ScrollPaddingBlockStart is the scroll-padding-block-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-start
+	ScrollPaddingBlockStart css.Property = "scroll-padding-block-start"
+
+	// ScrollPaddingBottom is the scroll-padding-bottom property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-bottom
+	ScrollPaddingBottom css.Property = "scroll-padding-bottom"
+
+	// ScrollPaddingInline is the scroll-padding-inline property.
+	//
+	//   - Shorthand for: scroll-padding-inline-end, scroll-padding-inline-start
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ auto | <length-percentage> ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline
+	ScrollPaddingInline css.Property = "scroll-padding-inline"
+
+	// ScrollPaddingInlineEnd is the scroll-padding-inline-end property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-end
+	ScrollPaddingInlineEnd css.Property = "scroll-padding-inline-end"
+
+	// ScrollPaddingInlineStart is the scroll-padding-inline-start property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-start
+	ScrollPaddingInlineStart css.Property = "scroll-padding-inline-start"
+
+	// ScrollPaddingLeft is the scroll-padding-left property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-left
+	ScrollPaddingLeft css.Property = "scroll-padding-left"
+
+	// ScrollPaddingRight is the scroll-padding-right property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-right
+	ScrollPaddingRight css.Property = "scroll-padding-right"
+
+	// ScrollPaddingTop is the scroll-padding-top property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-top
+	ScrollPaddingTop css.Property = "scroll-padding-top"
+
+	// ScrollSnapAlign is the scroll-snap-align property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ none | start | end | center ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-align
+	ScrollSnapAlign css.Property = "scroll-snap-align"
+
+	// ScrollSnapCoordinate is the scroll-snap-coordinate property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	none | <position>#
+	//
+	// Deprecated: the scroll-snap-coordinate property is obsolete.
+	ScrollSnapCoordinate css.Property = "scroll-snap-coordinate"
+
+	// ScrollSnapDestination is the scroll-snap-destination property.
+	//
+	//   - Initial: 0px 0px
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	<position>
+	//
+	// Deprecated: the scroll-snap-destination property is obsolete.
+	ScrollSnapDestination css.Property = "scroll-snap-destination"
+
+	// ScrollSnapPointsX is the scroll-snap-points-x property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	none | repeat( <length-percentage> )
+	//
+	// Deprecated: the scroll-snap-points-x property is obsolete.
+	ScrollSnapPointsX css.Property = "scroll-snap-points-x"
+
+	// ScrollSnapPointsY is the scroll-snap-points-y property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	none | repeat( <length-percentage> )
+	//
+	// Deprecated: the scroll-snap-points-y property is obsolete.
+	ScrollSnapPointsY css.Property = "scroll-snap-points-y"
+
+	// ScrollSnapStop is the scroll-snap-stop property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | always
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-stop
+	ScrollSnapStop css.Property = "scroll-snap-stop"
+
+	// ScrollSnapType is the scroll-snap-type property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ x | y | block | inline | both ] [ mandatory | proximity ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-type
+	ScrollSnapType css.Property = "scroll-snap-type"
+
+	// ScrollSnapTypeX is the scroll-snap-type-x property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	none | mandatory | proximity
+	//
+	// Deprecated: the scroll-snap-type-x property is obsolete.
+	ScrollSnapTypeX css.Property = "scroll-snap-type-x"
+
+	// ScrollSnapTypeY is the scroll-snap-type-y property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: obsolete
+	//
+	// Syntax:
+	//
+	//	none | mandatory | proximity
+	//
+	// Deprecated: the scroll-snap-type-y property is obsolete.
+	ScrollSnapTypeY css.Property = "scroll-snap-type-y"
+
+	// ScrollbarColor is the scrollbar-color property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <color>{2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-color
+	ScrollbarColor css.Property = "scrollbar-color"
+
+	// ScrollbarGutter is the scrollbar-gutter property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | stable && both-edges?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-gutter
+	ScrollbarGutter css.Property = "scrollbar-gutter"
+
+	// ScrollbarWidth is the scrollbar-width property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | thin | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-width
+	ScrollbarWidth css.Property = "scrollbar-width"
+
+	// ShapeImageThreshold is the shape-image-threshold property.
+	//
+	//   - Initial: 0.0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<opacity-value>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-image-threshold
+	ShapeImageThreshold css.Property = "shape-image-threshold"
+
+	// ShapeMargin is the shape-margin property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-margin
+	ShapeMargin css.Property = "shape-margin"
+
+	// ShapeOutside is the shape-outside property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ <shape-box> || <basic-shape> ] | <image>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-outside
+	ShapeOutside css.Property = "shape-outside"
+
+	// ShapeRendering is the shape-rendering property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | optimizeSpeed | crispEdges | geometricPrecision
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-rendering
+	ShapeRendering css.Property = "shape-rendering"
+
+	// StopColor is the stop-color property.
+	//
+	//   - Initial: black
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stop-color
+	StopColor css.Property = "stop-color"
+
+	// StopOpacity is the stop-opacity property.
+	//
+	//   - Initial: black
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'opacity'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stop-opacity
+	StopOpacity css.Property = "stop-opacity"
+
+	// Stroke is the stroke property.
+	//
+	//   - Shorthand for: stroke-dasharray, stroke-dashoffset, stroke-linecap, stroke-linejoin, stroke-miterlimit, stroke-opacity, stroke-width
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<paint>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke
+	Stroke css.Property = "stroke"
+
+	// StrokeDasharray is the stroke-dasharray property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <dasharray>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-dasharray
+	StrokeDasharray css.Property = "stroke-dasharray"
+
+	// StrokeDashoffset is the stroke-dashoffset property.
+	//
+	//   - Initial: 0
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | <number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-dashoffset
+	StrokeDashoffset css.Property = "stroke-dashoffset"
+
+	// StrokeLinecap is the stroke-linecap property.
+	//
+	//   - Initial: butt
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	butt | round | square
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linecap
+	StrokeLinecap css.Property = "stroke-linecap"
+
+	// StrokeLinejoin is the stroke-linejoin property.
+	//
+	//   - Initial: miter
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	miter | miter-clip | round | bevel | arcs
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linejoin
+	StrokeLinejoin css.Property = "stroke-linejoin"
+
+	// StrokeMiterlimit is the stroke-miterlimit property.
+	//
+	//   - Initial: 4
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-miterlimit
+	StrokeMiterlimit css.Property = "stroke-miterlimit"
+
+	// StrokeOpacity is the stroke-opacity property.
+	//
+	//   - Initial: 1
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'opacity'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-opacity
+	StrokeOpacity css.Property = "stroke-opacity"
+
+	// StrokeWidth is the stroke-width property.
+	//
+	//   - Initial: 1px
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> | <number>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-width
+	StrokeWidth css.Property = "stroke-width"
+
+	// TabSize is the tab-size property.
+	//
+	//   - Initial: 8
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer> | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/tab-size
+	TabSize css.Property = "tab-size"
+
+	// TableLayout is the table-layout property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | fixed
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/table-layout
+	TableLayout css.Property = "table-layout"
+
+	// TextAlign is the text-align property.
+	//
+	//   - Initial: startOrNamelessValueIfLTRRightIfRTL
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	start | end | left | right | center | justify | match-parent
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align
+	TextAlign css.Property = "text-align"
+
+	// TextAlignLast is the text-align-last property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | start | end | left | right | center | justify
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align-last
+	TextAlignLast css.Property = "text-align-last"
+
+	// TextAnchor is the text-anchor property.
+	//
+	//   - Initial: start
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	start | middle | end
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-anchor
+	TextAnchor css.Property = "text-anchor"
+
+	// TextAutospace is the text-autospace property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <autospace> | auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-autospace
+	TextAutospace css.Property = "text-autospace"
+
+	// TextBox is the text-box property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <'text-box-trim'> || <'text-box-edge'>
+	TextBox css.Property = "text-box"
+
+	// TextBoxEdge is the text-box-edge property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <text-edge>
+	TextBoxEdge css.Property = "text-box-edge"
+
+	// TextBoxTrim is the text-box-trim property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | trim-start | trim-end | trim-both
+	TextBoxTrim css.Property = "text-box-trim"
+
+	// TextCombineUpright is the text-combine-upright property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | all | [ digits <integer>? ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-combine-upright
+	TextCombineUpright css.Property = "text-combine-upright"
+
+	// TextDecoration is the text-decoration property.
+	//
+	//   - Shorthand for: text-decoration-color, text-decoration-line, text-decoration-style
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration
+	TextDecoration css.Property = "text-decoration"
+
+	// TextDecorationColor is the text-decoration-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-color
+	TextDecorationColor css.Property = "text-decoration-color"
+
+	// TextDecorationLine is the text-decoration-line property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-line
+	TextDecorationLine css.Property = "text-decoration-line"
+
+	// TextDecorationSkipInk is the text-decoration-skip-ink property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | all | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip-ink
+	TextDecorationSkipInk css.Property = "text-decoration-skip-ink"
+
+	// TextDecorationStyle is the text-decoration-style property.
+	//
+	//   - Initial: solid
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	solid | double | dotted | dashed | wavy
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-style
+	TextDecorationStyle css.Property = "text-decoration-style"
+
+	// TextDecorationThickness is the text-decoration-thickness property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | from-font | <length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-thickness
+	TextDecorationThickness css.Property = "text-decoration-thickness"
+
+	// TextEmphasis is the text-emphasis property.
+	//
+	//   - Shorthand for: text-emphasis-color, text-emphasis-style
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'text-emphasis-style'> || <'text-emphasis-color'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis
+	TextEmphasis css.Property = "text-emphasis"
+
+	// TextEmphasisColor is the text-emphasis-color property.
+	//
+	//   - Initial: currentcolor
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<color>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-color
+	TextEmphasisColor css.Property = "text-emphasis-color"
+
+	// TextEmphasisPosition is the text-emphasis-position property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | [ over | under ] && [ right | left ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-position
+	TextEmphasisPosition css.Property = "text-emphasis-position"
+
+	// TextEmphasisStyle is the text-emphasis-style property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-style
+	TextEmphasisStyle css.Property = "text-emphasis-style"
+
+	// TextIndent is the text-indent property.
+	//
+	//   - Initial: 0
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length-percentage> && hanging? && each-line?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-indent
+	TextIndent css.Property = "text-indent"
+
+	// TextJustify is the text-justify property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | inter-character | inter-word | none
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-justify
+	TextJustify css.Property = "text-justify"
+
+	// TextOrientation is the text-orientation property.
+	//
+	//   - Initial: mixed
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	mixed | upright | sideways
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-orientation
+	TextOrientation css.Property = "text-orientation"
+
+	// TextOverflow is the text-overflow property.
+	//
+	//   - Initial: clip
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ clip | ellipsis | <string> ]{1,2}
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-overflow
+	TextOverflow css.Property = "text-overflow"
+
+	// TextRendering is the text-rendering property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | optimizeSpeed | optimizeLegibility | geometricPrecision
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-rendering
+	TextRendering css.Property = "text-rendering"
+
+	// TextShadow is the text-shadow property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <shadow-t>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-shadow
+	TextShadow css.Property = "text-shadow"
+
+	// TextTransform is the text-transform property.
+	//
+	//   - Initial: none
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-transform
+	TextTransform css.Property = "text-transform"
+
+	// TextUnderlineOffset is the text-underline-offset property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-offset
+	TextUnderlineOffset css.Property = "text-underline-offset"
+
+	// TextUnderlinePosition is the text-underline-position property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | from-font | [ under || [ left | right ] ]
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-position
+	TextUnderlinePosition css.Property = "text-underline-position"
+
+	// TextWrap is the text-wrap property.
+	//
+	//   - Initial: wrap
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<'text-wrap-mode'> || <'text-wrap-style'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap
+	TextWrap css.Property = "text-wrap"
+
+	// TextWrapMode is the text-wrap-mode property.
+	//
+	//   - Initial: wrap
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	wrap | nowrap
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-mode
+	TextWrapMode css.Property = "text-wrap-mode"
+
+	// TextWrapStyle is the text-wrap-style property.
+	//
+	//   - Initial: auto
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | balance | stable | pretty
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-style
+	TextWrapStyle css.Property = "text-wrap-style"
+
+	// Top is the top property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/top
+	Top css.Property = "top"
+
+	// TouchAction is the touch-action property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/touch-action
+	TouchAction css.Property = "touch-action"
+
+	// Transform is the transform property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <transform-list>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transform
+	Transform css.Property = "transform"
+
+	// TransformBox is the transform-box property.
+	//
+	//   - Initial: view-box
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	content-box | border-box | fill-box | stroke-box | view-box
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-box
+	TransformBox css.Property = "transform-box"
+
+	// TransformOrigin is the transform-origin property.
+	//
+	//   - Initial: 50% 50% 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	[ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-origin
+	TransformOrigin css.Property = "transform-origin"
+
+	// TransformStyle is the transform-style property.
+	//
+	//   - Initial: flat
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	flat | preserve-3d
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-style
+	TransformStyle css.Property = "transform-style"
+
+	// Transition is the transition property.
+	//
+	//   - Shorthand for: transition-behavior, transition-delay, transition-duration, transition-property, transition-timing-function
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<single-transition>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition
+	Transition css.Property = "transition"
+
+	// TransitionBehavior is the transition-behavior property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<transition-behavior-value>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-behavior
+	TransitionBehavior css.Property = "transition-behavior"
+
+	// TransitionDelay is the transition-delay property.
+	//
+	//   - Initial: 0s
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<time>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-delay
+	TransitionDelay css.Property = "transition-delay"
+
+	// TransitionDuration is the transition-duration property.
+	//
+	//   - Initial: 0s
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<time>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-duration
+	TransitionDuration css.Property = "transition-duration"
+
+	// TransitionProperty is the transition-property property.
+	//
+	//   - Initial: all
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <single-transition-property>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-property
+	TransitionProperty css.Property = "transition-property"
+
+	// TransitionTimingFunction is the transition-timing-function property.
+	//
+	//   - Initial: ease
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<easing-function>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-timing-function
+	TransitionTimingFunction css.Property = "transition-timing-function"
+
+	// Translate is the translate property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <length-percentage> [ <length-percentage> <length>? ]?
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/translate
+	Translate css.Property = "translate"
+
+	// UnicodeBidi is the unicode-bidi property.
+	//
+	//   - Initial: normal
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | embed | isolate | bidi-override | isolate-override | plaintext
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/unicode-bidi
+	UnicodeBidi css.Property = "unicode-bidi"
+
+	// UserSelect is the user-select property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | text | none | all
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/user-select
+	UserSelect css.Property = "user-select"
+
+	// VectorEffect is the vector-effect property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/vector-effect
+	VectorEffect css.Property = "vector-effect"
+
+	// VerticalAlign is the vertical-align property.
+	//
+	//   - Initial: baseline
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/vertical-align
+	VerticalAlign css.Property = "vertical-align"
+
+	// ViewTransitionClass is the view-transition-class property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <custom-ident>+
+	ViewTransitionClass css.Property = "view-transition-class"
+
+	// ViewTransitionName is the view-transition-name property.
+	//
+	//   - Initial: none
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	none | <custom-ident> | match-element
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/view-transition-name
+	ViewTransitionName css.Property = "view-transition-name"
+
+	// Visibility is the visibility property.
+	//
+	//   - Initial: visible
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	visible | hidden | collapse
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/visibility
+	Visibility css.Property = "visibility"
+
+	// WhiteSpace is the white-space property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space
+	WhiteSpace css.Property = "white-space"
+
+	// WhiteSpaceCollapse is the white-space-collapse property.
+	//
+	//   - Initial: collapse
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	collapse | preserve | preserve-breaks | preserve-spaces | break-spaces
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space-collapse
+	WhiteSpaceCollapse css.Property = "white-space-collapse"
+
+	// Widows is the widows property.
+	//
+	//   - Initial: 2
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/widows
+	Widows css.Property = "widows"
+
+	// Width is the width property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/width
+	Width css.Property = "width"
+
+	// WillChange is the will-change property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <animateable-feature>#
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/will-change
+	WillChange css.Property = "will-change"
+
+	// WordBreak is the word-break property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | break-all | keep-all | break-word | auto-phrase
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/word-break
+	WordBreak css.Property = "word-break"
+
+	// WordSpacing is the word-spacing property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | <length>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/word-spacing
+	WordSpacing css.Property = "word-spacing"
+
+	// WordWrap is the word-wrap property.
+	//
+	//   - Initial: normal
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | break-word
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
+	WordWrap css.Property = "word-wrap"
+
+	// WritingMode is the writing-mode property.
+	//
+	//   - Initial: horizontal-tb
+	//   - Inherited: yes
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/writing-mode
+	WritingMode css.Property = "writing-mode"
+
+	// X is the x property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/x
+	X css.Property = "x"
+
+	// Y is the y property.
+	//
+	//   - Initial: 0
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	<length> | <percentage>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/y
+	Y css.Property = "y"
+
+	// ZIndex is the z-index property.
+	//
+	//   - Initial: auto
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	auto | <integer>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/z-index
+	ZIndex css.Property = "z-index"
+
+	// Zoom is the zoom property.
+	//
+	//   - Initial: 1
+	//   - Inherited: no
+	//   - Status: standard
+	//
+	// Syntax:
+	//
+	//	normal | reset | <number [0,∞]> || <percentage [0,∞]>
+	//
+	// MDN: https://developer.mozilla.org/docs/Web/CSS/zoom
+	Zoom css.Property = "zoom"
 )
@@ -0,0 +1,29 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T18:02:37Z
+
+//go:build cssexperimental
+
+package cssgen
+
+// Pseudo-class selectors, appended to a compound selector.
+// e.g., ".btn" + PseudoClassHover -> ".btn:hover"
+const (
+	PseudoClassBlank         = ":blank"
+	PseudoClassCurrent       = ":current"
+	PseudoClassLocalLink     = ":local-link"
+	PseudoClassTargetCurrent = ":target-current"
+	PseudoClassTargetWithin  = ":target-within"
+	PseudoClassXrOverlay     = ":xr-overlay"
+)
+
+// Pseudo-element selectors.
+const (
+	PseudoElementCueRegion         = "::cue-region"
+	PseudoElementScrollMarker      = "::scroll-marker"
+	PseudoElementScrollMarkerGroup = "::scroll-marker-group"
+)
+
+// PseudoElementCueRegionFunc creates a ::cue-region() selector: ::cue-region( <selector> )
+func PseudoElementCueRegionFunc(selector string) string {
+	return "::cue-region(" + selector + ")"
+}
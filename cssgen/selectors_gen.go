@@ -0,0 +1,203 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T18:02:37Z
+
+package cssgen
+
+import "strings"
+
+// Pseudo-class selectors, appended to a compound selector.
+// e.g., ".btn" + PseudoClassHover -> ".btn:hover"
+const (
+	PseudoClassActive               = ":active"
+	PseudoClassActiveViewTransition = ":active-view-transition"
+	PseudoClassAnyLink              = ":any-link"
+	PseudoClassAutofill             = ":autofill"
+	PseudoClassBuffering            = ":buffering"
+	PseudoClassChecked              = ":checked"
+	PseudoClassDefault              = ":default"
+	PseudoClassDefined              = ":defined"
+	PseudoClassDisabled             = ":disabled"
+	PseudoClassEmpty                = ":empty"
+	PseudoClassEnabled              = ":enabled"
+	PseudoClassFirst                = ":first"
+	PseudoClassFirstChild           = ":first-child"
+	PseudoClassFirstOfType          = ":first-of-type"
+	PseudoClassFocus                = ":focus"
+	PseudoClassFocusVisible         = ":focus-visible"
+	PseudoClassFocusWithin          = ":focus-within"
+	PseudoClassFullscreen           = ":fullscreen"
+	PseudoClassFuture               = ":future"
+	PseudoClassHasSlotted           = ":has-slotted"
+	PseudoClassHost                 = ":host"
+	PseudoClassHover                = ":hover"
+	PseudoClassInRange              = ":in-range"
+	PseudoClassIndeterminate        = ":indeterminate"
+	PseudoClassInvalid              = ":invalid"
+	PseudoClassLastChild            = ":last-child"
+	PseudoClassLastOfType           = ":last-of-type"
+	PseudoClassLeft                 = ":left"
+	PseudoClassLink                 = ":link"
+	PseudoClassModal                = ":modal"
+	PseudoClassMuted                = ":muted"
+	PseudoClassOnlyChild            = ":only-child"
+	PseudoClassOnlyOfType           = ":only-of-type"
+	PseudoClassOpen                 = ":open"
+	PseudoClassOptional             = ":optional"
+	PseudoClassOutOfRange           = ":out-of-range"
+	PseudoClassPast                 = ":past"
+	PseudoClassPaused               = ":paused"
+	PseudoClassPictureInPicture     = ":picture-in-picture"
+	PseudoClassPlaceholderShown     = ":placeholder-shown"
+	PseudoClassPlaying              = ":playing"
+	PseudoClassPopoverOpen          = ":popover-open"
+	PseudoClassReadOnly             = ":read-only"
+	PseudoClassReadWrite            = ":read-write"
+	PseudoClassRequired             = ":required"
+	PseudoClassRight                = ":right"
+	PseudoClassRoot                 = ":root"
+	PseudoClassScope                = ":scope"
+	PseudoClassSeeking              = ":seeking"
+	PseudoClassStalled              = ":stalled"
+	PseudoClassTarget               = ":target"
+	PseudoClassUserInvalid          = ":user-invalid"
+	PseudoClassUserValid            = ":user-valid"
+	PseudoClassValid                = ":valid"
+	PseudoClassVisited              = ":visited"
+	PseudoClassVolumeLocked         = ":volume-locked"
+)
+
+// Pseudo-element selectors.
+const (
+	PseudoElementAfter              = "::after"
+	PseudoElementBackdrop           = "::backdrop"
+	PseudoElementBefore             = "::before"
+	PseudoElementCheckmark          = "::checkmark"
+	PseudoElementCue                = "::cue"
+	PseudoElementDetailsContent     = "::details-content"
+	PseudoElementFileSelectorButton = "::file-selector-button"
+	PseudoElementFirstLetter        = "::first-letter"
+	PseudoElementFirstLine          = "::first-line"
+	PseudoElementGrammarError       = "::grammar-error"
+	PseudoElementMarker             = "::marker"
+	PseudoElementPickerIcon         = "::picker-icon"
+	PseudoElementPlaceholder        = "::placeholder"
+	PseudoElementSelection          = "::selection"
+	PseudoElementSpellingError      = "::spelling-error"
+	PseudoElementTargetText         = "::target-text"
+	PseudoElementViewTransition     = "::view-transition"
+)
+
+// PseudoElementCueFunc creates a ::cue() selector: ::cue( <selector> )
+func PseudoElementCueFunc(selector string) string {
+	return "::cue(" + selector + ")"
+}
+
+// PseudoElementHighlight creates a ::highlight() selector: ::highlight( <custom-ident> )
+func PseudoElementHighlight(arg string) string {
+	return "::highlight(" + arg + ")"
+}
+
+// PseudoElementPart creates a ::part() selector: ::part( <ident>+ )
+func PseudoElementPart(args ...string) string {
+	return "::part(" + strings.Join(args, " ") + ")"
+}
+
+// PseudoElementPicker creates a ::picker() selector: ::picker( <form-control-identifier>+ )
+func PseudoElementPicker(args ...string) string {
+	return "::picker(" + strings.Join(args, " ") + ")"
+}
+
+// PseudoElementSlotted creates a ::slotted() selector: ::slotted( <compound-selector> )
+func PseudoElementSlotted(selector string) string {
+	return "::slotted(" + selector + ")"
+}
+
+// PseudoElementViewTransitionGroup creates a ::view-transition-group() selector: ::view-transition-group([ '*' | <custom-ident> ])
+func PseudoElementViewTransitionGroup(arg string) string {
+	return "::view-transition-group(" + arg + ")"
+}
+
+// PseudoElementViewTransitionImagePair creates a ::view-transition-image-pair() selector: ::view-transition-image-pair([ '*' | <custom-ident> ])
+func PseudoElementViewTransitionImagePair(arg string) string {
+	return "::view-transition-image-pair(" + arg + ")"
+}
+
+// PseudoElementViewTransitionNew creates a ::view-transition-new() selector: ::view-transition-new([ '*' | <custom-ident> ])
+func PseudoElementViewTransitionNew(arg string) string {
+	return "::view-transition-new(" + arg + ")"
+}
+
+// PseudoElementViewTransitionOld creates a ::view-transition-old() selector: ::view-transition-old([ '*' | <custom-ident> ])
+func PseudoElementViewTransitionOld(arg string) string {
+	return "::view-transition-old(" + arg + ")"
+}
+
+// PseudoClassActiveViewTransitionType creates a :active-view-transition-type() selector: :active-view-transition-type( <custom-ident># )
+func PseudoClassActiveViewTransitionType(args ...string) string {
+	return ":active-view-transition-type(" + strings.Join(args, ", ") + ")"
+}
+
+// PseudoClassDir creates a :dir() selector: :dir( [ ltr | rtl ] )
+func PseudoClassDir(arg string) string {
+	return ":dir(" + arg + ")"
+}
+
+// PseudoClassHas creates a :has() selector: :has( <forgiving-relative-selector-list> )
+func PseudoClassHas(selectors ...string) string {
+	return ":has(" + strings.Join(selectors, ", ") + ")"
+}
+
+// PseudoClassHostFunc creates a :host() selector: :host( <compound-selector> )
+func PseudoClassHostFunc(selector string) string {
+	return ":host(" + selector + ")"
+}
+
+// PseudoClassHostContext creates a :host-context() selector: :host-context( <compound-selector> )
+func PseudoClassHostContext(selector string) string {
+	return ":host-context(" + selector + ")"
+}
+
+// PseudoClassIs creates a :is() selector: :is( <forgiving-selector-list> )
+func PseudoClassIs(selectors ...string) string {
+	return ":is(" + strings.Join(selectors, ", ") + ")"
+}
+
+// PseudoClassLang creates a :lang() selector: :lang( <language-code> )
+func PseudoClassLang(arg string) string {
+	return ":lang(" + arg + ")"
+}
+
+// PseudoClassNot creates a :not() selector: :not( <complex-selector-list> )
+func PseudoClassNot(selectors ...string) string {
+	return ":not(" + strings.Join(selectors, ", ") + ")"
+}
+
+// PseudoClassNthChild creates a :nth-child() selector: :nth-child( <an+b> [ of <complex-selector-list> ]? )
+func PseudoClassNthChild(arg string) string {
+	return ":nth-child(" + arg + ")"
+}
+
+// PseudoClassNthLastChild creates a :nth-last-child() selector: :nth-last-child( <an+b> [ of <complex-selector-list> ]? )
+func PseudoClassNthLastChild(arg string) string {
+	return ":nth-last-child(" + arg + ")"
+}
+
+// PseudoClassNthLastOfType creates a :nth-last-of-type() selector: :nth-last-of-type( <an+b> )
+func PseudoClassNthLastOfType(arg string) string {
+	return ":nth-last-of-type(" + arg + ")"
+}
+
+// PseudoClassNthOfType creates a :nth-of-type() selector: :nth-of-type( <an+b> )
+func PseudoClassNthOfType(arg string) string {
+	return ":nth-of-type(" + arg + ")"
+}
+
+// PseudoClassState creates a :state() selector: :state( <custom-ident> )
+func PseudoClassState(arg string) string {
+	return ":state(" + arg + ")"
+}
+
+// PseudoClassWhere creates a :where() selector: :where( <complex-selector-list> )
+func PseudoClassWhere(selectors ...string) string {
+	return ":where(" + strings.Join(selectors, ", ") + ")"
+}
@@ -0,0 +1,811 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
+
+//go:build cssexperimental
+
+package cssgen
+
+import "github.com/ahmed-com/typesafe-css/css"
+
+// Type-safe setter functions for CSS properties.
+
+// AnchorNameValue is implemented by the value types accepted by the anchor-name property.
+type AnchorNameValue interface {
+	css.Value
+	AnchorNameVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetAnchorName creates a declaration for the anchor-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <dashed-ident>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/anchor-name
+func SetAnchorName[T AnchorNameValue](v T) css.Decl {
+	return css.Set(AnchorName, v)
+}
+
+// AnchorScopeValue is implemented by the value types accepted by the anchor-scope property.
+type AnchorScopeValue interface {
+	css.Value
+	AnchorScopeVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetAnchorScope creates a declaration for the anchor-scope property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | all | <dashed-ident>#
+func SetAnchorScope[T AnchorScopeValue](v T) css.Decl {
+	return css.Set(AnchorScope, v)
+}
+
+// AnimationRangeValue is implemented by the value types accepted by the animation-range property.
+type AnimationRangeValue interface {
+	css.Value
+	AnimationRangeVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetAnimationRange creates a declaration for the animation-range property.
+//
+//   - Shorthand for: animation-range-end, animation-range-start
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'animation-range-start'> <'animation-range-end'>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range
+func SetAnimationRange[T AnimationRangeValue](v T) css.Decl {
+	return css.Set(AnimationRange, v)
+}
+
+// AnimationRangeEndValue is implemented by the value types accepted by the animation-range-end property.
+type AnimationRangeEndValue interface {
+	css.Value
+	AnimationRangeEndVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetAnimationRangeEnd creates a declaration for the animation-range-end property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-end
+func SetAnimationRangeEnd[T AnimationRangeEndValue](v T) css.Decl {
+	return css.Set(AnimationRangeEnd, v)
+}
+
+// AnimationRangeStartValue is implemented by the value types accepted by the animation-range-start property.
+type AnimationRangeStartValue interface {
+	css.Value
+	AnimationRangeStartVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetAnimationRangeStart creates a declaration for the animation-range-start property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-range-start
+func SetAnimationRangeStart[T AnimationRangeStartValue](v T) css.Decl {
+	return css.Set(AnimationRangeStart, v)
+}
+
+// AnimationTimelineValue is implemented by the value types accepted by the animation-timeline property.
+type AnimationTimelineValue interface {
+	css.Value
+	AnimationTimelineVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetAnimationTimeline creates a declaration for the animation-timeline property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<single-animation-timeline>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timeline
+func SetAnimationTimeline[T AnimationTimelineValue](v T) css.Decl {
+	return css.Set(AnimationTimeline, v)
+}
+
+// FieldSizingValue is implemented by the value types accepted by the field-sizing property.
+type FieldSizingValue interface {
+	css.Value
+	FieldSizingVal | css.Raw | css.Function | css.Global
+}
+
+// SetFieldSizing creates a declaration for the field-sizing property.
+//
+//   - Initial: fixed
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	content | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/field-sizing
+func SetFieldSizing[T FieldSizingValue](v T) css.Decl {
+	return css.Set(FieldSizing, v)
+}
+
+// FontSynthesisPositionValue is implemented by the value types accepted by the font-synthesis-position property.
+type FontSynthesisPositionValue interface {
+	css.Value
+	FontSynthesisPositionVal | css.Raw | css.Function | css.Global
+}
+
+// SetFontSynthesisPosition creates a declaration for the font-synthesis-position property.
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-position
+func SetFontSynthesisPosition[T FontSynthesisPositionValue](v T) css.Decl {
+	return css.Set(FontSynthesisPosition, v)
+}
+
+// FontWidthValue is implemented by the value types accepted by the font-width property.
+type FontWidthValue interface {
+	css.Value
+	FontWidthVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetFontWidth creates a declaration for the font-width property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | <percentage [0,∞]> | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded
+func SetFontWidth[T FontWidthValue](v T) css.Decl {
+	return css.Set(FontWidth, v)
+}
+
+// ImageResolutionValue is implemented by the value types accepted by the image-resolution property.
+type ImageResolutionValue interface {
+	css.Value
+	ImageResolutionVal | css.Resolution | css.Raw | css.Function | css.Global
+}
+
+// SetImageResolution creates a declaration for the image-resolution property.
+//
+//   - Initial: 1dppx
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ from-image || <resolution> ] && snap?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-resolution
+func SetImageResolution[T ImageResolutionValue](v T) css.Decl {
+	return css.Set(ImageResolution, v)
+}
+
+// InitialLetterAlignValue is implemented by the value types accepted by the initial-letter-align property.
+type InitialLetterAlignValue interface {
+	css.Value
+	InitialLetterAlignVal | css.Raw | css.Function | css.Global
+}
+
+// SetInitialLetterAlign creates a declaration for the initial-letter-align property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ auto | alphabetic | hanging | ideographic ]
+func SetInitialLetterAlign[T InitialLetterAlignValue](v T) css.Decl {
+	return css.Set(InitialLetterAlign, v)
+}
+
+// InterpolateSizeValue is implemented by the value types accepted by the interpolate-size property.
+type InterpolateSizeValue interface {
+	css.Value
+	InterpolateSizeVal | css.Raw | css.Function | css.Global
+}
+
+// SetInterpolateSize creates a declaration for the interpolate-size property.
+//
+//   - Initial: numeric-only
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	numeric-only | allow-keywords
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/interpolate-size
+func SetInterpolateSize[T InterpolateSizeValue](v T) css.Decl {
+	return css.Set(InterpolateSize, v)
+}
+
+// LineHeightStepValue is implemented by the value types accepted by the line-height-step property.
+type LineHeightStepValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetLineHeightStep creates a declaration for the line-height-step property.
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-height-step
+func SetLineHeightStep[T LineHeightStepValue](v T) css.Decl {
+	return css.Set(LineHeightStep, v)
+}
+
+// MarginTrimValue is implemented by the value types accepted by the margin-trim property.
+type MarginTrimValue interface {
+	css.Value
+	MarginTrimVal | css.Raw | css.Function | css.Global
+}
+
+// SetMarginTrim creates a declaration for the margin-trim property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | in-flow | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-trim
+func SetMarginTrim[T MarginTrimValue](v T) css.Decl {
+	return css.Set(MarginTrim, v)
+}
+
+// MathShiftValue is implemented by the value types accepted by the math-shift property.
+type MathShiftValue interface {
+	css.Value
+	MathShiftVal | css.Raw | css.Function | css.Global
+}
+
+// SetMathShift creates a declaration for the math-shift property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | compact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-shift
+func SetMathShift[T MathShiftValue](v T) css.Decl {
+	return css.Set(MathShift, v)
+}
+
+// MaxLinesValue is implemented by the value types accepted by the max-lines property.
+type MaxLinesValue interface {
+	css.Value
+	MaxLinesVal | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetMaxLines creates a declaration for the max-lines property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <integer>
+func SetMaxLines[T MaxLinesValue](v T) css.Decl {
+	return css.Set(MaxLines, v)
+}
+
+// ObjectViewBoxValue is implemented by the value types accepted by the object-view-box property.
+type ObjectViewBoxValue interface {
+	css.Value
+	ObjectViewBoxVal | css.Raw | css.Function | css.Global
+}
+
+// SetObjectViewBox creates a declaration for the object-view-box property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <basic-shape-rect>
+func SetObjectViewBox[T ObjectViewBoxValue](v T) css.Decl {
+	return css.Set(ObjectViewBox, v)
+}
+
+// OverlayValue is implemented by the value types accepted by the overlay property.
+type OverlayValue interface {
+	css.Value
+	OverlayVal | css.Raw | css.Function | css.Global
+}
+
+// SetOverlay creates a declaration for the overlay property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overlay
+func SetOverlay[T OverlayValue](v T) css.Decl {
+	return css.Set(Overlay, v)
+}
+
+// PositionAnchorValue is implemented by the value types accepted by the position-anchor property.
+type PositionAnchorValue interface {
+	css.Value
+	PositionAnchorVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetPositionAnchor creates a declaration for the position-anchor property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	auto | <anchor-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-anchor
+func SetPositionAnchor[T PositionAnchorValue](v T) css.Decl {
+	return css.Set(PositionAnchor, v)
+}
+
+// PositionAreaValue is implemented by the value types accepted by the position-area property.
+type PositionAreaValue interface {
+	css.Value
+	PositionAreaVal | css.Raw | css.Function | css.Global
+}
+
+// SetPositionArea creates a declaration for the position-area property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <position-area>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-area
+func SetPositionArea[T PositionAreaValue](v T) css.Decl {
+	return css.Set(PositionArea, v)
+}
+
+// PositionTryValue is implemented by the value types accepted by the position-try property.
+type PositionTryValue interface {
+	css.Value
+	PositionTryVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetPositionTry creates a declaration for the position-try property.
+//
+//   - Shorthand for: position-try-fallbacks, position-try-order
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<'position-try-order'>? <'position-try-fallbacks'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try
+func SetPositionTry[T PositionTryValue](v T) css.Decl {
+	return css.Set(PositionTry, v)
+}
+
+// PositionTryFallbacksValue is implemented by the value types accepted by the position-try-fallbacks property.
+type PositionTryFallbacksValue interface {
+	css.Value
+	PositionTryFallbacksVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetPositionTryFallbacks creates a declaration for the position-try-fallbacks property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | [ [<dashed-ident> || <try-tactic>] | <'position-area'> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-fallbacks
+func SetPositionTryFallbacks[T PositionTryFallbacksValue](v T) css.Decl {
+	return css.Set(PositionTryFallbacks, v)
+}
+
+// PositionTryOrderValue is implemented by the value types accepted by the position-try-order property.
+type PositionTryOrderValue interface {
+	css.Value
+	PositionTryOrderVal | css.Raw | css.Function | css.Global
+}
+
+// SetPositionTryOrder creates a declaration for the position-try-order property.
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | <try-size>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-try-order
+func SetPositionTryOrder[T PositionTryOrderValue](v T) css.Decl {
+	return css.Set(PositionTryOrder, v)
+}
+
+// PositionVisibilityValue is implemented by the value types accepted by the position-visibility property.
+type PositionVisibilityValue interface {
+	css.Value
+	PositionVisibilityVal | css.Raw | css.Function | css.Global
+}
+
+// SetPositionVisibility creates a declaration for the position-visibility property.
+//
+//   - Initial: anchors-visible
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	always | [ anchors-valid || anchors-visible || no-overflow ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position-visibility
+func SetPositionVisibility[T PositionVisibilityValue](v T) css.Decl {
+	return css.Set(PositionVisibility, v)
+}
+
+// RubyMergeValue is implemented by the value types accepted by the ruby-merge property.
+type RubyMergeValue interface {
+	css.Value
+	RubyMergeVal | css.Raw | css.Function | css.Global
+}
+
+// SetRubyMerge creates a declaration for the ruby-merge property.
+//
+//   - Initial: separate
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	separate | collapse | auto
+func SetRubyMerge[T RubyMergeValue](v T) css.Decl {
+	return css.Set(RubyMerge, v)
+}
+
+// ScrollInitialTargetValue is implemented by the value types accepted by the scroll-initial-target property.
+type ScrollInitialTargetValue interface {
+	css.Value
+	ScrollInitialTargetVal | css.Raw | css.Function | css.Global
+}
+
+// SetScrollInitialTarget creates a declaration for the scroll-initial-target property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | nearest
+func SetScrollInitialTarget[T ScrollInitialTargetValue](v T) css.Decl {
+	return css.Set(ScrollInitialTarget, v)
+}
+
+// ScrollTimelineValue is implemented by the value types accepted by the scroll-timeline property.
+type ScrollTimelineValue interface {
+	css.Value
+	ScrollTimelineVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetScrollTimeline creates a declaration for the scroll-timeline property.
+//
+//   - Shorthand for: scroll-timeline-axis, scroll-timeline-name
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline
+func SetScrollTimeline[T ScrollTimelineValue](v T) css.Decl {
+	return css.Set(ScrollTimeline, v)
+}
+
+// ScrollTimelineAxisValue is implemented by the value types accepted by the scroll-timeline-axis property.
+type ScrollTimelineAxisValue interface {
+	css.Value
+	ScrollTimelineAxisVal | css.Raw | css.Function | css.Global
+}
+
+// SetScrollTimelineAxis creates a declaration for the scroll-timeline-axis property.
+//
+//   - Initial: block
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ block | inline | x | y ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-axis
+func SetScrollTimelineAxis[T ScrollTimelineAxisValue](v T) css.Decl {
+	return css.Set(ScrollTimelineAxis, v)
+}
+
+// ScrollTimelineNameValue is implemented by the value types accepted by the scroll-timeline-name property.
+type ScrollTimelineNameValue interface {
+	css.Value
+	ScrollTimelineNameVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetScrollTimelineName creates a declaration for the scroll-timeline-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ none | <dashed-ident> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-timeline-name
+func SetScrollTimelineName[T ScrollTimelineNameValue](v T) css.Decl {
+	return css.Set(ScrollTimelineName, v)
+}
+
+// SpeakAsValue is implemented by the value types accepted by the speak-as property.
+type SpeakAsValue interface {
+	css.Value
+	SpeakAsVal | css.Raw | css.Function | css.Global
+}
+
+// SetSpeakAs creates a declaration for the speak-as property.
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	normal | spell-out || digits || [ literal-punctuation | no-punctuation ]
+func SetSpeakAs[T SpeakAsValue](v T) css.Decl {
+	return css.Set(SpeakAs, v)
+}
+
+// StrokeColorValue is implemented by the value types accepted by the stroke-color property.
+type StrokeColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
+}
+
+// SetStrokeColor creates a declaration for the stroke-color property.
+//
+//   - Initial: transparent
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	<color>
+func SetStrokeColor[T StrokeColorValue](v T) css.Decl {
+	return css.Set(StrokeColor, v)
+}
+
+// TextDecorationSkipValue is implemented by the value types accepted by the text-decoration-skip property.
+type TextDecorationSkipValue interface {
+	css.Value
+	TextDecorationSkipVal | css.Raw | css.Function | css.Global
+}
+
+// SetTextDecorationSkip creates a declaration for the text-decoration-skip property.
+//
+//   - Initial: objects
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | [ objects || [ spaces | [ leading-spaces || trailing-spaces ] ] || edges || box-decoration ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip
+func SetTextDecorationSkip[T TextDecorationSkipValue](v T) css.Decl {
+	return css.Set(TextDecorationSkip, v)
+}
+
+// TextSizeAdjustValue is implemented by the value types accepted by the text-size-adjust property.
+type TextSizeAdjustValue interface {
+	css.Value
+	TextSizeAdjustVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetTextSizeAdjust creates a declaration for the text-size-adjust property.
+//
+//   - Initial: autoForSmartphoneBrowsersSupportingInflation
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | auto | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-size-adjust
+func SetTextSizeAdjust[T TextSizeAdjustValue](v T) css.Decl {
+	return css.Set(TextSizeAdjust, v)
+}
+
+// TextSpacingTrimValue is implemented by the value types accepted by the text-spacing-trim property.
+type TextSpacingTrimValue interface {
+	css.Value
+	TextSpacingTrimVal | css.Raw | css.Function | css.Global
+}
+
+// SetTextSpacingTrim creates a declaration for the text-spacing-trim property.
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: experimental
+//
+// Syntax:
+//
+//	space-all | normal | space-first | trim-start
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-spacing-trim
+func SetTextSpacingTrim[T TextSpacingTrimValue](v T) css.Decl {
+	return css.Set(TextSpacingTrim, v)
+}
+
+// TimelineScopeValue is implemented by the value types accepted by the timeline-scope property.
+type TimelineScopeValue interface {
+	css.Value
+	TimelineScopeVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetTimelineScope creates a declaration for the timeline-scope property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	none | <dashed-ident>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/timeline-scope
+func SetTimelineScope[T TimelineScopeValue](v T) css.Decl {
+	return css.Set(TimelineScope, v)
+}
+
+// ViewTimelineValue is implemented by the value types accepted by the view-timeline property.
+type ViewTimelineValue interface {
+	css.Value
+	ViewTimelineVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetViewTimeline creates a declaration for the view-timeline property.
+//
+//   - Shorthand for: view-timeline-axis, view-timeline-name
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline
+func SetViewTimeline[T ViewTimelineValue](v T) css.Decl {
+	return css.Set(ViewTimeline, v)
+}
+
+// ViewTimelineAxisValue is implemented by the value types accepted by the view-timeline-axis property.
+type ViewTimelineAxisValue interface {
+	css.Value
+	ViewTimelineAxisVal | css.Raw | css.Function | css.Global
+}
+
+// SetViewTimelineAxis creates a declaration for the view-timeline-axis property.
+//
+//   - Initial: block
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ block | inline | x | y ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-axis
+func SetViewTimelineAxis[T ViewTimelineAxisValue](v T) css.Decl {
+	return css.Set(ViewTimelineAxis, v)
+}
+
+// ViewTimelineInsetValue is implemented by the value types accepted by the view-timeline-inset property.
+type ViewTimelineInsetValue interface {
+	css.Value
+	ViewTimelineInsetVal | css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetViewTimelineInset creates a declaration for the view-timeline-inset property.
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ [ auto | <length-percentage> ]{1,2} ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-inset
+func SetViewTimelineInset[T ViewTimelineInsetValue](v T) css.Decl {
+	return css.Set(ViewTimelineInset, v)
+}
+
+// ViewTimelineNameValue is implemented by the value types accepted by the view-timeline-name property.
+type ViewTimelineNameValue interface {
+	css.Value
+	ViewTimelineNameVal | css.Keyword | css.Raw | css.Function | css.Global
+}
+
+// SetViewTimelineName creates a declaration for the view-timeline-name property.
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: experimental
+//
+// Syntax:
+//
+//	[ none | <dashed-ident> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-timeline-name
+func SetViewTimelineName[T ViewTimelineNameValue](v T) css.Decl {
+	return css.Set(ViewTimelineName, v)
+}
@@ -1,5 +1,5 @@
 // Code generated by cssgen; DO NOT EDIT.
-// Source: spec version mdn-latest at 2025-09-01T01:22:06+03:00
+// Source: spec version mdn-latest at 2026-10-18T19:52:04Z
 
 package cssgen
 
@@ -7,2207 +7,9845 @@ import "github.com/ahmed-com/typesafe-css/css"
 
 // Type-safe setter functions for CSS properties.
 
+// AccentColorValue is implemented by the value types accepted by the accent-color property.
+type AccentColorValue interface {
+	css.Value
+	AccentColorVal | css.Color | css.Raw | css.Function | css.Global
+}
+
 // SetAccentColor creates a declaration for the accent-color property.
-func SetAccentColor(v AccentColorVal) css.Decl {
-	return css.Set(AccentColor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/accent-color
+func SetAccentColor[T AccentColorValue](v T) css.Decl {
+	return css.Set(AccentColor, v)
+}
+
+// AlignContentValue is implemented by the value types accepted by the align-content property.
+type AlignContentValue interface {
+	css.Value
+	AlignContentVal | css.Raw | css.Function | css.Global
 }
 
 // SetAlignContent creates a declaration for the align-content property.
-func SetAlignContent(v AlignContentVal) css.Decl {
-	return css.Set(AlignContent, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-content
+func SetAlignContent[T AlignContentValue](v T) css.Decl {
+	return css.Set(AlignContent, v)
+}
+
+// AlignItemsValue is implemented by the value types accepted by the align-items property.
+type AlignItemsValue interface {
+	css.Value
+	AlignItemsVal | css.Raw | css.Function | css.Global
 }
 
 // SetAlignItems creates a declaration for the align-items property.
-func SetAlignItems(v AlignItemsVal) css.Decl {
-	return css.Set(AlignItems, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-items
+func SetAlignItems[T AlignItemsValue](v T) css.Decl {
+	return css.Set(AlignItems, v)
+}
+
+// AlignSelfValue is implemented by the value types accepted by the align-self property.
+type AlignSelfValue interface {
+	css.Value
+	AlignSelfVal | css.Raw | css.Function | css.Global
 }
 
 // SetAlignSelf creates a declaration for the align-self property.
-func SetAlignSelf(v AlignSelfVal) css.Decl {
-	return css.Set(AlignSelf, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position> | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/align-self
+func SetAlignSelf[T AlignSelfValue](v T) css.Decl {
+	return css.Set(AlignSelf, v)
+}
+
+// AlignTracksValue is implemented by the value types accepted by the align-tracks property.
+type AlignTracksValue interface {
+	css.Value
+	AlignTracksVal | css.Raw | css.Function | css.Global
 }
 
 // SetAlignTracks creates a declaration for the align-tracks property.
-func SetAlignTracks(v AlignTracksVal) css.Decl {
-	return css.Set(AlignTracks, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position> ]#
+func SetAlignTracks[T AlignTracksValue](v T) css.Decl {
+	return css.Set(AlignTracks, v)
 }
 
-// SetAlignmentBaseline creates a declaration for the alignment-baseline property.
-func SetAlignmentBaseline(v AlignmentBaselineVal) css.Decl {
-	return css.Set(AlignmentBaseline, css.Keyword(string(v)))
+// AlignmentBaselineValue is implemented by the value types accepted by the alignment-baseline property.
+type AlignmentBaselineValue interface {
+	css.Value
+	AlignmentBaselineVal | css.Raw | css.Function | css.Global
 }
 
-// SetAll creates a declaration for the all property.
-func SetAll(v AllVal) css.Decl {
-	return css.Set(All, css.Keyword(string(v)))
+// SetAlignmentBaseline creates a declaration for the alignment-baseline property.
+//
+//   - Initial: baseline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/alignment-baseline
+func SetAlignmentBaseline[T AlignmentBaselineValue](v T) css.Decl {
+	return css.Set(AlignmentBaseline, v)
+}
+
+// AnimationValue is implemented by the value types accepted by the animation property.
+type AnimationValue interface {
+	css.Value
+	AnimationVal | css.Time | css.Number | css.Integer | css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetAnimation creates a declaration for the animation property.
-func SetAnimation(v AnimationVal) css.Decl {
-	return css.Set(Animation, css.Keyword(string(v)))
+//
+//   - Shorthand for: animation-delay, animation-direction, animation-duration, animation-fill-mode, animation-iteration-count, animation-name, animation-play-state, animation-timeline, animation-timing-function
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation
+func SetAnimation[T AnimationValue](v T) css.Decl {
+	return css.Set(Animation, v)
+}
+
+// AnimationCompositionValue is implemented by the value types accepted by the animation-composition property.
+type AnimationCompositionValue interface {
+	css.Value
+	AnimationCompositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationComposition creates a declaration for the animation-composition property.
-func SetAnimationComposition(v AnimationCompositionVal) css.Decl {
-	return css.Set(AnimationComposition, css.Keyword(string(v)))
+//
+//   - Initial: replace
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-composition>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-composition
+func SetAnimationComposition[T AnimationCompositionValue](v T) css.Decl {
+	return css.Set(AnimationComposition, v)
+}
+
+// AnimationDelayValue is implemented by the value types accepted by the animation-delay property.
+type AnimationDelayValue interface {
+	css.Value
+	css.Time | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationDelay creates a declaration for the animation-delay property.
-func SetAnimationDelay(v AnimationDelayVal) css.Decl {
-	return css.Set(AnimationDelay, css.Keyword(string(v)))
+//
+//   - Initial: 0s
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<time>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-delay
+func SetAnimationDelay[T AnimationDelayValue](v T) css.Decl {
+	return css.Set(AnimationDelay, v)
+}
+
+// AnimationDirectionValue is implemented by the value types accepted by the animation-direction property.
+type AnimationDirectionValue interface {
+	css.Value
+	AnimationDirectionVal | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationDirection creates a declaration for the animation-direction property.
-func SetAnimationDirection(v AnimationDirectionVal) css.Decl {
-	return css.Set(AnimationDirection, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-direction>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-direction
+func SetAnimationDirection[T AnimationDirectionValue](v T) css.Decl {
+	return css.Set(AnimationDirection, v)
+}
+
+// AnimationDurationValue is implemented by the value types accepted by the animation-duration property.
+type AnimationDurationValue interface {
+	css.Value
+	AnimationDurationVal | css.Time | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationDuration creates a declaration for the animation-duration property.
-func SetAnimationDuration(v AnimationDurationVal) css.Decl {
-	return css.Set(AnimationDuration, css.Keyword(string(v)))
+//
+//   - Initial: 0s
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <time [0s,∞]> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-duration
+func SetAnimationDuration[T AnimationDurationValue](v T) css.Decl {
+	return css.Set(AnimationDuration, v)
+}
+
+// AnimationFillModeValue is implemented by the value types accepted by the animation-fill-mode property.
+type AnimationFillModeValue interface {
+	css.Value
+	AnimationFillModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationFillMode creates a declaration for the animation-fill-mode property.
-func SetAnimationFillMode(v AnimationFillModeVal) css.Decl {
-	return css.Set(AnimationFillMode, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-fill-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-fill-mode
+func SetAnimationFillMode[T AnimationFillModeValue](v T) css.Decl {
+	return css.Set(AnimationFillMode, v)
+}
+
+// AnimationIterationCountValue is implemented by the value types accepted by the animation-iteration-count property.
+type AnimationIterationCountValue interface {
+	css.Value
+	AnimationIterationCountVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationIterationCount creates a declaration for the animation-iteration-count property.
-func SetAnimationIterationCount(v AnimationIterationCountVal) css.Decl {
-	return css.Set(AnimationIterationCount, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-iteration-count>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-iteration-count
+func SetAnimationIterationCount[T AnimationIterationCountValue](v T) css.Decl {
+	return css.Set(AnimationIterationCount, v)
+}
+
+// AnimationNameValue is implemented by the value types accepted by the animation-name property.
+type AnimationNameValue interface {
+	css.Value
+	AnimationNameVal | css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationName creates a declaration for the animation-name property.
-func SetAnimationName(v AnimationNameVal) css.Decl {
-	return css.Set(AnimationName, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ none | <keyframes-name> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-name
+func SetAnimationName[T AnimationNameValue](v T) css.Decl {
+	return css.Set(AnimationName, v)
+}
+
+// AnimationPlayStateValue is implemented by the value types accepted by the animation-play-state property.
+type AnimationPlayStateValue interface {
+	css.Value
+	AnimationPlayStateVal | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationPlayState creates a declaration for the animation-play-state property.
-func SetAnimationPlayState(v AnimationPlayStateVal) css.Decl {
-	return css.Set(AnimationPlayState, css.Keyword(string(v)))
+//
+//   - Initial: running
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-animation-play-state>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-play-state
+func SetAnimationPlayState[T AnimationPlayStateValue](v T) css.Decl {
+	return css.Set(AnimationPlayState, v)
+}
+
+// AnimationTimingFunctionValue is implemented by the value types accepted by the animation-timing-function property.
+type AnimationTimingFunctionValue interface {
+	css.Value
+	AnimationTimingFunctionVal | css.Raw | css.Function | css.Global
 }
 
 // SetAnimationTimingFunction creates a declaration for the animation-timing-function property.
-func SetAnimationTimingFunction(v AnimationTimingFunctionVal) css.Decl {
-	return css.Set(AnimationTimingFunction, css.Keyword(string(v)))
+//
+//   - Initial: ease
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<easing-function>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/animation-timing-function
+func SetAnimationTimingFunction[T AnimationTimingFunctionValue](v T) css.Decl {
+	return css.Set(AnimationTimingFunction, v)
+}
+
+// AppearanceValue is implemented by the value types accepted by the appearance property.
+type AppearanceValue interface {
+	css.Value
+	AppearanceVal | css.Raw | css.Function | css.Global
 }
 
 // SetAppearance creates a declaration for the appearance property.
-func SetAppearance(v AppearanceVal) css.Decl {
-	return css.Set(Appearance, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | auto | <compat-auto> | <compat-special>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/appearance
+func SetAppearance[T AppearanceValue](v T) css.Decl {
+	return css.Set(Appearance, v)
+}
+
+// AspectRatioValue is implemented by the value types accepted by the aspect-ratio property.
+type AspectRatioValue interface {
+	css.Value
+	AspectRatioVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetAspectRatio creates a declaration for the aspect-ratio property.
-func SetAspectRatio(v AspectRatioVal) css.Decl {
-	return css.Set(AspectRatio, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto || <ratio>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/aspect-ratio
+func SetAspectRatio[T AspectRatioValue](v T) css.Decl {
+	return css.Set(AspectRatio, v)
+}
+
+// BackdropFilterValue is implemented by the value types accepted by the backdrop-filter property.
+type BackdropFilterValue interface {
+	css.Value
+	BackdropFilterVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetBackdropFilter creates a declaration for the backdrop-filter property.
-func SetBackdropFilter(v BackdropFilterVal) css.Decl {
-	return css.Set(BackdropFilter, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <filter-value-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/backdrop-filter
+func SetBackdropFilter[T BackdropFilterValue](v T) css.Decl {
+	return css.Set(BackdropFilter, v)
+}
+
+// BackfaceVisibilityValue is implemented by the value types accepted by the backface-visibility property.
+type BackfaceVisibilityValue interface {
+	css.Value
+	BackfaceVisibilityVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackfaceVisibility creates a declaration for the backface-visibility property.
-func SetBackfaceVisibility(v BackfaceVisibilityVal) css.Decl {
-	return css.Set(BackfaceVisibility, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/backface-visibility
+func SetBackfaceVisibility[T BackfaceVisibilityValue](v T) css.Decl {
+	return css.Set(BackfaceVisibility, v)
+}
+
+// BackgroundValue is implemented by the value types accepted by the background property.
+type BackgroundValue interface {
+	css.Value
+	BackgroundVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackground creates a declaration for the background property.
-func SetBackground(v BackgroundVal) css.Decl {
-	return css.Set(Background, css.Keyword(string(v)))
+//
+//   - Shorthand for: background-attachment, background-clip, background-color, background-image, background-origin, background-position, background-repeat, background-size
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-layer>#? , <final-bg-layer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background
+func SetBackground[T BackgroundValue](v T) css.Decl {
+	return css.Set(Background, v)
+}
+
+// BackgroundAttachmentValue is implemented by the value types accepted by the background-attachment property.
+type BackgroundAttachmentValue interface {
+	css.Value
+	BackgroundAttachmentVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundAttachment creates a declaration for the background-attachment property.
-func SetBackgroundAttachment(v BackgroundAttachmentVal) css.Decl {
-	return css.Set(BackgroundAttachment, css.Keyword(string(v)))
+//
+//   - Initial: scroll
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<attachment>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-attachment
+func SetBackgroundAttachment[T BackgroundAttachmentValue](v T) css.Decl {
+	return css.Set(BackgroundAttachment, v)
+}
+
+// BackgroundBlendModeValue is implemented by the value types accepted by the background-blend-mode property.
+type BackgroundBlendModeValue interface {
+	css.Value
+	BackgroundBlendModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundBlendMode creates a declaration for the background-blend-mode property.
-func SetBackgroundBlendMode(v BackgroundBlendModeVal) css.Decl {
-	return css.Set(BackgroundBlendMode, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<blend-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-blend-mode
+func SetBackgroundBlendMode[T BackgroundBlendModeValue](v T) css.Decl {
+	return css.Set(BackgroundBlendMode, v)
+}
+
+// BackgroundClipValue is implemented by the value types accepted by the background-clip property.
+type BackgroundClipValue interface {
+	css.Value
+	BackgroundClipVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundClip creates a declaration for the background-clip property.
-func SetBackgroundClip(v BackgroundClipVal) css.Decl {
-	return css.Set(BackgroundClip, css.Keyword(string(v)))
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-clip>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-clip
+func SetBackgroundClip[T BackgroundClipValue](v T) css.Decl {
+	return css.Set(BackgroundClip, v)
+}
+
+// BackgroundColorValue is implemented by the value types accepted by the background-color property.
+type BackgroundColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundColor creates a declaration for the background-color property.
-func SetBackgroundColor(v BackgroundColorVal) css.Decl {
-	return css.Set(BackgroundColor, css.Keyword(string(v)))
+//
+//   - Initial: transparent
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-color
+func SetBackgroundColor[T BackgroundColorValue](v T) css.Decl {
+	return css.Set(BackgroundColor, v)
+}
+
+// BackgroundImageValue is implemented by the value types accepted by the background-image property.
+type BackgroundImageValue interface {
+	css.Value
+	BackgroundImageVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundImage creates a declaration for the background-image property.
-func SetBackgroundImage(v BackgroundImageVal) css.Decl {
-	return css.Set(BackgroundImage, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-image>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-image
+func SetBackgroundImage[T BackgroundImageValue](v T) css.Decl {
+	return css.Set(BackgroundImage, v)
+}
+
+// BackgroundOriginValue is implemented by the value types accepted by the background-origin property.
+type BackgroundOriginValue interface {
+	css.Value
+	BackgroundOriginVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundOrigin creates a declaration for the background-origin property.
-func SetBackgroundOrigin(v BackgroundOriginVal) css.Decl {
-	return css.Set(BackgroundOrigin, css.Keyword(string(v)))
+//
+//   - Initial: padding-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<visual-box>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-origin
+func SetBackgroundOrigin[T BackgroundOriginValue](v T) css.Decl {
+	return css.Set(BackgroundOrigin, v)
+}
+
+// BackgroundPositionValue is implemented by the value types accepted by the background-position property.
+type BackgroundPositionValue interface {
+	css.Value
+	BackgroundPositionVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundPosition creates a declaration for the background-position property.
-func SetBackgroundPosition(v BackgroundPositionVal) css.Decl {
-	return css.Set(BackgroundPosition, css.Keyword(string(v)))
+//
+//   - Initial: 0% 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-position>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position
+func SetBackgroundPosition[T BackgroundPositionValue](v T) css.Decl {
+	return css.Set(BackgroundPosition, v)
+}
+
+// BackgroundPositionXValue is implemented by the value types accepted by the background-position-x property.
+type BackgroundPositionXValue interface {
+	css.Value
+	BackgroundPositionXVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundPositionX creates a declaration for the background-position-x property.
-func SetBackgroundPositionX(v BackgroundPositionXVal) css.Decl {
-	return css.Set(BackgroundPositionX, css.Keyword(string(v)))
+//
+//   - Initial: 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-x
+func SetBackgroundPositionX[T BackgroundPositionXValue](v T) css.Decl {
+	return css.Set(BackgroundPositionX, v)
+}
+
+// BackgroundPositionYValue is implemented by the value types accepted by the background-position-y property.
+type BackgroundPositionYValue interface {
+	css.Value
+	BackgroundPositionYVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundPositionY creates a declaration for the background-position-y property.
-func SetBackgroundPositionY(v BackgroundPositionYVal) css.Decl {
-	return css.Set(BackgroundPositionY, css.Keyword(string(v)))
+//
+//   - Initial: 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-position-y
+func SetBackgroundPositionY[T BackgroundPositionYValue](v T) css.Decl {
+	return css.Set(BackgroundPositionY, v)
+}
+
+// BackgroundRepeatValue is implemented by the value types accepted by the background-repeat property.
+type BackgroundRepeatValue interface {
+	css.Value
+	BackgroundRepeatVal | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundRepeat creates a declaration for the background-repeat property.
-func SetBackgroundRepeat(v BackgroundRepeatVal) css.Decl {
-	return css.Set(BackgroundRepeat, css.Keyword(string(v)))
+//
+//   - Initial: repeat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<repeat-style>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-repeat
+func SetBackgroundRepeat[T BackgroundRepeatValue](v T) css.Decl {
+	return css.Set(BackgroundRepeat, v)
+}
+
+// BackgroundSizeValue is implemented by the value types accepted by the background-size property.
+type BackgroundSizeValue interface {
+	css.Value
+	BackgroundSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBackgroundSize creates a declaration for the background-size property.
-func SetBackgroundSize(v BackgroundSizeVal) css.Decl {
-	return css.Set(BackgroundSize, css.Keyword(string(v)))
+//
+//   - Initial: auto auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-size>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/background-size
+func SetBackgroundSize[T BackgroundSizeValue](v T) css.Decl {
+	return css.Set(BackgroundSize, v)
+}
+
+// BaselineShiftValue is implemented by the value types accepted by the baseline-shift property.
+type BaselineShiftValue interface {
+	css.Value
+	BaselineShiftVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBaselineShift creates a declaration for the baseline-shift property.
-func SetBaselineShift(v BaselineShiftVal) css.Decl {
-	return css.Set(BaselineShift, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | sub | super | baseline
+func SetBaselineShift[T BaselineShiftValue](v T) css.Decl {
+	return css.Set(BaselineShift, v)
+}
+
+// BlockSizeValue is implemented by the value types accepted by the block-size property.
+type BlockSizeValue interface {
+	css.Value
+	BlockSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBlockSize creates a declaration for the block-size property.
-func SetBlockSize(v BlockSizeVal) css.Decl {
-	return css.Set(BlockSize, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/block-size
+func SetBlockSize[T BlockSizeValue](v T) css.Decl {
+	return css.Set(BlockSize, v)
+}
+
+// BorderValue is implemented by the value types accepted by the border property.
+type BorderValue interface {
+	css.Value
+	BorderVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorder creates a declaration for the border property.
-func SetBorder(v BorderVal) css.Decl {
-	return css.Set(Border, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-color, border-style, border-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border
+func SetBorder[T BorderValue](v T) css.Decl {
+	return css.Set(Border, v)
+}
+
+// BorderBlockValue is implemented by the value types accepted by the border-block property.
+type BorderBlockValue interface {
+	css.Value
+	BorderBlockVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlock creates a declaration for the border-block property.
-func SetBorderBlock(v BorderBlockVal) css.Decl {
-	return css.Set(BorderBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-block-color, border-block-style, border-block-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-block-start'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block
+func SetBorderBlock[T BorderBlockValue](v T) css.Decl {
+	return css.Set(BorderBlock, v)
+}
+
+// BorderBlockColorValue is implemented by the value types accepted by the border-block-color property.
+type BorderBlockColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockColor creates a declaration for the border-block-color property.
-func SetBorderBlockColor(v BorderBlockColorVal) css.Decl {
-	return css.Set(BorderBlockColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-color
+func SetBorderBlockColor[T BorderBlockColorValue](v T) css.Decl {
+	return css.Set(BorderBlockColor, v)
+}
+
+// BorderBlockEndValue is implemented by the value types accepted by the border-block-end property.
+type BorderBlockEndValue interface {
+	css.Value
+	BorderBlockEndVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockEnd creates a declaration for the border-block-end property.
-func SetBorderBlockEnd(v BorderBlockEndVal) css.Decl {
-	return css.Set(BorderBlockEnd, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-top-color, border-top-style, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end
+func SetBorderBlockEnd[T BorderBlockEndValue](v T) css.Decl {
+	return css.Set(BorderBlockEnd, v)
+}
+
+// BorderBlockEndColorValue is implemented by the value types accepted by the border-block-end-color property.
+type BorderBlockEndColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockEndColor creates a declaration for the border-block-end-color property.
-func SetBorderBlockEndColor(v BorderBlockEndColorVal) css.Decl {
-	return css.Set(BorderBlockEndColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-color
+func SetBorderBlockEndColor[T BorderBlockEndColorValue](v T) css.Decl {
+	return css.Set(BorderBlockEndColor, v)
+}
+
+// BorderBlockEndStyleValue is implemented by the value types accepted by the border-block-end-style property.
+type BorderBlockEndStyleValue interface {
+	css.Value
+	BorderBlockEndStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockEndStyle creates a declaration for the border-block-end-style property.
-func SetBorderBlockEndStyle(v BorderBlockEndStyleVal) css.Decl {
-	return css.Set(BorderBlockEndStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-style
+func SetBorderBlockEndStyle[T BorderBlockEndStyleValue](v T) css.Decl {
+	return css.Set(BorderBlockEndStyle, v)
+}
+
+// BorderBlockEndWidthValue is implemented by the value types accepted by the border-block-end-width property.
+type BorderBlockEndWidthValue interface {
+	css.Value
+	BorderBlockEndWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockEndWidth creates a declaration for the border-block-end-width property.
-func SetBorderBlockEndWidth(v BorderBlockEndWidthVal) css.Decl {
-	return css.Set(BorderBlockEndWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-end-width
+func SetBorderBlockEndWidth[T BorderBlockEndWidthValue](v T) css.Decl {
+	return css.Set(BorderBlockEndWidth, v)
+}
+
+// BorderBlockStartValue is implemented by the value types accepted by the border-block-start property.
+type BorderBlockStartValue interface {
+	css.Value
+	BorderBlockStartVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockStart creates a declaration for the border-block-start property.
-func SetBorderBlockStart(v BorderBlockStartVal) css.Decl {
-	return css.Set(BorderBlockStart, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start
+func SetBorderBlockStart[T BorderBlockStartValue](v T) css.Decl {
+	return css.Set(BorderBlockStart, v)
+}
+
+// BorderBlockStartColorValue is implemented by the value types accepted by the border-block-start-color property.
+type BorderBlockStartColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockStartColor creates a declaration for the border-block-start-color property.
-func SetBorderBlockStartColor(v BorderBlockStartColorVal) css.Decl {
-	return css.Set(BorderBlockStartColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-color
+func SetBorderBlockStartColor[T BorderBlockStartColorValue](v T) css.Decl {
+	return css.Set(BorderBlockStartColor, v)
+}
+
+// BorderBlockStartStyleValue is implemented by the value types accepted by the border-block-start-style property.
+type BorderBlockStartStyleValue interface {
+	css.Value
+	BorderBlockStartStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockStartStyle creates a declaration for the border-block-start-style property.
-func SetBorderBlockStartStyle(v BorderBlockStartStyleVal) css.Decl {
-	return css.Set(BorderBlockStartStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-style
+func SetBorderBlockStartStyle[T BorderBlockStartStyleValue](v T) css.Decl {
+	return css.Set(BorderBlockStartStyle, v)
+}
+
+// BorderBlockStartWidthValue is implemented by the value types accepted by the border-block-start-width property.
+type BorderBlockStartWidthValue interface {
+	css.Value
+	BorderBlockStartWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockStartWidth creates a declaration for the border-block-start-width property.
-func SetBorderBlockStartWidth(v BorderBlockStartWidthVal) css.Decl {
-	return css.Set(BorderBlockStartWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-start-width
+func SetBorderBlockStartWidth[T BorderBlockStartWidthValue](v T) css.Decl {
+	return css.Set(BorderBlockStartWidth, v)
+}
+
+// BorderBlockStyleValue is implemented by the value types accepted by the border-block-style property.
+type BorderBlockStyleValue interface {
+	css.Value
+	BorderBlockStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockStyle creates a declaration for the border-block-style property.
-func SetBorderBlockStyle(v BorderBlockStyleVal) css.Decl {
-	return css.Set(BorderBlockStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-style
+func SetBorderBlockStyle[T BorderBlockStyleValue](v T) css.Decl {
+	return css.Set(BorderBlockStyle, v)
+}
+
+// BorderBlockWidthValue is implemented by the value types accepted by the border-block-width property.
+type BorderBlockWidthValue interface {
+	css.Value
+	BorderBlockWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBlockWidth creates a declaration for the border-block-width property.
-func SetBorderBlockWidth(v BorderBlockWidthVal) css.Decl {
-	return css.Set(BorderBlockWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-block-width
+func SetBorderBlockWidth[T BorderBlockWidthValue](v T) css.Decl {
+	return css.Set(BorderBlockWidth, v)
+}
+
+// BorderBottomValue is implemented by the value types accepted by the border-bottom property.
+type BorderBottomValue interface {
+	css.Value
+	BorderBottomVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottom creates a declaration for the border-bottom property.
-func SetBorderBottom(v BorderBottomVal) css.Decl {
-	return css.Set(BorderBottom, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-bottom-color, border-bottom-style, border-bottom-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom
+func SetBorderBottom[T BorderBottomValue](v T) css.Decl {
+	return css.Set(BorderBottom, v)
+}
+
+// BorderBottomColorValue is implemented by the value types accepted by the border-bottom-color property.
+type BorderBottomColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottomColor creates a declaration for the border-bottom-color property.
-func SetBorderBottomColor(v BorderBottomColorVal) css.Decl {
-	return css.Set(BorderBottomColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-color
+func SetBorderBottomColor[T BorderBottomColorValue](v T) css.Decl {
+	return css.Set(BorderBottomColor, v)
+}
+
+// BorderBottomLeftRadiusValue is implemented by the value types accepted by the border-bottom-left-radius property.
+type BorderBottomLeftRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottomLeftRadius creates a declaration for the border-bottom-left-radius property.
-func SetBorderBottomLeftRadius(v BorderBottomLeftRadiusVal) css.Decl {
-	return css.Set(BorderBottomLeftRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-left-radius
+func SetBorderBottomLeftRadius[T BorderBottomLeftRadiusValue](v T) css.Decl {
+	return css.Set(BorderBottomLeftRadius, v)
+}
+
+// BorderBottomRightRadiusValue is implemented by the value types accepted by the border-bottom-right-radius property.
+type BorderBottomRightRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottomRightRadius creates a declaration for the border-bottom-right-radius property.
-func SetBorderBottomRightRadius(v BorderBottomRightRadiusVal) css.Decl {
-	return css.Set(BorderBottomRightRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-right-radius
+func SetBorderBottomRightRadius[T BorderBottomRightRadiusValue](v T) css.Decl {
+	return css.Set(BorderBottomRightRadius, v)
+}
+
+// BorderBottomStyleValue is implemented by the value types accepted by the border-bottom-style property.
+type BorderBottomStyleValue interface {
+	css.Value
+	BorderBottomStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottomStyle creates a declaration for the border-bottom-style property.
-func SetBorderBottomStyle(v BorderBottomStyleVal) css.Decl {
-	return css.Set(BorderBottomStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-style
+func SetBorderBottomStyle[T BorderBottomStyleValue](v T) css.Decl {
+	return css.Set(BorderBottomStyle, v)
+}
+
+// BorderBottomWidthValue is implemented by the value types accepted by the border-bottom-width property.
+type BorderBottomWidthValue interface {
+	css.Value
+	BorderBottomWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderBottomWidth creates a declaration for the border-bottom-width property.
-func SetBorderBottomWidth(v BorderBottomWidthVal) css.Decl {
-	return css.Set(BorderBottomWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-bottom-width
+func SetBorderBottomWidth[T BorderBottomWidthValue](v T) css.Decl {
+	return css.Set(BorderBottomWidth, v)
+}
+
+// BorderCollapseValue is implemented by the value types accepted by the border-collapse property.
+type BorderCollapseValue interface {
+	css.Value
+	BorderCollapseVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderCollapse creates a declaration for the border-collapse property.
-func SetBorderCollapse(v BorderCollapseVal) css.Decl {
-	return css.Set(BorderCollapse, css.Keyword(string(v)))
+//
+//   - Initial: separate
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	separate | collapse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-collapse
+func SetBorderCollapse[T BorderCollapseValue](v T) css.Decl {
+	return css.Set(BorderCollapse, v)
+}
+
+// BorderColorValue is implemented by the value types accepted by the border-color property.
+type BorderColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderColor creates a declaration for the border-color property.
-func SetBorderColor(v BorderColorVal) css.Decl {
-	return css.Set(BorderColor, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-bottom-color, border-left-color, border-right-color, border-top-color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-color
+func SetBorderColor[T BorderColorValue](v T) css.Decl {
+	return css.Set(BorderColor, v)
+}
+
+// BorderEndEndRadiusValue is implemented by the value types accepted by the border-end-end-radius property.
+type BorderEndEndRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderEndEndRadius creates a declaration for the border-end-end-radius property.
-func SetBorderEndEndRadius(v BorderEndEndRadiusVal) css.Decl {
-	return css.Set(BorderEndEndRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-left-radius'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-end-end-radius
+func SetBorderEndEndRadius[T BorderEndEndRadiusValue](v T) css.Decl {
+	return css.Set(BorderEndEndRadius, v)
+}
+
+// BorderEndStartRadiusValue is implemented by the value types accepted by the border-end-start-radius property.
+type BorderEndStartRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderEndStartRadius creates a declaration for the border-end-start-radius property.
-func SetBorderEndStartRadius(v BorderEndStartRadiusVal) css.Decl {
-	return css.Set(BorderEndStartRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-left-radius'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-end-start-radius
+func SetBorderEndStartRadius[T BorderEndStartRadiusValue](v T) css.Decl {
+	return css.Set(BorderEndStartRadius, v)
+}
+
+// BorderImageValue is implemented by the value types accepted by the border-image property.
+type BorderImageValue interface {
+	css.Value
+	BorderImageVal | css.Length | css.Number | css.Integer | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImage creates a declaration for the border-image property.
-func SetBorderImage(v BorderImageVal) css.Decl {
-	return css.Set(BorderImage, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-image-outset, border-image-repeat, border-image-slice, border-image-source, border-image-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image
+func SetBorderImage[T BorderImageValue](v T) css.Decl {
+	return css.Set(BorderImage, v)
+}
+
+// BorderImageOutsetValue is implemented by the value types accepted by the border-image-outset property.
+type BorderImageOutsetValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImageOutset creates a declaration for the border-image-outset property.
-func SetBorderImageOutset(v BorderImageOutsetVal) css.Decl {
-	return css.Set(BorderImageOutset, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length [0,∞]> | <number [0,∞]> ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-outset
+func SetBorderImageOutset[T BorderImageOutsetValue](v T) css.Decl {
+	return css.Set(BorderImageOutset, v)
+}
+
+// BorderImageRepeatValue is implemented by the value types accepted by the border-image-repeat property.
+type BorderImageRepeatValue interface {
+	css.Value
+	BorderImageRepeatVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImageRepeat creates a declaration for the border-image-repeat property.
-func SetBorderImageRepeat(v BorderImageRepeatVal) css.Decl {
-	return css.Set(BorderImageRepeat, css.Keyword(string(v)))
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ stretch | repeat | round | space ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-repeat
+func SetBorderImageRepeat[T BorderImageRepeatValue](v T) css.Decl {
+	return css.Set(BorderImageRepeat, v)
+}
+
+// BorderImageSliceValue is implemented by the value types accepted by the border-image-slice property.
+type BorderImageSliceValue interface {
+	css.Value
+	BorderImageSliceVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImageSlice creates a declaration for the border-image-slice property.
-func SetBorderImageSlice(v BorderImageSliceVal) css.Decl {
-	return css.Set(BorderImageSlice, css.Keyword(string(v)))
+//
+//   - Initial: 100%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <number [0,∞]> | <percentage [0,∞]> ]{1,4}  && fill?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-slice
+func SetBorderImageSlice[T BorderImageSliceValue](v T) css.Decl {
+	return css.Set(BorderImageSlice, v)
+}
+
+// BorderImageSourceValue is implemented by the value types accepted by the border-image-source property.
+type BorderImageSourceValue interface {
+	css.Value
+	BorderImageSourceVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImageSource creates a declaration for the border-image-source property.
-func SetBorderImageSource(v BorderImageSourceVal) css.Decl {
-	return css.Set(BorderImageSource, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-source
+func SetBorderImageSource[T BorderImageSourceValue](v T) css.Decl {
+	return css.Set(BorderImageSource, v)
+}
+
+// BorderImageWidthValue is implemented by the value types accepted by the border-image-width property.
+type BorderImageWidthValue interface {
+	css.Value
+	BorderImageWidthVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetBorderImageWidth creates a declaration for the border-image-width property.
-func SetBorderImageWidth(v BorderImageWidthVal) css.Decl {
-	return css.Set(BorderImageWidth, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-image-width
+func SetBorderImageWidth[T BorderImageWidthValue](v T) css.Decl {
+	return css.Set(BorderImageWidth, v)
+}
+
+// BorderInlineValue is implemented by the value types accepted by the border-inline property.
+type BorderInlineValue interface {
+	css.Value
+	BorderInlineVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInline creates a declaration for the border-inline property.
-func SetBorderInline(v BorderInlineVal) css.Decl {
-	return css.Set(BorderInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-inline-color, border-inline-style, border-inline-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-block-start'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline
+func SetBorderInline[T BorderInlineValue](v T) css.Decl {
+	return css.Set(BorderInline, v)
+}
+
+// BorderInlineColorValue is implemented by the value types accepted by the border-inline-color property.
+type BorderInlineColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineColor creates a declaration for the border-inline-color property.
-func SetBorderInlineColor(v BorderInlineColorVal) css.Decl {
-	return css.Set(BorderInlineColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-color
+func SetBorderInlineColor[T BorderInlineColorValue](v T) css.Decl {
+	return css.Set(BorderInlineColor, v)
+}
+
+// BorderInlineEndValue is implemented by the value types accepted by the border-inline-end property.
+type BorderInlineEndValue interface {
+	css.Value
+	BorderInlineEndVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineEnd creates a declaration for the border-inline-end property.
-func SetBorderInlineEnd(v BorderInlineEndVal) css.Decl {
-	return css.Set(BorderInlineEnd, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end
+func SetBorderInlineEnd[T BorderInlineEndValue](v T) css.Decl {
+	return css.Set(BorderInlineEnd, v)
+}
+
+// BorderInlineEndColorValue is implemented by the value types accepted by the border-inline-end-color property.
+type BorderInlineEndColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineEndColor creates a declaration for the border-inline-end-color property.
-func SetBorderInlineEndColor(v BorderInlineEndColorVal) css.Decl {
-	return css.Set(BorderInlineEndColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-color
+func SetBorderInlineEndColor[T BorderInlineEndColorValue](v T) css.Decl {
+	return css.Set(BorderInlineEndColor, v)
+}
+
+// BorderInlineEndStyleValue is implemented by the value types accepted by the border-inline-end-style property.
+type BorderInlineEndStyleValue interface {
+	css.Value
+	BorderInlineEndStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineEndStyle creates a declaration for the border-inline-end-style property.
-func SetBorderInlineEndStyle(v BorderInlineEndStyleVal) css.Decl {
-	return css.Set(BorderInlineEndStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-style
+func SetBorderInlineEndStyle[T BorderInlineEndStyleValue](v T) css.Decl {
+	return css.Set(BorderInlineEndStyle, v)
+}
+
+// BorderInlineEndWidthValue is implemented by the value types accepted by the border-inline-end-width property.
+type BorderInlineEndWidthValue interface {
+	css.Value
+	BorderInlineEndWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineEndWidth creates a declaration for the border-inline-end-width property.
-func SetBorderInlineEndWidth(v BorderInlineEndWidthVal) css.Decl {
-	return css.Set(BorderInlineEndWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-end-width
+func SetBorderInlineEndWidth[T BorderInlineEndWidthValue](v T) css.Decl {
+	return css.Set(BorderInlineEndWidth, v)
+}
+
+// BorderInlineStartValue is implemented by the value types accepted by the border-inline-start property.
+type BorderInlineStartValue interface {
+	css.Value
+	BorderInlineStartVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineStart creates a declaration for the border-inline-start property.
-func SetBorderInlineStart(v BorderInlineStartVal) css.Decl {
-	return css.Set(BorderInlineStart, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-style, border-width, color
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'> || <'border-top-style'> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start
+func SetBorderInlineStart[T BorderInlineStartValue](v T) css.Decl {
+	return css.Set(BorderInlineStart, v)
+}
+
+// BorderInlineStartColorValue is implemented by the value types accepted by the border-inline-start-color property.
+type BorderInlineStartColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineStartColor creates a declaration for the border-inline-start-color property.
-func SetBorderInlineStartColor(v BorderInlineStartColorVal) css.Decl {
-	return css.Set(BorderInlineStartColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-color
+func SetBorderInlineStartColor[T BorderInlineStartColorValue](v T) css.Decl {
+	return css.Set(BorderInlineStartColor, v)
+}
+
+// BorderInlineStartStyleValue is implemented by the value types accepted by the border-inline-start-style property.
+type BorderInlineStartStyleValue interface {
+	css.Value
+	BorderInlineStartStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineStartStyle creates a declaration for the border-inline-start-style property.
-func SetBorderInlineStartStyle(v BorderInlineStartStyleVal) css.Decl {
-	return css.Set(BorderInlineStartStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-style
+func SetBorderInlineStartStyle[T BorderInlineStartStyleValue](v T) css.Decl {
+	return css.Set(BorderInlineStartStyle, v)
+}
+
+// BorderInlineStartWidthValue is implemented by the value types accepted by the border-inline-start-width property.
+type BorderInlineStartWidthValue interface {
+	css.Value
+	BorderInlineStartWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineStartWidth creates a declaration for the border-inline-start-width property.
-func SetBorderInlineStartWidth(v BorderInlineStartWidthVal) css.Decl {
-	return css.Set(BorderInlineStartWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-start-width
+func SetBorderInlineStartWidth[T BorderInlineStartWidthValue](v T) css.Decl {
+	return css.Set(BorderInlineStartWidth, v)
+}
+
+// BorderInlineStyleValue is implemented by the value types accepted by the border-inline-style property.
+type BorderInlineStyleValue interface {
+	css.Value
+	BorderInlineStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineStyle creates a declaration for the border-inline-style property.
-func SetBorderInlineStyle(v BorderInlineStyleVal) css.Decl {
-	return css.Set(BorderInlineStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-style'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-style
+func SetBorderInlineStyle[T BorderInlineStyleValue](v T) css.Decl {
+	return css.Set(BorderInlineStyle, v)
+}
+
+// BorderInlineWidthValue is implemented by the value types accepted by the border-inline-width property.
+type BorderInlineWidthValue interface {
+	css.Value
+	BorderInlineWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderInlineWidth creates a declaration for the border-inline-width property.
-func SetBorderInlineWidth(v BorderInlineWidthVal) css.Decl {
-	return css.Set(BorderInlineWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-width'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-inline-width
+func SetBorderInlineWidth[T BorderInlineWidthValue](v T) css.Decl {
+	return css.Set(BorderInlineWidth, v)
+}
+
+// BorderLeftValue is implemented by the value types accepted by the border-left property.
+type BorderLeftValue interface {
+	css.Value
+	BorderLeftVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderLeft creates a declaration for the border-left property.
-func SetBorderLeft(v BorderLeftVal) css.Decl {
-	return css.Set(BorderLeft, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-left-color, border-left-style, border-left-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left
+func SetBorderLeft[T BorderLeftValue](v T) css.Decl {
+	return css.Set(BorderLeft, v)
+}
+
+// BorderLeftColorValue is implemented by the value types accepted by the border-left-color property.
+type BorderLeftColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderLeftColor creates a declaration for the border-left-color property.
-func SetBorderLeftColor(v BorderLeftColorVal) css.Decl {
-	return css.Set(BorderLeftColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-color
+func SetBorderLeftColor[T BorderLeftColorValue](v T) css.Decl {
+	return css.Set(BorderLeftColor, v)
+}
+
+// BorderLeftStyleValue is implemented by the value types accepted by the border-left-style property.
+type BorderLeftStyleValue interface {
+	css.Value
+	BorderLeftStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderLeftStyle creates a declaration for the border-left-style property.
-func SetBorderLeftStyle(v BorderLeftStyleVal) css.Decl {
-	return css.Set(BorderLeftStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-style
+func SetBorderLeftStyle[T BorderLeftStyleValue](v T) css.Decl {
+	return css.Set(BorderLeftStyle, v)
+}
+
+// BorderLeftWidthValue is implemented by the value types accepted by the border-left-width property.
+type BorderLeftWidthValue interface {
+	css.Value
+	BorderLeftWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderLeftWidth creates a declaration for the border-left-width property.
-func SetBorderLeftWidth(v BorderLeftWidthVal) css.Decl {
-	return css.Set(BorderLeftWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-left-width
+func SetBorderLeftWidth[T BorderLeftWidthValue](v T) css.Decl {
+	return css.Set(BorderLeftWidth, v)
+}
+
+// BorderRadiusValue is implemented by the value types accepted by the border-radius property.
+type BorderRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderRadius creates a declaration for the border-radius property.
-func SetBorderRadius(v BorderRadiusVal) css.Decl {
-	return css.Set(BorderRadius, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-bottom-left-radius, border-bottom-right-radius, border-top-left-radius, border-top-right-radius
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>{1,4} [ / <length-percentage [0,∞]>{1,4} ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-radius
+func SetBorderRadius[T BorderRadiusValue](v T) css.Decl {
+	return css.Set(BorderRadius, v)
+}
+
+// BorderRightValue is implemented by the value types accepted by the border-right property.
+type BorderRightValue interface {
+	css.Value
+	BorderRightVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderRight creates a declaration for the border-right property.
-func SetBorderRight(v BorderRightVal) css.Decl {
-	return css.Set(BorderRight, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-right-color, border-right-style, border-right-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right
+func SetBorderRight[T BorderRightValue](v T) css.Decl {
+	return css.Set(BorderRight, v)
+}
+
+// BorderRightColorValue is implemented by the value types accepted by the border-right-color property.
+type BorderRightColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderRightColor creates a declaration for the border-right-color property.
-func SetBorderRightColor(v BorderRightColorVal) css.Decl {
-	return css.Set(BorderRightColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-color
+func SetBorderRightColor[T BorderRightColorValue](v T) css.Decl {
+	return css.Set(BorderRightColor, v)
+}
+
+// BorderRightStyleValue is implemented by the value types accepted by the border-right-style property.
+type BorderRightStyleValue interface {
+	css.Value
+	BorderRightStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderRightStyle creates a declaration for the border-right-style property.
-func SetBorderRightStyle(v BorderRightStyleVal) css.Decl {
-	return css.Set(BorderRightStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-style
+func SetBorderRightStyle[T BorderRightStyleValue](v T) css.Decl {
+	return css.Set(BorderRightStyle, v)
+}
+
+// BorderRightWidthValue is implemented by the value types accepted by the border-right-width property.
+type BorderRightWidthValue interface {
+	css.Value
+	BorderRightWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderRightWidth creates a declaration for the border-right-width property.
-func SetBorderRightWidth(v BorderRightWidthVal) css.Decl {
-	return css.Set(BorderRightWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-right-width
+func SetBorderRightWidth[T BorderRightWidthValue](v T) css.Decl {
+	return css.Set(BorderRightWidth, v)
+}
+
+// BorderSpacingValue is implemented by the value types accepted by the border-spacing property.
+type BorderSpacingValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderSpacing creates a declaration for the border-spacing property.
-func SetBorderSpacing(v BorderSpacingVal) css.Decl {
-	return css.Set(BorderSpacing, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-spacing
+func SetBorderSpacing[T BorderSpacingValue](v T) css.Decl {
+	return css.Set(BorderSpacing, v)
+}
+
+// BorderStartEndRadiusValue is implemented by the value types accepted by the border-start-end-radius property.
+type BorderStartEndRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderStartEndRadius creates a declaration for the border-start-end-radius property.
-func SetBorderStartEndRadius(v BorderStartEndRadiusVal) css.Decl {
-	return css.Set(BorderStartEndRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-left-radius'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-start-end-radius
+func SetBorderStartEndRadius[T BorderStartEndRadiusValue](v T) css.Decl {
+	return css.Set(BorderStartEndRadius, v)
+}
+
+// BorderStartStartRadiusValue is implemented by the value types accepted by the border-start-start-radius property.
+type BorderStartStartRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderStartStartRadius creates a declaration for the border-start-start-radius property.
-func SetBorderStartStartRadius(v BorderStartStartRadiusVal) css.Decl {
-	return css.Set(BorderStartStartRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-top-left-radius'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-start-start-radius
+func SetBorderStartStartRadius[T BorderStartStartRadiusValue](v T) css.Decl {
+	return css.Set(BorderStartStartRadius, v)
+}
+
+// BorderStyleValue is implemented by the value types accepted by the border-style property.
+type BorderStyleValue interface {
+	css.Value
+	BorderStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderStyle creates a declaration for the border-style property.
-func SetBorderStyle(v BorderStyleVal) css.Decl {
-	return css.Set(BorderStyle, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-bottom-style, border-left-style, border-right-style, border-top-style
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-style
+func SetBorderStyle[T BorderStyleValue](v T) css.Decl {
+	return css.Set(BorderStyle, v)
+}
+
+// BorderTopValue is implemented by the value types accepted by the border-top property.
+type BorderTopValue interface {
+	css.Value
+	BorderTopVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTop creates a declaration for the border-top property.
-func SetBorderTop(v BorderTopVal) css.Decl {
-	return css.Set(BorderTop, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-top-color, border-top-style, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width> || <line-style> || <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top
+func SetBorderTop[T BorderTopValue](v T) css.Decl {
+	return css.Set(BorderTop, v)
+}
+
+// BorderTopColorValue is implemented by the value types accepted by the border-top-color property.
+type BorderTopColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTopColor creates a declaration for the border-top-color property.
-func SetBorderTopColor(v BorderTopColorVal) css.Decl {
-	return css.Set(BorderTopColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-color
+func SetBorderTopColor[T BorderTopColorValue](v T) css.Decl {
+	return css.Set(BorderTopColor, v)
+}
+
+// BorderTopLeftRadiusValue is implemented by the value types accepted by the border-top-left-radius property.
+type BorderTopLeftRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTopLeftRadius creates a declaration for the border-top-left-radius property.
-func SetBorderTopLeftRadius(v BorderTopLeftRadiusVal) css.Decl {
-	return css.Set(BorderTopLeftRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-left-radius
+func SetBorderTopLeftRadius[T BorderTopLeftRadiusValue](v T) css.Decl {
+	return css.Set(BorderTopLeftRadius, v)
+}
+
+// BorderTopRightRadiusValue is implemented by the value types accepted by the border-top-right-radius property.
+type BorderTopRightRadiusValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTopRightRadius creates a declaration for the border-top-right-radius property.
-func SetBorderTopRightRadius(v BorderTopRightRadiusVal) css.Decl {
-	return css.Set(BorderTopRightRadius, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-right-radius
+func SetBorderTopRightRadius[T BorderTopRightRadiusValue](v T) css.Decl {
+	return css.Set(BorderTopRightRadius, v)
+}
+
+// BorderTopStyleValue is implemented by the value types accepted by the border-top-style property.
+type BorderTopStyleValue interface {
+	css.Value
+	BorderTopStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTopStyle creates a declaration for the border-top-style property.
-func SetBorderTopStyle(v BorderTopStyleVal) css.Decl {
-	return css.Set(BorderTopStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-style
+func SetBorderTopStyle[T BorderTopStyleValue](v T) css.Decl {
+	return css.Set(BorderTopStyle, v)
+}
+
+// BorderTopWidthValue is implemented by the value types accepted by the border-top-width property.
+type BorderTopWidthValue interface {
+	css.Value
+	BorderTopWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderTopWidth creates a declaration for the border-top-width property.
-func SetBorderTopWidth(v BorderTopWidthVal) css.Decl {
-	return css.Set(BorderTopWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-top-width
+func SetBorderTopWidth[T BorderTopWidthValue](v T) css.Decl {
+	return css.Set(BorderTopWidth, v)
+}
+
+// BorderWidthValue is implemented by the value types accepted by the border-width property.
+type BorderWidthValue interface {
+	css.Value
+	BorderWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBorderWidth creates a declaration for the border-width property.
-func SetBorderWidth(v BorderWidthVal) css.Decl {
-	return css.Set(BorderWidth, css.Keyword(string(v)))
+//
+//   - Shorthand for: border-bottom-width, border-left-width, border-right-width, border-top-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/border-width
+func SetBorderWidth[T BorderWidthValue](v T) css.Decl {
+	return css.Set(BorderWidth, v)
+}
+
+// BottomValue is implemented by the value types accepted by the bottom property.
+type BottomValue interface {
+	css.Value
+	BottomVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetBottom creates a declaration for the bottom property.
-func SetBottom(v BottomVal) css.Decl {
-	return css.Set(Bottom, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/bottom
+func SetBottom[T BottomValue](v T) css.Decl {
+	return css.Set(Bottom, v)
+}
+
+// BoxAlignValue is implemented by the value types accepted by the box-align property.
+type BoxAlignValue interface {
+	css.Value
+	BoxAlignVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxAlign creates a declaration for the box-align property.
-func SetBoxAlign(v BoxAlignVal) css.Decl {
-	return css.Set(BoxAlign, css.Keyword(string(v)))
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	start | center | end | baseline | stretch
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-align
+func SetBoxAlign[T BoxAlignValue](v T) css.Decl {
+	return css.Set(BoxAlign, v)
+}
+
+// BoxDecorationBreakValue is implemented by the value types accepted by the box-decoration-break property.
+type BoxDecorationBreakValue interface {
+	css.Value
+	BoxDecorationBreakVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxDecorationBreak creates a declaration for the box-decoration-break property.
-func SetBoxDecorationBreak(v BoxDecorationBreakVal) css.Decl {
-	return css.Set(BoxDecorationBreak, css.Keyword(string(v)))
+//
+//   - Initial: slice
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	slice | clone
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-decoration-break
+func SetBoxDecorationBreak[T BoxDecorationBreakValue](v T) css.Decl {
+	return css.Set(BoxDecorationBreak, v)
+}
+
+// BoxDirectionValue is implemented by the value types accepted by the box-direction property.
+type BoxDirectionValue interface {
+	css.Value
+	BoxDirectionVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxDirection creates a declaration for the box-direction property.
-func SetBoxDirection(v BoxDirectionVal) css.Decl {
-	return css.Set(BoxDirection, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	normal | reverse | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-direction
+func SetBoxDirection[T BoxDirectionValue](v T) css.Decl {
+	return css.Set(BoxDirection, v)
+}
+
+// BoxFlexValue is implemented by the value types accepted by the box-flex property.
+type BoxFlexValue interface {
+	css.Value
+	css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetBoxFlex creates a declaration for the box-flex property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	<number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-flex
+func SetBoxFlex[T BoxFlexValue](v T) css.Decl {
+	return css.Set(BoxFlex, v)
+}
+
+// BoxFlexGroupValue is implemented by the value types accepted by the box-flex-group property.
+type BoxFlexGroupValue interface {
+	css.Value
+	css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetBoxFlexGroup creates a declaration for the box-flex-group property.
-func SetBoxFlexGroup(v BoxFlexGroupVal) css.Decl {
-	return css.Set(BoxFlexGroup, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	<integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-flex-group
+func SetBoxFlexGroup[T BoxFlexGroupValue](v T) css.Decl {
+	return css.Set(BoxFlexGroup, v)
+}
+
+// BoxLinesValue is implemented by the value types accepted by the box-lines property.
+type BoxLinesValue interface {
+	css.Value
+	BoxLinesVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxLines creates a declaration for the box-lines property.
-func SetBoxLines(v BoxLinesVal) css.Decl {
-	return css.Set(BoxLines, css.Keyword(string(v)))
+//
+//   - Initial: single
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	single | multiple
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-lines
+func SetBoxLines[T BoxLinesValue](v T) css.Decl {
+	return css.Set(BoxLines, v)
+}
+
+// BoxOrdinalGroupValue is implemented by the value types accepted by the box-ordinal-group property.
+type BoxOrdinalGroupValue interface {
+	css.Value
+	css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetBoxOrdinalGroup creates a declaration for the box-ordinal-group property.
-func SetBoxOrdinalGroup(v BoxOrdinalGroupVal) css.Decl {
-	return css.Set(BoxOrdinalGroup, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	<integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-ordinal-group
+func SetBoxOrdinalGroup[T BoxOrdinalGroupValue](v T) css.Decl {
+	return css.Set(BoxOrdinalGroup, v)
+}
+
+// BoxOrientValue is implemented by the value types accepted by the box-orient property.
+type BoxOrientValue interface {
+	css.Value
+	BoxOrientVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxOrient creates a declaration for the box-orient property.
-func SetBoxOrient(v BoxOrientVal) css.Decl {
-	return css.Set(BoxOrient, css.Keyword(string(v)))
+//
+//   - Initial: inline-axis
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	horizontal | vertical | inline-axis | block-axis | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-orient
+func SetBoxOrient[T BoxOrientValue](v T) css.Decl {
+	return css.Set(BoxOrient, v)
+}
+
+// BoxPackValue is implemented by the value types accepted by the box-pack property.
+type BoxPackValue interface {
+	css.Value
+	BoxPackVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxPack creates a declaration for the box-pack property.
-func SetBoxPack(v BoxPackVal) css.Decl {
-	return css.Set(BoxPack, css.Keyword(string(v)))
+//
+//   - Initial: start
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	start | center | end | justify
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-pack
+func SetBoxPack[T BoxPackValue](v T) css.Decl {
+	return css.Set(BoxPack, v)
+}
+
+// BoxShadowValue is implemented by the value types accepted by the box-shadow property.
+type BoxShadowValue interface {
+	css.Value
+	BoxShadowVal | css.Shadow | css.Raw | css.Function | css.Global
 }
 
 // SetBoxShadow creates a declaration for the box-shadow property.
-func SetBoxShadow(v BoxShadowVal) css.Decl {
-	return css.Set(BoxShadow, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <shadow>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-shadow
+func SetBoxShadow[T BoxShadowValue](v T) css.Decl {
+	return css.Set(BoxShadow, v)
+}
+
+// BoxSizingValue is implemented by the value types accepted by the box-sizing property.
+type BoxSizingValue interface {
+	css.Value
+	BoxSizingVal | css.Raw | css.Function | css.Global
 }
 
 // SetBoxSizing creates a declaration for the box-sizing property.
-func SetBoxSizing(v BoxSizingVal) css.Decl {
-	return css.Set(BoxSizing, css.Keyword(string(v)))
+//
+//   - Initial: content-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content-box | border-box
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/box-sizing
+func SetBoxSizing[T BoxSizingValue](v T) css.Decl {
+	return css.Set(BoxSizing, v)
+}
+
+// BreakAfterValue is implemented by the value types accepted by the break-after property.
+type BreakAfterValue interface {
+	css.Value
+	BreakAfterVal | css.Raw | css.Function | css.Global
 }
 
 // SetBreakAfter creates a declaration for the break-after property.
-func SetBreakAfter(v BreakAfterVal) css.Decl {
-	return css.Set(BreakAfter, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-after
+func SetBreakAfter[T BreakAfterValue](v T) css.Decl {
+	return css.Set(BreakAfter, v)
+}
+
+// BreakBeforeValue is implemented by the value types accepted by the break-before property.
+type BreakBeforeValue interface {
+	css.Value
+	BreakBeforeVal | css.Raw | css.Function | css.Global
 }
 
 // SetBreakBefore creates a declaration for the break-before property.
-func SetBreakBefore(v BreakBeforeVal) css.Decl {
-	return css.Set(BreakBefore, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-before
+func SetBreakBefore[T BreakBeforeValue](v T) css.Decl {
+	return css.Set(BreakBefore, v)
+}
+
+// BreakInsideValue is implemented by the value types accepted by the break-inside property.
+type BreakInsideValue interface {
+	css.Value
+	BreakInsideVal | css.Raw | css.Function | css.Global
 }
 
 // SetBreakInside creates a declaration for the break-inside property.
-func SetBreakInside(v BreakInsideVal) css.Decl {
-	return css.Set(BreakInside, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | avoid | avoid-page | avoid-column | avoid-region
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/break-inside
+func SetBreakInside[T BreakInsideValue](v T) css.Decl {
+	return css.Set(BreakInside, v)
+}
+
+// CaptionSideValue is implemented by the value types accepted by the caption-side property.
+type CaptionSideValue interface {
+	css.Value
+	CaptionSideVal | css.Raw | css.Function | css.Global
 }
 
 // SetCaptionSide creates a declaration for the caption-side property.
-func SetCaptionSide(v CaptionSideVal) css.Decl {
-	return css.Set(CaptionSide, css.Keyword(string(v)))
+//
+//   - Initial: top
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	top | bottom
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/caption-side
+func SetCaptionSide[T CaptionSideValue](v T) css.Decl {
+	return css.Set(CaptionSide, v)
+}
+
+// CaretValue is implemented by the value types accepted by the caret property.
+type CaretValue interface {
+	css.Value
+	CaretVal | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetCaret creates a declaration for the caret property.
-func SetCaret(v CaretVal) css.Decl {
-	return css.Set(Caret, css.Keyword(string(v)))
+//
+//   - Shorthand for: caret-color, caret-shape
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'caret-color'> || <'caret-shape'>
+func SetCaret[T CaretValue](v T) css.Decl {
+	return css.Set(Caret, v)
+}
+
+// CaretColorValue is implemented by the value types accepted by the caret-color property.
+type CaretColorValue interface {
+	css.Value
+	CaretColorVal | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetCaretColor creates a declaration for the caret-color property.
-func SetCaretColor(v CaretColorVal) css.Decl {
-	return css.Set(CaretColor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/caret-color
+func SetCaretColor[T CaretColorValue](v T) css.Decl {
+	return css.Set(CaretColor, v)
+}
+
+// CaretShapeValue is implemented by the value types accepted by the caret-shape property.
+type CaretShapeValue interface {
+	css.Value
+	CaretShapeVal | css.Raw | css.Function | css.Global
 }
 
 // SetCaretShape creates a declaration for the caret-shape property.
-func SetCaretShape(v CaretShapeVal) css.Decl {
-	return css.Set(CaretShape, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | bar | block | underscore
+func SetCaretShape[T CaretShapeValue](v T) css.Decl {
+	return css.Set(CaretShape, v)
+}
+
+// ClearValue is implemented by the value types accepted by the clear property.
+type ClearValue interface {
+	css.Value
+	ClearVal | css.Raw | css.Function | css.Global
 }
 
 // SetClear creates a declaration for the clear property.
-func SetClear(v ClearVal) css.Decl {
-	return css.Set(Clear, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | left | right | both | inline-start | inline-end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clear
+func SetClear[T ClearValue](v T) css.Decl {
+	return css.Set(Clear, v)
+}
+
+// ClipValue is implemented by the value types accepted by the clip property.
+type ClipValue interface {
+	css.Value
+	ClipVal | css.Raw | css.Function | css.Global
 }
 
 // SetClip creates a declaration for the clip property.
-func SetClip(v ClipVal) css.Decl {
-	return css.Set(Clip, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<shape> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip
+//
+// Deprecated: the clip property is obsolete.
+func SetClip[T ClipValue](v T) css.Decl {
+	return css.Set(Clip, v)
+}
+
+// ClipPathValue is implemented by the value types accepted by the clip-path property.
+type ClipPathValue interface {
+	css.Value
+	ClipPathVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetClipPath creates a declaration for the clip-path property.
-func SetClipPath(v ClipPathVal) css.Decl {
-	return css.Set(ClipPath, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<clip-source> | [ <basic-shape> || <geometry-box> ] | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-path
+func SetClipPath[T ClipPathValue](v T) css.Decl {
+	return css.Set(ClipPath, v)
+}
+
+// ClipRuleValue is implemented by the value types accepted by the clip-rule property.
+type ClipRuleValue interface {
+	css.Value
+	ClipRuleVal | css.Raw | css.Function | css.Global
 }
 
 // SetClipRule creates a declaration for the clip-rule property.
-func SetClipRule(v ClipRuleVal) css.Decl {
-	return css.Set(ClipRule, css.Keyword(string(v)))
+//
+//   - Initial: nonzero
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	nonzero | evenodd
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/clip-rule
+func SetClipRule[T ClipRuleValue](v T) css.Decl {
+	return css.Set(ClipRule, v)
+}
+
+// ColorValue is implemented by the value types accepted by the color property.
+type ColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetColor creates a declaration for the color property.
-func SetColor(v ColorVal) css.Decl {
-	return css.Set(Color, css.Keyword(string(v)))
+//
+//   - Initial: canvastext
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/color
+func SetColor[T ColorValue](v T) css.Decl {
+	return css.Set(Color, v)
+}
+
+// ColorInterpolationFiltersValue is implemented by the value types accepted by the color-interpolation-filters property.
+type ColorInterpolationFiltersValue interface {
+	css.Value
+	ColorInterpolationFiltersVal | css.Raw | css.Function | css.Global
 }
 
 // SetColorInterpolationFilters creates a declaration for the color-interpolation-filters property.
-func SetColorInterpolationFilters(v ColorInterpolationFiltersVal) css.Decl {
-	return css.Set(ColorInterpolationFilters, css.Keyword(string(v)))
+//
+//   - Initial: linearRGB
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | sRGB | linearRGB
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/color-interpolation-filters
+func SetColorInterpolationFilters[T ColorInterpolationFiltersValue](v T) css.Decl {
+	return css.Set(ColorInterpolationFilters, v)
+}
+
+// ColorSchemeValue is implemented by the value types accepted by the color-scheme property.
+type ColorSchemeValue interface {
+	css.Value
+	ColorSchemeVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetColorScheme creates a declaration for the color-scheme property.
-func SetColorScheme(v ColorSchemeVal) css.Decl {
-	return css.Set(ColorScheme, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ light | dark | <custom-ident> ]+ && only?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/color-scheme
+func SetColorScheme[T ColorSchemeValue](v T) css.Decl {
+	return css.Set(ColorScheme, v)
+}
+
+// ColumnCountValue is implemented by the value types accepted by the column-count property.
+type ColumnCountValue interface {
+	css.Value
+	ColumnCountVal | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetColumnCount creates a declaration for the column-count property.
-func SetColumnCount(v ColumnCountVal) css.Decl {
-	return css.Set(ColumnCount, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-count
+func SetColumnCount[T ColumnCountValue](v T) css.Decl {
+	return css.Set(ColumnCount, v)
+}
+
+// ColumnFillValue is implemented by the value types accepted by the column-fill property.
+type ColumnFillValue interface {
+	css.Value
+	ColumnFillVal | css.Raw | css.Function | css.Global
 }
 
 // SetColumnFill creates a declaration for the column-fill property.
-func SetColumnFill(v ColumnFillVal) css.Decl {
-	return css.Set(ColumnFill, css.Keyword(string(v)))
+//
+//   - Initial: balance
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | balance
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-fill
+func SetColumnFill[T ColumnFillValue](v T) css.Decl {
+	return css.Set(ColumnFill, v)
+}
+
+// ColumnGapValue is implemented by the value types accepted by the column-gap property.
+type ColumnGapValue interface {
+	css.Value
+	ColumnGapVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetColumnGap creates a declaration for the column-gap property.
-func SetColumnGap(v ColumnGapVal) css.Decl {
-	return css.Set(ColumnGap, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-gap
+func SetColumnGap[T ColumnGapValue](v T) css.Decl {
+	return css.Set(ColumnGap, v)
+}
+
+// ColumnRuleValue is implemented by the value types accepted by the column-rule property.
+type ColumnRuleValue interface {
+	css.Value
+	ColumnRuleVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetColumnRule creates a declaration for the column-rule property.
-func SetColumnRule(v ColumnRuleVal) css.Decl {
-	return css.Set(ColumnRule, css.Keyword(string(v)))
+//
+//   - Shorthand for: column-rule-color, column-rule-style, column-rule-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule
+func SetColumnRule[T ColumnRuleValue](v T) css.Decl {
+	return css.Set(ColumnRule, v)
+}
+
+// ColumnRuleColorValue is implemented by the value types accepted by the column-rule-color property.
+type ColumnRuleColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetColumnRuleColor creates a declaration for the column-rule-color property.
-func SetColumnRuleColor(v ColumnRuleColorVal) css.Decl {
-	return css.Set(ColumnRuleColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-color
+func SetColumnRuleColor[T ColumnRuleColorValue](v T) css.Decl {
+	return css.Set(ColumnRuleColor, v)
+}
+
+// ColumnRuleStyleValue is implemented by the value types accepted by the column-rule-style property.
+type ColumnRuleStyleValue interface {
+	css.Value
+	ColumnRuleStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetColumnRuleStyle creates a declaration for the column-rule-style property.
-func SetColumnRuleStyle(v ColumnRuleStyleVal) css.Decl {
-	return css.Set(ColumnRuleStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-style
+func SetColumnRuleStyle[T ColumnRuleStyleValue](v T) css.Decl {
+	return css.Set(ColumnRuleStyle, v)
+}
+
+// ColumnRuleWidthValue is implemented by the value types accepted by the column-rule-width property.
+type ColumnRuleWidthValue interface {
+	css.Value
+	ColumnRuleWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetColumnRuleWidth creates a declaration for the column-rule-width property.
-func SetColumnRuleWidth(v ColumnRuleWidthVal) css.Decl {
-	return css.Set(ColumnRuleWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'border-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-rule-width
+func SetColumnRuleWidth[T ColumnRuleWidthValue](v T) css.Decl {
+	return css.Set(ColumnRuleWidth, v)
+}
+
+// ColumnSpanValue is implemented by the value types accepted by the column-span property.
+type ColumnSpanValue interface {
+	css.Value
+	ColumnSpanVal | css.Raw | css.Function | css.Global
 }
 
 // SetColumnSpan creates a declaration for the column-span property.
-func SetColumnSpan(v ColumnSpanVal) css.Decl {
-	return css.Set(ColumnSpan, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-span
+func SetColumnSpan[T ColumnSpanValue](v T) css.Decl {
+	return css.Set(ColumnSpan, v)
+}
+
+// ColumnWidthValue is implemented by the value types accepted by the column-width property.
+type ColumnWidthValue interface {
+	css.Value
+	ColumnWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetColumnWidth creates a declaration for the column-width property.
-func SetColumnWidth(v ColumnWidthVal) css.Decl {
-	return css.Set(ColumnWidth, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-width
+func SetColumnWidth[T ColumnWidthValue](v T) css.Decl {
+	return css.Set(ColumnWidth, v)
+}
+
+// ColumnsValue is implemented by the value types accepted by the columns property.
+type ColumnsValue interface {
+	css.Value
+	ColumnsVal | css.Length | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetColumns creates a declaration for the columns property.
-func SetColumns(v ColumnsVal) css.Decl {
-	return css.Set(Columns, css.Keyword(string(v)))
+//
+//   - Shorthand for: column-count, column-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'column-width'> || <'column-count'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/columns
+func SetColumns[T ColumnsValue](v T) css.Decl {
+	return css.Set(Columns, v)
+}
+
+// ContainValue is implemented by the value types accepted by the contain property.
+type ContainValue interface {
+	css.Value
+	ContainVal | css.Raw | css.Function | css.Global
 }
 
 // SetContain creates a declaration for the contain property.
-func SetContain(v ContainVal) css.Decl {
-	return css.Set(Contain, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | strict | content | [ [ size || inline-size ] || layout || style || paint ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain
+func SetContain[T ContainValue](v T) css.Decl {
+	return css.Set(Contain, v)
+}
+
+// ContainIntrinsicBlockSizeValue is implemented by the value types accepted by the contain-intrinsic-block-size property.
+type ContainIntrinsicBlockSizeValue interface {
+	css.Value
+	ContainIntrinsicBlockSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetContainIntrinsicBlockSize creates a declaration for the contain-intrinsic-block-size property.
-func SetContainIntrinsicBlockSize(v ContainIntrinsicBlockSizeVal) css.Decl {
-	return css.Set(ContainIntrinsicBlockSize, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-block-size
+func SetContainIntrinsicBlockSize[T ContainIntrinsicBlockSizeValue](v T) css.Decl {
+	return css.Set(ContainIntrinsicBlockSize, v)
+}
+
+// ContainIntrinsicHeightValue is implemented by the value types accepted by the contain-intrinsic-height property.
+type ContainIntrinsicHeightValue interface {
+	css.Value
+	ContainIntrinsicHeightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetContainIntrinsicHeight creates a declaration for the contain-intrinsic-height property.
-func SetContainIntrinsicHeight(v ContainIntrinsicHeightVal) css.Decl {
-	return css.Set(ContainIntrinsicHeight, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-height
+func SetContainIntrinsicHeight[T ContainIntrinsicHeightValue](v T) css.Decl {
+	return css.Set(ContainIntrinsicHeight, v)
+}
+
+// ContainIntrinsicInlineSizeValue is implemented by the value types accepted by the contain-intrinsic-inline-size property.
+type ContainIntrinsicInlineSizeValue interface {
+	css.Value
+	ContainIntrinsicInlineSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetContainIntrinsicInlineSize creates a declaration for the contain-intrinsic-inline-size property.
-func SetContainIntrinsicInlineSize(v ContainIntrinsicInlineSizeVal) css.Decl {
-	return css.Set(ContainIntrinsicInlineSize, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-inline-size
+func SetContainIntrinsicInlineSize[T ContainIntrinsicInlineSizeValue](v T) css.Decl {
+	return css.Set(ContainIntrinsicInlineSize, v)
+}
+
+// ContainIntrinsicSizeValue is implemented by the value types accepted by the contain-intrinsic-size property.
+type ContainIntrinsicSizeValue interface {
+	css.Value
+	ContainIntrinsicSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetContainIntrinsicSize creates a declaration for the contain-intrinsic-size property.
-func SetContainIntrinsicSize(v ContainIntrinsicSizeVal) css.Decl {
-	return css.Set(ContainIntrinsicSize, css.Keyword(string(v)))
+//
+//   - Shorthand for: contain-intrinsic-height, contain-intrinsic-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto? [ none | <length> ] ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-size
+func SetContainIntrinsicSize[T ContainIntrinsicSizeValue](v T) css.Decl {
+	return css.Set(ContainIntrinsicSize, v)
+}
+
+// ContainIntrinsicWidthValue is implemented by the value types accepted by the contain-intrinsic-width property.
+type ContainIntrinsicWidthValue interface {
+	css.Value
+	ContainIntrinsicWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetContainIntrinsicWidth creates a declaration for the contain-intrinsic-width property.
-func SetContainIntrinsicWidth(v ContainIntrinsicWidthVal) css.Decl {
-	return css.Set(ContainIntrinsicWidth, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto? [ none | <length> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/contain-intrinsic-width
+func SetContainIntrinsicWidth[T ContainIntrinsicWidthValue](v T) css.Decl {
+	return css.Set(ContainIntrinsicWidth, v)
+}
+
+// ContainerValue is implemented by the value types accepted by the container property.
+type ContainerValue interface {
+	css.Value
+	ContainerVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetContainer creates a declaration for the container property.
-func SetContainer(v ContainerVal) css.Decl {
-	return css.Set(Container, css.Keyword(string(v)))
+//
+//   - Shorthand for: container-name, container-type
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'container-name'> [ / <'container-type'> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container
+func SetContainer[T ContainerValue](v T) css.Decl {
+	return css.Set(Container, v)
+}
+
+// ContainerNameValue is implemented by the value types accepted by the container-name property.
+type ContainerNameValue interface {
+	css.Value
+	ContainerNameVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetContainerName creates a declaration for the container-name property.
-func SetContainerName(v ContainerNameVal) css.Decl {
-	return css.Set(ContainerName, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container-name
+func SetContainerName[T ContainerNameValue](v T) css.Decl {
+	return css.Set(ContainerName, v)
+}
+
+// ContainerTypeValue is implemented by the value types accepted by the container-type property.
+type ContainerTypeValue interface {
+	css.Value
+	ContainerTypeVal | css.Raw | css.Function | css.Global
 }
 
 // SetContainerType creates a declaration for the container-type property.
-func SetContainerType(v ContainerTypeVal) css.Decl {
-	return css.Set(ContainerType, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ [ size | inline-size ] || scroll-state ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/container-type
+func SetContainerType[T ContainerTypeValue](v T) css.Decl {
+	return css.Set(ContainerType, v)
+}
+
+// ContentValue is implemented by the value types accepted by the content property.
+type ContentValue interface {
+	css.Value
+	ContentVal | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetContent creates a declaration for the content property.
-func SetContent(v ContentVal) css.Decl {
-	return css.Set(Content, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <content-replacement> | <content-list> ] [ / [ <string> | <counter> | <attr()> ]+ ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/content
+func SetContent[T ContentValue](v T) css.Decl {
+	return css.Set(Content, v)
+}
+
+// ContentVisibilityValue is implemented by the value types accepted by the content-visibility property.
+type ContentVisibilityValue interface {
+	css.Value
+	ContentVisibilityVal | css.Raw | css.Function | css.Global
 }
 
 // SetContentVisibility creates a declaration for the content-visibility property.
-func SetContentVisibility(v ContentVisibilityVal) css.Decl {
-	return css.Set(ContentVisibility, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | auto | hidden
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/content-visibility
+func SetContentVisibility[T ContentVisibilityValue](v T) css.Decl {
+	return css.Set(ContentVisibility, v)
+}
+
+// CounterIncrementValue is implemented by the value types accepted by the counter-increment property.
+type CounterIncrementValue interface {
+	css.Value
+	CounterIncrementVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetCounterIncrement creates a declaration for the counter-increment property.
-func SetCounterIncrement(v CounterIncrementVal) css.Decl {
-	return css.Set(CounterIncrement, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-increment
+func SetCounterIncrement[T CounterIncrementValue](v T) css.Decl {
+	return css.Set(CounterIncrement, v)
+}
+
+// CounterResetValue is implemented by the value types accepted by the counter-reset property.
+type CounterResetValue interface {
+	css.Value
+	CounterResetVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetCounterReset creates a declaration for the counter-reset property.
-func SetCounterReset(v CounterResetVal) css.Decl {
-	return css.Set(CounterReset, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? | <reversed-counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-reset
+func SetCounterReset[T CounterResetValue](v T) css.Decl {
+	return css.Set(CounterReset, v)
+}
+
+// CounterSetValue is implemented by the value types accepted by the counter-set property.
+type CounterSetValue interface {
+	css.Value
+	CounterSetVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetCounterSet creates a declaration for the counter-set property.
-func SetCounterSet(v CounterSetVal) css.Decl {
-	return css.Set(CounterSet, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <counter-name> <integer>? ]+ | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/counter-set
+func SetCounterSet[T CounterSetValue](v T) css.Decl {
+	return css.Set(CounterSet, v)
+}
+
+// CursorValue is implemented by the value types accepted by the cursor property.
+type CursorValue interface {
+	css.Value
+	CursorVal | css.Raw | css.Function | css.Global
 }
 
 // SetCursor creates a declaration for the cursor property.
-func SetCursor(v CursorVal) css.Decl {
-	return css.Set(Cursor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/cursor
+func SetCursor[T CursorValue](v T) css.Decl {
+	return css.Set(Cursor, v)
+}
+
+// CxValue is implemented by the value types accepted by the cx property.
+type CxValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetCx creates a declaration for the cx property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/cx
+func SetCx[T CxValue](v T) css.Decl {
+	return css.Set(Cx, v)
+}
+
+// CyValue is implemented by the value types accepted by the cy property.
+type CyValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetCy creates a declaration for the cy property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/cy
+func SetCy[T CyValue](v T) css.Decl {
+	return css.Set(Cy, v)
+}
+
+// DValue is implemented by the value types accepted by the d property.
+type DValue interface {
+	css.Value
+	DVal | css.Raw | css.Function | css.Global
 }
 
 // SetD creates a declaration for the d property.
-func SetD(v DVal) css.Decl {
-	return css.Set(D, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | path(<string>)
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/d
+func SetD[T DValue](v T) css.Decl {
+	return css.Set(D, v)
+}
+
+// DirectionValue is implemented by the value types accepted by the direction property.
+type DirectionValue interface {
+	css.Value
+	DirectionVal | css.Raw | css.Function | css.Global
 }
 
 // SetDirection creates a declaration for the direction property.
-func SetDirection(v DirectionVal) css.Decl {
-	return css.Set(Direction, css.Keyword(string(v)))
+//
+//   - Initial: ltr
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	ltr | rtl
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/direction
+func SetDirection[T DirectionValue](v T) css.Decl {
+	return css.Set(Direction, v)
+}
+
+// DisplayValue is implemented by the value types accepted by the display property.
+type DisplayValue interface {
+	css.Value
+	DisplayVal | css.Raw | css.Function | css.Global
 }
 
 // SetDisplay creates a declaration for the display property.
-func SetDisplay(v DisplayVal) css.Decl {
-	return css.Set(Display, css.Keyword(string(v)))
+//
+//   - Initial: inline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/display
+func SetDisplay[T DisplayValue](v T) css.Decl {
+	return css.Set(Display, v)
+}
+
+// DominantBaselineValue is implemented by the value types accepted by the dominant-baseline property.
+type DominantBaselineValue interface {
+	css.Value
+	DominantBaselineVal | css.Raw | css.Function | css.Global
 }
 
 // SetDominantBaseline creates a declaration for the dominant-baseline property.
-func SetDominantBaseline(v DominantBaselineVal) css.Decl {
-	return css.Set(DominantBaseline, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/dominant-baseline
+func SetDominantBaseline[T DominantBaselineValue](v T) css.Decl {
+	return css.Set(DominantBaseline, v)
+}
+
+// EmptyCellsValue is implemented by the value types accepted by the empty-cells property.
+type EmptyCellsValue interface {
+	css.Value
+	EmptyCellsVal | css.Raw | css.Function | css.Global
 }
 
 // SetEmptyCells creates a declaration for the empty-cells property.
-func SetEmptyCells(v EmptyCellsVal) css.Decl {
-	return css.Set(EmptyCells, css.Keyword(string(v)))
+//
+//   - Initial: show
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	show | hide
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/empty-cells
+func SetEmptyCells[T EmptyCellsValue](v T) css.Decl {
+	return css.Set(EmptyCells, v)
+}
+
+// FillValue is implemented by the value types accepted by the fill property.
+type FillValue interface {
+	css.Value
+	FillVal | css.Color | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetFill creates a declaration for the fill property.
-func SetFill(v FillVal) css.Decl {
-	return css.Set(Fill, css.Keyword(string(v)))
+//
+//   - Initial: black
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<paint>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/fill
+func SetFill[T FillValue](v T) css.Decl {
+	return css.Set(Fill, v)
+}
+
+// FillOpacityValue is implemented by the value types accepted by the fill-opacity property.
+type FillOpacityValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetFillOpacity creates a declaration for the fill-opacity property.
-func SetFillOpacity(v FillOpacityVal) css.Decl {
-	return css.Set(FillOpacity, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'opacity'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/fill-opacity
+func SetFillOpacity[T FillOpacityValue](v T) css.Decl {
+	return css.Set(FillOpacity, v)
+}
+
+// FillRuleValue is implemented by the value types accepted by the fill-rule property.
+type FillRuleValue interface {
+	css.Value
+	FillRuleVal | css.Raw | css.Function | css.Global
 }
 
 // SetFillRule creates a declaration for the fill-rule property.
-func SetFillRule(v FillRuleVal) css.Decl {
-	return css.Set(FillRule, css.Keyword(string(v)))
+//
+//   - Initial: nonzero
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	nonzero | evenodd
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/fill-rule
+func SetFillRule[T FillRuleValue](v T) css.Decl {
+	return css.Set(FillRule, v)
+}
+
+// FilterValue is implemented by the value types accepted by the filter property.
+type FilterValue interface {
+	css.Value
+	FilterVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetFilter creates a declaration for the filter property.
-func SetFilter(v FilterVal) css.Decl {
-	return css.Set(Filter, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <filter-value-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/filter
+func SetFilter[T FilterValue](v T) css.Decl {
+	return css.Set(Filter, v)
+}
+
+// FlexValue is implemented by the value types accepted by the flex property.
+type FlexValue interface {
+	css.Value
+	FlexVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetFlex creates a declaration for the flex property.
-func SetFlex(v FlexVal) css.Decl {
-	return css.Set(Flex, css.Keyword(string(v)))
+//
+//   - Shorthand for: flex-basis, flex-grow, flex-shrink
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex
+func SetFlex[T FlexValue](v T) css.Decl {
+	return css.Set(Flex, v)
+}
+
+// FlexBasisValue is implemented by the value types accepted by the flex-basis property.
+type FlexBasisValue interface {
+	css.Value
+	FlexBasisVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetFlexBasis creates a declaration for the flex-basis property.
-func SetFlexBasis(v FlexBasisVal) css.Decl {
-	return css.Set(FlexBasis, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content | <'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-basis
+func SetFlexBasis[T FlexBasisValue](v T) css.Decl {
+	return css.Set(FlexBasis, v)
+}
+
+// FlexDirectionValue is implemented by the value types accepted by the flex-direction property.
+type FlexDirectionValue interface {
+	css.Value
+	FlexDirectionVal | css.Raw | css.Function | css.Global
 }
 
 // SetFlexDirection creates a declaration for the flex-direction property.
-func SetFlexDirection(v FlexDirectionVal) css.Decl {
-	return css.Set(FlexDirection, css.Keyword(string(v)))
+//
+//   - Initial: row
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	row | row-reverse | column | column-reverse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-direction
+func SetFlexDirection[T FlexDirectionValue](v T) css.Decl {
+	return css.Set(FlexDirection, v)
+}
+
+// FlexFlowValue is implemented by the value types accepted by the flex-flow property.
+type FlexFlowValue interface {
+	css.Value
+	FlexFlowVal | css.Raw | css.Function | css.Global
 }
 
 // SetFlexFlow creates a declaration for the flex-flow property.
-func SetFlexFlow(v FlexFlowVal) css.Decl {
-	return css.Set(FlexFlow, css.Keyword(string(v)))
+//
+//   - Shorthand for: flex-direction, flex-wrap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'flex-direction'> || <'flex-wrap'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-flow
+func SetFlexFlow[T FlexFlowValue](v T) css.Decl {
+	return css.Set(FlexFlow, v)
+}
+
+// FlexGrowValue is implemented by the value types accepted by the flex-grow property.
+type FlexGrowValue interface {
+	css.Value
+	css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetFlexGrow creates a declaration for the flex-grow property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-grow
+func SetFlexGrow[T FlexGrowValue](v T) css.Decl {
+	return css.Set(FlexGrow, v)
+}
+
+// FlexShrinkValue is implemented by the value types accepted by the flex-shrink property.
+type FlexShrinkValue interface {
+	css.Value
+	css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetFlexShrink creates a declaration for the flex-shrink property.
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-shrink
+func SetFlexShrink[T FlexShrinkValue](v T) css.Decl {
+	return css.Set(FlexShrink, v)
+}
+
+// FlexWrapValue is implemented by the value types accepted by the flex-wrap property.
+type FlexWrapValue interface {
+	css.Value
+	FlexWrapVal | css.Raw | css.Function | css.Global
 }
 
 // SetFlexWrap creates a declaration for the flex-wrap property.
-func SetFlexWrap(v FlexWrapVal) css.Decl {
-	return css.Set(FlexWrap, css.Keyword(string(v)))
+//
+//   - Initial: nowrap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	nowrap | wrap | wrap-reverse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flex-wrap
+func SetFlexWrap[T FlexWrapValue](v T) css.Decl {
+	return css.Set(FlexWrap, v)
+}
+
+// FloatValue is implemented by the value types accepted by the float property.
+type FloatValue interface {
+	css.Value
+	FloatVal | css.Raw | css.Function | css.Global
 }
 
 // SetFloat creates a declaration for the float property.
-func SetFloat(v FloatVal) css.Decl {
-	return css.Set(Float, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	left | right | none | inline-start | inline-end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/float
+func SetFloat[T FloatValue](v T) css.Decl {
+	return css.Set(Float, v)
+}
+
+// FloodColorValue is implemented by the value types accepted by the flood-color property.
+type FloodColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetFloodColor creates a declaration for the flood-color property.
-func SetFloodColor(v FloodColorVal) css.Decl {
-	return css.Set(FloodColor, css.Keyword(string(v)))
+//
+//   - Initial: black
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flood-color
+func SetFloodColor[T FloodColorValue](v T) css.Decl {
+	return css.Set(FloodColor, v)
+}
+
+// FloodOpacityValue is implemented by the value types accepted by the flood-opacity property.
+type FloodOpacityValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetFloodOpacity creates a declaration for the flood-opacity property.
-func SetFloodOpacity(v FloodOpacityVal) css.Decl {
-	return css.Set(FloodOpacity, css.Keyword(string(v)))
+//
+//   - Initial: black
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'opacity'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/flood-opacity
+func SetFloodOpacity[T FloodOpacityValue](v T) css.Decl {
+	return css.Set(FloodOpacity, v)
+}
+
+// FontValue is implemented by the value types accepted by the font property.
+type FontValue interface {
+	css.Value
+	FontVal | css.Raw | css.Function | css.Global
 }
 
 // SetFont creates a declaration for the font property.
-func SetFont(v FontVal) css.Decl {
-	return css.Set(Font, css.Keyword(string(v)))
+//
+//   - Shorthand for: font-family, font-size, font-stretch, font-style, font-variant, font-weight, line-height
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'># ] | <system-family-name>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font
+func SetFont[T FontValue](v T) css.Decl {
+	return css.Set(Font, v)
+}
+
+// FontFamilyValue is implemented by the value types accepted by the font-family property.
+type FontFamilyValue interface {
+	css.Value
+	FontFamilyVal | css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetFontFamily creates a declaration for the font-family property.
-func SetFontFamily(v FontFamilyVal) css.Decl {
-	return css.Set(FontFamily, css.Keyword(string(v)))
+//
+//   - Initial: dependsOnUserAgent
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <family-name> | <generic-family> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-family
+func SetFontFamily[T FontFamilyValue](v T) css.Decl {
+	return css.Set(FontFamily, v)
+}
+
+// FontFeatureSettingsValue is implemented by the value types accepted by the font-feature-settings property.
+type FontFeatureSettingsValue interface {
+	css.Value
+	FontFeatureSettingsVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetFontFeatureSettings creates a declaration for the font-feature-settings property.
-func SetFontFeatureSettings(v FontFeatureSettingsVal) css.Decl {
-	return css.Set(FontFeatureSettings, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <feature-tag-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-feature-settings
+func SetFontFeatureSettings[T FontFeatureSettingsValue](v T) css.Decl {
+	return css.Set(FontFeatureSettings, v)
+}
+
+// FontKerningValue is implemented by the value types accepted by the font-kerning property.
+type FontKerningValue interface {
+	css.Value
+	FontKerningVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontKerning creates a declaration for the font-kerning property.
-func SetFontKerning(v FontKerningVal) css.Decl {
-	return css.Set(FontKerning, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-kerning
+func SetFontKerning[T FontKerningValue](v T) css.Decl {
+	return css.Set(FontKerning, v)
+}
+
+// FontLanguageOverrideValue is implemented by the value types accepted by the font-language-override property.
+type FontLanguageOverrideValue interface {
+	css.Value
+	FontLanguageOverrideVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetFontLanguageOverride creates a declaration for the font-language-override property.
-func SetFontLanguageOverride(v FontLanguageOverrideVal) css.Decl {
-	return css.Set(FontLanguageOverride, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-language-override
+func SetFontLanguageOverride[T FontLanguageOverrideValue](v T) css.Decl {
+	return css.Set(FontLanguageOverride, v)
+}
+
+// FontOpticalSizingValue is implemented by the value types accepted by the font-optical-sizing property.
+type FontOpticalSizingValue interface {
+	css.Value
+	FontOpticalSizingVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontOpticalSizing creates a declaration for the font-optical-sizing property.
-func SetFontOpticalSizing(v FontOpticalSizingVal) css.Decl {
-	return css.Set(FontOpticalSizing, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-optical-sizing
+func SetFontOpticalSizing[T FontOpticalSizingValue](v T) css.Decl {
+	return css.Set(FontOpticalSizing, v)
+}
+
+// FontPaletteValue is implemented by the value types accepted by the font-palette property.
+type FontPaletteValue interface {
+	css.Value
+	FontPaletteVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetFontPalette creates a declaration for the font-palette property.
-func SetFontPalette(v FontPaletteVal) css.Decl {
-	return css.Set(FontPalette, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | light | dark | <palette-identifier> | <palette-mix()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-palette
+func SetFontPalette[T FontPaletteValue](v T) css.Decl {
+	return css.Set(FontPalette, v)
+}
+
+// FontSizeValue is implemented by the value types accepted by the font-size property.
+type FontSizeValue interface {
+	css.Value
+	FontSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetFontSize creates a declaration for the font-size property.
-func SetFontSize(v FontSizeVal) css.Decl {
-	return css.Set(FontSize, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size
+func SetFontSize[T FontSizeValue](v T) css.Decl {
+	return css.Set(FontSize, v)
+}
+
+// FontSizeAdjustValue is implemented by the value types accepted by the font-size-adjust property.
+type FontSizeAdjustValue interface {
+	css.Value
+	FontSizeAdjustVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetFontSizeAdjust creates a declaration for the font-size-adjust property.
-func SetFontSizeAdjust(v FontSizeAdjustVal) css.Decl {
-	return css.Set(FontSizeAdjust, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-size-adjust
+func SetFontSizeAdjust[T FontSizeAdjustValue](v T) css.Decl {
+	return css.Set(FontSizeAdjust, v)
+}
+
+// FontSmoothValue is implemented by the value types accepted by the font-smooth property.
+type FontSmoothValue interface {
+	css.Value
+	FontSmoothVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetFontSmooth creates a declaration for the font-smooth property.
-func SetFontSmooth(v FontSmoothVal) css.Decl {
-	return css.Set(FontSmooth, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	auto | never | always | <absolute-size> | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-smooth
+func SetFontSmooth[T FontSmoothValue](v T) css.Decl {
+	return css.Set(FontSmooth, v)
+}
+
+// FontStretchValue is implemented by the value types accepted by the font-stretch property.
+type FontStretchValue interface {
+	css.Value
+	FontStretchVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetFontStretch creates a declaration for the font-stretch property.
-func SetFontStretch(v FontStretchVal) css.Decl {
-	return css.Set(FontStretch, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<font-stretch-absolute>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-stretch
+//
+// Deprecated: the font-stretch property is obsolete.
+func SetFontStretch[T FontStretchValue](v T) css.Decl {
+	return css.Set(FontStretch, v)
+}
+
+// FontStyleValue is implemented by the value types accepted by the font-style property.
+type FontStyleValue interface {
+	css.Value
+	FontStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontStyle creates a declaration for the font-style property.
-func SetFontStyle(v FontStyleVal) css.Decl {
-	return css.Set(FontStyle, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | italic | oblique <angle>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-style
+func SetFontStyle[T FontStyleValue](v T) css.Decl {
+	return css.Set(FontStyle, v)
+}
+
+// FontSynthesisValue is implemented by the value types accepted by the font-synthesis property.
+type FontSynthesisValue interface {
+	css.Value
+	FontSynthesisVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontSynthesis creates a declaration for the font-synthesis property.
-func SetFontSynthesis(v FontSynthesisVal) css.Decl {
-	return css.Set(FontSynthesis, css.Keyword(string(v)))
+//
+//   - Initial: weight style small-caps position
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ weight || style || small-caps || position]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis
+func SetFontSynthesis[T FontSynthesisValue](v T) css.Decl {
+	return css.Set(FontSynthesis, v)
+}
+
+// FontSynthesisSmallCapsValue is implemented by the value types accepted by the font-synthesis-small-caps property.
+type FontSynthesisSmallCapsValue interface {
+	css.Value
+	FontSynthesisSmallCapsVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontSynthesisSmallCaps creates a declaration for the font-synthesis-small-caps property.
-func SetFontSynthesisSmallCaps(v FontSynthesisSmallCapsVal) css.Decl {
-	return css.Set(FontSynthesisSmallCaps, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-small-caps
+func SetFontSynthesisSmallCaps[T FontSynthesisSmallCapsValue](v T) css.Decl {
+	return css.Set(FontSynthesisSmallCaps, v)
+}
+
+// FontSynthesisStyleValue is implemented by the value types accepted by the font-synthesis-style property.
+type FontSynthesisStyleValue interface {
+	css.Value
+	FontSynthesisStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontSynthesisStyle creates a declaration for the font-synthesis-style property.
-func SetFontSynthesisStyle(v FontSynthesisStyleVal) css.Decl {
-	return css.Set(FontSynthesisStyle, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-style
+func SetFontSynthesisStyle[T FontSynthesisStyleValue](v T) css.Decl {
+	return css.Set(FontSynthesisStyle, v)
+}
+
+// FontSynthesisWeightValue is implemented by the value types accepted by the font-synthesis-weight property.
+type FontSynthesisWeightValue interface {
+	css.Value
+	FontSynthesisWeightVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontSynthesisWeight creates a declaration for the font-synthesis-weight property.
-func SetFontSynthesisWeight(v FontSynthesisWeightVal) css.Decl {
-	return css.Set(FontSynthesisWeight, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-synthesis-weight
+func SetFontSynthesisWeight[T FontSynthesisWeightValue](v T) css.Decl {
+	return css.Set(FontSynthesisWeight, v)
+}
+
+// FontVariantValue is implemented by the value types accepted by the font-variant property.
+type FontVariantValue interface {
+	css.Value
+	FontVariantVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariant creates a declaration for the font-variant property.
-func SetFontVariant(v FontVariantVal) css.Decl {
-	return css.Set(FontVariant, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) || [ small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps ] || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant
+func SetFontVariant[T FontVariantValue](v T) css.Decl {
+	return css.Set(FontVariant, v)
+}
+
+// FontVariantAlternatesValue is implemented by the value types accepted by the font-variant-alternates property.
+type FontVariantAlternatesValue interface {
+	css.Value
+	FontVariantAlternatesVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantAlternates creates a declaration for the font-variant-alternates property.
-func SetFontVariantAlternates(v FontVariantAlternatesVal) css.Decl {
-	return css.Set(FontVariantAlternates, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-alternates
+func SetFontVariantAlternates[T FontVariantAlternatesValue](v T) css.Decl {
+	return css.Set(FontVariantAlternates, v)
+}
+
+// FontVariantCapsValue is implemented by the value types accepted by the font-variant-caps property.
+type FontVariantCapsValue interface {
+	css.Value
+	FontVariantCapsVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantCaps creates a declaration for the font-variant-caps property.
-func SetFontVariantCaps(v FontVariantCapsVal) css.Decl {
-	return css.Set(FontVariantCaps, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-caps
+func SetFontVariantCaps[T FontVariantCapsValue](v T) css.Decl {
+	return css.Set(FontVariantCaps, v)
+}
+
+// FontVariantEastAsianValue is implemented by the value types accepted by the font-variant-east-asian property.
+type FontVariantEastAsianValue interface {
+	css.Value
+	FontVariantEastAsianVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantEastAsian creates a declaration for the font-variant-east-asian property.
-func SetFontVariantEastAsian(v FontVariantEastAsianVal) css.Decl {
-	return css.Set(FontVariantEastAsian, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-east-asian
+func SetFontVariantEastAsian[T FontVariantEastAsianValue](v T) css.Decl {
+	return css.Set(FontVariantEastAsian, v)
+}
+
+// FontVariantEmojiValue is implemented by the value types accepted by the font-variant-emoji property.
+type FontVariantEmojiValue interface {
+	css.Value
+	FontVariantEmojiVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantEmoji creates a declaration for the font-variant-emoji property.
-func SetFontVariantEmoji(v FontVariantEmojiVal) css.Decl {
-	return css.Set(FontVariantEmoji, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | text | emoji | unicode
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-emoji
+func SetFontVariantEmoji[T FontVariantEmojiValue](v T) css.Decl {
+	return css.Set(FontVariantEmoji, v)
+}
+
+// FontVariantLigaturesValue is implemented by the value types accepted by the font-variant-ligatures property.
+type FontVariantLigaturesValue interface {
+	css.Value
+	FontVariantLigaturesVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantLigatures creates a declaration for the font-variant-ligatures property.
-func SetFontVariantLigatures(v FontVariantLigaturesVal) css.Decl {
-	return css.Set(FontVariantLigatures, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-ligatures
+func SetFontVariantLigatures[T FontVariantLigaturesValue](v T) css.Decl {
+	return css.Set(FontVariantLigatures, v)
+}
+
+// FontVariantNumericValue is implemented by the value types accepted by the font-variant-numeric property.
+type FontVariantNumericValue interface {
+	css.Value
+	FontVariantNumericVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantNumeric creates a declaration for the font-variant-numeric property.
-func SetFontVariantNumeric(v FontVariantNumericVal) css.Decl {
-	return css.Set(FontVariantNumeric, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-numeric
+func SetFontVariantNumeric[T FontVariantNumericValue](v T) css.Decl {
+	return css.Set(FontVariantNumeric, v)
+}
+
+// FontVariantPositionValue is implemented by the value types accepted by the font-variant-position property.
+type FontVariantPositionValue interface {
+	css.Value
+	FontVariantPositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariantPosition creates a declaration for the font-variant-position property.
-func SetFontVariantPosition(v FontVariantPositionVal) css.Decl {
-	return css.Set(FontVariantPosition, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | sub | super
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variant-position
+func SetFontVariantPosition[T FontVariantPositionValue](v T) css.Decl {
+	return css.Set(FontVariantPosition, v)
+}
+
+// FontVariationSettingsValue is implemented by the value types accepted by the font-variation-settings property.
+type FontVariationSettingsValue interface {
+	css.Value
+	FontVariationSettingsVal | css.Raw | css.Function | css.Global
 }
 
 // SetFontVariationSettings creates a declaration for the font-variation-settings property.
-func SetFontVariationSettings(v FontVariationSettingsVal) css.Decl {
-	return css.Set(FontVariationSettings, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <string> <number> ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-variation-settings
+func SetFontVariationSettings[T FontVariationSettingsValue](v T) css.Decl {
+	return css.Set(FontVariationSettings, v)
+}
+
+// FontWeightValue is implemented by the value types accepted by the font-weight property.
+type FontWeightValue interface {
+	css.Value
+	FontWeightVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetFontWeight creates a declaration for the font-weight property.
-func SetFontWeight(v FontWeightVal) css.Decl {
-	return css.Set(FontWeight, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<font-weight-absolute> | bolder | lighter
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/font-weight
+func SetFontWeight[T FontWeightValue](v T) css.Decl {
+	return css.Set(FontWeight, v)
+}
+
+// ForcedColorAdjustValue is implemented by the value types accepted by the forced-color-adjust property.
+type ForcedColorAdjustValue interface {
+	css.Value
+	ForcedColorAdjustVal | css.Raw | css.Function | css.Global
 }
 
 // SetForcedColorAdjust creates a declaration for the forced-color-adjust property.
-func SetForcedColorAdjust(v ForcedColorAdjustVal) css.Decl {
-	return css.Set(ForcedColorAdjust, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | preserve-parent-color
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/forced-color-adjust
+func SetForcedColorAdjust[T ForcedColorAdjustValue](v T) css.Decl {
+	return css.Set(ForcedColorAdjust, v)
+}
+
+// GapValue is implemented by the value types accepted by the gap property.
+type GapValue interface {
+	css.Value
+	GapVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetGap creates a declaration for the gap property.
-func SetGap(v GapVal) css.Decl {
-	return css.Set(Gap, css.Keyword(string(v)))
+//
+//   - Shorthand for: column-gap, row-gap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'row-gap'> <'column-gap'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/gap
+func SetGap[T GapValue](v T) css.Decl {
+	return css.Set(Gap, v)
+}
+
+// GridValue is implemented by the value types accepted by the grid property.
+type GridValue interface {
+	css.Value
+	GridVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetGrid creates a declaration for the grid property.
-func SetGrid(v GridVal) css.Decl {
-	return css.Set(Grid, css.Keyword(string(v)))
+//
+//   - Shorthand for: column-gap, grid-auto-columns, grid-auto-flow, grid-auto-rows, grid-column-gap, grid-row-gap, grid-template-areas, grid-template-columns, grid-template-rows, row-gap
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid
+func SetGrid[T GridValue](v T) css.Decl {
+	return css.Set(Grid, v)
+}
+
+// GridAreaValue is implemented by the value types accepted by the grid-area property.
+type GridAreaValue interface {
+	css.Value
+	GridAreaVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridArea creates a declaration for the grid-area property.
-func SetGridArea(v GridAreaVal) css.Decl {
-	return css.Set(GridArea, css.Keyword(string(v)))
+//
+//   - Shorthand for: grid-column-end, grid-column-start, grid-row-end, grid-row-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]{0,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-area
+func SetGridArea[T GridAreaValue](v T) css.Decl {
+	return css.Set(GridArea, v)
+}
+
+// GridAutoColumnsValue is implemented by the value types accepted by the grid-auto-columns property.
+type GridAutoColumnsValue interface {
+	css.Value
+	GridAutoColumnsVal | css.Length | css.Flex | css.Raw | css.Function | css.Global
 }
 
 // SetGridAutoColumns creates a declaration for the grid-auto-columns property.
-func SetGridAutoColumns(v GridAutoColumnsVal) css.Decl {
-	return css.Set(GridAutoColumns, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<track-size>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-columns
+func SetGridAutoColumns[T GridAutoColumnsValue](v T) css.Decl {
+	return css.Set(GridAutoColumns, v)
+}
+
+// GridAutoFlowValue is implemented by the value types accepted by the grid-auto-flow property.
+type GridAutoFlowValue interface {
+	css.Value
+	GridAutoFlowVal | css.Raw | css.Function | css.Global
 }
 
 // SetGridAutoFlow creates a declaration for the grid-auto-flow property.
-func SetGridAutoFlow(v GridAutoFlowVal) css.Decl {
-	return css.Set(GridAutoFlow, css.Keyword(string(v)))
+//
+//   - Initial: row
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ row | column ] || dense
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
+func SetGridAutoFlow[T GridAutoFlowValue](v T) css.Decl {
+	return css.Set(GridAutoFlow, v)
+}
+
+// GridAutoRowsValue is implemented by the value types accepted by the grid-auto-rows property.
+type GridAutoRowsValue interface {
+	css.Value
+	GridAutoRowsVal | css.Length | css.Flex | css.Raw | css.Function | css.Global
 }
 
 // SetGridAutoRows creates a declaration for the grid-auto-rows property.
-func SetGridAutoRows(v GridAutoRowsVal) css.Decl {
-	return css.Set(GridAutoRows, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<track-size>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-rows
+func SetGridAutoRows[T GridAutoRowsValue](v T) css.Decl {
+	return css.Set(GridAutoRows, v)
+}
+
+// GridColumnValue is implemented by the value types accepted by the grid-column property.
+type GridColumnValue interface {
+	css.Value
+	GridColumnVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridColumn creates a declaration for the grid-column property.
-func SetGridColumn(v GridColumnVal) css.Decl {
-	return css.Set(GridColumn, css.Keyword(string(v)))
+//
+//   - Shorthand for: grid-column-end, grid-column-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column
+func SetGridColumn[T GridColumnValue](v T) css.Decl {
+	return css.Set(GridColumn, v)
+}
+
+// GridColumnEndValue is implemented by the value types accepted by the grid-column-end property.
+type GridColumnEndValue interface {
+	css.Value
+	GridColumnEndVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridColumnEnd creates a declaration for the grid-column-end property.
-func SetGridColumnEnd(v GridColumnEndVal) css.Decl {
-	return css.Set(GridColumnEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-end
+func SetGridColumnEnd[T GridColumnEndValue](v T) css.Decl {
+	return css.Set(GridColumnEnd, v)
+}
+
+// GridColumnGapValue is implemented by the value types accepted by the grid-column-gap property.
+type GridColumnGapValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetGridColumnGap creates a declaration for the grid-column-gap property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/column-gap
+//
+// Deprecated: the grid-column-gap property is obsolete.
+func SetGridColumnGap[T GridColumnGapValue](v T) css.Decl {
+	return css.Set(GridColumnGap, v)
+}
+
+// GridColumnStartValue is implemented by the value types accepted by the grid-column-start property.
+type GridColumnStartValue interface {
+	css.Value
+	GridColumnStartVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridColumnStart creates a declaration for the grid-column-start property.
-func SetGridColumnStart(v GridColumnStartVal) css.Decl {
-	return css.Set(GridColumnStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-column-start
+func SetGridColumnStart[T GridColumnStartValue](v T) css.Decl {
+	return css.Set(GridColumnStart, v)
+}
+
+// GridGapValue is implemented by the value types accepted by the grid-gap property.
+type GridGapValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetGridGap creates a declaration for the grid-gap property.
-func SetGridGap(v GridGapVal) css.Decl {
-	return css.Set(GridGap, css.Keyword(string(v)))
+//
+//   - Shorthand for: grid-column-gap, grid-row-gap
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<'grid-row-gap'> <'grid-column-gap'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/gap
+//
+// Deprecated: the grid-gap property is obsolete.
+func SetGridGap[T GridGapValue](v T) css.Decl {
+	return css.Set(GridGap, v)
+}
+
+// GridRowValue is implemented by the value types accepted by the grid-row property.
+type GridRowValue interface {
+	css.Value
+	GridRowVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridRow creates a declaration for the grid-row property.
-func SetGridRow(v GridRowVal) css.Decl {
-	return css.Set(GridRow, css.Keyword(string(v)))
+//
+//   - Shorthand for: grid-row-end, grid-row-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line> [ / <grid-line> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row
+func SetGridRow[T GridRowValue](v T) css.Decl {
+	return css.Set(GridRow, v)
+}
+
+// GridRowEndValue is implemented by the value types accepted by the grid-row-end property.
+type GridRowEndValue interface {
+	css.Value
+	GridRowEndVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridRowEnd creates a declaration for the grid-row-end property.
-func SetGridRowEnd(v GridRowEndVal) css.Decl {
-	return css.Set(GridRowEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-end
+func SetGridRowEnd[T GridRowEndValue](v T) css.Decl {
+	return css.Set(GridRowEnd, v)
+}
+
+// GridRowGapValue is implemented by the value types accepted by the grid-row-gap property.
+type GridRowGapValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetGridRowGap creates a declaration for the grid-row-gap property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/row-gap
+//
+// Deprecated: the grid-row-gap property is obsolete.
+func SetGridRowGap[T GridRowGapValue](v T) css.Decl {
+	return css.Set(GridRowGap, v)
+}
+
+// GridRowStartValue is implemented by the value types accepted by the grid-row-start property.
+type GridRowStartValue interface {
+	css.Value
+	GridRowStartVal | css.Integer | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetGridRowStart creates a declaration for the grid-row-start property.
-func SetGridRowStart(v GridRowStartVal) css.Decl {
-	return css.Set(GridRowStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<grid-line>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-row-start
+func SetGridRowStart[T GridRowStartValue](v T) css.Decl {
+	return css.Set(GridRowStart, v)
+}
+
+// GridTemplateValue is implemented by the value types accepted by the grid-template property.
+type GridTemplateValue interface {
+	css.Value
+	GridTemplateVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetGridTemplate creates a declaration for the grid-template property.
-func SetGridTemplate(v GridTemplateVal) css.Decl {
-	return css.Set(GridTemplate, css.Keyword(string(v)))
+//
+//   - Shorthand for: grid-template-areas, grid-template-columns, grid-template-rows
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template
+func SetGridTemplate[T GridTemplateValue](v T) css.Decl {
+	return css.Set(GridTemplate, v)
+}
+
+// GridTemplateAreasValue is implemented by the value types accepted by the grid-template-areas property.
+type GridTemplateAreasValue interface {
+	css.Value
+	GridTemplateAreasVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetGridTemplateAreas creates a declaration for the grid-template-areas property.
-func SetGridTemplateAreas(v GridTemplateAreasVal) css.Decl {
-	return css.Set(GridTemplateAreas, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <string>+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-areas
+func SetGridTemplateAreas[T GridTemplateAreasValue](v T) css.Decl {
+	return css.Set(GridTemplateAreas, v)
+}
+
+// GridTemplateColumnsValue is implemented by the value types accepted by the grid-template-columns property.
+type GridTemplateColumnsValue interface {
+	css.Value
+	GridTemplateColumnsVal | css.Length | css.Flex | css.Raw | css.Function | css.Global
 }
 
 // SetGridTemplateColumns creates a declaration for the grid-template-columns property.
-func SetGridTemplateColumns(v GridTemplateColumnsVal) css.Decl {
-	return css.Set(GridTemplateColumns, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-columns
+func SetGridTemplateColumns[T GridTemplateColumnsValue](v T) css.Decl {
+	return css.Set(GridTemplateColumns, v)
+}
+
+// GridTemplateRowsValue is implemented by the value types accepted by the grid-template-rows property.
+type GridTemplateRowsValue interface {
+	css.Value
+	GridTemplateRowsVal | css.Length | css.Flex | css.Raw | css.Function | css.Global
 }
 
 // SetGridTemplateRows creates a declaration for the grid-template-rows property.
-func SetGridTemplateRows(v GridTemplateRowsVal) css.Decl {
-	return css.Set(GridTemplateRows, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <track-list> | <auto-track-list> | subgrid <line-name-list>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-template-rows
+func SetGridTemplateRows[T GridTemplateRowsValue](v T) css.Decl {
+	return css.Set(GridTemplateRows, v)
+}
+
+// HangingPunctuationValue is implemented by the value types accepted by the hanging-punctuation property.
+type HangingPunctuationValue interface {
+	css.Value
+	HangingPunctuationVal | css.Raw | css.Function | css.Global
 }
 
 // SetHangingPunctuation creates a declaration for the hanging-punctuation property.
-func SetHangingPunctuation(v HangingPunctuationVal) css.Decl {
-	return css.Set(HangingPunctuation, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ first || [ force-end | allow-end ] || last ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hanging-punctuation
+func SetHangingPunctuation[T HangingPunctuationValue](v T) css.Decl {
+	return css.Set(HangingPunctuation, v)
+}
+
+// HeightValue is implemented by the value types accepted by the height property.
+type HeightValue interface {
+	css.Value
+	HeightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetHeight creates a declaration for the height property.
-func SetHeight(v HeightVal) css.Decl {
-	return css.Set(Height, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/height
+func SetHeight[T HeightValue](v T) css.Decl {
+	return css.Set(Height, v)
+}
+
+// HyphenateCharacterValue is implemented by the value types accepted by the hyphenate-character property.
+type HyphenateCharacterValue interface {
+	css.Value
+	HyphenateCharacterVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetHyphenateCharacter creates a declaration for the hyphenate-character property.
-func SetHyphenateCharacter(v HyphenateCharacterVal) css.Decl {
-	return css.Set(HyphenateCharacter, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-character
+func SetHyphenateCharacter[T HyphenateCharacterValue](v T) css.Decl {
+	return css.Set(HyphenateCharacter, v)
+}
+
+// HyphenateLimitCharsValue is implemented by the value types accepted by the hyphenate-limit-chars property.
+type HyphenateLimitCharsValue interface {
+	css.Value
+	HyphenateLimitCharsVal | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetHyphenateLimitChars creates a declaration for the hyphenate-limit-chars property.
-func SetHyphenateLimitChars(v HyphenateLimitCharsVal) css.Decl {
-	return css.Set(HyphenateLimitChars, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <integer> ]{1,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphenate-limit-chars
+func SetHyphenateLimitChars[T HyphenateLimitCharsValue](v T) css.Decl {
+	return css.Set(HyphenateLimitChars, v)
+}
+
+// HyphensValue is implemented by the value types accepted by the hyphens property.
+type HyphensValue interface {
+	css.Value
+	HyphensVal | css.Raw | css.Function | css.Global
 }
 
 // SetHyphens creates a declaration for the hyphens property.
-func SetHyphens(v HyphensVal) css.Decl {
-	return css.Set(Hyphens, css.Keyword(string(v)))
+//
+//   - Initial: manual
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | manual | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/hyphens
+func SetHyphens[T HyphensValue](v T) css.Decl {
+	return css.Set(Hyphens, v)
+}
+
+// ImageOrientationValue is implemented by the value types accepted by the image-orientation property.
+type ImageOrientationValue interface {
+	css.Value
+	ImageOrientationVal | css.Angle | css.Raw | css.Function | css.Global
 }
 
 // SetImageOrientation creates a declaration for the image-orientation property.
-func SetImageOrientation(v ImageOrientationVal) css.Decl {
-	return css.Set(ImageOrientation, css.Keyword(string(v)))
+//
+//   - Initial: from-image
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	from-image | <angle> | [ <angle>? flip ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-orientation
+func SetImageOrientation[T ImageOrientationValue](v T) css.Decl {
+	return css.Set(ImageOrientation, v)
+}
+
+// ImageRenderingValue is implemented by the value types accepted by the image-rendering property.
+type ImageRenderingValue interface {
+	css.Value
+	ImageRenderingVal | css.Raw | css.Function | css.Global
 }
 
 // SetImageRendering creates a declaration for the image-rendering property.
-func SetImageRendering(v ImageRenderingVal) css.Decl {
-	return css.Set(ImageRendering, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | crisp-edges | pixelated | smooth
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/image-rendering
+func SetImageRendering[T ImageRenderingValue](v T) css.Decl {
+	return css.Set(ImageRendering, v)
+}
+
+// ImeModeValue is implemented by the value types accepted by the ime-mode property.
+type ImeModeValue interface {
+	css.Value
+	ImeModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetImeMode creates a declaration for the ime-mode property.
-func SetImeMode(v ImeModeVal) css.Decl {
-	return css.Set(ImeMode, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | normal | active | inactive | disabled
+//
+// Deprecated: the ime-mode property is obsolete.
+func SetImeMode[T ImeModeValue](v T) css.Decl {
+	return css.Set(ImeMode, v)
+}
+
+// InitialLetterValue is implemented by the value types accepted by the initial-letter property.
+type InitialLetterValue interface {
+	css.Value
+	InitialLetterVal | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetInitialLetter creates a declaration for the initial-letter property.
-func SetInitialLetter(v InitialLetterVal) css.Decl {
-	return css.Set(InitialLetter, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ <number> <integer>? ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/initial-letter
+func SetInitialLetter[T InitialLetterValue](v T) css.Decl {
+	return css.Set(InitialLetter, v)
+}
+
+// InlineSizeValue is implemented by the value types accepted by the inline-size property.
+type InlineSizeValue interface {
+	css.Value
+	InlineSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInlineSize creates a declaration for the inline-size property.
-func SetInlineSize(v InlineSizeVal) css.Decl {
-	return css.Set(InlineSize, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inline-size
+func SetInlineSize[T InlineSizeValue](v T) css.Decl {
+	return css.Set(InlineSize, v)
+}
+
+// InsetValue is implemented by the value types accepted by the inset property.
+type InsetValue interface {
+	css.Value
+	InsetVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInset creates a declaration for the inset property.
-func SetInset(v InsetVal) css.Decl {
-	return css.Set(Inset, css.Keyword(string(v)))
+//
+//   - Shorthand for: bottom, left, right, top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset
+func SetInset[T InsetValue](v T) css.Decl {
+	return css.Set(Inset, v)
+}
+
+// InsetBlockValue is implemented by the value types accepted by the inset-block property.
+type InsetBlockValue interface {
+	css.Value
+	InsetBlockVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetBlock creates a declaration for the inset-block property.
-func SetInsetBlock(v InsetBlockVal) css.Decl {
-	return css.Set(InsetBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: inset-block-end, inset-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block
+func SetInsetBlock[T InsetBlockValue](v T) css.Decl {
+	return css.Set(InsetBlock, v)
+}
+
+// InsetBlockEndValue is implemented by the value types accepted by the inset-block-end property.
+type InsetBlockEndValue interface {
+	css.Value
+	InsetBlockEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetBlockEnd creates a declaration for the inset-block-end property.
-func SetInsetBlockEnd(v InsetBlockEndVal) css.Decl {
-	return css.Set(InsetBlockEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-end
+func SetInsetBlockEnd[T InsetBlockEndValue](v T) css.Decl {
+	return css.Set(InsetBlockEnd, v)
+}
+
+// InsetBlockStartValue is implemented by the value types accepted by the inset-block-start property.
+type InsetBlockStartValue interface {
+	css.Value
+	InsetBlockStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetBlockStart creates a declaration for the inset-block-start property.
-func SetInsetBlockStart(v InsetBlockStartVal) css.Decl {
-	return css.Set(InsetBlockStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-block-start
+func SetInsetBlockStart[T InsetBlockStartValue](v T) css.Decl {
+	return css.Set(InsetBlockStart, v)
+}
+
+// InsetInlineValue is implemented by the value types accepted by the inset-inline property.
+type InsetInlineValue interface {
+	css.Value
+	InsetInlineVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetInline creates a declaration for the inset-inline property.
-func SetInsetInline(v InsetInlineVal) css.Decl {
-	return css.Set(InsetInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: inset-inline-end, inset-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline
+func SetInsetInline[T InsetInlineValue](v T) css.Decl {
+	return css.Set(InsetInline, v)
+}
+
+// InsetInlineEndValue is implemented by the value types accepted by the inset-inline-end property.
+type InsetInlineEndValue interface {
+	css.Value
+	InsetInlineEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetInlineEnd creates a declaration for the inset-inline-end property.
-func SetInsetInlineEnd(v InsetInlineEndVal) css.Decl {
-	return css.Set(InsetInlineEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-end
+func SetInsetInlineEnd[T InsetInlineEndValue](v T) css.Decl {
+	return css.Set(InsetInlineEnd, v)
+}
+
+// InsetInlineStartValue is implemented by the value types accepted by the inset-inline-start property.
+type InsetInlineStartValue interface {
+	css.Value
+	InsetInlineStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetInsetInlineStart creates a declaration for the inset-inline-start property.
-func SetInsetInlineStart(v InsetInlineStartVal) css.Decl {
-	return css.Set(InsetInlineStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/inset-inline-start
+func SetInsetInlineStart[T InsetInlineStartValue](v T) css.Decl {
+	return css.Set(InsetInlineStart, v)
+}
+
+// IsolationValue is implemented by the value types accepted by the isolation property.
+type IsolationValue interface {
+	css.Value
+	IsolationVal | css.Raw | css.Function | css.Global
 }
 
 // SetIsolation creates a declaration for the isolation property.
-func SetIsolation(v IsolationVal) css.Decl {
-	return css.Set(Isolation, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | isolate
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/isolation
+func SetIsolation[T IsolationValue](v T) css.Decl {
+	return css.Set(Isolation, v)
+}
+
+// JustifyContentValue is implemented by the value types accepted by the justify-content property.
+type JustifyContentValue interface {
+	css.Value
+	JustifyContentVal | css.Raw | css.Function | css.Global
 }
 
 // SetJustifyContent creates a declaration for the justify-content property.
-func SetJustifyContent(v JustifyContentVal) css.Decl {
-	return css.Set(JustifyContent, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-content
+func SetJustifyContent[T JustifyContentValue](v T) css.Decl {
+	return css.Set(JustifyContent, v)
+}
+
+// JustifyItemsValue is implemented by the value types accepted by the justify-items property.
+type JustifyItemsValue interface {
+	css.Value
+	JustifyItemsVal | css.Raw | css.Function | css.Global
 }
 
 // SetJustifyItems creates a declaration for the justify-items property.
-func SetJustifyItems(v JustifyItemsVal) css.Decl {
-	return css.Set(JustifyItems, css.Keyword(string(v)))
+//
+//   - Initial: legacy
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-items
+func SetJustifyItems[T JustifyItemsValue](v T) css.Decl {
+	return css.Set(JustifyItems, v)
+}
+
+// JustifySelfValue is implemented by the value types accepted by the justify-self property.
+type JustifySelfValue interface {
+	css.Value
+	JustifySelfVal | css.Raw | css.Function | css.Global
 }
 
 // SetJustifySelf creates a declaration for the justify-self property.
-func SetJustifySelf(v JustifySelfVal) css.Decl {
-	return css.Set(JustifySelf, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | anchor-center
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/justify-self
+func SetJustifySelf[T JustifySelfValue](v T) css.Decl {
+	return css.Set(JustifySelf, v)
+}
+
+// JustifyTracksValue is implemented by the value types accepted by the justify-tracks property.
+type JustifyTracksValue interface {
+	css.Value
+	JustifyTracksVal | css.Raw | css.Function | css.Global
 }
 
 // SetJustifyTracks creates a declaration for the justify-tracks property.
-func SetJustifyTracks(v JustifyTracksVal) css.Decl {
-	return css.Set(JustifyTracks, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ] ]#
+func SetJustifyTracks[T JustifyTracksValue](v T) css.Decl {
+	return css.Set(JustifyTracks, v)
+}
+
+// LeftValue is implemented by the value types accepted by the left property.
+type LeftValue interface {
+	css.Value
+	LeftVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetLeft creates a declaration for the left property.
-func SetLeft(v LeftVal) css.Decl {
-	return css.Set(Left, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/left
+func SetLeft[T LeftValue](v T) css.Decl {
+	return css.Set(Left, v)
+}
+
+// LetterSpacingValue is implemented by the value types accepted by the letter-spacing property.
+type LetterSpacingValue interface {
+	css.Value
+	LetterSpacingVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetLetterSpacing creates a declaration for the letter-spacing property.
-func SetLetterSpacing(v LetterSpacingVal) css.Decl {
-	return css.Set(LetterSpacing, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/letter-spacing
+func SetLetterSpacing[T LetterSpacingValue](v T) css.Decl {
+	return css.Set(LetterSpacing, v)
+}
+
+// LightingColorValue is implemented by the value types accepted by the lighting-color property.
+type LightingColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetLightingColor creates a declaration for the lighting-color property.
-func SetLightingColor(v LightingColorVal) css.Decl {
-	return css.Set(LightingColor, css.Keyword(string(v)))
+//
+//   - Initial: white
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/lighting-color
+func SetLightingColor[T LightingColorValue](v T) css.Decl {
+	return css.Set(LightingColor, v)
+}
+
+// LineBreakValue is implemented by the value types accepted by the line-break property.
+type LineBreakValue interface {
+	css.Value
+	LineBreakVal | css.Raw | css.Function | css.Global
 }
 
 // SetLineBreak creates a declaration for the line-break property.
-func SetLineBreak(v LineBreakVal) css.Decl {
-	return css.Set(LineBreak, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | loose | normal | strict | anywhere
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-break
+func SetLineBreak[T LineBreakValue](v T) css.Decl {
+	return css.Set(LineBreak, v)
+}
+
+// LineClampValue is implemented by the value types accepted by the line-clamp property.
+type LineClampValue interface {
+	css.Value
+	LineClampVal | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetLineClamp creates a declaration for the line-clamp property.
-func SetLineClamp(v LineClampVal) css.Decl {
-	return css.Set(LineClamp, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-clamp
+func SetLineClamp[T LineClampValue](v T) css.Decl {
+	return css.Set(LineClamp, v)
+}
+
+// LineHeightValue is implemented by the value types accepted by the line-height property.
+type LineHeightValue interface {
+	css.Value
+	LineHeightVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetLineHeight creates a declaration for the line-height property.
-func SetLineHeight(v LineHeightVal) css.Decl {
-	return css.Set(LineHeight, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <number> | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/line-height
+func SetLineHeight[T LineHeightValue](v T) css.Decl {
+	return css.Set(LineHeight, v)
+}
+
+// ListStyleValue is implemented by the value types accepted by the list-style property.
+type ListStyleValue interface {
+	css.Value
+	ListStyleVal | css.Keyword | css.QuotedString | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetListStyle creates a declaration for the list-style property.
-func SetListStyle(v ListStyleVal) css.Decl {
-	return css.Set(ListStyle, css.Keyword(string(v)))
+//
+//   - Shorthand for: list-style-image, list-style-position, list-style-type
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'list-style-type'> || <'list-style-position'> || <'list-style-image'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style
+func SetListStyle[T ListStyleValue](v T) css.Decl {
+	return css.Set(ListStyle, v)
+}
+
+// ListStyleImageValue is implemented by the value types accepted by the list-style-image property.
+type ListStyleImageValue interface {
+	css.Value
+	ListStyleImageVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetListStyleImage creates a declaration for the list-style-image property.
-func SetListStyleImage(v ListStyleImageVal) css.Decl {
-	return css.Set(ListStyleImage, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<image> | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-image
+func SetListStyleImage[T ListStyleImageValue](v T) css.Decl {
+	return css.Set(ListStyleImage, v)
+}
+
+// ListStylePositionValue is implemented by the value types accepted by the list-style-position property.
+type ListStylePositionValue interface {
+	css.Value
+	ListStylePositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetListStylePosition creates a declaration for the list-style-position property.
-func SetListStylePosition(v ListStylePositionVal) css.Decl {
-	return css.Set(ListStylePosition, css.Keyword(string(v)))
+//
+//   - Initial: outside
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	inside | outside
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-position
+func SetListStylePosition[T ListStylePositionValue](v T) css.Decl {
+	return css.Set(ListStylePosition, v)
+}
+
+// ListStyleTypeValue is implemented by the value types accepted by the list-style-type property.
+type ListStyleTypeValue interface {
+	css.Value
+	ListStyleTypeVal | css.Keyword | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetListStyleType creates a declaration for the list-style-type property.
-func SetListStyleType(v ListStyleTypeVal) css.Decl {
-	return css.Set(ListStyleType, css.Keyword(string(v)))
+//
+//   - Initial: disc
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<counter-style> | <string> | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/list-style-type
+func SetListStyleType[T ListStyleTypeValue](v T) css.Decl {
+	return css.Set(ListStyleType, v)
+}
+
+// MarginValue is implemented by the value types accepted by the margin property.
+type MarginValue interface {
+	css.Value
+	MarginVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMargin creates a declaration for the margin property.
-func SetMargin(v MarginVal) css.Decl {
-	return css.Set(Margin, css.Keyword(string(v)))
+//
+//   - Shorthand for: margin-bottom, margin-left, margin-right, margin-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin
+func SetMargin[T MarginValue](v T) css.Decl {
+	return css.Set(Margin, v)
+}
+
+// MarginBlockValue is implemented by the value types accepted by the margin-block property.
+type MarginBlockValue interface {
+	css.Value
+	MarginBlockVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginBlock creates a declaration for the margin-block property.
-func SetMarginBlock(v MarginBlockVal) css.Decl {
-	return css.Set(MarginBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: margin-block-end, margin-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block
+func SetMarginBlock[T MarginBlockValue](v T) css.Decl {
+	return css.Set(MarginBlock, v)
+}
+
+// MarginBlockEndValue is implemented by the value types accepted by the margin-block-end property.
+type MarginBlockEndValue interface {
+	css.Value
+	MarginBlockEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginBlockEnd creates a declaration for the margin-block-end property.
-func SetMarginBlockEnd(v MarginBlockEndVal) css.Decl {
-	return css.Set(MarginBlockEnd, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-end
+func SetMarginBlockEnd[T MarginBlockEndValue](v T) css.Decl {
+	return css.Set(MarginBlockEnd, v)
+}
+
+// MarginBlockStartValue is implemented by the value types accepted by the margin-block-start property.
+type MarginBlockStartValue interface {
+	css.Value
+	MarginBlockStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginBlockStart creates a declaration for the margin-block-start property.
-func SetMarginBlockStart(v MarginBlockStartVal) css.Decl {
-	return css.Set(MarginBlockStart, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-block-start
+func SetMarginBlockStart[T MarginBlockStartValue](v T) css.Decl {
+	return css.Set(MarginBlockStart, v)
+}
+
+// MarginBottomValue is implemented by the value types accepted by the margin-bottom property.
+type MarginBottomValue interface {
+	css.Value
+	MarginBottomVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginBottom creates a declaration for the margin-bottom property.
-func SetMarginBottom(v MarginBottomVal) css.Decl {
-	return css.Set(MarginBottom, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-bottom
+func SetMarginBottom[T MarginBottomValue](v T) css.Decl {
+	return css.Set(MarginBottom, v)
+}
+
+// MarginInlineValue is implemented by the value types accepted by the margin-inline property.
+type MarginInlineValue interface {
+	css.Value
+	MarginInlineVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginInline creates a declaration for the margin-inline property.
-func SetMarginInline(v MarginInlineVal) css.Decl {
-	return css.Set(MarginInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: margin-inline-end, margin-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline
+func SetMarginInline[T MarginInlineValue](v T) css.Decl {
+	return css.Set(MarginInline, v)
+}
+
+// MarginInlineEndValue is implemented by the value types accepted by the margin-inline-end property.
+type MarginInlineEndValue interface {
+	css.Value
+	MarginInlineEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginInlineEnd creates a declaration for the margin-inline-end property.
-func SetMarginInlineEnd(v MarginInlineEndVal) css.Decl {
-	return css.Set(MarginInlineEnd, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-end
+func SetMarginInlineEnd[T MarginInlineEndValue](v T) css.Decl {
+	return css.Set(MarginInlineEnd, v)
+}
+
+// MarginInlineStartValue is implemented by the value types accepted by the margin-inline-start property.
+type MarginInlineStartValue interface {
+	css.Value
+	MarginInlineStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginInlineStart creates a declaration for the margin-inline-start property.
-func SetMarginInlineStart(v MarginInlineStartVal) css.Decl {
-	return css.Set(MarginInlineStart, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'margin-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-inline-start
+func SetMarginInlineStart[T MarginInlineStartValue](v T) css.Decl {
+	return css.Set(MarginInlineStart, v)
+}
+
+// MarginLeftValue is implemented by the value types accepted by the margin-left property.
+type MarginLeftValue interface {
+	css.Value
+	MarginLeftVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginLeft creates a declaration for the margin-left property.
-func SetMarginLeft(v MarginLeftVal) css.Decl {
-	return css.Set(MarginLeft, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-left
+func SetMarginLeft[T MarginLeftValue](v T) css.Decl {
+	return css.Set(MarginLeft, v)
+}
+
+// MarginRightValue is implemented by the value types accepted by the margin-right property.
+type MarginRightValue interface {
+	css.Value
+	MarginRightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginRight creates a declaration for the margin-right property.
-func SetMarginRight(v MarginRightVal) css.Decl {
-	return css.Set(MarginRight, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-right
+func SetMarginRight[T MarginRightValue](v T) css.Decl {
+	return css.Set(MarginRight, v)
+}
+
+// MarginTopValue is implemented by the value types accepted by the margin-top property.
+type MarginTopValue interface {
+	css.Value
+	MarginTopVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMarginTop creates a declaration for the margin-top property.
-func SetMarginTop(v MarginTopVal) css.Decl {
-	return css.Set(MarginTop, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | auto | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/margin-top
+func SetMarginTop[T MarginTopValue](v T) css.Decl {
+	return css.Set(MarginTop, v)
+}
+
+// MarkerValue is implemented by the value types accepted by the marker property.
+type MarkerValue interface {
+	css.Value
+	MarkerVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMarker creates a declaration for the marker property.
-func SetMarker(v MarkerVal) css.Decl {
-	return css.Set(Marker, css.Keyword(string(v)))
+//
+//   - Shorthand for: marker-end, marker-mid, marker-start
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker
+func SetMarker[T MarkerValue](v T) css.Decl {
+	return css.Set(Marker, v)
+}
+
+// MarkerEndValue is implemented by the value types accepted by the marker-end property.
+type MarkerEndValue interface {
+	css.Value
+	MarkerEndVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMarkerEnd creates a declaration for the marker-end property.
-func SetMarkerEnd(v MarkerEndVal) css.Decl {
-	return css.Set(MarkerEnd, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-end
+func SetMarkerEnd[T MarkerEndValue](v T) css.Decl {
+	return css.Set(MarkerEnd, v)
+}
+
+// MarkerMidValue is implemented by the value types accepted by the marker-mid property.
+type MarkerMidValue interface {
+	css.Value
+	MarkerMidVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMarkerMid creates a declaration for the marker-mid property.
-func SetMarkerMid(v MarkerMidVal) css.Decl {
-	return css.Set(MarkerMid, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-mid
+func SetMarkerMid[T MarkerMidValue](v T) css.Decl {
+	return css.Set(MarkerMid, v)
+}
+
+// MarkerStartValue is implemented by the value types accepted by the marker-start property.
+type MarkerStartValue interface {
+	css.Value
+	MarkerStartVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMarkerStart creates a declaration for the marker-start property.
-func SetMarkerStart(v MarkerStartVal) css.Decl {
-	return css.Set(MarkerStart, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <url>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/marker-start
+func SetMarkerStart[T MarkerStartValue](v T) css.Decl {
+	return css.Set(MarkerStart, v)
+}
+
+// MaskValue is implemented by the value types accepted by the mask property.
+type MaskValue interface {
+	css.Value
+	MaskVal | css.Length | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMask creates a declaration for the mask property.
-func SetMask(v MaskVal) css.Decl {
-	return css.Set(Mask, css.Keyword(string(v)))
+//
+//   - Shorthand for: mask-clip, mask-composite, mask-image, mask-mode, mask-origin, mask-position, mask-repeat, mask-size
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<mask-layer>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask
+func SetMask[T MaskValue](v T) css.Decl {
+	return css.Set(Mask, v)
+}
+
+// MaskBorderValue is implemented by the value types accepted by the mask-border property.
+type MaskBorderValue interface {
+	css.Value
+	MaskBorderVal | css.Length | css.Number | css.Integer | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorder creates a declaration for the mask-border property.
-func SetMaskBorder(v MaskBorderVal) css.Decl {
-	return css.Set(MaskBorder, css.Keyword(string(v)))
+//
+//   - Shorthand for: mask-border-mode, mask-border-outset, mask-border-repeat, mask-border-slice, mask-border-source, mask-border-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border
+func SetMaskBorder[T MaskBorderValue](v T) css.Decl {
+	return css.Set(MaskBorder, v)
+}
+
+// MaskBorderModeValue is implemented by the value types accepted by the mask-border-mode property.
+type MaskBorderModeValue interface {
+	css.Value
+	MaskBorderModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderMode creates a declaration for the mask-border-mode property.
-func SetMaskBorderMode(v MaskBorderModeVal) css.Decl {
-	return css.Set(MaskBorderMode, css.Keyword(string(v)))
+//
+//   - Initial: alpha
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	luminance | alpha
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-mode
+func SetMaskBorderMode[T MaskBorderModeValue](v T) css.Decl {
+	return css.Set(MaskBorderMode, v)
+}
+
+// MaskBorderOutsetValue is implemented by the value types accepted by the mask-border-outset property.
+type MaskBorderOutsetValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderOutset creates a declaration for the mask-border-outset property.
-func SetMaskBorderOutset(v MaskBorderOutsetVal) css.Decl {
-	return css.Set(MaskBorderOutset, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length> | <number> ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-outset
+func SetMaskBorderOutset[T MaskBorderOutsetValue](v T) css.Decl {
+	return css.Set(MaskBorderOutset, v)
+}
+
+// MaskBorderRepeatValue is implemented by the value types accepted by the mask-border-repeat property.
+type MaskBorderRepeatValue interface {
+	css.Value
+	MaskBorderRepeatVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderRepeat creates a declaration for the mask-border-repeat property.
-func SetMaskBorderRepeat(v MaskBorderRepeatVal) css.Decl {
-	return css.Set(MaskBorderRepeat, css.Keyword(string(v)))
+//
+//   - Initial: stretch
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ stretch | repeat | round | space ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-repeat
+func SetMaskBorderRepeat[T MaskBorderRepeatValue](v T) css.Decl {
+	return css.Set(MaskBorderRepeat, v)
+}
+
+// MaskBorderSliceValue is implemented by the value types accepted by the mask-border-slice property.
+type MaskBorderSliceValue interface {
+	css.Value
+	MaskBorderSliceVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderSlice creates a declaration for the mask-border-slice property.
-func SetMaskBorderSlice(v MaskBorderSliceVal) css.Decl {
-	return css.Set(MaskBorderSlice, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<number-percentage>{1,4} fill?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-slice
+func SetMaskBorderSlice[T MaskBorderSliceValue](v T) css.Decl {
+	return css.Set(MaskBorderSlice, v)
+}
+
+// MaskBorderSourceValue is implemented by the value types accepted by the mask-border-source property.
+type MaskBorderSourceValue interface {
+	css.Value
+	MaskBorderSourceVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderSource creates a declaration for the mask-border-source property.
-func SetMaskBorderSource(v MaskBorderSourceVal) css.Decl {
-	return css.Set(MaskBorderSource, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-source
+func SetMaskBorderSource[T MaskBorderSourceValue](v T) css.Decl {
+	return css.Set(MaskBorderSource, v)
+}
+
+// MaskBorderWidthValue is implemented by the value types accepted by the mask-border-width property.
+type MaskBorderWidthValue interface {
+	css.Value
+	MaskBorderWidthVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetMaskBorderWidth creates a declaration for the mask-border-width property.
-func SetMaskBorderWidth(v MaskBorderWidthVal) css.Decl {
-	return css.Set(MaskBorderWidth, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage> | <number> | auto ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-border-width
+func SetMaskBorderWidth[T MaskBorderWidthValue](v T) css.Decl {
+	return css.Set(MaskBorderWidth, v)
+}
+
+// MaskClipValue is implemented by the value types accepted by the mask-clip property.
+type MaskClipValue interface {
+	css.Value
+	MaskClipVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskClip creates a declaration for the mask-clip property.
-func SetMaskClip(v MaskClipVal) css.Decl {
-	return css.Set(MaskClip, css.Keyword(string(v)))
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <coord-box> | no-clip ]#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-clip
+func SetMaskClip[T MaskClipValue](v T) css.Decl {
+	return css.Set(MaskClip, v)
+}
+
+// MaskCompositeValue is implemented by the value types accepted by the mask-composite property.
+type MaskCompositeValue interface {
+	css.Value
+	MaskCompositeVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskComposite creates a declaration for the mask-composite property.
-func SetMaskComposite(v MaskCompositeVal) css.Decl {
-	return css.Set(MaskComposite, css.Keyword(string(v)))
+//
+//   - Initial: add
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<compositing-operator>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-composite
+func SetMaskComposite[T MaskCompositeValue](v T) css.Decl {
+	return css.Set(MaskComposite, v)
+}
+
+// MaskImageValue is implemented by the value types accepted by the mask-image property.
+type MaskImageValue interface {
+	css.Value
+	MaskImageVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetMaskImage creates a declaration for the mask-image property.
-func SetMaskImage(v MaskImageVal) css.Decl {
-	return css.Set(MaskImage, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<mask-reference>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-image
+func SetMaskImage[T MaskImageValue](v T) css.Decl {
+	return css.Set(MaskImage, v)
+}
+
+// MaskModeValue is implemented by the value types accepted by the mask-mode property.
+type MaskModeValue interface {
+	css.Value
+	MaskModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskMode creates a declaration for the mask-mode property.
-func SetMaskMode(v MaskModeVal) css.Decl {
-	return css.Set(MaskMode, css.Keyword(string(v)))
+//
+//   - Initial: match-source
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<masking-mode>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-mode
+func SetMaskMode[T MaskModeValue](v T) css.Decl {
+	return css.Set(MaskMode, v)
+}
+
+// MaskOriginValue is implemented by the value types accepted by the mask-origin property.
+type MaskOriginValue interface {
+	css.Value
+	MaskOriginVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskOrigin creates a declaration for the mask-origin property.
-func SetMaskOrigin(v MaskOriginVal) css.Decl {
-	return css.Set(MaskOrigin, css.Keyword(string(v)))
+//
+//   - Initial: border-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<coord-box>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-origin
+func SetMaskOrigin[T MaskOriginValue](v T) css.Decl {
+	return css.Set(MaskOrigin, v)
+}
+
+// MaskPositionValue is implemented by the value types accepted by the mask-position property.
+type MaskPositionValue interface {
+	css.Value
+	MaskPositionVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaskPosition creates a declaration for the mask-position property.
-func SetMaskPosition(v MaskPositionVal) css.Decl {
-	return css.Set(MaskPosition, css.Keyword(string(v)))
+//
+//   - Initial: 0% 0%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-position
+func SetMaskPosition[T MaskPositionValue](v T) css.Decl {
+	return css.Set(MaskPosition, v)
+}
+
+// MaskRepeatValue is implemented by the value types accepted by the mask-repeat property.
+type MaskRepeatValue interface {
+	css.Value
+	MaskRepeatVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskRepeat creates a declaration for the mask-repeat property.
-func SetMaskRepeat(v MaskRepeatVal) css.Decl {
-	return css.Set(MaskRepeat, css.Keyword(string(v)))
+//
+//   - Initial: repeat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<repeat-style>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-repeat
+func SetMaskRepeat[T MaskRepeatValue](v T) css.Decl {
+	return css.Set(MaskRepeat, v)
+}
+
+// MaskSizeValue is implemented by the value types accepted by the mask-size property.
+type MaskSizeValue interface {
+	css.Value
+	MaskSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaskSize creates a declaration for the mask-size property.
-func SetMaskSize(v MaskSizeVal) css.Decl {
-	return css.Set(MaskSize, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<bg-size>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-size
+func SetMaskSize[T MaskSizeValue](v T) css.Decl {
+	return css.Set(MaskSize, v)
+}
+
+// MaskTypeValue is implemented by the value types accepted by the mask-type property.
+type MaskTypeValue interface {
+	css.Value
+	MaskTypeVal | css.Raw | css.Function | css.Global
 }
 
 // SetMaskType creates a declaration for the mask-type property.
-func SetMaskType(v MaskTypeVal) css.Decl {
-	return css.Set(MaskType, css.Keyword(string(v)))
+//
+//   - Initial: luminance
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	luminance | alpha
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mask-type
+func SetMaskType[T MaskTypeValue](v T) css.Decl {
+	return css.Set(MaskType, v)
+}
+
+// MasonryAutoFlowValue is implemented by the value types accepted by the masonry-auto-flow property.
+type MasonryAutoFlowValue interface {
+	css.Value
+	MasonryAutoFlowVal | css.Raw | css.Function | css.Global
 }
 
 // SetMasonryAutoFlow creates a declaration for the masonry-auto-flow property.
-func SetMasonryAutoFlow(v MasonryAutoFlowVal) css.Decl {
-	return css.Set(MasonryAutoFlow, css.Keyword(string(v)))
+//
+//   - Initial: pack
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	[ pack | next ] || [ definite-first | ordered ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/grid-auto-flow
+func SetMasonryAutoFlow[T MasonryAutoFlowValue](v T) css.Decl {
+	return css.Set(MasonryAutoFlow, v)
+}
+
+// MathDepthValue is implemented by the value types accepted by the math-depth property.
+type MathDepthValue interface {
+	css.Value
+	MathDepthVal | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetMathDepth creates a declaration for the math-depth property.
-func SetMathDepth(v MathDepthVal) css.Decl {
-	return css.Set(MathDepth, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto-add | add(<integer>) | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-depth
+func SetMathDepth[T MathDepthValue](v T) css.Decl {
+	return css.Set(MathDepth, v)
+}
+
+// MathStyleValue is implemented by the value types accepted by the math-style property.
+type MathStyleValue interface {
+	css.Value
+	MathStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetMathStyle creates a declaration for the math-style property.
-func SetMathStyle(v MathStyleVal) css.Decl {
-	return css.Set(MathStyle, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | compact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/math-style
+func SetMathStyle[T MathStyleValue](v T) css.Decl {
+	return css.Set(MathStyle, v)
+}
+
+// MaxBlockSizeValue is implemented by the value types accepted by the max-block-size property.
+type MaxBlockSizeValue interface {
+	css.Value
+	MaxBlockSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaxBlockSize creates a declaration for the max-block-size property.
-func SetMaxBlockSize(v MaxBlockSizeVal) css.Decl {
-	return css.Set(MaxBlockSize, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'max-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-block-size
+func SetMaxBlockSize[T MaxBlockSizeValue](v T) css.Decl {
+	return css.Set(MaxBlockSize, v)
+}
+
+// MaxHeightValue is implemented by the value types accepted by the max-height property.
+type MaxHeightValue interface {
+	css.Value
+	MaxHeightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaxHeight creates a declaration for the max-height property.
-func SetMaxHeight(v MaxHeightVal) css.Decl {
-	return css.Set(MaxHeight, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-height
+func SetMaxHeight[T MaxHeightValue](v T) css.Decl {
+	return css.Set(MaxHeight, v)
+}
+
+// MaxInlineSizeValue is implemented by the value types accepted by the max-inline-size property.
+type MaxInlineSizeValue interface {
+	css.Value
+	MaxInlineSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaxInlineSize creates a declaration for the max-inline-size property.
-func SetMaxInlineSize(v MaxInlineSizeVal) css.Decl {
-	return css.Set(MaxInlineSize, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'max-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-inline-size
+func SetMaxInlineSize[T MaxInlineSizeValue](v T) css.Decl {
+	return css.Set(MaxInlineSize, v)
+}
+
+// MaxWidthValue is implemented by the value types accepted by the max-width property.
+type MaxWidthValue interface {
+	css.Value
+	MaxWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMaxWidth creates a declaration for the max-width property.
-func SetMaxWidth(v MaxWidthVal) css.Decl {
-	return css.Set(MaxWidth, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/max-width
+func SetMaxWidth[T MaxWidthValue](v T) css.Decl {
+	return css.Set(MaxWidth, v)
+}
+
+// MinBlockSizeValue is implemented by the value types accepted by the min-block-size property.
+type MinBlockSizeValue interface {
+	css.Value
+	MinBlockSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMinBlockSize creates a declaration for the min-block-size property.
-func SetMinBlockSize(v MinBlockSizeVal) css.Decl {
-	return css.Set(MinBlockSize, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'min-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-block-size
+func SetMinBlockSize[T MinBlockSizeValue](v T) css.Decl {
+	return css.Set(MinBlockSize, v)
+}
+
+// MinHeightValue is implemented by the value types accepted by the min-height property.
+type MinHeightValue interface {
+	css.Value
+	MinHeightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMinHeight creates a declaration for the min-height property.
-func SetMinHeight(v MinHeightVal) css.Decl {
-	return css.Set(MinHeight, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-height
+func SetMinHeight[T MinHeightValue](v T) css.Decl {
+	return css.Set(MinHeight, v)
+}
+
+// MinInlineSizeValue is implemented by the value types accepted by the min-inline-size property.
+type MinInlineSizeValue interface {
+	css.Value
+	MinInlineSizeVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMinInlineSize creates a declaration for the min-inline-size property.
-func SetMinInlineSize(v MinInlineSizeVal) css.Decl {
-	return css.Set(MinInlineSize, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'min-width'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-inline-size
+func SetMinInlineSize[T MinInlineSizeValue](v T) css.Decl {
+	return css.Set(MinInlineSize, v)
+}
+
+// MinWidthValue is implemented by the value types accepted by the min-width property.
+type MinWidthValue interface {
+	css.Value
+	MinWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetMinWidth creates a declaration for the min-width property.
-func SetMinWidth(v MinWidthVal) css.Decl {
-	return css.Set(MinWidth, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/min-width
+func SetMinWidth[T MinWidthValue](v T) css.Decl {
+	return css.Set(MinWidth, v)
+}
+
+// MixBlendModeValue is implemented by the value types accepted by the mix-blend-mode property.
+type MixBlendModeValue interface {
+	css.Value
+	MixBlendModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetMixBlendMode creates a declaration for the mix-blend-mode property.
-func SetMixBlendMode(v MixBlendModeVal) css.Decl {
-	return css.Set(MixBlendMode, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<blend-mode> | plus-darker | plus-lighter
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/mix-blend-mode
+func SetMixBlendMode[T MixBlendModeValue](v T) css.Decl {
+	return css.Set(MixBlendMode, v)
+}
+
+// ObjectFitValue is implemented by the value types accepted by the object-fit property.
+type ObjectFitValue interface {
+	css.Value
+	ObjectFitVal | css.Raw | css.Function | css.Global
 }
 
 // SetObjectFit creates a declaration for the object-fit property.
-func SetObjectFit(v ObjectFitVal) css.Decl {
-	return css.Set(ObjectFit, css.Keyword(string(v)))
+//
+//   - Initial: fill
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	fill | contain | cover | none | scale-down
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/object-fit
+func SetObjectFit[T ObjectFitValue](v T) css.Decl {
+	return css.Set(ObjectFit, v)
+}
+
+// ObjectPositionValue is implemented by the value types accepted by the object-position property.
+type ObjectPositionValue interface {
+	css.Value
+	ObjectPositionVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetObjectPosition creates a declaration for the object-position property.
-func SetObjectPosition(v ObjectPositionVal) css.Decl {
-	return css.Set(ObjectPosition, css.Keyword(string(v)))
+//
+//   - Initial: 50% 50%
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/object-position
+func SetObjectPosition[T ObjectPositionValue](v T) css.Decl {
+	return css.Set(ObjectPosition, v)
+}
+
+// OffsetValue is implemented by the value types accepted by the offset property.
+type OffsetValue interface {
+	css.Value
+	OffsetVal | css.Length | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetOffset creates a declaration for the offset property.
-func SetOffset(v OffsetVal) css.Decl {
-	return css.Set(Offset, css.Keyword(string(v)))
+//
+//   - Shorthand for: offset-anchor, offset-distance, offset-path, offset-position, offset-rotate
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset
+func SetOffset[T OffsetValue](v T) css.Decl {
+	return css.Set(Offset, v)
+}
+
+// OffsetAnchorValue is implemented by the value types accepted by the offset-anchor property.
+type OffsetAnchorValue interface {
+	css.Value
+	OffsetAnchorVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetOffsetAnchor creates a declaration for the offset-anchor property.
-func SetOffsetAnchor(v OffsetAnchorVal) css.Decl {
-	return css.Set(OffsetAnchor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-anchor
+func SetOffsetAnchor[T OffsetAnchorValue](v T) css.Decl {
+	return css.Set(OffsetAnchor, v)
+}
+
+// OffsetDistanceValue is implemented by the value types accepted by the offset-distance property.
+type OffsetDistanceValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetOffsetDistance creates a declaration for the offset-distance property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-distance
+func SetOffsetDistance[T OffsetDistanceValue](v T) css.Decl {
+	return css.Set(OffsetDistance, v)
+}
+
+// OffsetPathValue is implemented by the value types accepted by the offset-path property.
+type OffsetPathValue interface {
+	css.Value
+	OffsetPathVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetOffsetPath creates a declaration for the offset-path property.
-func SetOffsetPath(v OffsetPathVal) css.Decl {
-	return css.Set(OffsetPath, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <offset-path> || <coord-box>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-path
+func SetOffsetPath[T OffsetPathValue](v T) css.Decl {
+	return css.Set(OffsetPath, v)
+}
+
+// OffsetPositionValue is implemented by the value types accepted by the offset-position property.
+type OffsetPositionValue interface {
+	css.Value
+	OffsetPositionVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetOffsetPosition creates a declaration for the offset-position property.
-func SetOffsetPosition(v OffsetPositionVal) css.Decl {
-	return css.Set(OffsetPosition, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | auto | <position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-position
+func SetOffsetPosition[T OffsetPositionValue](v T) css.Decl {
+	return css.Set(OffsetPosition, v)
+}
+
+// OffsetRotateValue is implemented by the value types accepted by the offset-rotate property.
+type OffsetRotateValue interface {
+	css.Value
+	OffsetRotateVal | css.Angle | css.Raw | css.Function | css.Global
 }
 
 // SetOffsetRotate creates a declaration for the offset-rotate property.
-func SetOffsetRotate(v OffsetRotateVal) css.Decl {
-	return css.Set(OffsetRotate, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | reverse ] || <angle>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/offset-rotate
+func SetOffsetRotate[T OffsetRotateValue](v T) css.Decl {
+	return css.Set(OffsetRotate, v)
+}
+
+// OpacityValue is implemented by the value types accepted by the opacity property.
+type OpacityValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetOpacity creates a declaration for the opacity property.
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<opacity-value>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/opacity
+func SetOpacity[T OpacityValue](v T) css.Decl {
+	return css.Set(Opacity, v)
+}
+
+// OrderValue is implemented by the value types accepted by the order property.
+type OrderValue interface {
+	css.Value
+	css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetOrder creates a declaration for the order property.
-func SetOrder(v OrderVal) css.Decl {
-	return css.Set(Order, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/order
+func SetOrder[T OrderValue](v T) css.Decl {
+	return css.Set(Order, v)
+}
+
+// OrphansValue is implemented by the value types accepted by the orphans property.
+type OrphansValue interface {
+	css.Value
+	css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetOrphans creates a declaration for the orphans property.
-func SetOrphans(v OrphansVal) css.Decl {
-	return css.Set(Orphans, css.Keyword(string(v)))
+//
+//   - Initial: 2
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/orphans
+func SetOrphans[T OrphansValue](v T) css.Decl {
+	return css.Set(Orphans, v)
+}
+
+// OutlineValue is implemented by the value types accepted by the outline property.
+type OutlineValue interface {
+	css.Value
+	OutlineVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetOutline creates a declaration for the outline property.
-func SetOutline(v OutlineVal) css.Decl {
-	return css.Set(Outline, css.Keyword(string(v)))
+//
+//   - Shorthand for: outline-color, outline-style, outline-width
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'outline-width'> || <'outline-style'> || <'outline-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline
+func SetOutline[T OutlineValue](v T) css.Decl {
+	return css.Set(Outline, v)
+}
+
+// OutlineColorValue is implemented by the value types accepted by the outline-color property.
+type OutlineColorValue interface {
+	css.Value
+	OutlineColorVal | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetOutlineColor creates a declaration for the outline-color property.
-func SetOutlineColor(v OutlineColorVal) css.Decl {
-	return css.Set(OutlineColor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-color
+func SetOutlineColor[T OutlineColorValue](v T) css.Decl {
+	return css.Set(OutlineColor, v)
+}
+
+// OutlineOffsetValue is implemented by the value types accepted by the outline-offset property.
+type OutlineOffsetValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetOutlineOffset creates a declaration for the outline-offset property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-offset
+func SetOutlineOffset[T OutlineOffsetValue](v T) css.Decl {
+	return css.Set(OutlineOffset, v)
+}
+
+// OutlineStyleValue is implemented by the value types accepted by the outline-style property.
+type OutlineStyleValue interface {
+	css.Value
+	OutlineStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetOutlineStyle creates a declaration for the outline-style property.
-func SetOutlineStyle(v OutlineStyleVal) css.Decl {
-	return css.Set(OutlineStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <outline-line-style>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-style
+func SetOutlineStyle[T OutlineStyleValue](v T) css.Decl {
+	return css.Set(OutlineStyle, v)
+}
+
+// OutlineWidthValue is implemented by the value types accepted by the outline-width property.
+type OutlineWidthValue interface {
+	css.Value
+	OutlineWidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetOutlineWidth creates a declaration for the outline-width property.
-func SetOutlineWidth(v OutlineWidthVal) css.Decl {
-	return css.Set(OutlineWidth, css.Keyword(string(v)))
+//
+//   - Initial: medium
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<line-width>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/outline-width
+func SetOutlineWidth[T OutlineWidthValue](v T) css.Decl {
+	return css.Set(OutlineWidth, v)
+}
+
+// OverflowValue is implemented by the value types accepted by the overflow property.
+type OverflowValue interface {
+	css.Value
+	OverflowVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflow creates a declaration for the overflow property.
-func SetOverflow(v OverflowVal) css.Decl {
-	return css.Set(Overflow, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ visible | hidden | clip | scroll | auto ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow
+func SetOverflow[T OverflowValue](v T) css.Decl {
+	return css.Set(Overflow, v)
+}
+
+// OverflowAnchorValue is implemented by the value types accepted by the overflow-anchor property.
+type OverflowAnchorValue interface {
+	css.Value
+	OverflowAnchorVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowAnchor creates a declaration for the overflow-anchor property.
-func SetOverflowAnchor(v OverflowAnchorVal) css.Decl {
-	return css.Set(OverflowAnchor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-anchor
+func SetOverflowAnchor[T OverflowAnchorValue](v T) css.Decl {
+	return css.Set(OverflowAnchor, v)
+}
+
+// OverflowBlockValue is implemented by the value types accepted by the overflow-block property.
+type OverflowBlockValue interface {
+	css.Value
+	OverflowBlockVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowBlock creates a declaration for the overflow-block property.
-func SetOverflowBlock(v OverflowBlockVal) css.Decl {
-	return css.Set(OverflowBlock, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-block
+func SetOverflowBlock[T OverflowBlockValue](v T) css.Decl {
+	return css.Set(OverflowBlock, v)
+}
+
+// OverflowClipBoxValue is implemented by the value types accepted by the overflow-clip-box property.
+type OverflowClipBoxValue interface {
+	css.Value
+	OverflowClipBoxVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowClipBox creates a declaration for the overflow-clip-box property.
-func SetOverflowClipBox(v OverflowClipBoxVal) css.Decl {
-	return css.Set(OverflowClipBox, css.Keyword(string(v)))
+//
+//   - Initial: padding-box
+//   - Inherited: no
+//   - Status: nonstandard
+//
+// Syntax:
+//
+//	padding-box | content-box
+func SetOverflowClipBox[T OverflowClipBoxValue](v T) css.Decl {
+	return css.Set(OverflowClipBox, v)
+}
+
+// OverflowClipMarginValue is implemented by the value types accepted by the overflow-clip-margin property.
+type OverflowClipMarginValue interface {
+	css.Value
+	OverflowClipMarginVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowClipMargin creates a declaration for the overflow-clip-margin property.
-func SetOverflowClipMargin(v OverflowClipMarginVal) css.Decl {
-	return css.Set(OverflowClipMargin, css.Keyword(string(v)))
+//
+//   - Initial: 0px
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<visual-box> || <length [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-clip-margin
+func SetOverflowClipMargin[T OverflowClipMarginValue](v T) css.Decl {
+	return css.Set(OverflowClipMargin, v)
+}
+
+// OverflowInlineValue is implemented by the value types accepted by the overflow-inline property.
+type OverflowInlineValue interface {
+	css.Value
+	OverflowInlineVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowInline creates a declaration for the overflow-inline property.
-func SetOverflowInline(v OverflowInlineVal) css.Decl {
-	return css.Set(OverflowInline, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-inline
+func SetOverflowInline[T OverflowInlineValue](v T) css.Decl {
+	return css.Set(OverflowInline, v)
+}
+
+// OverflowWrapValue is implemented by the value types accepted by the overflow-wrap property.
+type OverflowWrapValue interface {
+	css.Value
+	OverflowWrapVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowWrap creates a declaration for the overflow-wrap property.
-func SetOverflowWrap(v OverflowWrapVal) css.Decl {
-	return css.Set(OverflowWrap, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-word | anywhere
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
+func SetOverflowWrap[T OverflowWrapValue](v T) css.Decl {
+	return css.Set(OverflowWrap, v)
+}
+
+// OverflowXValue is implemented by the value types accepted by the overflow-x property.
+type OverflowXValue interface {
+	css.Value
+	OverflowXVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowX creates a declaration for the overflow-x property.
-func SetOverflowX(v OverflowXVal) css.Decl {
-	return css.Set(OverflowX, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-x
+func SetOverflowX[T OverflowXValue](v T) css.Decl {
+	return css.Set(OverflowX, v)
+}
+
+// OverflowYValue is implemented by the value types accepted by the overflow-y property.
+type OverflowYValue interface {
+	css.Value
+	OverflowYVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverflowY creates a declaration for the overflow-y property.
-func SetOverflowY(v OverflowYVal) css.Decl {
-	return css.Set(OverflowY, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | clip | scroll | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-y
+func SetOverflowY[T OverflowYValue](v T) css.Decl {
+	return css.Set(OverflowY, v)
+}
+
+// OverscrollBehaviorValue is implemented by the value types accepted by the overscroll-behavior property.
+type OverscrollBehaviorValue interface {
+	css.Value
+	OverscrollBehaviorVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverscrollBehavior creates a declaration for the overscroll-behavior property.
-func SetOverscrollBehavior(v OverscrollBehaviorVal) css.Decl {
-	return css.Set(OverscrollBehavior, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ contain | none | auto ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior
+func SetOverscrollBehavior[T OverscrollBehaviorValue](v T) css.Decl {
+	return css.Set(OverscrollBehavior, v)
+}
+
+// OverscrollBehaviorBlockValue is implemented by the value types accepted by the overscroll-behavior-block property.
+type OverscrollBehaviorBlockValue interface {
+	css.Value
+	OverscrollBehaviorBlockVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverscrollBehaviorBlock creates a declaration for the overscroll-behavior-block property.
-func SetOverscrollBehaviorBlock(v OverscrollBehaviorBlockVal) css.Decl {
-	return css.Set(OverscrollBehaviorBlock, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-block
+func SetOverscrollBehaviorBlock[T OverscrollBehaviorBlockValue](v T) css.Decl {
+	return css.Set(OverscrollBehaviorBlock, v)
+}
+
+// OverscrollBehaviorInlineValue is implemented by the value types accepted by the overscroll-behavior-inline property.
+type OverscrollBehaviorInlineValue interface {
+	css.Value
+	OverscrollBehaviorInlineVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverscrollBehaviorInline creates a declaration for the overscroll-behavior-inline property.
-func SetOverscrollBehaviorInline(v OverscrollBehaviorInlineVal) css.Decl {
-	return css.Set(OverscrollBehaviorInline, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-inline
+func SetOverscrollBehaviorInline[T OverscrollBehaviorInlineValue](v T) css.Decl {
+	return css.Set(OverscrollBehaviorInline, v)
+}
+
+// OverscrollBehaviorXValue is implemented by the value types accepted by the overscroll-behavior-x property.
+type OverscrollBehaviorXValue interface {
+	css.Value
+	OverscrollBehaviorXVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverscrollBehaviorX creates a declaration for the overscroll-behavior-x property.
-func SetOverscrollBehaviorX(v OverscrollBehaviorXVal) css.Decl {
-	return css.Set(OverscrollBehaviorX, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-x
+func SetOverscrollBehaviorX[T OverscrollBehaviorXValue](v T) css.Decl {
+	return css.Set(OverscrollBehaviorX, v)
+}
+
+// OverscrollBehaviorYValue is implemented by the value types accepted by the overscroll-behavior-y property.
+type OverscrollBehaviorYValue interface {
+	css.Value
+	OverscrollBehaviorYVal | css.Raw | css.Function | css.Global
 }
 
 // SetOverscrollBehaviorY creates a declaration for the overscroll-behavior-y property.
-func SetOverscrollBehaviorY(v OverscrollBehaviorYVal) css.Decl {
-	return css.Set(OverscrollBehaviorY, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	contain | none | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overscroll-behavior-y
+func SetOverscrollBehaviorY[T OverscrollBehaviorYValue](v T) css.Decl {
+	return css.Set(OverscrollBehaviorY, v)
+}
+
+// PaddingValue is implemented by the value types accepted by the padding property.
+type PaddingValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPadding creates a declaration for the padding property.
-func SetPadding(v PaddingVal) css.Decl {
-	return css.Set(Padding, css.Keyword(string(v)))
+//
+//   - Shorthand for: padding-bottom, padding-left, padding-right, padding-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding
+func SetPadding[T PaddingValue](v T) css.Decl {
+	return css.Set(Padding, v)
+}
+
+// PaddingBlockValue is implemented by the value types accepted by the padding-block property.
+type PaddingBlockValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingBlock creates a declaration for the padding-block property.
-func SetPaddingBlock(v PaddingBlockVal) css.Decl {
-	return css.Set(PaddingBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: padding-block-end, padding-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block
+func SetPaddingBlock[T PaddingBlockValue](v T) css.Decl {
+	return css.Set(PaddingBlock, v)
+}
+
+// PaddingBlockEndValue is implemented by the value types accepted by the padding-block-end property.
+type PaddingBlockEndValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingBlockEnd creates a declaration for the padding-block-end property.
-func SetPaddingBlockEnd(v PaddingBlockEndVal) css.Decl {
-	return css.Set(PaddingBlockEnd, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block-end
+func SetPaddingBlockEnd[T PaddingBlockEndValue](v T) css.Decl {
+	return css.Set(PaddingBlockEnd, v)
+}
+
+// PaddingBlockStartValue is implemented by the value types accepted by the padding-block-start property.
+type PaddingBlockStartValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingBlockStart creates a declaration for the padding-block-start property.
-func SetPaddingBlockStart(v PaddingBlockStartVal) css.Decl {
-	return css.Set(PaddingBlockStart, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-block-start
+func SetPaddingBlockStart[T PaddingBlockStartValue](v T) css.Decl {
+	return css.Set(PaddingBlockStart, v)
+}
+
+// PaddingBottomValue is implemented by the value types accepted by the padding-bottom property.
+type PaddingBottomValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingBottom creates a declaration for the padding-bottom property.
-func SetPaddingBottom(v PaddingBottomVal) css.Decl {
-	return css.Set(PaddingBottom, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-bottom
+func SetPaddingBottom[T PaddingBottomValue](v T) css.Decl {
+	return css.Set(PaddingBottom, v)
+}
+
+// PaddingInlineValue is implemented by the value types accepted by the padding-inline property.
+type PaddingInlineValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingInline creates a declaration for the padding-inline property.
-func SetPaddingInline(v PaddingInlineVal) css.Decl {
-	return css.Set(PaddingInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: padding-inline-end, padding-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline
+func SetPaddingInline[T PaddingInlineValue](v T) css.Decl {
+	return css.Set(PaddingInline, v)
+}
+
+// PaddingInlineEndValue is implemented by the value types accepted by the padding-inline-end property.
+type PaddingInlineEndValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingInlineEnd creates a declaration for the padding-inline-end property.
-func SetPaddingInlineEnd(v PaddingInlineEndVal) css.Decl {
-	return css.Set(PaddingInlineEnd, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline-end
+func SetPaddingInlineEnd[T PaddingInlineEndValue](v T) css.Decl {
+	return css.Set(PaddingInlineEnd, v)
+}
+
+// PaddingInlineStartValue is implemented by the value types accepted by the padding-inline-start property.
+type PaddingInlineStartValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingInlineStart creates a declaration for the padding-inline-start property.
-func SetPaddingInlineStart(v PaddingInlineStartVal) css.Decl {
-	return css.Set(PaddingInlineStart, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'padding-top'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-inline-start
+func SetPaddingInlineStart[T PaddingInlineStartValue](v T) css.Decl {
+	return css.Set(PaddingInlineStart, v)
+}
+
+// PaddingLeftValue is implemented by the value types accepted by the padding-left property.
+type PaddingLeftValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingLeft creates a declaration for the padding-left property.
-func SetPaddingLeft(v PaddingLeftVal) css.Decl {
-	return css.Set(PaddingLeft, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-left
+func SetPaddingLeft[T PaddingLeftValue](v T) css.Decl {
+	return css.Set(PaddingLeft, v)
+}
+
+// PaddingRightValue is implemented by the value types accepted by the padding-right property.
+type PaddingRightValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingRight creates a declaration for the padding-right property.
-func SetPaddingRight(v PaddingRightVal) css.Decl {
-	return css.Set(PaddingRight, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-right
+func SetPaddingRight[T PaddingRightValue](v T) css.Decl {
+	return css.Set(PaddingRight, v)
+}
+
+// PaddingTopValue is implemented by the value types accepted by the padding-top property.
+type PaddingTopValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPaddingTop creates a declaration for the padding-top property.
-func SetPaddingTop(v PaddingTopVal) css.Decl {
-	return css.Set(PaddingTop, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/padding-top
+func SetPaddingTop[T PaddingTopValue](v T) css.Decl {
+	return css.Set(PaddingTop, v)
+}
+
+// PageValue is implemented by the value types accepted by the page property.
+type PageValue interface {
+	css.Value
+	PageVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetPage creates a declaration for the page property.
-func SetPage(v PageVal) css.Decl {
-	return css.Set(Page, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <custom-ident>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page
+func SetPage[T PageValue](v T) css.Decl {
+	return css.Set(Page, v)
+}
+
+// PageBreakAfterValue is implemented by the value types accepted by the page-break-after property.
+type PageBreakAfterValue interface {
+	css.Value
+	PageBreakAfterVal | css.Raw | css.Function | css.Global
 }
 
 // SetPageBreakAfter creates a declaration for the page-break-after property.
-func SetPageBreakAfter(v PageBreakAfterVal) css.Decl {
-	return css.Set(PageBreakAfter, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | always | avoid | left | right | recto | verso
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-after
+//
+// Deprecated: the page-break-after property is obsolete.
+func SetPageBreakAfter[T PageBreakAfterValue](v T) css.Decl {
+	return css.Set(PageBreakAfter, v)
+}
+
+// PageBreakBeforeValue is implemented by the value types accepted by the page-break-before property.
+type PageBreakBeforeValue interface {
+	css.Value
+	PageBreakBeforeVal | css.Raw | css.Function | css.Global
 }
 
 // SetPageBreakBefore creates a declaration for the page-break-before property.
-func SetPageBreakBefore(v PageBreakBeforeVal) css.Decl {
-	return css.Set(PageBreakBefore, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | always | avoid | left | right | recto | verso
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-before
+//
+// Deprecated: the page-break-before property is obsolete.
+func SetPageBreakBefore[T PageBreakBeforeValue](v T) css.Decl {
+	return css.Set(PageBreakBefore, v)
+}
+
+// PageBreakInsideValue is implemented by the value types accepted by the page-break-inside property.
+type PageBreakInsideValue interface {
+	css.Value
+	PageBreakInsideVal | css.Raw | css.Function | css.Global
 }
 
 // SetPageBreakInside creates a declaration for the page-break-inside property.
-func SetPageBreakInside(v PageBreakInsideVal) css.Decl {
-	return css.Set(PageBreakInside, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	auto | avoid
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/page-break-inside
+//
+// Deprecated: the page-break-inside property is obsolete.
+func SetPageBreakInside[T PageBreakInsideValue](v T) css.Decl {
+	return css.Set(PageBreakInside, v)
+}
+
+// PaintOrderValue is implemented by the value types accepted by the paint-order property.
+type PaintOrderValue interface {
+	css.Value
+	PaintOrderVal | css.Raw | css.Function | css.Global
 }
 
 // SetPaintOrder creates a declaration for the paint-order property.
-func SetPaintOrder(v PaintOrderVal) css.Decl {
-	return css.Set(PaintOrder, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | [ fill || stroke || markers ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/paint-order
+func SetPaintOrder[T PaintOrderValue](v T) css.Decl {
+	return css.Set(PaintOrder, v)
+}
+
+// PerspectiveValue is implemented by the value types accepted by the perspective property.
+type PerspectiveValue interface {
+	css.Value
+	PerspectiveVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPerspective creates a declaration for the perspective property.
-func SetPerspective(v PerspectiveVal) css.Decl {
-	return css.Set(Perspective, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective
+func SetPerspective[T PerspectiveValue](v T) css.Decl {
+	return css.Set(Perspective, v)
+}
+
+// PerspectiveOriginValue is implemented by the value types accepted by the perspective-origin property.
+type PerspectiveOriginValue interface {
+	css.Value
+	PerspectiveOriginVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetPerspectiveOrigin creates a declaration for the perspective-origin property.
-func SetPerspectiveOrigin(v PerspectiveOriginVal) css.Decl {
-	return css.Set(PerspectiveOrigin, css.Keyword(string(v)))
+//
+//   - Initial: 50% 50%
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<position>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/perspective-origin
+func SetPerspectiveOrigin[T PerspectiveOriginValue](v T) css.Decl {
+	return css.Set(PerspectiveOrigin, v)
+}
+
+// PlaceContentValue is implemented by the value types accepted by the place-content property.
+type PlaceContentValue interface {
+	css.Value
+	PlaceContentVal | css.Raw | css.Function | css.Global
 }
 
 // SetPlaceContent creates a declaration for the place-content property.
-func SetPlaceContent(v PlaceContentVal) css.Decl {
-	return css.Set(PlaceContent, css.Keyword(string(v)))
+//
+//   - Shorthand for: align-content, justify-content
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-content'> <'justify-content'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-content
+func SetPlaceContent[T PlaceContentValue](v T) css.Decl {
+	return css.Set(PlaceContent, v)
+}
+
+// PlaceItemsValue is implemented by the value types accepted by the place-items property.
+type PlaceItemsValue interface {
+	css.Value
+	PlaceItemsVal | css.Raw | css.Function | css.Global
 }
 
 // SetPlaceItems creates a declaration for the place-items property.
-func SetPlaceItems(v PlaceItemsVal) css.Decl {
-	return css.Set(PlaceItems, css.Keyword(string(v)))
+//
+//   - Shorthand for: align-items, justify-items
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-items'> <'justify-items'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-items
+func SetPlaceItems[T PlaceItemsValue](v T) css.Decl {
+	return css.Set(PlaceItems, v)
+}
+
+// PlaceSelfValue is implemented by the value types accepted by the place-self property.
+type PlaceSelfValue interface {
+	css.Value
+	PlaceSelfVal | css.Raw | css.Function | css.Global
 }
 
 // SetPlaceSelf creates a declaration for the place-self property.
-func SetPlaceSelf(v PlaceSelfVal) css.Decl {
-	return css.Set(PlaceSelf, css.Keyword(string(v)))
+//
+//   - Shorthand for: align-self, justify-self
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'align-self'> <'justify-self'>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/place-self
+func SetPlaceSelf[T PlaceSelfValue](v T) css.Decl {
+	return css.Set(PlaceSelf, v)
+}
+
+// PointerEventsValue is implemented by the value types accepted by the pointer-events property.
+type PointerEventsValue interface {
+	css.Value
+	PointerEventsVal | css.Raw | css.Function | css.Global
 }
 
 // SetPointerEvents creates a declaration for the pointer-events property.
-func SetPointerEvents(v PointerEventsVal) css.Decl {
-	return css.Set(PointerEvents, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/pointer-events
+func SetPointerEvents[T PointerEventsValue](v T) css.Decl {
+	return css.Set(PointerEvents, v)
+}
+
+// PositionValue is implemented by the value types accepted by the position property.
+type PositionValue interface {
+	css.Value
+	PositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetPosition creates a declaration for the position property.
-func SetPosition(v PositionVal) css.Decl {
-	return css.Set(Position, css.Keyword(string(v)))
+//
+//   - Initial: static
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	static | relative | absolute | sticky | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/position
+func SetPosition[T PositionValue](v T) css.Decl {
+	return css.Set(Position, v)
+}
+
+// PrintColorAdjustValue is implemented by the value types accepted by the print-color-adjust property.
+type PrintColorAdjustValue interface {
+	css.Value
+	PrintColorAdjustVal | css.Raw | css.Function | css.Global
 }
 
 // SetPrintColorAdjust creates a declaration for the print-color-adjust property.
-func SetPrintColorAdjust(v PrintColorAdjustVal) css.Decl {
-	return css.Set(PrintColorAdjust, css.Keyword(string(v)))
+//
+//   - Initial: economy
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	economy | exact
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/print-color-adjust
+func SetPrintColorAdjust[T PrintColorAdjustValue](v T) css.Decl {
+	return css.Set(PrintColorAdjust, v)
+}
+
+// QuotesValue is implemented by the value types accepted by the quotes property.
+type QuotesValue interface {
+	css.Value
+	QuotesVal | css.Raw | css.Function | css.Global
 }
 
 // SetQuotes creates a declaration for the quotes property.
-func SetQuotes(v QuotesVal) css.Decl {
-	return css.Set(Quotes, css.Keyword(string(v)))
+//
+//   - Initial: dependsOnUserAgent
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | auto | [ <string> <string> ]+
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/quotes
+func SetQuotes[T QuotesValue](v T) css.Decl {
+	return css.Set(Quotes, v)
+}
+
+// RValue is implemented by the value types accepted by the r property.
+type RValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetR creates a declaration for the r property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/r
+func SetR[T RValue](v T) css.Decl {
+	return css.Set(R, v)
+}
+
+// ResizeValue is implemented by the value types accepted by the resize property.
+type ResizeValue interface {
+	css.Value
+	ResizeVal | css.Raw | css.Function | css.Global
 }
 
 // SetResize creates a declaration for the resize property.
-func SetResize(v ResizeVal) css.Decl {
-	return css.Set(Resize, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | both | horizontal | vertical | block | inline
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/resize
+func SetResize[T ResizeValue](v T) css.Decl {
+	return css.Set(Resize, v)
+}
+
+// RightValue is implemented by the value types accepted by the right property.
+type RightValue interface {
+	css.Value
+	RightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetRight creates a declaration for the right property.
-func SetRight(v RightVal) css.Decl {
-	return css.Set(Right, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/right
+func SetRight[T RightValue](v T) css.Decl {
+	return css.Set(Right, v)
+}
+
+// RotateValue is implemented by the value types accepted by the rotate property.
+type RotateValue interface {
+	css.Value
+	RotateVal | css.Angle | css.Raw | css.Function | css.Global
 }
 
 // SetRotate creates a declaration for the rotate property.
-func SetRotate(v RotateVal) css.Decl {
-	return css.Set(Rotate, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <angle> | [ x | y | z | <number>{3} ] && <angle>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/rotate
+func SetRotate[T RotateValue](v T) css.Decl {
+	return css.Set(Rotate, v)
+}
+
+// RowGapValue is implemented by the value types accepted by the row-gap property.
+type RowGapValue interface {
+	css.Value
+	RowGapVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetRowGap creates a declaration for the row-gap property.
-func SetRowGap(v RowGapVal) css.Decl {
-	return css.Set(RowGap, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/row-gap
+func SetRowGap[T RowGapValue](v T) css.Decl {
+	return css.Set(RowGap, v)
+}
+
+// RubyAlignValue is implemented by the value types accepted by the ruby-align property.
+type RubyAlignValue interface {
+	css.Value
+	RubyAlignVal | css.Raw | css.Function | css.Global
 }
 
 // SetRubyAlign creates a declaration for the ruby-align property.
-func SetRubyAlign(v RubyAlignVal) css.Decl {
-	return css.Set(RubyAlign, css.Keyword(string(v)))
+//
+//   - Initial: space-around
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | center | space-between | space-around
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-align
+func SetRubyAlign[T RubyAlignValue](v T) css.Decl {
+	return css.Set(RubyAlign, v)
+}
+
+// RubyOverhangValue is implemented by the value types accepted by the ruby-overhang property.
+type RubyOverhangValue interface {
+	css.Value
+	RubyOverhangVal | css.Raw | css.Function | css.Global
 }
 
 // SetRubyOverhang creates a declaration for the ruby-overhang property.
-func SetRubyOverhang(v RubyOverhangVal) css.Decl {
-	return css.Set(RubyOverhang, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none
+func SetRubyOverhang[T RubyOverhangValue](v T) css.Decl {
+	return css.Set(RubyOverhang, v)
+}
+
+// RubyPositionValue is implemented by the value types accepted by the ruby-position property.
+type RubyPositionValue interface {
+	css.Value
+	RubyPositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetRubyPosition creates a declaration for the ruby-position property.
-func SetRubyPosition(v RubyPositionVal) css.Decl {
-	return css.Set(RubyPosition, css.Keyword(string(v)))
+//
+//   - Initial: alternate
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ alternate || [ over | under ] ] | inter-character
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/ruby-position
+func SetRubyPosition[T RubyPositionValue](v T) css.Decl {
+	return css.Set(RubyPosition, v)
+}
+
+// RxValue is implemented by the value types accepted by the rx property.
+type RxValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetRx creates a declaration for the rx property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/rx
+func SetRx[T RxValue](v T) css.Decl {
+	return css.Set(Rx, v)
+}
+
+// RyValue is implemented by the value types accepted by the ry property.
+type RyValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetRy creates a declaration for the ry property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/ry
+func SetRy[T RyValue](v T) css.Decl {
+	return css.Set(Ry, v)
+}
+
+// ScaleValue is implemented by the value types accepted by the scale property.
+type ScaleValue interface {
+	css.Value
+	ScaleVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetScale creates a declaration for the scale property.
-func SetScale(v ScaleVal) css.Decl {
-	return css.Set(Scale, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <number> | <percentage> ]{1,3}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scale
+func SetScale[T ScaleValue](v T) css.Decl {
+	return css.Set(Scale, v)
+}
+
+// ScrollBehaviorValue is implemented by the value types accepted by the scroll-behavior property.
+type ScrollBehaviorValue interface {
+	css.Value
+	ScrollBehaviorVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollBehavior creates a declaration for the scroll-behavior property.
-func SetScrollBehavior(v ScrollBehaviorVal) css.Decl {
-	return css.Set(ScrollBehavior, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | smooth
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-behavior
+func SetScrollBehavior[T ScrollBehaviorValue](v T) css.Decl {
+	return css.Set(ScrollBehavior, v)
+}
+
+// ScrollMarginValue is implemented by the value types accepted by the scroll-margin property.
+type ScrollMarginValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollMargin creates a declaration for the scroll-margin property.
-func SetScrollMargin(v ScrollMarginVal) css.Decl {
-	return css.Set(ScrollMargin, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-margin-bottom, scroll-margin-left, scroll-margin-right, scroll-margin-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin
+func SetScrollMargin[T ScrollMarginValue](v T) css.Decl {
+	return css.Set(ScrollMargin, v)
+}
+
+// ScrollMarginBlockValue is implemented by the value types accepted by the scroll-margin-block property.
+type ScrollMarginBlockValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollMarginBlock creates a declaration for the scroll-margin-block property.
-func SetScrollMarginBlock(v ScrollMarginBlockVal) css.Decl {
-	return css.Set(ScrollMarginBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-margin-block-end, scroll-margin-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block
+func SetScrollMarginBlock[T ScrollMarginBlockValue](v T) css.Decl {
+	return css.Set(ScrollMarginBlock, v)
+}
+
+// ScrollMarginBlockEndValue is implemented by the value types accepted by the scroll-margin-block-end property.
+type ScrollMarginBlockEndValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginBlockEnd creates a declaration for the scroll-margin-block-end property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-end
+func SetScrollMarginBlockEnd[T ScrollMarginBlockEndValue](v T) css.Decl {
+	return css.Set(ScrollMarginBlockEnd, v)
+}
+
+// ScrollMarginBlockStartValue is implemented by the value types accepted by the scroll-margin-block-start property.
+type ScrollMarginBlockStartValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginBlockStart creates a declaration for the scroll-margin-block-start property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-block-start
+func SetScrollMarginBlockStart[T ScrollMarginBlockStartValue](v T) css.Decl {
+	return css.Set(ScrollMarginBlockStart, v)
+}
+
+// ScrollMarginBottomValue is implemented by the value types accepted by the scroll-margin-bottom property.
+type ScrollMarginBottomValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginBottom creates a declaration for the scroll-margin-bottom property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-bottom
+func SetScrollMarginBottom[T ScrollMarginBottomValue](v T) css.Decl {
+	return css.Set(ScrollMarginBottom, v)
+}
+
+// ScrollMarginInlineValue is implemented by the value types accepted by the scroll-margin-inline property.
+type ScrollMarginInlineValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollMarginInline creates a declaration for the scroll-margin-inline property.
-func SetScrollMarginInline(v ScrollMarginInlineVal) css.Decl {
-	return css.Set(ScrollMarginInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-margin-inline-end, scroll-margin-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline
+func SetScrollMarginInline[T ScrollMarginInlineValue](v T) css.Decl {
+	return css.Set(ScrollMarginInline, v)
+}
+
+// ScrollMarginInlineEndValue is implemented by the value types accepted by the scroll-margin-inline-end property.
+type ScrollMarginInlineEndValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginInlineEnd creates a declaration for the scroll-margin-inline-end property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-end
+func SetScrollMarginInlineEnd[T ScrollMarginInlineEndValue](v T) css.Decl {
+	return css.Set(ScrollMarginInlineEnd, v)
+}
+
+// ScrollMarginInlineStartValue is implemented by the value types accepted by the scroll-margin-inline-start property.
+type ScrollMarginInlineStartValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginInlineStart creates a declaration for the scroll-margin-inline-start property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-inline-start
+func SetScrollMarginInlineStart[T ScrollMarginInlineStartValue](v T) css.Decl {
+	return css.Set(ScrollMarginInlineStart, v)
+}
+
+// ScrollMarginLeftValue is implemented by the value types accepted by the scroll-margin-left property.
+type ScrollMarginLeftValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginLeft creates a declaration for the scroll-margin-left property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-left
+func SetScrollMarginLeft[T ScrollMarginLeftValue](v T) css.Decl {
+	return css.Set(ScrollMarginLeft, v)
+}
+
+// ScrollMarginRightValue is implemented by the value types accepted by the scroll-margin-right property.
+type ScrollMarginRightValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginRight creates a declaration for the scroll-margin-right property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-right
+func SetScrollMarginRight[T ScrollMarginRightValue](v T) css.Decl {
+	return css.Set(ScrollMarginRight, v)
+}
+
+// ScrollMarginTopValue is implemented by the value types accepted by the scroll-margin-top property.
+type ScrollMarginTopValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetScrollMarginTop creates a declaration for the scroll-margin-top property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-margin-top
+func SetScrollMarginTop[T ScrollMarginTopValue](v T) css.Decl {
+	return css.Set(ScrollMarginTop, v)
+}
+
+// ScrollPaddingValue is implemented by the value types accepted by the scroll-padding property.
+type ScrollPaddingValue interface {
+	css.Value
+	ScrollPaddingVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPadding creates a declaration for the scroll-padding property.
-func SetScrollPadding(v ScrollPaddingVal) css.Decl {
-	return css.Set(ScrollPadding, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-padding-bottom, scroll-padding-left, scroll-padding-right, scroll-padding-top
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,4}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding
+func SetScrollPadding[T ScrollPaddingValue](v T) css.Decl {
+	return css.Set(ScrollPadding, v)
+}
+
+// ScrollPaddingBlockValue is implemented by the value types accepted by the scroll-padding-block property.
+type ScrollPaddingBlockValue interface {
+	css.Value
+	ScrollPaddingBlockVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingBlock creates a declaration for the scroll-padding-block property.
-func SetScrollPaddingBlock(v ScrollPaddingBlockVal) css.Decl {
-	return css.Set(ScrollPaddingBlock, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-padding-block-end, scroll-padding-block-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block
+func SetScrollPaddingBlock[T ScrollPaddingBlockValue](v T) css.Decl {
+	return css.Set(ScrollPaddingBlock, v)
+}
+
+// ScrollPaddingBlockEndValue is implemented by the value types accepted by the scroll-padding-block-end property.
+type ScrollPaddingBlockEndValue interface {
+	css.Value
+	ScrollPaddingBlockEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingBlockEnd creates a declaration for the scroll-padding-block-end property.
-func SetScrollPaddingBlockEnd(v ScrollPaddingBlockEndVal) css.Decl {
-	return css.Set(ScrollPaddingBlockEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-end
+func SetScrollPaddingBlockEnd[T ScrollPaddingBlockEndValue](v T) css.Decl {
+	return css.Set(ScrollPaddingBlockEnd, v)
+}
+
+// ScrollPaddingBlockStartValue is implemented by the value types accepted by the scroll-padding-block-start property.
+type ScrollPaddingBlockStartValue interface {
+	css.Value
+	ScrollPaddingBlockStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingBlockStart creates a declaration for the scroll-padding-block-start property.
-func SetScrollPaddingBlockStart(v ScrollPaddingBlockStartVal) css.Decl {
-	return css.Set(ScrollPaddingBlockStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-block-start
+func SetScrollPaddingBlockStart[T ScrollPaddingBlockStartValue](v T) css.Decl {
+	return css.Set(ScrollPaddingBlockStart, v)
+}
+
+// ScrollPaddingBottomValue is implemented by the value types accepted by the scroll-padding-bottom property.
+type ScrollPaddingBottomValue interface {
+	css.Value
+	ScrollPaddingBottomVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingBottom creates a declaration for the scroll-padding-bottom property.
-func SetScrollPaddingBottom(v ScrollPaddingBottomVal) css.Decl {
-	return css.Set(ScrollPaddingBottom, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-bottom
+func SetScrollPaddingBottom[T ScrollPaddingBottomValue](v T) css.Decl {
+	return css.Set(ScrollPaddingBottom, v)
+}
+
+// ScrollPaddingInlineValue is implemented by the value types accepted by the scroll-padding-inline property.
+type ScrollPaddingInlineValue interface {
+	css.Value
+	ScrollPaddingInlineVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingInline creates a declaration for the scroll-padding-inline property.
-func SetScrollPaddingInline(v ScrollPaddingInlineVal) css.Decl {
-	return css.Set(ScrollPaddingInline, css.Keyword(string(v)))
+//
+//   - Shorthand for: scroll-padding-inline-end, scroll-padding-inline-start
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ auto | <length-percentage> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline
+func SetScrollPaddingInline[T ScrollPaddingInlineValue](v T) css.Decl {
+	return css.Set(ScrollPaddingInline, v)
+}
+
+// ScrollPaddingInlineEndValue is implemented by the value types accepted by the scroll-padding-inline-end property.
+type ScrollPaddingInlineEndValue interface {
+	css.Value
+	ScrollPaddingInlineEndVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingInlineEnd creates a declaration for the scroll-padding-inline-end property.
-func SetScrollPaddingInlineEnd(v ScrollPaddingInlineEndVal) css.Decl {
-	return css.Set(ScrollPaddingInlineEnd, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-end
+func SetScrollPaddingInlineEnd[T ScrollPaddingInlineEndValue](v T) css.Decl {
+	return css.Set(ScrollPaddingInlineEnd, v)
+}
+
+// ScrollPaddingInlineStartValue is implemented by the value types accepted by the scroll-padding-inline-start property.
+type ScrollPaddingInlineStartValue interface {
+	css.Value
+	ScrollPaddingInlineStartVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingInlineStart creates a declaration for the scroll-padding-inline-start property.
-func SetScrollPaddingInlineStart(v ScrollPaddingInlineStartVal) css.Decl {
-	return css.Set(ScrollPaddingInlineStart, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-inline-start
+func SetScrollPaddingInlineStart[T ScrollPaddingInlineStartValue](v T) css.Decl {
+	return css.Set(ScrollPaddingInlineStart, v)
+}
+
+// ScrollPaddingLeftValue is implemented by the value types accepted by the scroll-padding-left property.
+type ScrollPaddingLeftValue interface {
+	css.Value
+	ScrollPaddingLeftVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingLeft creates a declaration for the scroll-padding-left property.
-func SetScrollPaddingLeft(v ScrollPaddingLeftVal) css.Decl {
-	return css.Set(ScrollPaddingLeft, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-left
+func SetScrollPaddingLeft[T ScrollPaddingLeftValue](v T) css.Decl {
+	return css.Set(ScrollPaddingLeft, v)
+}
+
+// ScrollPaddingRightValue is implemented by the value types accepted by the scroll-padding-right property.
+type ScrollPaddingRightValue interface {
+	css.Value
+	ScrollPaddingRightVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingRight creates a declaration for the scroll-padding-right property.
-func SetScrollPaddingRight(v ScrollPaddingRightVal) css.Decl {
-	return css.Set(ScrollPaddingRight, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-right
+func SetScrollPaddingRight[T ScrollPaddingRightValue](v T) css.Decl {
+	return css.Set(ScrollPaddingRight, v)
+}
+
+// ScrollPaddingTopValue is implemented by the value types accepted by the scroll-padding-top property.
+type ScrollPaddingTopValue interface {
+	css.Value
+	ScrollPaddingTopVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollPaddingTop creates a declaration for the scroll-padding-top property.
-func SetScrollPaddingTop(v ScrollPaddingTopVal) css.Decl {
-	return css.Set(ScrollPaddingTop, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-padding-top
+func SetScrollPaddingTop[T ScrollPaddingTopValue](v T) css.Decl {
+	return css.Set(ScrollPaddingTop, v)
+}
+
+// ScrollSnapAlignValue is implemented by the value types accepted by the scroll-snap-align property.
+type ScrollSnapAlignValue interface {
+	css.Value
+	ScrollSnapAlignVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapAlign creates a declaration for the scroll-snap-align property.
-func SetScrollSnapAlign(v ScrollSnapAlignVal) css.Decl {
-	return css.Set(ScrollSnapAlign, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ none | start | end | center ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-align
+func SetScrollSnapAlign[T ScrollSnapAlignValue](v T) css.Decl {
+	return css.Set(ScrollSnapAlign, v)
+}
+
+// ScrollSnapCoordinateValue is implemented by the value types accepted by the scroll-snap-coordinate property.
+type ScrollSnapCoordinateValue interface {
+	css.Value
+	ScrollSnapCoordinateVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapCoordinate creates a declaration for the scroll-snap-coordinate property.
-func SetScrollSnapCoordinate(v ScrollSnapCoordinateVal) css.Decl {
-	return css.Set(ScrollSnapCoordinate, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | <position>#
+//
+// Deprecated: the scroll-snap-coordinate property is obsolete.
+func SetScrollSnapCoordinate[T ScrollSnapCoordinateValue](v T) css.Decl {
+	return css.Set(ScrollSnapCoordinate, v)
+}
+
+// ScrollSnapDestinationValue is implemented by the value types accepted by the scroll-snap-destination property.
+type ScrollSnapDestinationValue interface {
+	css.Value
+	ScrollSnapDestinationVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapDestination creates a declaration for the scroll-snap-destination property.
-func SetScrollSnapDestination(v ScrollSnapDestinationVal) css.Decl {
-	return css.Set(ScrollSnapDestination, css.Keyword(string(v)))
+//
+//   - Initial: 0px 0px
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	<position>
+//
+// Deprecated: the scroll-snap-destination property is obsolete.
+func SetScrollSnapDestination[T ScrollSnapDestinationValue](v T) css.Decl {
+	return css.Set(ScrollSnapDestination, v)
+}
+
+// ScrollSnapPointsXValue is implemented by the value types accepted by the scroll-snap-points-x property.
+type ScrollSnapPointsXValue interface {
+	css.Value
+	ScrollSnapPointsXVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapPointsX creates a declaration for the scroll-snap-points-x property.
-func SetScrollSnapPointsX(v ScrollSnapPointsXVal) css.Decl {
-	return css.Set(ScrollSnapPointsX, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | repeat( <length-percentage> )
+//
+// Deprecated: the scroll-snap-points-x property is obsolete.
+func SetScrollSnapPointsX[T ScrollSnapPointsXValue](v T) css.Decl {
+	return css.Set(ScrollSnapPointsX, v)
+}
+
+// ScrollSnapPointsYValue is implemented by the value types accepted by the scroll-snap-points-y property.
+type ScrollSnapPointsYValue interface {
+	css.Value
+	ScrollSnapPointsYVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapPointsY creates a declaration for the scroll-snap-points-y property.
-func SetScrollSnapPointsY(v ScrollSnapPointsYVal) css.Decl {
-	return css.Set(ScrollSnapPointsY, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | repeat( <length-percentage> )
+//
+// Deprecated: the scroll-snap-points-y property is obsolete.
+func SetScrollSnapPointsY[T ScrollSnapPointsYValue](v T) css.Decl {
+	return css.Set(ScrollSnapPointsY, v)
+}
+
+// ScrollSnapStopValue is implemented by the value types accepted by the scroll-snap-stop property.
+type ScrollSnapStopValue interface {
+	css.Value
+	ScrollSnapStopVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapStop creates a declaration for the scroll-snap-stop property.
-func SetScrollSnapStop(v ScrollSnapStopVal) css.Decl {
-	return css.Set(ScrollSnapStop, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | always
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-stop
+func SetScrollSnapStop[T ScrollSnapStopValue](v T) css.Decl {
+	return css.Set(ScrollSnapStop, v)
+}
+
+// ScrollSnapTypeValue is implemented by the value types accepted by the scroll-snap-type property.
+type ScrollSnapTypeValue interface {
+	css.Value
+	ScrollSnapTypeVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapType creates a declaration for the scroll-snap-type property.
-func SetScrollSnapType(v ScrollSnapTypeVal) css.Decl {
-	return css.Set(ScrollSnapType, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ x | y | block | inline | both ] [ mandatory | proximity ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scroll-snap-type
+func SetScrollSnapType[T ScrollSnapTypeValue](v T) css.Decl {
+	return css.Set(ScrollSnapType, v)
+}
+
+// ScrollSnapTypeXValue is implemented by the value types accepted by the scroll-snap-type-x property.
+type ScrollSnapTypeXValue interface {
+	css.Value
+	ScrollSnapTypeXVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapTypeX creates a declaration for the scroll-snap-type-x property.
-func SetScrollSnapTypeX(v ScrollSnapTypeXVal) css.Decl {
-	return css.Set(ScrollSnapTypeX, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | mandatory | proximity
+//
+// Deprecated: the scroll-snap-type-x property is obsolete.
+func SetScrollSnapTypeX[T ScrollSnapTypeXValue](v T) css.Decl {
+	return css.Set(ScrollSnapTypeX, v)
+}
+
+// ScrollSnapTypeYValue is implemented by the value types accepted by the scroll-snap-type-y property.
+type ScrollSnapTypeYValue interface {
+	css.Value
+	ScrollSnapTypeYVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollSnapTypeY creates a declaration for the scroll-snap-type-y property.
-func SetScrollSnapTypeY(v ScrollSnapTypeYVal) css.Decl {
-	return css.Set(ScrollSnapTypeY, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: obsolete
+//
+// Syntax:
+//
+//	none | mandatory | proximity
+//
+// Deprecated: the scroll-snap-type-y property is obsolete.
+func SetScrollSnapTypeY[T ScrollSnapTypeYValue](v T) css.Decl {
+	return css.Set(ScrollSnapTypeY, v)
+}
+
+// ScrollbarColorValue is implemented by the value types accepted by the scrollbar-color property.
+type ScrollbarColorValue interface {
+	css.Value
+	ScrollbarColorVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollbarColor creates a declaration for the scrollbar-color property.
-func SetScrollbarColor(v ScrollbarColorVal) css.Decl {
-	return css.Set(ScrollbarColor, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <color>{2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-color
+func SetScrollbarColor[T ScrollbarColorValue](v T) css.Decl {
+	return css.Set(ScrollbarColor, v)
+}
+
+// ScrollbarGutterValue is implemented by the value types accepted by the scrollbar-gutter property.
+type ScrollbarGutterValue interface {
+	css.Value
+	ScrollbarGutterVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollbarGutter creates a declaration for the scrollbar-gutter property.
-func SetScrollbarGutter(v ScrollbarGutterVal) css.Decl {
-	return css.Set(ScrollbarGutter, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | stable && both-edges?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-gutter
+func SetScrollbarGutter[T ScrollbarGutterValue](v T) css.Decl {
+	return css.Set(ScrollbarGutter, v)
+}
+
+// ScrollbarWidthValue is implemented by the value types accepted by the scrollbar-width property.
+type ScrollbarWidthValue interface {
+	css.Value
+	ScrollbarWidthVal | css.Raw | css.Function | css.Global
 }
 
 // SetScrollbarWidth creates a declaration for the scrollbar-width property.
-func SetScrollbarWidth(v ScrollbarWidthVal) css.Decl {
-	return css.Set(ScrollbarWidth, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | thin | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/scrollbar-width
+func SetScrollbarWidth[T ScrollbarWidthValue](v T) css.Decl {
+	return css.Set(ScrollbarWidth, v)
+}
+
+// ShapeImageThresholdValue is implemented by the value types accepted by the shape-image-threshold property.
+type ShapeImageThresholdValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetShapeImageThreshold creates a declaration for the shape-image-threshold property.
+//
+//   - Initial: 0.0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<opacity-value>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-image-threshold
+func SetShapeImageThreshold[T ShapeImageThresholdValue](v T) css.Decl {
+	return css.Set(ShapeImageThreshold, v)
+}
+
+// ShapeMarginValue is implemented by the value types accepted by the shape-margin property.
+type ShapeMarginValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetShapeMargin creates a declaration for the shape-margin property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-margin
+func SetShapeMargin[T ShapeMarginValue](v T) css.Decl {
+	return css.Set(ShapeMargin, v)
+}
+
+// ShapeOutsideValue is implemented by the value types accepted by the shape-outside property.
+type ShapeOutsideValue interface {
+	css.Value
+	ShapeOutsideVal | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetShapeOutside creates a declaration for the shape-outside property.
-func SetShapeOutside(v ShapeOutsideVal) css.Decl {
-	return css.Set(ShapeOutside, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ <shape-box> || <basic-shape> ] | <image>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-outside
+func SetShapeOutside[T ShapeOutsideValue](v T) css.Decl {
+	return css.Set(ShapeOutside, v)
+}
+
+// ShapeRenderingValue is implemented by the value types accepted by the shape-rendering property.
+type ShapeRenderingValue interface {
+	css.Value
+	ShapeRenderingVal | css.Raw | css.Function | css.Global
 }
 
 // SetShapeRendering creates a declaration for the shape-rendering property.
-func SetShapeRendering(v ShapeRenderingVal) css.Decl {
-	return css.Set(ShapeRendering, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | optimizeSpeed | crispEdges | geometricPrecision
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/shape-rendering
+func SetShapeRendering[T ShapeRenderingValue](v T) css.Decl {
+	return css.Set(ShapeRendering, v)
+}
+
+// StopColorValue is implemented by the value types accepted by the stop-color property.
+type StopColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
+}
+
+// SetStopColor creates a declaration for the stop-color property.
+//
+//   - Initial: black
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stop-color
+func SetStopColor[T StopColorValue](v T) css.Decl {
+	return css.Set(StopColor, v)
+}
+
+// StopOpacityValue is implemented by the value types accepted by the stop-opacity property.
+type StopOpacityValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetStopOpacity creates a declaration for the stop-opacity property.
-func SetStopOpacity(v StopOpacityVal) css.Decl {
-	return css.Set(StopOpacity, css.Keyword(string(v)))
+//
+//   - Initial: black
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'opacity'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stop-opacity
+func SetStopOpacity[T StopOpacityValue](v T) css.Decl {
+	return css.Set(StopOpacity, v)
+}
+
+// StrokeValue is implemented by the value types accepted by the stroke property.
+type StrokeValue interface {
+	css.Value
+	StrokeVal | css.Color | css.URL | css.Raw | css.Function | css.Global
 }
 
 // SetStroke creates a declaration for the stroke property.
-func SetStroke(v StrokeVal) css.Decl {
-	return css.Set(Stroke, css.Keyword(string(v)))
+//
+//   - Shorthand for: stroke-dasharray, stroke-dashoffset, stroke-linecap, stroke-linejoin, stroke-miterlimit, stroke-opacity, stroke-width
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<paint>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke
+func SetStroke[T StrokeValue](v T) css.Decl {
+	return css.Set(Stroke, v)
+}
+
+// StrokeDasharrayValue is implemented by the value types accepted by the stroke-dasharray property.
+type StrokeDasharrayValue interface {
+	css.Value
+	StrokeDasharrayVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetStrokeDasharray creates a declaration for the stroke-dasharray property.
-func SetStrokeDasharray(v StrokeDasharrayVal) css.Decl {
-	return css.Set(StrokeDasharray, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <dasharray>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-dasharray
+func SetStrokeDasharray[T StrokeDasharrayValue](v T) css.Decl {
+	return css.Set(StrokeDasharray, v)
+}
+
+// StrokeDashoffsetValue is implemented by the value types accepted by the stroke-dashoffset property.
+type StrokeDashoffsetValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetStrokeDashoffset creates a declaration for the stroke-dashoffset property.
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | <number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-dashoffset
+func SetStrokeDashoffset[T StrokeDashoffsetValue](v T) css.Decl {
+	return css.Set(StrokeDashoffset, v)
+}
+
+// StrokeLinecapValue is implemented by the value types accepted by the stroke-linecap property.
+type StrokeLinecapValue interface {
+	css.Value
+	StrokeLinecapVal | css.Raw | css.Function | css.Global
 }
 
 // SetStrokeLinecap creates a declaration for the stroke-linecap property.
-func SetStrokeLinecap(v StrokeLinecapVal) css.Decl {
-	return css.Set(StrokeLinecap, css.Keyword(string(v)))
+//
+//   - Initial: butt
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	butt | round | square
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linecap
+func SetStrokeLinecap[T StrokeLinecapValue](v T) css.Decl {
+	return css.Set(StrokeLinecap, v)
+}
+
+// StrokeLinejoinValue is implemented by the value types accepted by the stroke-linejoin property.
+type StrokeLinejoinValue interface {
+	css.Value
+	StrokeLinejoinVal | css.Raw | css.Function | css.Global
 }
 
 // SetStrokeLinejoin creates a declaration for the stroke-linejoin property.
-func SetStrokeLinejoin(v StrokeLinejoinVal) css.Decl {
-	return css.Set(StrokeLinejoin, css.Keyword(string(v)))
+//
+//   - Initial: miter
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	miter | miter-clip | round | bevel | arcs
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-linejoin
+func SetStrokeLinejoin[T StrokeLinejoinValue](v T) css.Decl {
+	return css.Set(StrokeLinejoin, v)
+}
+
+// StrokeMiterlimitValue is implemented by the value types accepted by the stroke-miterlimit property.
+type StrokeMiterlimitValue interface {
+	css.Value
+	css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetStrokeMiterlimit creates a declaration for the stroke-miterlimit property.
+//
+//   - Initial: 4
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-miterlimit
+func SetStrokeMiterlimit[T StrokeMiterlimitValue](v T) css.Decl {
+	return css.Set(StrokeMiterlimit, v)
+}
+
+// StrokeOpacityValue is implemented by the value types accepted by the stroke-opacity property.
+type StrokeOpacityValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetStrokeOpacity creates a declaration for the stroke-opacity property.
-func SetStrokeOpacity(v StrokeOpacityVal) css.Decl {
-	return css.Set(StrokeOpacity, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'opacity'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-opacity
+func SetStrokeOpacity[T StrokeOpacityValue](v T) css.Decl {
+	return css.Set(StrokeOpacity, v)
+}
+
+// StrokeWidthValue is implemented by the value types accepted by the stroke-width property.
+type StrokeWidthValue interface {
+	css.Value
+	css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
+}
+
+// SetStrokeWidth creates a declaration for the stroke-width property.
+//
+//   - Initial: 1px
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> | <number>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/stroke-width
+func SetStrokeWidth[T StrokeWidthValue](v T) css.Decl {
+	return css.Set(StrokeWidth, v)
+}
+
+// TabSizeValue is implemented by the value types accepted by the tab-size property.
+type TabSizeValue interface {
+	css.Value
+	css.Length | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetTabSize creates a declaration for the tab-size property.
-func SetTabSize(v TabSizeVal) css.Decl {
-	return css.Set(TabSize, css.Keyword(string(v)))
+//
+//   - Initial: 8
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer> | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/tab-size
+func SetTabSize[T TabSizeValue](v T) css.Decl {
+	return css.Set(TabSize, v)
+}
+
+// TableLayoutValue is implemented by the value types accepted by the table-layout property.
+type TableLayoutValue interface {
+	css.Value
+	TableLayoutVal | css.Raw | css.Function | css.Global
 }
 
 // SetTableLayout creates a declaration for the table-layout property.
-func SetTableLayout(v TableLayoutVal) css.Decl {
-	return css.Set(TableLayout, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | fixed
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/table-layout
+func SetTableLayout[T TableLayoutValue](v T) css.Decl {
+	return css.Set(TableLayout, v)
+}
+
+// TextAlignValue is implemented by the value types accepted by the text-align property.
+type TextAlignValue interface {
+	css.Value
+	TextAlignVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextAlign creates a declaration for the text-align property.
-func SetTextAlign(v TextAlignVal) css.Decl {
-	return css.Set(TextAlign, css.Keyword(string(v)))
+//
+//   - Initial: startOrNamelessValueIfLTRRightIfRTL
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | end | left | right | center | justify | match-parent
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align
+func SetTextAlign[T TextAlignValue](v T) css.Decl {
+	return css.Set(TextAlign, v)
+}
+
+// TextAlignLastValue is implemented by the value types accepted by the text-align-last property.
+type TextAlignLastValue interface {
+	css.Value
+	TextAlignLastVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextAlignLast creates a declaration for the text-align-last property.
-func SetTextAlignLast(v TextAlignLastVal) css.Decl {
-	return css.Set(TextAlignLast, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | start | end | left | right | center | justify
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-align-last
+func SetTextAlignLast[T TextAlignLastValue](v T) css.Decl {
+	return css.Set(TextAlignLast, v)
+}
+
+// TextAnchorValue is implemented by the value types accepted by the text-anchor property.
+type TextAnchorValue interface {
+	css.Value
+	TextAnchorVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextAnchor creates a declaration for the text-anchor property.
-func SetTextAnchor(v TextAnchorVal) css.Decl {
-	return css.Set(TextAnchor, css.Keyword(string(v)))
+//
+//   - Initial: start
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	start | middle | end
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-anchor
+func SetTextAnchor[T TextAnchorValue](v T) css.Decl {
+	return css.Set(TextAnchor, v)
+}
+
+// TextAutospaceValue is implemented by the value types accepted by the text-autospace property.
+type TextAutospaceValue interface {
+	css.Value
+	TextAutospaceVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextAutospace creates a declaration for the text-autospace property.
-func SetTextAutospace(v TextAutospaceVal) css.Decl {
-	return css.Set(TextAutospace, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <autospace> | auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-autospace
+func SetTextAutospace[T TextAutospaceValue](v T) css.Decl {
+	return css.Set(TextAutospace, v)
+}
+
+// TextBoxValue is implemented by the value types accepted by the text-box property.
+type TextBoxValue interface {
+	css.Value
+	TextBoxVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextBox creates a declaration for the text-box property.
-func SetTextBox(v TextBoxVal) css.Decl {
-	return css.Set(TextBox, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <'text-box-trim'> || <'text-box-edge'>
+func SetTextBox[T TextBoxValue](v T) css.Decl {
+	return css.Set(TextBox, v)
+}
+
+// TextBoxEdgeValue is implemented by the value types accepted by the text-box-edge property.
+type TextBoxEdgeValue interface {
+	css.Value
+	TextBoxEdgeVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextBoxEdge creates a declaration for the text-box-edge property.
-func SetTextBoxEdge(v TextBoxEdgeVal) css.Decl {
-	return css.Set(TextBoxEdge, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <text-edge>
+func SetTextBoxEdge[T TextBoxEdgeValue](v T) css.Decl {
+	return css.Set(TextBoxEdge, v)
+}
+
+// TextBoxTrimValue is implemented by the value types accepted by the text-box-trim property.
+type TextBoxTrimValue interface {
+	css.Value
+	TextBoxTrimVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextBoxTrim creates a declaration for the text-box-trim property.
-func SetTextBoxTrim(v TextBoxTrimVal) css.Decl {
-	return css.Set(TextBoxTrim, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | trim-start | trim-end | trim-both
+func SetTextBoxTrim[T TextBoxTrimValue](v T) css.Decl {
+	return css.Set(TextBoxTrim, v)
+}
+
+// TextCombineUprightValue is implemented by the value types accepted by the text-combine-upright property.
+type TextCombineUprightValue interface {
+	css.Value
+	TextCombineUprightVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextCombineUpright creates a declaration for the text-combine-upright property.
-func SetTextCombineUpright(v TextCombineUprightVal) css.Decl {
-	return css.Set(TextCombineUpright, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | all | [ digits <integer>? ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-combine-upright
+func SetTextCombineUpright[T TextCombineUprightValue](v T) css.Decl {
+	return css.Set(TextCombineUpright, v)
+}
+
+// TextDecorationValue is implemented by the value types accepted by the text-decoration property.
+type TextDecorationValue interface {
+	css.Value
+	TextDecorationVal | css.Length | css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecoration creates a declaration for the text-decoration property.
-func SetTextDecoration(v TextDecorationVal) css.Decl {
-	return css.Set(TextDecoration, css.Keyword(string(v)))
+//
+//   - Shorthand for: text-decoration-color, text-decoration-line, text-decoration-style
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration
+func SetTextDecoration[T TextDecorationValue](v T) css.Decl {
+	return css.Set(TextDecoration, v)
+}
+
+// TextDecorationColorValue is implemented by the value types accepted by the text-decoration-color property.
+type TextDecorationColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecorationColor creates a declaration for the text-decoration-color property.
-func SetTextDecorationColor(v TextDecorationColorVal) css.Decl {
-	return css.Set(TextDecorationColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-color
+func SetTextDecorationColor[T TextDecorationColorValue](v T) css.Decl {
+	return css.Set(TextDecorationColor, v)
+}
+
+// TextDecorationLineValue is implemented by the value types accepted by the text-decoration-line property.
+type TextDecorationLineValue interface {
+	css.Value
+	TextDecorationLineVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecorationLine creates a declaration for the text-decoration-line property.
-func SetTextDecorationLine(v TextDecorationLineVal) css.Decl {
-	return css.Set(TextDecorationLine, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-line
+func SetTextDecorationLine[T TextDecorationLineValue](v T) css.Decl {
+	return css.Set(TextDecorationLine, v)
+}
+
+// TextDecorationSkipInkValue is implemented by the value types accepted by the text-decoration-skip-ink property.
+type TextDecorationSkipInkValue interface {
+	css.Value
+	TextDecorationSkipInkVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecorationSkipInk creates a declaration for the text-decoration-skip-ink property.
-func SetTextDecorationSkipInk(v TextDecorationSkipInkVal) css.Decl {
-	return css.Set(TextDecorationSkipInk, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | all | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-skip-ink
+func SetTextDecorationSkipInk[T TextDecorationSkipInkValue](v T) css.Decl {
+	return css.Set(TextDecorationSkipInk, v)
+}
+
+// TextDecorationStyleValue is implemented by the value types accepted by the text-decoration-style property.
+type TextDecorationStyleValue interface {
+	css.Value
+	TextDecorationStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecorationStyle creates a declaration for the text-decoration-style property.
-func SetTextDecorationStyle(v TextDecorationStyleVal) css.Decl {
-	return css.Set(TextDecorationStyle, css.Keyword(string(v)))
+//
+//   - Initial: solid
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	solid | double | dotted | dashed | wavy
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-style
+func SetTextDecorationStyle[T TextDecorationStyleValue](v T) css.Decl {
+	return css.Set(TextDecorationStyle, v)
+}
+
+// TextDecorationThicknessValue is implemented by the value types accepted by the text-decoration-thickness property.
+type TextDecorationThicknessValue interface {
+	css.Value
+	TextDecorationThicknessVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTextDecorationThickness creates a declaration for the text-decoration-thickness property.
-func SetTextDecorationThickness(v TextDecorationThicknessVal) css.Decl {
-	return css.Set(TextDecorationThickness, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | from-font | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-decoration-thickness
+func SetTextDecorationThickness[T TextDecorationThicknessValue](v T) css.Decl {
+	return css.Set(TextDecorationThickness, v)
+}
+
+// TextEmphasisValue is implemented by the value types accepted by the text-emphasis property.
+type TextEmphasisValue interface {
+	css.Value
+	TextEmphasisVal | css.Color | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetTextEmphasis creates a declaration for the text-emphasis property.
-func SetTextEmphasis(v TextEmphasisVal) css.Decl {
-	return css.Set(TextEmphasis, css.Keyword(string(v)))
+//
+//   - Shorthand for: text-emphasis-color, text-emphasis-style
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-emphasis-style'> || <'text-emphasis-color'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis
+func SetTextEmphasis[T TextEmphasisValue](v T) css.Decl {
+	return css.Set(TextEmphasis, v)
+}
+
+// TextEmphasisColorValue is implemented by the value types accepted by the text-emphasis-color property.
+type TextEmphasisColorValue interface {
+	css.Value
+	css.Color | css.Raw | css.Function | css.Global
 }
 
 // SetTextEmphasisColor creates a declaration for the text-emphasis-color property.
-func SetTextEmphasisColor(v TextEmphasisColorVal) css.Decl {
-	return css.Set(TextEmphasisColor, css.Keyword(string(v)))
+//
+//   - Initial: currentcolor
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<color>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-color
+func SetTextEmphasisColor[T TextEmphasisColorValue](v T) css.Decl {
+	return css.Set(TextEmphasisColor, v)
+}
+
+// TextEmphasisPositionValue is implemented by the value types accepted by the text-emphasis-position property.
+type TextEmphasisPositionValue interface {
+	css.Value
+	TextEmphasisPositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextEmphasisPosition creates a declaration for the text-emphasis-position property.
-func SetTextEmphasisPosition(v TextEmphasisPositionVal) css.Decl {
-	return css.Set(TextEmphasisPosition, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | [ over | under ] && [ right | left ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-position
+func SetTextEmphasisPosition[T TextEmphasisPositionValue](v T) css.Decl {
+	return css.Set(TextEmphasisPosition, v)
+}
+
+// TextEmphasisStyleValue is implemented by the value types accepted by the text-emphasis-style property.
+type TextEmphasisStyleValue interface {
+	css.Value
+	TextEmphasisStyleVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetTextEmphasisStyle creates a declaration for the text-emphasis-style property.
-func SetTextEmphasisStyle(v TextEmphasisStyleVal) css.Decl {
-	return css.Set(TextEmphasisStyle, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-emphasis-style
+func SetTextEmphasisStyle[T TextEmphasisStyleValue](v T) css.Decl {
+	return css.Set(TextEmphasisStyle, v)
+}
+
+// TextIndentValue is implemented by the value types accepted by the text-indent property.
+type TextIndentValue interface {
+	css.Value
+	TextIndentVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTextIndent creates a declaration for the text-indent property.
-func SetTextIndent(v TextIndentVal) css.Decl {
-	return css.Set(TextIndent, css.Keyword(string(v)))
+//
+//   - Initial: 0
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length-percentage> && hanging? && each-line?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-indent
+func SetTextIndent[T TextIndentValue](v T) css.Decl {
+	return css.Set(TextIndent, v)
+}
+
+// TextJustifyValue is implemented by the value types accepted by the text-justify property.
+type TextJustifyValue interface {
+	css.Value
+	TextJustifyVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextJustify creates a declaration for the text-justify property.
-func SetTextJustify(v TextJustifyVal) css.Decl {
-	return css.Set(TextJustify, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | inter-character | inter-word | none
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-justify
+func SetTextJustify[T TextJustifyValue](v T) css.Decl {
+	return css.Set(TextJustify, v)
+}
+
+// TextOrientationValue is implemented by the value types accepted by the text-orientation property.
+type TextOrientationValue interface {
+	css.Value
+	TextOrientationVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextOrientation creates a declaration for the text-orientation property.
-func SetTextOrientation(v TextOrientationVal) css.Decl {
-	return css.Set(TextOrientation, css.Keyword(string(v)))
+//
+//   - Initial: mixed
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	mixed | upright | sideways
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-orientation
+func SetTextOrientation[T TextOrientationValue](v T) css.Decl {
+	return css.Set(TextOrientation, v)
+}
+
+// TextOverflowValue is implemented by the value types accepted by the text-overflow property.
+type TextOverflowValue interface {
+	css.Value
+	TextOverflowVal | css.QuotedString | css.Raw | css.Function | css.Global
 }
 
 // SetTextOverflow creates a declaration for the text-overflow property.
-func SetTextOverflow(v TextOverflowVal) css.Decl {
-	return css.Set(TextOverflow, css.Keyword(string(v)))
+//
+//   - Initial: clip
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ clip | ellipsis | <string> ]{1,2}
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-overflow
+func SetTextOverflow[T TextOverflowValue](v T) css.Decl {
+	return css.Set(TextOverflow, v)
+}
+
+// TextRenderingValue is implemented by the value types accepted by the text-rendering property.
+type TextRenderingValue interface {
+	css.Value
+	TextRenderingVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextRendering creates a declaration for the text-rendering property.
-func SetTextRendering(v TextRenderingVal) css.Decl {
-	return css.Set(TextRendering, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | optimizeSpeed | optimizeLegibility | geometricPrecision
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-rendering
+func SetTextRendering[T TextRenderingValue](v T) css.Decl {
+	return css.Set(TextRendering, v)
+}
+
+// TextShadowValue is implemented by the value types accepted by the text-shadow property.
+type TextShadowValue interface {
+	css.Value
+	TextShadowVal | css.Shadow | css.Raw | css.Function | css.Global
 }
 
 // SetTextShadow creates a declaration for the text-shadow property.
-func SetTextShadow(v TextShadowVal) css.Decl {
-	return css.Set(TextShadow, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <shadow-t>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-shadow
+func SetTextShadow[T TextShadowValue](v T) css.Decl {
+	return css.Set(TextShadow, v)
+}
+
+// TextTransformValue is implemented by the value types accepted by the text-transform property.
+type TextTransformValue interface {
+	css.Value
+	TextTransformVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextTransform creates a declaration for the text-transform property.
-func SetTextTransform(v TextTransformVal) css.Decl {
-	return css.Set(TextTransform, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-transform
+func SetTextTransform[T TextTransformValue](v T) css.Decl {
+	return css.Set(TextTransform, v)
+}
+
+// TextUnderlineOffsetValue is implemented by the value types accepted by the text-underline-offset property.
+type TextUnderlineOffsetValue interface {
+	css.Value
+	TextUnderlineOffsetVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTextUnderlineOffset creates a declaration for the text-underline-offset property.
-func SetTextUnderlineOffset(v TextUnderlineOffsetVal) css.Decl {
-	return css.Set(TextUnderlineOffset, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-offset
+func SetTextUnderlineOffset[T TextUnderlineOffsetValue](v T) css.Decl {
+	return css.Set(TextUnderlineOffset, v)
+}
+
+// TextUnderlinePositionValue is implemented by the value types accepted by the text-underline-position property.
+type TextUnderlinePositionValue interface {
+	css.Value
+	TextUnderlinePositionVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextUnderlinePosition creates a declaration for the text-underline-position property.
-func SetTextUnderlinePosition(v TextUnderlinePositionVal) css.Decl {
-	return css.Set(TextUnderlinePosition, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | from-font | [ under || [ left | right ] ]
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-underline-position
+func SetTextUnderlinePosition[T TextUnderlinePositionValue](v T) css.Decl {
+	return css.Set(TextUnderlinePosition, v)
+}
+
+// TextWrapValue is implemented by the value types accepted by the text-wrap property.
+type TextWrapValue interface {
+	css.Value
+	TextWrapVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextWrap creates a declaration for the text-wrap property.
-func SetTextWrap(v TextWrapVal) css.Decl {
-	return css.Set(TextWrap, css.Keyword(string(v)))
+//
+//   - Initial: wrap
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<'text-wrap-mode'> || <'text-wrap-style'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap
+func SetTextWrap[T TextWrapValue](v T) css.Decl {
+	return css.Set(TextWrap, v)
+}
+
+// TextWrapModeValue is implemented by the value types accepted by the text-wrap-mode property.
+type TextWrapModeValue interface {
+	css.Value
+	TextWrapModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextWrapMode creates a declaration for the text-wrap-mode property.
-func SetTextWrapMode(v TextWrapModeVal) css.Decl {
-	return css.Set(TextWrapMode, css.Keyword(string(v)))
+//
+//   - Initial: wrap
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	wrap | nowrap
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-mode
+func SetTextWrapMode[T TextWrapModeValue](v T) css.Decl {
+	return css.Set(TextWrapMode, v)
+}
+
+// TextWrapStyleValue is implemented by the value types accepted by the text-wrap-style property.
+type TextWrapStyleValue interface {
+	css.Value
+	TextWrapStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetTextWrapStyle creates a declaration for the text-wrap-style property.
-func SetTextWrapStyle(v TextWrapStyleVal) css.Decl {
-	return css.Set(TextWrapStyle, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | balance | stable | pretty
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/text-wrap-style
+func SetTextWrapStyle[T TextWrapStyleValue](v T) css.Decl {
+	return css.Set(TextWrapStyle, v)
+}
+
+// TopValue is implemented by the value types accepted by the top property.
+type TopValue interface {
+	css.Value
+	TopVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTop creates a declaration for the top property.
-func SetTop(v TopVal) css.Decl {
-	return css.Set(Top, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage> | <anchor()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/top
+func SetTop[T TopValue](v T) css.Decl {
+	return css.Set(Top, v)
+}
+
+// TouchActionValue is implemented by the value types accepted by the touch-action property.
+type TouchActionValue interface {
+	css.Value
+	TouchActionVal | css.Raw | css.Function | css.Global
 }
 
 // SetTouchAction creates a declaration for the touch-action property.
-func SetTouchAction(v TouchActionVal) css.Decl {
-	return css.Set(TouchAction, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/touch-action
+func SetTouchAction[T TouchActionValue](v T) css.Decl {
+	return css.Set(TouchAction, v)
+}
+
+// TransformValue is implemented by the value types accepted by the transform property.
+type TransformValue interface {
+	css.Value
+	TransformVal | css.Raw | css.Function | css.Global
 }
 
 // SetTransform creates a declaration for the transform property.
-func SetTransform(v TransformVal) css.Decl {
-	return css.Set(Transform, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <transform-list>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform
+func SetTransform[T TransformValue](v T) css.Decl {
+	return css.Set(Transform, v)
+}
+
+// TransformBoxValue is implemented by the value types accepted by the transform-box property.
+type TransformBoxValue interface {
+	css.Value
+	TransformBoxVal | css.Raw | css.Function | css.Global
 }
 
 // SetTransformBox creates a declaration for the transform-box property.
-func SetTransformBox(v TransformBoxVal) css.Decl {
-	return css.Set(TransformBox, css.Keyword(string(v)))
+//
+//   - Initial: view-box
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	content-box | border-box | fill-box | stroke-box | view-box
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-box
+func SetTransformBox[T TransformBoxValue](v T) css.Decl {
+	return css.Set(TransformBox, v)
+}
+
+// TransformOriginValue is implemented by the value types accepted by the transform-origin property.
+type TransformOriginValue interface {
+	css.Value
+	TransformOriginVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTransformOrigin creates a declaration for the transform-origin property.
-func SetTransformOrigin(v TransformOriginVal) css.Decl {
-	return css.Set(TransformOrigin, css.Keyword(string(v)))
+//
+//   - Initial: 50% 50% 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	[ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-origin
+func SetTransformOrigin[T TransformOriginValue](v T) css.Decl {
+	return css.Set(TransformOrigin, v)
+}
+
+// TransformStyleValue is implemented by the value types accepted by the transform-style property.
+type TransformStyleValue interface {
+	css.Value
+	TransformStyleVal | css.Raw | css.Function | css.Global
 }
 
 // SetTransformStyle creates a declaration for the transform-style property.
-func SetTransformStyle(v TransformStyleVal) css.Decl {
-	return css.Set(TransformStyle, css.Keyword(string(v)))
+//
+//   - Initial: flat
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	flat | preserve-3d
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transform-style
+func SetTransformStyle[T TransformStyleValue](v T) css.Decl {
+	return css.Set(TransformStyle, v)
+}
+
+// TransitionValue is implemented by the value types accepted by the transition property.
+type TransitionValue interface {
+	css.Value
+	TransitionVal | css.Time | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetTransition creates a declaration for the transition property.
-func SetTransition(v TransitionVal) css.Decl {
-	return css.Set(Transition, css.Keyword(string(v)))
+//
+//   - Shorthand for: transition-behavior, transition-delay, transition-duration, transition-property, transition-timing-function
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<single-transition>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition
+func SetTransition[T TransitionValue](v T) css.Decl {
+	return css.Set(Transition, v)
+}
+
+// TransitionBehaviorValue is implemented by the value types accepted by the transition-behavior property.
+type TransitionBehaviorValue interface {
+	css.Value
+	TransitionBehaviorVal | css.Raw | css.Function | css.Global
 }
 
 // SetTransitionBehavior creates a declaration for the transition-behavior property.
-func SetTransitionBehavior(v TransitionBehaviorVal) css.Decl {
-	return css.Set(TransitionBehavior, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<transition-behavior-value>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-behavior
+func SetTransitionBehavior[T TransitionBehaviorValue](v T) css.Decl {
+	return css.Set(TransitionBehavior, v)
+}
+
+// TransitionDelayValue is implemented by the value types accepted by the transition-delay property.
+type TransitionDelayValue interface {
+	css.Value
+	css.Time | css.Raw | css.Function | css.Global
 }
 
 // SetTransitionDelay creates a declaration for the transition-delay property.
-func SetTransitionDelay(v TransitionDelayVal) css.Decl {
-	return css.Set(TransitionDelay, css.Keyword(string(v)))
+//
+//   - Initial: 0s
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<time>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-delay
+func SetTransitionDelay[T TransitionDelayValue](v T) css.Decl {
+	return css.Set(TransitionDelay, v)
+}
+
+// TransitionDurationValue is implemented by the value types accepted by the transition-duration property.
+type TransitionDurationValue interface {
+	css.Value
+	css.Time | css.Raw | css.Function | css.Global
 }
 
 // SetTransitionDuration creates a declaration for the transition-duration property.
-func SetTransitionDuration(v TransitionDurationVal) css.Decl {
-	return css.Set(TransitionDuration, css.Keyword(string(v)))
+//
+//   - Initial: 0s
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<time>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-duration
+func SetTransitionDuration[T TransitionDurationValue](v T) css.Decl {
+	return css.Set(TransitionDuration, v)
+}
+
+// TransitionPropertyValue is implemented by the value types accepted by the transition-property property.
+type TransitionPropertyValue interface {
+	css.Value
+	TransitionPropertyVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetTransitionProperty creates a declaration for the transition-property property.
-func SetTransitionProperty(v TransitionPropertyVal) css.Decl {
-	return css.Set(TransitionProperty, css.Keyword(string(v)))
+//
+//   - Initial: all
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <single-transition-property>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-property
+func SetTransitionProperty[T TransitionPropertyValue](v T) css.Decl {
+	return css.Set(TransitionProperty, v)
+}
+
+// TransitionTimingFunctionValue is implemented by the value types accepted by the transition-timing-function property.
+type TransitionTimingFunctionValue interface {
+	css.Value
+	TransitionTimingFunctionVal | css.Raw | css.Function | css.Global
 }
 
 // SetTransitionTimingFunction creates a declaration for the transition-timing-function property.
-func SetTransitionTimingFunction(v TransitionTimingFunctionVal) css.Decl {
-	return css.Set(TransitionTimingFunction, css.Keyword(string(v)))
+//
+//   - Initial: ease
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<easing-function>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/transition-timing-function
+func SetTransitionTimingFunction[T TransitionTimingFunctionValue](v T) css.Decl {
+	return css.Set(TransitionTimingFunction, v)
+}
+
+// TranslateValue is implemented by the value types accepted by the translate property.
+type TranslateValue interface {
+	css.Value
+	TranslateVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetTranslate creates a declaration for the translate property.
-func SetTranslate(v TranslateVal) css.Decl {
-	return css.Set(Translate, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <length-percentage> [ <length-percentage> <length>? ]?
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/translate
+func SetTranslate[T TranslateValue](v T) css.Decl {
+	return css.Set(Translate, v)
+}
+
+// UnicodeBidiValue is implemented by the value types accepted by the unicode-bidi property.
+type UnicodeBidiValue interface {
+	css.Value
+	UnicodeBidiVal | css.Raw | css.Function | css.Global
 }
 
 // SetUnicodeBidi creates a declaration for the unicode-bidi property.
-func SetUnicodeBidi(v UnicodeBidiVal) css.Decl {
-	return css.Set(UnicodeBidi, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | embed | isolate | bidi-override | isolate-override | plaintext
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/unicode-bidi
+func SetUnicodeBidi[T UnicodeBidiValue](v T) css.Decl {
+	return css.Set(UnicodeBidi, v)
+}
+
+// UserSelectValue is implemented by the value types accepted by the user-select property.
+type UserSelectValue interface {
+	css.Value
+	UserSelectVal | css.Raw | css.Function | css.Global
 }
 
 // SetUserSelect creates a declaration for the user-select property.
-func SetUserSelect(v UserSelectVal) css.Decl {
-	return css.Set(UserSelect, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | text | none | all
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/user-select
+func SetUserSelect[T UserSelectValue](v T) css.Decl {
+	return css.Set(UserSelect, v)
+}
+
+// VectorEffectValue is implemented by the value types accepted by the vector-effect property.
+type VectorEffectValue interface {
+	css.Value
+	VectorEffectVal | css.Raw | css.Function | css.Global
 }
 
 // SetVectorEffect creates a declaration for the vector-effect property.
-func SetVectorEffect(v VectorEffectVal) css.Decl {
-	return css.Set(VectorEffect, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/vector-effect
+func SetVectorEffect[T VectorEffectValue](v T) css.Decl {
+	return css.Set(VectorEffect, v)
+}
+
+// VerticalAlignValue is implemented by the value types accepted by the vertical-align property.
+type VerticalAlignValue interface {
+	css.Value
+	VerticalAlignVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetVerticalAlign creates a declaration for the vertical-align property.
-func SetVerticalAlign(v VerticalAlignVal) css.Decl {
-	return css.Set(VerticalAlign, css.Keyword(string(v)))
+//
+//   - Initial: baseline
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/vertical-align
+func SetVerticalAlign[T VerticalAlignValue](v T) css.Decl {
+	return css.Set(VerticalAlign, v)
+}
+
+// ViewTransitionClassValue is implemented by the value types accepted by the view-transition-class property.
+type ViewTransitionClassValue interface {
+	css.Value
+	ViewTransitionClassVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetViewTransitionClass creates a declaration for the view-transition-class property.
-func SetViewTransitionClass(v ViewTransitionClassVal) css.Decl {
-	return css.Set(ViewTransitionClass, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident>+
+func SetViewTransitionClass[T ViewTransitionClassValue](v T) css.Decl {
+	return css.Set(ViewTransitionClass, v)
+}
+
+// ViewTransitionNameValue is implemented by the value types accepted by the view-transition-name property.
+type ViewTransitionNameValue interface {
+	css.Value
+	ViewTransitionNameVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetViewTransitionName creates a declaration for the view-transition-name property.
-func SetViewTransitionName(v ViewTransitionNameVal) css.Decl {
-	return css.Set(ViewTransitionName, css.Keyword(string(v)))
+//
+//   - Initial: none
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	none | <custom-ident> | match-element
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/view-transition-name
+func SetViewTransitionName[T ViewTransitionNameValue](v T) css.Decl {
+	return css.Set(ViewTransitionName, v)
+}
+
+// VisibilityValue is implemented by the value types accepted by the visibility property.
+type VisibilityValue interface {
+	css.Value
+	VisibilityVal | css.Raw | css.Function | css.Global
 }
 
 // SetVisibility creates a declaration for the visibility property.
-func SetVisibility(v VisibilityVal) css.Decl {
-	return css.Set(Visibility, css.Keyword(string(v)))
+//
+//   - Initial: visible
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	visible | hidden | collapse
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/visibility
+func SetVisibility[T VisibilityValue](v T) css.Decl {
+	return css.Set(Visibility, v)
+}
+
+// WhiteSpaceValue is implemented by the value types accepted by the white-space property.
+type WhiteSpaceValue interface {
+	css.Value
+	WhiteSpaceVal | css.Raw | css.Function | css.Global
 }
 
 // SetWhiteSpace creates a declaration for the white-space property.
-func SetWhiteSpace(v WhiteSpaceVal) css.Decl {
-	return css.Set(WhiteSpace, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space
+func SetWhiteSpace[T WhiteSpaceValue](v T) css.Decl {
+	return css.Set(WhiteSpace, v)
+}
+
+// WhiteSpaceCollapseValue is implemented by the value types accepted by the white-space-collapse property.
+type WhiteSpaceCollapseValue interface {
+	css.Value
+	WhiteSpaceCollapseVal | css.Raw | css.Function | css.Global
 }
 
 // SetWhiteSpaceCollapse creates a declaration for the white-space-collapse property.
-func SetWhiteSpaceCollapse(v WhiteSpaceCollapseVal) css.Decl {
-	return css.Set(WhiteSpaceCollapse, css.Keyword(string(v)))
+//
+//   - Initial: collapse
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	collapse | preserve | preserve-breaks | preserve-spaces | break-spaces
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/white-space-collapse
+func SetWhiteSpaceCollapse[T WhiteSpaceCollapseValue](v T) css.Decl {
+	return css.Set(WhiteSpaceCollapse, v)
+}
+
+// WidowsValue is implemented by the value types accepted by the widows property.
+type WidowsValue interface {
+	css.Value
+	css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetWidows creates a declaration for the widows property.
-func SetWidows(v WidowsVal) css.Decl {
-	return css.Set(Widows, css.Keyword(string(v)))
+//
+//   - Initial: 2
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	<integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/widows
+func SetWidows[T WidowsValue](v T) css.Decl {
+	return css.Set(Widows, v)
+}
+
+// WidthValue is implemented by the value types accepted by the width property.
+type WidthValue interface {
+	css.Value
+	WidthVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetWidth creates a declaration for the width property.
-func SetWidth(v WidthVal) css.Decl {
-	return css.Set(Width, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/width
+func SetWidth[T WidthValue](v T) css.Decl {
+	return css.Set(Width, v)
+}
+
+// WillChangeValue is implemented by the value types accepted by the will-change property.
+type WillChangeValue interface {
+	css.Value
+	WillChangeVal | css.Keyword | css.Raw | css.Function | css.Global
 }
 
 // SetWillChange creates a declaration for the will-change property.
-func SetWillChange(v WillChangeVal) css.Decl {
-	return css.Set(WillChange, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <animateable-feature>#
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/will-change
+func SetWillChange[T WillChangeValue](v T) css.Decl {
+	return css.Set(WillChange, v)
+}
+
+// WordBreakValue is implemented by the value types accepted by the word-break property.
+type WordBreakValue interface {
+	css.Value
+	WordBreakVal | css.Raw | css.Function | css.Global
 }
 
 // SetWordBreak creates a declaration for the word-break property.
-func SetWordBreak(v WordBreakVal) css.Decl {
-	return css.Set(WordBreak, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-all | keep-all | break-word | auto-phrase
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/word-break
+func SetWordBreak[T WordBreakValue](v T) css.Decl {
+	return css.Set(WordBreak, v)
+}
+
+// WordSpacingValue is implemented by the value types accepted by the word-spacing property.
+type WordSpacingValue interface {
+	css.Value
+	WordSpacingVal | css.Length | css.Raw | css.Function | css.Global
 }
 
 // SetWordSpacing creates a declaration for the word-spacing property.
-func SetWordSpacing(v WordSpacingVal) css.Decl {
-	return css.Set(WordSpacing, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | <length>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/word-spacing
+func SetWordSpacing[T WordSpacingValue](v T) css.Decl {
+	return css.Set(WordSpacing, v)
+}
+
+// WordWrapValue is implemented by the value types accepted by the word-wrap property.
+type WordWrapValue interface {
+	css.Value
+	WordWrapVal | css.Raw | css.Function | css.Global
 }
 
 // SetWordWrap creates a declaration for the word-wrap property.
-func SetWordWrap(v WordWrapVal) css.Decl {
-	return css.Set(WordWrap, css.Keyword(string(v)))
+//
+//   - Initial: normal
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | break-word
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/overflow-wrap
+func SetWordWrap[T WordWrapValue](v T) css.Decl {
+	return css.Set(WordWrap, v)
+}
+
+// WritingModeValue is implemented by the value types accepted by the writing-mode property.
+type WritingModeValue interface {
+	css.Value
+	WritingModeVal | css.Raw | css.Function | css.Global
 }
 
 // SetWritingMode creates a declaration for the writing-mode property.
-func SetWritingMode(v WritingModeVal) css.Decl {
-	return css.Set(WritingMode, css.Keyword(string(v)))
+//
+//   - Initial: horizontal-tb
+//   - Inherited: yes
+//   - Status: standard
+//
+// Syntax:
+//
+//	horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/writing-mode
+func SetWritingMode[T WritingModeValue](v T) css.Decl {
+	return css.Set(WritingMode, v)
+}
+
+// XValue is implemented by the value types accepted by the x property.
+type XValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetX creates a declaration for the x property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/x
+func SetX[T XValue](v T) css.Decl {
+	return css.Set(X, v)
+}
+
+// YValue is implemented by the value types accepted by the y property.
+type YValue interface {
+	css.Value
+	css.Length | css.Raw | css.Function | css.Global
+}
+
+// SetY creates a declaration for the y property.
+//
+//   - Initial: 0
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	<length> | <percentage>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/y
+func SetY[T YValue](v T) css.Decl {
+	return css.Set(Y, v)
+}
+
+// ZIndexValue is implemented by the value types accepted by the z-index property.
+type ZIndexValue interface {
+	css.Value
+	ZIndexVal | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetZIndex creates a declaration for the z-index property.
-func SetZIndex(v ZIndexVal) css.Decl {
-	return css.Set(ZIndex, css.Keyword(string(v)))
+//
+//   - Initial: auto
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	auto | <integer>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/z-index
+func SetZIndex[T ZIndexValue](v T) css.Decl {
+	return css.Set(ZIndex, v)
+}
+
+// ZoomValue is implemented by the value types accepted by the zoom property.
+type ZoomValue interface {
+	css.Value
+	ZoomVal | css.Length | css.Number | css.Integer | css.Raw | css.Function | css.Global
 }
 
 // SetZoom creates a declaration for the zoom property.
-func SetZoom(v ZoomVal) css.Decl {
-	return css.Set(Zoom, css.Keyword(string(v)))
+//
+//   - Initial: 1
+//   - Inherited: no
+//   - Status: standard
+//
+// Syntax:
+//
+//	normal | reset | <number [0,∞]> || <percentage [0,∞]>
+//
+// MDN: https://developer.mozilla.org/docs/Web/CSS/zoom
+func SetZoom[T ZoomValue](v T) css.Decl {
+	return css.Set(Zoom, v)
 }
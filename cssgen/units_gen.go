@@ -0,0 +1,168 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T18:02:37Z
+
+package cssgen
+
+import (
+	"strconv"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Constructors for CSS dimensions, one per unit.
+
+// dimension formats a number with the shortest representation followed by
+// a unit, e.g. 1.5rem.
+func dimension(x float64, unit string) string {
+	return strconv.FormatFloat(x, 'f', -1, 64) + unit
+}
+
+// Cap creates a css.Length dimension in cap.
+func Cap(x float64) css.Length {
+	return css.Length(dimension(x, "cap"))
+}
+
+// Ch creates a css.Length dimension in ch.
+func Ch(x float64) css.Length {
+	return css.Length(dimension(x, "ch"))
+}
+
+// Cm creates a css.Length dimension in cm.
+func Cm(x float64) css.Length {
+	return css.Length(dimension(x, "cm"))
+}
+
+// Deg creates a css.Angle dimension in deg.
+func Deg(x float64) css.Angle {
+	return css.Angle(dimension(x, "deg"))
+}
+
+// Dpcm creates a css.Resolution dimension in dpcm.
+func Dpcm(x float64) css.Resolution {
+	return css.Resolution(dimension(x, "dpcm"))
+}
+
+// Dpi creates a css.Resolution dimension in dpi.
+func Dpi(x float64) css.Resolution {
+	return css.Resolution(dimension(x, "dpi"))
+}
+
+// Dppx creates a css.Resolution dimension in dppx.
+func Dppx(x float64) css.Resolution {
+	return css.Resolution(dimension(x, "dppx"))
+}
+
+// Em creates a css.Length dimension in em.
+func Em(x float64) css.Length {
+	return css.Length(dimension(x, "em"))
+}
+
+// Ex creates a css.Length dimension in ex.
+func Ex(x float64) css.Length {
+	return css.Length(dimension(x, "ex"))
+}
+
+// Fr creates a css.Flex dimension in fr.
+func Fr(x float64) css.Flex {
+	return css.Flex(dimension(x, "fr"))
+}
+
+// Grad creates a css.Angle dimension in grad.
+func Grad(x float64) css.Angle {
+	return css.Angle(dimension(x, "grad"))
+}
+
+// Hz creates a css.Frequency dimension in Hz.
+func Hz(x float64) css.Frequency {
+	return css.Frequency(dimension(x, "Hz"))
+}
+
+// Ic creates a css.Length dimension in ic.
+func Ic(x float64) css.Length {
+	return css.Length(dimension(x, "ic"))
+}
+
+// In creates a css.Length dimension in in.
+func In(x float64) css.Length {
+	return css.Length(dimension(x, "in"))
+}
+
+// KHz creates a css.Frequency dimension in kHz.
+func KHz(x float64) css.Frequency {
+	return css.Frequency(dimension(x, "kHz"))
+}
+
+// Mm creates a css.Length dimension in mm.
+func Mm(x float64) css.Length {
+	return css.Length(dimension(x, "mm"))
+}
+
+// Ms creates a css.Time dimension in ms.
+func Ms(x float64) css.Time {
+	return css.Time(dimension(x, "ms"))
+}
+
+// Pc creates a css.Length dimension in pc.
+func Pc(x float64) css.Length {
+	return css.Length(dimension(x, "pc"))
+}
+
+// Pt creates a css.Length dimension in pt.
+func Pt(x float64) css.Length {
+	return css.Length(dimension(x, "pt"))
+}
+
+// Px creates a css.Length dimension in px.
+func Px(x float64) css.Length {
+	return css.Length(dimension(x, "px"))
+}
+
+// Q creates a css.Length dimension in Q.
+func Q(x float64) css.Length {
+	return css.Length(dimension(x, "Q"))
+}
+
+// Rad creates a css.Angle dimension in rad.
+func Rad(x float64) css.Angle {
+	return css.Angle(dimension(x, "rad"))
+}
+
+// Rem creates a css.Length dimension in rem.
+func Rem(x float64) css.Length {
+	return css.Length(dimension(x, "rem"))
+}
+
+// S creates a css.Time dimension in s.
+func S(x float64) css.Time {
+	return css.Time(dimension(x, "s"))
+}
+
+// Turn creates a css.Angle dimension in turn.
+func Turn(x float64) css.Angle {
+	return css.Angle(dimension(x, "turn"))
+}
+
+// Vh creates a css.Length dimension in vh.
+func Vh(x float64) css.Length {
+	return css.Length(dimension(x, "vh"))
+}
+
+// Vmax creates a css.Length dimension in vmax.
+func Vmax(x float64) css.Length {
+	return css.Length(dimension(x, "vmax"))
+}
+
+// Vmin creates a css.Length dimension in vmin.
+func Vmin(x float64) css.Length {
+	return css.Length(dimension(x, "vmin"))
+}
+
+// Vw creates a css.Length dimension in vw.
+func Vw(x float64) css.Length {
+	return css.Length(dimension(x, "vw"))
+}
+
+// ResolutionX creates a css.Resolution dimension in x.
+func ResolutionX(x float64) css.Resolution {
+	return css.Resolution(dimension(x, "x"))
+}
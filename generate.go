@@ -1,7 +1,7 @@
 // Package main includes go:generate directives for code generation.
 package main
 
-//go:generate go run ./cmd/cssgen -in ./spec -out ./cssgen -pkg cssgen
+//go:generate go run ./cmd/cssgen -in ./spec -out ./cssgen -pkg cssgen -grammar ./internal/syntax/grammar_gen.go
 
 func main() {
 	// This file is only used for go:generate directives.
@@ -0,0 +1,86 @@
+package syntax
+
+import "strings"
+
+// builtinTypes matches the basic data types that the spec data does not
+// define in terms of other syntax. Each matches a single component value.
+var builtinTypes = map[string]func(*valueToken) bool{
+	"length":     func(t *valueToken) bool { return isDimension(t, lengthUnits) || isZero(t) || isMath(t) },
+	"percentage": func(t *valueToken) bool { return t.kind == vPercentage || isMath(t) },
+	"number":     func(t *valueToken) bool { return t.kind == vNumber || isMath(t) },
+	"integer":    func(t *valueToken) bool { return t.kind == vNumber && t.integer || isMath(t) },
+	"time":       func(t *valueToken) bool { return isDimension(t, timeUnits) || isMath(t) },
+	"angle":      func(t *valueToken) bool { return isDimension(t, angleUnits) || isZero(t) || isMath(t) },
+	"frequency":  func(t *valueToken) bool { return isDimension(t, frequencyUnits) || isMath(t) },
+	"resolution": func(t *valueToken) bool { return isDimension(t, resolutionUnits) || isMath(t) },
+	"flex":       func(t *valueToken) bool { return isDimension(t, flexUnits) || isMath(t) },
+	"dimension":  func(t *valueToken) bool { return t.kind == vDimension || isMath(t) },
+	"zero":       isZero,
+
+	"string": func(t *valueToken) bool { return t.kind == vString },
+	"url": func(t *valueToken) bool {
+		return t.kind == vURL || t.kind == vFunction && (t.name == "url" || t.name == "src")
+	},
+	"hex-color": func(t *valueToken) bool {
+		if t.kind != vHash {
+			return false
+		}
+		switch len(t.name) {
+		case 3, 4, 6, 8:
+			return strings.Trim(t.name, "0123456789abcdef") == ""
+		}
+		return false
+	},
+
+	"ident":                func(t *valueToken) bool { return t.kind == vIdent },
+	"custom-ident":         func(t *valueToken) bool { return t.kind == vIdent && !cssWideKeywords[t.name] && t.name != "default" },
+	"dashed-ident":         isDashedIdent,
+	"custom-property-name": isDashedIdent,
+
+	// Tokens named directly by the grammar.
+	"ident-token":     func(t *valueToken) bool { return t.kind == vIdent },
+	"string-token":    func(t *valueToken) bool { return t.kind == vString },
+	"number-token":    func(t *valueToken) bool { return t.kind == vNumber },
+	"dimension-token": func(t *valueToken) bool { return t.kind == vDimension },
+	"hash-token":      func(t *valueToken) bool { return t.kind == vHash },
+	"function-token":  func(t *valueToken) bool { return t.kind == vFunction },
+
+	// Arbitrary token sequences; see matchBuiltin.
+	"any-value":         nil,
+	"declaration-value": nil,
+}
+
+var (
+	lengthUnits = units("px", "em", "rem", "ex", "rex", "ch", "rch", "cap", "rcap", "ic", "ric", "lh", "rlh",
+		"vw", "vh", "vi", "vb", "vmin", "vmax", "svw", "svh", "svi", "svb", "svmin", "svmax",
+		"lvw", "lvh", "lvi", "lvb", "lvmin", "lvmax", "dvw", "dvh", "dvi", "dvb", "dvmin", "dvmax",
+		"cqw", "cqh", "cqi", "cqb", "cqmin", "cqmax", "cm", "mm", "q", "in", "pt", "pc")
+	timeUnits       = units("s", "ms")
+	angleUnits      = units("deg", "grad", "rad", "turn")
+	frequencyUnits  = units("hz", "khz")
+	resolutionUnits = units("dpi", "dpcm", "dppx", "x")
+	flexUnits       = units("fr")
+
+	mathFunctions = units("calc", "min", "max", "clamp", "round", "mod", "rem", "abs", "sign",
+		"sin", "cos", "tan", "asin", "acos", "atan", "atan2", "pow", "sqrt", "hypot", "log", "exp")
+)
+
+func units(names ...string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, n := range names {
+		set[n] = true
+	}
+	return set
+}
+
+func isDimension(t *valueToken, units map[string]bool) bool {
+	return t.kind == vDimension && units[t.name]
+}
+
+func isZero(t *valueToken) bool { return t.kind == vNumber && t.num == 0 }
+
+func isMath(t *valueToken) bool { return t.kind == vFunction && mathFunctions[t.name] }
+
+func isDashedIdent(t *valueToken) bool {
+	return t.kind == vIdent && strings.HasPrefix(t.name, "--")
+}
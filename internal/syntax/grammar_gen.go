@@ -0,0 +1,893 @@
+// Code generated by cssgen; DO NOT EDIT.
+// Source: spec version mdn-latest at 2026-10-18T17:32:17Z
+
+package syntax
+
+// properties maps property names to their value definition syntax.
+var properties = map[string]string{
+	"accent-color":                  "auto | <color>",
+	"align-content":                 "normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position>",
+	"align-items":                   "normal | stretch | <baseline-position> | [ <overflow-position>? <self-position> ] | anchor-center",
+	"align-self":                    "auto | normal | stretch | <baseline-position> | <overflow-position>? <self-position> | anchor-center",
+	"align-tracks":                  "[ normal | <baseline-position> | <content-distribution> | <overflow-position>? <content-position> ]#",
+	"alignment-baseline":            "baseline | alphabetic | ideographic | middle | central | mathematical | text-before-edge | text-after-edge",
+	"all":                           "initial | inherit | unset | revert | revert-layer",
+	"anchor-name":                   "none | <dashed-ident>#",
+	"anchor-scope":                  "none | all | <dashed-ident>#",
+	"animation":                     "<single-animation>#",
+	"animation-composition":         "<single-animation-composition>#",
+	"animation-delay":               "<time>#",
+	"animation-direction":           "<single-animation-direction>#",
+	"animation-duration":            "[ auto | <time [0s,∞]> ]#",
+	"animation-fill-mode":           "<single-animation-fill-mode>#",
+	"animation-iteration-count":     "<single-animation-iteration-count>#",
+	"animation-name":                "[ none | <keyframes-name> ]#",
+	"animation-play-state":          "<single-animation-play-state>#",
+	"animation-range":               "[ <'animation-range-start'> <'animation-range-end'>? ]#",
+	"animation-range-end":           "[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#",
+	"animation-range-start":         "[ normal | <length-percentage> | <timeline-range-name> <length-percentage>? ]#",
+	"animation-timeline":            "<single-animation-timeline>#",
+	"animation-timing-function":     "<easing-function>#",
+	"appearance":                    "none | auto | <compat-auto> | <compat-special>",
+	"aspect-ratio":                  "auto || <ratio>",
+	"backdrop-filter":               "none | <filter-value-list>",
+	"backface-visibility":           "visible | hidden",
+	"background":                    "<bg-layer>#? , <final-bg-layer>",
+	"background-attachment":         "<attachment>#",
+	"background-blend-mode":         "<blend-mode>#",
+	"background-clip":               "<bg-clip>#",
+	"background-color":              "<color>",
+	"background-image":              "<bg-image>#",
+	"background-origin":             "<visual-box>#",
+	"background-position":           "<bg-position>#",
+	"background-position-x":         "[ center | [ [ left | right | x-start | x-end ]? <length-percentage>? ]! ]#",
+	"background-position-y":         "[ center | [ [ top | bottom | y-start | y-end ]? <length-percentage>? ]! ]#",
+	"background-repeat":             "<repeat-style>#",
+	"background-size":               "<bg-size>#",
+	"baseline-shift":                "<length-percentage> | sub | super | baseline",
+	"block-size":                    "<'width'>",
+	"border":                        "<line-width> || <line-style> || <color>",
+	"border-block":                  "<'border-block-start'>",
+	"border-block-color":            "<'border-top-color'>{1,2}",
+	"border-block-end":              "<'border-top-width'> || <'border-top-style'> || <color>",
+	"border-block-end-color":        "<'border-top-color'>",
+	"border-block-end-style":        "<'border-top-style'>",
+	"border-block-end-width":        "<'border-top-width'>",
+	"border-block-start":            "<'border-top-width'> || <'border-top-style'> || <color>",
+	"border-block-start-color":      "<'border-top-color'>",
+	"border-block-start-style":      "<'border-top-style'>",
+	"border-block-start-width":      "<'border-top-width'>",
+	"border-block-style":            "<'border-top-style'>{1,2}",
+	"border-block-width":            "<'border-top-width'>{1,2}",
+	"border-bottom":                 "<line-width> || <line-style> || <color>",
+	"border-bottom-color":           "<'border-top-color'>",
+	"border-bottom-left-radius":     "<length-percentage [0,∞]>{1,2}",
+	"border-bottom-right-radius":    "<length-percentage [0,∞]>{1,2}",
+	"border-bottom-style":           "<line-style>",
+	"border-bottom-width":           "<line-width>",
+	"border-collapse":               "separate | collapse",
+	"border-color":                  "<color>{1,4}",
+	"border-end-end-radius":         "<'border-top-left-radius'>",
+	"border-end-start-radius":       "<'border-top-left-radius'>",
+	"border-image":                  "<'border-image-source'> || <'border-image-slice'> [ / <'border-image-width'> | / <'border-image-width'>? / <'border-image-outset'> ]? || <'border-image-repeat'>",
+	"border-image-outset":           "[ <length [0,∞]> | <number [0,∞]> ]{1,4}  ",
+	"border-image-repeat":           "[ stretch | repeat | round | space ]{1,2}",
+	"border-image-slice":            "[ <number [0,∞]> | <percentage [0,∞]> ]{1,4}  && fill?",
+	"border-image-source":           "none | <image>",
+	"border-image-width":            "[ <length-percentage [0,∞]> | <number [0,∞]> | auto ]{1,4}",
+	"border-inline":                 "<'border-block-start'>",
+	"border-inline-color":           "<'border-top-color'>{1,2}",
+	"border-inline-end":             "<'border-top-width'> || <'border-top-style'> || <color>",
+	"border-inline-end-color":       "<'border-top-color'>",
+	"border-inline-end-style":       "<'border-top-style'>",
+	"border-inline-end-width":       "<'border-top-width'>",
+	"border-inline-start":           "<'border-top-width'> || <'border-top-style'> || <color>",
+	"border-inline-start-color":     "<'border-top-color'>",
+	"border-inline-start-style":     "<'border-top-style'>",
+	"border-inline-start-width":     "<'border-top-width'>",
+	"border-inline-style":           "<'border-top-style'>{1,2}",
+	"border-inline-width":           "<'border-top-width'>{1,2}",
+	"border-left":                   "<line-width> || <line-style> || <color>",
+	"border-left-color":             "<color>",
+	"border-left-style":             "<line-style>",
+	"border-left-width":             "<line-width>",
+	"border-radius":                 "<length-percentage [0,∞]>{1,4} [ / <length-percentage [0,∞]>{1,4} ]?",
+	"border-right":                  "<line-width> || <line-style> || <color>",
+	"border-right-color":            "<color>",
+	"border-right-style":            "<line-style>",
+	"border-right-width":            "<line-width>",
+	"border-spacing":                "<length>{1,2}",
+	"border-start-end-radius":       "<'border-top-left-radius'>",
+	"border-start-start-radius":     "<'border-top-left-radius'>",
+	"border-style":                  "<line-style>{1,4}",
+	"border-top":                    "<line-width> || <line-style> || <color>",
+	"border-top-color":              "<color>",
+	"border-top-left-radius":        "<length-percentage [0,∞]>{1,2}",
+	"border-top-right-radius":       "<length-percentage [0,∞]>{1,2}",
+	"border-top-style":              "<line-style>",
+	"border-top-width":              "<line-width>",
+	"border-width":                  "<line-width>{1,4}",
+	"bottom":                        "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+	"box-align":                     "start | center | end | baseline | stretch",
+	"box-decoration-break":          "slice | clone",
+	"box-direction":                 "normal | reverse | inherit",
+	"box-flex":                      "<number>",
+	"box-flex-group":                "<integer>",
+	"box-lines":                     "single | multiple",
+	"box-ordinal-group":             "<integer>",
+	"box-orient":                    "horizontal | vertical | inline-axis | block-axis | inherit",
+	"box-pack":                      "start | center | end | justify",
+	"box-shadow":                    "none | <shadow>#",
+	"box-sizing":                    "content-box | border-box",
+	"break-after":                   "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+	"break-before":                  "auto | avoid | always | all | avoid-page | page | left | right | recto | verso | avoid-column | column | avoid-region | region",
+	"break-inside":                  "auto | avoid | avoid-page | avoid-column | avoid-region",
+	"caption-side":                  "top | bottom",
+	"caret":                         "<'caret-color'> || <'caret-shape'>",
+	"caret-color":                   "auto | <color>",
+	"caret-shape":                   "auto | bar | block | underscore",
+	"clear":                         "none | left | right | both | inline-start | inline-end",
+	"clip":                          "<shape> | auto",
+	"clip-path":                     "<clip-source> | [ <basic-shape> || <geometry-box> ] | none",
+	"clip-rule":                     "nonzero | evenodd",
+	"color":                         "<color>",
+	"color-interpolation-filters":   "auto | sRGB | linearRGB",
+	"color-scheme":                  "normal | [ light | dark | <custom-ident> ]+ && only?",
+	"column-count":                  "<integer> | auto",
+	"column-fill":                   "auto | balance",
+	"column-gap":                    "normal | <length-percentage>",
+	"column-rule":                   "<'column-rule-width'> || <'column-rule-style'> || <'column-rule-color'>",
+	"column-rule-color":             "<color>",
+	"column-rule-style":             "<'border-style'>",
+	"column-rule-width":             "<'border-width'>",
+	"column-span":                   "none | all",
+	"column-width":                  "<length> | auto",
+	"columns":                       "<'column-width'> || <'column-count'>",
+	"contain":                       "none | strict | content | [ [ size || inline-size ] || layout || style || paint ]",
+	"contain-intrinsic-block-size":  "auto? [ none | <length> ]",
+	"contain-intrinsic-height":      "auto? [ none | <length> ]",
+	"contain-intrinsic-inline-size": "auto? [ none | <length> ]",
+	"contain-intrinsic-size":        "[ auto? [ none | <length> ] ]{1,2}",
+	"contain-intrinsic-width":       "auto? [ none | <length> ]",
+	"container":                     "<'container-name'> [ / <'container-type'> ]?",
+	"container-name":                "none | <custom-ident>+",
+	"container-type":                "normal | [ [ size | inline-size ] || scroll-state ]",
+	"content":                       "normal | none | [ <content-replacement> | <content-list> ] [ / [ <string> | <counter> | <attr()> ]+ ]?",
+	"content-visibility":            "visible | auto | hidden",
+	"counter-increment":             "[ <counter-name> <integer>? ]+ | none",
+	"counter-reset":                 "[ <counter-name> <integer>? | <reversed-counter-name> <integer>? ]+ | none",
+	"counter-set":                   "[ <counter-name> <integer>? ]+ | none",
+	"cursor":                        "[ [ <url> [ <x> <y> ]? , ]* [ auto | default | none | context-menu | help | pointer | progress | wait | cell | crosshair | text | vertical-text | alias | copy | move | no-drop | not-allowed | e-resize | n-resize | ne-resize | nw-resize | s-resize | se-resize | sw-resize | w-resize | ew-resize | ns-resize | nesw-resize | nwse-resize | col-resize | row-resize | all-scroll | zoom-in | zoom-out | grab | grabbing ] ]",
+	"cx":                            "<length> | <percentage>",
+	"cy":                            "<length> | <percentage>",
+	"d":                             "none | path(<string>)",
+	"direction":                     "ltr | rtl",
+	"display":                       "[ <display-outside> || <display-inside> ] | <display-listitem> | <display-internal> | <display-box> | <display-legacy>",
+	"dominant-baseline":             "auto | text-bottom | alphabetic | ideographic | middle | central | mathematical | hanging | text-top",
+	"empty-cells":                   "show | hide",
+	"field-sizing":                  "content | fixed",
+	"fill":                          "<paint>",
+	"fill-opacity":                  "<'opacity'>",
+	"fill-rule":                     "nonzero | evenodd",
+	"filter":                        "none | <filter-value-list>",
+	"flex":                          "none | [ <'flex-grow'> <'flex-shrink'>? || <'flex-basis'> ]",
+	"flex-basis":                    "content | <'width'>",
+	"flex-direction":                "row | row-reverse | column | column-reverse",
+	"flex-flow":                     "<'flex-direction'> || <'flex-wrap'>",
+	"flex-grow":                     "<number>",
+	"flex-shrink":                   "<number>",
+	"flex-wrap":                     "nowrap | wrap | wrap-reverse",
+	"float":                         "left | right | none | inline-start | inline-end",
+	"flood-color":                   "<color>",
+	"flood-opacity":                 "<'opacity'>",
+	"font":                          "[ [ <'font-style'> || <font-variant-css2> || <'font-weight'> || <font-width-css3> ]? <'font-size'> [ / <'line-height'> ]? <'font-family'># ] | <system-family-name>",
+	"font-family":                   "[ <family-name> | <generic-family> ]#",
+	"font-feature-settings":         "normal | <feature-tag-value>#",
+	"font-kerning":                  "auto | normal | none",
+	"font-language-override":        "normal | <string>",
+	"font-optical-sizing":           "auto | none",
+	"font-palette":                  "normal | light | dark | <palette-identifier> | <palette-mix()>",
+	"font-size":                     "<absolute-size> | <relative-size> | <length-percentage [0,∞]> | math",
+	"font-size-adjust":              "none | [ ex-height | cap-height | ch-width | ic-width | ic-height ]? [ from-font | <number> ]",
+	"font-smooth":                   "auto | never | always | <absolute-size> | <length>",
+	"font-stretch":                  "<font-stretch-absolute>",
+	"font-style":                    "normal | italic | oblique <angle>?",
+	"font-synthesis":                "none | [ weight || style || small-caps || position]",
+	"font-synthesis-position":       "auto | none",
+	"font-synthesis-small-caps":     "auto | none",
+	"font-synthesis-style":          "auto | none",
+	"font-synthesis-weight":         "auto | none",
+	"font-variant":                  "normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> || stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) || [ small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps ] || <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero || <east-asian-variant-values> || <east-asian-width-values> || ruby ]",
+	"font-variant-alternates":       "normal | [ stylistic( <feature-value-name> ) || historical-forms || styleset( <feature-value-name># ) || character-variant( <feature-value-name># ) || swash( <feature-value-name> ) || ornaments( <feature-value-name> ) || annotation( <feature-value-name> ) ]",
+	"font-variant-caps":             "normal | small-caps | all-small-caps | petite-caps | all-petite-caps | unicase | titling-caps",
+	"font-variant-east-asian":       "normal | [ <east-asian-variant-values> || <east-asian-width-values> || ruby ]",
+	"font-variant-emoji":            "normal | text | emoji | unicode",
+	"font-variant-ligatures":        "normal | none | [ <common-lig-values> || <discretionary-lig-values> || <historical-lig-values> || <contextual-alt-values> ]",
+	"font-variant-numeric":          "normal | [ <numeric-figure-values> || <numeric-spacing-values> || <numeric-fraction-values> || ordinal || slashed-zero ]",
+	"font-variant-position":         "normal | sub | super",
+	"font-variation-settings":       "normal | [ <string> <number> ]#",
+	"font-weight":                   "<font-weight-absolute> | bolder | lighter",
+	"font-width":                    "normal | <percentage [0,∞]> | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded",
+	"forced-color-adjust":           "auto | none | preserve-parent-color",
+	"gap":                           "<'row-gap'> <'column-gap'>?",
+	"grid":                          "<'grid-template'> | <'grid-template-rows'> / [ auto-flow && dense? ] <'grid-auto-columns'>? | [ auto-flow && dense? ] <'grid-auto-rows'>? / <'grid-template-columns'>",
+	"grid-area":                     "<grid-line> [ / <grid-line> ]{0,3}",
+	"grid-auto-columns":             "<track-size>+",
+	"grid-auto-flow":                "[ row | column ] || dense",
+	"grid-auto-rows":                "<track-size>+",
+	"grid-column":                   "<grid-line> [ / <grid-line> ]?",
+	"grid-column-end":               "<grid-line>",
+	"grid-column-gap":               "<length-percentage>",
+	"grid-column-start":             "<grid-line>",
+	"grid-gap":                      "<'grid-row-gap'> <'grid-column-gap'>?",
+	"grid-row":                      "<grid-line> [ / <grid-line> ]?",
+	"grid-row-end":                  "<grid-line>",
+	"grid-row-gap":                  "<length-percentage>",
+	"grid-row-start":                "<grid-line>",
+	"grid-template":                 "none | [ <'grid-template-rows'> / <'grid-template-columns'> ] | [ <line-names>? <string> <track-size>? <line-names>? ]+ [ / <explicit-track-list> ]?",
+	"grid-template-areas":           "none | <string>+",
+	"grid-template-columns":         "none | <track-list> | <auto-track-list> | subgrid <line-name-list>?",
+	"grid-template-rows":            "none | <track-list> | <auto-track-list> | subgrid <line-name-list>?",
+	"hanging-punctuation":           "none | [ first || [ force-end | allow-end ] || last ]",
+	"height":                        "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"hyphenate-character":           "auto | <string>",
+	"hyphenate-limit-chars":         "[ auto | <integer> ]{1,3}",
+	"hyphens":                       "none | manual | auto",
+	"image-orientation":             "from-image | <angle> | [ <angle>? flip ]",
+	"image-rendering":               "auto | crisp-edges | pixelated | smooth",
+	"image-resolution":              "[ from-image || <resolution> ] && snap?",
+	"ime-mode":                      "auto | normal | active | inactive | disabled",
+	"initial-letter":                "normal | [ <number> <integer>? ]",
+	"initial-letter-align":          "[ auto | alphabetic | hanging | ideographic ]",
+	"inline-size":                   "<'width'>",
+	"inset":                         "<'top'>{1,4}",
+	"inset-block":                   "<'top'>{1,2}",
+	"inset-block-end":               "<'top'>",
+	"inset-block-start":             "<'top'>",
+	"inset-inline":                  "<'top'>{1,2}",
+	"inset-inline-end":              "<'top'>",
+	"inset-inline-start":            "<'top'>",
+	"interpolate-size":              "numeric-only | allow-keywords",
+	"isolation":                     "auto | isolate",
+	"justify-content":               "normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ]",
+	"justify-items":                 "normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | legacy | legacy && [ left | right | center ] | anchor-center",
+	"justify-self":                  "auto | normal | stretch | <baseline-position> | <overflow-position>? [ <self-position> | left | right ] | anchor-center",
+	"justify-tracks":                "[ normal | <content-distribution> | <overflow-position>? [ <content-position> | left | right ] ]#",
+	"left":                          "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+	"letter-spacing":                "normal | <length>",
+	"lighting-color":                "<color>",
+	"line-break":                    "auto | loose | normal | strict | anywhere",
+	"line-clamp":                    "none | <integer>",
+	"line-height":                   "normal | <number> | <length> | <percentage>",
+	"line-height-step":              "<length>",
+	"list-style":                    "<'list-style-type'> || <'list-style-position'> || <'list-style-image'>",
+	"list-style-image":              "<image> | none",
+	"list-style-position":           "inside | outside",
+	"list-style-type":               "<counter-style> | <string> | none",
+	"margin":                        "<'margin-top'>{1,4}",
+	"margin-block":                  "<'margin-top'>{1,2}",
+	"margin-block-end":              "<'margin-top'>",
+	"margin-block-start":            "<'margin-top'>",
+	"margin-bottom":                 "<length-percentage> | auto | <anchor-size()>",
+	"margin-inline":                 "<'margin-top'>{1,2}",
+	"margin-inline-end":             "<'margin-top'>",
+	"margin-inline-start":           "<'margin-top'>",
+	"margin-left":                   "<length-percentage> | auto | <anchor-size()>",
+	"margin-right":                  "<length-percentage> | auto | <anchor-size()>",
+	"margin-top":                    "<length-percentage> | auto | <anchor-size()>",
+	"margin-trim":                   "none | in-flow | all",
+	"marker":                        "none | <url>",
+	"marker-end":                    "none | <url>",
+	"marker-mid":                    "none | <url>",
+	"marker-start":                  "none | <url>",
+	"mask":                          "<mask-layer>#",
+	"mask-border":                   "<'mask-border-source'> || <'mask-border-slice'> [ / <'mask-border-width'>? [ / <'mask-border-outset'> ]? ]? || <'mask-border-repeat'> || <'mask-border-mode'>",
+	"mask-border-mode":              "luminance | alpha",
+	"mask-border-outset":            "[ <length> | <number> ]{1,4}",
+	"mask-border-repeat":            "[ stretch | repeat | round | space ]{1,2}",
+	"mask-border-slice":             "<number-percentage>{1,4} fill?",
+	"mask-border-source":            "none | <image>",
+	"mask-border-width":             "[ <length-percentage> | <number> | auto ]{1,4}",
+	"mask-clip":                     "[ <coord-box> | no-clip ]#",
+	"mask-composite":                "<compositing-operator>#",
+	"mask-image":                    "<mask-reference>#",
+	"mask-mode":                     "<masking-mode>#",
+	"mask-origin":                   "<coord-box>#",
+	"mask-position":                 "<position>#",
+	"mask-repeat":                   "<repeat-style>#",
+	"mask-size":                     "<bg-size>#",
+	"mask-type":                     "luminance | alpha",
+	"masonry-auto-flow":             "[ pack | next ] || [ definite-first | ordered ]",
+	"math-depth":                    "auto-add | add(<integer>) | <integer>",
+	"math-shift":                    "normal | compact",
+	"math-style":                    "normal | compact",
+	"max-block-size":                "<'max-width'>",
+	"max-height":                    "none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"max-inline-size":               "<'max-width'>",
+	"max-lines":                     "none | <integer>",
+	"max-width":                     "none | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"min-block-size":                "<'min-width'>",
+	"min-height":                    "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"min-inline-size":               "<'min-width'>",
+	"min-width":                     "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"mix-blend-mode":                "<blend-mode> | plus-darker | plus-lighter",
+	"object-fit":                    "fill | contain | cover | none | scale-down",
+	"object-position":               "<position>",
+	"object-view-box":               "none | <basic-shape-rect>",
+	"offset":                        "[ <'offset-position'>? [ <'offset-path'> [ <'offset-distance'> || <'offset-rotate'> ]? ]? ]! [ / <'offset-anchor'> ]?",
+	"offset-anchor":                 "auto | <position>",
+	"offset-distance":               "<length-percentage>",
+	"offset-path":                   "none | <offset-path> || <coord-box>",
+	"offset-position":               "normal | auto | <position>",
+	"offset-rotate":                 "[ auto | reverse ] || <angle>",
+	"opacity":                       "<opacity-value>",
+	"order":                         "<integer>",
+	"orphans":                       "<integer>",
+	"outline":                       "<'outline-width'> || <'outline-style'> || <'outline-color'>",
+	"outline-color":                 "auto | <color>",
+	"outline-offset":                "<length>",
+	"outline-style":                 "auto | <outline-line-style>",
+	"outline-width":                 "<line-width>",
+	"overflow":                      "[ visible | hidden | clip | scroll | auto ]{1,2}",
+	"overflow-anchor":               "auto | none",
+	"overflow-block":                "visible | hidden | clip | scroll | auto",
+	"overflow-clip-box":             "padding-box | content-box",
+	"overflow-clip-margin":          "<visual-box> || <length [0,∞]>",
+	"overflow-inline":               "visible | hidden | clip | scroll | auto",
+	"overflow-wrap":                 "normal | break-word | anywhere",
+	"overflow-x":                    "visible | hidden | clip | scroll | auto",
+	"overflow-y":                    "visible | hidden | clip | scroll | auto",
+	"overlay":                       "none | auto",
+	"overscroll-behavior":           "[ contain | none | auto ]{1,2}",
+	"overscroll-behavior-block":     "contain | none | auto",
+	"overscroll-behavior-inline":    "contain | none | auto",
+	"overscroll-behavior-x":         "contain | none | auto",
+	"overscroll-behavior-y":         "contain | none | auto",
+	"padding":                       "<'padding-top'>{1,4}",
+	"padding-block":                 "<'padding-top'>{1,2}",
+	"padding-block-end":             "<'padding-top'>",
+	"padding-block-start":           "<'padding-top'>",
+	"padding-bottom":                "<length-percentage [0,∞]>",
+	"padding-inline":                "<'padding-top'>{1,2}",
+	"padding-inline-end":            "<'padding-top'>",
+	"padding-inline-start":          "<'padding-top'>",
+	"padding-left":                  "<length-percentage [0,∞]>",
+	"padding-right":                 "<length-percentage [0,∞]>",
+	"padding-top":                   "<length-percentage [0,∞]>",
+	"page":                          "auto | <custom-ident>",
+	"page-break-after":              "auto | always | avoid | left | right | recto | verso",
+	"page-break-before":             "auto | always | avoid | left | right | recto | verso",
+	"page-break-inside":             "auto | avoid",
+	"paint-order":                   "normal | [ fill || stroke || markers ]",
+	"perspective":                   "none | <length>",
+	"perspective-origin":            "<position>",
+	"place-content":                 "<'align-content'> <'justify-content'>?",
+	"place-items":                   "<'align-items'> <'justify-items'>?",
+	"place-self":                    "<'align-self'> <'justify-self'>?",
+	"pointer-events":                "auto | none | visiblePainted | visibleFill | visibleStroke | visible | painted | fill | stroke | all | inherit",
+	"position":                      "static | relative | absolute | sticky | fixed",
+	"position-anchor":               "auto | <anchor-name>",
+	"position-area":                 "none | <position-area>",
+	"position-try":                  "<'position-try-order'>? <'position-try-fallbacks'>",
+	"position-try-fallbacks":        "none | [ [<dashed-ident> || <try-tactic>] | <'position-area'> ]#",
+	"position-try-order":            "normal | <try-size>",
+	"position-visibility":           "always | [ anchors-valid || anchors-visible || no-overflow ]",
+	"print-color-adjust":            "economy | exact",
+	"quotes":                        "none | auto | [ <string> <string> ]+",
+	"r":                             "<length> | <percentage>",
+	"resize":                        "none | both | horizontal | vertical | block | inline",
+	"right":                         "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+	"rotate":                        "none | <angle> | [ x | y | z | <number>{3} ] && <angle>",
+	"row-gap":                       "normal | <length-percentage>",
+	"ruby-align":                    "start | center | space-between | space-around",
+	"ruby-merge":                    "separate | collapse | auto",
+	"ruby-overhang":                 "auto | none",
+	"ruby-position":                 "[ alternate || [ over | under ] ] | inter-character",
+	"rx":                            "<length> | <percentage>",
+	"ry":                            "<length> | <percentage>",
+	"scale":                         "none | [ <number> | <percentage> ]{1,3}",
+	"scroll-behavior":               "auto | smooth",
+	"scroll-initial-target":         "none | nearest",
+	"scroll-margin":                 "<length>{1,4}",
+	"scroll-margin-block":           "<length>{1,2}",
+	"scroll-margin-block-end":       "<length>",
+	"scroll-margin-block-start":     "<length>",
+	"scroll-margin-bottom":          "<length>",
+	"scroll-margin-inline":          "<length>{1,2}",
+	"scroll-margin-inline-end":      "<length>",
+	"scroll-margin-inline-start":    "<length>",
+	"scroll-margin-left":            "<length>",
+	"scroll-margin-right":           "<length>",
+	"scroll-margin-top":             "<length>",
+	"scroll-padding":                "[ auto | <length-percentage> ]{1,4}",
+	"scroll-padding-block":          "[ auto | <length-percentage> ]{1,2}",
+	"scroll-padding-block-end":      "auto | <length-percentage>",
+	"scroll-padding-block-start":    "auto | <length-percentage>",
+	"scroll-padding-bottom":         "auto | <length-percentage>",
+	"scroll-padding-inline":         "[ auto | <length-percentage> ]{1,2}",
+	"scroll-padding-inline-end":     "auto | <length-percentage>",
+	"scroll-padding-inline-start":   "auto | <length-percentage>",
+	"scroll-padding-left":           "auto | <length-percentage>",
+	"scroll-padding-right":          "auto | <length-percentage>",
+	"scroll-padding-top":            "auto | <length-percentage>",
+	"scroll-snap-align":             "[ none | start | end | center ]{1,2}",
+	"scroll-snap-coordinate":        "none | <position>#",
+	"scroll-snap-destination":       "<position>",
+	"scroll-snap-points-x":          "none | repeat( <length-percentage> )",
+	"scroll-snap-points-y":          "none | repeat( <length-percentage> )",
+	"scroll-snap-stop":              "normal | always",
+	"scroll-snap-type":              "none | [ x | y | block | inline | both ] [ mandatory | proximity ]?",
+	"scroll-snap-type-x":            "none | mandatory | proximity",
+	"scroll-snap-type-y":            "none | mandatory | proximity",
+	"scroll-timeline":               "[ <'scroll-timeline-name'> <'scroll-timeline-axis'>? ]#",
+	"scroll-timeline-axis":          "[ block | inline | x | y ]#",
+	"scroll-timeline-name":          "[ none | <dashed-ident> ]#",
+	"scrollbar-color":               "auto | <color>{2}",
+	"scrollbar-gutter":              "auto | stable && both-edges?",
+	"scrollbar-width":               "auto | thin | none",
+	"shape-image-threshold":         "<opacity-value>",
+	"shape-margin":                  "<length-percentage>",
+	"shape-outside":                 "none | [ <shape-box> || <basic-shape> ] | <image>",
+	"shape-rendering":               "auto | optimizeSpeed | crispEdges | geometricPrecision",
+	"speak-as":                      "normal | spell-out || digits || [ literal-punctuation | no-punctuation ]",
+	"stop-color":                    "<'color'>",
+	"stop-opacity":                  "<'opacity'>",
+	"stroke":                        "<paint>",
+	"stroke-color":                  "<color>",
+	"stroke-dasharray":              "none | <dasharray>",
+	"stroke-dashoffset":             "<length-percentage> | <number>",
+	"stroke-linecap":                "butt | round | square",
+	"stroke-linejoin":               "miter | miter-clip | round | bevel | arcs",
+	"stroke-miterlimit":             "<number>",
+	"stroke-opacity":                "<'opacity'>",
+	"stroke-width":                  "<length-percentage> | <number>",
+	"tab-size":                      "<integer> | <length>",
+	"table-layout":                  "auto | fixed",
+	"text-align":                    "start | end | left | right | center | justify | match-parent",
+	"text-align-last":               "auto | start | end | left | right | center | justify",
+	"text-anchor":                   "start | middle | end",
+	"text-autospace":                "normal | <autospace> | auto",
+	"text-box":                      "normal | <'text-box-trim'> || <'text-box-edge'>",
+	"text-box-edge":                 "auto | <text-edge>",
+	"text-box-trim":                 "none | trim-start | trim-end | trim-both",
+	"text-combine-upright":          "none | all | [ digits <integer>? ]",
+	"text-decoration":               "<'text-decoration-line'> || <'text-decoration-style'> || <'text-decoration-color'> || <'text-decoration-thickness'>",
+	"text-decoration-color":         "<color>",
+	"text-decoration-line":          "none | [ underline || overline || line-through || blink ] | spelling-error | grammar-error",
+	"text-decoration-skip":          "none | [ objects || [ spaces | [ leading-spaces || trailing-spaces ] ] || edges || box-decoration ]",
+	"text-decoration-skip-ink":      "auto | all | none",
+	"text-decoration-style":         "solid | double | dotted | dashed | wavy",
+	"text-decoration-thickness":     "auto | from-font | <length> | <percentage> ",
+	"text-emphasis":                 "<'text-emphasis-style'> || <'text-emphasis-color'>",
+	"text-emphasis-color":           "<color>",
+	"text-emphasis-position":        "auto | [ over | under ] && [ right | left ]?",
+	"text-emphasis-style":           "none | [ [ filled | open ] || [ dot | circle | double-circle | triangle | sesame ] ] | <string>",
+	"text-indent":                   "<length-percentage> && hanging? && each-line?",
+	"text-justify":                  "auto | inter-character | inter-word | none",
+	"text-orientation":              "mixed | upright | sideways",
+	"text-overflow":                 "[ clip | ellipsis | <string> ]{1,2}",
+	"text-rendering":                "auto | optimizeSpeed | optimizeLegibility | geometricPrecision",
+	"text-shadow":                   "none | <shadow-t>#",
+	"text-size-adjust":              "none | auto | <percentage>",
+	"text-spacing-trim":             "space-all | normal | space-first | trim-start",
+	"text-transform":                "none | [ capitalize | uppercase | lowercase ] || full-width || full-size-kana | math-auto",
+	"text-underline-offset":         "auto | <length> | <percentage> ",
+	"text-underline-position":       "auto | from-font | [ under || [ left | right ] ]",
+	"text-wrap":                     "<'text-wrap-mode'> || <'text-wrap-style'>",
+	"text-wrap-mode":                "wrap | nowrap",
+	"text-wrap-style":               "auto | balance | stable | pretty",
+	"timeline-scope":                "none | <dashed-ident>#",
+	"top":                           "auto | <length-percentage> | <anchor()> | <anchor-size()>",
+	"touch-action":                  "auto | none | [ [ pan-x | pan-left | pan-right ] || [ pan-y | pan-up | pan-down ] || pinch-zoom ] | manipulation",
+	"transform":                     "none | <transform-list>",
+	"transform-box":                 "content-box | border-box | fill-box | stroke-box | view-box",
+	"transform-origin":              "[ <length-percentage> | left | center | right | top | bottom ] | [ [ <length-percentage> | left | center | right ] && [ <length-percentage> | top | center | bottom ] ] <length>?",
+	"transform-style":               "flat | preserve-3d",
+	"transition":                    "<single-transition>#",
+	"transition-behavior":           "<transition-behavior-value>#",
+	"transition-delay":              "<time>#",
+	"transition-duration":           "<time>#",
+	"transition-property":           "none | <single-transition-property>#",
+	"transition-timing-function":    "<easing-function>#",
+	"translate":                     "none | <length-percentage> [ <length-percentage> <length>? ]?",
+	"unicode-bidi":                  "normal | embed | isolate | bidi-override | isolate-override | plaintext",
+	"user-select":                   "auto | text | none | all",
+	"vector-effect":                 "none | non-scaling-stroke | non-scaling-size | non-rotation | fixed-position",
+	"vertical-align":                "baseline | sub | super | text-top | text-bottom | middle | top | bottom | <percentage> | <length>",
+	"view-timeline":                 "[ <'view-timeline-name'> [ <'view-timeline-axis'> || <'view-timeline-inset'> ]? ]#",
+	"view-timeline-axis":            "[ block | inline | x | y ]#",
+	"view-timeline-inset":           "[ [ auto | <length-percentage> ]{1,2} ]#",
+	"view-timeline-name":            "[ none | <dashed-ident> ]#",
+	"view-transition-class":         "none | <custom-ident>+",
+	"view-transition-name":          "none | <custom-ident> | match-element",
+	"visibility":                    "visible | hidden | collapse",
+	"white-space":                   "normal | pre | pre-wrap | pre-line | <'white-space-collapse'> || <'text-wrap-mode'>",
+	"white-space-collapse":          "collapse | preserve | preserve-breaks | preserve-spaces | break-spaces",
+	"widows":                        "<integer>",
+	"width":                         "auto | <length-percentage [0,∞]> | min-content | max-content | fit-content | fit-content(<length-percentage [0,∞]>) | <calc-size()> | <anchor-size()>",
+	"will-change":                   "auto | <animateable-feature>#",
+	"word-break":                    "normal | break-all | keep-all | break-word | auto-phrase",
+	"word-spacing":                  "normal | <length>",
+	"word-wrap":                     "normal | break-word",
+	"writing-mode":                  "horizontal-tb | vertical-rl | vertical-lr | sideways-rl | sideways-lr",
+	"x":                             "<length> | <percentage>",
+	"y":                             "<length> | <percentage>",
+	"z-index":                       "auto | <integer>",
+	"zoom":                          "normal | reset | <number [0,∞]> || <percentage [0,∞]>",
+}
+
+// types maps data type names to their value definition syntax.
+var types = map[string]string{
+	"abs()":                            "abs( <calc-sum> )",
+	"absolute-size":                    "xx-small | x-small | small | medium | large | x-large | xx-large | xxx-large",
+	"acos()":                           "acos( <calc-sum> )",
+	"alpha-value":                      "<number> | <percentage>",
+	"an+b":                             "odd | even | <integer> | <n-dimension> | '+'?† n | -n | <ndashdigit-dimension> | '+'?† <ndashdigit-ident> | <dashndashdigit-ident> | <n-dimension> <signed-integer> | '+'?† n <signed-integer> | -n <signed-integer> | <ndash-dimension> <signless-integer> | '+'?† n- <signless-integer> | -n- <signless-integer> | <n-dimension> ['+' | '-'] <signless-integer> | '+'?† n ['+' | '-'] <signless-integer> | -n ['+' | '-'] <signless-integer>",
+	"anchor()":                         "anchor( <anchor-name>? && <anchor-side>, <length-percentage>? )",
+	"anchor-name":                      "<dashed-ident>",
+	"anchor-side":                      "inside | outside | top | left | right | bottom | start | end | self-start | self-end | <percentage> | center",
+	"anchor-size":                      "width | height | block | inline | self-block | self-inline",
+	"anchor-size()":                    "anchor-size( [ <anchor-name> || <anchor-size> ]? , <length-percentage>? )",
+	"angle-percentage":                 "<angle> | <percentage>",
+	"angular-color-hint":               "<angle-percentage> | <zero>",
+	"angular-color-stop":               "<color> <color-stop-angle>?",
+	"angular-color-stop-list":          "<angular-color-stop> , [ <angular-color-hint>? , <angular-color-stop> ]#?",
+	"animateable-feature":              "scroll-position | contents | <custom-ident>",
+	"asin()":                           "asin( <calc-sum> )",
+	"atan()":                           "atan( <calc-sum> )",
+	"atan2()":                          "atan2( <calc-sum>, <calc-sum> )",
+	"attachment":                       "scroll | fixed | local",
+	"attr()":                           "attr( <attr-name> <type-or-unit>? [, <attr-fallback> ]? )",
+	"attr-matcher":                     "[ '~' | '|' | '^' | '$' | '*' ]? '='",
+	"attr-modifier":                    "i | s",
+	"attribute-selector":               "'[' <wq-name> ']' | '[' <wq-name> <attr-matcher> [ <string-token> | <ident-token> ] <attr-modifier>? ']'",
+	"auto-repeat":                      "repeat( [ auto-fill | auto-fit ] , [ <line-names>? <fixed-size> ]+ <line-names>? )",
+	"auto-track-list":                  "[ <line-names>? [ <fixed-size> | <fixed-repeat> ] ]* <line-names>? <auto-repeat>\n[ <line-names>? [ <fixed-size> | <fixed-repeat> ] ]* <line-names>?",
+	"axis":                             "block | inline | x | y",
+	"baseline-position":                "[ first | last ]? baseline",
+	"basic-shape":                      "<inset()> | <xywh()> | <rect()> | <circle()> | <ellipse()> | <polygon()> | <path()>",
+	"basic-shape-rect":                 "<inset()> | <rect()> | <xywh()>",
+	"bg-clip":                          "<visual-box> | border-area | text",
+	"bg-image":                         "<image> | none",
+	"bg-layer":                         "<bg-image> || <bg-position> [ / <bg-size> ]? || <repeat-style> || <attachment> || <visual-box> || <visual-box>",
+	"bg-position":                      "[ [ left | center | right | top | bottom | <length-percentage> ] | [ left | center | right | <length-percentage> ] [ top | center | bottom | <length-percentage> ] | [ center | [ left | right ] <length-percentage>? ] && [ center | [ top | bottom ] <length-percentage>? ] ]",
+	"bg-size":                          "[ <length-percentage [0,∞]> | auto ]{1,2} | cover | contain",
+	"blend-mode":                       "normal | multiply | screen | overlay | darken | lighten | color-dodge | color-burn | hard-light | soft-light | difference | exclusion | hue | saturation | color | luminosity",
+	"blur()":                           "blur( <length>? )",
+	"brightness()":                     "brightness( [ <number> | <percentage> ]? )",
+	"calc()":                           "calc( <calc-sum> )",
+	"calc-constant":                    "e | pi | infinity | -infinity | NaN",
+	"calc-product":                     "<calc-value> [ '*' <calc-value> | '/' <number> ]*",
+	"calc-size()":                      "calc-size( <calc-size-basis>, <calc-sum> )",
+	"calc-size-basis":                  "<intrinsic-size-keyword> | <calc-size()> | any | <calc-sum>",
+	"calc-sum":                         "<calc-product> [ [ '+' | '-' ] <calc-product> ]*",
+	"calc-value":                       "<number> | <dimension> | <percentage> | <calc-constant> | ( <calc-sum> )",
+	"cf-final-image":                   "<image> | <color>",
+	"cf-mixing-image":                  "<percentage>? && <image>",
+	"circle()":                         "circle( <radial-size>? [ at <position> ]? )",
+	"clamp()":                          "clamp( <calc-sum>#{3} )",
+	"class-selector":                   "'.' <ident-token>",
+	"clip-source":                      "<url>",
+	"color":                            "<color-base> | currentColor | <system-color> | <light-dark()> | <deprecated-system-color>",
+	"color()":                          "color( [ from <color> ]? <colorspace-params> [ / [ <alpha-value> | none ] ]? )",
+	"color-base":                       "<hex-color> | <color-function> | <named-color> | <color-mix()> | transparent",
+	"color-function":                   "<rgb()> | <rgba()> | <hsl()> | <hsla()> | <hwb()> | <lab()> | <lch()> | <oklab()> | <oklch()> | <color()>",
+	"color-interpolation-method":       "in [ <rectangular-color-space> | <polar-color-space> <hue-interpolation-method>? | <custom-color-space> ]",
+	"color-mix()":                      "color-mix( <color-interpolation-method> , [ <color> && <percentage [0,100]>? ]#{2})",
+	"color-stop":                       "<color-stop-length> | <color-stop-angle>",
+	"color-stop-angle":                 "[ <angle-percentage> | <zero> ]{1,2}",
+	"color-stop-length":                "<length-percentage>{1,2}",
+	"color-stop-list":                  "<linear-color-stop> , [ <linear-color-hint>? , <linear-color-stop> ]#?",
+	"colorspace-params":                "[<custom-params> | <predefined-rgb-params> | <xyz-params>]",
+	"combinator":                       "'>' | '+' | '~' | [ '||' ]",
+	"common-lig-values":                "[ common-ligatures | no-common-ligatures ]",
+	"compat-auto":                      "searchfield | textarea | checkbox | radio | menulist | listbox | meter | progress-bar | button",
+	"compat-special":                   "textfield | menulist-button",
+	"complex-selector":                 "<compound-selector> [ <combinator>? <compound-selector> ]*",
+	"complex-selector-list":            "<complex-selector>#",
+	"composite-style":                  "clear | copy | source-over | source-in | source-out | source-atop | destination-over | destination-in | destination-out | destination-atop | xor",
+	"compositing-operator":             "add | subtract | intersect | exclude",
+	"compound-selector":                "[ <type-selector>? <subclass-selector>* [ <pseudo-element-selector> <pseudo-class-selector>* ]* ]!",
+	"compound-selector-list":           "<compound-selector>#",
+	"conic-gradient()":                 "conic-gradient( [ <conic-gradient-syntax> ] )",
+	"conic-gradient-syntax":            "[ [ [ from [ <angle> | <zero> ] ]? [ at <position> ]? ] || <color-interpolation-method> ]? , <angular-color-stop-list>",
+	"container-condition":              "[ <container-name>? <container-query>? ]!",
+	"container-name":                   "<custom-ident>",
+	"container-query":                  "not <query-in-parens> | <query-in-parens> [ [ and <query-in-parens> ]* | [ or <query-in-parens> ]* ]",
+	"content-distribution":             "space-between | space-around | space-evenly | stretch",
+	"content-list":                     "[ <string> | <image | <attr()> | <quote> | <counter> ]+ ",
+	"content-position":                 "center | start | end | flex-start | flex-end",
+	"content-replacement":              "<image>",
+	"contextual-alt-values":            "[ contextual | no-contextual ]",
+	"contrast()":                       "contrast( [ <number> | <percentage> ]? )",
+	"coord-box":                        "<paint-box> | view-box",
+	"cos()":                            "cos( <calc-sum> )",
+	"counter":                          "<counter()> | <counters()>",
+	"counter()":                        "counter( <counter-name>, <counter-style>? )",
+	"counter-name":                     "<custom-ident>",
+	"counter-style":                    "<counter-style-name> | symbols()",
+	"counter-style-name":               "<custom-ident>",
+	"counters()":                       "counters( <counter-name>, <string>, <counter-style>? )",
+	"cross-fade()":                     "cross-fade( <cf-mixing-image> , <cf-final-image>? )",
+	"cubic-bezier()":                   "cubic-bezier( [ <number [0,1]>, <number> ]#{2} )",
+	"cubic-bezier-easing-function":     "ease | ease-in | ease-out | ease-in-out | <cubic-bezier()>",
+	"custom-color-space":               "<dashed-ident>",
+	"custom-params":                    "<dashed-ident> [ <number> | <percentage> | none ]+",
+	"dasharray":                        "[ [ <length-percentage> | <number> ]+ ]#",
+	"dashndashdigit-ident":             "<ident-token>",
+	"deprecated-system-color":          "ActiveBorder | ActiveCaption | AppWorkspace | Background | ButtonHighlight | ButtonShadow | CaptionText | InactiveBorder | InactiveCaption | InactiveCaptionText | InfoBackground | InfoText | Menu | MenuText | Scrollbar | ThreeDDarkShadow | ThreeDFace | ThreeDHighlight | ThreeDLightShadow | ThreeDShadow | Window | WindowFrame | WindowText",
+	"discretionary-lig-values":         "[ discretionary-ligatures | no-discretionary-ligatures ]",
+	"display-box":                      "contents | none",
+	"display-inside":                   "flow | flow-root | table | flex | grid | ruby",
+	"display-internal":                 "table-row-group | table-header-group | table-footer-group | table-row | table-cell | table-column-group | table-column | table-caption | ruby-base | ruby-text | ruby-base-container | ruby-text-container",
+	"display-legacy":                   "inline-block | inline-list-item | inline-table | inline-flex | inline-grid",
+	"display-listitem":                 "<display-outside>? && [ flow | flow-root ]? && list-item",
+	"display-outside":                  "block | inline | run-in",
+	"drop-shadow()":                    "drop-shadow( [ <color>? && <length>{2,3} ] )",
+	"easing-function":                  "<linear-easing-function> | <cubic-bezier-easing-function> | <step-easing-function>",
+	"east-asian-variant-values":        "[ jis78 | jis83 | jis90 | jis04 | simplified | traditional ]",
+	"east-asian-width-values":          "[ full-width | proportional-width ]",
+	"element()":                        "element( <id-selector> )",
+	"ellipse()":                        "ellipse( <radial-size>? [ at <position> ]? )",
+	"env()":                            "env( <custom-ident> , <declaration-value>? )",
+	"exp()":                            "exp( <calc-sum> )",
+	"explicit-track-list":              "[ <line-names>? <track-size> ]+ <line-names>?",
+	"family-name":                      "<string> | <custom-ident>+",
+	"feature-tag-value":                "<string> [ <integer> | on | off ]?",
+	"feature-type":                     "@stylistic | @historical-forms | @styleset | @character-variant | @swash | @ornaments | @annotation",
+	"feature-value-block":              "<feature-type> '{' <feature-value-declaration-list> '}'",
+	"feature-value-block-list":         "<feature-value-block>+",
+	"feature-value-declaration":        "<custom-ident>: <integer>+;",
+	"feature-value-declaration-list":   "<feature-value-declaration>",
+	"feature-value-name":               "<custom-ident>",
+	"filter-function":                  "<blur()> | <brightness()> | <contrast()> | <drop-shadow()> | <grayscale()> | <hue-rotate()> | <invert()> | <opacity()> | <saturate()> | <sepia()>",
+	"filter-value-list":                "[ <filter-function> | <url> ]+",
+	"final-bg-layer":                   "<bg-image> || <bg-position> [ / <bg-size> ]? || <repeat-style> || <attachment> || <visual-box> || <visual-box> || <'background-color'>",
+	"fit-content()":                    "fit-content( <length-percentage [0,∞]> )",
+	"fixed-breadth":                    "<length-percentage>",
+	"fixed-repeat":                     "repeat( [ <integer [1,∞]> ] , [ <line-names>? <fixed-size> ]+ <line-names>? )",
+	"fixed-size":                       "<fixed-breadth> | minmax( <fixed-breadth> , <track-breadth> ) | minmax( <inflexible-breadth> , <fixed-breadth> )",
+	"font-stretch-absolute":            "normal | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded | <percentage>",
+	"font-variant-css2":                "normal | small-caps",
+	"font-weight-absolute":             "normal | bold | <number [1,1000]>",
+	"font-width-css3":                  "normal | ultra-condensed | extra-condensed | condensed | semi-condensed | semi-expanded | expanded | extra-expanded | ultra-expanded",
+	"form-control-identifier":          "select",
+	"frequency-percentage":             "<frequency> | <percentage>",
+	"general-enclosed":                 "[ <function-token> <any-value> ) ] | ( <ident> <any-value> )",
+	"generic-complete":                 "serif | sans-serif | system-ui | cursive | fantasy | math | monospace",
+	"generic-family":                   "<generic-complete> | <generic-incomplete> | emoji | fangsong",
+	"generic-incomplete":               "ui-serif | ui-sans-serif | ui-monospace | ui-rounded",
+	"geometry-box":                     "<shape-box> | fill-box | stroke-box | view-box",
+	"gradient":                         "<linear-gradient()> | <repeating-linear-gradient()> | <radial-gradient()> | <repeating-radial-gradient()> | <conic-gradient()> | <repeating-conic-gradient()>",
+	"grayscale()":                      "grayscale( [ <number> | <percentage> ]? )",
+	"grid-line":                        "auto | <custom-ident> | [ <integer> && <custom-ident>? ] | [ span && [ <integer> || <custom-ident> ] ]",
+	"historical-lig-values":            "[ historical-ligatures | no-historical-ligatures ]",
+	"hsl()":                            "hsl( <hue>, <percentage>, <percentage>, <alpha-value>? ) | hsl( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? )",
+	"hsla()":                           "hsla( <hue>, <percentage>, <percentage>, <alpha-value>? ) | hsla( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? )",
+	"hue":                              "<number> | <angle>",
+	"hue-interpolation-method":         "[ shorter | longer | increasing | decreasing ] hue",
+	"hue-rotate()":                     "hue-rotate( [ <angle> | <zero> ]? )",
+	"hwb()":                            "hwb( [ <hue> | none ] [ <percentage> | <number> | none ] [ <percentage> | <number> | none ] [ / [ <alpha-value> | none ] ]? )",
+	"hypot()":                          "hypot( <calc-sum># )",
+	"id-selector":                      "<hash-token>",
+	"image":                            "<url> | <image()> | <image-set()> | <element()> | <paint()> | <cross-fade()> | <gradient>",
+	"image()":                          "image( <image-tags>? [ <image-src>? , <color>? ]! )",
+	"image-set()":                      "image-set( <image-set-option># )",
+	"image-set-option":                 "[ <image> | <string> ] [ <resolution> || type(<string>) ]",
+	"image-src":                        "<url> | <string>",
+	"image-tags":                       "ltr | rtl",
+	"inflexible-breadth":               "<length-percentage> | min-content | max-content | auto",
+	"inset()":                          "inset( <length-percentage>{1,4} [ round <'border-radius'> ]? )",
+	"integer":                          "<number-token>",
+	"invert()":                         "invert( [ <number> | <percentage> ]? )",
+	"keyframe-block":                   "<keyframe-selector># {\n  <declaration-list>\n}",
+	"keyframe-selector":                "from | to | <percentage [0,100]> | <timeline-range-name> <percentage>",
+	"keyframes-name":                   "<custom-ident> | <string>",
+	"lab()":                            "lab( [<percentage> | <number> | none] [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ / [<alpha-value> | none] ]? )",
+	"layer()":                          "layer( <layer-name> )",
+	"layer-name":                       "<ident> [ '.' <ident> ]*",
+	"lch()":                            "lch( [<percentage> | <number> | none] [ <percentage> | <number> | none] [ <hue> | none] [ / [<alpha-value> | none] ]? )",
+	"leader()":                         "leader( <leader-type> )",
+	"leader-type":                      "dotted | solid | space | <string>",
+	"length-percentage":                "<length> | <percentage>",
+	"light-dark()":                     "light-dark( <color>, <color> )",
+	"line-name-list":                   "[ <line-names> | <name-repeat> ]+",
+	"line-names":                       "'[' <custom-ident>* ']'",
+	"line-style":                       "none | hidden | dotted | dashed | solid | double | groove | ridge | inset | outset",
+	"line-width":                       "<length> | thin | medium | thick",
+	"linear()":                         "linear( [ <number> && <percentage>{0,2} ]# )",
+	"linear-color-hint":                "<length-percentage>",
+	"linear-color-stop":                "<color> <color-stop-length>?",
+	"linear-easing-function":           "linear | <linear()>",
+	"linear-gradient()":                "linear-gradient( [ <linear-gradient-syntax> ] )",
+	"linear-gradient-syntax":           "[ [ <angle> | <zero> | to <side-or-corner> ] || <color-interpolation-method> ]? , <color-stop-list>",
+	"log()":                            "log( <calc-sum>, <calc-sum>? )",
+	"mask-layer":                       "<mask-reference> || <position> [ / <bg-size> ]? || <repeat-style> || <geometry-box> || [ <geometry-box> | no-clip ] || <compositing-operator> || <masking-mode>",
+	"mask-position":                    "[ <length-percentage> | left | center | right ] [ <length-percentage> | top | center | bottom ]?",
+	"mask-reference":                   "none | <image> | <mask-source>",
+	"mask-source":                      "<url>",
+	"masking-mode":                     "alpha | luminance | match-source",
+	"matrix()":                         "matrix( <number>#{6} )",
+	"matrix3d()":                       "matrix3d( <number>#{16} )",
+	"max()":                            "max( <calc-sum># )",
+	"media-and":                        "<media-in-parens> [ and <media-in-parens> ]+",
+	"media-condition":                  "<media-not> | <media-and> | <media-or> | <media-in-parens>",
+	"media-condition-without-or":       "<media-not> | <media-and> | <media-in-parens>",
+	"media-feature":                    "( [ <mf-plain> | <mf-boolean> | <mf-range> ] )",
+	"media-in-parens":                  "( <media-condition> ) | <media-feature> | <general-enclosed>",
+	"media-not":                        "not <media-in-parens>",
+	"media-or":                         "<media-in-parens> [ or <media-in-parens> ]+",
+	"media-query":                      "<media-condition> | [ not | only ]? <media-type> [ and <media-condition-without-or> ]?",
+	"media-query-list":                 "<media-query>#",
+	"media-type":                       "<ident>",
+	"mf-boolean":                       "<mf-name>",
+	"mf-name":                          "<ident>",
+	"mf-plain":                         "<mf-name> : <mf-value>",
+	"mf-range":                         "<mf-name> [ '<' | '>' ]? '='? <mf-value>\n| <mf-value> [ '<' | '>' ]? '='? <mf-name>\n| <mf-value> '<' '='? <mf-name> '<' '='? <mf-value>\n| <mf-value> '>' '='? <mf-name> '>' '='? <mf-value>",
+	"mf-value":                         "<number> | <dimension> | <ident> | <ratio>",
+	"min()":                            "min( <calc-sum># )",
+	"minmax()":                         "minmax( [ <length-percentage> | min-content | max-content | auto ] , [ <length-percentage> | <flex> | min-content | max-content | auto ] )",
+	"mod()":                            "mod( <calc-sum>, <calc-sum> )",
+	"n-dimension":                      "<dimension-token>",
+	"name-repeat":                      "repeat( [ <integer [1,∞]> | auto-fill ], <line-names>+ )",
+	"named-color":                      "aliceblue | antiquewhite | aqua | aquamarine | azure | beige | bisque | black | blanchedalmond | blue | blueviolet | brown | burlywood | cadetblue | chartreuse | chocolate | coral | cornflowerblue | cornsilk | crimson | cyan | darkblue | darkcyan | darkgoldenrod | darkgray | darkgreen | darkgrey | darkkhaki | darkmagenta | darkolivegreen | darkorange | darkorchid | darkred | darksalmon | darkseagreen | darkslateblue | darkslategray | darkslategrey | darkturquoise | darkviolet | deeppink | deepskyblue | dimgray | dimgrey | dodgerblue | firebrick | floralwhite | forestgreen | fuchsia | gainsboro | ghostwhite | gold | goldenrod | gray | green | greenyellow | grey | honeydew | hotpink | indianred | indigo | ivory | khaki | lavender | lavenderblush | lawngreen | lemonchiffon | lightblue | lightcoral | lightcyan | lightgoldenrodyellow | lightgray | lightgreen | lightgrey | lightpink | lightsalmon | lightseagreen | lightskyblue | lightslategray | lightslategrey | lightsteelblue | lightyellow | lime | limegreen | linen | magenta | maroon | mediumaquamarine | mediumblue | mediumorchid | mediumpurple | mediumseagreen | mediumslateblue | mediumspringgreen | mediumturquoise | mediumvioletred | midnightblue | mintcream | mistyrose | moccasin | navajowhite | navy | oldlace | olive | olivedrab | orange | orangered | orchid | palegoldenrod | palegreen | paleturquoise | palevioletred | papayawhip | peachpuff | peru | pink | plum | powderblue | purple | rebeccapurple | red | rosybrown | royalblue | saddlebrown | salmon | sandybrown | seagreen | seashell | sienna | silver | skyblue | slateblue | slategray | slategrey | snow | springgreen | steelblue | tan | teal | thistle | tomato | turquoise | violet | wheat | white | whitesmoke | yellow | yellowgreen",
+	"namespace-prefix":                 "<ident>",
+	"ndash-dimension":                  "<dimension-token>",
+	"ndashdigit-dimension":             "<dimension-token>",
+	"ndashdigit-ident":                 "<ident-token>",
+	"ns-prefix":                        "[ <ident-token> | '*' ]? '|'",
+	"number-percentage":                "<number> | <percentage>",
+	"numeric-figure-values":            "[ lining-nums | oldstyle-nums ]",
+	"numeric-fraction-values":          "[ diagonal-fractions | stacked-fractions ]",
+	"numeric-spacing-values":           "[ proportional-nums | tabular-nums ]",
+	"offset-path":                      "<ray()> | <url> | <basic-shape>",
+	"oklab()":                          "oklab( [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ / [<alpha-value> | none] ]? )",
+	"oklch()":                          "oklch( [ <percentage> | <number> | none] [ <percentage> | <number> | none] [ <hue> | none] [ / [<alpha-value> | none] ]? )",
+	"opacity()":                        "opacity( [ <number> | <percentage> ]? )",
+	"opacity-value":                    "<number> | <percentage>",
+	"outline-line-style":               "none | dotted | dashed | solid | double | groove | ridge | inset | outset",
+	"outline-radius":                   "<length> | <percentage>",
+	"overflow-position":                "unsafe | safe",
+	"page-body":                        "<declaration>? [ ; <page-body> ]? | <page-margin-box> <page-body>",
+	"page-margin-box":                  "<page-margin-box-type> '{' <declaration-list> '}'",
+	"page-margin-box-type":             "@top-left-corner | @top-left | @top-center | @top-right | @top-right-corner | @bottom-left-corner | @bottom-left | @bottom-center | @bottom-right | @bottom-right-corner | @left-top | @left-middle | @left-bottom | @right-top | @right-middle | @right-bottom",
+	"page-selector":                    "<pseudo-page>+ | <ident> <pseudo-page>*",
+	"page-selector-list":               "[ <page-selector># ]?",
+	"page-size":                        "A5 | A4 | A3 | B5 | B4 | JIS-B5 | JIS-B4 | letter | legal | ledger",
+	"paint":                            "none | <color> | <url> [none | <color>]? | context-fill | context-stroke",
+	"paint()":                          "paint( <ident>, <declaration-value>? )",
+	"paint-box":                        "<visual-box> | fill-box | stroke-box",
+	"palette-identifier":               "<dashed-ident>",
+	"palette-mix()":                    "palette-mix(<color-interpolation-method> , [ [normal | light | dark | <palette-identifier> | <palette-mix()> ] && <percentage [0,100]>? ]#{2})",
+	"path()":                           "path( <'fill-rule'>? , <string> )",
+	"perspective()":                    "perspective( [ <length [0,∞]> | none ] )",
+	"polar-color-space":                "hsl | hwb | lch | oklch",
+	"polygon()":                        "polygon( <'fill-rule'>? , [ <length-percentage> <length-percentage> ]# )",
+	"position":                         "[ [ left | center | right ] || [ top | center | bottom ] | [ left | center | right | <length-percentage> ] [ top | center | bottom | <length-percentage> ]? | [ [ left | right ] <length-percentage> ] && [ [ top | bottom ] <length-percentage> ] ]",
+	"position-area":                    "[ left | center | right | span-left | span-right | x-start | x-end | span-x-start | span-x-end | x-self-start | x-self-end | span-x-self-start | span-x-self-end | span-all ] || [ top | center | bottom | span-top | span-bottom | y-start | y-end | span-y-start | span-y-end | y-self-start | y-self-end | span-y-self-start | span-y-self-end | span-all ] | [ block-start | center | block-end | span-block-start | span-block-end | span-all ] || [ inline-start | center | inline-end | span-inline-start | span-inline-end | span-all ] | [ self-block-start | center | self-block-end | span-self-block-start | span-self-block-end | span-all ] || [ self-inline-start | center | self-inline-end | span-self-inline-start | span-self-inline-end | span-all ] | [ start | center | end | span-start | span-end | span-all ]{1,2} | [ self-start | center | self-end | span-self-start | span-self-end | span-all ]{1,2}",
+	"pow()":                            "pow( <calc-sum>, <calc-sum> )",
+	"predefined-rgb":                   "srgb | srgb-linear | display-p3 | a98-rgb | prophoto-rgb | rec2020",
+	"predefined-rgb-params":            "<predefined-rgb> [ <number> | <percentage> | none ]{3}",
+	"pseudo-class-selector":            "':' <ident-token> | ':' <function-token> <any-value> ')'",
+	"pseudo-element-selector":          "':' <pseudo-class-selector>",
+	"pseudo-page":                      ": [ left | right | first | blank ]",
+	"query-in-parens":                  "( <container-query> ) | ( <size-feature> ) | style( <style-query> ) | scroll-state( <scroll-state-query> ) | <general-enclosed>",
+	"quote":                            "open-quote | close-quote | no-open-quote | no-close-quote",
+	"radial-extent":                    "closest-corner | closest-side | farthest-corner | farthest-side",
+	"radial-gradient()":                "radial-gradient( [ <radial-gradient-syntax> ] )",
+	"radial-gradient-syntax":           "[ [ [ <radial-shape> || <radial-size> ]? [ at <position> ]? ] || <color-interpolation-method> ]? , <color-stop-list>",
+	"radial-shape":                     "circle | ellipse",
+	"radial-size":                      "<radial-extent> | <length [0,∞]> | <length-percentage [0,∞]>{2}",
+	"ratio":                            "<number [0,∞]> [ / <number [0,∞]> ]?",
+	"ray()":                            "ray( <angle> && <ray-size>? && contain? && [at <position>]? )",
+	"ray-size":                         "closest-side | closest-corner | farthest-side | farthest-corner | sides",
+	"rect()":                           "rect( [ <length-percentage> | auto ]{4} [ round <'border-radius'> ]? )",
+	"rectangular-color-space":          "srgb | srgb-linear | display-p3 | a98-rgb | prophoto-rgb | rec2020 | lab | oklab | xyz | xyz-d50 | xyz-d65",
+	"relative-selector":                "<combinator>? <complex-selector>",
+	"relative-selector-list":           "<relative-selector>#",
+	"relative-size":                    "larger | smaller",
+	"rem()":                            "rem( <calc-sum>, <calc-sum> )",
+	"repeat-style":                     "repeat-x | repeat-y | [ repeat | space | round | no-repeat ]{1,2}",
+	"repeating-conic-gradient()":       "repeating-conic-gradient( [ <conic-gradient-syntax> ] )",
+	"repeating-linear-gradient()":      "repeating-linear-gradient( [ <linear-gradient-syntax> ] )",
+	"repeating-radial-gradient()":      "repeating-radial-gradient( [ <radial-gradient-syntax> ] )",
+	"reversed-counter-name":            "reversed( <counter-name> )",
+	"rgb()":                            "rgb( <percentage>#{3} , <alpha-value>? ) | rgb( <number>#{3} , <alpha-value>? ) | rgb( [ <number> | <percentage> | none ]{3} [ / [ <alpha-value> | none ] ]? )",
+	"rgba()":                           "rgba( <percentage>#{3} , <alpha-value>? ) | rgba( <number>#{3} , <alpha-value>? ) | rgba( [ <number> | <percentage> | none ]{3} [ / [ <alpha-value> | none ] ]? )",
+	"rotate()":                         "rotate( [ <angle> | <zero> ] )",
+	"rotate3d()":                       "rotate3d( <number> , <number> , <number> , [ <angle> | <zero> ] )",
+	"rotateX()":                        "rotateX( [ <angle> | <zero> ] )",
+	"rotateY()":                        "rotateY( [ <angle> | <zero> ] )",
+	"rotateZ()":                        "rotateZ( [ <angle> | <zero> ] )",
+	"round()":                          "round( <rounding-strategy>?, <calc-sum>, <calc-sum> )",
+	"rounding-strategy":                "nearest | up | down | to-zero",
+	"saturate()":                       "saturate( [ <number> | <percentage> ]? )",
+	"scale()":                          "scale( [ <number> | <percentage> ]#{1,2} )",
+	"scale3d()":                        "scale3d( [ <number> | <percentage> ]#{3} )",
+	"scaleX()":                         "scaleX( [ <number> | <percentage> ] )",
+	"scaleY()":                         "scaleY( [ <number> | <percentage> ] )",
+	"scaleZ()":                         "scaleZ( [ <number> | <percentage> ] )",
+	"scope-end":                        "<selector-list>",
+	"scope-start":                      "<selector-list>",
+	"scroll()":                         "scroll( [ <scroller> || <axis> ]? )",
+	"scroll-state-feature":             "<media-query-list>",
+	"scroll-state-in-parens":           "( <scroll-state-query> ) | ( <scroll-state-feature> ) | <general-enclosed>",
+	"scroll-state-query":               "not <scroll-state-in-parens> | <scroll-state-in-parens> [ [ and <scroll-state-in-parens> ]* | [ or <scroll-state-in-parens> ]* ] | <scroll-state-feature>  ",
+	"scroller":                         "root | nearest | self",
+	"selector-list":                    "<complex-selector-list>",
+	"self-position":                    "center | start | end | self-start | self-end | flex-start | flex-end",
+	"sepia()":                          "sepia( [ <number> | <percentage> ]? )",
+	"shadow":                           "inset? && <length>{2,4} && <color>?",
+	"shadow-t":                         "[ <length>{2,3} && <color>? ]",
+	"shape":                            "rect(<top>, <right>, <bottom>, <left>)",
+	"shape-box":                        "<visual-box> | margin-box",
+	"side-or-corner":                   "[ left | right ] || [ top | bottom ]",
+	"sign()":                           "sign( <calc-sum> )",
+	"signed-integer":                   "<number-token>",
+	"signless-integer":                 "<number-token>",
+	"sin()":                            "sin( <calc-sum> )",
+	"single-animation":                 "<'animation-duration'> || <easing-function> || <'animation-delay'> || <single-animation-iteration-count> || <single-animation-direction> || <single-animation-fill-mode> || <single-animation-play-state> || [ none | <keyframes-name> ] || <single-animation-timeline>",
+	"single-animation-composition":     "replace | add | accumulate",
+	"single-animation-direction":       "normal | reverse | alternate | alternate-reverse",
+	"single-animation-fill-mode":       "none | forwards | backwards | both",
+	"single-animation-iteration-count": "infinite | <number>",
+	"single-animation-play-state":      "running | paused",
+	"single-animation-timeline":        "auto | none | <dashed-ident> | <scroll()> | <view()>",
+	"single-transition":                "[ none | <single-transition-property> ] || <time> || <easing-function> || <time> || <transition-behavior-value>",
+	"single-transition-property":       "all | <custom-ident>",
+	"size":                             "closest-side | farthest-side | closest-corner | farthest-corner | <length> | <length-percentage>{2}",
+	"size-feature":                     "<media-query-list>",
+	"skew()":                           "skew( [ <angle> | <zero> ] , [ <angle> | <zero> ]? )",
+	"skewX()":                          "skewX( [ <angle> | <zero> ] )",
+	"skewY()":                          "skewY( [ <angle> | <zero> ] )",
+	"sqrt()":                           "sqrt( <calc-sum> )",
+	"step-easing-function":             "step-start | step-end | <steps()>",
+	"step-position":                    "jump-start | jump-end | jump-none | jump-both | start | end",
+	"steps()":                          "steps( <integer>, <step-position>? )",
+	"style-feature":                    "<declaration>",
+	"style-in-parens":                  "( <style-query> ) | ( <style-feature> ) | <general-enclosed>",
+	"style-query":                      "not <style-in-parens> | <style-in-parens> [ [ and <style-in-parens> ]* | [ or <style-in-parens> ]* ] | <style-feature> ",
+	"subclass-selector":                "<id-selector> | <class-selector> | <attribute-selector> | <pseudo-class-selector>",
+	"supports-condition":               "not <supports-in-parens> | <supports-in-parens> [ and <supports-in-parens> ]* | <supports-in-parens> [ or <supports-in-parens> ]*",
+	"supports-decl":                    "( <declaration> )",
+	"supports-feature":                 "<supports-decl> | <supports-selector-fn>",
+	"supports-in-parens":               "( <supports-condition> ) | <supports-feature> | <general-enclosed>",
+	"supports-selector-fn":             "selector( <complex-selector> )",
+	"symbol":                           "<string> | <image> | <custom-ident>",
+	"symbols()":                        "symbols( <symbols-type>? [ <string> | <image> ]+ )",
+	"symbols-type":                     "cyclic | numeric | alphabetic | symbolic | fixed",
+	"system-color":                     "AccentColor | AccentColorText | ActiveText | ButtonBorder | ButtonFace | ButtonText | Canvas | CanvasText | Field | FieldText | GrayText | Highlight | HighlightText | LinkText | Mark | MarkText | SelectedItem | SelectedItemText | VisitedText",
+	"system-family-name":               "caption | icon | menu | message-box | small-caption | status-bar",
+	"tan()":                            "tan( <calc-sum> )",
+	"target":                           "<target-counter()> | <target-counters()> | <target-text()>",
+	"target-counter()":                 "target-counter( [ <string> | <url> ] , <custom-ident> , <counter-style>? )",
+	"target-counters()":                "target-counters( [ <string> | <url> ] , <custom-ident> , <string> , <counter-style>? )",
+	"target-text()":                    "target-text( [ <string> | <url> ] , [ content | before | after | first-letter ]? )",
+	"text-edge":                        "[ text | cap | ex | ideographic | ideographic-ink ] [ text | alphabetic | ideographic | ideographic-ink ]?",
+	"time-percentage":                  "<time> | <percentage>",
+	"timeline-range-name":              "cover | contain | entry | exit | entry-crossing | exit-crossing",
+	"track-breadth":                    "<length-percentage> | <flex> | min-content | max-content | auto",
+	"track-list":                       "[ <line-names>? [ <track-size> | <track-repeat> ] ]+ <line-names>?",
+	"track-repeat":                     "repeat( [ <integer [1,∞]> ] , [ <line-names>? <track-size> ]+ <line-names>? )",
+	"track-size":                       "<track-breadth> | minmax( <inflexible-breadth> , <track-breadth> ) | fit-content( <length-percentage> )",
+	"transform-function":               "<matrix()> | <translate()> | <translateX()> | <translateY()> | <scale()> | <scaleX()> | <scaleY()> | <rotate()> | <skew()> | <skewX()> | <skewY()> | <matrix3d()> | <translate3d()> | <translateZ()> | <scale3d()> | <scaleZ()> | <rotate3d()> | <rotateX()> | <rotateY()> | <rotateZ()> | <perspective()>",
+	"transform-list":                   "<transform-function>+",
+	"transition-behavior-value":        "normal | allow-discrete",
+	"translate()":                      "translate( <length-percentage> , <length-percentage>? )",
+	"translate3d()":                    "translate3d( <length-percentage> , <length-percentage> , <length> )",
+	"translateX()":                     "translateX( <length-percentage> )",
+	"translateY()":                     "translateY( <length-percentage> )",
+	"translateZ()":                     "translateZ( <length> )",
+	"try-size":                         "most-width | most-height | most-block-size | most-inline-size",
+	"try-tactic":                       "flip-block || flip-inline || flip-start",
+	"type-or-unit":                     "string | color | url | integer | number | length | angle | time | frequency | cap | ch | em | ex | ic | lh | rlh | rem | vb | vi | vw | vh | vmin | vmax | mm | Q | cm | in | pt | pc | px | deg | grad | rad | turn | ms | s | Hz | kHz | %",
+	"type-selector":                    "<wq-name> | <ns-prefix>? '*'",
+	"var()":                            "var( <custom-property-name> , <declaration-value>? )",
+	"view()":                           "view([<axis> || <'view-timeline-inset'>]?)",
+	"viewport-length":                  "auto | <length-percentage>",
+	"visual-box":                       "content-box | padding-box | border-box",
+	"wq-name":                          "<ns-prefix>? <ident-token>",
+	"xywh()":                           "xywh( <length-percentage>{2} <length-percentage [0,∞]>{2} [ round <'border-radius'> ]? )",
+	"xyz":                              "xyz | xyz-d50 | xyz-d65",
+	"xyz-params":                       "<xyz> [ <number> | <percentage> | none ]{3}",
+}
@@ -0,0 +1,144 @@
+package syntax
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind int
+
+const (
+	tEOF          tokenKind = iota
+	tIdent                  // auto
+	tLiteral                // , / or a quoted token such as '+'
+	tType                   // <length [0,∞]> or <'margin-top'>, without the brackets
+	tFunction               // name( or a bare (
+	tCloseParen             // )
+	tOpenBracket            // [
+	tCloseBracket           // ]
+	tBar                    // |
+	tDoubleBar              // ||
+	tDoubleAmp              // &&
+	tMultiplier             // ? * + # #{1,2} {1,2} !
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	if t.kind == tEOF {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+var rangeMultiplier = regexp.MustCompile(`^\{\d+(,\d*)?\}`)
+
+// lexer splits a value definition syntax into tokens. Multiplier characters
+// are only treated as multipliers when they directly follow a component;
+// elsewhere they are literals, as in the "{" of "<keyframe-selector># {".
+type lexer struct {
+	src      string
+	pos      int
+	adjacent bool // the previous token ended a component with no whitespace since
+}
+
+func (l *lexer) next() token {
+	start := l.pos
+	l.skipSpace()
+	if l.pos > start {
+		l.adjacent = false
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tEOF, pos: l.pos}
+	}
+
+	pos := l.pos
+	rest := l.src[l.pos:]
+	emit := func(kind tokenKind, text string, size int, endsComponent bool) token {
+		l.pos += size
+		l.adjacent = endsComponent
+		return token{kind: kind, text: text, pos: pos}
+	}
+
+	if l.adjacent {
+		switch c := rest[0]; {
+		case c == '#':
+			if m := rangeMultiplier.FindString(rest[1:]); m != "" {
+				return emit(tMultiplier, "#"+m, 1+len(m), true)
+			}
+			return emit(tMultiplier, "#", 1, true)
+		case c == '?' || c == '*' || c == '+' || c == '!':
+			return emit(tMultiplier, string(c), 1, true)
+		case c == '{':
+			if m := rangeMultiplier.FindString(rest); m != "" {
+				return emit(tMultiplier, m, len(m), true)
+			}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(rest, "||"):
+		return emit(tDoubleBar, "||", 2, false)
+	case strings.HasPrefix(rest, "&&"):
+		return emit(tDoubleAmp, "&&", 2, false)
+	case rest[0] == '|':
+		return emit(tBar, "|", 1, false)
+	case rest[0] == '[':
+		return emit(tOpenBracket, "[", 1, false)
+	case rest[0] == ']':
+		return emit(tCloseBracket, "]", 1, true)
+	case rest[0] == '(':
+		return emit(tFunction, "", 1, false)
+	case rest[0] == ')':
+		return emit(tCloseParen, ")", 1, true)
+	case rest[0] == '<':
+		if end := strings.IndexByte(rest, '>'); end > 1 && !strings.ContainsAny(rest[1:end], "<|") {
+			return emit(tType, strings.TrimSpace(rest[1:end]), end+1, true)
+		}
+	case rest[0] == '\'':
+		// A quoted literal; the token itself may contain quotes ('||').
+		if end := strings.IndexByte(rest[1:], '\''); end > 0 {
+			return emit(tLiteral, rest[1:end+1], end+2, true)
+		}
+	case isIdentByte(rest[0]):
+		n := 1
+		for n < len(rest) && isIdentByte(rest[n]) {
+			n++
+		}
+		if n < len(rest) && rest[n] == '(' {
+			return emit(tFunction, rest[:n], n+1, false)
+		}
+		return emit(tIdent, rest[:n], n, true)
+	}
+
+	r, size := utf8.DecodeRuneInString(rest)
+	if r == '†' {
+		// Marks that no whitespace is allowed between tokens; it carries no
+		// meaning for matching purposes here.
+		l.pos += size
+		l.adjacent = true
+		return l.next()
+	}
+	return emit(tLiteral, string(r), size, true)
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case ' ', '\t', '\n', '\r':
+			l.pos++
+		default:
+			return
+		}
+	}
+}
+
+func isIdentByte(c byte) bool {
+	return c == '-' || c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
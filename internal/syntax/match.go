@@ -0,0 +1,467 @@
+package syntax
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownProperty is returned when matching a value for a property that
+// has no syntax in the grammar.
+var ErrUnknownProperty = errors.New("unknown property")
+
+// MatchError describes where a value stopped matching its grammar.
+type MatchError struct {
+	Value    string
+	Offset   int      // byte offset of Token in Value
+	Token    string   // the first token that could not be matched; empty at the end of the value
+	Expected []string // grammar components tried at Token
+}
+
+func (e *MatchError) Error() string {
+	got := "end of value"
+	if e.Token != "" {
+		got = strconv.Quote(e.Token)
+	}
+	if len(e.Expected) == 0 {
+		return "unexpected " + got
+	}
+	expected := e.Expected
+	if len(expected) > 8 {
+		expected = append(expected[:8:8], "...")
+	}
+	return fmt.Sprintf("unexpected %s, expected %s", got, strings.Join(expected, " | "))
+}
+
+// Grammar matches declaration values against property syntaxes, resolving
+// <type> and <'property'> references through its tables.
+type Grammar struct {
+	properties map[string]string
+	types      map[string]string
+
+	mu     sync.Mutex
+	parsed map[string]*Node
+}
+
+// NewGrammar creates a grammar from property and data type syntaxes.
+func NewGrammar(properties, types map[string]string) *Grammar {
+	return &Grammar{properties: properties, types: types, parsed: make(map[string]*Node)}
+}
+
+// Default is the grammar generated from the spec data.
+var Default = NewGrammar(properties, types)
+
+// HasProperty reports whether the grammar knows the property.
+func (g *Grammar) HasProperty(name string) bool {
+	_, ok := g.properties[name]
+	return ok
+}
+
+// MatchProperty matches a value against a property's syntax. It returns nil
+// on success, ErrUnknownProperty, or a *MatchError describing the mismatch.
+//
+// CSS-wide keywords are accepted for every property. Values containing
+// var(), env() or attr() are only known at computed-value time and are
+// accepted without matching.
+func (g *Grammar) MatchProperty(property, value string) error {
+	s, ok := g.properties[property]
+	if !ok {
+		return ErrUnknownProperty
+	}
+	root, err := g.parse(s)
+	if err != nil {
+		return err
+	}
+
+	value = strings.TrimSpace(value)
+	if v := strings.TrimSuffix(strings.TrimRight(value, " "), "!important"); len(v) < len(value) {
+		value = strings.TrimSpace(v)
+	}
+	toks, err := tokenizeValue(value)
+	if err != nil {
+		merr := err.(*MatchError)
+		merr.Value = value
+		return merr
+	}
+	if len(toks) == 1 && toks[0].kind == vIdent && cssWideKeywords[toks[0].name] {
+		return nil
+	}
+	if hasSubstitution(toks) {
+		return nil
+	}
+
+	m := &matcher{g: g, value: value, active: make(map[int]int)}
+	list := &tokenList{toks: toks, end: len(value)}
+	if m.match(root, list, 0, func(pos int) bool {
+		if pos == len(toks) {
+			return true
+		}
+		m.fail(list, pos, "")
+		return false
+	}) || m.steps > maxSteps {
+		return nil
+	}
+	return m.error()
+}
+
+func (g *Grammar) parse(s string) (*Node, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if n, ok := g.parsed[s]; ok {
+		return n, nil
+	}
+	n, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	g.parsed[s] = n
+	return n, nil
+}
+
+var cssWideKeywords = map[string]bool{
+	"inherit": true, "initial": true, "unset": true, "revert": true, "revert-layer": true,
+}
+
+func hasSubstitution(toks []valueToken) bool {
+	for _, t := range toks {
+		if t.kind == vFunction && (t.name == "var" || t.name == "env" || t.name == "attr") {
+			return true
+		}
+		if hasSubstitution(t.args) {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	maxSteps = 200000 // matching gives up, accepting the value, after this many steps
+	maxDepth = 64     // nesting limit for type references
+)
+
+// tokenList is a sequence of tokens being matched: a whole value or the
+// arguments of a function. end is the offset reported for running past the
+// last token.
+type tokenList struct {
+	toks []valueToken
+	end  int
+}
+
+func (l *tokenList) offset(pos int) int {
+	if pos < len(l.toks) {
+		return l.toks[pos].offset
+	}
+	return l.end
+}
+
+// matcher is a backtracking matcher. Each match call tries every way the node
+// can consume tokens starting at pos and calls k with the position after
+// each; matching succeeds as soon as k returns true.
+type matcher struct {
+	g     *Grammar
+	value string
+	steps int
+	depth int
+
+	// Failure tracking: the furthest offset any component failed at, and
+	// what was expected there. References record their own name and
+	// suppress the components they expand to at the same offset.
+	furthest int
+	expected []string
+	active   map[int]int
+}
+
+func (m *matcher) fail(l *tokenList, pos int, expected string) {
+	off := l.offset(pos)
+	if expected != "" && m.active[off] > 0 {
+		return
+	}
+	m.expect(off, expected)
+}
+
+func (m *matcher) expect(off int, expected string) {
+	if off > m.furthest {
+		m.furthest = off
+		m.expected = nil
+	}
+	if off < m.furthest || expected == "" {
+		return
+	}
+	for _, e := range m.expected {
+		if e == expected {
+			return
+		}
+	}
+	m.expected = append(m.expected, expected)
+}
+
+func (m *matcher) error() *MatchError {
+	e := &MatchError{Value: m.value, Offset: m.furthest, Expected: m.expected}
+	if m.furthest < len(m.value) {
+		// Report the whole token at the failure offset.
+		toks, err := tokenizeValue(m.value[m.furthest:])
+		if err == nil && len(toks) > 0 {
+			e.Token = toks[0].text
+		} else {
+			e.Token = m.value[m.furthest:]
+		}
+	}
+	return e
+}
+
+func (m *matcher) match(n *Node, l *tokenList, pos int, k func(int) bool) bool {
+	if m.steps++; m.steps > maxSteps {
+		return true
+	}
+	if !n.Multiplied() {
+		return m.matchOnce(n, l, pos, k)
+	}
+	return m.repeat(n, l, pos, 0, k)
+}
+
+// repeat matches further repetitions of a multiplied node, trying the
+// longest match first.
+func (m *matcher) repeat(n *Node, l *tokenList, pos, count int, k func(int) bool) bool {
+	if n.Max == Unbounded || count < n.Max {
+		start := pos
+		sepOK := true
+		if n.Comma && count > 0 {
+			if pos < len(l.toks) && l.toks[pos].kind == vDelim && l.toks[pos].text == "," {
+				start++
+			} else {
+				sepOK = false
+			}
+		}
+		if sepOK && m.matchOnce(n, l, start, func(next int) bool {
+			if next == start && count >= n.Min {
+				return false // an empty repetition adds nothing
+			}
+			return m.repeat(n, l, next, count+1, k)
+		}) {
+			return true
+		}
+	}
+	return count >= n.Min && k(pos)
+}
+
+func (m *matcher) matchOnce(n *Node, l *tokenList, pos int, k func(int) bool) bool {
+	var tok *valueToken
+	if pos < len(l.toks) {
+		tok = &l.toks[pos]
+	}
+
+	switch n.Kind {
+	case Keyword:
+		if tok != nil && tok.kind == vIdent && strings.EqualFold(tok.text, n.Name) {
+			return k(pos + 1)
+		}
+		m.fail(l, pos, n.Name)
+		return false
+
+	case Literal:
+		if tok != nil && tok.kind == vDelim && tok.text == n.Name {
+			return k(pos + 1)
+		}
+		m.fail(l, pos, "'"+n.Name+"'")
+		return false
+
+	case Function:
+		if tok == nil || tok.kind != vFunction || !strings.EqualFold(tok.name, n.Name) {
+			m.fail(l, pos, n.Name+"(")
+			return false
+		}
+		args := &tokenList{toks: tok.args, end: tok.end - 1}
+		if !m.match(n.Children[0], args, 0, func(p int) bool {
+			if p == len(args.toks) {
+				return true
+			}
+			m.fail(args, p, "")
+			return false
+		}) {
+			return false
+		}
+		return k(pos + 1)
+
+	case Type, Property:
+		return m.matchReference(n, l, pos, k)
+
+	case Group:
+		if n.Required {
+			inner := k
+			k = func(next int) bool { return next > pos && inner(next) }
+		}
+		switch n.Combinator {
+		case Juxtapose:
+			return m.sequence(n.Children, l, pos, true, k)
+		case OneOf:
+			for _, c := range n.Children {
+				if m.match(c, l, pos, k) {
+					return true
+				}
+			}
+			return false
+		default:
+			return m.unordered(n.Children, n.Combinator == AllOf, 0, false, l, pos, k)
+		}
+	}
+	return false
+}
+
+// sequence matches juxtaposed components in order. As in the CSS Values
+// spec, a comma in the grammar is omitted when the component before or after
+// it is omitted; prevEmpty reports whether the previous component (or the
+// start of the sequence) consumed nothing.
+func (m *matcher) sequence(children []*Node, l *tokenList, pos int, prevEmpty bool, k func(int) bool) bool {
+	if len(children) == 0 {
+		return k(pos)
+	}
+	c, rest := children[0], children[1:]
+	if m.match(c, l, pos, func(next int) bool {
+		return m.sequence(rest, l, next, next == pos, k)
+	}) {
+		return true
+	}
+	if c.Kind != Literal || c.Name != "," || c.Multiplied() {
+		return false
+	}
+	if prevEmpty || len(rest) == 0 {
+		return m.sequence(rest, l, pos, true, k)
+	}
+	return m.match(rest[0], l, pos, func(next int) bool {
+		return next == pos && m.sequence(rest[1:], l, next, true, k)
+	})
+}
+
+// unordered matches the members of an && group (all, any order) or an ||
+// group (at least one, any order). used is a bit set of matched members.
+func (m *matcher) unordered(children []*Node, all bool, used uint64, matched bool, l *tokenList, pos int, k func(int) bool) bool {
+	full := used == 1<<len(children)-1
+	if all && full {
+		return k(pos)
+	}
+	if !all && matched && k(pos) {
+		return true
+	}
+	for i, c := range children {
+		if used&(1<<i) != 0 {
+			continue
+		}
+		if m.match(c, l, pos, func(next int) bool {
+			if next == pos && !all {
+				return false // members of || must consume tokens
+			}
+			return m.unordered(children, all, used|1<<i, true, l, next, k)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *matcher) matchReference(n *Node, l *tokenList, pos int, k func(int) bool) bool {
+	label := "<" + n.Name + ">"
+	if n.Kind == Property {
+		label = "<'" + n.Name + "'>"
+	}
+
+	var def string
+	var ok bool
+	if n.Kind == Type {
+		if check, builtin := builtinTypes[n.Name]; builtin {
+			return m.matchBuiltin(n, check, label, l, pos, k)
+		}
+		def, ok = m.g.types[n.Name]
+	} else {
+		def, ok = m.g.properties[n.Name]
+	}
+
+	if !ok {
+		// Unknown references accept any single component value.
+		if pos < len(l.toks) && !isSeparator(l.toks[pos]) {
+			return k(pos + 1)
+		}
+		m.fail(l, pos, label)
+		return false
+	}
+	root, err := m.g.parse(def)
+	if err != nil || m.depth >= maxDepth {
+		return false
+	}
+
+	off := l.offset(pos)
+	m.fail(l, pos, label)
+	m.active[off]++
+	m.depth++
+	matched := m.match(root, l, pos, func(next int) bool {
+		// A range restriction such as <length-percentage [0,∞]> applies
+		// to the numbers the reference expanded to.
+		for i := pos; i < next; i++ {
+			if !inRange(&l.toks[i], n.Range) {
+				return false
+			}
+		}
+		m.active[off]--
+		m.depth--
+		defer func() { m.active[off]++; m.depth++ }()
+		return k(next)
+	})
+	m.depth--
+	m.active[off]--
+	return matched
+}
+
+func (m *matcher) matchBuiltin(n *Node, check func(*valueToken) bool, label string, l *tokenList, pos int, k func(int) bool) bool {
+	if check == nil {
+		// One or more arbitrary tokens.
+		for end := len(l.toks); end > pos; end-- {
+			if k(end) {
+				return true
+			}
+		}
+		m.fail(l, pos, label)
+		return false
+	}
+	if pos < len(l.toks) {
+		tok := &l.toks[pos]
+		if check(tok) && inRange(tok, n.Range) {
+			return k(pos + 1)
+		}
+	}
+	m.fail(l, pos, label)
+	return false
+}
+
+func isSeparator(t valueToken) bool {
+	return t.kind == vDelim && (t.text == "," || t.text == "/")
+}
+
+// inRange checks a numeric token against a range restriction such as
+// "[0,∞]". Only numbers, percentages and dimensions are checked; math
+// functions are not evaluated.
+func inRange(t *valueToken, r string) bool {
+	if r == "" || t.kind != vNumber && t.kind != vPercentage && t.kind != vDimension {
+		return true
+	}
+	lo, hi, ok := strings.Cut(strings.Trim(r, "[]"), ",")
+	if !ok {
+		return true
+	}
+	min, max := parseBound(lo, math.Inf(-1)), parseBound(hi, math.Inf(1))
+	return t.num >= min && t.num <= max
+}
+
+func parseBound(s string, infinity float64) float64 {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "∞") {
+		return infinity
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return infinity
+	}
+	return v
+}
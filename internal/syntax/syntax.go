@@ -0,0 +1,343 @@
+// Package syntax parses the CSS Value Definition Syntax used by the spec data,
+// e.g. "none | [ <length> | <percentage> ]{1,2} && inset?".
+//
+// See https://developer.mozilla.org/en-US/docs/Web/CSS/Value_definition_syntax.
+package syntax
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Kind identifies the kind of a syntax Node.
+type Kind int
+
+const (
+	Keyword  Kind = iota // a literal identifier such as auto
+	Literal              // punctuation such as ',' or '/', or a quoted token
+	Type                 // a data type reference such as <length> or <calc-size()>
+	Property             // a property reference such as <'margin-top'>
+	Function             // name( ... ), or a parenthesized block when Name is empty
+	Group                // components joined by a combinator
+)
+
+// Combinator joins the children of a Group. Juxtaposition binds tightest,
+// followed by &&, || and |.
+type Combinator int
+
+const (
+	Juxtapose Combinator = iota // a b: all, in order
+	AllOf                       // a && b: all, in any order
+	AnyOf                       // a || b: one or more, in any order
+	OneOf                       // a | b: exactly one
+)
+
+// Unbounded is the Max of a multiplier without an upper bound.
+const Unbounded = -1
+
+// Node is a component of a parsed syntax.
+type Node struct {
+	Kind       Kind
+	Name       string     // keyword, literal, type, property or function name
+	Range      string     // range restriction of a type, e.g. "[0,∞]"
+	Combinator Combinator // for groups
+	Children   []*Node    // group members, or the single argument group of a function
+
+	// Multiplier. A plain component has Min = Max = 1.
+	Min, Max int
+	Comma    bool // '#': repetitions are comma-separated
+	Required bool // '!': the group must not be empty
+}
+
+// Multiplied reports whether the node carries a multiplier.
+func (n *Node) Multiplied() bool {
+	return n.Min != 1 || n.Max != 1 || n.Comma || n.Required
+}
+
+// Parse parses a value definition syntax.
+func Parse(s string) (*Node, error) {
+	p := &parser{lex: lexer{src: s}}
+	p.next()
+	n, err := p.oneOf()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tEOF {
+		return nil, p.errorf("unexpected %s", p.tok)
+	}
+	return n, nil
+}
+
+// MustParse is like Parse but panics on error.
+func MustParse(s string) *Node {
+	n, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// Walk calls fn for n and each of its descendants, depth first. Children of
+// a node are skipped when fn returns false.
+func Walk(n *Node, fn func(*Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for _, c := range n.Children {
+		Walk(c, fn)
+	}
+}
+
+// String returns the syntax in canonical form.
+func (n *Node) String() string {
+	var b strings.Builder
+	n.write(&b, true)
+	return b.String()
+}
+
+func (n *Node) write(b *strings.Builder, top bool) {
+	switch n.Kind {
+	case Keyword:
+		b.WriteString(n.Name)
+	case Literal:
+		if n.Name == "," || n.Name == "/" {
+			b.WriteString(n.Name)
+		} else {
+			b.WriteString("'" + n.Name + "'")
+		}
+	case Type:
+		b.WriteString("<" + n.Name)
+		if n.Range != "" {
+			b.WriteString(" " + n.Range)
+		}
+		b.WriteString(">")
+	case Property:
+		b.WriteString("<'" + n.Name + "'>")
+	case Function:
+		b.WriteString(n.Name + "(")
+		for _, c := range n.Children {
+			b.WriteString(" ")
+			c.write(b, true)
+		}
+		b.WriteString(" )")
+	case Group:
+		bracket := !top || n.Multiplied()
+		if bracket {
+			b.WriteString("[ ")
+		}
+		sep := [...]string{" ", " && ", " || ", " | "}[n.Combinator]
+		for i, c := range n.Children {
+			if i > 0 {
+				b.WriteString(sep)
+			}
+			c.write(b, false)
+		}
+		if bracket {
+			b.WriteString(" ]")
+		}
+	}
+	b.WriteString(n.multiplier())
+}
+
+func (n *Node) multiplier() string {
+	var m string
+	switch {
+	case n.Min == 1 && n.Max == 1:
+	case n.Comma && n.Min == 1 && n.Max == Unbounded:
+	case n.Min == 0 && n.Max == 1:
+		m = "?"
+	case n.Min == 0 && n.Max == Unbounded:
+		m = "*"
+	case n.Min == 1 && n.Max == Unbounded:
+		m = "+"
+	case n.Min == n.Max:
+		m = fmt.Sprintf("{%d}", n.Min)
+	case n.Max == Unbounded:
+		m = fmt.Sprintf("{%d,}", n.Min)
+	default:
+		m = fmt.Sprintf("{%d,%d}", n.Min, n.Max)
+	}
+	if n.Comma {
+		m = "#" + m
+	}
+	if n.Required {
+		m += "!"
+	}
+	return m
+}
+
+// parser is a recursive descent parser over the lexer's tokens.
+type parser struct {
+	lex   lexer
+	tok   token
+	depth int // nesting of functions; an unbalanced ) outside one is a literal
+}
+
+func (p *parser) next() { p.tok = p.lex.next() }
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("syntax: %s at offset %d in %q", fmt.Sprintf(format, args...), p.tok.pos, p.lex.src)
+}
+
+// oneOf parses a | b | ...
+func (p *parser) oneOf() (*Node, error) {
+	return p.combined(OneOf, tBar, p.anyOf)
+}
+
+// anyOf parses a || b || ...
+func (p *parser) anyOf() (*Node, error) {
+	return p.combined(AnyOf, tDoubleBar, p.allOf)
+}
+
+// allOf parses a && b && ...
+func (p *parser) allOf() (*Node, error) {
+	return p.combined(AllOf, tDoubleAmp, p.juxtaposed)
+}
+
+func (p *parser) combined(c Combinator, sep tokenKind, operand func() (*Node, error)) (*Node, error) {
+	first, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != sep {
+		return first, nil
+	}
+	g := &Node{Kind: Group, Combinator: c, Children: []*Node{first}, Min: 1, Max: 1}
+	for p.tok.kind == sep {
+		p.next()
+		n, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		g.Children = append(g.Children, n)
+	}
+	return g, nil
+}
+
+// juxtaposed parses components separated by whitespace. An empty sequence,
+// as in "name()", yields an empty group.
+func (p *parser) juxtaposed() (*Node, error) {
+	var children []*Node
+	for {
+		switch p.tok.kind {
+		case tCloseParen:
+			if p.depth == 0 {
+				break
+			}
+			fallthrough
+		case tEOF, tBar, tDoubleBar, tDoubleAmp, tCloseBracket:
+			if len(children) == 1 {
+				return children[0], nil
+			}
+			return &Node{Kind: Group, Combinator: Juxtapose, Children: children, Min: 1, Max: 1}, nil
+		}
+		n, err := p.component()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, n)
+	}
+}
+
+// component parses a single component followed by its multipliers.
+func (p *parser) component() (*Node, error) {
+	n, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tMultiplier {
+		if n.Multiplied() {
+			// Stacked multipliers such as <x>+# apply to the repeated form.
+			n = &Node{Kind: Group, Combinator: Juxtapose, Children: []*Node{n}, Min: 1, Max: 1}
+		}
+		if err := p.applyMultiplier(n, p.tok.text); err != nil {
+			return nil, err
+		}
+		p.next()
+	}
+	return n, nil
+}
+
+func (p *parser) applyMultiplier(n *Node, m string) error {
+	if strings.HasPrefix(m, "#") {
+		n.Comma = true
+		n.Min, n.Max = 1, Unbounded
+		if m = m[1:]; m == "" {
+			return nil
+		}
+	}
+	switch m {
+	case "?":
+		n.Min, n.Max = 0, 1
+	case "*":
+		n.Min, n.Max = 0, Unbounded
+	case "+":
+		n.Min, n.Max = 1, Unbounded
+	case "!":
+		n.Required = true
+	default:
+		lo, hi, hasComma := strings.Cut(strings.Trim(m, "{}"), ",")
+		min, err := strconv.Atoi(lo)
+		if err != nil {
+			return p.errorf("invalid multiplier %s", m)
+		}
+		max := min
+		if hasComma {
+			if hi == "" {
+				max = Unbounded
+			} else if max, err = strconv.Atoi(hi); err != nil {
+				return p.errorf("invalid multiplier %s", m)
+			}
+		}
+		n.Min, n.Max = min, max
+	}
+	return nil
+}
+
+func (p *parser) primary() (*Node, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tIdent:
+		p.next()
+		return &Node{Kind: Keyword, Name: tok.text, Min: 1, Max: 1}, nil
+	case tLiteral, tCloseParen:
+		p.next()
+		return &Node{Kind: Literal, Name: tok.text, Min: 1, Max: 1}, nil
+	case tType:
+		p.next()
+		name, rng, _ := strings.Cut(tok.text, " ")
+		if strings.HasPrefix(name, "'") {
+			return &Node{Kind: Property, Name: strings.Trim(name, "'"), Min: 1, Max: 1}, nil
+		}
+		return &Node{Kind: Type, Name: name, Range: strings.TrimSpace(rng), Min: 1, Max: 1}, nil
+	case tFunction:
+		p.next()
+		p.depth++
+		args, err := p.oneOf()
+		p.depth--
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tCloseParen {
+			return nil, p.errorf("expected ) to close %s(", tok.text)
+		}
+		p.next()
+		return &Node{Kind: Function, Name: tok.text, Children: []*Node{args}, Min: 1, Max: 1}, nil
+	case tOpenBracket:
+		p.next()
+		inner, err := p.oneOf()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tCloseBracket {
+			return nil, p.errorf("expected ]")
+		}
+		p.next()
+		if inner.Kind == Group && !inner.Multiplied() {
+			return inner, nil
+		}
+		return &Node{Kind: Group, Combinator: Juxtapose, Children: []*Node{inner}, Min: 1, Max: 1}, nil
+	}
+	return nil, p.errorf("unexpected %s", tok)
+}
@@ -0,0 +1,153 @@
+package syntax
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestParseCanonical(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"auto | <length>", "auto | <length>"},
+		{"a b && c || d | e", "[ [ [ a b ] && c ] || d ] | e"},
+		{"[ <length> | <percentage> ]{1,2}", "[ <length> | <percentage> ]{1,2}"},
+		{"<length-percentage [0,∞]>{1,4}", "<length-percentage [0,∞]>{1,4}"},
+		{"<'margin-top'>{1,4}", "<'margin-top'>{1,4}"},
+		{"none | <shadow>#", "none | <shadow>#"},
+		{"<number>#{3}", "<number>#{3}"},
+		{"<integer>{2,}", "<integer>{2,}"},
+		{"[ <x>? <y>? ]!", "[ <x>? <y>? ]!"},
+		{"fit-content(<length-percentage>)", "fit-content( <length-percentage> )"},
+		{"<calc-size()> | auto", "<calc-size()> | auto"},
+		{"<a> [ / <b> ]?", "<a> [ / <b> ]?"},
+		{"'[' <ident> ']'", "'[' <ident> ']'"},
+		{"<keyframe-selector># {\n  <declaration-list>\n}", "<keyframe-selector># '{' <declaration-list> '}'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			n, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := n.String(); got != tt.want {
+				t.Errorf("Parse().String() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseStructure(t *testing.T) {
+	n := MustParse("none | [ <length> | <number> ]{1,4} && inset?")
+	if n.Kind != Group || n.Combinator != OneOf || len(n.Children) != 2 {
+		t.Fatalf("top level = %+v, want a two-member | group", n)
+	}
+	all := n.Children[1]
+	if all.Combinator != AllOf || len(all.Children) != 2 {
+		t.Fatalf("second member = %s, want an && group", all)
+	}
+	rep := all.Children[0]
+	if rep.Min != 1 || rep.Max != 4 || rep.Combinator != OneOf {
+		t.Errorf("repeated group = %s (min %d, max %d)", rep, rep.Min, rep.Max)
+	}
+	if inset := all.Children[1]; inset.Kind != Keyword || inset.Min != 0 || inset.Max != 1 {
+		t.Errorf("inset = %+v, want optional keyword", inset)
+	}
+
+	ranged := MustParse("<number [1,1000]>")
+	if ranged.Kind != Type || ranged.Name != "number" || ranged.Range != "[1,1000]" {
+		t.Errorf("ranged type = %+v", ranged)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{"[ a | b", "a ]", "f( a"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("Parse(%q) succeeded, want error", input)
+		}
+	}
+}
+
+// TestParseSpec parses every property and data type syntax in the spec data
+// and checks that the canonical form parses back to itself.
+func TestParseSpec(t *testing.T) {
+	for _, file := range []string{"properties.json", "syntaxes.json"} {
+		data, err := os.ReadFile("../../spec/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var entries map[string]struct{ Syntax string }
+		if err := json.Unmarshal(data, &entries); err != nil {
+			t.Fatal(err)
+		}
+		for name, entry := range entries {
+			n, err := Parse(entry.Syntax)
+			if err != nil {
+				t.Errorf("%s %s: %v", file, name, err)
+				continue
+			}
+			again, err := Parse(n.String())
+			if err != nil || again.String() != n.String() {
+				t.Errorf("%s %s: canonical form %q does not round-trip (%v)", file, name, n, err)
+			}
+		}
+	}
+}
+
+func TestMatchProperty(t *testing.T) {
+	valid := [][2]string{
+		{"animation-delay", "150ms, 1s"},
+		{"width", "fit-content(20em)"},
+		{"width", "calc(100% - 2rem)"},
+		{"color", "rgb(255 0 0 / 0.5)"},
+		{"color", "#ff0000"},
+		{"color", "var(--brand)"},
+		{"margin", "1px 2px auto 3px"},
+		{"box-shadow", "0 1px 2px rgba(0,0,0,.2), inset 0 0 1px red"},
+		{"font", `italic bold 16px/1.5 "Helvetica Neue", sans-serif`},
+		{"grid-template-columns", "repeat(3, minmax(0, 1fr))"},
+		{"display", "inline flex"},
+		{"background", "url(a.png) no-repeat center / cover, linear-gradient(to right, red, blue)"},
+		{"transform", "translateX(10px) rotate(45deg)"},
+		{"z-index", "inherit"},
+		{"padding", "1rem !important"},
+	}
+	for _, c := range valid {
+		if err := Default.MatchProperty(c[0], c[1]); err != nil {
+			t.Errorf("%s: %s: %v", c[0], c[1], err)
+		}
+	}
+
+	invalid := []struct {
+		property, value string
+		want            string
+	}{
+		{"animation-delay", "10px", `unexpected "10px", expected <time>`},
+		{"z-index", "1.5", `unexpected "1.5", expected auto | <integer>`},
+		{"margin", "1px 2px 3px 4px 5px", `unexpected "5px"`},
+		{"color", "rgb(255 0 0", `unexpected end of value, expected ')'`},
+		{"display", "flexx", `unexpected "flexx", expected <display-outside> | <display-inside> | <display-listitem> | <display-internal> | <display-box> | <display-legacy>`},
+	}
+	for _, c := range invalid {
+		err := Default.MatchProperty(c.property, c.value)
+		if err == nil || err.Error() != c.want {
+			t.Errorf("%s: %s: got %v, want %s", c.property, c.value, err, c.want)
+		}
+	}
+
+	if err := Default.MatchProperty("colr", "red"); err != ErrUnknownProperty {
+		t.Errorf("unknown property: got %v", err)
+	}
+}
+
+func TestMatchRange(t *testing.T) {
+	if err := Default.MatchProperty("width", "-5px"); err == nil {
+		t.Error("width: -5px should be rejected by <length-percentage [0,∞]>")
+	}
+	if err := Default.MatchProperty("margin-top", "-5px"); err != nil {
+		t.Errorf("margin-top: -5px: %v", err)
+	}
+}
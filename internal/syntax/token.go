@@ -0,0 +1,242 @@
+package syntax
+
+import (
+	"strconv"
+	"strings"
+)
+
+type valueTokenKind int
+
+const (
+	vIdent      valueTokenKind = iota // auto, --brand
+	vFunction                         // name( ... ), or a ( ... ) block when name is empty
+	vNumber                           // 1.5
+	vPercentage                       // 50%
+	vDimension                        // 10px
+	vHash                             // #fff
+	vString                           // "text"
+	vURL                              // url(/img.png)
+	vDelim                            // , / + and other single characters
+)
+
+// valueToken is a component value of a declaration value.
+type valueToken struct {
+	kind    valueTokenKind
+	text    string  // source text of the token
+	name    string  // lowercased identifier, function name or unit
+	num     float64 // numeric value of numbers, percentages and dimensions
+	integer bool    // the number was written without a fraction or exponent
+	args    []valueToken
+	offset  int // byte offset of the token in the value
+	end     int // byte offset just past the token
+}
+
+// tokenizeValue splits a declaration value into component values. Function
+// arguments are nested inside their function token. Unterminated strings and
+// functions are reported as a *MatchError.
+func tokenizeValue(s string) ([]valueToken, error) {
+	t := &valueTokenizer{src: s}
+	return t.tokens(0)
+}
+
+type valueTokenizer struct {
+	src string
+	pos int
+}
+
+// tokens reads tokens until the close character, or the end of input when
+// close is 0.
+func (t *valueTokenizer) tokens(close byte) ([]valueToken, error) {
+	var list []valueToken
+	for {
+		t.skipSpace()
+		if t.pos >= len(t.src) {
+			if close != 0 {
+				return nil, &MatchError{Offset: t.pos, Expected: []string{"'" + string(close) + "'"}}
+			}
+			return list, nil
+		}
+		if t.src[t.pos] == close {
+			t.pos++
+			return list, nil
+		}
+		tok, err := t.next()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, tok)
+	}
+}
+
+func (t *valueTokenizer) next() (valueToken, error) {
+	start := t.pos
+	c := t.src[t.pos]
+	tok := valueToken{offset: start}
+	finish := func() (valueToken, error) {
+		tok.end = t.pos
+		tok.text = t.src[start:t.pos]
+		return tok, nil
+	}
+
+	switch {
+	case c == '"' || c == '\'':
+		t.pos++
+		for t.pos < len(t.src) && t.src[t.pos] != c {
+			if t.src[t.pos] == '\\' {
+				t.pos++
+			}
+			t.pos++
+		}
+		if t.pos >= len(t.src) {
+			return tok, &MatchError{Offset: t.pos, Expected: []string{"'" + string(c) + "'"}}
+		}
+		t.pos++
+		tok.kind = vString
+		return finish()
+
+	case c == '#' && t.pos+1 < len(t.src) && isNameByte(t.src[t.pos+1]):
+		t.pos++
+		t.consumeName()
+		tok.kind = vHash
+		tok.name = strings.ToLower(t.src[start+1 : t.pos])
+		return finish()
+
+	case t.startsNumber():
+		t.consumeNumber(&tok)
+		switch {
+		case t.pos < len(t.src) && t.src[t.pos] == '%':
+			t.pos++
+			tok.kind = vPercentage
+		case t.startsIdent():
+			unitStart := t.pos
+			t.consumeName()
+			tok.kind = vDimension
+			tok.name = strings.ToLower(t.src[unitStart:t.pos])
+		default:
+			tok.kind = vNumber
+		}
+		return finish()
+
+	case t.startsIdent():
+		t.consumeName()
+		tok.name = strings.ToLower(t.src[start:t.pos])
+		if t.pos >= len(t.src) || t.src[t.pos] != '(' {
+			tok.kind = vIdent
+			return finish()
+		}
+		t.pos++
+		if tok.name == "url" && !t.quoteFollows() {
+			end := strings.IndexByte(t.src[t.pos:], ')')
+			if end < 0 {
+				return tok, &MatchError{Offset: len(t.src), Expected: []string{"')'"}}
+			}
+			t.pos += end + 1
+			tok.kind = vURL
+			return finish()
+		}
+		return t.function(&tok, finish)
+
+	case c == '(':
+		t.pos++
+		return t.function(&tok, finish)
+	}
+
+	t.pos++
+	for t.pos < len(t.src) && t.src[t.pos] >= 0x80 && t.src[t.pos] < 0xC0 {
+		t.pos++ // rest of a multi-byte character
+	}
+	tok.kind = vDelim
+	return finish()
+}
+
+func (t *valueTokenizer) function(tok *valueToken, finish func() (valueToken, error)) (valueToken, error) {
+	args, err := t.tokens(')')
+	if err != nil {
+		return *tok, err
+	}
+	tok.kind = vFunction
+	tok.args = args
+	return finish()
+}
+
+func (t *valueTokenizer) skipSpace() {
+	for t.pos < len(t.src) && strings.IndexByte(" \t\n\r\f", t.src[t.pos]) >= 0 {
+		t.pos++
+	}
+}
+
+func (t *valueTokenizer) quoteFollows() bool {
+	rest := strings.TrimLeft(t.src[t.pos:], " \t\n")
+	return strings.HasPrefix(rest, `"`) || strings.HasPrefix(rest, "'")
+}
+
+func (t *valueTokenizer) startsNumber() bool {
+	rest := t.src[t.pos:]
+	if rest != "" && (rest[0] == '+' || rest[0] == '-') {
+		rest = rest[1:]
+	}
+	if rest != "" && rest[0] == '.' {
+		rest = rest[1:]
+	}
+	return rest != "" && isDigit(rest[0])
+}
+
+func (t *valueTokenizer) startsIdent() bool {
+	rest := t.src[t.pos:]
+	if rest == "" {
+		return false
+	}
+	if rest[0] == '-' {
+		return len(rest) > 1 && (rest[1] == '-' || isNameStart(rest[1]))
+	}
+	return isNameStart(rest[0])
+}
+
+func (t *valueTokenizer) consumeName() {
+	for t.pos < len(t.src) && isNameByte(t.src[t.pos]) {
+		t.pos++
+	}
+}
+
+func (t *valueTokenizer) consumeNumber(tok *valueToken) {
+	start := t.pos
+	tok.integer = true
+	if c := t.src[t.pos]; c == '+' || c == '-' {
+		t.pos++
+	}
+	t.consumeDigits()
+	if t.pos+1 < len(t.src) && t.src[t.pos] == '.' && isDigit(t.src[t.pos+1]) {
+		tok.integer = false
+		t.pos++
+		t.consumeDigits()
+	}
+	// An exponent, but not the "e" of a unit such as em.
+	if t.pos+1 < len(t.src) && (t.src[t.pos] == 'e' || t.src[t.pos] == 'E') {
+		rest := t.src[t.pos+1:]
+		if rest[0] == '+' || rest[0] == '-' {
+			rest = rest[1:]
+		}
+		if rest != "" && isDigit(rest[0]) {
+			tok.integer = false
+			t.pos = len(t.src) - len(rest)
+			t.consumeDigits()
+		}
+	}
+	tok.num, _ = strconv.ParseFloat(t.src[start:t.pos], 64)
+}
+
+func (t *valueTokenizer) consumeDigits() {
+	for t.pos < len(t.src) && isDigit(t.src[t.pos]) {
+		t.pos++
+	}
+}
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
+
+func isNameStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+func isNameByte(c byte) bool {
+	return isNameStart(c) || c == '-' || isDigit(c)
+}
@@ -0,0 +1,643 @@
+// This file implements the just-in-time compiler, which turns Tailwind class
+// strings such as "px-4 md:hover:bg-blue-500" into CSS rules.
+
+package tailwind
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Compiler compiles Tailwind class strings against a configuration.
+type Compiler struct {
+	config   Config
+	theme    ThemeConfig // the theme utilities are generated from
+	err      error       // from resolving the configuration or registering its plugins
+	variants *Variants
+
+	static    map[string]staticUtility
+	matchers  map[string][]*matchUtility // by class prefix, e.g. "bg"
+	plugins   map[string]*pluginClass    // plugin components and utilities
+	base      []css.Item                 // theme variables and plugin base styles
+	requires  map[int][]css.Item         // rules pulled in by utilities, by rank
+	children  map[int]string             // child selectors of utilities such as space-x, by rank
+	keyframes keyframesIndex             // @keyframes pulled in by animations
+	rank      int                        // registration order of the next utility
+
+	candidatesOnce sync.Once
+	candidates     []string // known class names, for suggestions
+}
+
+// staticUtility is a utility without a value, such as flex.
+type staticUtility struct {
+	rank  int
+	decls []css.Decl
+}
+
+// matchUtility is a utility that takes a value from a theme scale or an
+// arbitrary value, such as bg-blue-500 or bg-[#bada55].
+type matchUtility struct {
+	rank     int
+	values   []scaleEntry
+	types    []valueType // types of the arbitrary values it accepts
+	negative bool        // whether -prefix-value negates the value
+	decls    func(v css.Value) []css.Decl
+}
+
+// scaleEntry is a named value of a theme scale. The empty key is the
+// scale's DEFAULT value, used by the bare class, e.g. rounded.
+type scaleEntry struct {
+	key   string
+	value css.Value
+}
+
+// valueType classifies arbitrary values, to choose between utilities that
+// share a prefix, such as text-[#333] (color) and text-[14px] (font-size).
+type valueType string
+
+const (
+	typeColor  valueType = "color"
+	typeLength valueType = "length"
+	typeNumber valueType = "number"
+	typeAny    valueType = "any"
+)
+
+// NewCompiler creates a compiler for the utilities and variants of a
+// configuration and its plugins, after resolving its presets. If the
+// configuration does not resolve, the compiler uses it as is and Err
+// reports why, as it does for plugins registering invalid additions.
+func NewCompiler(config Config) *Compiler {
+	resolved, err := ResolveConfig(config)
+	if err == nil {
+		config = resolved
+	}
+	c := &Compiler{
+		config:    config,
+		theme:     utilityTheme(config),
+		err:       err,
+		variants:  NewVariants(config),
+		static:    make(map[string]staticUtility),
+		matchers:  make(map[string][]*matchUtility),
+		plugins:   make(map[string]*pluginClass),
+		requires:  make(map[int][]css.Item),
+		children:  make(map[int]string),
+		keyframes: newKeyframesIndex(config),
+	}
+	c.registerUtilities()
+	c.registerPlugins()
+	return c
+}
+
+// registerPlugins registers the additions of the configuration's plugins,
+// after the built-in utilities.
+func (c *Compiler) registerPlugins() {
+	api := registerPlugins(c.config.Plugins, c.theme)
+	if c.err == nil {
+		c.err = api.err()
+	}
+	c.base = append(configVariables(c.config), api.base...)
+	for _, class := range api.classes {
+		class.rank = c.rank
+		c.plugins[class.name] = class
+		c.rank++
+	}
+	for _, m := range api.matchers {
+		c.addMatch(m.Name, m.values, anyTypes, m.Negative, m.Decls)
+	}
+	for _, v := range api.variants {
+		c.variants.Register(v.name, v.def)
+	}
+}
+
+// Err returns the error resolving the configuration or registering its
+// plugins, if any.
+func (c *Compiler) Err() error {
+	return c.err
+}
+
+// Variants returns the variants used by the compiler.
+func (c *Compiler) Variants() *Variants {
+	return c.variants
+}
+
+// addStatic registers a utility without a value.
+func (c *Compiler) addStatic(name string, decls ...css.Decl) {
+	c.static[name] = staticUtility{rank: c.rank, decls: decls}
+	c.rank++
+}
+
+// addMatch registers a utility taking values from a scale or arbitrary
+// values of the given types.
+func (c *Compiler) addMatch(prefix string, values []scaleEntry, types []valueType, negative bool, decls func(v css.Value) []css.Decl) {
+	c.matchers[prefix] = append(c.matchers[prefix], &matchUtility{
+		rank:     c.rank,
+		values:   values,
+		types:    types,
+		negative: negative,
+		decls:    decls,
+	})
+	c.rank++
+}
+
+// require makes the last registered utility pull in rules, such as the
+// @property rules of the custom properties it sets. Compile includes them
+// once, after the base styles.
+func (c *Compiler) require(items ...css.Item) {
+	c.requires[c.rank-1] = append(c.requires[c.rank-1], items...)
+}
+
+// onChildren makes the last registered utility style the children its
+// selector matches, as space-x does with " > :not([hidden]) ~
+// :not([hidden])", rather than the element with the class.
+func (c *Compiler) onChildren(selector string) {
+	c.children[c.rank-1] = selector
+}
+
+// compiled is a compiled class with its sort keys.
+type compiled struct {
+	item        css.Item
+	requires    []css.Item
+	component   bool
+	variantRank int
+	rank        int
+}
+
+// Compile compiles the whitespace-separated classes of a class string. The
+// rules are deduplicated and ordered like Tailwind's output: utilities
+// without variants first, then those with pseudo-class variants, then those
+// in media queries, with breakpoints last and smallest first. Within each
+// group rules follow the utility order, so that px-4 overrides p-2 wherever
+// the classes appear. The theme variables of Config.CSSVariables and plugin
+// base styles come first, followed once by the rules the utilities require,
+// such as @property rules and the @keyframes of animations, and plugin
+// components before all utilities.
+// Classes that match no utility are reported as *UnknownClassError, after
+// the error of Err, if any.
+func (c *Compiler) Compile(classes string) (css.Stylesheet, []error) {
+	return c.compile(classes, true)
+}
+
+// compile implements Compile. Suggestions for unknown classes are only
+// computed if suggest is set, as comparing a class with every known class
+// is expensive.
+func (c *Compiler) compile(classes string, suggest bool) (css.Stylesheet, []error) {
+	var (
+		rules []compiled
+		errs  []error
+		seen  = make(map[string]bool)
+	)
+	if c.err != nil {
+		errs = append(errs, c.err)
+	}
+	for _, class := range strings.Fields(classes) {
+		if seen[class] {
+			continue
+		}
+		seen[class] = true
+
+		r, err := c.compileClass(class, suggest)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rules = append(rules, r)
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		a, b := rules[i], rules[j]
+		if a.component != b.component {
+			return a.component
+		}
+		if a.variantRank != b.variantRank {
+			return a.variantRank < b.variantRank
+		}
+		return a.rank < b.rank
+	})
+
+	var stylesheet css.Stylesheet
+	stylesheet.Add(c.base...)
+	required := make(map[string]bool)
+	for _, r := range rules {
+		for _, item := range r.requires {
+			if s := item.String(); !required[s] {
+				required[s] = true
+				stylesheet.Add(item)
+			}
+		}
+	}
+	for _, r := range rules {
+		stylesheet.Add(flatten(r.item)...)
+	}
+	return stylesheet, errs
+}
+
+// CompileClass compiles a single class. A plugin class made up of several
+// rules compiles to a css.Stylesheet of them.
+func (c *Compiler) CompileClass(class string) (css.Item, error) {
+	r, err := c.compileClass(class, true)
+	return r.item, err
+}
+
+// compileClass compiles a class with its sort keys, suggesting similar
+// classes for an unknown one if suggest is set.
+func (c *Compiler) compileClass(class string, suggest bool) (compiled, error) {
+	parts := splitTopLevel(class, ':')
+	variants, base := parts[:len(parts)-1], parts[len(parts)-1]
+
+	utility := base
+	important := false
+	switch {
+	case strings.HasPrefix(utility, "!"):
+		utility, important = utility[1:], true
+	case strings.HasSuffix(utility, "!"):
+		utility, important = utility[:len(utility)-1], true
+	}
+
+	r := compiled{variantRank: c.variantRank(class)}
+	if plugin, ok := c.plugins[utility]; ok {
+		r.item, r.component, r.rank = plugin.item(base, important), plugin.component, plugin.rank
+	} else {
+		decls, rank, ok := c.resolve(utility)
+		if !ok {
+			err := &UnknownClassError{Class: class}
+			if suggest {
+				err.Suggestions = c.suggest(utility)
+			}
+			return compiled{}, err
+		}
+		if important {
+			for i, decl := range decls {
+				decls[i].Value = css.Raw(decl.Value.String() + " !important")
+			}
+		}
+		r.item, r.rank = css.RuleSet("."+escapeClass(base)+c.children[rank], decls...), rank
+		r.requires = c.requires[rank]
+		if keyframes := c.keyframes.find(decls); len(keyframes) > 0 {
+			r.requires = append(append([]css.Item(nil), r.requires...), keyframes...)
+		}
+	}
+
+	item, err := c.variants.Apply(r.item, variants...)
+	if err != nil {
+		return compiled{}, fmt.Errorf("tailwind: class %q: %w", class, err)
+	}
+	r.item = item
+	return r, nil
+}
+
+// resolve returns the declarations of a utility class without variants or
+// the important flag, and the rank of its utility.
+func (c *Compiler) resolve(utility string) ([]css.Decl, int, bool) {
+	// Arbitrary properties: [mask-type:luminance]
+	if isArbitrary(utility) {
+		property, value, ok := strings.Cut(arbitraryValue(utility), ":")
+		if !ok || property == "" || value == "" {
+			return nil, 0, false
+		}
+		return []css.Decl{css.Set(css.Property(property), css.Raw(value))}, c.rank, true
+	}
+
+	if static, ok := c.static[utility]; ok {
+		return append([]css.Decl(nil), static.decls...), static.rank, true
+	}
+
+	negative := strings.HasPrefix(utility, "-")
+	if negative {
+		utility = utility[1:]
+	}
+
+	if decls, rank, ok := c.match(utility, "", negative); ok {
+		return decls, rank, true
+	}
+
+	// Opacity modifier: bg-blue-500/50 or bg-[#bada55]/[.37]
+	if slash := indexTopLevel(utility, 0, func(s string, i int) bool { return s[i] == '/' }); slash > 0 && !negative {
+		return c.match(utility[:slash], utility[slash+1:], false)
+	}
+	return nil, 0, false
+}
+
+// match resolves a functional utility. The longest prefix wins, so gap-x-4
+// is gap-x with 4 rather than gap with x-4.
+func (c *Compiler) match(utility, modifier string, negative bool) ([]css.Decl, int, bool) {
+	for i := len(utility); i > 0; i = strings.LastIndexByte(utility[:i], '-') {
+		prefix, key := utility[:i], ""
+		if i < len(utility) {
+			key = utility[i+1:]
+		}
+		for _, m := range c.matchers[prefix] {
+			if negative && !m.negative || modifier != "" && !m.accepts(typeColor) {
+				continue
+			}
+			value, ok := m.lookup(key)
+			if !ok {
+				continue
+			}
+			if modifier != "" {
+				alpha, ok := c.alpha(modifier)
+				if !ok {
+					continue
+				}
+				value = withAlpha(value, alpha)
+			}
+			if negative {
+				value = negate(value)
+			}
+			return m.decls(value), m.rank, true
+		}
+	}
+	return nil, 0, false
+}
+
+// lookup resolves a key from the utility's scale, or an arbitrary value of
+// a type the utility accepts.
+func (m *matchUtility) lookup(key string) (css.Value, bool) {
+	if isArbitrary(key) {
+		raw := arbitraryValue(key)
+		if hint, rest, ok := typeHint(raw); ok {
+			return css.Raw(rest), m.accepts(hint)
+		}
+		return css.Raw(raw), m.accepts(inferType(raw))
+	}
+	for _, entry := range m.values {
+		if entry.key == key {
+			return entry.value, true
+		}
+	}
+	return nil, false
+}
+
+func (m *matchUtility) accepts(t valueType) bool {
+	for _, accepted := range m.types {
+		if accepted == t {
+			return true
+		}
+	}
+	return false
+}
+
+// alpha resolves an opacity modifier against the opacity scale, or as an
+// arbitrary value.
+func (c *Compiler) alpha(modifier string) (string, bool) {
+	if isArbitrary(modifier) {
+		return arbitraryValue(modifier), true
+	}
+	for _, entry := range themeScale(c.theme.Opacity) {
+		if entry.key == modifier {
+			return entry.value.String(), true
+		}
+	}
+	return "", false
+}
+
+// variantRank orders classes by their variants: none, pseudo-classes and
+// other selectors, media queries, then breakpoints from smallest to largest.
+func (c *Compiler) variantRank(class string) int {
+	parts := splitTopLevel(class, ':')
+	rank := 0
+	for _, name := range parts[:len(parts)-1] {
+		def, _ := c.variants.Lookup(name)
+		r := 1
+		if def.AtRule != "" {
+			r = 2
+		}
+		for i, screen := range c.variants.Screens() {
+			if name == screen || name == "max-"+screen {
+				r = 3 + i
+			}
+		}
+		rank = max(rank, r)
+	}
+	return rank
+}
+
+// UnknownClassError reports a class that matches no utility.
+type UnknownClassError struct {
+	Class       string
+	Suggestions []string // similar known classes, closest first
+}
+
+func (e *UnknownClassError) Error() string {
+	msg := fmt.Sprintf("tailwind: unknown class %q", e.Class)
+	if len(e.Suggestions) > 0 {
+		quoted := make([]string, len(e.Suggestions))
+		for i, s := range e.Suggestions {
+			quoted[i] = strconv.Quote(s)
+		}
+		msg += "; did you mean " + strings.Join(quoted, " or ") + "?"
+	}
+	return msg
+}
+
+// suggest returns up to three known classes close to an unknown one.
+func (c *Compiler) suggest(utility string) []string {
+	c.candidatesOnce.Do(func() {
+		for name := range c.static {
+			c.candidates = append(c.candidates, name)
+		}
+		for name := range c.plugins {
+			c.candidates = append(c.candidates, name)
+		}
+		for prefix, matchers := range c.matchers {
+			for _, m := range matchers {
+				for _, entry := range m.values {
+					c.candidates = append(c.candidates, ClassName(prefix, entry.key))
+				}
+			}
+		}
+		sort.Strings(c.candidates)
+	})
+
+	limit := 1 + len(utility)/4
+	type scored struct {
+		name string
+		dist int
+	}
+	var matches []scored
+	for _, name := range c.candidates {
+		if d := levenshtein(utility, name); d <= limit {
+			matches = append(matches, scored{name, d})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].dist < matches[j].dist })
+
+	var suggestions []string
+	for i := 0; i < len(matches) && i < 3; i++ {
+		suggestions = append(suggestions, matches[i].name)
+	}
+	return suggestions
+}
+
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// Arbitrary values
+
+// isArbitrary reports whether s is a single bracketed value, such as
+// [#bada55] but not [#bada55]/[.5].
+func isArbitrary(s string) bool {
+	if len(s) <= 2 || s[0] != '[' {
+		return false
+	}
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '[':
+			depth++
+		case ']':
+			if depth--; depth == 0 {
+				return i == len(s)-1
+			}
+		}
+	}
+	return false
+}
+
+// arbitraryValue returns the CSS text of an arbitrary value such as
+// [calc(100%_-_1rem)], where underscores stand for spaces and \_ for an
+// underscore.
+func arbitraryValue(s string) string {
+	s = s[1 : len(s)-1]
+	s = strings.ReplaceAll(s, `\_`, "\x00")
+	s = strings.ReplaceAll(s, "_", " ")
+	return strings.ReplaceAll(s, "\x00", "_")
+}
+
+// typeHint splits a type hint such as color: or length: off an arbitrary
+// value.
+func typeHint(raw string) (valueType, string, bool) {
+	hint, rest, ok := strings.Cut(raw, ":")
+	if !ok {
+		return "", raw, false
+	}
+	switch valueType(hint) {
+	case typeColor, typeLength, typeNumber:
+		return valueType(hint), rest, true
+	}
+	return "", raw, false
+}
+
+// inferType guesses the type of an arbitrary value.
+func inferType(raw string) valueType {
+	lower := strings.ToLower(raw)
+	switch {
+	case strings.HasPrefix(lower, "#"):
+		return typeColor
+	case strings.HasPrefix(lower, "var("), strings.HasPrefix(lower, "url("):
+		return typeAny
+	}
+	for _, fn := range []string{"rgb", "rgba", "hsl", "hsla", "hwb", "lab", "lch", "oklab", "oklch", "color", "color-mix", "light-dark"} {
+		if strings.HasPrefix(lower, fn+"(") {
+			return typeColor
+		}
+	}
+	for _, fn := range []string{"calc", "min", "max", "clamp"} {
+		if strings.HasPrefix(lower, fn+"(") {
+			return typeLength
+		}
+	}
+	if _, err := strconv.ParseFloat(raw, 64); err == nil {
+		return typeNumber
+	}
+	num := strings.TrimRight(lower, "abcdefghijklmnopqrstuvwxyz%")
+	if _, err := strconv.ParseFloat(num, 64); err == nil && num != lower {
+		return typeLength
+	}
+	if namedColors[lower] {
+		return typeColor
+	}
+	return typeAny
+}
+
+// namedColors are the color keywords recognized in arbitrary values.
+var namedColors = map[string]bool{
+	"transparent": true, "currentcolor": true, "black": true, "white": true, "red": true,
+	"green": true, "blue": true, "yellow": true, "orange": true, "purple": true, "pink": true,
+	"gray": true, "grey": true, "brown": true, "cyan": true, "magenta": true, "lime": true,
+	"navy": true, "teal": true, "olive": true, "maroon": true, "silver": true, "aqua": true,
+	"fuchsia": true, "rebeccapurple": true,
+}
+
+// withAlpha applies an opacity modifier to a color. Hex colors become
+// rgb() with an alpha; other colors are mixed with transparent.
+func withAlpha(color css.Value, alpha string) css.Value {
+	s := color.String()
+	if r, g, b, ok := parseHex(s); ok {
+		return css.Color(fmt.Sprintf("rgb(%d %d %d / %s)", r, g, b, alpha))
+	}
+	percent := alpha
+	if f, err := strconv.ParseFloat(alpha, 64); err == nil {
+		percent = strconv.FormatFloat(f*100, 'f', -1, 64) + "%"
+	} else if !strings.HasSuffix(alpha, "%") {
+		percent = "calc(" + alpha + " * 100%)" // var(--opacity-50)
+	}
+	return css.Color(fmt.Sprintf("color-mix(in srgb, %s %s, transparent)", s, percent))
+}
+
+// parseHex parses #rgb and #rrggbb colors.
+func parseHex(s string) (r, g, b uint8, ok bool) {
+	if !strings.HasPrefix(s, "#") {
+		return 0, 0, 0, false
+	}
+	hex := s[1:]
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	n, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(n >> 16), uint8(n >> 8), uint8(n), true
+}
+
+// negate negates a value for negative utilities such as -mt-4. Zeros, in
+// any unit, are returned as is.
+func negate(v css.Value) css.Value {
+	s := v.String()
+	if zero, err := strconv.ParseFloat(strings.TrimRight(s, "abcdefghijklmnopqrstuvwxyz%"), 64); err == nil && zero == 0 {
+		return v
+	}
+	switch {
+	case strings.HasPrefix(s, "-"):
+		return css.Raw(s[1:])
+	case strings.ContainsAny(s, "( "):
+		return css.Raw("calc(" + s + " * -1)")
+	}
+	return css.Raw("-" + s)
+}
+
+// defaultCompiler compiles with the default configuration.
+var defaultCompiler = sync.OnceValue(func() *Compiler { return NewCompiler(DefaultConfig()) })
+
+// Compile compiles a class string with the default configuration. See
+// Compiler.Compile.
+func Compile(classes string) (css.Stylesheet, []error) {
+	return defaultCompiler().Compile(classes)
+}
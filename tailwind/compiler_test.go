@@ -0,0 +1,234 @@
+package tailwind
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestCompileClasses(t *testing.T) {
+	tests := []struct {
+		class string
+		want  string
+	}{
+		{"p-4", `.p-4{padding:1rem}`},
+		{"px-0.5", `.px-0\.5{padding-left:0.125rem;padding-right:0.125rem}`},
+		{"gap-x-2", `.gap-x-2{column-gap:0.5rem}`},
+		{"-mt-4", `.-mt-4{margin-top:-1rem}`},
+		{"-inset-x-[var(--gutter)]", `.-inset-x-\[var\(--gutter\)\]{left:calc(var(--gutter) * -1);right:calc(var(--gutter) * -1)}`},
+		{"w-1/3", `.w-1\/3{width:33.333333%}`},
+		{"max-w-screen-md", `.max-w-screen-md{max-width:768px}`},
+		{"bg-blue-500", `.bg-blue-500{background-color:#3b82f6}`},
+		{"bg-blue-500/50", `.bg-blue-500\/50{background-color:rgb(59 130 246 / 0.500)}`},
+		{"bg-[#bada55]/[.37]", `.bg-\[\#bada55\]\/\[\.37\]{background-color:rgb(186 218 85 / .37)}`},
+		{"text-current/25", `.text-current\/25{color:color-mix(in srgb, currentColor 25%, transparent)}`},
+		{"text-lg", `.text-lg{font-size:1.125rem;line-height:1.75rem}`},
+		{"text-[#333]", `.text-\[\#333\]{color:#333}`},
+		{"text-[14px]", `.text-\[14px\]{font-size:14px}`},
+		{"text-[length:var(--size)]", `.text-\[length\:var\(--size\)\]{font-size:var(--size)}`},
+		{"top-[calc(100%_-_1rem)]", `.top-\[calc\(100\%_-_1rem\)\]{top:calc(100% - 1rem)}`},
+		{"font-bold", `.font-bold{font-weight:700}`},
+		{"font-[550]", `.font-\[550\]{font-weight:550}`},
+		{"rounded", `.rounded{border-radius:0.25rem}`},
+		{"border", `.border{border-width:1px}`},
+		{"border-red-500", `.border-red-500{border-color:#ef4444}`},
+		{"opacity-50", `.opacity-50{opacity:0.500}`},
+		{"grid-cols-3", `.grid-cols-3{grid-template-columns:repeat(3, minmax(0, 1fr))}`},
+		{"grid-cols-[200px_minmax(0,1fr)]", `.grid-cols-\[200px_minmax\(0\,1fr\)\]{grid-template-columns:200px minmax(0,1fr)}`},
+		{"grid-rows-subgrid", `.grid-rows-subgrid{grid-template-rows:subgrid}`},
+		{"col-span-2", `.col-span-2{grid-column:span 2 / span 2}`},
+		{"col-span-full", `.col-span-full{grid-column:1 / -1}`},
+		{"col-start-13", `.col-start-13{grid-column-start:13}`},
+		{"row-end-[-1]", `.row-end-\[-1\]{grid-row-end:-1}`},
+		{"grid-flow-col-dense", `.grid-flow-col-dense{grid-auto-flow:column dense}`},
+		{"auto-rows-fr", `.auto-rows-fr{grid-auto-rows:minmax(0, 1fr)}`},
+		{"place-content-between", `.place-content-between{place-content:space-between}`},
+		{"place-self-center", `.place-self-center{place-self:center}`},
+		{"rotate-45", `.rotate-45{rotate:45deg}`},
+		{"-rotate-[17deg]", `.-rotate-\[17deg\]{rotate:-17deg}`},
+		{"-translate-x-1/2", `.-translate-x-1\/2{--tw-translate-x:-50%;translate:var(--tw-translate-x) var(--tw-translate-y)}`},
+		{"scale-110", `.scale-110{--tw-scale-x:1.1;--tw-scale-y:1.1;scale:var(--tw-scale-x) var(--tw-scale-y)}`},
+		{"-scale-x-100", `.-scale-x-100{--tw-scale-x:-1;scale:var(--tw-scale-x) var(--tw-scale-y)}`},
+		{"skew-y-[3deg]", `.skew-y-\[3deg\]{--tw-skew-y:skewY(3deg);transform:var(--tw-skew-x) var(--tw-skew-y)}`},
+		{"origin-bottom-left", `.origin-bottom-left{transform-origin:bottom left}`},
+		{"transition-colors", `.transition-colors{transition-property:color, background-color, border-color, text-decoration-color, fill, stroke;transition-timing-function:cubic-bezier(0.4, 0, 0.2, 1);transition-duration:150ms}`},
+		{"transition-none", `.transition-none{transition-property:none}`},
+		{"duration-300", `.duration-300{transition-duration:300ms}`},
+		{"ease-in", `.ease-in{transition-timing-function:cubic-bezier(0.4, 0, 1, 1)}`},
+		{"delay-[2s]", `.delay-\[2s\]{transition-delay:2s}`},
+		{"animate-pulse", `.animate-pulse{animation:pulse 2s cubic-bezier(0.4, 0, 0.6, 1) infinite}`},
+		{"space-x-4", `.space-x-4 > :not([hidden]) ~ :not([hidden]){--tw-space-x-reverse:0;margin-right:calc(1rem * var(--tw-space-x-reverse));margin-left:calc(1rem * calc(1 - var(--tw-space-x-reverse)))}`},
+		{"-space-y-2", `.-space-y-2 > :not([hidden]) ~ :not([hidden]){--tw-space-y-reverse:0;margin-bottom:calc(-0.5rem * var(--tw-space-y-reverse));margin-top:calc(-0.5rem * calc(1 - var(--tw-space-y-reverse)))}`},
+		{"space-x-reverse", `.space-x-reverse > :not([hidden]) ~ :not([hidden]){--tw-space-x-reverse:1}`},
+		{"divide-y", `.divide-y > :not([hidden]) ~ :not([hidden]){--tw-divide-y-reverse:0;border-bottom-width:calc(1px * var(--tw-divide-y-reverse));border-top-width:calc(1px * calc(1 - var(--tw-divide-y-reverse)))}`},
+		{"divide-dashed", `.divide-dashed > :not([hidden]) ~ :not([hidden]){border-style:dashed}`},
+		{"divide-gray-200", `.divide-gray-200 > :not([hidden]) ~ :not([hidden]){border-color:#e5e7eb}`},
+		{"ring-2", `.ring-2{--tw-ring-offset-shadow:var(--tw-ring-inset, ) 0 0 0 var(--tw-ring-offset-width) var(--tw-ring-offset-color);--tw-ring-shadow:var(--tw-ring-inset, ) 0 0 0 calc(2px + var(--tw-ring-offset-width)) var(--tw-ring-color, rgb(59 130 246 / 0.5));box-shadow:var(--tw-ring-offset-shadow), var(--tw-ring-shadow), var(--tw-shadow)}`},
+		{"ring-inset", `.ring-inset{--tw-ring-inset:inset}`},
+		{"ring-red-500/50", `.ring-red-500\/50{--tw-ring-color:rgb(239 68 68 / 0.500)}`},
+		{"ring-offset-2", `.ring-offset-2{--tw-ring-offset-width:2px}`},
+		{"ring-offset-white", `.ring-offset-white{--tw-ring-offset-color:#ffffff}`},
+		{"outline-none", `.outline-none{outline:2px solid transparent;outline-offset:2px}`},
+		{"outline-dashed", `.outline-dashed{outline-style:dashed}`},
+		{"outline-2", `.outline-2{outline-width:2px}`},
+		{"-outline-offset-2", `.-outline-offset-2{outline-offset:-2px}`},
+		{"outline-blue-500", `.outline-blue-500{outline-color:#3b82f6}`},
+		{"!p-2", `.\!p-2{padding:0.5rem !important}`},
+		{"p-2!", `.p-2\!{padding:0.5rem !important}`},
+		{"[mask-type:luminance]", `.\[mask-type\:luminance\]{mask-type:luminance}`},
+		{"md:hover:bg-blue-500", `@media (min-width: 768px){.md\:hover\:bg-blue-500:hover{background-color:#3b82f6}}`},
+		{"[&:nth-child(3)]:underline", `.\[\&\:nth-child\(3\)\]\:underline:nth-child(3){text-decoration-line:underline}`},
+		{"data-[state=open]:flex", `.data-\[state\=open\]\:flex[data-state=open]{display:flex}`},
+		{"aria-checked:block", `.aria-checked\:block[aria-checked="true"]{display:block}`},
+		{"supports-[display:grid]:grid", `@supports (display:grid){.supports-\[display\:grid\]\:grid{display:grid}}`},
+		{"min-[900px]:hidden", `@media (min-width: 900px){.min-\[900px\]\:hidden{display:none}}`},
+	}
+	compiler := NewCompiler(DefaultConfig())
+	for _, tt := range tests {
+		item, err := compiler.CompileClass(tt.class)
+		if err != nil {
+			t.Errorf("CompileClass(%q) error: %v", tt.class, err)
+			continue
+		}
+		if got := item.String(); got != tt.want {
+			t.Errorf("CompileClass(%q) =\n%s\nwant\n%s", tt.class, got, tt.want)
+		}
+	}
+}
+
+func TestCompileStylesheets(t *testing.T) {
+	tests := []struct {
+		name    string
+		classes string
+		want    []string
+	}{
+		{
+			"order",
+			"lg:p-8 hover:px-2 px-4 md:p-6 p-2 px-4 dark:p-1",
+			[]string{
+				`.p-2{padding:0.5rem}`,
+				`.px-4{padding-left:1rem;padding-right:1rem}`,
+				`.hover\:px-2:hover{padding-left:0.5rem;padding-right:0.5rem}`,
+				`@media (prefers-color-scheme: dark){.dark\:p-1{padding:0.25rem}}`,
+				`@media (min-width: 768px){.md\:p-6{padding:1.5rem}}`,
+				`@media (min-width: 1024px){.lg\:p-8{padding:2rem}}`,
+			},
+		},
+		{
+			"transforms",
+			"translate-x-4 -translate-y-2 rotate-45 scale-110 hover:scale-95",
+			[]string{
+				`@property --tw-translate-x{syntax:'*';inherits:false;initial-value:0}`,
+				`@property --tw-translate-y{syntax:'*';inherits:false;initial-value:0}`,
+				`@property --tw-scale-x{syntax:'*';inherits:false;initial-value:1}`,
+				`@property --tw-scale-y{syntax:'*';inherits:false;initial-value:1}`,
+				`.translate-x-4{--tw-translate-x:1rem;translate:var(--tw-translate-x) var(--tw-translate-y)}`,
+				`.-translate-y-2{--tw-translate-y:-0.5rem;translate:var(--tw-translate-x) var(--tw-translate-y)}`,
+				`.rotate-45{rotate:45deg}`,
+				`.scale-110{--tw-scale-x:1.1;--tw-scale-y:1.1;scale:var(--tw-scale-x) var(--tw-scale-y)}`,
+				`.hover\:scale-95:hover{--tw-scale-x:0.95;--tw-scale-y:0.95;scale:var(--tw-scale-x) var(--tw-scale-y)}`,
+			},
+		},
+		{
+			"rings",
+			"ring-offset-2 ring focus:ring-4 ring-blue-500 md:divide-x-2 hover:space-y-1",
+			[]string{
+				`@property --tw-ring-inset{syntax:'*';inherits:false}`,
+				`@property --tw-ring-color{syntax:'*';inherits:false}`,
+				`@property --tw-ring-offset-width{syntax:'*';inherits:false;initial-value:0px}`,
+				`@property --tw-ring-offset-color{syntax:'*';inherits:false;initial-value:#fff}`,
+				`@property --tw-ring-offset-shadow{syntax:'*';inherits:false;initial-value:0 0 #0000}`,
+				`@property --tw-ring-shadow{syntax:'*';inherits:false;initial-value:0 0 #0000}`,
+				`@property --tw-shadow{syntax:'*';inherits:false;initial-value:0 0 #0000}`,
+				`@property --tw-space-y-reverse{syntax:'*';inherits:false;initial-value:0}`,
+				`@property --tw-divide-x-reverse{syntax:'*';inherits:false;initial-value:0}`,
+				`.ring{--tw-ring-offset-shadow:var(--tw-ring-inset, ) 0 0 0 var(--tw-ring-offset-width) var(--tw-ring-offset-color);--tw-ring-shadow:var(--tw-ring-inset, ) 0 0 0 calc(3px + var(--tw-ring-offset-width)) var(--tw-ring-color, rgb(59 130 246 / 0.5));box-shadow:var(--tw-ring-offset-shadow), var(--tw-ring-shadow), var(--tw-shadow)}`,
+				`.ring-blue-500{--tw-ring-color:#3b82f6}`,
+				`.ring-offset-2{--tw-ring-offset-width:2px}`,
+				`.hover\:space-y-1:hover > :not([hidden]) ~ :not([hidden]){--tw-space-y-reverse:0;margin-bottom:calc(0.25rem * var(--tw-space-y-reverse));margin-top:calc(0.25rem * calc(1 - var(--tw-space-y-reverse)))}`,
+				`.focus\:ring-4:focus{--tw-ring-offset-shadow:var(--tw-ring-inset, ) 0 0 0 var(--tw-ring-offset-width) var(--tw-ring-offset-color);--tw-ring-shadow:var(--tw-ring-inset, ) 0 0 0 calc(4px + var(--tw-ring-offset-width)) var(--tw-ring-color, rgb(59 130 246 / 0.5));box-shadow:var(--tw-ring-offset-shadow), var(--tw-ring-shadow), var(--tw-shadow)}`,
+				`@media (min-width: 768px){.md\:divide-x-2 > :not([hidden]) ~ :not([hidden]){--tw-divide-x-reverse:0;border-right-width:calc(2px * var(--tw-divide-x-reverse));border-left-width:calc(2px * calc(1 - var(--tw-divide-x-reverse)))}}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stylesheet, errs := Compile(tt.classes)
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+			var got []string
+			for _, item := range stylesheet.Items {
+				got = append(got, item.String())
+			}
+			if strings.Join(got, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("Compile(%q) =\n%s\nwant\n%s", tt.classes, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestCompileKeyframes(t *testing.T) {
+	for _, variables := range []bool{false, true} {
+		config := DefaultConfig()
+		config.CSSVariables = variables
+		stylesheet, errs := NewCompiler(config).Compile("animate-spin hover:animate-spin md:animate-[ping_2s_infinite] animate-none [animation:spin_3s]")
+		if len(errs) > 0 {
+			t.Fatal(errs)
+		}
+		var keyframes []string
+		for _, item := range stylesheet.Items {
+			if s := item.String(); strings.HasPrefix(s, "@keyframes") {
+				keyframes = append(keyframes, s)
+			}
+		}
+		want := []string{
+			`@keyframes spin{to{transform:rotate(360deg)}}`,
+			`@keyframes ping{75%, 100%{transform:scale(2);opacity:0}}`,
+		}
+		if strings.Join(keyframes, "\n") != strings.Join(want, "\n") {
+			t.Errorf("CSSVariables=%v: keyframes =\n%s\nwant\n%s", variables, strings.Join(keyframes, "\n"), strings.Join(want, "\n"))
+		}
+	}
+}
+
+func TestCompileUnknownClasses(t *testing.T) {
+	_, errs := Compile("flex bg-blu-500 p-4 -bg-red-500 wobble:p-4")
+	if len(errs) != 3 {
+		t.Fatalf("got %d errors, want 3: %v", len(errs), errs)
+	}
+
+	var unknown *UnknownClassError
+	if !errors.As(errs[0], &unknown) || unknown.Class != "bg-blu-500" {
+		t.Fatalf("errs[0] = %v, want an UnknownClassError for bg-blu-500", errs[0])
+	}
+	if len(unknown.Suggestions) == 0 || unknown.Suggestions[0] != "bg-blue-500" {
+		t.Errorf("suggestions = %v, want bg-blue-500 first", unknown.Suggestions)
+	}
+	if msg := errs[0].Error(); !strings.Contains(msg, `did you mean "bg-blue-500"`) {
+		t.Errorf("error message = %s", msg)
+	}
+
+	// Colors cannot be negative.
+	if !errors.As(errs[1], &unknown) || unknown.Class != "-bg-red-500" {
+		t.Errorf("errs[1] = %v, want an UnknownClassError for -bg-red-500", errs[1])
+	}
+	if !strings.Contains(errs[2].Error(), `unknown variant "wobble"`) {
+		t.Errorf("errs[2] = %v, want an unknown variant error", errs[2])
+	}
+}
+
+func TestCompileCustomTheme(t *testing.T) {
+	config := DefaultConfig()
+	config.Theme.Colors.Blue500 = ColorFromHex("blue-500", "#0000ff")
+	config.Theme.Screens = ScreensConfig{Md: LengthFromPx("md", 900)}
+
+	stylesheet, errs := NewCompiler(config).Compile("md:bg-blue-500")
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	want := `@media (min-width: 900px){.md\:bg-blue-500{background-color:#0000ff}}`
+	if got := stylesheet.String(); got != want {
+		t.Errorf("Compile = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,635 @@
+// This file registers the utilities known to the compiler and reads their
+// values from the theme.
+
+package tailwind
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/cssgen"
+)
+
+// registerUtilities registers the utilities in the order their rules are
+// emitted, which follows Tailwind: layout before box model, box model before
+// typography, and shorthands such as p before the longhands such as px and
+// pt that override them.
+func (c *Compiler) registerUtilities() {
+	theme := c.theme
+	spacing := themeScale(theme.Spacing)
+	colors := themeScale(theme.Colors)
+	fractions := fractionScale()
+
+	// Layout
+	c.addKeywords(cssgen.Position, "static", "fixed", "absolute", "relative", "sticky")
+	c.addStatic("visible", css.Set(cssgen.Visibility, css.Keyword("visible")))
+	c.addStatic("invisible", css.Set(cssgen.Visibility, css.Keyword("hidden")))
+	c.addStatic("collapse", css.Set(cssgen.Visibility, css.Keyword("collapse")))
+
+	insets := concat(spacing, fractions, keywordScale("auto"), scale("full", "100%"))
+	c.addMatch("inset", insets, lengthTypes, true, setAll(cssgen.Inset))
+	c.addMatch("inset-x", insets, lengthTypes, true, setAll(cssgen.Left, cssgen.Right))
+	c.addMatch("inset-y", insets, lengthTypes, true, setAll(cssgen.Top, cssgen.Bottom))
+	c.addMatch("top", insets, lengthTypes, true, setAll(cssgen.Top))
+	c.addMatch("right", insets, lengthTypes, true, setAll(cssgen.Right))
+	c.addMatch("bottom", insets, lengthTypes, true, setAll(cssgen.Bottom))
+	c.addMatch("left", insets, lengthTypes, true, setAll(cssgen.Left))
+
+	zIndex := concat(scale("0", "0", "10", "10", "20", "20", "30", "30", "40", "40", "50", "50"), keywordScale("auto"))
+	c.addMatch("z", zIndex, []valueType{typeNumber, typeAny}, true, setAll(cssgen.ZIndex))
+
+	// Margin
+	margins := concat(spacing, keywordScale("auto"))
+	c.addMatch("m", margins, lengthTypes, true, setAll(cssgen.Margin))
+	c.addMatch("mx", margins, lengthTypes, true, setAll(cssgen.MarginLeft, cssgen.MarginRight))
+	c.addMatch("my", margins, lengthTypes, true, setAll(cssgen.MarginTop, cssgen.MarginBottom))
+	c.addMatch("mt", margins, lengthTypes, true, setAll(cssgen.MarginTop))
+	c.addMatch("mr", margins, lengthTypes, true, setAll(cssgen.MarginRight))
+	c.addMatch("mb", margins, lengthTypes, true, setAll(cssgen.MarginBottom))
+	c.addMatch("ml", margins, lengthTypes, true, setAll(cssgen.MarginLeft))
+
+	// Display
+	c.addKeywords(cssgen.Display, "block", "inline-block", "inline", "flex", "inline-flex", "table",
+		"inline-table", "table-row", "table-cell", "flow-root", "grid", "inline-grid", "contents", "list-item")
+	c.addStatic("hidden", cssgen.SetDisplay(cssgen.DisplayValNone))
+
+	// Sizing
+	aspect := themeScale(theme.AspectRatio)
+	c.addMatch("aspect", aspect, []valueType{typeAny, typeNumber}, false, setAll(cssgen.AspectRatio))
+
+	widths := concat(spacing, fractions, keywordScale("auto", "min", "max", "fit"),
+		scale("full", "100%", "screen", "100vw", "svw", "100svw", "lvw", "100lvw", "dvw", "100dvw", "min", "min-content", "max", "max-content", "fit", "fit-content"))
+	heights := concat(spacing, fractions, keywordScale("auto"),
+		scale("full", "100%", "screen", "100vh", "svh", "100svh", "lvh", "100lvh", "dvh", "100dvh", "min", "min-content", "max", "max-content", "fit", "fit-content"))
+	c.addMatch("size", concat(spacing, fractions, keywordScale("auto"), scale("full", "100%", "min", "min-content", "max", "max-content", "fit", "fit-content")),
+		lengthTypes, false, setAll(cssgen.Width, cssgen.Height))
+	c.addMatch("h", heights, lengthTypes, false, setAll(cssgen.Height))
+	c.addMatch("min-h", heights, lengthTypes, false, setAll(cssgen.MinHeight))
+	c.addMatch("max-h", concat(heights, keywordScale("none")), lengthTypes, false, setAll(cssgen.MaxHeight))
+	c.addMatch("w", widths, lengthTypes, false, setAll(cssgen.Width))
+	c.addMatch("min-w", widths, lengthTypes, false, setAll(cssgen.MinWidth))
+	c.addMatch("max-w", concat(widths, keywordScale("none"), screenScale(theme.Screens)), lengthTypes, false, setAll(cssgen.MaxWidth))
+
+	// Flexbox
+	c.addStatic("flex-1", css.Set(cssgen.Flex, css.Raw("1 1 0%")))
+	c.addStatic("flex-auto", css.Set(cssgen.Flex, css.Raw("1 1 auto")))
+	c.addStatic("flex-initial", css.Set(cssgen.Flex, css.Raw("0 1 auto")))
+	c.addStatic("flex-none", css.Set(cssgen.Flex, css.Keyword("none")))
+	c.addMatch("shrink", scale("", "1", "0", "0"), []valueType{typeNumber}, false, setAll(cssgen.FlexShrink))
+	c.addMatch("grow", scale("", "1", "0", "0"), []valueType{typeNumber}, false, setAll(cssgen.FlexGrow))
+	c.addMatch("basis", concat(spacing, fractions, keywordScale("auto"), scale("full", "100%")), lengthTypes, false, setAll(cssgen.FlexBasis))
+	c.addStatic("flex-row", cssgen.SetFlexDirection(cssgen.FlexDirectionValRow))
+	c.addStatic("flex-row-reverse", cssgen.SetFlexDirection(cssgen.FlexDirectionValRowReverse))
+	c.addStatic("flex-col", cssgen.SetFlexDirection(cssgen.FlexDirectionValColumn))
+	c.addStatic("flex-col-reverse", cssgen.SetFlexDirection(cssgen.FlexDirectionValColumnReverse))
+	c.addStatic("flex-wrap", cssgen.SetFlexWrap(cssgen.FlexWrapValWrap))
+	c.addStatic("flex-wrap-reverse", cssgen.SetFlexWrap(cssgen.FlexWrapValWrapReverse))
+	c.addStatic("flex-nowrap", cssgen.SetFlexWrap(cssgen.FlexWrapValNowrap))
+
+	c.addStatic("justify-normal", css.Set(cssgen.JustifyContent, css.Keyword("normal")))
+	c.addStatic("justify-start", cssgen.SetJustifyContent(cssgen.JustifyContentValFlexStart))
+	c.addStatic("justify-end", cssgen.SetJustifyContent(cssgen.JustifyContentValFlexEnd))
+	c.addStatic("justify-center", cssgen.SetJustifyContent(cssgen.JustifyContentValCenter))
+	c.addStatic("justify-between", cssgen.SetJustifyContent(cssgen.JustifyContentValSpaceBetween))
+	c.addStatic("justify-around", cssgen.SetJustifyContent(cssgen.JustifyContentValSpaceAround))
+	c.addStatic("justify-evenly", cssgen.SetJustifyContent(cssgen.JustifyContentValSpaceEvenly))
+	c.addStatic("justify-stretch", cssgen.SetJustifyContent(cssgen.JustifyContentValStretch))
+	c.addStatic("items-start", cssgen.SetAlignItems(cssgen.AlignItemsValFlexStart))
+	c.addStatic("items-end", cssgen.SetAlignItems(cssgen.AlignItemsValFlexEnd))
+	c.addStatic("items-center", cssgen.SetAlignItems(cssgen.AlignItemsValCenter))
+	c.addStatic("items-baseline", cssgen.SetAlignItems(cssgen.AlignItemsValBaseline))
+	c.addStatic("items-stretch", cssgen.SetAlignItems(cssgen.AlignItemsValStretch))
+	c.addStatic("self-auto", css.Set(cssgen.AlignSelf, css.Keyword("auto")))
+	c.addStatic("self-start", css.Set(cssgen.AlignSelf, css.Keyword("flex-start")))
+	c.addStatic("self-end", css.Set(cssgen.AlignSelf, css.Keyword("flex-end")))
+	c.addStatic("self-center", css.Set(cssgen.AlignSelf, css.Keyword("center")))
+	c.addStatic("self-stretch", css.Set(cssgen.AlignSelf, css.Keyword("stretch")))
+	c.addStatic("self-baseline", css.Set(cssgen.AlignSelf, css.Keyword("baseline")))
+
+	// Grid
+	c.addMatch("grid-cols", themeScale(theme.GridTemplateColumns), anyTypes, false, setAll(cssgen.GridTemplateColumns))
+	c.addMatch("grid-rows", themeScale(theme.GridTemplateRows), anyTypes, false, setAll(cssgen.GridTemplateRows))
+	c.addMatch("col", themeScale(theme.GridColumn), anyTypes, false, setAll(cssgen.GridColumn))
+	c.addMatch("col-start", themeScale(theme.GridColumnStart), anyTypes, false, setAll(cssgen.GridColumnStart))
+	c.addMatch("col-end", themeScale(theme.GridColumnEnd), anyTypes, false, setAll(cssgen.GridColumnEnd))
+	c.addMatch("row", themeScale(theme.GridRow), anyTypes, false, setAll(cssgen.GridRow))
+	c.addMatch("row-start", themeScale(theme.GridRowStart), anyTypes, false, setAll(cssgen.GridRowStart))
+	c.addMatch("row-end", themeScale(theme.GridRowEnd), anyTypes, false, setAll(cssgen.GridRowEnd))
+	for _, flow := range gridFlows {
+		c.addStatic("grid-flow-"+flow.name, css.Set(cssgen.GridAutoFlow, css.Keyword(flow.value)))
+	}
+	c.addMatch("auto-cols", themeScale(theme.GridAutoColumns), anyTypes, false, setAll(cssgen.GridAutoColumns))
+	c.addMatch("auto-rows", themeScale(theme.GridAutoRows), anyTypes, false, setAll(cssgen.GridAutoRows))
+	for _, place := range placeContent {
+		c.addStatic("place-content-"+place.name, css.Set(cssgen.PlaceContent, css.Keyword(place.value)))
+	}
+	for _, place := range placeItems {
+		c.addStatic("place-items-"+place, css.Set(cssgen.PlaceItems, css.Keyword(place)))
+	}
+	for _, place := range placeSelf {
+		c.addStatic("place-self-"+place, css.Set(cssgen.PlaceSelf, css.Keyword(place)))
+	}
+
+	c.addMatch("gap", spacing, lengthTypes, false, setAll(cssgen.Gap))
+	c.addMatch("gap-x", spacing, lengthTypes, false, setAll(cssgen.ColumnGap))
+	c.addMatch("gap-y", spacing, lengthTypes, false, setAll(cssgen.RowGap))
+
+	// Space between
+	space := concat(spacing, themeScale(theme.Space))
+	c.addMatch("space-x", space, lengthTypes, true, reverseDecls(twSpaceXReverse, cssgen.MarginLeft, cssgen.MarginRight))
+	c.onChildren(betweenChildren)
+	c.require(twSpaceXReverse.Register(false, "0"))
+	c.addMatch("space-y", space, lengthTypes, true, reverseDecls(twSpaceYReverse, cssgen.MarginTop, cssgen.MarginBottom))
+	c.onChildren(betweenChildren)
+	c.require(twSpaceYReverse.Register(false, "0"))
+	c.addStatic("space-x-reverse", twSpaceXReverse.Set("1"))
+	c.onChildren(betweenChildren)
+	c.require(twSpaceXReverse.Register(false, "0"))
+	c.addStatic("space-y-reverse", twSpaceYReverse.Set("1"))
+	c.onChildren(betweenChildren)
+	c.require(twSpaceYReverse.Register(false, "0"))
+
+	// Transforms
+	c.addMatch("origin", themeScale(theme.TransformOrigin), []valueType{typeAny}, false, setAll(cssgen.TransformOrigin))
+	translate := concat(spacing, themeScale(theme.Translate))
+	c.addMatch("translate-x", translate, lengthTypes, true, transformDecls(cssgen.Translate, translateXY, "%s", twTranslateX))
+	c.require(translateProperties...)
+	c.addMatch("translate-y", translate, lengthTypes, true, transformDecls(cssgen.Translate, translateXY, "%s", twTranslateY))
+	c.require(translateProperties...)
+	c.addMatch("rotate", themeScale(theme.Rotate), lengthTypes, true, setAll(cssgen.Rotate))
+	c.addMatch("skew-x", themeScale(theme.Skew), lengthTypes, true, transformDecls(cssgen.Transform, skewXY, "skewX(%s)", twSkewX))
+	c.require(skewProperties...)
+	c.addMatch("skew-y", themeScale(theme.Skew), lengthTypes, true, transformDecls(cssgen.Transform, skewXY, "skewY(%s)", twSkewY))
+	c.require(skewProperties...)
+	scales := themeScale(theme.Scale)
+	c.addMatch("scale", scales, []valueType{typeNumber, typeAny}, true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleX, twScaleY))
+	c.require(scaleProperties...)
+	c.addMatch("scale-x", scales, []valueType{typeNumber, typeAny}, true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleX))
+	c.require(scaleProperties...)
+	c.addMatch("scale-y", scales, []valueType{typeNumber, typeAny}, true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleY))
+	c.require(scaleProperties...)
+	c.addStatic("transform-none", transformNone...)
+
+	// Overflow
+	for _, value := range []string{"auto", "hidden", "clip", "visible", "scroll"} {
+		c.addStatic("overflow-"+value, css.Set(cssgen.Overflow, css.Keyword(value)))
+		c.addStatic("overflow-x-"+value, css.Set(cssgen.OverflowX, css.Keyword(value)))
+		c.addStatic("overflow-y-"+value, css.Set(cssgen.OverflowY, css.Keyword(value)))
+	}
+	c.addStatic("truncate",
+		css.Set(cssgen.Overflow, css.Keyword("hidden")),
+		css.Set(cssgen.TextOverflow, css.Keyword("ellipsis")),
+		css.Set(cssgen.WhiteSpace, css.Keyword("nowrap")))
+
+	// Borders
+	radius := themeScale(theme.BorderRadius)
+	for i, entry := range radius {
+		if entry.key == "base" {
+			radius[i].key = ""
+		}
+	}
+	c.addMatch("rounded", radius, lengthTypes, false, setAll(cssgen.BorderRadius))
+	c.addMatch("rounded-t", radius, lengthTypes, false, setAll(cssgen.BorderTopLeftRadius, cssgen.BorderTopRightRadius))
+	c.addMatch("rounded-r", radius, lengthTypes, false, setAll(cssgen.BorderTopRightRadius, cssgen.BorderBottomRightRadius))
+	c.addMatch("rounded-b", radius, lengthTypes, false, setAll(cssgen.BorderBottomRightRadius, cssgen.BorderBottomLeftRadius))
+	c.addMatch("rounded-l", radius, lengthTypes, false, setAll(cssgen.BorderTopLeftRadius, cssgen.BorderBottomLeftRadius))
+	c.addMatch("rounded-tl", radius, lengthTypes, false, setAll(cssgen.BorderTopLeftRadius))
+	c.addMatch("rounded-tr", radius, lengthTypes, false, setAll(cssgen.BorderTopRightRadius))
+	c.addMatch("rounded-br", radius, lengthTypes, false, setAll(cssgen.BorderBottomRightRadius))
+	c.addMatch("rounded-bl", radius, lengthTypes, false, setAll(cssgen.BorderBottomLeftRadius))
+
+	borderWidths := themeScale(theme.BorderWidth)
+	c.addMatch("border", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderWidth))
+	c.addMatch("border-x", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderLeftWidth, cssgen.BorderRightWidth))
+	c.addMatch("border-y", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderTopWidth, cssgen.BorderBottomWidth))
+	c.addMatch("border-t", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderTopWidth))
+	c.addMatch("border-r", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderRightWidth))
+	c.addMatch("border-b", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderBottomWidth))
+	c.addMatch("border-l", borderWidths, []valueType{typeLength}, false, setAll(cssgen.BorderLeftWidth))
+	c.addKeywords(cssgen.BorderStyle, "border-solid", "border-dashed", "border-dotted", "border-double", "border-hidden", "border-none")
+
+	// Colors
+	borderColors := concat(colors, themeScale(theme.BorderColor))
+	c.addMatch("border", borderColors, colorTypes, false, setAll(cssgen.BorderColor))
+	c.addMatch("border-x", borderColors, colorTypes, false, setAll(cssgen.BorderLeftColor, cssgen.BorderRightColor))
+	c.addMatch("border-y", borderColors, colorTypes, false, setAll(cssgen.BorderTopColor, cssgen.BorderBottomColor))
+	c.addMatch("border-t", borderColors, colorTypes, false, setAll(cssgen.BorderTopColor))
+	c.addMatch("border-r", borderColors, colorTypes, false, setAll(cssgen.BorderRightColor))
+	c.addMatch("border-b", borderColors, colorTypes, false, setAll(cssgen.BorderBottomColor))
+	c.addMatch("border-l", borderColors, colorTypes, false, setAll(cssgen.BorderLeftColor))
+	c.addMatch("bg", concat(colors, themeScale(theme.BackgroundColor)), colorTypes, false, setAll(cssgen.BackgroundColor))
+	c.addMatch("fill", concat(colors, themeScale(theme.Fill)), colorTypes, false, setAll(cssgen.Fill))
+	c.addMatch("stroke", concat(colors, themeScale(theme.StrokeColor)), colorTypes, false, setAll(cssgen.Stroke))
+
+	// Divide
+	divideWidths := themeScale(theme.DivideWidth)
+	c.addMatch("divide-x", divideWidths, []valueType{typeLength}, false, reverseDecls(twDivideXReverse, cssgen.BorderLeftWidth, cssgen.BorderRightWidth))
+	c.onChildren(betweenChildren)
+	c.require(twDivideXReverse.Register(false, "0"))
+	c.addMatch("divide-y", divideWidths, []valueType{typeLength}, false, reverseDecls(twDivideYReverse, cssgen.BorderTopWidth, cssgen.BorderBottomWidth))
+	c.onChildren(betweenChildren)
+	c.require(twDivideYReverse.Register(false, "0"))
+	c.addStatic("divide-x-reverse", twDivideXReverse.Set("1"))
+	c.onChildren(betweenChildren)
+	c.require(twDivideXReverse.Register(false, "0"))
+	c.addStatic("divide-y-reverse", twDivideYReverse.Set("1"))
+	c.onChildren(betweenChildren)
+	c.require(twDivideYReverse.Register(false, "0"))
+	for _, style := range []string{"solid", "dashed", "dotted", "double", "none"} {
+		c.addStatic("divide-"+style, css.Set(cssgen.BorderStyle, css.Keyword(style)))
+		c.onChildren(betweenChildren)
+	}
+	_, divideColors := splitDefault(concat(borderColors, themeScale(theme.DivideColor)), nil)
+	c.addMatch("divide", divideColors, colorTypes, false, setAll(cssgen.BorderColor))
+	c.onChildren(betweenChildren)
+
+	// Padding
+	c.addMatch("p", spacing, lengthTypes, false, setAll(cssgen.Padding))
+	c.addMatch("px", spacing, lengthTypes, false, setAll(cssgen.PaddingLeft, cssgen.PaddingRight))
+	c.addMatch("py", spacing, lengthTypes, false, setAll(cssgen.PaddingTop, cssgen.PaddingBottom))
+	c.addMatch("pt", spacing, lengthTypes, false, setAll(cssgen.PaddingTop))
+	c.addMatch("pr", spacing, lengthTypes, false, setAll(cssgen.PaddingRight))
+	c.addMatch("pb", spacing, lengthTypes, false, setAll(cssgen.PaddingBottom))
+	c.addMatch("pl", spacing, lengthTypes, false, setAll(cssgen.PaddingLeft))
+
+	// Typography
+	c.addKeywords(cssgen.TextAlign, "text-left", "text-center", "text-right", "text-justify", "text-start", "text-end")
+	c.addMatch("font", themeScale(theme.FontFamily), []valueType{typeAny}, false, setAll(cssgen.FontFamily))
+	c.addMatch("text", themeScale(theme.FontSize), []valueType{typeLength}, false, func(v css.Value) []css.Decl {
+		if size, ok := v.(fontSize); ok && size.lineHeight != nil {
+			return []css.Decl{css.Set(cssgen.FontSize, size.size), css.Set(cssgen.LineHeight, size.lineHeight)}
+		}
+		return []css.Decl{css.Set(cssgen.FontSize, v)}
+	})
+	c.addMatch("font", themeScale(theme.FontWeight), []valueType{typeNumber}, false, setAll(cssgen.FontWeight))
+	c.addKeywords(cssgen.TextTransform, "uppercase", "lowercase", "capitalize")
+	c.addStatic("normal-case", css.Set(cssgen.TextTransform, css.Keyword("none")))
+	c.addStatic("italic", css.Set(cssgen.FontStyle, css.Keyword("italic")))
+	c.addStatic("not-italic", css.Set(cssgen.FontStyle, css.Keyword("normal")))
+	c.addKeywords(cssgen.TextDecorationLine, "underline", "overline", "line-through")
+	c.addStatic("no-underline", css.Set(cssgen.TextDecorationLine, css.Keyword("none")))
+	c.addMatch("text", concat(colors, themeScale(theme.TextColor)), colorTypes, false, setAll(cssgen.Color))
+	c.addMatch("decoration", concat(colors, themeScale(theme.TextDecorationColor)), colorTypes, false, setAll(cssgen.TextDecorationColor))
+	c.addMatch("accent", concat(colors, themeScale(theme.AccentColor)), colorTypes, false, setAll(cssgen.AccentColor))
+	c.addMatch("caret", concat(colors, themeScale(theme.CaretColor)), colorTypes, false, setAll(cssgen.CaretColor))
+
+	// Effects
+	c.addMatch("opacity", themeScale(theme.Opacity), []valueType{typeNumber, typeAny}, false, setAll(cssgen.Opacity))
+
+	// Outline
+	c.addStatic("outline-none", outlineNone...)
+	c.addStatic("outline", css.Set(cssgen.OutlineStyle, css.Keyword("solid")))
+	c.addKeywords(cssgen.OutlineStyle, "outline-dashed", "outline-dotted", "outline-double")
+	c.addMatch("outline", themeScale(theme.OutlineWidth), []valueType{typeLength}, false, setAll(cssgen.OutlineWidth))
+	c.addMatch("outline-offset", themeScale(theme.OutlineOffset), lengthTypes, true, setAll(cssgen.OutlineOffset))
+	c.addMatch("outline", concat(colors, themeScale(theme.OutlineColor)), colorTypes, false, setAll(cssgen.OutlineColor))
+
+	// Ring
+	ringColor, ringColors := splitDefault(themeScale(theme.RingColor), ringColorFallback)
+	c.addMatch("ring", themeScale(theme.RingWidth), []valueType{typeLength}, false, ringDecls(ringColor))
+	c.require(ringProperties...)
+	c.addStatic("ring-inset", twRingInset.Set("inset"))
+	c.require(ringProperties...)
+	c.addMatch("ring", concat(colors, ringColors), colorTypes, false, setAll(twRingColor.Property()))
+	c.require(ringProperties...)
+	c.addMatch("ring-offset", themeScale(theme.RingOffsetWidth), []valueType{typeLength}, false, setAll(twRingOffsetWidth.Property()))
+	c.require(ringProperties...)
+	c.addMatch("ring-offset", concat(colors, themeScale(theme.RingOffsetColor)), colorTypes, false, setAll(twRingOffsetColor.Property()))
+	c.require(ringProperties...)
+
+	c.addStatic("blur-none", css.Set(cssgen.Filter, css.Raw("blur(0)")))
+	c.addMatch("blur", themeScale(theme.Blur), lengthTypes, false, func(v css.Value) []css.Decl {
+		return []css.Decl{css.Set(cssgen.Filter, css.Raw("blur("+v.String()+")"))}
+	})
+	c.addMatch("brightness", themeScale(theme.Brightness), []valueType{typeNumber, typeAny}, false, func(v css.Value) []css.Decl {
+		return []css.Decl{css.Set(cssgen.Filter, css.Raw("brightness("+v.String()+")"))}
+	})
+
+	// Transitions and animation
+	timing, easings := splitDefault(themeScale(theme.TransitionTimingFunction), transitionTiming)
+	duration, durations := splitDefault(themeScale(theme.TransitionDuration), transitionDuration)
+	c.addStatic("transition-none", css.Set(cssgen.TransitionProperty, css.Keyword("none")))
+	c.addMatch("transition", themeScale(theme.TransitionProperty), []valueType{typeAny}, false, transitionDecls(timing, duration))
+	c.addMatch("duration", durations, lengthTypes, false, setAll(cssgen.TransitionDuration))
+	c.addMatch("ease", easings, []valueType{typeAny}, false, setAll(cssgen.TransitionTimingFunction))
+	c.addMatch("delay", themeScale(theme.TransitionDelay), lengthTypes, false, setAll(cssgen.TransitionDelay))
+	c.addMatch("animate", themeScale(theme.Animation), []valueType{typeAny}, false, setAll(cssgen.Animation))
+
+	// Interactivity
+	c.addMatch("cursor", themeScale(theme.Cursor), []valueType{typeAny}, false, setAll(cssgen.Cursor))
+	c.addKeywords(cssgen.PointerEvents, "pointer-events-none", "pointer-events-auto")
+	c.addKeywords(cssgen.UserSelect, "select-none", "select-text", "select-all", "select-auto")
+}
+
+var (
+	lengthTypes = []valueType{typeLength, typeNumber, typeAny}
+	colorTypes  = []valueType{typeColor, typeAny}
+	anyTypes    = []valueType{typeColor, typeLength, typeNumber, typeAny}
+)
+
+// The transform utilities compose: translate-x and translate-y, scale-x
+// and scale-y, and skew-x and skew-y each set a custom property of their
+// own and a property combining the pair, and rotate, translate, scale and
+// transform apply on top of one another. The custom properties are
+// registered so that they do not inherit, which would otherwise make an
+// element's translate-y pick up its parent's translate-x.
+var (
+	twTranslateX = css.NewVar[css.Raw]("--tw-translate-x")
+	twTranslateY = css.NewVar[css.Raw]("--tw-translate-y")
+	twScaleX     = css.NewVar[css.Raw]("--tw-scale-x")
+	twScaleY     = css.NewVar[css.Raw]("--tw-scale-y")
+	twSkewX      = css.NewVar[css.Raw]("--tw-skew-x")
+	twSkewY      = css.NewVar[css.Raw]("--tw-skew-y")
+
+	translateXY = twTranslateX.Ref() + " " + twTranslateY.Ref()
+	scaleXY     = twScaleX.Ref() + " " + twScaleY.Ref()
+	skewXY      = twSkewX.Ref() + " " + twSkewY.Ref()
+
+	translateProperties = []css.Item{twTranslateX.Register(false, "0"), twTranslateY.Register(false, "0")}
+	scaleProperties     = []css.Item{twScaleX.Register(false, "1"), twScaleY.Register(false, "1")}
+	skewProperties      = []css.Item{twSkewX.Register(false, "skewX(0)"), twSkewY.Register(false, "skewY(0)")}
+
+	transformNone = []css.Decl{
+		css.Set(cssgen.Translate, css.Keyword("none")),
+		css.Set(cssgen.Rotate, css.Keyword("none")),
+		css.Set(cssgen.Scale, css.Keyword("none")),
+		css.Set(cssgen.Transform, css.Keyword("none")),
+	}
+)
+
+// transformDecls returns a declaration function setting each custom
+// property to the value, formatted by format, and property to composed.
+func transformDecls(property css.Property, composed css.Raw, format string, vars ...css.TypedVar[css.Raw]) func(v css.Value) []css.Decl {
+	return func(v css.Value) []css.Decl {
+		decls := make([]css.Decl, 0, len(vars)+1)
+		for _, tv := range vars {
+			decls = append(decls, tv.Set(css.Raw(fmt.Sprintf(format, v))))
+		}
+		return append(decls, css.Set(property, composed))
+	}
+}
+
+// transitionTiming and transitionDuration are the timing function and
+// duration of transition-* when the theme has no DEFAULT for them.
+var (
+	transitionTiming   = css.Raw("cubic-bezier(0.4, 0, 0.2, 1)")
+	transitionDuration = css.Raw("150ms")
+)
+
+// transitionDecls returns a declaration function for transition-*, which
+// sets the transitioned properties with the default timing function and
+// duration.
+func transitionDecls(timing, duration css.Value) func(v css.Value) []css.Decl {
+	return func(v css.Value) []css.Decl {
+		return []css.Decl{
+			css.Set(cssgen.TransitionProperty, v),
+			css.Set(cssgen.TransitionTimingFunction, timing),
+			css.Set(cssgen.TransitionDuration, duration),
+		}
+	}
+}
+
+// splitDefault separates the DEFAULT value of a scale from its other
+// values, for scales such as the transition durations whose DEFAULT is used
+// by other utilities rather than a bare class. It returns fallback if the
+// scale has no DEFAULT.
+func splitDefault(entries []scaleEntry, fallback css.Value) (css.Value, []scaleEntry) {
+	var rest []scaleEntry
+	for _, entry := range entries {
+		if entry.key == "" {
+			fallback = entry.value
+		} else {
+			rest = append(rest, entry)
+		}
+	}
+	return fallback, rest
+}
+
+// betweenChildren selects the children of an element but the first,
+// skipping hidden ones, for the space-between and divide utilities.
+const betweenChildren = " > :not([hidden]) ~ :not([hidden])"
+
+// space-x, space-y, divide-x and divide-y set the margin or border on one
+// side of each child and the reverse utilities move it to the other side,
+// for flex-row-reverse and flex-col-reverse.
+var (
+	twSpaceXReverse  = css.NewVar[css.Raw]("--tw-space-x-reverse")
+	twSpaceYReverse  = css.NewVar[css.Raw]("--tw-space-y-reverse")
+	twDivideXReverse = css.NewVar[css.Raw]("--tw-divide-x-reverse")
+	twDivideYReverse = css.NewVar[css.Raw]("--tw-divide-y-reverse")
+)
+
+// reverseDecls returns a declaration function setting start and end to
+// the value, on the end side unless reverse is set to 1.
+func reverseDecls(reverse css.TypedVar[css.Raw], start, end css.Property) func(v css.Value) []css.Decl {
+	return func(v css.Value) []css.Decl {
+		return []css.Decl{
+			reverse.Set("0"),
+			css.Set(end, css.Raw(fmt.Sprintf("calc(%s * %s)", v, reverse.Ref()))),
+			css.Set(start, css.Raw(fmt.Sprintf("calc(%s * calc(1 - %s))", v, reverse.Ref()))),
+		}
+	}
+}
+
+// outlineNone hides the outline while keeping it visible in forced colors
+// mode, as Tailwind's outline-none does.
+var outlineNone = []css.Decl{
+	css.Set(cssgen.Outline, css.Raw("2px solid transparent")),
+	css.Set(cssgen.OutlineOffset, css.Raw("2px")),
+}
+
+// Rings are box shadows composed from custom properties, so that ring-2,
+// ring-blue-500, ring-offset-2 and ring-inset combine, and leave the
+// --tw-shadow of shadow utilities in place. The properties are registered
+// so that a ring's color and offset do not pass on to the children.
+var (
+	twRingInset        = css.NewVar[css.Raw]("--tw-ring-inset")
+	twRingColor        = css.NewVar[css.Raw]("--tw-ring-color")
+	twRingOffsetWidth  = css.NewVar[css.Raw]("--tw-ring-offset-width")
+	twRingOffsetColor  = css.NewVar[css.Raw]("--tw-ring-offset-color")
+	twRingOffsetShadow = css.NewVar[css.Raw]("--tw-ring-offset-shadow")
+	twRingShadow       = css.NewVar[css.Raw]("--tw-ring-shadow")
+	twShadow           = css.NewVar[css.Raw]("--tw-shadow")
+
+	ringProperties = []css.Item{
+		twRingInset.Register(false, ""),
+		twRingColor.Register(false, ""),
+		twRingOffsetWidth.Register(false, "0px"),
+		twRingOffsetColor.Register(false, "#fff"),
+		twRingOffsetShadow.Register(false, "0 0 #0000"),
+		twRingShadow.Register(false, "0 0 #0000"),
+		twShadow.Register(false, "0 0 #0000"),
+	}
+
+	ringBoxShadow = twRingOffsetShadow.Ref() + ", " + twRingShadow.Ref() + ", " + twShadow.Ref()
+)
+
+// ringColorFallback is the color of ring-* when the theme has no DEFAULT
+// ring color.
+const ringColorFallback = css.Raw("currentcolor")
+
+// ringDecls returns a declaration function for ring-*, which draws a ring
+// of the width outside the ring offset, in the ring color or color.
+func ringDecls(color css.Value) func(v css.Value) []css.Decl {
+	return func(v css.Value) []css.Decl {
+		inset := twRingInset.Or("")
+		return []css.Decl{
+			twRingOffsetShadow.Set(css.Raw(fmt.Sprintf("%s 0 0 0 %s %s", inset, twRingOffsetWidth.Ref(), twRingOffsetColor.Ref()))),
+			twRingShadow.Set(css.Raw(fmt.Sprintf("%s 0 0 0 calc(%s + %s) %s", inset, v, twRingOffsetWidth.Ref(), twRingColor.Or(css.Raw(color.String()))))),
+			css.Set(cssgen.BoxShadow, ringBoxShadow),
+		}
+	}
+}
+
+// namedKeyword is a keyword utility whose class suffix differs from its
+// value, as in grid-flow-col, which sets grid-auto-flow to column.
+type namedKeyword struct {
+	name  string
+	value string
+}
+
+var (
+	gridFlows = []namedKeyword{
+		{"row", "row"}, {"col", "column"}, {"dense", "dense"}, {"row-dense", "row dense"}, {"col-dense", "column dense"},
+	}
+	placeContent = []namedKeyword{
+		{"center", "center"}, {"start", "start"}, {"end", "end"}, {"between", "space-between"},
+		{"around", "space-around"}, {"evenly", "space-evenly"}, {"baseline", "baseline"}, {"stretch", "stretch"},
+	}
+	placeItems = []string{"start", "end", "center", "baseline", "stretch"}
+	placeSelf  = []string{"auto", "start", "end", "center", "stretch"}
+)
+
+// addKeywords registers utilities setting a property to a keyword. The
+// keyword is the class name after the prefix it shares with the property,
+// so "border-dashed" sets border-style to dashed and "flex" sets display to
+// flex.
+func (c *Compiler) addKeywords(property css.Property, classes ...string) {
+	for _, class := range classes {
+		keyword := class
+		for _, prefix := range []string{"border-", "text-", "pointer-events-", "select-", "outline-"} {
+			keyword = strings.TrimPrefix(keyword, prefix)
+		}
+		c.addStatic(class, css.Set(property, css.Keyword(keyword)))
+	}
+}
+
+// setAll returns a declaration function setting each property to the value.
+func setAll(properties ...css.Property) func(v css.Value) []css.Decl {
+	return func(v css.Value) []css.Decl {
+		decls := make([]css.Decl, len(properties))
+		for i, p := range properties {
+			decls[i] = css.Set(p, v)
+		}
+		return decls
+	}
+}
+
+// fontSize is a font-size scale value that also sets the line height.
+type fontSize struct {
+	size       css.Value
+	lineHeight css.Value
+}
+
+func (f fontSize) String() string { return f.size.String() }
+
+// themeScale returns the values of a theme configuration struct in field
+// order. Values are keyed by their name, with DEFAULT keyed by "" for the
+// bare class; plain string and []string fields, such as the aspect ratios
+// and font families, are keyed by their lowercased field name. Unset fields
+// are skipped.
+func themeScale(config any) []scaleEntry {
+	var entries []scaleEntry
+	v := reflect.ValueOf(config)
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if !field.CanInterface() || field.IsZero() {
+			continue
+		}
+		switch value := field.Interface().(type) {
+		case FontSizeValue:
+			if value.Size == nil {
+				continue
+			}
+			size := fontSize{size: value.Size.ToCSSValue()}
+			if value.LineHeight != nil {
+				size.lineHeight = value.LineHeight.ToCSSValue()
+			}
+			entries = append(entries, scaleEntry{scaleKey(value.Size.String()), size})
+		case interface {
+			ToCSSValue() css.Value
+			String() string
+		}:
+			entries = append(entries, scaleEntry{scaleKey(value.String()), value.ToCSSValue()})
+		case string:
+			if value != "" {
+				entries = append(entries, scaleEntry{strings.ToLower(t.Field(i).Name), css.Raw(value)})
+			}
+		case []string:
+			if len(value) > 0 {
+				entries = append(entries, scaleEntry{strings.ToLower(t.Field(i).Name), css.Raw(strings.Join(value, ", "))})
+			}
+		}
+	}
+	return entries
+}
+
+func scaleKey(name string) string {
+	if name == "DEFAULT" {
+		return ""
+	}
+	return name
+}
+
+// scale builds a scale from key, value pairs.
+func scale(pairs ...string) []scaleEntry {
+	entries := make([]scaleEntry, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		entries = append(entries, scaleEntry{pairs[i], css.Raw(pairs[i+1])})
+	}
+	return entries
+}
+
+// keywordScale builds a scale of keywords keyed by themselves.
+func keywordScale(keywords ...string) []scaleEntry {
+	entries := make([]scaleEntry, len(keywords))
+	for i, k := range keywords {
+		entries[i] = scaleEntry{k, css.Keyword(k)}
+	}
+	return entries
+}
+
+// screenScale keys the breakpoints with "screen-", as in max-w-screen-md.
+func screenScale(screens ScreensConfig) []scaleEntry {
+	var entries []scaleEntry
+	for _, s := range screenValues(screens) {
+		entries = append(entries, scaleEntry{"screen-" + s.name, css.Raw(s.value)})
+	}
+	return entries
+}
+
+// fractionScale returns the percentages of the fractions used by sizing
+// utilities, from 1/2 to 11/12.
+func fractionScale() []scaleEntry {
+	var entries []scaleEntry
+	for _, d := range []int{2, 3, 4, 5, 6, 12} {
+		for n := 1; n < d; n++ {
+			percent := strconv.FormatFloat(float64(n)*100/float64(d), 'f', 6, 64)
+			percent = strings.TrimSuffix(strings.TrimRight(percent, "0"), ".")
+			entries = append(entries, scaleEntry{strconv.Itoa(n) + "/" + strconv.Itoa(d), css.Raw(percent + "%")})
+		}
+	}
+	return entries
+}
+
+func concat(scales ...[]scaleEntry) []scaleEntry {
+	var entries []scaleEntry
+	for _, s := range scales {
+		entries = append(entries, s...)
+	}
+	return entries
+}
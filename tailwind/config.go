@@ -14,15 +14,30 @@ import (
 // Config represents the complete Tailwind CSS configuration with strongly-typed
 // nested properties instead of string-based maps.
 type Config struct {
-	// Content specifies which files to scan for class names
+	// Content specifies which files to scan for class names, as globs
+	// such as "./web/**/*.{html,templ}"; see ExpandContent and Build.
 	Content []string
 
-	// Presets allows extending configuration from base presets
+	// Presets are base configurations this one builds on, applied in order
+	// before it; see ResolveConfig.
 	Presets []Config
 
 	// DarkMode configures how dark mode is activated
 	DarkMode DarkModeConfig
 
+	// Plugins add utilities, components, base styles and variants; see
+	// Plugin. Presets' plugins come before the configuration's own.
+	Plugins []Plugin
+
+	// CSSVariables emits the theme as custom properties on :root, such as
+	// --color-blue-500, and makes utilities reference them, as in
+	// background-color:var(--color-blue-500); see ThemeVariables.
+	CSSVariables bool
+
+	// AlternateThemes are emitted after :root when CSSVariables is set, to
+	// restyle the utilities under their selectors.
+	AlternateThemes []AlternateTheme
+
 	// Theme contains all design tokens and their values
 	Theme ThemeConfig
 }
@@ -199,6 +214,10 @@ type ThemeConfig struct {
 	Aria     AriaConfig
 	Data     DataConfig
 	Supports SupportsConfig
+
+	// Extend adds to the theme rather than replacing it: its set fields are
+	// merged into the categories above, field by field; see ResolveConfig.
+	Extend *ThemeConfig
 }
 
 // Base value types that integrate with cssgen types
@@ -347,6 +366,11 @@ func ColorFromRGBA(name string, r, g, b uint8, a float64) StaticColor {
 	return StaticColor{Name: name, Value: css.RGBA(r, g, b, uint8(a*255))}
 }
 
+// ColorFromVar creates a StaticColor that references a typed custom property.
+func ColorFromVar(name string, v css.TypedVar[css.Color]) StaticColor {
+	return StaticColor{Name: name, Value: v.Ref()}
+}
+
 // LengthFromPx creates a StaticLength from pixel value.
 func LengthFromPx(name string, px float64) StaticLength {
 	return StaticLength{Name: name, Value: css.Px(int(px))}
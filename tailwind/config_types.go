@@ -3,6 +3,8 @@
 
 package tailwind
 
+import "github.com/ahmed-com/typesafe-css/css"
+
 // Color-related configurations
 
 // ColorsConfig defines the base color palette with strongly-typed color properties.
@@ -441,6 +443,58 @@ type BorderWidthConfig struct {
 	Size8   LengthValue // 8px
 }
 
+// DivideWidthConfig defines the widths of the borders between children.
+type DivideWidthConfig struct {
+	DEFAULT LengthValue // 1px
+	Size0   LengthValue // 0px
+	Size2   LengthValue // 2px
+	Size4   LengthValue // 4px
+	Size8   LengthValue // 8px
+}
+
+// RingWidthConfig defines ring width values.
+type RingWidthConfig struct {
+	DEFAULT LengthValue // 3px
+	Size0   LengthValue // 0px
+	Size1   LengthValue // 1px
+	Size2   LengthValue // 2px
+	Size4   LengthValue // 4px
+	Size8   LengthValue // 8px
+}
+
+// RingOffsetWidthConfig defines ring offset width values.
+type RingOffsetWidthConfig struct {
+	Size0 LengthValue // 0px
+	Size1 LengthValue // 1px
+	Size2 LengthValue // 2px
+	Size4 LengthValue // 4px
+	Size8 LengthValue // 8px
+}
+
+// OutlineWidthConfig defines outline width values.
+type OutlineWidthConfig struct {
+	Size0 LengthValue // 0px
+	Size1 LengthValue // 1px
+	Size2 LengthValue // 2px
+	Size4 LengthValue // 4px
+	Size8 LengthValue // 8px
+}
+
+// OutlineOffsetConfig defines outline offset values.
+type OutlineOffsetConfig struct {
+	Size0 LengthValue // 0px
+	Size1 LengthValue // 1px
+	Size2 LengthValue // 2px
+	Size4 LengthValue // 4px
+	Size8 LengthValue // 8px
+}
+
+// SpaceConfig defines the space between children, used by space-x and
+// space-y.
+type SpaceConfig struct {
+	// Inherits from Spacing
+}
+
 // ... (more spacing configs would continue here)
 
 // Typography configurations
@@ -506,6 +560,248 @@ type AnimationConfig struct {
 	Bounce string       // bounce 1s infinite
 }
 
+// KeyframesConfig defines the @keyframes of the animations, keyed by their
+// lowercased field name like the animations that use them.
+type KeyframesConfig struct {
+	Spin   []css.Rule // to { transform: rotate(360deg) }
+	Ping   []css.Rule // 75%, 100% { transform: scale(2); opacity: 0 }
+	Pulse  []css.Rule // 50% { opacity: .5 }
+	Bounce []css.Rule // 0%, 100% { transform: translateY(-25%) } 50% { transform: none }
+}
+
+// Transition configurations
+
+// TransitionPropertyConfig defines transition-property values.
+type TransitionPropertyConfig struct {
+	None      KeywordValue // none
+	All       KeywordValue // all
+	DEFAULT   KeywordValue // color, background-color, border-color, ..., transform, filter, backdrop-filter
+	Colors    KeywordValue // color, background-color, border-color, text-decoration-color, fill, stroke
+	Opacity   KeywordValue // opacity
+	Shadow    KeywordValue // box-shadow
+	Transform KeywordValue // transform, translate, scale, rotate
+}
+
+// TransitionDurationConfig defines transition-duration values. DEFAULT is
+// the duration of the transition-* utilities.
+type TransitionDurationConfig struct {
+	DEFAULT  TimeValue // 150ms
+	Size0    TimeValue // 0s
+	Size75   TimeValue // 75ms
+	Size100  TimeValue // 100ms
+	Size150  TimeValue // 150ms
+	Size200  TimeValue // 200ms
+	Size300  TimeValue // 300ms
+	Size500  TimeValue // 500ms
+	Size700  TimeValue // 700ms
+	Size1000 TimeValue // 1000ms
+}
+
+// TransitionTimingFunctionConfig defines transition-timing-function values.
+// DEFAULT is the timing function of the transition-* utilities.
+type TransitionTimingFunctionConfig struct {
+	DEFAULT KeywordValue // cubic-bezier(0.4, 0, 0.2, 1)
+	Linear  KeywordValue // linear
+	In      KeywordValue // cubic-bezier(0.4, 0, 1, 1)
+	Out     KeywordValue // cubic-bezier(0, 0, 0.2, 1)
+	InOut   KeywordValue // cubic-bezier(0.4, 0, 0.2, 1)
+}
+
+// TransitionDelayConfig defines transition-delay values.
+type TransitionDelayConfig struct {
+	Size0    TimeValue // 0s
+	Size75   TimeValue // 75ms
+	Size100  TimeValue // 100ms
+	Size150  TimeValue // 150ms
+	Size200  TimeValue // 200ms
+	Size300  TimeValue // 300ms
+	Size500  TimeValue // 500ms
+	Size700  TimeValue // 700ms
+	Size1000 TimeValue // 1000ms
+}
+
+// Grid configurations
+
+// GridTemplateColumnsConfig defines grid-template-columns values.
+type GridTemplateColumnsConfig struct {
+	None    KeywordValue // none
+	Subgrid KeywordValue // subgrid
+	Size1   KeywordValue // repeat(1, minmax(0, 1fr))
+	Size2   KeywordValue // repeat(2, minmax(0, 1fr))
+	Size3   KeywordValue // repeat(3, minmax(0, 1fr))
+	Size4   KeywordValue // repeat(4, minmax(0, 1fr))
+	Size5   KeywordValue // repeat(5, minmax(0, 1fr))
+	Size6   KeywordValue // repeat(6, minmax(0, 1fr))
+	Size7   KeywordValue // repeat(7, minmax(0, 1fr))
+	Size8   KeywordValue // repeat(8, minmax(0, 1fr))
+	Size9   KeywordValue // repeat(9, minmax(0, 1fr))
+	Size10  KeywordValue // repeat(10, minmax(0, 1fr))
+	Size11  KeywordValue // repeat(11, minmax(0, 1fr))
+	Size12  KeywordValue // repeat(12, minmax(0, 1fr))
+}
+
+// GridTemplateRowsConfig defines grid-template-rows values.
+type GridTemplateRowsConfig struct {
+	None    KeywordValue // none
+	Subgrid KeywordValue // subgrid
+	Size1   KeywordValue // repeat(1, minmax(0, 1fr))
+	Size2   KeywordValue // repeat(2, minmax(0, 1fr))
+	Size3   KeywordValue // repeat(3, minmax(0, 1fr))
+	Size4   KeywordValue // repeat(4, minmax(0, 1fr))
+	Size5   KeywordValue // repeat(5, minmax(0, 1fr))
+	Size6   KeywordValue // repeat(6, minmax(0, 1fr))
+	Size7   KeywordValue // repeat(7, minmax(0, 1fr))
+	Size8   KeywordValue // repeat(8, minmax(0, 1fr))
+	Size9   KeywordValue // repeat(9, minmax(0, 1fr))
+	Size10  KeywordValue // repeat(10, minmax(0, 1fr))
+	Size11  KeywordValue // repeat(11, minmax(0, 1fr))
+	Size12  KeywordValue // repeat(12, minmax(0, 1fr))
+}
+
+// GridColumnConfig defines grid-column values, used by col-*.
+type GridColumnConfig struct {
+	Auto     KeywordValue // auto
+	Span1    KeywordValue // span 1 / span 1
+	Span2    KeywordValue // span 2 / span 2
+	Span3    KeywordValue // span 3 / span 3
+	Span4    KeywordValue // span 4 / span 4
+	Span5    KeywordValue // span 5 / span 5
+	Span6    KeywordValue // span 6 / span 6
+	Span7    KeywordValue // span 7 / span 7
+	Span8    KeywordValue // span 8 / span 8
+	Span9    KeywordValue // span 9 / span 9
+	Span10   KeywordValue // span 10 / span 10
+	Span11   KeywordValue // span 11 / span 11
+	Span12   KeywordValue // span 12 / span 12
+	SpanFull KeywordValue // 1 / -1
+}
+
+// GridRowConfig defines grid-row values, used by row-*.
+type GridRowConfig struct {
+	Auto     KeywordValue // auto
+	Span1    KeywordValue // span 1 / span 1
+	Span2    KeywordValue // span 2 / span 2
+	Span3    KeywordValue // span 3 / span 3
+	Span4    KeywordValue // span 4 / span 4
+	Span5    KeywordValue // span 5 / span 5
+	Span6    KeywordValue // span 6 / span 6
+	Span7    KeywordValue // span 7 / span 7
+	Span8    KeywordValue // span 8 / span 8
+	Span9    KeywordValue // span 9 / span 9
+	Span10   KeywordValue // span 10 / span 10
+	Span11   KeywordValue // span 11 / span 11
+	Span12   KeywordValue // span 12 / span 12
+	SpanFull KeywordValue // 1 / -1
+}
+
+// GridLineConfig defines the grid lines an item starts or ends at.
+type GridLineConfig struct {
+	Auto   KeywordValue // auto
+	Size1  KeywordValue // 1
+	Size2  KeywordValue // 2
+	Size3  KeywordValue // 3
+	Size4  KeywordValue // 4
+	Size5  KeywordValue // 5
+	Size6  KeywordValue // 6
+	Size7  KeywordValue // 7
+	Size8  KeywordValue // 8
+	Size9  KeywordValue // 9
+	Size10 KeywordValue // 10
+	Size11 KeywordValue // 11
+	Size12 KeywordValue // 12
+	Size13 KeywordValue // 13
+}
+
+// GridColumnStartConfig defines grid-column-start values.
+type GridColumnStartConfig GridLineConfig
+
+// GridColumnEndConfig defines grid-column-end values.
+type GridColumnEndConfig GridLineConfig
+
+// GridRowStartConfig defines grid-row-start values.
+type GridRowStartConfig GridLineConfig
+
+// GridRowEndConfig defines grid-row-end values.
+type GridRowEndConfig GridLineConfig
+
+// GridAutoConfig defines the size of implicitly created grid tracks.
+type GridAutoConfig struct {
+	Auto KeywordValue // auto
+	Min  KeywordValue // min-content
+	Max  KeywordValue // max-content
+	Fr   KeywordValue // minmax(0, 1fr)
+}
+
+// GridAutoColumnsConfig defines grid-auto-columns values.
+type GridAutoColumnsConfig GridAutoConfig
+
+// GridAutoRowsConfig defines grid-auto-rows values.
+type GridAutoRowsConfig GridAutoConfig
+
+// Transform configurations
+
+// TranslateConfig defines translate values.
+type TranslateConfig struct {
+	Size1_2 LengthValue // 50%
+	Size1_3 LengthValue // 33.333333%
+	Size2_3 LengthValue // 66.666667%
+	Size1_4 LengthValue // 25%
+	Size2_4 LengthValue // 50%
+	Size3_4 LengthValue // 75%
+	Full    LengthValue // 100%
+	// Inherits from Spacing
+}
+
+// RotateConfig defines rotate values.
+type RotateConfig struct {
+	Size0   AngleValue // 0deg
+	Size1   AngleValue // 1deg
+	Size2   AngleValue // 2deg
+	Size3   AngleValue // 3deg
+	Size6   AngleValue // 6deg
+	Size12  AngleValue // 12deg
+	Size45  AngleValue // 45deg
+	Size90  AngleValue // 90deg
+	Size180 AngleValue // 180deg
+}
+
+// ScaleConfig defines scale values.
+type ScaleConfig struct {
+	Size0   NumberValue // 0
+	Size50  NumberValue // .5
+	Size75  NumberValue // .75
+	Size90  NumberValue // .9
+	Size95  NumberValue // .95
+	Size100 NumberValue // 1
+	Size105 NumberValue // 1.05
+	Size110 NumberValue // 1.1
+	Size125 NumberValue // 1.25
+	Size150 NumberValue // 1.5
+}
+
+// SkewConfig defines skew values.
+type SkewConfig struct {
+	Size0  AngleValue // 0deg
+	Size1  AngleValue // 1deg
+	Size2  AngleValue // 2deg
+	Size3  AngleValue // 3deg
+	Size6  AngleValue // 6deg
+	Size12 AngleValue // 12deg
+}
+
+// TransformOriginConfig defines transform-origin values.
+type TransformOriginConfig struct {
+	Center      KeywordValue // center
+	Top         KeywordValue // top
+	TopRight    KeywordValue // top right
+	Right       KeywordValue // right
+	BottomRight KeywordValue // bottom right
+	Bottom      KeywordValue // bottom
+	BottomLeft  KeywordValue // bottom left
+	Left        KeywordValue // left
+	TopLeft     KeywordValue // top left
+}
+
 // Visual effects configurations
 
 // BlurConfig defines blur values.
@@ -615,7 +911,6 @@ type ScreensConfig struct {
 // Placeholder configurations for remaining types
 // These would be fully implemented with proper types
 
-type DivideWidthConfig struct{}
 type GapConfig struct{}
 type HeightConfig struct{}
 type InlineSizeConfig struct{}
@@ -625,14 +920,9 @@ type MaxHeightConfig struct{}
 type MaxWidthConfig struct{}
 type MinHeightConfig struct{}
 type MinWidthConfig struct{}
-type OutlineOffsetConfig struct{}
-type OutlineWidthConfig struct{}
 type PaddingConfig struct{}
-type RingOffsetWidthConfig struct{}
-type RingWidthConfig struct{}
 type ScrollMarginConfig struct{}
 type ScrollPaddingConfig struct{}
-type SpaceConfig struct{}
 type StrokeWidthConfig struct{}
 type TextIndentConfig struct{}
 type WidthConfig struct{}
@@ -643,16 +933,6 @@ type TextUnderlineOffsetConfig struct{}
 type TextDecorationThicknessConfig struct{}
 type ColumnsConfig struct{}
 type FlexBasisConfig struct{}
-type GridAutoColumnsConfig struct{}
-type GridAutoRowsConfig struct{}
-type GridColumnConfig struct{}
-type GridColumnEndConfig struct{}
-type GridColumnStartConfig struct{}
-type GridRowConfig struct{}
-type GridRowEndConfig struct{}
-type GridRowStartConfig struct{}
-type GridTemplateColumnsConfig struct{}
-type GridTemplateRowsConfig struct{}
 type OrderConfig struct{}
 type BackdropBlurConfig struct{}
 type BackdropBrightnessConfig struct{}
@@ -680,16 +960,6 @@ type BorderOpacityConfig struct{}
 type BorderSpacingConfig struct{}
 type DivideOpacityConfig struct{}
 type ContainerConfig struct{}
-type KeyframesConfig struct{}
-type TransitionDelayConfig struct{}
-type TransitionDurationConfig struct{}
-type TransitionPropertyConfig struct{}
-type TransitionTimingFunctionConfig struct{}
-type RotateConfig struct{}
-type ScaleConfig struct{}
-type SkewConfig struct{}
-type TransformOriginConfig struct{}
-type TranslateConfig struct{}
 type SizeConfig struct{}
 type ZIndexConfig struct{}
 type ContentConfig struct{}
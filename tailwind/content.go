@@ -0,0 +1,295 @@
+// This file implements content scanning: it expands the Config.Content globs,
+// extracts candidate classes from the matched files, and compiles the ones
+// that are utilities.
+
+package tailwind
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// ExpandContent returns the files matched by content globs, sorted and
+// without duplicates. Patterns use path.Match syntax with two additions:
+// "**" matches any number of directories, and braces list alternatives, as
+// in "./web/**/*.{html,templ}". Patterns starting with "!" exclude the files
+// they match.
+func ExpandContent(patterns []string) ([]string, error) {
+	var include, exclude []string
+	for _, p := range patterns {
+		if rest, ok := strings.CutPrefix(p, "!"); ok {
+			exclude = append(exclude, expandBraces(rest)...)
+		} else {
+			include = append(include, expandBraces(p)...)
+		}
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, pattern := range include {
+		matches, err := globFiles(pattern)
+		if err != nil {
+			return nil, err
+		}
+	next:
+		for _, file := range matches {
+			if seen[file] {
+				continue
+			}
+			for _, ex := range exclude {
+				if matchGlob(cleanGlob(ex), cleanGlob(file)) {
+					continue next
+				}
+			}
+			seen[file] = true
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// globFiles returns the regular files matching a pattern without braces.
+func globFiles(pattern string) ([]string, error) {
+	pattern = cleanGlob(pattern)
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("tailwind: content pattern %q: %w", pattern, err)
+	}
+
+	// Walk from the longest directory prefix without wildcards.
+	root := "."
+	segments := strings.Split(pattern, "/")
+	for i, seg := range segments[:len(segments)-1] {
+		if strings.ContainsAny(seg, `*?[\`) {
+			break
+		}
+		root = strings.Join(segments[:i+1], "/")
+	}
+	if strings.HasPrefix(pattern, "/") && root == "" {
+		root = "/"
+	}
+
+	var files []string
+	err := filepath.WalkDir(filepath.FromSlash(root), func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == filepath.FromSlash(root) && os.IsNotExist(err) {
+				return fs.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			// Dependencies and VCS data are never content.
+			if p != filepath.FromSlash(root) && (d.Name() == "node_modules" || d.Name() == ".git") {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		if matchGlob(pattern, cleanGlob(filepath.ToSlash(p))) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tailwind: scanning %s: %w", root, err)
+	}
+	return files, nil
+}
+
+// cleanGlob normalizes a slash-separated pattern or path, so that
+// "./src/**/*.html" and "src/**/*.html" match the same files.
+func cleanGlob(p string) string {
+	p = path.Clean(filepath.ToSlash(p))
+	return strings.TrimPrefix(p, "./")
+}
+
+// matchGlob reports whether a slash-separated path matches a pattern, where
+// a "**" segment matches zero or more path segments.
+func matchGlob(pattern, name string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(name, "/"))
+}
+
+func matchSegments(pattern, name []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(name); i++ {
+				if matchSegments(pattern[1:], name[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(name) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pattern[0], name[0]); !ok {
+			return false
+		}
+		pattern, name = pattern[1:], name[1:]
+	}
+	return len(name) == 0
+}
+
+// expandBraces expands the first {a,b} group of a pattern, recursively:
+// "*.{html,go}" -> "*.html", "*.go".
+func expandBraces(pattern string) []string {
+	open := strings.IndexByte(pattern, '{')
+	if open < 0 {
+		return []string{pattern}
+	}
+	depth := 0
+	for i := open; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth--; depth > 0 {
+				continue
+			}
+			var expanded []string
+			for _, alt := range splitAlternatives(pattern[open+1 : i]) {
+				expanded = append(expanded, expandBraces(pattern[:open]+alt+pattern[i+1:])...)
+			}
+			return expanded
+		}
+	}
+	return []string{pattern}
+}
+
+// splitAlternatives splits the inside of a brace group at top-level commas.
+func splitAlternatives(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// ExtractCandidates returns the tokens of a source file that may be
+// classes, in order of first appearance. Like Tailwind's extractor it does
+// not parse the file: it splits the text at whitespace, quotes, angle
+// brackets, braces and similar delimiters, except inside square brackets,
+// so that it finds classes in HTML attributes, Go templates, templ
+// components and Go string literals alike. Most candidates are not classes;
+// the compiler drops those.
+func ExtractCandidates(content string) []string {
+	var candidates []string
+	seen := make(map[string]bool)
+	add := func(token string) {
+		token = strings.TrimRight(token, ".,;:")
+		token = strings.TrimLeft(token, ".,;:")
+		if token == "" || seen[token] || !strings.ContainsFunc(token, isLetterRune) {
+			return
+		}
+		seen[token] = true
+		candidates = append(candidates, token)
+	}
+
+	start, depth := -1, 0
+	for i := 0; i < len(content); i++ {
+		ch := content[i]
+		switch {
+		case ch == '[':
+			depth++
+		case ch == ']':
+			depth = max(depth-1, 0)
+		case depth > 0 && ch != '\n' && ch != '"' && ch != '`' && ch != '<' && ch != '>':
+			// Arbitrary values may contain delimiters, as in content-['a'].
+		case isCandidateDelimiter(ch):
+			if start >= 0 {
+				add(content[start:i])
+			}
+			start, depth = -1, 0
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		add(content[start:])
+	}
+	return candidates
+}
+
+// isCandidateDelimiter reports whether a byte cannot be part of a class
+// outside of square brackets.
+func isCandidateDelimiter(ch byte) bool {
+	switch ch {
+	case ' ', '\t', '\n', '\r', '\f', '"', '\'', '`', '<', '>', '=', '{', '}', '(', ')', ',', ';', '\\', '|', '$', '+':
+		return true
+	}
+	return false
+}
+
+func isLetterRune(r rune) bool {
+	return r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z'
+}
+
+// ScanContent extracts the candidate classes of the files matched by
+// content globs, in order of first appearance.
+func ScanContent(patterns []string) ([]string, error) {
+	files, err := ExpandContent(patterns)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []string
+	seen := make(map[string]bool)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("tailwind: reading content: %w", err)
+		}
+		for _, c := range ExtractCandidates(string(data)) {
+			if !seen[c] {
+				seen[c] = true
+				candidates = append(candidates, c)
+			}
+		}
+	}
+	return candidates, nil
+}
+
+// CompileCandidates compiles the candidates that are classes and silently
+// skips the others, as scanned content is mostly not classes. Unlike
+// Compile, it does not look for suggestions for the others.
+func (c *Compiler) CompileCandidates(candidates []string) css.Stylesheet {
+	stylesheet, _ := c.compile(strings.Join(candidates, " "), false)
+	return stylesheet
+}
+
+// GenerateContent generates the utilities used by the files of
+// Config.Content, rather than every utility of the theme.
+func (g *UtilityGenerator) GenerateContent() (css.Stylesheet, error) {
+	if g.err != nil {
+		return css.Stylesheet{}, g.err
+	}
+	candidates, err := ScanContent(g.config.Content)
+	if err != nil {
+		return css.Stylesheet{}, err
+	}
+	return NewCompiler(g.config).CompileCandidates(candidates), nil
+}
+
+// Build scans the content files of a configuration and returns the CSS of
+// the utilities they use.
+func Build(config Config) (css.Stylesheet, error) {
+	return NewUtilityGenerator(config).GenerateContent()
+}
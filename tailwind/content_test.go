@@ -0,0 +1,116 @@
+package tailwind
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestExtractCandidates(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"html", `<div class="flex md:hover:bg-blue-500/50 p-4">Hi.</div>`, []string{"flex", "md:hover:bg-blue-500/50", "p-4"}},
+		{"template", `<a class="{{if .Active}}font-bold{{else}}text-gray-500{{end}} px-2">`, []string{"font-bold", "text-gray-500", "px-2"}},
+		{"templ", `<p class={ "mt-2", templ.KV("underline", active) }>`, []string{"mt-2", "underline"}},
+		{"go", "cls := `w-[calc(100%_-_1rem)]` + \"content-['a_b'] -mt-4\"", []string{"w-[calc(100%_-_1rem)]", "content-['a_b']", "-mt-4"}},
+	}
+	for _, tt := range tests {
+		got := ExtractCandidates(tt.content)
+		for _, want := range tt.want {
+			if !slices.Contains(got, want) {
+				t.Errorf("%s: ExtractCandidates missed %q in %q", tt.name, want, got)
+			}
+		}
+	}
+}
+
+func TestExpandContent(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"web/index.html", "web/parts/nav.templ", "web/parts/deep/card.html", "main.go", "web/node_modules/x.html", "web/skip.html"} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	root := filepath.ToSlash(dir)
+	files, err := ExpandContent([]string{root + "/web/**/*.{html,templ}", root + "/*.go", "!" + root + "/web/skip.html"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		rel, _ := filepath.Rel(dir, f)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	want := []string{"main.go", "web/index.html", "web/parts/deep/card.html", "web/parts/nav.templ"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ExpandContent = %v, want %v", got, want)
+	}
+
+	if _, err := ExpandContent([]string{root + "/web/[.html"}); err == nil {
+		t.Error("ExpandContent accepted a malformed pattern")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	dir := t.TempDir()
+	page := `<main class="flex p-4 md:p-8">{{range .Items}}<p class="text-red-500">{{.}}</p>{{end}}</main>`
+	code := "package web\n\nconst button = \"rounded bg-blue-500 hover:bg-blue-600\"\n"
+	if err := os.WriteFile(filepath.Join(dir, "page.html"), []byte(page), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "button.go"), []byte(code), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	config := DefaultConfig()
+	config.Content = []string{filepath.ToSlash(dir) + "/**/*.{html,go}"}
+	stylesheet, err := Build(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := stylesheet.String()
+	for _, want := range []string{".flex{", ".p-4{", `.md\:p-8{`, ".text-red-500{", ".rounded{", ".bg-blue-500{", `.hover\:bg-blue-600:hover{`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %s", want)
+		}
+	}
+	if len(stylesheet.Items) != 7 {
+		t.Errorf("got %d rules, want only the 7 used classes:\n%s", len(stylesheet.Items), out)
+	}
+}
+
+func TestCompileCandidatesSkipsSuggestions(t *testing.T) {
+	c := NewCompiler(DefaultConfig())
+	stylesheet := c.CompileCandidates([]string{"flex", "package", "tailwind", "p-4"})
+	if len(stylesheet.Items) != 2 {
+		t.Errorf("got %d rules, want 2:\n%s", len(stylesheet.Items), stylesheet.String())
+	}
+	if c.candidates != nil {
+		t.Error("CompileCandidates computed suggestions for the candidates that are not classes")
+	}
+}
+
+// BenchmarkCompileCandidates compiles the candidates of this package's
+// sources, which are mostly not classes.
+func BenchmarkCompileCandidates(b *testing.B) {
+	candidates, err := ScanContent([]string{"*.go"})
+	if err != nil {
+		b.Fatal(err)
+	}
+	c := NewCompiler(DefaultConfig())
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.CompileCandidates(candidates)
+	}
+}
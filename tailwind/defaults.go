@@ -21,24 +21,50 @@ func DefaultConfig() Config {
 // DefaultTheme returns the default Tailwind theme configuration.
 func DefaultTheme() ThemeConfig {
 	return ThemeConfig{
-		Colors:          DefaultColors(),
-		AccentColor:     DefaultAccentColor(),
-		BackgroundColor: DefaultBackgroundColor(),
-		BorderColor:     DefaultBorderColor(),
-		CaretColor:      DefaultCaretColor(),
-		Spacing:         DefaultSpacing(),
-		BorderRadius:    DefaultBorderRadius(),
-		BorderWidth:     DefaultBorderWidth(),
-		FontFamily:      DefaultFontFamily(),
-		FontSize:        DefaultFontSize(),
-		FontWeight:      DefaultFontWeight(),
-		AspectRatio:     DefaultAspectRatio(),
-		Animation:       DefaultAnimation(),
-		Blur:            DefaultBlur(),
-		Brightness:      DefaultBrightness(),
-		Opacity:         DefaultOpacity(),
-		Cursor:          DefaultCursor(),
-		Screens:         DefaultScreens(),
+		Colors:                   DefaultColors(),
+		AccentColor:              DefaultAccentColor(),
+		BackgroundColor:          DefaultBackgroundColor(),
+		BorderColor:              DefaultBorderColor(),
+		CaretColor:               DefaultCaretColor(),
+		Spacing:                  DefaultSpacing(),
+		BorderRadius:             DefaultBorderRadius(),
+		BorderWidth:              DefaultBorderWidth(),
+		DivideWidth:              DefaultDivideWidth(),
+		RingColor:                DefaultRingColor(),
+		RingWidth:                DefaultRingWidth(),
+		RingOffsetWidth:          DefaultRingOffsetWidth(),
+		OutlineWidth:             DefaultOutlineWidth(),
+		OutlineOffset:            DefaultOutlineOffset(),
+		FontFamily:               DefaultFontFamily(),
+		FontSize:                 DefaultFontSize(),
+		FontWeight:               DefaultFontWeight(),
+		AspectRatio:              DefaultAspectRatio(),
+		GridAutoColumns:          DefaultGridAutoColumns(),
+		GridAutoRows:             DefaultGridAutoRows(),
+		GridColumn:               DefaultGridColumn(),
+		GridColumnEnd:            DefaultGridColumnEnd(),
+		GridColumnStart:          DefaultGridColumnStart(),
+		GridRow:                  DefaultGridRow(),
+		GridRowEnd:               DefaultGridRowEnd(),
+		GridRowStart:             DefaultGridRowStart(),
+		GridTemplateColumns:      DefaultGridTemplateColumns(),
+		GridTemplateRows:         DefaultGridTemplateRows(),
+		Animation:                DefaultAnimation(),
+		Keyframes:                DefaultKeyframes(),
+		TransitionProperty:       DefaultTransitionProperty(),
+		TransitionDuration:       DefaultTransitionDuration(),
+		TransitionTimingFunction: DefaultTransitionTimingFunction(),
+		TransitionDelay:          DefaultTransitionDelay(),
+		Blur:                     DefaultBlur(),
+		Brightness:               DefaultBrightness(),
+		Opacity:                  DefaultOpacity(),
+		Cursor:                   DefaultCursor(),
+		Translate:                DefaultTranslate(),
+		Rotate:                   DefaultRotate(),
+		Scale:                    DefaultScale(),
+		Skew:                     DefaultSkew(),
+		TransformOrigin:          DefaultTransformOrigin(),
+		Screens:                  DefaultScreens(),
 	}
 }
 
@@ -361,6 +387,13 @@ func DefaultBackgroundColor() BackgroundColorConfig {
 	return BackgroundColorConfig{}
 }
 
+// Default ring color configuration
+func DefaultRingColor() RingColorConfig {
+	return RingColorConfig{
+		DEFAULT: StaticColor{Name: "DEFAULT", Value: css.Color("rgb(59 130 246 / 0.5)")},
+	}
+}
+
 // Default border color configuration
 func DefaultBorderColor() BorderColorConfig {
 	return BorderColorConfig{
@@ -534,15 +567,15 @@ func DefaultFontSize() FontSizeConfig {
 // Default font weight configuration
 func DefaultFontWeight() FontWeightConfig {
 	return FontWeightConfig{
-		Thin:       FontWeightKeyword("thin", cssgen.FontWeightValNormal), // Will be replaced with actual value
-		Extralight: FontWeightKeyword("extralight", cssgen.FontWeightValNormal),
-		Light:      FontWeightKeyword("light", cssgen.FontWeightValNormal),
-		Normal:     FontWeightKeyword("normal", cssgen.FontWeightValNormal),
-		Medium:     FontWeightKeyword("medium", cssgen.FontWeightValNormal),
-		Semibold:   FontWeightKeyword("semibold", cssgen.FontWeightValNormal),
-		Bold:       FontWeightKeyword("bold", cssgen.FontWeightValBold),
-		Extrabold:  FontWeightKeyword("extrabold", cssgen.FontWeightValNormal),
-		Black:      FontWeightKeyword("black", cssgen.FontWeightValNormal),
+		Thin:       KeywordValue{Name: "thin", Keyword: css.Keyword("100")},
+		Extralight: KeywordValue{Name: "extralight", Keyword: css.Keyword("200")},
+		Light:      KeywordValue{Name: "light", Keyword: css.Keyword("300")},
+		Normal:     KeywordValue{Name: "normal", Keyword: css.Keyword("400")},
+		Medium:     KeywordValue{Name: "medium", Keyword: css.Keyword("500")},
+		Semibold:   KeywordValue{Name: "semibold", Keyword: css.Keyword("600")},
+		Bold:       KeywordValue{Name: "bold", Keyword: css.Keyword("700")},
+		Extrabold:  KeywordValue{Name: "extrabold", Keyword: css.Keyword("800")},
+		Black:      KeywordValue{Name: "black", Keyword: css.Keyword("900")},
 	}
 }
 
@@ -677,3 +710,369 @@ func DefaultScreens() ScreensConfig {
 		Size2xl: LengthFromPx("2xl", 1536),
 	}
 }
+
+// Default grid template columns configuration
+func DefaultGridTemplateColumns() GridTemplateColumnsConfig {
+	return GridTemplateColumnsConfig{
+		None:    KeywordValue{Name: "none", Keyword: css.Keyword("none")},
+		Subgrid: KeywordValue{Name: "subgrid", Keyword: css.Keyword("subgrid")},
+		Size1:   KeywordValue{Name: "1", Keyword: css.Keyword("repeat(1, minmax(0, 1fr))")},
+		Size2:   KeywordValue{Name: "2", Keyword: css.Keyword("repeat(2, minmax(0, 1fr))")},
+		Size3:   KeywordValue{Name: "3", Keyword: css.Keyword("repeat(3, minmax(0, 1fr))")},
+		Size4:   KeywordValue{Name: "4", Keyword: css.Keyword("repeat(4, minmax(0, 1fr))")},
+		Size5:   KeywordValue{Name: "5", Keyword: css.Keyword("repeat(5, minmax(0, 1fr))")},
+		Size6:   KeywordValue{Name: "6", Keyword: css.Keyword("repeat(6, minmax(0, 1fr))")},
+		Size7:   KeywordValue{Name: "7", Keyword: css.Keyword("repeat(7, minmax(0, 1fr))")},
+		Size8:   KeywordValue{Name: "8", Keyword: css.Keyword("repeat(8, minmax(0, 1fr))")},
+		Size9:   KeywordValue{Name: "9", Keyword: css.Keyword("repeat(9, minmax(0, 1fr))")},
+		Size10:  KeywordValue{Name: "10", Keyword: css.Keyword("repeat(10, minmax(0, 1fr))")},
+		Size11:  KeywordValue{Name: "11", Keyword: css.Keyword("repeat(11, minmax(0, 1fr))")},
+		Size12:  KeywordValue{Name: "12", Keyword: css.Keyword("repeat(12, minmax(0, 1fr))")},
+	}
+}
+
+// Default grid template rows configuration
+func DefaultGridTemplateRows() GridTemplateRowsConfig {
+	return GridTemplateRowsConfig{
+		None:    KeywordValue{Name: "none", Keyword: css.Keyword("none")},
+		Subgrid: KeywordValue{Name: "subgrid", Keyword: css.Keyword("subgrid")},
+		Size1:   KeywordValue{Name: "1", Keyword: css.Keyword("repeat(1, minmax(0, 1fr))")},
+		Size2:   KeywordValue{Name: "2", Keyword: css.Keyword("repeat(2, minmax(0, 1fr))")},
+		Size3:   KeywordValue{Name: "3", Keyword: css.Keyword("repeat(3, minmax(0, 1fr))")},
+		Size4:   KeywordValue{Name: "4", Keyword: css.Keyword("repeat(4, minmax(0, 1fr))")},
+		Size5:   KeywordValue{Name: "5", Keyword: css.Keyword("repeat(5, minmax(0, 1fr))")},
+		Size6:   KeywordValue{Name: "6", Keyword: css.Keyword("repeat(6, minmax(0, 1fr))")},
+		Size7:   KeywordValue{Name: "7", Keyword: css.Keyword("repeat(7, minmax(0, 1fr))")},
+		Size8:   KeywordValue{Name: "8", Keyword: css.Keyword("repeat(8, minmax(0, 1fr))")},
+		Size9:   KeywordValue{Name: "9", Keyword: css.Keyword("repeat(9, minmax(0, 1fr))")},
+		Size10:  KeywordValue{Name: "10", Keyword: css.Keyword("repeat(10, minmax(0, 1fr))")},
+		Size11:  KeywordValue{Name: "11", Keyword: css.Keyword("repeat(11, minmax(0, 1fr))")},
+		Size12:  KeywordValue{Name: "12", Keyword: css.Keyword("repeat(12, minmax(0, 1fr))")},
+	}
+}
+
+// Default grid column configuration
+func DefaultGridColumn() GridColumnConfig {
+	return GridColumnConfig{
+		Auto:     KeywordValue{Name: "auto", Keyword: css.Keyword("auto")},
+		Span1:    KeywordValue{Name: "span-1", Keyword: css.Keyword("span 1 / span 1")},
+		Span2:    KeywordValue{Name: "span-2", Keyword: css.Keyword("span 2 / span 2")},
+		Span3:    KeywordValue{Name: "span-3", Keyword: css.Keyword("span 3 / span 3")},
+		Span4:    KeywordValue{Name: "span-4", Keyword: css.Keyword("span 4 / span 4")},
+		Span5:    KeywordValue{Name: "span-5", Keyword: css.Keyword("span 5 / span 5")},
+		Span6:    KeywordValue{Name: "span-6", Keyword: css.Keyword("span 6 / span 6")},
+		Span7:    KeywordValue{Name: "span-7", Keyword: css.Keyword("span 7 / span 7")},
+		Span8:    KeywordValue{Name: "span-8", Keyword: css.Keyword("span 8 / span 8")},
+		Span9:    KeywordValue{Name: "span-9", Keyword: css.Keyword("span 9 / span 9")},
+		Span10:   KeywordValue{Name: "span-10", Keyword: css.Keyword("span 10 / span 10")},
+		Span11:   KeywordValue{Name: "span-11", Keyword: css.Keyword("span 11 / span 11")},
+		Span12:   KeywordValue{Name: "span-12", Keyword: css.Keyword("span 12 / span 12")},
+		SpanFull: KeywordValue{Name: "span-full", Keyword: css.Keyword("1 / -1")},
+	}
+}
+
+// Default grid row configuration
+func DefaultGridRow() GridRowConfig {
+	return GridRowConfig{
+		Auto:     KeywordValue{Name: "auto", Keyword: css.Keyword("auto")},
+		Span1:    KeywordValue{Name: "span-1", Keyword: css.Keyword("span 1 / span 1")},
+		Span2:    KeywordValue{Name: "span-2", Keyword: css.Keyword("span 2 / span 2")},
+		Span3:    KeywordValue{Name: "span-3", Keyword: css.Keyword("span 3 / span 3")},
+		Span4:    KeywordValue{Name: "span-4", Keyword: css.Keyword("span 4 / span 4")},
+		Span5:    KeywordValue{Name: "span-5", Keyword: css.Keyword("span 5 / span 5")},
+		Span6:    KeywordValue{Name: "span-6", Keyword: css.Keyword("span 6 / span 6")},
+		Span7:    KeywordValue{Name: "span-7", Keyword: css.Keyword("span 7 / span 7")},
+		Span8:    KeywordValue{Name: "span-8", Keyword: css.Keyword("span 8 / span 8")},
+		Span9:    KeywordValue{Name: "span-9", Keyword: css.Keyword("span 9 / span 9")},
+		Span10:   KeywordValue{Name: "span-10", Keyword: css.Keyword("span 10 / span 10")},
+		Span11:   KeywordValue{Name: "span-11", Keyword: css.Keyword("span 11 / span 11")},
+		Span12:   KeywordValue{Name: "span-12", Keyword: css.Keyword("span 12 / span 12")},
+		SpanFull: KeywordValue{Name: "span-full", Keyword: css.Keyword("1 / -1")},
+	}
+}
+
+// Default grid line configuration, shared by the start and end of columns
+// and rows
+func defaultGridLines() GridLineConfig {
+	return GridLineConfig{
+		Auto:   KeywordValue{Name: "auto", Keyword: css.Keyword("auto")},
+		Size1:  KeywordValue{Name: "1", Keyword: css.Keyword("1")},
+		Size2:  KeywordValue{Name: "2", Keyword: css.Keyword("2")},
+		Size3:  KeywordValue{Name: "3", Keyword: css.Keyword("3")},
+		Size4:  KeywordValue{Name: "4", Keyword: css.Keyword("4")},
+		Size5:  KeywordValue{Name: "5", Keyword: css.Keyword("5")},
+		Size6:  KeywordValue{Name: "6", Keyword: css.Keyword("6")},
+		Size7:  KeywordValue{Name: "7", Keyword: css.Keyword("7")},
+		Size8:  KeywordValue{Name: "8", Keyword: css.Keyword("8")},
+		Size9:  KeywordValue{Name: "9", Keyword: css.Keyword("9")},
+		Size10: KeywordValue{Name: "10", Keyword: css.Keyword("10")},
+		Size11: KeywordValue{Name: "11", Keyword: css.Keyword("11")},
+		Size12: KeywordValue{Name: "12", Keyword: css.Keyword("12")},
+		Size13: KeywordValue{Name: "13", Keyword: css.Keyword("13")},
+	}
+}
+
+// Default implicit grid track configuration, shared by columns and rows
+func defaultGridAuto() GridAutoConfig {
+	return GridAutoConfig{
+		Auto: KeywordValue{Name: "auto", Keyword: css.Keyword("auto")},
+		Min:  KeywordValue{Name: "min", Keyword: css.Keyword("min-content")},
+		Max:  KeywordValue{Name: "max", Keyword: css.Keyword("max-content")},
+		Fr:   KeywordValue{Name: "fr", Keyword: css.Keyword("minmax(0, 1fr)")},
+	}
+}
+
+// Default grid column start configuration
+func DefaultGridColumnStart() GridColumnStartConfig {
+	return GridColumnStartConfig(defaultGridLines())
+}
+
+// Default grid column end configuration
+func DefaultGridColumnEnd() GridColumnEndConfig {
+	return GridColumnEndConfig(defaultGridLines())
+}
+
+// Default grid row start configuration
+func DefaultGridRowStart() GridRowStartConfig {
+	return GridRowStartConfig(defaultGridLines())
+}
+
+// Default grid row end configuration
+func DefaultGridRowEnd() GridRowEndConfig {
+	return GridRowEndConfig(defaultGridLines())
+}
+
+// Default grid auto columns configuration
+func DefaultGridAutoColumns() GridAutoColumnsConfig {
+	return GridAutoColumnsConfig(defaultGridAuto())
+}
+
+// Default grid auto rows configuration
+func DefaultGridAutoRows() GridAutoRowsConfig {
+	return GridAutoRowsConfig(defaultGridAuto())
+}
+
+// Default translate configuration
+func DefaultTranslate() TranslateConfig {
+	return TranslateConfig{
+		Size1_2: LengthFromPercent("1/2", 50),
+		Size1_3: LengthFromPercent("1/3", 100.0/3),
+		Size2_3: LengthFromPercent("2/3", 200.0/3),
+		Size1_4: LengthFromPercent("1/4", 25),
+		Size2_4: LengthFromPercent("2/4", 50),
+		Size3_4: LengthFromPercent("3/4", 75),
+		Full:    LengthFromPercent("full", 100),
+	}
+}
+
+// angleDeg, number, timeMs and timeS create the values of the transform
+// and transition scales. Unlike AngleFromDeg, NumberFromFloat and the Time
+// helpers they drop trailing zeros, printing 45deg and 1.1 rather than
+// 45.0deg and 1.100.
+func angleDeg(name string, deg float64) StaticAngle {
+	return StaticAngle{Name: name, Value: css.Raw(css.Deg(deg))}
+}
+
+func number(name string, val float64) StaticNumber {
+	return StaticNumber{Name: name, Value: css.Raw(css.Num(val))}
+}
+
+func timeMs(name string, ms int) StaticTime {
+	return StaticTime{Name: name, Value: css.Raw(css.Ms(ms))}
+}
+
+func timeS(name string, s float64) StaticTime {
+	return StaticTime{Name: name, Value: css.Raw(css.Sec(s))}
+}
+
+// Default rotate configuration
+func DefaultRotate() RotateConfig {
+	return RotateConfig{
+		Size0:   angleDeg("0", 0),
+		Size1:   angleDeg("1", 1),
+		Size2:   angleDeg("2", 2),
+		Size3:   angleDeg("3", 3),
+		Size6:   angleDeg("6", 6),
+		Size12:  angleDeg("12", 12),
+		Size45:  angleDeg("45", 45),
+		Size90:  angleDeg("90", 90),
+		Size180: angleDeg("180", 180),
+	}
+}
+
+// Default scale configuration
+func DefaultScale() ScaleConfig {
+	return ScaleConfig{
+		Size0:   number("0", 0),
+		Size50:  number("50", 0.5),
+		Size75:  number("75", 0.75),
+		Size90:  number("90", 0.9),
+		Size95:  number("95", 0.95),
+		Size100: number("100", 1),
+		Size105: number("105", 1.05),
+		Size110: number("110", 1.1),
+		Size125: number("125", 1.25),
+		Size150: number("150", 1.5),
+	}
+}
+
+// Default skew configuration
+func DefaultSkew() SkewConfig {
+	return SkewConfig{
+		Size0:  angleDeg("0", 0),
+		Size1:  angleDeg("1", 1),
+		Size2:  angleDeg("2", 2),
+		Size3:  angleDeg("3", 3),
+		Size6:  angleDeg("6", 6),
+		Size12: angleDeg("12", 12),
+	}
+}
+
+// Default transform origin configuration
+func DefaultTransformOrigin() TransformOriginConfig {
+	return TransformOriginConfig{
+		Center:      KeywordValue{Name: "center", Keyword: css.Keyword("center")},
+		Top:         KeywordValue{Name: "top", Keyword: css.Keyword("top")},
+		TopRight:    KeywordValue{Name: "top-right", Keyword: css.Keyword("top right")},
+		Right:       KeywordValue{Name: "right", Keyword: css.Keyword("right")},
+		BottomRight: KeywordValue{Name: "bottom-right", Keyword: css.Keyword("bottom right")},
+		Bottom:      KeywordValue{Name: "bottom", Keyword: css.Keyword("bottom")},
+		BottomLeft:  KeywordValue{Name: "bottom-left", Keyword: css.Keyword("bottom left")},
+		Left:        KeywordValue{Name: "left", Keyword: css.Keyword("left")},
+		TopLeft:     KeywordValue{Name: "top-left", Keyword: css.Keyword("top left")},
+	}
+}
+
+// Default keyframes configuration
+func DefaultKeyframes() KeyframesConfig {
+	return KeyframesConfig{
+		Spin: []css.Rule{
+			css.RuleSet("to", css.Set(cssgen.Transform, css.Raw("rotate(360deg)"))),
+		},
+		Ping: []css.Rule{
+			css.RuleSet("75%, 100%", css.Set(cssgen.Transform, css.Raw("scale(2)")), css.Set(cssgen.Opacity, css.Raw("0"))),
+		},
+		Pulse: []css.Rule{
+			css.RuleSet("50%", css.Set(cssgen.Opacity, css.Raw(".5"))),
+		},
+		Bounce: []css.Rule{
+			css.RuleSet("0%, 100%",
+				css.Set(cssgen.Transform, css.Raw("translateY(-25%)")),
+				css.Set(cssgen.AnimationTimingFunction, css.Raw("cubic-bezier(0.8, 0, 1, 1)"))),
+			css.RuleSet("50%",
+				css.Set(cssgen.Transform, css.Keyword("none")),
+				css.Set(cssgen.AnimationTimingFunction, css.Raw("cubic-bezier(0, 0, 0.2, 1)"))),
+		},
+	}
+}
+
+// Default transition property configuration
+func DefaultTransitionProperty() TransitionPropertyConfig {
+	return TransitionPropertyConfig{
+		None:      KeywordValue{Name: "none", Keyword: css.Keyword("none")},
+		All:       KeywordValue{Name: "all", Keyword: css.Keyword("all")},
+		DEFAULT:   KeywordValue{Name: "DEFAULT", Keyword: css.Keyword("color, background-color, border-color, text-decoration-color, fill, stroke, opacity, box-shadow, transform, translate, scale, rotate, filter, backdrop-filter")},
+		Colors:    KeywordValue{Name: "colors", Keyword: css.Keyword("color, background-color, border-color, text-decoration-color, fill, stroke")},
+		Opacity:   KeywordValue{Name: "opacity", Keyword: css.Keyword("opacity")},
+		Shadow:    KeywordValue{Name: "shadow", Keyword: css.Keyword("box-shadow")},
+		Transform: KeywordValue{Name: "transform", Keyword: css.Keyword("transform, translate, scale, rotate")},
+	}
+}
+
+// Default transition duration configuration
+func DefaultTransitionDuration() TransitionDurationConfig {
+	return TransitionDurationConfig{
+		DEFAULT:  timeMs("DEFAULT", 150),
+		Size0:    timeS("0", 0),
+		Size75:   timeMs("75", 75),
+		Size100:  timeMs("100", 100),
+		Size150:  timeMs("150", 150),
+		Size200:  timeMs("200", 200),
+		Size300:  timeMs("300", 300),
+		Size500:  timeMs("500", 500),
+		Size700:  timeMs("700", 700),
+		Size1000: timeMs("1000", 1000),
+	}
+}
+
+// Default transition timing function configuration
+func DefaultTransitionTimingFunction() TransitionTimingFunctionConfig {
+	return TransitionTimingFunctionConfig{
+		DEFAULT: KeywordValue{Name: "DEFAULT", Keyword: css.Keyword("cubic-bezier(0.4, 0, 0.2, 1)")},
+		Linear:  KeywordValue{Name: "linear", Keyword: css.Keyword("linear")},
+		In:      KeywordValue{Name: "in", Keyword: css.Keyword("cubic-bezier(0.4, 0, 1, 1)")},
+		Out:     KeywordValue{Name: "out", Keyword: css.Keyword("cubic-bezier(0, 0, 0.2, 1)")},
+		InOut:   KeywordValue{Name: "in-out", Keyword: css.Keyword("cubic-bezier(0.4, 0, 0.2, 1)")},
+	}
+}
+
+// Default transition delay configuration
+func DefaultTransitionDelay() TransitionDelayConfig {
+	return TransitionDelayConfig{
+		Size0:    timeS("0", 0),
+		Size75:   timeMs("75", 75),
+		Size100:  timeMs("100", 100),
+		Size150:  timeMs("150", 150),
+		Size200:  timeMs("200", 200),
+		Size300:  timeMs("300", 300),
+		Size500:  timeMs("500", 500),
+		Size700:  timeMs("700", 700),
+		Size1000: timeMs("1000", 1000),
+	}
+}
+
+// Default divide width configuration
+func DefaultDivideWidth() DivideWidthConfig {
+	return DivideWidthConfig{
+		DEFAULT: LengthFromPx("DEFAULT", 1),
+		Size0:   LengthFromPx("0", 0),
+		Size2:   LengthFromPx("2", 2),
+		Size4:   LengthFromPx("4", 4),
+		Size8:   LengthFromPx("8", 8),
+	}
+}
+
+// Default ring width configuration
+func DefaultRingWidth() RingWidthConfig {
+	return RingWidthConfig{
+		DEFAULT: LengthFromPx("DEFAULT", 3),
+		Size0:   LengthFromPx("0", 0),
+		Size1:   LengthFromPx("1", 1),
+		Size2:   LengthFromPx("2", 2),
+		Size4:   LengthFromPx("4", 4),
+		Size8:   LengthFromPx("8", 8),
+	}
+}
+
+// Default ring offset width configuration
+func DefaultRingOffsetWidth() RingOffsetWidthConfig {
+	return RingOffsetWidthConfig{
+		Size0: LengthFromPx("0", 0),
+		Size1: LengthFromPx("1", 1),
+		Size2: LengthFromPx("2", 2),
+		Size4: LengthFromPx("4", 4),
+		Size8: LengthFromPx("8", 8),
+	}
+}
+
+// Default outline width configuration
+func DefaultOutlineWidth() OutlineWidthConfig {
+	return OutlineWidthConfig{
+		Size0: LengthFromPx("0", 0),
+		Size1: LengthFromPx("1", 1),
+		Size2: LengthFromPx("2", 2),
+		Size4: LengthFromPx("4", 4),
+		Size8: LengthFromPx("8", 8),
+	}
+}
+
+// Default outline offset configuration
+func DefaultOutlineOffset() OutlineOffsetConfig {
+	return OutlineOffsetConfig{
+		Size0: LengthFromPx("0", 0),
+		Size1: LengthFromPx("1", 1),
+		Size2: LengthFromPx("2", 2),
+		Size4: LengthFromPx("4", 4),
+		Size8: LengthFromPx("8", 8),
+	}
+}
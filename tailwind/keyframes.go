@@ -0,0 +1,84 @@
+// This file implements the @keyframes that animation utilities pull into
+// the output, so that animate-spin brings the spin keyframes along.
+
+package tailwind
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/cssgen"
+)
+
+// namedKeyframes is a @keyframes rule and the name animations refer to it by.
+type namedKeyframes struct {
+	name string
+	rule css.AtRule
+}
+
+// themeKeyframes returns the @keyframes rules of a theme in field order,
+// named by their lowercased field name. Unset fields are skipped.
+func themeKeyframes(config KeyframesConfig) []namedKeyframes {
+	var keyframes []namedKeyframes
+	v := reflect.ValueOf(config)
+	for i := 0; i < v.NumField(); i++ {
+		steps, ok := v.Field(i).Interface().([]css.Rule)
+		if !ok || len(steps) == 0 {
+			continue
+		}
+		name := strings.ToLower(v.Type().Field(i).Name)
+		body := make([]css.Item, len(steps))
+		for j, step := range steps {
+			body[j] = step
+		}
+		keyframes = append(keyframes, namedKeyframes{name, css.AtRule{Name: "keyframes", Params: name, Body: body}})
+	}
+	return keyframes
+}
+
+// keyframesIndex finds the @keyframes rules that declarations use.
+type keyframesIndex struct {
+	keyframes map[string]css.Item
+	variables map[string]string // animation custom properties and their values
+}
+
+// newKeyframesIndex indexes the keyframes of a configuration and, with
+// CSSVariables set, the animation custom properties that utilities refer to
+// instead of the animations themselves.
+func newKeyframesIndex(config Config) keyframesIndex {
+	index := keyframesIndex{keyframes: make(map[string]css.Item), variables: make(map[string]string)}
+	for _, k := range themeKeyframes(config.Theme.Keyframes) {
+		index.keyframes[k.name] = k.rule
+	}
+	if config.CSSVariables {
+		for _, entry := range themeScale(config.Theme.Animation) {
+			index.variables[variableName("Animation", entry.key)] = entry.value.String()
+		}
+	}
+	return index
+}
+
+// find returns the @keyframes rules named by the animation and
+// animation-name declarations, in order and without duplicates.
+func (index keyframesIndex) find(decls []css.Decl) []css.Item {
+	var items []css.Item
+	seen := make(map[string]bool)
+	var scan func(value string)
+	scan = func(value string) {
+		for _, token := range strings.FieldsFunc(value, func(r rune) bool { return strings.ContainsRune(" ,()", r) }) {
+			if v, ok := index.variables[token]; ok {
+				scan(v)
+			} else if rule, ok := index.keyframes[token]; ok && !seen[token] {
+				seen[token] = true
+				items = append(items, rule)
+			}
+		}
+	}
+	for _, decl := range decls {
+		if decl.Property == cssgen.Animation || decl.Property == cssgen.AnimationName {
+			scan(decl.Value.String())
+		}
+	}
+	return items
+}
@@ -14,12 +14,11 @@ type UtilityManager struct {
 	mu    sync.RWMutex        // Protects concurrent access
 }
 
-// NewUtilityManager creates a new utility manager with the given theme.
+// NewUtilityManager creates a new utility manager with the given theme, or
+// with the theme of DefaultConfig if theme is nil.
 func NewUtilityManager(theme *Theme) *UtilityManager {
 	if theme == nil {
-		// TODO: Update to use new typed config system
-		// theme = DefaultTheme()
-		theme = nil // Temporary placeholder
+		theme = themeFromConfig(DefaultTheme())
 	} else {
 		theme.Rebuild()
 	}
@@ -30,6 +29,84 @@ func NewUtilityManager(theme *Theme) *UtilityManager {
 	}
 }
 
+// NewUtilityManagerFromConfig creates a utility manager with the theme of a
+// configuration, after resolving its presets with ResolveConfig.
+func NewUtilityManagerFromConfig(config Config) (*UtilityManager, error) {
+	resolved, err := ResolveConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return NewUtilityManager(themeFromConfig(resolved.Theme)), nil
+}
+
+// themeFromConfig converts a typed theme into a Theme. Categories the typed
+// configuration does not define yet, such as box shadows and z-indexes,
+// keep their built-in scales.
+func themeFromConfig(config ThemeConfig) *Theme {
+	t := &Theme{
+		BoxShadow:  buildBoxShadowScale(),
+		LineHeight: buildLineHeightScale(),
+		ZIndex:     buildZIndexScale(),
+		Contrast:   buildContrastScale(),
+		Grayscale:  buildGrayscaleScale(),
+		Invert:     buildInvertScale(),
+		Saturate:   buildSaturateScale(),
+		Sepia:      buildSepiaScale(),
+
+		customColors:       make(map[string]ColorToken),
+		customSpacing:      make(map[string]ValueToken),
+		customFontSizes:    make(map[string]ValueToken),
+		customFontWeight:   make(map[string]ValueToken),
+		customBorderRadius: make(map[string]ValueToken),
+		customBorderWidth:  make(map[string]ValueToken),
+		customOpacity:      make(map[string]ValueToken),
+		customBlur:         make(map[string]ValueToken),
+		customBrightness:   make(map[string]ValueToken),
+	}
+
+	for _, e := range themeScale(config.Colors) {
+		t.customColors[e.key] = newColorToken(e.key, css.Color(e.value.String()))
+	}
+	for _, e := range themeScale(config.FontSize) {
+		t.customFontSizes[e.key] = newValueToken(e.key, e.value.(fontSize).size)
+	}
+	for _, scale := range []struct {
+		config any
+		tokens map[string]ValueToken
+	}{
+		{config.Spacing, t.customSpacing},
+		{config.FontWeight, t.customFontWeight},
+		{config.BorderRadius, t.customBorderRadius},
+		{config.BorderWidth, t.customBorderWidth},
+		{config.Opacity, t.customOpacity},
+		{config.Blur, t.customBlur},
+		{config.Brightness, t.customBrightness},
+	} {
+		for _, e := range themeScale(scale.config) {
+			scale.tokens[e.key] = newValueToken(e.key, e.value)
+		}
+	}
+
+	for _, s := range screenValues(config.Screens) {
+		minWidth := fmt.Sprintf("(min-width: %s)", s.value)
+		switch s.name {
+		case "sm":
+			t.Screens.Sm = minWidth
+		case "md":
+			t.Screens.Md = minWidth
+		case "lg":
+			t.Screens.Lg = minWidth
+		case "xl":
+			t.Screens.Xl = minWidth
+		case "2xl":
+			t.Screens.X2L = minWidth
+		}
+	}
+
+	t.Rebuild()
+	return t
+}
+
 // GetOrCreateRule gets an existing rule or creates a new one if it doesn't exist.
 // This ensures each utility class is only defined once.
 func (um *UtilityManager) GetOrCreateRule(className string, createFn func() css.Rule) css.Rule {
@@ -70,15 +147,14 @@ func (um *UtilityManager) Theme() *Theme {
 	return um.theme
 }
 
-// UpdateTheme updates the theme and clears the rule cache.
+// UpdateTheme updates the theme and clears the rule cache. A nil theme
+// restores the theme of DefaultConfig.
 func (um *UtilityManager) UpdateTheme(theme *Theme) {
 	um.mu.Lock()
 	defer um.mu.Unlock()
 
 	if theme == nil {
-		// TODO: Update to use new typed config system
-		// theme = DefaultTheme()
-		theme = nil // Temporary placeholder
+		theme = themeFromConfig(DefaultTheme())
 	} else {
 		theme.Rebuild()
 	}
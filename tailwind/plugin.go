@@ -0,0 +1,277 @@
+// This file implements plugins, which add utilities, components, base
+// styles and variants to a configuration the way Tailwind plugins do.
+
+package tailwind
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// Plugin extends a configuration with utilities, components, base styles
+// and variants. Plugins are listed in Config.Plugins, and the compiler and
+// the utility generator each call Register once, with an API bound to the
+// resolved theme. Both emit the base styles, components and utilities;
+// the utility generator generates no variants beyond the responsive
+// screens, so UtilityGenerator.Err reports plugin variants.
+type Plugin interface {
+	Register(api *PluginAPI)
+}
+
+// PluginFunc adapts a function to a Plugin.
+//
+//	tailwind.PluginFunc(func(api *tailwind.PluginAPI) {
+//		api.AddUtilities(css.RuleSet(".content-auto", css.Set(cssgen.ContentVisibility, css.Keyword("auto"))))
+//	})
+type PluginFunc func(api *PluginAPI)
+
+// Register calls f(api).
+func (f PluginFunc) Register(api *PluginAPI) { f(api) }
+
+// MatchUtility is a utility that takes its value from a scale or from an
+// arbitrary value, such as tab-4 and tab-[3] for a utility named tab.
+type MatchUtility struct {
+	// Name is the class prefix, e.g. "tab".
+	Name string
+
+	// Values is the scale: a theme category such as ThemeConfig.Spacing, or
+	// a map[string]string. The "DEFAULT" value, if any, is the bare class.
+	// Without values the utility only takes arbitrary values.
+	Values any
+
+	// Negative reports whether -tab-4 negates the value of tab-4.
+	Negative bool
+
+	// Decls returns the declarations for a value.
+	Decls func(value css.Value) []css.Decl
+}
+
+// PluginAPI is the API plugins register their additions with.
+type PluginAPI struct {
+	theme    ThemeConfig
+	base     []css.Item
+	classes  []*pluginClass // components and utilities, in registration order
+	byClass  map[string]*pluginClass
+	matchers []pluginMatch
+	variants []pluginVariant
+	errs     []error
+}
+
+// pluginClass is a component or utility class with the rules that make it
+// up, such as .btn and .btn:hover for btn.
+type pluginClass struct {
+	name      string
+	component bool
+	rules     []css.Rule
+	rank      int
+}
+
+type pluginMatch struct {
+	MatchUtility
+	values []scaleEntry
+}
+
+type pluginVariant struct {
+	name string
+	def  VariantDef
+}
+
+// registerPlugins calls plugins with the theme utilities are generated
+// from.
+func registerPlugins(plugins []Plugin, theme ThemeConfig) *PluginAPI {
+	api := &PluginAPI{theme: theme, byClass: make(map[string]*pluginClass)}
+	for _, p := range plugins {
+		p.Register(api)
+	}
+	return api
+}
+
+// Theme returns the resolved theme, for plugins that take their values from
+// it. With Config.CSSVariables set, its values reference custom properties.
+func (api *PluginAPI) Theme() ThemeConfig {
+	return api.theme
+}
+
+// AddBase adds base styles, such as element defaults. They are emitted
+// first, whichever classes are used.
+func (api *PluginAPI) AddBase(items ...css.Item) {
+	api.base = append(api.base, items...)
+}
+
+// AddComponents adds component classes. A rule belongs to the first class
+// of its selector, so that .btn and .btn:hover make up btn; components are
+// emitted before utilities, so utilities can override them.
+func (api *PluginAPI) AddComponents(rules ...css.Rule) {
+	api.addRules(true, rules)
+}
+
+// AddUtilities adds utility classes, which, like components, are made up of
+// the rules of their first class.
+func (api *PluginAPI) AddUtilities(rules ...css.Rule) {
+	api.addRules(false, rules)
+}
+
+func (api *PluginAPI) addRules(component bool, rules []css.Rule) {
+	for _, rule := range rules {
+		name, ok := selectorClass(rule.Selector)
+		if !ok {
+			api.errs = append(api.errs, fmt.Errorf("tailwind: plugin rule %q: selector has no class", rule.Selector))
+			continue
+		}
+		class, ok := api.byClass[name]
+		if !ok {
+			class = &pluginClass{name: name, component: component}
+			api.byClass[name] = class
+			api.classes = append(api.classes, class)
+		}
+		class.rules = append(class.rules, rule)
+	}
+}
+
+// MatchUtilities adds utilities that take values from a scale or arbitrary
+// values.
+func (api *PluginAPI) MatchUtilities(utilities ...MatchUtility) {
+	for _, u := range utilities {
+		if u.Name == "" || u.Decls == nil {
+			api.errs = append(api.errs, fmt.Errorf("tailwind: plugin utility %q: missing name or declarations", u.Name))
+			continue
+		}
+		values, err := pluginScale(u.Values)
+		if err != nil {
+			api.errs = append(api.errs, fmt.Errorf("tailwind: plugin utility %q: %w", u.Name, err))
+			continue
+		}
+		api.matchers = append(api.matchers, pluginMatch{u, values})
+	}
+}
+
+// AddVariant adds or replaces a variant of the compiler. The utility
+// generator cannot generate it, and reports it from Err.
+func (api *PluginAPI) AddVariant(name string, def VariantDef) {
+	api.variants = append(api.variants, pluginVariant{name, def})
+}
+
+// err returns the errors of the registrations.
+func (api *PluginAPI) err() error {
+	return errors.Join(api.errs...)
+}
+
+// pluginScale converts the values of a MatchUtility to a scale.
+func pluginScale(values any) ([]scaleEntry, error) {
+	switch values := values.(type) {
+	case nil:
+		return nil, nil
+	case map[string]string:
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		entries := make([]scaleEntry, len(keys))
+		for i, k := range keys {
+			entries[i] = scaleEntry{scaleKey(k), css.Raw(values[k])}
+		}
+		return entries, nil
+	}
+	if reflect.ValueOf(values).Kind() != reflect.Struct {
+		return nil, fmt.Errorf("values of type %T are neither a theme category nor a map[string]string", values)
+	}
+	return themeScale(values), nil
+}
+
+// selectorClass returns the first class of a selector.
+func selectorClass(selector string) (string, bool) {
+	i := indexTopLevel(selector, 0, func(s string, i int) bool { return s[i] == '.' })
+	if i < 0 {
+		return "", false
+	}
+	end := i + 1
+	for end < len(selector) && isClassChar(selector[end]) {
+		end++
+	}
+	return selector[i+1 : end], end > i+1
+}
+
+// item returns the rules of the class for a use of it, such as hover:!btn:
+// the class is renamed to the escaped use, and the declarations made
+// important if important is set. Several rules are returned as a
+// css.Stylesheet.
+func (p *pluginClass) item(use string, important bool) css.Item {
+	rules := make([]css.Item, len(p.rules))
+	for i, rule := range p.rules {
+		decls := append([]css.Decl(nil), rule.Decls...)
+		if important {
+			for j, decl := range decls {
+				decls[j].Value = css.Raw(decl.Value.String() + " !important")
+			}
+		}
+		rules[i] = css.RuleSet(renameClass(rule.Selector, p.name, escapeClass(use)), decls...)
+	}
+	if len(rules) == 1 {
+		return rules[0]
+	}
+	return css.Stylesheet{Items: rules}
+}
+
+// renameClass replaces the class from with the class to in a selector.
+func renameClass(selector, from, to string) string {
+	var b strings.Builder
+	for i := 0; ; {
+		j := indexTopLevel(selector, i, func(s string, k int) bool {
+			end := k + 1 + len(from)
+			return s[k] == '.' && strings.HasPrefix(s[k+1:], from) && (end == len(s) || !isClassChar(s[end]))
+		})
+		if j < 0 {
+			b.WriteString(selector[i:])
+			return b.String()
+		}
+		b.WriteString(selector[i:j])
+		b.WriteString("." + to)
+		i = j + 1 + len(from)
+	}
+}
+
+// components returns the rules of the plugin components, for the utility
+// generator.
+func (api *PluginAPI) components() []css.Item {
+	return api.rules(true)
+}
+
+// utilities returns the rules of the plugin utilities, with the match
+// utilities over their scale, for the utility generator.
+func (api *PluginAPI) utilities() []css.Item {
+	items := api.rules(false)
+	for _, m := range api.matchers {
+		for _, entry := range m.values {
+			class := ClassName(m.Name, entry.key)
+			items = append(items, css.RuleSet("."+escapeClass(class), m.Decls(entry.value)...))
+			if negative := negate(entry.value); m.Negative && negative.String() != entry.value.String() {
+				items = append(items, css.RuleSet("."+escapeClass("-"+class), m.Decls(negative)...))
+			}
+		}
+	}
+	return items
+}
+
+func (api *PluginAPI) rules(component bool) []css.Item {
+	var items []css.Item
+	for _, class := range api.classes {
+		if class.component == component {
+			items = append(items, flatten(class.item(class.name, false))...)
+		}
+	}
+	return items
+}
+
+// flatten returns the items of a css.Stylesheet, or the item itself.
+func flatten(item css.Item) []css.Item {
+	if s, ok := item.(css.Stylesheet); ok {
+		return s.Items
+	}
+	return []css.Item{item}
+}
@@ -0,0 +1,108 @@
+package tailwind
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/cssgen"
+)
+
+var testPlugin = PluginFunc(func(api *PluginAPI) {
+	api.AddBase(css.RuleSet("h1", css.Set(cssgen.FontSize, css.Raw("2rem"))))
+	api.AddComponents(
+		css.RuleSet(".btn", css.Set(cssgen.Padding, css.Raw("0.5rem 1rem"))),
+		css.RuleSet(".btn:hover", css.Set(cssgen.Opacity, css.Raw("0.9"))),
+	)
+	api.AddUtilities(css.RuleSet(".content-auto", css.Set(cssgen.ContentVisibility, css.Keyword("auto"))))
+	api.MatchUtilities(MatchUtility{
+		Name:   "tab",
+		Values: map[string]string{"2": "2", "4": "4", "DEFAULT": "8"},
+		Decls:  setAll(cssgen.TabSize),
+	}, MatchUtility{
+		Name:     "nudge",
+		Values:   api.Theme().Spacing,
+		Negative: true,
+		Decls:    setAll(cssgen.Translate),
+	})
+	api.AddVariant("optional", VariantDef{Suffix: ":optional"})
+})
+
+func TestPluginCompile(t *testing.T) {
+	config := DefaultConfig()
+	config.Plugins = []Plugin{testPlugin}
+	compiler := NewCompiler(config)
+	if err := compiler.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	stylesheet, errs := compiler.Compile("p-2 md:btn content-auto tab tab-[3] -nudge-4 optional:content-auto")
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	var got []string
+	for _, item := range stylesheet.Items {
+		got = append(got, item.String())
+	}
+	want := []string{
+		`h1{font-size:2rem}`,
+		`@media (min-width: 768px){.md\:btn{padding:0.5rem 1rem}.md\:btn:hover{opacity:0.9}}`,
+		`.p-2{padding:0.5rem}`,
+		`.content-auto{content-visibility:auto}`,
+		`.tab{tab-size:8}`,
+		`.tab-\[3\]{tab-size:3}`,
+		`.-nudge-4{translate:-1rem}`,
+		`.optional\:content-auto:optional{content-visibility:auto}`,
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compile =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+
+	item, err := compiler.CompileClass("!btn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := item.String(), `.\!btn{padding:0.5rem 1rem !important}.\!btn:hover{opacity:0.9 !important}`; got != want {
+		t.Errorf("CompileClass(!btn) = %s, want %s", got, want)
+	}
+}
+
+func TestPluginGenerateUtilities(t *testing.T) {
+	config := DefaultConfig()
+	config.Presets = []Config{{Plugins: []Plugin{testPlugin}}}
+	generator := NewUtilityGenerator(config)
+	if err := generator.Err(); err == nil || !strings.Contains(err.Error(), `plugin variant "optional"`) {
+		t.Errorf("Err() = %v, want the plugin variant reported", err)
+	}
+
+	out := generator.GenerateUtilities().String()
+	if !strings.HasPrefix(out, `h1{font-size:2rem}.btn{padding:0.5rem 1rem}.btn:hover{opacity:0.9}`) {
+		t.Errorf("output does not start with the base styles and components:\n%.200s", out)
+	}
+	for _, want := range []string{`.content-auto{content-visibility:auto}`, `.tab-4{tab-size:4}`, `.tab{tab-size:8}`, `.nudge-4{translate:1rem}`, `.-nudge-4{translate:-1rem}`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %s", want)
+		}
+	}
+	if strings.Contains(out, `.-nudge-0{`) {
+		t.Error("output has a negative utility for zero")
+	}
+}
+
+func TestPluginErrors(t *testing.T) {
+	config := DefaultConfig()
+	config.Plugins = []Plugin{PluginFunc(func(api *PluginAPI) {
+		api.AddUtilities(css.RuleSet("main", css.Set(cssgen.Display, css.Keyword("grid"))))
+		api.MatchUtilities(MatchUtility{Name: "tab", Values: []string{"4"}, Decls: setAll(cssgen.TabSize)})
+	})}
+
+	err := NewCompiler(config).Err()
+	if err == nil {
+		t.Fatal("NewCompiler accepted invalid plugin additions")
+	}
+	for _, want := range []string{`plugin rule "main": selector has no class`, `plugin utility "tab": values of type []string`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %s", err, want)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// This file implements preset resolution, which merges a configuration with
+// its presets into the single configuration that utilities are generated
+// from.
+
+package tailwind
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ResolveConfig merges a configuration with its presets, recursively, the
+// way Tailwind does:
+//
+//   - Presets are applied in order, each after its own presets, and the
+//     configuration itself last.
+//   - A theme category set in Theme, such as Theme.Colors, replaces the
+//     category of the presets as a whole.
+//   - A field set in Theme.Extend is merged into its category, so that
+//     extending Colors.Blue500 keeps the other blue shades, and extending
+//     Screens.Size2xl keeps the other breakpoints. Extensions apply after
+//     all replacements, presets' extensions first.
+//   - Content and DarkMode replace those of the presets when set, and
+//     Plugins and AlternateThemes add to them.
+//
+// The result has no presets and no Extend. ResolveConfig reports preset
+// cycles, nested extends and theme values of the wrong kind, such as a
+// length in a color field, as errors naming the offending field.
+func ResolveConfig(config Config) (Config, error) {
+	r, err := resolvePresets(&config, "config", nil)
+	if err != nil {
+		return Config{}, err
+	}
+	for _, extend := range r.extends {
+		extendTheme(&r.config.Theme, extend)
+	}
+	return r.config, nil
+}
+
+// resolvedConfig is a configuration with its presets merged and its
+// extensions not yet applied.
+type resolvedConfig struct {
+	config  Config
+	extends []*ThemeConfig
+}
+
+// pathEntry is a configuration on the path from the root to the preset
+// being resolved, used to detect cycles.
+type pathEntry struct {
+	config *Config
+	name   string
+}
+
+func resolvePresets(config *Config, name string, path []pathEntry) (resolvedConfig, error) {
+	for i, entry := range path {
+		if entry.config == config {
+			var names []string
+			for _, e := range path[i:] {
+				names = append(names, e.name)
+			}
+			return resolvedConfig{}, fmt.Errorf("tailwind: preset cycle: %s -> %s", strings.Join(names, " -> "), name)
+		}
+	}
+	path = append(path, pathEntry{config, name})
+
+	if err := checkTheme(config.Theme, name+".Theme"); err != nil {
+		return resolvedConfig{}, err
+	}
+	for i, alt := range config.AlternateThemes {
+		if err := checkTheme(alt.Theme, fmt.Sprintf("%s.AlternateThemes[%d].Theme", name, i)); err != nil {
+			return resolvedConfig{}, err
+		}
+	}
+	if extend := config.Theme.Extend; extend != nil {
+		if extend.Extend != nil {
+			return resolvedConfig{}, fmt.Errorf("tailwind: %s.Theme.Extend.Extend: extensions cannot be nested", name)
+		}
+		if err := checkTheme(*extend, name+".Theme.Extend"); err != nil {
+			return resolvedConfig{}, err
+		}
+	}
+
+	var r resolvedConfig
+	for i := range config.Presets {
+		preset, err := resolvePresets(&config.Presets[i], fmt.Sprintf("%s.Presets[%d]", name, i), path)
+		if err != nil {
+			return resolvedConfig{}, err
+		}
+		mergeConfig(&r.config, preset.config)
+		r.extends = append(r.extends, preset.extends...)
+	}
+	mergeConfig(&r.config, *config)
+	if config.Theme.Extend != nil {
+		r.extends = append(r.extends, config.Theme.Extend)
+	}
+	r.config.Presets = nil
+	r.config.Theme.Extend = nil
+	return r, nil
+}
+
+// mergeConfig merges the settings and theme categories set in over into
+// base.
+func mergeConfig(base *Config, over Config) {
+	if len(over.Content) > 0 {
+		base.Content = append([]string(nil), over.Content...)
+	}
+	if over.DarkMode != (DarkModeConfig{}) {
+		base.DarkMode = over.DarkMode
+	}
+	base.CSSVariables = base.CSSVariables || over.CSSVariables
+	base.Plugins = append(base.Plugins[:len(base.Plugins):len(base.Plugins)], over.Plugins...)
+	base.AlternateThemes = append(base.AlternateThemes[:len(base.AlternateThemes):len(base.AlternateThemes)], over.AlternateThemes...)
+
+	b := reflect.ValueOf(&base.Theme).Elem()
+	o := reflect.ValueOf(over.Theme)
+	for i := 0; i < o.NumField(); i++ {
+		if isThemeCategory(o.Type().Field(i)) && !o.Field(i).IsZero() {
+			b.Field(i).Set(o.Field(i))
+		}
+	}
+}
+
+// extendTheme sets the fields set in the categories of extend.
+func extendTheme(theme *ThemeConfig, extend *ThemeConfig) {
+	t := reflect.ValueOf(theme).Elem()
+	e := reflect.ValueOf(*extend)
+	for i := 0; i < e.NumField(); i++ {
+		if !isThemeCategory(e.Type().Field(i)) {
+			continue
+		}
+		category := e.Field(i)
+		for j := 0; j < category.NumField(); j++ {
+			if !category.Field(j).IsZero() {
+				t.Field(i).Field(j).Set(category.Field(j))
+			}
+		}
+	}
+}
+
+func isThemeCategory(field reflect.StructField) bool {
+	return field.IsExported() && field.Type.Kind() == reflect.Struct
+}
+
+// valueKinds lists the value types accepted by each value interface. The
+// interfaces have the same methods, so the compiler accepts a StaticLength
+// in a ColorValue field; ResolveConfig does not. KeywordValue and
+// ArbitraryValue fit every field, as do types defined outside this package.
+var valueKinds = map[reflect.Type][]reflect.Type{
+	reflect.TypeFor[ColorValue]():      {reflect.TypeFor[StaticColor]()},
+	reflect.TypeFor[LengthValue]():     {reflect.TypeFor[StaticLength](), reflect.TypeFor[StaticNumber](), reflect.TypeFor[StaticPercentage]()},
+	reflect.TypeFor[TimeValue]():       {reflect.TypeFor[StaticTime]()},
+	reflect.TypeFor[AngleValue]():      {reflect.TypeFor[StaticAngle]()},
+	reflect.TypeFor[NumberValue]():     {reflect.TypeFor[StaticNumber](), reflect.TypeFor[StaticPercentage]()},
+	reflect.TypeFor[PercentageValue](): {reflect.TypeFor[StaticPercentage](), reflect.TypeFor[StaticNumber]()},
+}
+
+// kindNames names the value interfaces in errors.
+var kindNames = map[reflect.Type]string{
+	reflect.TypeFor[ColorValue]():      "color",
+	reflect.TypeFor[LengthValue]():     "length",
+	reflect.TypeFor[TimeValue]():       "time",
+	reflect.TypeFor[AngleValue]():      "angle",
+	reflect.TypeFor[NumberValue]():     "number",
+	reflect.TypeFor[PercentageValue](): "percentage",
+}
+
+// checkTheme checks that the values of a theme fit the fields they are in.
+func checkTheme(theme ThemeConfig, path string) error {
+	v := reflect.ValueOf(theme)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !isThemeCategory(field) {
+			continue
+		}
+		if err := checkValues(v.Field(i), path+"."+field.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkValues(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := checkValues(v.Field(i), path+"."+v.Type().Field(i).Name); err != nil {
+				return err
+			}
+		}
+	case reflect.Interface:
+		accepted, ok := valueKinds[v.Type()]
+		if !ok || v.IsNil() {
+			return nil
+		}
+		actual := v.Elem().Type()
+		if actual.PkgPath() != reflect.TypeFor[StaticColor]().PkgPath() ||
+			actual == reflect.TypeFor[KeywordValue]() || actual == reflect.TypeFor[ArbitraryValue]() {
+			return nil
+		}
+		for _, t := range accepted {
+			if actual == t {
+				return nil
+			}
+		}
+		return fmt.Errorf("tailwind: %s: %s is not a %s value", path, actual.Name(), kindNames[v.Type()])
+	}
+	return nil
+}
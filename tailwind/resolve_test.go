@@ -0,0 +1,128 @@
+package tailwind
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolveConfigPresets(t *testing.T) {
+	brand := Config{
+		Theme: ThemeConfig{
+			Colors: ColorsConfig{
+				Blue500: ColorFromHex("blue-500", "#0000ff"),
+				Blue600: ColorFromHex("blue-600", "#0000cc"),
+			},
+			Extend: &ThemeConfig{
+				Spacing: SpacingConfig{Size96: LengthFromRem("96", 30)},
+			},
+		},
+	}
+	config := Config{
+		Presets: []Config{DefaultConfig(), brand},
+		Theme: ThemeConfig{
+			Extend: &ThemeConfig{
+				Colors:  ColorsConfig{Blue500: ColorFromHex("blue-500", "#1e40af")},
+				Screens: ScreensConfig{Sm: LengthFromPx("sm", 480)},
+			},
+		},
+	}
+
+	resolved, err := ResolveConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Presets != nil || resolved.Theme.Extend != nil {
+		t.Error("ResolveConfig left presets or an extension in the result")
+	}
+
+	colors := resolved.Theme.Colors
+	// brand replaces the default colors, and the extension merges per shade.
+	if colors.Red500 != nil {
+		t.Errorf("Colors.Red500 = %v, want the default colors replaced", colors.Red500)
+	}
+	if got := colors.Blue500.ToCSSValue().String(); got != "#1e40af" {
+		t.Errorf("Colors.Blue500 = %s, want the extension's #1e40af", got)
+	}
+	if got := colors.Blue600.ToCSSValue().String(); got != "#0000cc" {
+		t.Errorf("Colors.Blue600 = %s, want brand's #0000cc", got)
+	}
+	// The defaults' other categories survive, with preset extensions applied.
+	if resolved.Theme.Spacing.Size4 == nil || resolved.Theme.Spacing.Size96.ToCSSValue().String() != "30rem" {
+		t.Error("Spacing lost the defaults or the preset's extension")
+	}
+	if resolved.Theme.Screens.Md == nil || resolved.Theme.Screens.Sm.ToCSSValue().String() != "480px" {
+		t.Errorf("Screens = %+v, want sm extended to 480px", resolved.Theme.Screens)
+	}
+
+	stylesheet, errs := NewCompiler(config).Compile("bg-blue-500 p-96 sm:flex")
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	want := `.bg-blue-500{background-color:#1e40af}.p-96{padding:30rem}@media (min-width: 480px){.sm\:flex{display:flex}}`
+	if got := stylesheet.String(); got != want {
+		t.Errorf("Compile =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestResolveConfigScreenOrder(t *testing.T) {
+	config := DefaultConfig()
+	config.Theme.Extend = &ThemeConfig{Screens: ScreensConfig{Sm: LengthFromPx("sm", 900)}}
+
+	got := NewCompiler(config).Variants().Screens()
+	want := []string{"md", "sm", "lg", "xl", "2xl"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("Screens = %v, want %v", got, want)
+	}
+}
+
+func TestResolveConfigErrors(t *testing.T) {
+	cyclic := Config{Presets: make([]Config, 1)}
+	cyclic.Presets[0].Presets = cyclic.Presets
+
+	nested := DefaultConfig()
+	nested.Theme.Extend = &ThemeConfig{Extend: &ThemeConfig{}}
+
+	conflict := Config{Presets: []Config{DefaultConfig(), {
+		Theme: ThemeConfig{Colors: ColorsConfig{Blue500: LengthFromPx("blue-500", 4)}},
+	}}}
+
+	tests := []struct {
+		name   string
+		config Config
+		want   string
+	}{
+		{"cycle", cyclic, "tailwind: preset cycle: config.Presets[0] -> config.Presets[0].Presets[0]"},
+		{"nested extend", nested, "config.Theme.Extend.Extend: extensions cannot be nested"},
+		{"conflicting type", conflict, "tailwind: config.Presets[1].Theme.Colors.Blue500: StaticLength is not a color value"},
+	}
+	for _, tt := range tests {
+		_, err := ResolveConfig(tt.config)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: ResolveConfig error = %v, want %q", tt.name, err, tt.want)
+		}
+		if err := NewUtilityGenerator(tt.config).Err(); err == nil {
+			t.Errorf("%s: NewUtilityGenerator did not report the error", tt.name)
+		}
+	}
+}
+
+func TestUtilityManagerFromConfig(t *testing.T) {
+	config := Config{
+		Presets: []Config{DefaultConfig()},
+		Theme: ThemeConfig{
+			Extend: &ThemeConfig{Colors: ColorsConfig{Blue600: ColorFromHex("blue-600", "#123456")}},
+		},
+	}
+	manager, err := NewUtilityManagerFromConfig(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := BackgroundColor(manager, "blue-600").String(); got != ".bg-blue-600{background-color:#123456}" {
+		t.Errorf("BackgroundColor = %s", got)
+	}
+
+	// The default manager uses the default theme.
+	if got := BgBlue600().String(); got != ".bg-blue-600{background-color:#2563eb}" {
+		t.Errorf("BgBlue600 = %s", got)
+	}
+}
@@ -2,6 +2,8 @@ package tailwind
 
 import (
 	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
 )
 
 func TestSimpleTypedConfig(t *testing.T) {
@@ -62,3 +64,12 @@ func TestConfigValueTypes(t *testing.T) {
 
 	t.Logf("✅ Value type conversions work correctly")
 }
+
+func TestColorFromVar(t *testing.T) {
+	brand := css.NewVar[css.Color]("--brand")
+	color := ColorFromVar("brand", brand)
+
+	if got := color.ToCSSValue().String(); got != "var(--brand)" {
+		t.Errorf("Expected CSS value to be 'var(--brand)', got %s", got)
+	}
+}
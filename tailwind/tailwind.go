@@ -153,6 +153,5 @@ func WithCustomTheme(theme *Theme) *UtilityManager {
 
 // ResetDefaultTheme resets the default theme to the built-in Tailwind-inspired theme.
 func ResetDefaultTheme() {
-	// TODO: Update to use new typed config system
-	// SetDefaultTheme(DefaultTheme())
+	SetDefaultTheme(nil)
 }
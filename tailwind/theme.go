@@ -100,7 +100,9 @@ func (t *Theme) Rebuild() {
 func makeValueIndex(base []ValueToken, custom map[string]ValueToken) map[string]ValueToken {
 	index := make(map[string]ValueToken, len(base)+len(custom))
 	for _, token := range base {
-		index[token.Suffix()] = token
+		if !token.IsZero() {
+			index[token.Suffix()] = token
+		}
 	}
 	for name, token := range custom {
 		index[name] = token
@@ -111,7 +113,9 @@ func makeValueIndex(base []ValueToken, custom map[string]ValueToken) map[string]
 func (t *Theme) rebuildColorIndex() {
 	index := make(map[string]ColorToken)
 	for _, token := range t.Colors.tokens() {
-		index[token.Suffix()] = token
+		if !token.IsZero() {
+			index[token.Suffix()] = token
+		}
 	}
 	for name, token := range t.customColors {
 		index[name] = token
@@ -667,11 +671,14 @@ func (t *Theme) SepiaCount() int {
 }
 
 // CustomTheme returns a theme derived from the default theme with the supplied
-// mutation callbacks applied. Each modifier receives a fresh copy of the
+// mutation callbacks applied. Each call starts from a fresh copy of the
 // default theme, allowing callers to tweak nested fields without manual
 // merging logic.
 func CustomTheme(modifiers ...func(*Theme)) *Theme {
-	// Note: This function now uses the typed DefaultTheme from defaults.go
-	// TODO: This function should be updated to work with the new typed Config system
-	return nil // Placeholder - needs update for typed system
+	theme := themeFromConfig(DefaultTheme())
+	for _, modify := range modifiers {
+		modify(theme)
+	}
+	theme.Rebuild()
+	return theme
 }
@@ -2,6 +2,8 @@ package tailwind
 
 import (
 	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
 )
 
 func TestTypedConfig(t *testing.T) {
@@ -69,4 +71,106 @@ func TestValueTypes(t *testing.T) {
 	if length.Name != "test-length" {
 		t.Errorf("Expected length name to be 'test-length', got %s", length.Name)
 	}
-}
\ No newline at end of file
+}
+
+func TestGenerateUtilityRules(t *testing.T) {
+	rules := generatedRules(t, NewDefaultUtilityGenerator().GenerateUtilities().Items, "")
+	tests := []struct {
+		selector string
+		want     string // empty if no rule may have the selector
+	}{
+		// Grid
+		{`.grid-cols-12`, `.grid-cols-12{grid-template-columns:repeat(12, minmax(0, 1fr))}`},
+		{`.grid-rows-none`, `.grid-rows-none{grid-template-rows:none}`},
+		{`.col-auto`, `.col-auto{grid-column:auto}`},
+		{`.col-span-full`, `.col-span-full{grid-column:1 / -1}`},
+		{`.col-end-13`, `.col-end-13{grid-column-end:13}`},
+		{`.row-span-6`, `.row-span-6{grid-row:span 6 / span 6}`},
+		{`.row-start-1`, `.row-start-1{grid-row-start:1}`},
+		{`.grid-flow-row-dense`, `.grid-flow-row-dense{grid-auto-flow:row dense}`},
+		{`.auto-cols-min`, `.auto-cols-min{grid-auto-columns:min-content}`},
+		{`.place-items-center`, `.place-items-center{place-items:center}`},
+		{`.md\:grid-cols-3`, `@media (min-width: 768px){.md\:grid-cols-3{grid-template-columns:repeat(3, minmax(0, 1fr))}}`},
+		{`.md\:col-span-2`, ``},
+		{`.sm\:place-items-center`, ``},
+
+		// Transforms
+		{`@property --tw-skew-x`, `@property --tw-skew-x{syntax:'*';inherits:false;initial-value:skewX(0)}`},
+		{`.-rotate-90`, `.-rotate-90{rotate:-90deg}`},
+		{`.-rotate-0`, ``},
+		{`.translate-y-full`, `.translate-y-full{--tw-translate-y:100%;translate:var(--tw-translate-x) var(--tw-translate-y)}`},
+		{`.scale-y-50`, `.scale-y-50{--tw-scale-y:0.5;scale:var(--tw-scale-x) var(--tw-scale-y)}`},
+		{`.-skew-x-12`, `.-skew-x-12{--tw-skew-x:skewX(-12deg);transform:var(--tw-skew-x) var(--tw-skew-y)}`},
+		{`.origin-top-right`, `.origin-top-right{transform-origin:top right}`},
+
+		// Transitions and animations
+		{`.transition`, `.transition{transition-property:color, background-color, border-color, text-decoration-color, fill, stroke, opacity, box-shadow, transform, translate, scale, rotate, filter, backdrop-filter;transition-timing-function:cubic-bezier(0.4, 0, 0.2, 1);transition-duration:150ms}`},
+		{`.transition-opacity`, `.transition-opacity{transition-property:opacity;transition-timing-function:cubic-bezier(0.4, 0, 0.2, 1);transition-duration:150ms}`},
+		{`.transition-none`, `.transition-none{transition-property:none}`},
+		{`.duration-0`, `.duration-0{transition-duration:0s}`},
+		{`.duration`, ``},
+		{`.ease-linear`, `.ease-linear{transition-timing-function:linear}`},
+		{`.ease`, ``},
+		{`.delay-1000`, `.delay-1000{transition-delay:1000ms}`},
+		{`.animate-bounce`, `.animate-bounce{animation:bounce 1s infinite}`},
+		{`@keyframes spin`, `@keyframes spin{to{transform:rotate(360deg)}}`},
+		{`@keyframes pulse`, `@keyframes pulse{50%{opacity:.5}}`},
+
+		// Rings, divide, space and outline
+		{`@property --tw-ring-shadow`, `@property --tw-ring-shadow{syntax:'*';inherits:false;initial-value:0 0 #0000}`},
+		{`.ring`, `.ring{--tw-ring-offset-shadow:var(--tw-ring-inset, ) 0 0 0 var(--tw-ring-offset-width) var(--tw-ring-offset-color);--tw-ring-shadow:var(--tw-ring-inset, ) 0 0 0 calc(3px + var(--tw-ring-offset-width)) var(--tw-ring-color, rgb(59 130 246 / 0.5));box-shadow:var(--tw-ring-offset-shadow), var(--tw-ring-shadow), var(--tw-shadow)}`},
+		{`.ring-inset`, `.ring-inset{--tw-ring-inset:inset}`},
+		{`.ring-offset-4`, `.ring-offset-4{--tw-ring-offset-width:4px}`},
+		{`.ring-offset-black`, `.ring-offset-black{--tw-ring-offset-color:#000000}`},
+		{`.divide-x-reverse > :not([hidden]) ~ :not([hidden])`, `.divide-x-reverse > :not([hidden]) ~ :not([hidden]){--tw-divide-x-reverse:1}`},
+		{`.divide-y-4 > :not([hidden]) ~ :not([hidden])`, `.divide-y-4 > :not([hidden]) ~ :not([hidden]){--tw-divide-y-reverse:0;border-bottom-width:calc(4px * var(--tw-divide-y-reverse));border-top-width:calc(4px * calc(1 - var(--tw-divide-y-reverse)))}`},
+		{`.divide > :not([hidden]) ~ :not([hidden])`, ``},
+		{`.-space-x-0\.5 > :not([hidden]) ~ :not([hidden])`, `.-space-x-0\.5 > :not([hidden]) ~ :not([hidden]){--tw-space-x-reverse:0;margin-right:calc(-0.125rem * var(--tw-space-x-reverse));margin-left:calc(-0.125rem * calc(1 - var(--tw-space-x-reverse)))}`},
+		{`.outline-none`, `.outline-none{outline:2px solid transparent;outline-offset:2px}`},
+		{`.outline-8`, `.outline-8{outline-width:8px}`},
+		{`.-outline-offset-4`, `.-outline-offset-4{outline-offset:-4px}`},
+	}
+	for _, tt := range tests {
+		if got := rules[tt.selector]; got != tt.want {
+			t.Errorf("rule for %s =\n%s\nwant\n%s", tt.selector, got, tt.want)
+		}
+	}
+}
+
+// generatedRules indexes the rules of generated items by selector, and
+// other at-rules by name and parameters, such as "@keyframes spin". Rules
+// in a media query are indexed with it, as in @media (...){.md\:flex{...}}.
+// Every selector must appear once.
+func generatedRules(t *testing.T, items []css.Item, media string) map[string]string {
+	t.Helper()
+	rules := make(map[string]string)
+	add := func(key, rule string) {
+		if _, ok := rules[key]; ok {
+			t.Errorf("output has several rules for %s", key)
+		}
+		rules[key] = rule
+	}
+	for _, item := range items {
+		switch item := item.(type) {
+		case css.Rule:
+			rule := item.String()
+			if media != "" {
+				rule = "@media " + media + "{" + rule + "}"
+			}
+			add(item.Selector, rule)
+		case css.AtRule:
+			if item.Name != "media" {
+				add("@"+item.Name+" "+item.Params, item.String())
+				continue
+			}
+			for key, rule := range generatedRules(t, item.Body, item.Params) {
+				add(key, rule)
+			}
+		case css.Stylesheet:
+			for key, rule := range generatedRules(t, item.Items, media) {
+				add(key, rule)
+			}
+		}
+	}
+	return rules
+}
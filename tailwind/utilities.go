@@ -4,6 +4,7 @@
 package tailwind
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
@@ -14,12 +15,39 @@ import (
 
 // UtilityGenerator generates CSS utility classes from the typed configuration.
 type UtilityGenerator struct {
-	config Config
+	config  Config
+	theme   ThemeConfig // the theme utilities are generated from
+	plugins *PluginAPI
+	err     error // from resolving the configuration or registering its plugins
 }
 
-// NewUtilityGenerator creates a new utility generator with the given configuration.
+// NewUtilityGenerator creates a new utility generator with the given configuration,
+// after resolving its presets. If the configuration does not resolve, the
+// generator uses it as is and Err reports why, as it does for plugins
+// registering invalid additions.
 func NewUtilityGenerator(config Config) *UtilityGenerator {
-	return &UtilityGenerator{config: config}
+	resolved, err := ResolveConfig(config)
+	if err == nil {
+		config = resolved
+	}
+	theme := utilityTheme(config)
+	plugins := registerPlugins(config.Plugins, theme)
+	if err == nil {
+		err = plugins.err()
+	}
+	return &UtilityGenerator{config: config, theme: theme, plugins: plugins, err: err}
+}
+
+// Err returns the error resolving the configuration or registering its
+// plugins, if any. It also reports the variants plugins add, which
+// GenerateUtilities does not generate: only the compiler, and so
+// GenerateContent, applies them.
+func (g *UtilityGenerator) Err() error {
+	errs := []error{g.err}
+	for _, v := range g.plugins.variants {
+		errs = append(errs, fmt.Errorf("tailwind: plugin variant %q: GenerateUtilities does not generate variants; use a Compiler or GenerateContent", v.name))
+	}
+	return errors.Join(errs...)
 }
 
 // NewDefaultUtilityGenerator creates a utility generator with the default configuration.
@@ -27,10 +55,17 @@ func NewDefaultUtilityGenerator() *UtilityGenerator {
 	return NewUtilityGenerator(DefaultConfig())
 }
 
-// GenerateUtilities generates all utility classes for a given category.
+// GenerateUtilities generates all utility classes for a given category,
+// after the theme variables of Config.CSSVariables and the base styles and
+// components of plugins, and followed by the plugin utilities.
 func (g *UtilityGenerator) GenerateUtilities() css.Stylesheet {
 	var stylesheet css.Stylesheet
 
+	// Theme variables, plugin base styles and components
+	stylesheet.Add(configVariables(g.config)...)
+	stylesheet.Add(g.plugins.base...)
+	stylesheet.Add(g.plugins.components()...)
+
 	// Generate color utilities
 	g.generateColorUtilities(&stylesheet)
 
@@ -43,12 +78,27 @@ func (g *UtilityGenerator) GenerateUtilities() css.Stylesheet {
 	// Generate layout utilities
 	g.generateLayoutUtilities(&stylesheet)
 
+	// Generate grid utilities
+	g.generateGridUtilities(&stylesheet)
+
+	// Generate transform utilities
+	g.generateTransformUtilities(&stylesheet)
+
 	// Generate effect utilities
 	g.generateEffectUtilities(&stylesheet)
 
+	// Generate divide utilities
+	g.generateDivideUtilities(&stylesheet)
+
+	// Generate transition and animation utilities
+	g.generateTransitionUtilities(&stylesheet)
+
 	// Generate responsive utilities
 	g.generateResponsiveUtilities(&stylesheet)
 
+	// Plugin utilities
+	stylesheet.Add(g.plugins.utilities()...)
+
 	return stylesheet
 }
 
@@ -69,7 +119,7 @@ func (g *UtilityGenerator) generateColorUtilities(stylesheet *css.Stylesheet) {
 }
 
 func (g *UtilityGenerator) generateBackgroundColorUtilities(stylesheet *css.Stylesheet) {
-	colors := g.config.Theme.Colors
+	colors := g.theme.Colors
 	v := reflect.ValueOf(colors)
 	t := reflect.TypeOf(colors)
 
@@ -80,11 +130,6 @@ func (g *UtilityGenerator) generateBackgroundColorUtilities(stylesheet *css.Styl
 		if colorValue, ok := field.Interface().(ColorValue); ok {
 			className := fmt.Sprintf("bg-%s", kebabCase(fieldType.Name))
 			rule := css.RuleSet(
-				"."+className,
-				cssgen.SetBackgroundColor(cssgen.BackgroundColorValColorBase),
-			)
-			// Override with actual color value
-			rule = css.RuleSet(
 				"."+className,
 				css.Set(cssgen.BackgroundColor, colorValue.ToCSSValue()),
 			)
@@ -94,7 +139,7 @@ func (g *UtilityGenerator) generateBackgroundColorUtilities(stylesheet *css.Styl
 }
 
 func (g *UtilityGenerator) generateTextColorUtilities(stylesheet *css.Stylesheet) {
-	colors := g.config.Theme.Colors
+	colors := g.theme.Colors
 	v := reflect.ValueOf(colors)
 	t := reflect.TypeOf(colors)
 
@@ -114,7 +159,7 @@ func (g *UtilityGenerator) generateTextColorUtilities(stylesheet *css.Stylesheet
 }
 
 func (g *UtilityGenerator) generateBorderColorUtilities(stylesheet *css.Stylesheet) {
-	colors := g.config.Theme.Colors
+	colors := g.theme.Colors
 	v := reflect.ValueOf(colors)
 	t := reflect.TypeOf(colors)
 
@@ -134,7 +179,7 @@ func (g *UtilityGenerator) generateBorderColorUtilities(stylesheet *css.Styleshe
 }
 
 func (g *UtilityGenerator) generateRingColorUtilities(stylesheet *css.Stylesheet) {
-	colors := g.config.Theme.Colors
+	colors := g.theme.Colors
 	v := reflect.ValueOf(colors)
 	t := reflect.TypeOf(colors)
 
@@ -164,10 +209,13 @@ func (g *UtilityGenerator) generateSpacingUtilities(stylesheet *css.Stylesheet)
 
 	// Gap utilities
 	g.generateGapUtilities(stylesheet)
+
+	// Space between utilities
+	g.generateSpaceUtilities(stylesheet)
 }
 
 func (g *UtilityGenerator) generatePaddingUtilities(stylesheet *css.Stylesheet) {
-	spacing := g.config.Theme.Spacing
+	spacing := g.theme.Spacing
 	v := reflect.ValueOf(spacing)
 	t := reflect.TypeOf(spacing)
 
@@ -220,7 +268,7 @@ func (g *UtilityGenerator) generatePaddingUtilities(stylesheet *css.Stylesheet)
 }
 
 func (g *UtilityGenerator) generateMarginUtilities(stylesheet *css.Stylesheet) {
-	spacing := g.config.Theme.Spacing
+	spacing := g.theme.Spacing
 	v := reflect.ValueOf(spacing)
 	t := reflect.TypeOf(spacing)
 
@@ -273,7 +321,7 @@ func (g *UtilityGenerator) generateMarginUtilities(stylesheet *css.Stylesheet) {
 }
 
 func (g *UtilityGenerator) generateGapUtilities(stylesheet *css.Stylesheet) {
-	spacing := g.config.Theme.Spacing
+	spacing := g.theme.Spacing
 	v := reflect.ValueOf(spacing)
 	t := reflect.TypeOf(spacing)
 
@@ -305,6 +353,29 @@ func (g *UtilityGenerator) generateGapUtilities(stylesheet *css.Stylesheet) {
 	}
 }
 
+func (g *UtilityGenerator) generateSpaceUtilities(stylesheet *css.Stylesheet) {
+	stylesheet.Add(twSpaceXReverse.Register(false, "0"), twSpaceYReverse.Register(false, "0"))
+
+	space := concat(themeScale(g.theme.Spacing), themeScale(g.theme.Space))
+	for _, axis := range []struct {
+		prefix string
+		decls  func(v css.Value) []css.Decl
+	}{
+		{"space-x", reverseDecls(twSpaceXReverse, cssgen.MarginLeft, cssgen.MarginRight)},
+		{"space-y", reverseDecls(twSpaceYReverse, cssgen.MarginTop, cssgen.MarginBottom)},
+	} {
+		for _, entry := range space {
+			class := ClassName(axis.prefix, entry.key)
+			stylesheet.Add(css.RuleSet("."+escapeClass(class)+betweenChildren, axis.decls(entry.value)...))
+			if negative := negate(entry.value); negative.String() != entry.value.String() {
+				stylesheet.Add(css.RuleSet("."+escapeClass("-"+class)+betweenChildren, axis.decls(negative)...))
+			}
+		}
+	}
+	stylesheet.Add(css.RuleSet(".space-x-reverse"+betweenChildren, twSpaceXReverse.Set("1")))
+	stylesheet.Add(css.RuleSet(".space-y-reverse"+betweenChildren, twSpaceYReverse.Set("1")))
+}
+
 // Typography utility generation
 
 func (g *UtilityGenerator) generateTypographyUtilities(stylesheet *css.Stylesheet) {
@@ -319,7 +390,7 @@ func (g *UtilityGenerator) generateTypographyUtilities(stylesheet *css.Styleshee
 }
 
 func (g *UtilityGenerator) generateFontSizeUtilities(stylesheet *css.Stylesheet) {
-	fontSizes := g.config.Theme.FontSize
+	fontSizes := g.theme.FontSize
 	v := reflect.ValueOf(fontSizes)
 	t := reflect.TypeOf(fontSizes)
 
@@ -345,7 +416,7 @@ func (g *UtilityGenerator) generateFontSizeUtilities(stylesheet *css.Stylesheet)
 }
 
 func (g *UtilityGenerator) generateFontWeightUtilities(stylesheet *css.Stylesheet) {
-	fontWeights := g.config.Theme.FontWeight
+	fontWeights := g.theme.FontWeight
 	v := reflect.ValueOf(fontWeights)
 	t := reflect.TypeOf(fontWeights)
 
@@ -367,7 +438,7 @@ func (g *UtilityGenerator) generateFontWeightUtilities(stylesheet *css.Styleshee
 }
 
 func (g *UtilityGenerator) generateFontFamilyUtilities(stylesheet *css.Stylesheet) {
-	fontFamilies := g.config.Theme.FontFamily
+	fontFamilies := g.theme.FontFamily
 
 	// Font sans
 	rule := css.RuleSet(
@@ -437,6 +508,81 @@ func (g *UtilityGenerator) generateLayoutUtilities(stylesheet *css.Stylesheet) {
 	stylesheet.Add(css.RuleSet(".items-stretch", cssgen.SetAlignItems(cssgen.AlignItemsValStretch)))
 }
 
+func (g *UtilityGenerator) generateGridUtilities(stylesheet *css.Stylesheet) {
+	scales := []struct {
+		prefix   string
+		property css.Property
+		config   any
+	}{
+		{"grid-cols", cssgen.GridTemplateColumns, g.theme.GridTemplateColumns},
+		{"grid-rows", cssgen.GridTemplateRows, g.theme.GridTemplateRows},
+		{"col", cssgen.GridColumn, g.theme.GridColumn},
+		{"col-start", cssgen.GridColumnStart, g.theme.GridColumnStart},
+		{"col-end", cssgen.GridColumnEnd, g.theme.GridColumnEnd},
+		{"row", cssgen.GridRow, g.theme.GridRow},
+		{"row-start", cssgen.GridRowStart, g.theme.GridRowStart},
+		{"row-end", cssgen.GridRowEnd, g.theme.GridRowEnd},
+		{"auto-cols", cssgen.GridAutoColumns, g.theme.GridAutoColumns},
+		{"auto-rows", cssgen.GridAutoRows, g.theme.GridAutoRows},
+	}
+	for _, s := range scales {
+		for _, entry := range themeScale(s.config) {
+			stylesheet.Add(css.RuleSet("."+ClassName(s.prefix, entry.key), css.Set(s.property, entry.value)))
+		}
+	}
+
+	for _, flow := range gridFlows {
+		stylesheet.Add(css.RuleSet(".grid-flow-"+flow.name, css.Set(cssgen.GridAutoFlow, css.Keyword(flow.value))))
+	}
+	for _, place := range placeContent {
+		stylesheet.Add(css.RuleSet(".place-content-"+place.name, css.Set(cssgen.PlaceContent, css.Keyword(place.value))))
+	}
+	for _, place := range placeItems {
+		stylesheet.Add(css.RuleSet(".place-items-"+place, css.Set(cssgen.PlaceItems, css.Keyword(place))))
+	}
+	for _, place := range placeSelf {
+		stylesheet.Add(css.RuleSet(".place-self-"+place, css.Set(cssgen.PlaceSelf, css.Keyword(place))))
+	}
+}
+
+// Transform utility generation
+
+func (g *UtilityGenerator) generateTransformUtilities(stylesheet *css.Stylesheet) {
+	// Custom properties of the composed transforms
+	stylesheet.Add(translateProperties...)
+	stylesheet.Add(scaleProperties...)
+	stylesheet.Add(skewProperties...)
+
+	translate := concat(themeScale(g.theme.Spacing), themeScale(g.theme.Translate))
+	scales := []struct {
+		prefix   string
+		values   []scaleEntry
+		negative bool
+		decls    func(v css.Value) []css.Decl
+	}{
+		{"origin", themeScale(g.theme.TransformOrigin), false, setAll(cssgen.TransformOrigin)},
+		{"translate-x", translate, true, transformDecls(cssgen.Translate, translateXY, "%s", twTranslateX)},
+		{"translate-y", translate, true, transformDecls(cssgen.Translate, translateXY, "%s", twTranslateY)},
+		{"rotate", themeScale(g.theme.Rotate), true, setAll(cssgen.Rotate)},
+		{"skew-x", themeScale(g.theme.Skew), true, transformDecls(cssgen.Transform, skewXY, "skewX(%s)", twSkewX)},
+		{"skew-y", themeScale(g.theme.Skew), true, transformDecls(cssgen.Transform, skewXY, "skewY(%s)", twSkewY)},
+		{"scale", themeScale(g.theme.Scale), true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleX, twScaleY)},
+		{"scale-x", themeScale(g.theme.Scale), true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleX)},
+		{"scale-y", themeScale(g.theme.Scale), true, transformDecls(cssgen.Scale, scaleXY, "%s", twScaleY)},
+	}
+	for _, s := range scales {
+		for _, entry := range s.values {
+			class := ClassName(s.prefix, entry.key)
+			stylesheet.Add(css.RuleSet("."+escapeClass(class), s.decls(entry.value)...))
+			if negative := negate(entry.value); s.negative && negative.String() != entry.value.String() {
+				stylesheet.Add(css.RuleSet("."+escapeClass("-"+class), s.decls(negative)...))
+			}
+		}
+	}
+
+	stylesheet.Add(css.RuleSet(".transform-none", transformNone...))
+}
+
 // Effect utility generation
 
 func (g *UtilityGenerator) generateEffectUtilities(stylesheet *css.Stylesheet) {
@@ -448,10 +594,16 @@ func (g *UtilityGenerator) generateEffectUtilities(stylesheet *css.Stylesheet) {
 
 	// Brightness utilities
 	g.generateBrightnessUtilities(stylesheet)
+
+	// Outline utilities
+	g.generateOutlineUtilities(stylesheet)
+
+	// Ring utilities
+	g.generateRingUtilities(stylesheet)
 }
 
 func (g *UtilityGenerator) generateOpacityUtilities(stylesheet *css.Stylesheet) {
-	opacity := g.config.Theme.Opacity
+	opacity := g.theme.Opacity
 	v := reflect.ValueOf(opacity)
 	t := reflect.TypeOf(opacity)
 
@@ -473,7 +625,7 @@ func (g *UtilityGenerator) generateOpacityUtilities(stylesheet *css.Stylesheet)
 }
 
 func (g *UtilityGenerator) generateBlurUtilities(stylesheet *css.Stylesheet) {
-	blur := g.config.Theme.Blur
+	blur := g.theme.Blur
 	v := reflect.ValueOf(blur)
 	t := reflect.TypeOf(blur)
 
@@ -503,7 +655,7 @@ func (g *UtilityGenerator) generateBlurUtilities(stylesheet *css.Stylesheet) {
 }
 
 func (g *UtilityGenerator) generateBrightnessUtilities(stylesheet *css.Stylesheet) {
-	brightness := g.config.Theme.Brightness
+	brightness := g.theme.Brightness
 	v := reflect.ValueOf(brightness)
 	t := reflect.TypeOf(brightness)
 
@@ -524,34 +676,128 @@ func (g *UtilityGenerator) generateBrightnessUtilities(stylesheet *css.Styleshee
 	}
 }
 
-// Responsive utility generation
+func (g *UtilityGenerator) generateOutlineUtilities(stylesheet *css.Stylesheet) {
+	stylesheet.Add(css.RuleSet(".outline-none", outlineNone...))
+	for _, style := range []string{"solid", "dashed", "dotted", "double"} {
+		class := "outline-" + style
+		if style == "solid" {
+			class = "outline"
+		}
+		stylesheet.Add(css.RuleSet("."+class, css.Set(cssgen.OutlineStyle, css.Keyword(style))))
+	}
+	for _, entry := range themeScale(g.theme.OutlineWidth) {
+		stylesheet.Add(css.RuleSet("."+ClassName("outline", entry.key), css.Set(cssgen.OutlineWidth, entry.value)))
+	}
+	for _, entry := range themeScale(g.theme.OutlineOffset) {
+		class := ClassName("outline-offset", entry.key)
+		stylesheet.Add(css.RuleSet("."+class, css.Set(cssgen.OutlineOffset, entry.value)))
+		if negative := negate(entry.value); negative.String() != entry.value.String() {
+			stylesheet.Add(css.RuleSet(".-"+class, css.Set(cssgen.OutlineOffset, negative)))
+		}
+	}
+}
 
-func (g *UtilityGenerator) generateResponsiveUtilities(stylesheet *css.Stylesheet) {
-	screens := g.config.Theme.Screens
-	v := reflect.ValueOf(screens)
-	t := reflect.TypeOf(screens)
+func (g *UtilityGenerator) generateRingUtilities(stylesheet *css.Stylesheet) {
+	// Custom properties of the composed ring shadows
+	stylesheet.Add(ringProperties...)
 
-	// For each breakpoint, we would generate responsive variants
-	// This is a simplified example - full implementation would be more complex
-	for i := 0; i < v.NumField(); i++ {
-		field := v.Field(i)
-		fieldType := t.Field(i)
+	color, _ := splitDefault(themeScale(g.theme.RingColor), ringColorFallback)
+	decls := ringDecls(color)
+	for _, entry := range themeScale(g.theme.RingWidth) {
+		stylesheet.Add(css.RuleSet("."+ClassName("ring", entry.key), decls(entry.value)...))
+	}
+	stylesheet.Add(css.RuleSet(".ring-inset", twRingInset.Set("inset")))
+	for _, entry := range themeScale(g.theme.RingOffsetWidth) {
+		stylesheet.Add(css.RuleSet("."+ClassName("ring-offset", entry.key), twRingOffsetWidth.Set(css.Raw(entry.value.String()))))
+	}
+	for _, entry := range concat(themeScale(g.theme.Colors), themeScale(g.theme.RingOffsetColor)) {
+		stylesheet.Add(css.RuleSet("."+ClassName("ring-offset", entry.key), twRingOffsetColor.Set(css.Raw(entry.value.String()))))
+	}
+}
 
-		if lengthValue, ok := field.Interface().(LengthValue); ok {
-			screenName := getScreenName(fieldType.Name)
-
-			// Example: @media (min-width: 640px) { .sm\:block { display: block; } }
-			mediaRule := css.AtRule{
-				Name:   "media",
-				Params: fmt.Sprintf("(min-width: %s)", lengthValue.ToCSSValue().String()),
-				Body: []css.Item{
-					css.RuleSet(fmt.Sprintf(".%s\\:block", screenName), cssgen.SetDisplay(cssgen.DisplayValBlock)),
-					css.RuleSet(fmt.Sprintf(".%s\\:flex", screenName), cssgen.SetDisplay(cssgen.DisplayValFlex)),
-					css.RuleSet(fmt.Sprintf(".%s\\:hidden", screenName), cssgen.SetDisplay(cssgen.DisplayValNone)),
-				},
+// Divide utility generation
+
+func (g *UtilityGenerator) generateDivideUtilities(stylesheet *css.Stylesheet) {
+	stylesheet.Add(twDivideXReverse.Register(false, "0"), twDivideYReverse.Register(false, "0"))
+
+	widths := themeScale(g.theme.DivideWidth)
+	for _, axis := range []struct {
+		prefix string
+		decls  func(v css.Value) []css.Decl
+	}{
+		{"divide-x", reverseDecls(twDivideXReverse, cssgen.BorderLeftWidth, cssgen.BorderRightWidth)},
+		{"divide-y", reverseDecls(twDivideYReverse, cssgen.BorderTopWidth, cssgen.BorderBottomWidth)},
+	} {
+		for _, entry := range widths {
+			stylesheet.Add(css.RuleSet("."+ClassName(axis.prefix, entry.key)+betweenChildren, axis.decls(entry.value)...))
+		}
+	}
+	stylesheet.Add(css.RuleSet(".divide-x-reverse"+betweenChildren, twDivideXReverse.Set("1")))
+	stylesheet.Add(css.RuleSet(".divide-y-reverse"+betweenChildren, twDivideYReverse.Set("1")))
+	for _, style := range []string{"solid", "dashed", "dotted", "double", "none"} {
+		stylesheet.Add(css.RuleSet(".divide-"+style+betweenChildren, css.Set(cssgen.BorderStyle, css.Keyword(style))))
+	}
+	_, colors := splitDefault(concat(themeScale(g.theme.Colors), themeScale(g.theme.BorderColor), themeScale(g.theme.DivideColor)), nil)
+	for _, entry := range colors {
+		stylesheet.Add(css.RuleSet("."+ClassName("divide", entry.key)+betweenChildren, css.Set(cssgen.BorderColor, entry.value)))
+	}
+}
+
+// Transition and animation utility generation
+
+func (g *UtilityGenerator) generateTransitionUtilities(stylesheet *css.Stylesheet) {
+	// Keyframes of the animations
+	for _, k := range themeKeyframes(g.config.Theme.Keyframes) {
+		stylesheet.Add(k.rule)
+	}
+
+	timing, easings := splitDefault(themeScale(g.theme.TransitionTimingFunction), transitionTiming)
+	duration, durations := splitDefault(themeScale(g.theme.TransitionDuration), transitionDuration)
+	scales := []struct {
+		prefix string
+		values []scaleEntry
+		decls  func(v css.Value) []css.Decl
+	}{
+		{"transition", themeScale(g.theme.TransitionProperty), transitionDecls(timing, duration)},
+		{"duration", durations, setAll(cssgen.TransitionDuration)},
+		{"ease", easings, setAll(cssgen.TransitionTimingFunction)},
+		{"delay", themeScale(g.theme.TransitionDelay), setAll(cssgen.TransitionDelay)},
+		{"animate", themeScale(g.theme.Animation), setAll(cssgen.Animation)},
+	}
+	for _, s := range scales {
+		for _, entry := range s.values {
+			class := ClassName(s.prefix, entry.key)
+			if class == "transition-none" {
+				stylesheet.Add(css.RuleSet(".transition-none", css.Set(cssgen.TransitionProperty, css.Keyword("none"))))
+				continue
 			}
-			stylesheet.Add(mediaRule)
+			stylesheet.Add(css.RuleSet("."+class, s.decls(entry.value)...))
+		}
+	}
+}
+
+// Responsive utility generation
+
+func (g *UtilityGenerator) generateResponsiveUtilities(stylesheet *css.Stylesheet) {
+	// Responsive forms of the layout utilities, one media query per
+	// breakpoint, e.g. @media (min-width: 640px) { .sm\:block { display: block; } }
+	var layout css.Stylesheet
+	g.generateLayoutUtilities(&layout)
+
+	// Of the grid utilities only the column counts, as in md:grid-cols-3:
+	// the whole grid family would be copied into every breakpoint.
+	for _, entry := range themeScale(g.theme.GridTemplateColumns) {
+		layout.Add(css.RuleSet("."+ClassName("grid-cols", entry.key), css.Set(cssgen.GridTemplateColumns, entry.value)))
+	}
+
+	variants := NewVariants(g.config)
+	for _, screen := range variants.Screens() {
+		def, _ := variants.Lookup(screen)
+		body := make([]css.Item, len(layout.Items))
+		for i, item := range layout.Items {
+			body[i] = def.rewrite(item, screen)
 		}
+		stylesheet.Add(css.AtRule{Name: def.AtRule, Params: def.Params, Body: body})
 	}
 }
 
@@ -636,5 +882,5 @@ func getScreenName(fieldName string) string {
 	if strings.HasPrefix(fieldName, "Size") {
 		return strings.ToLower(fieldName[4:]) // Remove "Size" and convert to lowercase
 	}
-	return kebabCase(fieldName)
+	return strings.ToLower(kebabCase(fieldName))
 }
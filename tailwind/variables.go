@@ -0,0 +1,161 @@
+// This file implements the export of the theme as CSS custom properties,
+// which lets utilities be restyled at runtime, per tenant or color scheme,
+// without regenerating the CSS.
+
+package tailwind
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// AlternateTheme is a set of theme values for the elements matching a
+// selector, such as ".dark" or "[data-theme=acme]". It only needs the
+// values that differ from the theme.
+type AlternateTheme struct {
+	Selector string
+	Theme    ThemeConfig
+}
+
+// variablePrefixes names the custom properties of the theme categories
+// whose prefix, following Tailwind, is not the kebab-cased category name.
+var variablePrefixes = map[string]string{
+	"Colors":       "color",
+	"BorderRadius": "radius",
+	"FontFamily":   "font",
+	"FontSize":     "text",
+	"AspectRatio":  "aspect",
+	"Animation":    "animate",
+	"Screens":      "breakpoint",
+}
+
+// variableName returns the custom property of a theme value: the category
+// prefix and the key, with dots and slashes, which would need escaping, as
+// underscores, as in --translate-1_2. The DEFAULT value has the bare prefix.
+func variableName(category, key string) string {
+	prefix, ok := variablePrefixes[category]
+	if !ok {
+		prefix = strings.ToLower(kebabCase(category))
+	}
+	if key == "" {
+		return "--" + prefix
+	}
+	return "--" + prefix + "-" + variableKey.Replace(key)
+}
+
+// variableKey replaces the characters of theme keys that are not valid in
+// custom property names.
+var variableKey = strings.NewReplacer(".", "_", "/", "_")
+
+// ThemeVariables returns the values of a theme as custom properties on a
+// selector, as in :root{--color-blue-500:#3b82f6;--spacing-4:1rem}. Font
+// sizes add their line height as --text-lg--line-height. Unset values are
+// skipped, so a partial theme yields only its own values.
+func ThemeVariables(selector string, theme ThemeConfig) css.Rule {
+	var decls []css.Decl
+	v := reflect.ValueOf(theme)
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !isThemeCategory(field) {
+			continue
+		}
+		for _, entry := range themeScale(v.Field(i).Interface()) {
+			name := variableName(field.Name, entry.key)
+			if size, ok := entry.value.(fontSize); ok {
+				decls = append(decls, css.Set(css.Property(name), size.size))
+				if size.lineHeight != nil {
+					decls = append(decls, css.Set(css.Property(name+"--line-height"), size.lineHeight))
+				}
+				continue
+			}
+			decls = append(decls, css.Set(css.Property(name), entry.value))
+		}
+	}
+	return css.RuleSet(selector, decls...)
+}
+
+// configVariables returns the custom property rules of a configuration with
+// CSSVariables set: the theme on :root and the alternate themes on their
+// selectors.
+func configVariables(config Config) []css.Item {
+	if !config.CSSVariables {
+		return nil
+	}
+	items := []css.Item{ThemeVariables(":root", config.Theme)}
+	for _, alt := range config.AlternateThemes {
+		items = append(items, ThemeVariables(alt.Selector, alt.Theme))
+	}
+	return items
+}
+
+// utilityTheme returns the theme utilities are generated from: the theme
+// itself or, with CSSVariables set, the theme with each value replaced by a
+// reference to its custom property. Screens keep their values, as media
+// queries cannot use custom properties.
+func utilityTheme(config Config) ThemeConfig {
+	theme := config.Theme
+	if !config.CSSVariables {
+		return theme
+	}
+	v := reflect.ValueOf(&theme).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		if !isThemeCategory(field) || field.Name == "Screens" {
+			continue
+		}
+		category := v.Field(i)
+		for j := 0; j < category.NumField(); j++ {
+			if f := category.Field(j); f.CanSet() && !f.IsZero() {
+				referenceVariable(f, field.Name, category.Type().Field(j).Name)
+			}
+		}
+	}
+	return theme
+}
+
+// referenceVariable replaces the theme value in f with a reference to its
+// custom property, keyed the way themeScale keys it.
+func referenceVariable(f reflect.Value, category, fieldName string) {
+	switch value := f.Interface().(type) {
+	case FontSizeValue:
+		if value.Size == nil {
+			return
+		}
+		name := variableName(category, scaleKey(value.Size.String()))
+		value.Size = variableValue{value.Size.String(), name}
+		if value.LineHeight != nil {
+			value.LineHeight = variableValue{value.LineHeight.String(), name + "--line-height"}
+		}
+		f.Set(reflect.ValueOf(value))
+	case KeywordValue:
+		value.Keyword = css.Keyword("var(" + variableName(category, scaleKey(value.Name)) + ")")
+		f.Set(reflect.ValueOf(value))
+	case interface {
+		ToCSSValue() css.Value
+		String() string
+	}:
+		if f.Kind() == reflect.Interface {
+			f.Set(reflect.ValueOf(variableValue{value.String(), variableName(category, scaleKey(value.String()))}))
+		}
+	case string:
+		if value != "" {
+			f.SetString("var(" + variableName(category, strings.ToLower(fieldName)) + ")")
+		}
+	case []string:
+		if len(value) > 0 {
+			f.Set(reflect.ValueOf([]string{"var(" + variableName(category, strings.ToLower(fieldName)) + ")"}))
+		}
+	}
+}
+
+// variableValue is a theme value that references its custom property. It
+// fits every value interface.
+type variableValue struct {
+	name     string
+	variable string
+}
+
+func (v variableValue) ToCSSValue() css.Value { return css.Raw("var(" + v.variable + ")") }
+func (v variableValue) String() string        { return v.name }
@@ -0,0 +1,90 @@
+package tailwind
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestThemeVariables(t *testing.T) {
+	theme := ThemeConfig{
+		Colors:   ColorsConfig{Blue500: ColorFromHex("blue-500", "#3b82f6")},
+		Spacing:  SpacingConfig{Size0_5: LengthFromRem("0.5", 0.125)},
+		FontSize: FontSizeConfig{Lg: FontSizeValue{Size: LengthFromRem("lg", 1.125), LineHeight: LengthFromRem("lg", 1.75)}},
+	}
+	want := `[data-theme=acme]{--color-blue-500:#3b82f6;--spacing-0_5:0.125rem;--text-lg:1.125rem;--text-lg--line-height:1.75rem}`
+	if got := ThemeVariables("[data-theme=acme]", theme).String(); got != want {
+		t.Errorf("ThemeVariables =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestCompileCSSVariables(t *testing.T) {
+	config := DefaultConfig()
+	config.CSSVariables = true
+	config.AlternateThemes = []AlternateTheme{{
+		Selector: ".dark",
+		Theme:    ThemeConfig{Colors: ColorsConfig{Blue500: ColorFromHex("blue-500", "#60a5fa")}},
+	}}
+
+	stylesheet, errs := NewCompiler(config).Compile("bg-blue-500/50 p-0.5 md:p-4 font-bold")
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	var got []string
+	for _, item := range stylesheet.Items {
+		got = append(got, item.String())
+	}
+	if len(got) != 6 {
+		t.Fatalf("got %d items, want 6:\n%s", len(got), strings.Join(got, "\n"))
+	}
+	for _, want := range []string{"--color-blue-500:#3b82f6;", "--spacing-4:1rem;", "--font-weight-bold:700;", "--breakpoint-md:768px;"} {
+		if !strings.HasPrefix(got[0], ":root{") || !strings.Contains(got[0], want) {
+			t.Errorf("root variables are missing %s", want)
+		}
+	}
+	want := []string{
+		`.dark{--color-blue-500:#60a5fa}`,
+		`.bg-blue-500\/50{background-color:color-mix(in srgb, var(--color-blue-500) calc(var(--opacity-50) * 100%), transparent)}`,
+		`.p-0\.5{padding:var(--spacing-0_5)}`,
+		`.font-bold{font-weight:var(--font-weight-bold)}`,
+		`@media (min-width: 768px){.md\:p-4{padding:var(--spacing-4)}}`,
+	}
+	if strings.Join(got[1:], "\n") != strings.Join(want, "\n") {
+		t.Errorf("Compile =\n%s\nwant\n%s", strings.Join(got[1:], "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestCompileCSSVariableFractions(t *testing.T) {
+	config := DefaultConfig()
+	config.CSSVariables = true
+
+	stylesheet, errs := NewCompiler(config).Compile("translate-x-1/2 -translate-y-1/3")
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	out := stylesheet.String()
+	for _, want := range []string{
+		"--translate-1_2:50%;",
+		`.translate-x-1\/2{--tw-translate-x:var(--translate-1_2);`,
+		`.-translate-y-1\/3{--tw-translate-y:calc(var(--translate-1_3) * -1);`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output is missing %s", want)
+		}
+	}
+	if strings.Contains(out, "--translate-1/") {
+		t.Error("output has a custom property name with a slash")
+	}
+}
+
+func TestGenerateUtilitiesCSSVariables(t *testing.T) {
+	config := DefaultConfig()
+	config.CSSVariables = true
+
+	out := NewUtilityGenerator(config).GenerateUtilities().String()
+	if !strings.HasPrefix(out, ":root{") {
+		t.Errorf("output does not start with the root variables: %.100s", out)
+	}
+	if !strings.Contains(out, "{background-color:var(--color-blue-500)}") {
+		t.Error("background colors do not reference their variables")
+	}
+}
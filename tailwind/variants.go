@@ -0,0 +1,507 @@
+// This file implements Tailwind-style variants, which rewrite a utility rule
+// into its hover:, md:, dark: and similar forms.
+
+package tailwind
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ahmed-com/typesafe-css/css"
+)
+
+// VariantDef describes how a variant rewrites a utility rule. The class name
+// of the utility is always prefixed with the variant name, so hover applied
+// to .bg-blue-600 selects .hover\:bg-blue-600.
+type VariantDef struct {
+	// Suffix is added to the utility's compound selector: a pseudo-class
+	// such as ":hover", an attribute selector such as "[open]", or a
+	// pseudo-element such as "::before", which is kept last.
+	Suffix string
+
+	// Prefix is prepended to the selector together with its combinator,
+	// e.g. ".group:hover " or ".peer:focus ~ ".
+	Prefix string
+
+	// AtRule and Params wrap the rule in an at-rule, e.g. "media" and
+	// "(min-width: 768px)".
+	AtRule string
+	Params string
+}
+
+// pseudoClassVariants maps variant names to the pseudo-classes and
+// attribute selectors they add. Each also has group- and peer- forms.
+var pseudoClassVariants = map[string]string{
+	// Interaction
+	"hover":         ":hover",
+	"focus":         ":focus",
+	"focus-visible": ":focus-visible",
+	"focus-within":  ":focus-within",
+	"active":        ":active",
+	"visited":       ":visited",
+	"target":        ":target",
+
+	// Structure
+	"first":         ":first-child",
+	"last":          ":last-child",
+	"only":          ":only-child",
+	"odd":           ":nth-child(odd)",
+	"even":          ":nth-child(even)",
+	"first-of-type": ":first-of-type",
+	"last-of-type":  ":last-of-type",
+	"only-of-type":  ":only-of-type",
+	"empty":         ":empty",
+
+	// Forms
+	"disabled":          ":disabled",
+	"enabled":           ":enabled",
+	"checked":           ":checked",
+	"indeterminate":     ":indeterminate",
+	"default":           ":default",
+	"required":          ":required",
+	"optional":          ":optional",
+	"valid":             ":valid",
+	"invalid":           ":invalid",
+	"user-valid":        ":user-valid",
+	"user-invalid":      ":user-invalid",
+	"in-range":          ":in-range",
+	"out-of-range":      ":out-of-range",
+	"placeholder-shown": ":placeholder-shown",
+	"autofill":          ":autofill",
+	"read-only":         ":read-only",
+
+	// Attributes
+	"open": "[open]",
+}
+
+// pseudoElementVariants maps variant names to the pseudo-elements they
+// select.
+var pseudoElementVariants = map[string]string{
+	"before":       "::before",
+	"after":        "::after",
+	"first-letter": "::first-letter",
+	"first-line":   "::first-line",
+	"marker":       "::marker",
+	"selection":    "::selection",
+	"file":         "::file-selector-button",
+	"placeholder":  "::placeholder",
+	"backdrop":     "::backdrop",
+}
+
+// mediaVariants maps variant names to the media queries they apply in.
+var mediaVariants = map[string]string{
+	"motion-safe":   "(prefers-reduced-motion: no-preference)",
+	"motion-reduce": "(prefers-reduced-motion: reduce)",
+	"contrast-more": "(prefers-contrast: more)",
+	"contrast-less": "(prefers-contrast: less)",
+	"portrait":      "(orientation: portrait)",
+	"landscape":     "(orientation: landscape)",
+	"print":         "print",
+}
+
+// Variants applies variants to utility rules, with the breakpoints and dark
+// mode strategy of a configuration.
+type Variants struct {
+	defs    map[string]VariantDef
+	screens []string // breakpoint names, smallest first
+}
+
+// NewVariants creates the variants of a configuration: the pseudo-class,
+// pseudo-element and media variants, a min-width and a max- variant for each
+// breakpoint in Theme.Screens, and dark following DarkMode.Strategy.
+func NewVariants(config Config) *Variants {
+	v := &Variants{defs: make(map[string]VariantDef)}
+
+	for name, pseudo := range pseudoClassVariants {
+		v.defs[name] = VariantDef{Suffix: pseudo}
+		v.defs["group-"+name] = VariantDef{Prefix: ".group" + pseudo + " "}
+		v.defs["peer-"+name] = VariantDef{Prefix: ".peer" + pseudo + " ~ "}
+	}
+	for name, pseudo := range pseudoElementVariants {
+		v.defs[name] = VariantDef{Suffix: pseudo}
+	}
+	for name, query := range mediaVariants {
+		v.defs[name] = VariantDef{AtRule: "media", Params: query}
+	}
+	v.defs["ltr"] = VariantDef{Prefix: `[dir="ltr"] `}
+	v.defs["rtl"] = VariantDef{Prefix: `[dir="rtl"] `}
+
+	for _, screen := range screenValues(config.Theme.Screens) {
+		minWidth := fmt.Sprintf("(min-width: %s)", screen.value)
+		v.defs[screen.name] = VariantDef{AtRule: "media", Params: minWidth}
+		v.defs["max-"+screen.name] = VariantDef{AtRule: "media", Params: "not all and " + minWidth}
+		v.screens = append(v.screens, screen.name)
+	}
+
+	switch config.DarkMode.Strategy {
+	case DarkModeClass:
+		v.defs["dark"] = VariantDef{Prefix: ".dark "}
+	case DarkModeSelector:
+		selector := config.DarkMode.Selector
+		if selector == "" {
+			selector = ".dark"
+		}
+		v.defs["dark"] = VariantDef{Prefix: selector + " "}
+	default:
+		v.defs["dark"] = VariantDef{AtRule: "media", Params: "(prefers-color-scheme: dark)"}
+	}
+	return v
+}
+
+// Lookup returns the definition of a variant. Besides the registered
+// variants it accepts arbitrary ones: a selector with & for the utility,
+// as in [&:nth-child(3)] or [.theme-x_&], an at-rule such as
+// [@supports(display:grid)], and the data-[…], aria-[…], supports-[…],
+// min-[…] and max-[…] forms. Underscores in brackets stand for spaces.
+func (v *Variants) Lookup(name string) (VariantDef, bool) {
+	if def, ok := v.defs[name]; ok {
+		return def, true
+	}
+	if isArbitrary(name) {
+		value := arbitraryValue(name)
+		if rule, ok := strings.CutPrefix(value, "@"); ok {
+			end := strings.IndexFunc(rule, func(r rune) bool { return r != '-' && (r < 'a' || r > 'z') })
+			if end <= 0 {
+				return VariantDef{}, false
+			}
+			return VariantDef{AtRule: rule[:end], Params: strings.TrimSpace(rule[end:])}, true
+		}
+		prefix, suffix, ok := strings.Cut(value, "&")
+		if !ok || strings.Contains(suffix, "&") {
+			return VariantDef{}, false
+		}
+		return VariantDef{Prefix: prefix, Suffix: suffix}, true
+	}
+	kind, arg, ok := strings.Cut(name, "-")
+	if !ok || !isArbitrary(arg) && kind != "aria" {
+		return VariantDef{}, false
+	}
+	value := arg
+	if isArbitrary(arg) {
+		value = arbitraryValue(arg)
+	}
+	switch kind {
+	case "data":
+		return VariantDef{Suffix: "[data-" + value + "]"}, true
+	case "aria":
+		if !isArbitrary(arg) {
+			value += `="true"`
+		}
+		return VariantDef{Suffix: "[aria-" + value + "]"}, true
+	case "supports":
+		if !strings.Contains(value, ":") || strings.HasPrefix(value, "(") {
+			return VariantDef{AtRule: "supports", Params: value}, true
+		}
+		return VariantDef{AtRule: "supports", Params: "(" + value + ")"}, true
+	case "min":
+		return VariantDef{AtRule: "media", Params: "(min-width: " + value + ")"}, true
+	case "max":
+		return VariantDef{AtRule: "media", Params: "not all and (min-width: " + value + ")"}, true
+	}
+	return VariantDef{}, false
+}
+
+// Register adds or replaces a variant.
+func (v *Variants) Register(name string, def VariantDef) {
+	v.defs[name] = def
+}
+
+// Screens returns the breakpoint variant names, smallest first.
+func (v *Variants) Screens() []string {
+	return append([]string(nil), v.screens...)
+}
+
+// Apply applies variants to a utility rule, or to the rules inside an
+// at-rule returned by an earlier Apply. The names are in class-string order:
+// Apply(rule, "md", "hover") produces the md:hover: form, with the media
+// query of md outermost and the :hover of hover on the selector.
+func (v *Variants) Apply(item css.Item, names ...string) (css.Item, error) {
+	for i := len(names) - 1; i >= 0; i-- {
+		def, ok := v.Lookup(names[i])
+		if !ok {
+			return nil, fmt.Errorf("tailwind: unknown variant %q", names[i])
+		}
+		item = def.wrap(def.rewrite(item, names[i]))
+	}
+	return item, nil
+}
+
+// rewrite prefixes the class names of the rules in item with the variant
+// name and adds the variant's selector parts.
+func (d VariantDef) rewrite(item css.Item, name string) css.Item {
+	switch item := item.(type) {
+	case css.Rule:
+		return css.RuleSet(d.rewriteSelector(item.Selector, name), item.Decls...)
+	case css.AtRule:
+		if item.Name == "keyframes" {
+			return item
+		}
+		item.Body = d.rewriteAll(item.Body, name)
+		return item
+	case css.Stylesheet:
+		return css.Stylesheet{Items: d.rewriteAll(item.Items, name)}
+	}
+	return item
+}
+
+func (d VariantDef) rewriteAll(items []css.Item, name string) []css.Item {
+	rewritten := make([]css.Item, len(items))
+	for i, item := range items {
+		rewritten[i] = d.rewrite(item, name)
+	}
+	return rewritten
+}
+
+// wrap wraps an item, or the items of a css.Stylesheet, in the variant's
+// at-rule, if it has one.
+func (d VariantDef) wrap(item css.Item) css.Item {
+	if d.AtRule == "" {
+		return item
+	}
+	return css.AtRule{Name: d.AtRule, Params: d.Params, Body: flatten(item)}
+}
+
+// rewriteSelector applies the variant to each selector of a selector list.
+func (d VariantDef) rewriteSelector(selector, name string) string {
+	parts := splitTopLevel(selector, ',')
+	for i, part := range parts {
+		part = strings.TrimSpace(part)
+
+		// Find the utility's class and the end of its compound selector.
+		class := utilityClass(part)
+		start := max(class, 0)
+		end := indexTopLevel(part, start, func(s string, i int) bool { return strings.IndexByte(" >+~", s[i]) >= 0 })
+		if end < 0 {
+			end = len(part)
+		}
+
+		insert := end
+		if !strings.HasPrefix(d.Suffix, "::") {
+			// Pseudo-classes go before a pseudo-element of the utility.
+			if pe := indexTopLevel(part[:end], start, func(s string, i int) bool { return strings.HasPrefix(s[i:], "::") }); pe >= 0 {
+				insert = pe
+			}
+		}
+		part = part[:insert] + d.Suffix + part[insert:]
+
+		if class >= 0 {
+			part = part[:class+1] + escapeClass(name+":") + part[class+1:]
+		}
+		parts[i] = d.Prefix + part
+	}
+	return strings.Join(parts, ", ")
+}
+
+// utilityClass returns the index of the '.' of the utility's class in a
+// selector, or -1. Prefixes added by variants such as group-hover come
+// first, so once a variant has been applied the utility is the first class
+// with an escaped colon.
+func utilityClass(selector string) int {
+	first := -1
+	for i := 0; ; {
+		i = indexTopLevel(selector, i, func(s string, i int) bool { return s[i] == '.' })
+		if i < 0 {
+			return first
+		}
+		if first < 0 {
+			first = i
+		}
+		end := i + 1
+		for end < len(selector) && (selector[end] == '\\' || isClassChar(selector[end])) {
+			if selector[end] == '\\' {
+				end = skipEscape(selector, end)
+			}
+			end++
+		}
+		if strings.Contains(selector[i:end], `\:`) {
+			return i
+		}
+		i = end
+	}
+}
+
+func isClassChar(ch byte) bool {
+	return ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' ||
+		ch == '-' || ch == '_' || ch >= 0x80
+}
+
+// indexTopLevel returns the index of the first byte of s at or after from
+// that matches, skipping escapes, strings, and bracketed or parenthesized
+// text, or -1.
+func indexTopLevel(s string, from int, match func(s string, i int) bool) int {
+	depth := 0
+	for i := from; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '\\':
+			i = skipEscape(s, i)
+		case ch == '"' || ch == '\'':
+			if j := strings.IndexByte(s[i+1:], ch); j >= 0 {
+				i += j + 1
+			}
+		case ch == '(' || ch == '[':
+			depth++
+		case ch == ')' || ch == ']':
+			depth--
+		case depth == 0 && match(s, i):
+			return i
+		}
+	}
+	return -1
+}
+
+// skipEscape returns the index of the last byte of the escape starting at
+// s[i]: a backslash followed by a character, or by up to six hex digits and
+// an optional space.
+func skipEscape(s string, i int) int {
+	j := i + 1
+	for j < len(s) && j < i+7 && isHexDigit(s[j]) {
+		j++
+	}
+	if j == i+1 {
+		return min(j, len(s)-1)
+	}
+	if j < len(s) && s[j] == ' ' {
+		return j
+	}
+	return j - 1
+}
+
+func isHexDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9' || ch >= 'a' && ch <= 'f' || ch >= 'A' && ch <= 'F'
+}
+
+// splitTopLevel splits s at the separators outside of brackets,
+// parentheses and strings.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	for {
+		i := indexTopLevel(s, 0, func(s string, i int) bool { return s[i] == sep })
+		if i < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, s[:i])
+		s = s[i+1:]
+	}
+}
+
+// escapeClass escapes a class name for use in a selector, e.g.
+// "md:hover:bg-[#fff]" -> `md\:hover\:bg-\[\#fff\]` and "2xl:" -> `\32 xl\:`.
+func escapeClass(name string) string {
+	var b strings.Builder
+	for i := 0; i < len(name); i++ {
+		ch := name[i]
+		switch {
+		case i == 0 && ch >= '0' && ch <= '9':
+			fmt.Fprintf(&b, "\\%x ", ch)
+		case isClassChar(ch):
+			b.WriteByte(ch)
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(ch)
+		}
+	}
+	return b.String()
+}
+
+// screen is a named breakpoint.
+type screen struct {
+	name  string
+	value string
+}
+
+// screenValues returns the breakpoints of a screens configuration,
+// smallest first. Breakpoints keep their field order unless their widths,
+// in px, rem or em, say otherwise, as when a preset widens sm past md.
+func screenValues(screens ScreensConfig) []screen {
+	var values []screen
+	v := reflect.ValueOf(screens)
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		if length, ok := v.Field(i).Interface().(LengthValue); ok && length != nil {
+			values = append(values, screen{getScreenName(t.Field(i).Name), length.ToCSSValue().String()})
+		}
+	}
+	for _, s := range values {
+		if _, ok := screenWidth(s.value); !ok {
+			return values
+		}
+	}
+	sort.SliceStable(values, func(i, j int) bool {
+		a, _ := screenWidth(values[i].value)
+		b, _ := screenWidth(values[j].value)
+		return a < b
+	})
+	return values
+}
+
+// screenWidth returns a breakpoint width in pixels, taking rem and em as
+// 16px.
+func screenWidth(value string) (float64, bool) {
+	scale := 1.0
+	number, ok := strings.CutSuffix(value, "px")
+	if !ok {
+		if number, ok = strings.CutSuffix(value, "rem"); !ok {
+			number, ok = strings.CutSuffix(value, "em")
+		}
+		scale = 16
+	}
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(number, 64)
+	return f * scale, err == nil
+}
+
+// Variant returns a function that applies the named variants, in
+// class-string order, using the default configuration. It panics if a
+// variant is unknown.
+//
+//	stylesheet.Add(tailwind.Variant("md", "hover")(tailwind.BgBlue600()))
+func Variant(names ...string) func(css.Item) css.Item {
+	for _, name := range names {
+		if _, ok := defaultVariants.Lookup(name); !ok {
+			panic(fmt.Sprintf("tailwind: unknown variant %q", name))
+		}
+	}
+	return func(item css.Item) css.Item {
+		item, _ = defaultVariants.Apply(item, names...)
+		return item
+	}
+}
+
+var defaultVariants = NewVariants(DefaultConfig())
+
+// Variant shortcuts using the default configuration.
+
+func Hover(item css.Item) css.Item        { return Variant("hover")(item) }
+func Focus(item css.Item) css.Item        { return Variant("focus")(item) }
+func FocusVisible(item css.Item) css.Item { return Variant("focus-visible")(item) }
+func FocusWithin(item css.Item) css.Item  { return Variant("focus-within")(item) }
+func Active(item css.Item) css.Item       { return Variant("active")(item) }
+func Visited(item css.Item) css.Item      { return Variant("visited")(item) }
+func Disabled(item css.Item) css.Item     { return Variant("disabled")(item) }
+func Checked(item css.Item) css.Item      { return Variant("checked")(item) }
+func First(item css.Item) css.Item        { return Variant("first")(item) }
+func Last(item css.Item) css.Item         { return Variant("last")(item) }
+func Odd(item css.Item) css.Item          { return Variant("odd")(item) }
+func Even(item css.Item) css.Item         { return Variant("even")(item) }
+func GroupHover(item css.Item) css.Item   { return Variant("group-hover")(item) }
+func PeerFocus(item css.Item) css.Item    { return Variant("peer-focus")(item) }
+func Before(item css.Item) css.Item       { return Variant("before")(item) }
+func After(item css.Item) css.Item        { return Variant("after")(item) }
+func Placeholder(item css.Item) css.Item  { return Variant("placeholder")(item) }
+func Dark(item css.Item) css.Item         { return Variant("dark")(item) }
+func MotionSafe(item css.Item) css.Item   { return Variant("motion-safe")(item) }
+func MotionReduce(item css.Item) css.Item { return Variant("motion-reduce")(item) }
+func Print(item css.Item) css.Item        { return Variant("print")(item) }
+
+// Breakpoint shortcuts using the default screens.
+
+func Sm(item css.Item) css.Item  { return Variant("sm")(item) }
+func Md(item css.Item) css.Item  { return Variant("md")(item) }
+func Lg(item css.Item) css.Item  { return Variant("lg")(item) }
+func Xl(item css.Item) css.Item  { return Variant("xl")(item) }
+func X2L(item css.Item) css.Item { return Variant("2xl")(item) }
@@ -0,0 +1,105 @@
+package tailwind
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ahmed-com/typesafe-css/css"
+	"github.com/ahmed-com/typesafe-css/cssgen"
+)
+
+func TestVariants(t *testing.T) {
+	bg := css.RuleSet(".bg-blue-600", css.Set(cssgen.BackgroundColor, css.Hex("#2563eb")))
+	space := css.RuleSet(".space-x-4 > :not([hidden]) ~ :not([hidden])", css.Set(cssgen.MarginLeft, css.Rem(1)))
+	before := css.RuleSet(".content-none::before", css.Set(cssgen.Content, css.Keyword("none")))
+
+	variants := NewVariants(DefaultConfig())
+	tests := []struct {
+		item  css.Item
+		names []string
+		want  string
+	}{
+		{bg, []string{"hover"}, `.hover\:bg-blue-600:hover{background-color:#2563eb}`},
+		{bg, []string{"md", "hover"}, `@media (min-width: 768px){.md\:hover\:bg-blue-600:hover{background-color:#2563eb}}`},
+		{bg, []string{"focus", "hover"}, `.focus\:hover\:bg-blue-600:hover:focus{background-color:#2563eb}`},
+		{bg, []string{"2xl"}, `@media (min-width: 1536px){.\32 xl\:bg-blue-600{background-color:#2563eb}}`},
+		{bg, []string{"max-sm"}, `@media not all and (min-width: 640px){.max-sm\:bg-blue-600{background-color:#2563eb}}`},
+		{bg, []string{"group-hover"}, `.group:hover .group-hover\:bg-blue-600{background-color:#2563eb}`},
+		{bg, []string{"group-hover", "focus"}, `.group:hover .group-hover\:focus\:bg-blue-600:focus{background-color:#2563eb}`},
+		{bg, []string{"focus", "group-hover"}, `.group:hover .focus\:group-hover\:bg-blue-600:focus{background-color:#2563eb}`},
+		{bg, []string{"peer-checked"}, `.peer:checked ~ .peer-checked\:bg-blue-600{background-color:#2563eb}`},
+		{bg, []string{"dark"}, `@media (prefers-color-scheme: dark){.dark\:bg-blue-600{background-color:#2563eb}}`},
+		{bg, []string{"open"}, `.open\:bg-blue-600[open]{background-color:#2563eb}`},
+		{bg, []string{"placeholder"}, `.placeholder\:bg-blue-600::placeholder{background-color:#2563eb}`},
+		{before, []string{"hover"}, `.hover\:content-none:hover::before{content:none}`},
+		{space, []string{"hover"}, `.hover\:space-x-4:hover > :not([hidden]) ~ :not([hidden]){margin-left:1rem}`},
+		{space, []string{"lg", "dark"}, `@media (min-width: 1024px){@media (prefers-color-scheme: dark){.lg\:dark\:space-x-4 > :not([hidden]) ~ :not([hidden]){margin-left:1rem}}}`},
+	}
+	for _, tt := range tests {
+		got, err := variants.Apply(tt.item, tt.names...)
+		if err != nil {
+			t.Errorf("Apply(%v) error: %v", tt.names, err)
+			continue
+		}
+		if s := got.String(); s != tt.want {
+			t.Errorf("Apply(%v) =\n%s\nwant\n%s", tt.names, s, tt.want)
+		}
+	}
+
+	if _, err := variants.Apply(bg, "hover", "wobble"); err == nil || !strings.Contains(err.Error(), `"wobble"`) {
+		t.Errorf("Apply with an unknown variant: err = %v", err)
+	}
+
+	// Applying variants one at a time stacks like a class string.
+	stacked := Md(Hover(bg))
+	direct, _ := variants.Apply(bg, "md", "hover")
+	if stacked.String() != direct.String() {
+		t.Errorf("Md(Hover(rule)) = %s, want %s", stacked, direct)
+	}
+	if got := Variant("md", "hover")(bg).String(); got != direct.String() {
+		t.Errorf("Variant(md, hover) = %s, want %s", got, direct)
+	}
+}
+
+func TestDarkModeStrategies(t *testing.T) {
+	bg := css.RuleSet(".bg-black", css.Set(cssgen.BackgroundColor, css.Hex("#000")))
+	tests := []struct {
+		mode DarkModeConfig
+		want string
+	}{
+		{DarkModeConfig{Strategy: DarkModeClass}, `.dark .dark\:bg-black{background-color:#000}`},
+		{DarkModeConfig{Strategy: DarkModeSelector, Selector: `[data-theme="dark"]`}, `[data-theme="dark"] .dark\:bg-black{background-color:#000}`},
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.DarkMode = tt.mode
+		got, err := NewVariants(config).Apply(bg, "dark")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s := got.String(); s != tt.want {
+			t.Errorf("dark with %+v = %s, want %s", tt.mode, s, tt.want)
+		}
+	}
+}
+
+func TestCustomScreens(t *testing.T) {
+	config := DefaultConfig()
+	config.Theme.Screens = ScreensConfig{Sm: LengthFromPx("sm", 480), Md: LengthFromPx("md", 900)}
+	variants := NewVariants(config)
+
+	if got := strings.Join(variants.Screens(), " "); got != "sm md" {
+		t.Errorf("Screens() = %s", got)
+	}
+	if _, ok := variants.Lookup("lg"); ok {
+		t.Error("unset breakpoint lg is a variant")
+	}
+	if def, _ := variants.Lookup("md"); def.Params != "(min-width: 900px)" {
+		t.Errorf("md params = %q", def.Params)
+	}
+
+	out := NewUtilityGenerator(config).GenerateUtilities().String()
+	if !strings.Contains(out, `@media (min-width: 480px)`) || !strings.Contains(out, `.sm\:grid`) {
+		t.Error("responsive utilities do not use the configured screens")
+	}
+}